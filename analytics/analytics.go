@@ -0,0 +1,353 @@
+// Package analytics 基于 []TVQ 的时间序列统计, 按服务端统计值(rtdbh_summary、RtdbSummaryData)的定义计算,
+// 可以对缓存的历史数据进行计算并与 ReadSummary 的结果相互校验
+//
+//   - 只支持整数与浮点数类型, 整数类型与阶跃点(PointInfo.Step 为 ON)按前值计算, 其他按线性计算
+//   - 只有质量码为 QualityGood 的数值参与统计, 质量码异常的数值中断积分
+//   - 积分(Power)的单位为 数值×秒, 数值需要按时间升序排列
+//   - 累计值(Total)为按秒累计的积分, 即把数值视为每秒的速率换算到工程单位; 服务端在统计时间段内没有数值时仍然返回累计值与加权平均值,
+//     说明二者是对时间段的积分而不是数值的和, 与服务端比较时使用 SummarizeRange
+package analytics
+
+import (
+	"errors"
+	"time"
+
+	rtdb "github.com/kkbase/rtdb_api"
+)
+
+// ErrInvalidType 不是整数或浮点数类型
+var ErrInvalidType = errors.New("只支持整数与浮点数类型")
+
+// kind 数值类型的计算方式
+type kind struct {
+	float bool // 是否为浮点数类型
+	step  bool // 是否按前值计算
+}
+
+// kindOf 标签点的计算方式
+func kindOf(info *rtdb.PointInfo) (kind, error) {
+	switch info.ValueType {
+	case rtdb.ValueTypeFloat16, rtdb.ValueTypeFloat32, rtdb.ValueTypeFloat64, rtdb.ValueTypeFp16, rtdb.ValueTypeFp32, rtdb.ValueTypeFp64:
+		return kind{float: true, step: info.Step == rtdb.ON}, nil
+	case rtdb.ValueTypeBool, rtdb.ValueTypeUint8, rtdb.ValueTypeInt8, rtdb.ValueTypeChar, rtdb.ValueTypeUint16, rtdb.ValueTypeInt16, rtdb.ValueTypeUint32, rtdb.ValueTypeInt32, rtdb.ValueTypeInt64:
+		return kind{step: true}, nil
+	}
+	return kind{}, ErrInvalidType
+}
+
+// number 数值
+func (k kind) number(tvq rtdb.TVQ) float64 {
+	if k.float {
+		return tvq.Value.FloatValue
+	}
+	return float64(tvq.Value.IntValue)
+}
+
+// good 质量码是否正常
+func good(tvq rtdb.TVQ) bool {
+	return tvq.Quality == rtdb.QualityGood
+}
+
+// Summarize 计算全部数值的统计值, 统计一段时间并与服务端比较时使用 SummarizeRange
+//   - First、Last、Count 包括质量码异常的数值
+//   - Max、Min、CalcAvg、ValidCount 只统计质量码正常的数值
+//   - Power 为按时间加权的积分, Total 为按秒累计的积分(与 Power 相同), PowerAvg 为 Power 除以首尾数值的时间跨度, 时间跨度为0时为 CalcAvg
+//
+// input:
+//   - info 标签点信息
+//   - tvqs 按时间升序排列的数值
+//
+// output:
+//   - *rtdb.Summary 统计值
+func Summarize(info *rtdb.PointInfo, tvqs []rtdb.TVQ) (*rtdb.Summary, error) {
+	k, err := kindOf(info)
+	if err != nil {
+		return nil, err
+	}
+	summary := &rtdb.Summary{}
+	sum := 0.0
+	var prev *rtdb.TVQ
+	for i := range tvqs {
+		tvq := &tvqs[i]
+		value := summaryValue(k, *tvq)
+		if summary.Count == 0 {
+			summary.First = value
+		}
+		summary.Last = value
+		summary.Count++
+		if !good(*tvq) {
+			prev = nil
+			continue
+		}
+		if summary.ValidCount == 0 || value.Value > summary.Max.Value {
+			summary.Max = value
+		}
+		if summary.ValidCount == 0 || value.Value < summary.Min.Value {
+			summary.Min = value
+		}
+		summary.ValidCount++
+		sum += value.Value
+		if prev != nil {
+			summary.Power += k.area(*prev, *tvq)
+		}
+		prev = tvq
+	}
+	summary.Total = summary.Power
+	if summary.ValidCount > 0 {
+		summary.CalcAvg = sum / float64(summary.ValidCount)
+		if span := summary.Last.Timestamp.Sub(summary.First.Timestamp).Seconds(); span > 0 {
+			summary.PowerAvg = summary.Power / span
+		} else {
+			summary.PowerAvg = summary.CalcAvg
+		}
+	}
+	return summary, nil
+}
+
+// SummarizeRange 按服务端 ReadSummary 的定义计算一段时间内的统计值
+//   - First、Last、Max、Min、CalcAvg、Count、ValidCount 只统计时间段内的数值
+//   - Power、Total 为时间段内的积分, 起止时间在首尾数值之间时先插值(参见 Clip), 因此时间段内没有数值时也有效
+//   - PowerAvg 为 Power 除以起止时间的跨度, 跨度为0时为 CalcAvg
+//   - 开始时间晚于结束时间时交换二者, 与服务端一致
+//
+// input:
+//   - info 标签点信息
+//   - tvqs 按时间升序排列的数值, 需要包括时间段前后的数值才能在起止时间插值
+//   - start 开始时间(包含)
+//   - end 结束时间(包含)
+//
+// output:
+//   - *rtdb.Summary 统计值
+func SummarizeRange(info *rtdb.PointInfo, tvqs []rtdb.TVQ, start, end time.Time) (*rtdb.Summary, error) {
+	if end.Before(start) {
+		start, end = end, start
+	}
+	window, err := Clip(info, tvqs, start, end)
+	if err != nil {
+		return nil, err
+	}
+	last := search(tvqs, end)
+	if last < len(tvqs) && tvqs[last].Timestamp.Equal(end) {
+		last++
+	}
+	summary, err := Summarize(info, tvqs[search(tvqs, start):last])
+	if err != nil {
+		return nil, err
+	}
+	integral, _ := Summarize(info, window)
+	summary.Power, summary.Total = integral.Power, integral.Total
+	if span := end.Sub(start).Seconds(); span > 0 {
+		summary.PowerAvg = summary.Power / span
+	} else {
+		summary.PowerAvg = summary.CalcAvg
+	}
+	return summary, nil
+}
+
+// summaryValue 转换成统计值中的单个数值
+func summaryValue(k kind, tvq rtdb.TVQ) rtdb.SummaryValue {
+	return rtdb.SummaryValue{Timestamp: tvq.Timestamp, Value: k.number(tvq), Quality: tvq.Quality}
+}
+
+// area 相邻两个数值之间的积分
+func (k kind) area(prev, next rtdb.TVQ) float64 {
+	seconds := next.Timestamp.Sub(prev.Timestamp).Seconds()
+	if k.step {
+		return k.number(prev) * seconds
+	}
+	return (k.number(prev) + k.number(next)) / 2 * seconds
+}
+
+// Integral 按时间加权的积分(数值×秒), 对应 RtdbSummaryData.Power
+//
+// input:
+//   - info 标签点信息
+//   - tvqs 按时间升序排列的数值, 需要统计固定时间段时先使用 Clip 截取
+func Integral(info *rtdb.PointInfo, tvqs []rtdb.TVQ) (float64, error) {
+	summary, err := Summarize(info, tvqs)
+	if err != nil {
+		return 0, err
+	}
+	return summary.Power, nil
+}
+
+// TimeWeightedAverage 时间加权平均值, 即积分除以首尾数值的时间跨度; 统计固定时间段时使用 SummarizeRange 的 PowerAvg
+//
+// input:
+//   - info 标签点信息
+//   - tvqs 按时间升序排列的数值, 需要统计固定时间段时先使用 Clip 截取
+func TimeWeightedAverage(info *rtdb.PointInfo, tvqs []rtdb.TVQ) (float64, error) {
+	summary, err := Summarize(info, tvqs)
+	if err != nil {
+		return 0, err
+	}
+	return summary.PowerAvg, nil
+}
+
+// Total 按秒累计的积分(工程单位), 对应 RtdbSummaryData.Total, 数值为其他时间单位的速率时除以相应的秒数
+func Total(info *rtdb.PointInfo, tvqs []rtdb.TVQ) (float64, error) {
+	summary, err := Summarize(info, tvqs)
+	if err != nil {
+		return 0, err
+	}
+	return summary.Total, nil
+}
+
+// MinMax 质量码正常的数值中的最小值与最大值及其时间, 相同的数值取最早的
+//
+// output:
+//   - rtdb.SummaryValue 最小值
+//   - rtdb.SummaryValue 最大值
+//   - bool 是否存在质量码正常的数值
+func MinMax(info *rtdb.PointInfo, tvqs []rtdb.TVQ) (rtdb.SummaryValue, rtdb.SummaryValue, bool, error) {
+	summary, err := Summarize(info, tvqs)
+	if err != nil {
+		return rtdb.SummaryValue{}, rtdb.SummaryValue{}, false, err
+	}
+	return summary.Min, summary.Max, summary.ValidCount > 0, nil
+}
+
+// Interpolate 计算某一时刻的数值
+//   - 与数值的时间相同时返回该数值
+//   - 整数类型与阶跃点取前值, 其他在前后两个数值之间线性插值
+//   - 前值质量码异常时返回前值, 后值质量码异常时取前值
+//
+// input:
+//   - info 标签点信息
+//   - tvqs 按时间升序排列的数值
+//   - t 时刻
+//
+// output:
+//   - rtdb.TVQ 数值, 时间戳为t
+//   - bool t是否在首尾数值的时间范围内
+func Interpolate(info *rtdb.PointInfo, tvqs []rtdb.TVQ, t time.Time) (rtdb.TVQ, bool, error) {
+	k, err := kindOf(info)
+	if err != nil {
+		return rtdb.TVQ{}, false, err
+	}
+	i := search(tvqs, t)
+	switch {
+	case i < len(tvqs) && tvqs[i].Timestamp.Equal(t):
+		return tvqs[i], true, nil
+	case i == 0 || i == len(tvqs):
+		return rtdb.TVQ{Timestamp: t, Type: info.ValueType, Quality: rtdb.QualityNoData}, false, nil
+	}
+	return k.between(info, tvqs[i-1], tvqs[i], t), true, nil
+}
+
+// search 第一个时间不早于t的数值的序号
+func search(tvqs []rtdb.TVQ, t time.Time) int {
+	lo, hi := 0, len(tvqs)
+	for lo < hi {
+		mid := (lo + hi) / 2
+		if tvqs[mid].Timestamp.Before(t) {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	return lo
+}
+
+// between 在prev与next之间插值
+func (k kind) between(info *rtdb.PointInfo, prev, next rtdb.TVQ, t time.Time) rtdb.TVQ {
+	tvq := prev
+	tvq.Timestamp = t
+	tvq.Type = info.ValueType
+	if k.step || !good(prev) || !good(next) {
+		return tvq
+	}
+	ratio := t.Sub(prev.Timestamp).Seconds() / next.Timestamp.Sub(prev.Timestamp).Seconds()
+	tvq.Value = rtdb.AnyValue{FloatValue: prev.Value.FloatValue + (next.Value.FloatValue-prev.Value.FloatValue)*ratio}
+	return tvq
+}
+
+// Clip 截取一段时间内的数值, 并在起止时间插入插值, 截取后的积分即为这段时间内的积分
+//
+// input:
+//   - info 标签点信息
+//   - tvqs 按时间升序排列的数值
+//   - start 开始时间
+//   - end 结束时间
+//
+// output:
+//   - []rtdb.TVQ 截取的数值, 起止时间不在数值的时间范围内时不插入插值
+func Clip(info *rtdb.PointInfo, tvqs []rtdb.TVQ, start, end time.Time) ([]rtdb.TVQ, error) {
+	if _, err := kindOf(info); err != nil {
+		return nil, err
+	}
+	rtn := make([]rtdb.TVQ, 0)
+	if end.Before(start) {
+		return rtn, nil
+	}
+	first, last := search(tvqs, start), search(tvqs, end)
+	if tvq, ok, _ := Interpolate(info, tvqs, start); ok && (first == len(tvqs) || !tvqs[first].Timestamp.Equal(start)) {
+		rtn = append(rtn, tvq)
+	}
+	if last < len(tvqs) && tvqs[last].Timestamp.Equal(end) {
+		last++
+	}
+	rtn = append(rtn, tvqs[first:last]...)
+	if tvq, ok, _ := Interpolate(info, tvqs, end); ok && (len(rtn) == 0 || rtn[len(rtn)-1].Timestamp.Before(end)) {
+		rtn = append(rtn, tvq)
+	}
+	return rtn, nil
+}
+
+// Resample 按固定间隔插值
+//
+// input:
+//   - info 标签点信息
+//   - tvqs 按时间升序排列的数值
+//   - start 开始时间
+//   - end 结束时间(包含)
+//   - interval 间隔
+//
+// output:
+//   - []rtdb.TVQ 插值结果, 不在数值的时间范围内的时刻质量码为 QualityNoData
+func Resample(info *rtdb.PointInfo, tvqs []rtdb.TVQ, start, end time.Time, interval time.Duration) ([]rtdb.TVQ, error) {
+	if interval <= 0 {
+		return nil, errors.New("插值间隔必须大于0")
+	}
+	rtn := make([]rtdb.TVQ, 0)
+	for t := start; !t.After(end); t = t.Add(interval) {
+		tvq, _, err := Interpolate(info, tvqs, t)
+		if err != nil {
+			return nil, err
+		}
+		rtn = append(rtn, tvq)
+	}
+	return rtn, nil
+}
+
+// StateDurations 每个状态持续的时间, 用于开关量(bool)等状态点
+//   - 质量码正常的数值的状态持续到下一个数值, 最后一个数值持续到end
+//   - 质量码异常的数值之后的时间不计入任何状态
+//
+// input:
+//   - tvqs 按时间升序排列的整数类型数值, bool类型的状态为0和1
+//   - end 结束时间, 早于最后一个数值时最后一个数值不计入
+//
+// output:
+//   - map[int64]time.Duration 状态与持续时间
+func StateDurations(tvqs []rtdb.TVQ, end time.Time) map[int64]time.Duration {
+	durations := make(map[int64]time.Duration)
+	for i, tvq := range tvqs {
+		if !good(tvq) {
+			continue
+		}
+		next := end
+		if i+1 < len(tvqs) {
+			next = tvqs[i+1].Timestamp
+		}
+		if d := next.Sub(tvq.Timestamp); d > 0 {
+			durations[tvq.Value.IntValue] += d
+		}
+	}
+	return durations
+}
+
+// OnDuration bool类型数值为true的持续时间, 参见 StateDurations
+func OnDuration(tvqs []rtdb.TVQ, end time.Time) time.Duration {
+	return StateDurations(tvqs, end)[1]
+}
@@ -0,0 +1,237 @@
+package analytics
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	rtdb "github.com/kkbase/rtdb_api"
+)
+
+var base = time.Unix(1700000000, 0)
+
+// at 相对base的秒数
+func at(s float64) time.Time {
+	return base.Add(time.Duration(s * float64(time.Second)))
+}
+
+// floats 每秒一个的浮点数
+func floats(values ...float64) []rtdb.TVQ {
+	tvqs := make([]rtdb.TVQ, len(values))
+	for i, v := range values {
+		tvqs[i] = rtdb.NewTvqFloat64(at(float64(i)), v, rtdb.QualityGood)
+	}
+	return tvqs
+}
+
+// 按定义逐项计算的统计值: 线性、阶跃与整数类型, 质量码异常的数值中断积分, 累计值为按秒累计的积分
+func TestSummarize(t *testing.T) {
+	samples := []struct {
+		offset  float64
+		value   float64
+		quality rtdb.Quality
+	}{{0, 1, rtdb.QualityGood}, {1.5, 4, rtdb.QualityGood}, {2, -2, rtdb.QualityGood}, {3, 7, rtdb.QualityBad}, {4, 3, rtdb.QualityGood}, {6.25, 5, rtdb.QualityGood}}
+	value := func(i int) rtdb.SummaryValue {
+		return rtdb.SummaryValue{Timestamp: at(samples[i].offset), Value: samples[i].value, Quality: samples[i].quality}
+	}
+	// 共同的部分: 质量码正常的数值为 1、4、-2、3、5
+	common := rtdb.Summary{First: value(0), Last: value(5), Max: value(5), Min: value(2), CalcAvg: 2.2, Count: 6, ValidCount: 5}
+
+	linear := rtdb.NewPointInfo("linear", 1, rtdb.ValueTypeFloat64, rtdb.PointBase, rtdb.RtdbPrecisionMilli, "", "")
+	step := rtdb.NewPointInfo("step", 1, rtdb.ValueTypeFloat32, rtdb.PointBase, rtdb.RtdbPrecisionMilli, "", "")
+	step.Step = rtdb.ON
+	count := rtdb.NewPointInfo("count", 1, rtdb.ValueTypeInt32, rtdb.PointBase, rtdb.RtdbPrecisionMilli, "", "")
+	cases := []struct {
+		info  *rtdb.PointInfo
+		power float64
+	}{
+		// 梯形: (1+4)/2×1.5 + (4-2)/2×0.5 + (3+5)/2×2.25, 2秒到4秒之间被质量码异常的数值中断
+		{linear, 3.75 + 0.5 + 9},
+		// 前值: 1×1.5 + 4×0.5 + 3×2.25
+		{step, 1.5 + 2 + 6.75},
+		{count, 1.5 + 2 + 6.75},
+	}
+	for _, c := range cases {
+		tvqs := make([]rtdb.TVQ, len(samples))
+		for i, s := range samples {
+			switch c.info.ValueType {
+			case rtdb.ValueTypeFloat64:
+				tvqs[i] = rtdb.NewTvqFloat64(at(s.offset), s.value, s.quality)
+			case rtdb.ValueTypeFloat32:
+				tvqs[i] = rtdb.NewTvqFloat32(at(s.offset), float32(s.value), s.quality)
+			default:
+				tvqs[i] = rtdb.NewTvqInt32(at(s.offset), int32(s.value), s.quality)
+			}
+		}
+		got, err := Summarize(c.info, tvqs)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := common
+		want.Power, want.Total, want.PowerAvg = c.power, c.power, c.power/6.25
+		if math.Abs(got.PowerAvg-want.PowerAvg) > 1e-9 {
+			t.Errorf("%s 加权平均值错误, 期望%v实际%v", c.info.Name, want.PowerAvg, got.PowerAvg)
+		}
+		got.PowerAvg = want.PowerAvg
+		if *got != want {
+			t.Errorf("%s 统计值错误\n期望 %+v\n实际 %+v", c.info.Name, want, *got)
+		}
+	}
+
+	if _, err := Summarize(rtdb.NewPointInfo("name", 1, rtdb.ValueTypeString, rtdb.PointBase, rtdb.RtdbPrecisionMilli, "", ""), nil); !errors.Is(err, ErrInvalidType) {
+		t.Error("字符串类型期望失败", err)
+	}
+	if summary, _ := Summarize(linear, floats(3)); summary.PowerAvg != 3 {
+		t.Error("时间跨度为0时加权平均值应为算术平均值", summary.PowerAvg)
+	}
+}
+
+// 一段时间内的统计值: 起止时间插值后积分, 加权平均值除以起止时间的跨度
+func TestSummarizeRange(t *testing.T) {
+	info := rtdb.NewPointInfo("temp", 1, rtdb.ValueTypeFloat64, rtdb.PointBase, rtdb.RtdbPrecisionMilli, "", "")
+	tvqs := floats(0, 10, 20, 30)
+	summary, err := SummarizeRange(info, tvqs, at(2.5), at(0.5))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 0.5秒到2.5秒: 5→10→20→25, 积分 7.5/2 + 15 + 22.5/2 = 30
+	if summary.Count != 2 || summary.First.Value != 10 || summary.Last.Value != 20 || summary.CalcAvg != 15 ||
+		math.Abs(summary.Power-30) > 1e-9 || summary.Total != summary.Power || math.Abs(summary.PowerAvg-15) > 1e-9 {
+		t.Errorf("统计值错误 %+v", summary)
+	}
+	// 时间段内没有数值时只有积分与加权平均值有效
+	summary, _ = SummarizeRange(info, tvqs, at(1.25), at(1.75))
+	if summary.Count != 0 || math.Abs(summary.Power-7.5) > 1e-9 || math.Abs(summary.PowerAvg-15) > 1e-9 {
+		t.Errorf("没有数值时的统计值错误 %+v", summary)
+	}
+}
+
+// 与服务端的 ReadSummary 比较时间段内的积分、累计值与加权平均值
+func TestRtdbConnect_SummarizeRange(t *testing.T) {
+	conn, err := rtdb.Login("159.75.187.68", 6327, "sa", "golden")
+	if err != nil {
+		t.Fatal("登录用户失败", err)
+	}
+	defer func() { _ = conn.Logout() }()
+	table, err := conn.CreateTable(fmt.Sprintf("analytics_%d", time.Now().Unix()), "统计")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = conn.DeleteTable(table.ID) }()
+	info := rtdb.NewPointInfo("linear", table.ID, rtdb.ValueTypeFloat64, rtdb.PointBase, rtdb.RtdbPrecisionMilli, "", "")
+	info.SetException(0, 0, 0, 0)
+	info.SetCompress(rtdb.OFF, 0, 0, 0, 0)
+	if info, err = conn.AddPoint(info); err != nil {
+		t.Fatal(err)
+	}
+	start := time.Now().Add(-time.Hour).Truncate(time.Second)
+	tvqs := make([]rtdb.TVQ, 0)
+	for i, v := range []float64{1, 4, -2, 3, 5, 8} {
+		tvqs = append(tvqs, rtdb.NewTvqFloat64(start.Add(time.Duration(i)*2*time.Second), v, rtdb.QualityGood))
+	}
+	if _, err := conn.WriteValues(info, false, tvqs); err != nil {
+		t.Fatal(err)
+	}
+	for _, window := range [][2]time.Duration{{0, 10 * time.Second}, {time.Second, 9 * time.Second}, {3 * time.Second, 3500 * time.Millisecond}} {
+		from, to := start.Add(window[0]), start.Add(window[1])
+		want, err := conn.ReadSummary(info, from, to)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := SummarizeRange(info, tvqs, from, to)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if math.Abs(got.Power-want.Power) > 1e-6 || math.Abs(got.Total-want.Total) > 1e-6 || math.Abs(got.PowerAvg-want.PowerAvg) > 1e-6 {
+			t.Errorf("%v~%v 与服务端不一致\n服务端 %+v\n本地 %+v", window[0], window[1], want, got)
+		}
+	}
+}
+
+// 积分、加权平均值、最值
+func TestIntegral(t *testing.T) {
+	info := rtdb.NewPointInfo("temp", 1, rtdb.ValueTypeFloat64, rtdb.PointBase, rtdb.RtdbPrecisionMilli, "", "")
+	tvqs := floats(0, 2, 2, 6)
+	if power, _ := Integral(info, tvqs); power != 1+2+4 {
+		t.Error("线性积分错误", power)
+	}
+	if avg, _ := TimeWeightedAverage(info, tvqs); avg != 7.0/3 {
+		t.Error("加权平均值错误", avg)
+	}
+	if total, _ := Total(info, tvqs); total != 1+2+4 {
+		t.Error("累计值错误", total)
+	}
+	info.Step = rtdb.ON
+	if power, _ := Integral(info, tvqs); power != 0+2+2 {
+		t.Error("阶跃积分错误", power)
+	}
+	minimum, maximum, ok, _ := MinMax(info, floats(3, 1, 5, 1, 5))
+	if !ok || minimum.Value != 1 || !minimum.Timestamp.Equal(at(1)) || maximum.Value != 5 || !maximum.Timestamp.Equal(at(2)) {
+		t.Error("最值错误", minimum, maximum, ok)
+	}
+	if _, _, ok, _ := MinMax(info, nil); ok {
+		t.Error("没有数值时期望ok为false")
+	}
+}
+
+// 插值与截取
+func TestInterpolate(t *testing.T) {
+	info := rtdb.NewPointInfo("temp", 1, rtdb.ValueTypeFloat64, rtdb.PointBase, rtdb.RtdbPrecisionMilli, "", "")
+	tvqs := floats(0, 10, 20)
+	if tvq, ok, _ := Interpolate(info, tvqs, at(0.25)); !ok || tvq.Value.FloatValue != 2.5 || !tvq.Timestamp.Equal(at(0.25)) {
+		t.Error("线性插值错误", tvq, ok)
+	}
+	if tvq, ok, _ := Interpolate(info, tvqs, at(1)); !ok || tvq.Value.FloatValue != 10 {
+		t.Error("相同时间的插值错误", tvq, ok)
+	}
+	if tvq, ok, _ := Interpolate(info, tvqs, at(3)); ok || tvq.Quality != rtdb.QualityNoData {
+		t.Error("超出范围的插值错误", tvq, ok)
+	}
+	step := *info
+	step.Step = rtdb.ON
+	if tvq, _, _ := Interpolate(&step, tvqs, at(1.75)); tvq.Value.FloatValue != 10 {
+		t.Error("阶跃插值错误", tvq)
+	}
+
+	clipped, err := Clip(info, tvqs, at(0.5), at(1.5))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(clipped) != 3 || clipped[0].Value.FloatValue != 5 || clipped[2].Value.FloatValue != 15 {
+		t.Fatal("截取结果错误", clipped)
+	}
+	if power, _ := Integral(info, clipped); math.Abs(power-10) > 1e-9 {
+		t.Error("截取后的积分错误", power)
+	}
+	if clipped, _ = Clip(info, tvqs, at(1), at(2)); len(clipped) != 2 {
+		t.Error("起止时间与数值相同时不应插入插值", clipped)
+	}
+
+	resampled, err := Resample(info, tvqs, at(-1), at(2), 1500*time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resampled) != 3 || resampled[0].Quality != rtdb.QualityNoData || resampled[1].Value.FloatValue != 5 || resampled[2].Value.FloatValue != 20 {
+		t.Error("按间隔插值错误", resampled)
+	}
+}
+
+// 开关量的状态持续时间
+func TestStateDurations(t *testing.T) {
+	tvqs := []rtdb.TVQ{
+		rtdb.NewTvqBool(at(0), false, rtdb.QualityGood),
+		rtdb.NewTvqBool(at(10), true, rtdb.QualityGood),
+		rtdb.NewTvqBool(at(25), true, rtdb.QualityBad),
+		rtdb.NewTvqBool(at(30), false, rtdb.QualityGood),
+		rtdb.NewTvqBool(at(40), true, rtdb.QualityGood),
+	}
+	durations := StateDurations(tvqs, at(45))
+	if durations[0] != 20*time.Second || durations[1] != 20*time.Second {
+		t.Error("状态持续时间错误", durations)
+	}
+	if on := OnDuration(tvqs, at(40)); on != 15*time.Second {
+		t.Error("开启时间错误", on)
+	}
+}
@@ -1,4204 +1,45 @@
-package rtdb_api
-
-// #cgo CFLAGS: -DPNG_DEBUG=1 -I./cinclude
-// #cgo CXXFLAGS: -std=c++11
-// #include <stdlib.h>
-// #include "api.h"
-// #include "gofn.h"
-import "C"
-import (
-	_ "embed"
-	"errors"
-	"fmt"
-	"os"
-	"path/filepath"
-	"runtime"
-	"strings"
-	"time"
-	"unsafe"
-)
-
-//go:embed clibrary/linux_amd64/librtdbapi.so
-var LinuxAmd64RtdbSo []byte
-
-//go:embed clibrary/linux_arm64/librtdbapi.so
-var LinuxArm64RtdbSo []byte
-
-//go:embed clibrary/windows_amd32/rtdbapi.dll
-var WindowsAmd32RtdbSo []byte
-
-//go:embed clibrary/windows_amd64/rtdbapi.dll
-var WindowsAmd64RtdbSo []byte
-
-func init() {
-	data := make([]byte, 0)
-	name := ""
-	if runtime.GOOS == "linux" {
-		if runtime.GOARCH == "amd64" {
-			data = LinuxAmd64RtdbSo
-			name = "librtdb.so"
-		} else if runtime.GOARCH == "arm64" {
-			data = LinuxArm64RtdbSo
-			name = "librtdb.so"
-		} else {
-			panic("不支持的平台，分支不可达")
-		}
-	} else if runtime.GOOS == "windows" {
-		if runtime.GOARCH == "amd64" {
-			data = WindowsAmd64RtdbSo
-			name = "rtdbapi.dll"
-		} else if runtime.GOARCH == "amd32" {
-			data = WindowsAmd32RtdbSo
-			name = "rtdbapi.dll"
-		} else {
-			panic("不支持的平台，分支不可达")
-		}
-	} else {
-		panic("不支持的平台，分支不可达")
-	}
-
-	// 将动态库写入到临时文件
-	path := filepath.Join(os.TempDir(), name)
-	if err := os.WriteFile(path, data, 0755); err != nil {
-		panic(err)
-	}
-
-	// 加载动态库
-	if runtime.GOOS == "linux" {
-		cPath := C.CString(path)
-		defer C.free(unsafe.Pointer(cPath))
-		C.load_library_linux(cPath)
-	} else {
-		cPath, err := UTF16PtrFromString(path)
-		if err != nil {
-			panic("字符转换失败：" + err.Error())
-		}
-		C.load_library_windows((*C.wchar_t)(unsafe.Pointer(cPath)))
-	}
-}
-
-// Switch 开关
-type Switch byte
-
-const (
-	// OFF 关闭
-	OFF = Switch(0)
-
-	// ON 开启
-	ON = Switch(1)
-)
-
-// RtdbError 数据库错误
-type RtdbError uint32
-
-// IsOk 判断当前错误是否为RteOk
-func (re RtdbError) IsOk() bool {
-	return errors.Is(re, RteOk)
-}
-
-// Error 实现 error 接口
-func (re RtdbError) Error() string {
-	desc := ""
-	switch {
-	case errors.Is(re, RteUnknownError):
-		desc = "未知错误"
-	case errors.Is(re, RteOk):
-		desc = "操作成功完成"
-	case errors.Is(re, RteWindowsError):
-		desc = "Windows操作系统错误的起始值"
-	case errors.Is(re, RteWindowsErrorMax):
-		desc = "Windows操作系统错误的结束值"
-	case errors.Is(re, RteInvalidOpenmode):
-		desc = "无效的文件打开方式"
-	case errors.Is(re, RteOpenfileFailed):
-		desc = "打开文件失败"
-	case errors.Is(re, RteMovetoendFailed):
-		desc = "移动文件指针到文件尾失败"
-	case errors.Is(re, RteDifferReadbytes):
-		desc = "读取内容长度与要求不符"
-	case errors.Is(re, RteGetfileposFailed):
-		desc = "获取当前文件指针失败"
-	case errors.Is(re, RteFlushfileFailed):
-		desc = "刷新文件缓冲区失败"
-	case errors.Is(re, RteSetsizeFailed):
-		desc = "设置文件大小失败"
-	case errors.Is(re, RteFileNotClosed):
-		desc = "试图用未关闭的文件对象创建或打开文件"
-	case errors.Is(re, RteFileUnknown):
-		desc = "创建或打开文件时必须指定文件名"
-	case errors.Is(re, RteInvalidHeader):
-		desc = "数据文件头信息错误"
-	case errors.Is(re, RteDisabledFile):
-		desc = "数据文件无效，试图访问无效数据文件"
-	case errors.Is(re, RteFileNotOpened):
-		desc = "试图访问尚未打开的数据文件"
-	case errors.Is(re, RtePointNotFound):
-		desc = "要求访问的标签点不存在或无效"
-	case errors.Is(re, RteReadyblockNotFound):
-		desc = "数据文件中找不到从指定数据块以后的可用的空块"
-	case errors.Is(re, RteFileIsIncult):
-		desc = "文件未被使用过"
-	case errors.Is(re, RteFileIsFull):
-		desc = "数据文件已满"
-	case errors.Is(re, RteFileexIsFull):
-		desc = "数据文件扩展区已满，无法继续装载数据"
-	case errors.Is(re, RteInvalidDataType):
-		desc = "无效的数据类型"
-	case errors.Is(re, RteDatablockNotFound):
-		desc = "找不到符合时间条件的数据块"
-	case errors.Is(re, RteDataBetweenBlock):
-		desc = "数据时间位于找到的块和下一个数据块之间"
-	case errors.Is(re, RteCantModifyExistValue):
-		desc = "不允许修改已存在的数据"
-	case errors.Is(re, RteWrongdataInBlock):
-		desc = "块中有错误数据导致数据块头信息不符"
-	case errors.Is(re, RteDatatimeNotIn):
-		desc = "数据文件中没有该标签点指定时间的数据"
-	case errors.Is(re, RteNullArchivePath):
-		desc = "操作的数据文件路径为空"
-	case errors.Is(re, RteRegArchivePath):
-		desc = "数据文件已被注册"
-	case errors.Is(re, RteUnregArchivePath):
-		desc = "未注册的数据文件"
-	case errors.Is(re, RteFileInexistence):
-		desc = "指定的文件不存在"
-	case errors.Is(re, RteDataTypeNotMatch):
-		desc = "数据类型不匹配"
-	case errors.Is(re, RteFileIsReadonly):
-		desc = "不允许修改只读数据文件中的数据"
-	case errors.Is(re, RteTomanyArchiveFile):
-		desc = "过多的数据文件"
-	case errors.Is(re, RteNoPointsList):
-		desc = "缺少标签点列表"
-	case errors.Is(re, RteNoActivedArchive):
-		desc = "缺少活动文档"
-	case errors.Is(re, RteNoArchiveFile):
-		desc = "缺少数据文档"
-	case errors.Is(re, RteNeedActivedArchive):
-		desc = "只能在活动文档上执行该操作"
-	case errors.Is(re, RteInvalidTimestamp):
-		desc = "无效的时间戳"
-	case errors.Is(re, RteNeedMoreWritable):
-		desc = "非只读文档个数太少"
-	case errors.Is(re, RteNoArchiveForPut):
-		desc = "找不到合适的追加历史数据的文档"
-	case errors.Is(re, RteInvalidValueMode):
-		desc = "无效的取值模式"
-	case errors.Is(re, RteDataNotFound):
-		desc = "找不到需要的数据"
-	case errors.Is(re, RteInvalidParameter):
-		desc = "无效的参数"
-	case errors.Is(re, RteReduplicateTag):
-		desc = "重复的标签点名"
-	case errors.Is(re, RteReduplicateTabname):
-		desc = "重复的表名称"
-	case errors.Is(re, RteReduplicateTabid):
-		desc = "重复的表ID"
-	case errors.Is(re, RteTableNotFound):
-		desc = "指定的表不存在"
-	case errors.Is(re, RteUnsupportedClassof):
-		desc = "不支持的标签点类别"
-	case errors.Is(re, RteWrongOrDuplicTag):
-		desc = "错误的或重复的标签点名"
-	case errors.Is(re, RteReduplicatePt):
-		desc = "重复的标签点标识"
-	case errors.Is(re, RtePointLicenseFull):
-		desc = "标签点数超出了许可证规定的最大数目"
-	case errors.Is(re, RteTableLicenseFull):
-		desc = "标签点表个数超出了许可证规定的最大数目"
-	case errors.Is(re, RteWrongOrDuplicTabname):
-		desc = "错误的或重复的表名称"
-	case errors.Is(re, RteInvalidFileFormat):
-		desc = "无效的数据文件格式"
-	case errors.Is(re, RteWrongTabname):
-		desc = "错误的表名称"
-	case errors.Is(re, RteWrongTag):
-		desc = "错误的标签点名"
-	case errors.Is(re, RteNotInScope):
-		desc = "数值超出了应属的范围"
-	case errors.Is(re, RteCantLoadBase):
-		desc = "不能同标签点信息服务取得联系"
-	case errors.Is(re, RteCantLoadSnapshot):
-		desc = "不能同快照数据服务取得联系"
-	case errors.Is(re, RteCantLoadHistory):
-		desc = "不能同历史数据服务取得联系"
-	case errors.Is(re, RteCantLoadEquation):
-		desc = "不能同实施方程式服务取得联系"
-	case errors.Is(re, RteArraySizeNotMatch):
-		desc = "数组尺寸不匹配"
-	case errors.Is(re, RteInvalidHostAddress):
-		desc = "无效的主机地址"
-	case errors.Is(re, RteConnectFalse):
-		desc = "连接已断开"
-	case errors.Is(re, RteToomanyBytesRecved):
-		desc = "接收到的数据长度超出了指定字节长度"
-	case errors.Is(re, RteReqidRespidNotMatch):
-		desc = "应答与请求的ID不一致"
-	case errors.Is(re, RteLessBytesRecved):
-		desc = "接收到的数据长度小于指定字节长度"
-	case errors.Is(re, RteUnsupportedCalcMode):
-		desc = "不支持的计算模式"
-	case errors.Is(re, RteUnsupportedDataType):
-		desc = "不支持的标签点类型"
-	case errors.Is(re, RteInvalidExpression):
-		desc = "无效的表达式"
-	case errors.Is(re, RteIncondDataNotFound):
-		desc = "找不到符合条件的数据"
-	case errors.Is(re, RteValidDataNotFound):
-		desc = "找不到需要的有效数据"
-	case errors.Is(re, RteValueOrStateIsNan):
-		desc = "数据或状态不正常，为NAN"
-	case errors.Is(re, RteCreateMutexFailed):
-		desc = "创建互斥对象失败"
-	case errors.Is(re, RteTlsallocfail):
-		desc = "处理TLS时调用系统函数LocalAlloc()失败，可能因为内存不足导致"
-	case errors.Is(re, RteToManyPoints):
-		desc = "正在调用的API函数不支持过多的标签点数量，请参考函数声明和开发手册"
-	case errors.Is(re, RteLicInfoError):
-		desc = "获取授权许可协议信息时发生错误"
-	case errors.Is(re, RteArchiveBufferFull):
-		desc = "标签点的历史数据补写缓冲区已满，请稍后再补"
-	case errors.Is(re, RteUserNotExist):
-		desc = "用户不存在"
-	case errors.Is(re, RteUserIsLocked):
-		desc = "帐户被锁定,需要管理员为您解锁"
-	case errors.Is(re, RteWrongPassword):
-		desc = "错误的口令"
-	case errors.Is(re, RteAccessIsDenied):
-		desc = "访问被拒绝，请确定是否具有足够的权限"
-	case errors.Is(re, RteHaveNotLogin):
-		desc = "您尚未登录，请先登录"
-	case errors.Is(re, RteUserIsDeleted):
-		desc = "帐户已被删除"
-	case errors.Is(re, RteUserAlreadyExist):
-		desc = "帐户已存在"
-	case errors.Is(re, RteWrongCreateTabname):
-		desc = "创建删除表失败"
-	case errors.Is(re, RteWrongFieldValue):
-		desc = "标签点属性值有错误"
-	case errors.Is(re, RteInvalidTagId):
-		desc = "无效的标签点ID"
-	case errors.Is(re, RteCheckNamedTypeNameError):
-		desc = "无效的自定义类型名称或字段名称"
-	case errors.Is(re, RteCantLoadDispatch):
-		desc = "不能同转发服务器取得联系"
-	case errors.Is(re, RteConnectTimeOut):
-		desc = "连接已超时，需要重新登录"
-	case errors.Is(re, RteWrongLogin4):
-		desc = "账户信息验证失败，还有4次尝试机会"
-	case errors.Is(re, RteWrongLogin3):
-		desc = "账户信息验证失败，还有3次尝试机会"
-	case errors.Is(re, RteWrongLogin2):
-		desc = "账户信息验证失败，还有2次尝试机会"
-	case errors.Is(re, RteWrongLogin1):
-		desc = "账户信息验证失败，还有1次尝试机会"
-	case errors.Is(re, RteWrongDesc):
-		desc = "错误的表描述"
-	case errors.Is(re, RteWrongUnit):
-		desc = "错误的工程单位"
-	case errors.Is(re, RteWrongChanger):
-		desc = "错误的最后一次被修改的用户名"
-	case errors.Is(re, RteWrongCreator):
-		desc = "错误的标签点创建者用户名"
-	case errors.Is(re, RteWrongFull):
-		desc = "错误的标签点全名"
-	case errors.Is(re, RteWrongSource):
-		desc = "错误的数据源"
-	case errors.Is(re, RteWrongInstrument):
-		desc = "错误的设备标签"
-	case errors.Is(re, RteWrongUser):
-		desc = "错误的创建者"
-	case errors.Is(re, RteWrongEquation):
-		desc = "错误的实时方程式"
-	case errors.Is(re, RteWrongTypeName):
-		desc = "错误的自定义类型名称"
-	case errors.Is(re, RteWrongEncode):
-		desc = "编码转换时出错"
-	case errors.Is(re, RteWrongOthermask):
-		desc = "错误的搜索类型转换mask值"
-	case errors.Is(re, RteWrongType):
-		desc = "错误的搜索类型"
-	case errors.Is(re, RtePointHardwareLimited):
-		desc = "由于硬件资源限制，创建或恢复标签点失败"
-	case errors.Is(re, RteWaitingRecoverData):
-		desc = "正在等待恢复数据完成，请稍后尝试连接"
-	case errors.Is(re, RteReplicationLicMismatch):
-		desc = "双活数据库授权不一致"
-	case errors.Is(re, RteReadConfigFailed):
-		desc = "读取配置文件失败"
-	case errors.Is(re, RteUpdateConfigFailed):
-		desc = "更新配置文件失败"
-	case errors.Is(re, RteFilterTooLong):
-		desc = "filter超过最大长度"
-	case errors.Is(re, RteGetArchiveNameFail):
-		desc = "获取存档文件名失败"
-	case errors.Is(re, RteAutoMoveFailed):
-		desc = "自动移动存档文件失败"
-	case errors.Is(re, RteTimeGreaterThanHotTailArc):
-		desc = "创建/入列非闪盘存档文件的时间大于闪盘最早的存档文件"
-	case errors.Is(re, RteTimeLessThanColdBeginArc):
-		desc = "创建/入列闪盘的存档文件时间小于非闪盘最新的存档文件"
-	case errors.Is(re, RteRemoveEarliestArcFailed):
-		desc = "删除最早的存档文件失败（存档文件列表为空）"
-	case errors.Is(re, RteNoFreeTableId):
-		desc = "没有空闲的表ID可用"
-	case errors.Is(re, RteNoFreeTagPosition):
-		desc = "没有空闲的标签点位址可用"
-	case errors.Is(re, RteNoFreeScanTagPosition):
-		desc = "没有空闲的采集标签点位址可用"
-	case errors.Is(re, RteNoFreeCalcTagPosition):
-		desc = "没有空闲的计算标签点位址可用"
-	case errors.Is(re, RteInvalidIpcPosition):
-		desc = "无效的位址被用于进程间内存共享"
-	case errors.Is(re, RteWrongIpcPosition):
-		desc = "错误的位址被用于进程间内存共享"
-	case errors.Is(re, RteIpcAccessException):
-		desc = "共享内存访问异常"
-	case errors.Is(re, RteArvPageNotReady):
-		desc = "没有空闲的历史数据缓存页"
-	case errors.Is(re, RteArvexPageNotReady):
-		desc = "没有空闲的补历史数据缓存页"
-	case errors.Is(re, RteInvalidPositionFromId):
-		desc = "依据标签点ID获得的位址无效"
-	case errors.Is(re, RteNoActivePageAllocator):
-		desc = "新的活动存档无法加载页分配器"
-	case errors.Is(re, RteMapIsNotReady):
-		desc = "内存映射尚未就绪"
-	case errors.Is(re, RteFileMapFailed):
-		desc = "文件映射到内存失败"
-	case errors.Is(re, RteTimeRangeNotAllowed):
-		desc = "不允许使用的时间区间"
-	case errors.Is(re, RteNoDataForSummary):
-		desc = "找不到用于统计的源数据"
-	case errors.Is(re, RteCantOperateOnActived):
-		desc = "不允许操作活动存档文件"
-	case errors.Is(re, RteScanPointLicenseFull):
-		desc = "采集标签点数超出了许可证规定的最大数目"
-	case errors.Is(re, RteCalcPointLicenseFull):
-		desc = "计算标签点数超出了许可证规定的最大数目"
-	case errors.Is(re, RteHistorianIsShuttingdown):
-		desc = "历史数据服务正在停止"
-	case errors.Is(re, RteSnapshotIsShuttingdown):
-		desc = "实时数据服务正在停止"
-	case errors.Is(re, RteEquationIsShuttingdown):
-		desc = "实时方程式服务正在停止"
-	case errors.Is(re, RteBaseIsShuttingdown):
-		desc = "标签点信息服务正在停止"
-	case errors.Is(re, RteServerIsShuttingdown):
-		desc = "网络通信服务正在停止"
-	case errors.Is(re, RteOutOfMemory):
-		desc = "内存不足"
-	case errors.Is(re, RteInvalidPage):
-		desc = "无效的数据页，有可能是未加载"
-	case errors.Is(re, RtePageIsEmpty):
-		desc = "遇到空的数据页"
-	case errors.Is(re, RteStrOrBlobTooLong):
-		desc = "字符串或BLOB数据长度超出限制"
-	case errors.Is(re, RteCreatedOrOverdue):
-		desc = "尚未产生任何快照或快照已过期"
-	case errors.Is(re, RteArchiveInfoNotMatching):
-		desc = "历史存档文件头部信息与实际不符"
-	case errors.Is(re, RteTimeRangeOverlapping):
-		desc = "指定的时间范围与已有存档文件重叠"
-	case errors.Is(re, RteCannotShiftToActived):
-		desc = "找不到合适的存档文件用于切换成活动存档"
-	case errors.Is(re, RteIndexNotReady):
-		desc = "历史存档文件对应的索引尚未就绪"
-	case errors.Is(re, RteIndexNodeNotMatch):
-		desc = "索引节点与指向的内容不符"
-	case errors.Is(re, RteCanNotCreateIndex):
-		desc = "无法创建索引节点"
-	case errors.Is(re, RteCanNotRemoveIndex):
-		desc = "无法删除索引节点"
-	case errors.Is(re, RteInvalidFilterExpress):
-		desc = "无效的过滤器表达式"
-	case errors.Is(re, RteMoreVarInFilterExp):
-		desc = "过滤器表达式中的包含了过多的变量"
-	case errors.Is(re, RteInvalidArvPageAllocate):
-		desc = "刚分配的历史数据缓存页ID与标签点事件对象ID不匹配"
-	case errors.Is(re, RteInvalidArvexPageAllocate):
-		desc = "刚分配的补历史数据缓存页ID与标签点事件对象ID不匹配"
-	case errors.Is(re, RteBigJobIsNotDone):
-		desc = "正在执行重要的任务，请稍后再试"
-	case errors.Is(re, RteDatabaseNeedRestart):
-		desc = "数据库需要重新启动以便应用新的参数"
-	case errors.Is(re, RteInvalidTimeFormat):
-		desc = "无效的时间格式字符串"
-	case errors.Is(re, RteDataPlaybackDone):
-		desc = "历史数据回放过程已结束"
-	case errors.Is(re, RteBadEquation):
-		desc = "错误的方程式"
-	case errors.Is(re, RteNotEnoughSapce):
-		desc = "剩余磁盘空间不足"
-	case errors.Is(re, RteActivedArchiveExist):
-		desc = "已存在活动存档"
-	case errors.Is(re, RteArchiveHaveExFiles):
-		desc = "指定的存档文件具有附属文件"
-	case errors.Is(re, RteArchiveIsNotLatest):
-		desc = "指定的存档文件不是最晚的"
-	case errors.Is(re, RteDbSystemNotRunning):
-		desc = "数据库管理系统尚未完全启动"
-	case errors.Is(re, RteArchiveIsAltered):
-		desc = "存档文件内容发生变更"
-	case errors.Is(re, RteArchiveIsTooSmall):
-		desc = "不允许创建太小的存档文件和附属文件"
-	case errors.Is(re, RteInvalidIndexNode):
-		desc = "遇到无效的索引节点"
-	case errors.Is(re, RteModifySnapshotNotAllowed):
-		desc = "不允许删除或修改快照事件"
-	case errors.Is(re, RteSearchInterrupted):
-		desc = "因目标正被创建、删除或恢复，搜索被迫中断，请稍后再试"
-	case errors.Is(re, RteRecycleShutdown):
-		desc = "回收站已失效，相关操作无法完成"
-	case errors.Is(re, RteNeedToReindex):
-		desc = "索引文件缺失，或部分索引节点被损坏，需要重建索引"
-	case errors.Is(re, RteInvalidQuality):
-		desc = "无效的质量码"
-	case errors.Is(re, RteEquationNotReady):
-		desc = "实时方程式服务正在解析，请稍后再试"
-	case errors.Is(re, RteArchivesLicenseFull):
-		desc = "存档文件数已达到许可证规定的最大数目"
-	case errors.Is(re, RteRecycledLicenseFull):
-		desc = "标签点回收站容量超出了许可证规定的最大数目"
-	case errors.Is(re, RteStrBlobLicenseFull):
-		desc = "字符串或BLOB类型标签点数量超出了许可证规定的最大数目"
-	case errors.Is(re, RteNotSupportWhenDebug):
-		desc = "此功能被某个调试选项禁用"
-	case errors.Is(re, RteMappingAlreadyLoaded):
-		desc = "映射已经被加载，不允许重复加载"
-	case errors.Is(re, RteArchiveIsModified):
-		desc = "存档文件被修改，动作被中断"
-	case errors.Is(re, RteActiveArchiveFull):
-		desc = "活动文档已满"
-	case errors.Is(re, RteSplitNoData):
-		desc = "拆分数据页后所给时间区间内没有数据"
-	case errors.Is(re, RteInvalidDirectory):
-		desc = "指定的路径不存在或无效"
-	case errors.Is(re, RteArchiveLackExFiles):
-		desc = "指定存档文件的部分附属文件缺失"
-	case errors.Is(re, RteBigJobIsCanceled):
-		desc = "后台任务被取消"
-	case errors.Is(re, RteArvexBlobPageNotReady):
-		desc = "没有空闲的blob补历史数据缓存页"
-	case errors.Is(re, RteInvalidArvexBlobPageAllocate):
-		desc = "刚分配的blob补历史数据缓存页ID与标签点事件对象ID不匹配"
-	case errors.Is(re, RteTimestampEqualtoSnapshot):
-		desc = "写入的时间与快照时间相同"
-	case errors.Is(re, RteTimestampEarlierThanSnapshot):
-		desc = "写入的时间比当前快照时间较早"
-	case errors.Is(re, RteTimestampGreaterThanAllow):
-		desc = "写入的时间超过了允许的时间"
-	case errors.Is(re, RteTimestampBegintimeGreagerThanEndtime):
-		desc = "开始时间大于结束时间"
-	case errors.Is(re, RteTimestampBegintimeEqualtoEndtime):
-		desc = "开始时间等于结束时间"
-	case errors.Is(re, RteInvalidCount):
-		desc = "无效的count"
-	case errors.Is(re, RteInvalidCapacity):
-		desc = "无效的capacity"
-	case errors.Is(re, RteInvalidPath):
-		desc = "无效的路径"
-	case errors.Is(re, RteInvalidPosition):
-		desc = "无效的position"
-	case errors.Is(re, RteInvalidArvPage):
-		desc = "无效的rtdb_arv_page<RTDB_T>,未加载，或者size小于等于0"
-	case errors.Is(re, RteInvalidHisinfoItemState):
-		desc = "无效的历史信息条目"
-	case errors.Is(re, RteInvalidInterval):
-		desc = "无效的间隔"
-	case errors.Is(re, RteInvalidLength):
-		desc = "无效的字符串长度"
-	case errors.Is(re, RteInvalidSerachMode):
-		desc = "无效的search mode"
-	case errors.Is(re, RteInvalidFileId):
-		desc = "无效的存档文件ID"
-	case errors.Is(re, RteInvalidMillisecond):
-		desc = "无效的毫秒值/纳秒值"
-	case errors.Is(re, RteInvalidDeadline):
-		desc = "无效的截止时间"
-	case errors.Is(re, RteInvalidJobname):
-		desc = "无效的Job名称"
-	case errors.Is(re, RteInvalidJobstate):
-		desc = "无效的Job状态"
-	case errors.Is(re, RteInvalidProcessRate):
-		desc = "无效的Process速率"
-	case errors.Is(re, RteInvalidTableId):
-		desc = "无效的表ID"
-	case errors.Is(re, RteInvalidDataSource):
-		desc = "无效的数据源格式"
-	case errors.Is(re, RteInvalidTriggerMethod):
-		desc = "无效的触发方式"
-	case errors.Is(re, RteInvalidCalcTimeRes):
-		desc = "无效的计算结果时间戳参考方式"
-	case errors.Is(re, RteInvalidTriggerTimer):
-		desc = "无效的定时触发触发周期,不能小于1秒"
-	case errors.Is(re, RteInvalidLimit):
-		desc = "工程上限不得低于工程下限"
-	case errors.Is(re, RteInvalidCompTime):
-		desc = "无效的压缩间隔，最长压缩间隔不得小于最短压缩间隔"
-	case errors.Is(re, RteInvalidExtTime):
-		desc = "无效的例外间隔，最长例外间隔不得小于最短例外间隔"
-	case errors.Is(re, RteInvalidDigits):
-		desc = "无效的数值位数，数值位数超出了范围,-20~10"
-	case errors.Is(re, RteInvalidFullTagName):
-		desc = "标签点全名有误，找不到表名与点名的分隔符“.”"
-	case errors.Is(re, RteInvalidTableDesc):
-		desc = "表描述信息过有误"
-	case errors.Is(re, RteInvalidUserCount):
-		desc = "非法的用户个数，小于0"
-	case errors.Is(re, RteInvalidBlacklistCount):
-		desc = "非法的黑名单个数，小于0"
-	case errors.Is(re, RteInvalidAuthorizationCount):
-		desc = "非法的信任连接个数，小于0"
-	case errors.Is(re, RteInvalidBigJobType):
-		desc = "非法的大任务类型"
-	case errors.Is(re, RteInvalidSysParam):
-		desc = "无效的系统参数，调用db_set_db_info2时，参数有误"
-	case errors.Is(re, RteInvalidFileParam):
-		desc = "无效的文件路径参数，调用db_set_db_info1时，参数有误"
-	case errors.Is(re, RteInvalidFileSize):
-		desc = "文件长度有误  < 1 baserecycle.dat、scanrecycle.dat、calcrecycle.dat、snaprecycle.dat"
-	case errors.Is(re, RteInvalidTagType):
-		desc = "标签点类型有误，合法（ rtdb_bool ~ rtdb_blob)，但是不属于相应函数的处理范围"
-	case errors.Is(re, RteInvalidRecyStructPos):
-		desc = "回收站对象最后一个结构体位置非法"
-	case errors.Is(re, RteInvalidRecycleFile):
-		desc = "scanrecycle.dat、baserecycle.dat  snaprecycle.dat文件不存在或失效"
-	case errors.Is(re, RteInvalidSuffixName):
-		desc = "无效的文件后缀名"
-	case errors.Is(re, RteInsertStringFalse):
-		desc = "向数据页中插入字符串数据失败"
-	case errors.Is(re, RteBlobPageFull):
-		desc = "blob数据页已满"
-	case errors.Is(re, RteInvalidStringIteratorPointer):
-		desc = "无效的str/blob迭代器指针"
-	case errors.Is(re, RteNotEqualTagid):
-		desc = "目标页标签点ID 与 当前ID不一致"
-	case errors.Is(re, RtePathsOfArchiveAndAutobackAreSame):
-		desc = "存档文件路径与自动备份路径相同"
-	case errors.Is(re, RteXmlParseFail):
-		desc = "xml文件解析失败"
-	case errors.Is(re, RteXmlElementsAbsent):
-		desc = "xml清单文件文件内容缺失"
-	case errors.Is(re, RteXmlMismatchOnName):
-		desc = "xml清单文件与本产品不匹配"
-	case errors.Is(re, RteXmlMismatchOnVersion):
-		desc = "xml清单文件版本不匹配"
-	case errors.Is(re, RteXmlMismatchOnDatasize):
-		desc = "xml清单文件数据尺寸不匹配"
-	case errors.Is(re, RteXmlMismatchOnFileinfo):
-		desc = "xml清单文件中数据文件信息不匹配"
-	case errors.Is(re, RteXmlMismatchOnWindow):
-		desc = "xml清单文件中所有数据文件的窗口大小必须一致"
-	case errors.Is(re, RteXmlMismatchOnTypecount):
-		desc = "xml清单文件自定义数据类型的数量不匹配"
-	case errors.Is(re, RteXmlMismatchOnFieldcount):
-		desc = "xml清单文件自定义数据类型的field不匹配"
-	case errors.Is(re, RteXmlFieldMustInType):
-		desc = "xml清单文件中field标签必须嵌套在type标签中"
-	case errors.Is(re, RteInvalidNamedTypeFieldCount):
-		desc = "无效的FIELD数量"
-	case errors.Is(re, RteReduplicateFieldName):
-		desc = "重复的FIELD名字"
-	case errors.Is(re, RteInvalidNamedTypeName):
-		desc = "无效的自定义数据类型的名字"
-	case errors.Is(re, RteReduplicateNamedType):
-		desc = "已经存在的自定义数据类型"
-	case errors.Is(re, RteNotExistNamedType):
-		desc = "不存在的自定义数据类型"
-	case errors.Is(re, RteUpdateXmlFailed):
-		desc = "更新XML清单文件失败"
-	case errors.Is(re, RteNamedTypeUsedWithPoint):
-		desc = "有些标签点正在使用此自定义数据类型，不允许删除"
-	case errors.Is(re, RteNamedTypeUnsupportCalcPoint):
-		desc = "自定义数据类型不支持计算点"
-	case errors.Is(re, RteXmlMismatchOnMaxId):
-		desc = "自定义数据类型的最大ID与实际的自定义数据类型数量不一致"
-	case errors.Is(re, RteNamedTypeLicenseFull):
-		desc = "自定义数据类型的数量超出了许可证规定的最大数目"
-	case errors.Is(re, RteNoFreeNamedTypeId):
-		desc = "没有空闲的自定义数据类型的ID"
-	case errors.Is(re, RteInvalidNamedTypeId):
-		desc = "无效的自定义数据类型ID"
-	case errors.Is(re, RteInvalidNamedTypeFieldName):
-		desc = "无效的自定义数据类型的字段名字"
-	case errors.Is(re, RteNamedTypeUsedWithRecyclePoint):
-		desc = "有些回收站中的标签点正在使用此自定义数据类型，不允许删除"
-	case errors.Is(re, RteNamedTypeNameTooLong):
-		desc = "自定义类型的名字超过了允许的最大长度"
-	case errors.Is(re, RteNamedTypeFieldNameTooLong):
-		desc = "自定义类型的field 名字超过了允许的最大长度"
-	case errors.Is(re, RteInvalidNamedTypeFieldLength):
-		desc = "无效的自定义数据类型的字段长度"
-	case errors.Is(re, RteInvalidSearchMask):
-		desc = "无效的高级搜索的标签点属性mask"
-	case errors.Is(re, RteRecycledSpaceNotEnough):
-		desc = "标签点回收站空闲空间不足"
-	case errors.Is(re, RteDynamicLoadedMemoryNotInit):
-		desc = "动态加载的内存未初始化"
-	case errors.Is(re, RteForbidDynamicAllocType):
-		desc = "内存库禁止动态分配类型"
-	case errors.Is(re, RteMemorydbIndexCreateFailed):
-		desc = "内存库索引创建失败"
-	case errors.Is(re, RteWgMakeQueryReturnNull):
-		desc = "whitedb make_query_rc返回null"
-	case errors.Is(re, RteThtreadPoolCreatedFailed):
-		desc = "内存库创建线程池失败"
-	case errors.Is(re, RteMemorydbRemoveRecordFailed):
-		desc = "内存库删除记录失败"
-	case errors.Is(re, RteMemorydbConfigLoadFailed):
-		desc = "内存库配置文件加载失败"
-	case errors.Is(re, RteMemorydbProhibitDynamicAlloType):
-		desc = "内存库禁止动态分配类型"
-	case errors.Is(re, RteMemorydbDynamicAllocTypeFailed):
-		desc = "内存库动态分配类型失败"
-	case errors.Is(re, RteMemorydbStorageFileNameParseFailed):
-		desc = "内存库优先级文件名解析失败"
-	case errors.Is(re, RteMemorydbTtreeIndexDamage):
-		desc = "内存库T树索引损坏"
-	case errors.Is(re, RteMemorydbConfigFailed):
-		desc = "内存库配置文件错误"
-	case errors.Is(re, RteMemorydbValueCountNotMatch):
-		desc = "内存库记录的值个数不匹配。"
-	case errors.Is(re, RteMemorydbFieldTypeNotMatch):
-		desc = "内存库的字段类型不匹配"
-	case errors.Is(re, RteMemorydbMemoryAllocFailed):
-		desc = "内存库内存分配失败"
-	case errors.Is(re, RteMemorydbMethodParamErr):
-		desc = "内存库方法参数错误"
-	case errors.Is(re, RteMemorydbQueryResultAllocFailed):
-		desc = "内存库查询结果缓存分配失败"
-	case errors.Is(re, RteFilePathLength):
-		desc = "指定的文件路径长度错误"
-	case errors.Is(re, RteMemorydbFileVersionMatch):
-		desc = "内存库文件版本不匹配"
-	case errors.Is(re, RteMemorydbFileCrcError):
-		desc = "内存库文件CRC错误"
-	case errors.Is(re, RteMemorydbFileFlagMatch):
-		desc = "内存库文件标志错误"
-	case errors.Is(re, RteMemorydbInexistence):
-		desc = "存储库不存在"
-	case errors.Is(re, RteMemorydbLoadFailed):
-		desc = "存储库加载失败"
-	case errors.Is(re, RteNoDataInInterval):
-		desc = "指定的查询区间内没有数据。"
-	case errors.Is(re, RteCantLoadMemorydb):
-		desc = "不能与内存服务取得联系"
-	case errors.Is(re, RteQueryInWhitedb):
-		desc = "查询内存库过程中出现了错误，这是whitedb内部错误"
-	case errors.Is(re, RteNoDatabaseMemorydb):
-		desc = "没有找到指定数据类型所对应的分库"
-	case errors.Is(re, RteRecordNotGet):
-		desc = "从whitedb中获取记录失败"
-	case errors.Is(re, RteMemoryAllocErr):
-		desc = "内存库用于接收快照的缓冲区分配失败"
-	case errors.Is(re, RteEventCreateFailed):
-		desc = "用于内存库接收缓冲区的事件创建失败"
-	case errors.Is(re, RteGetPointFailed):
-		desc = "获取标签点失败"
-	case errors.Is(re, RteMemoryInitFailed):
-		desc = "内存库初始化失败"
-	case errors.Is(re, RteDatatypeNotMatch):
-		desc = "数据类型不匹配"
-	case errors.Is(re, RteGetFieldErr):
-		desc = "在whitedb获取记录的字段时出现了错误"
-	case errors.Is(re, RteMemorydbInternalErr):
-		desc = "whitedb内部未知错误"
-	case errors.Is(re, RteMemorydbRecordCreatedFailed):
-		desc = "内存库创建记录失败"
-	case errors.Is(re, RteParseNormalTypeSnapshotErr):
-		desc = "解析普通数据类型的快照失败"
-	case errors.Is(re, RteParseNamedTypeSnapshotErr):
-		desc = "解析自定义数据类型的快照失败"
-	case errors.Is(re, RteStringBlobTypeUnsupportCalcPoint):
-		desc = "string、blob类型不支持计算点"
-	case errors.Is(re, RteCoorTypeUnsupportCalcPoint):
-		desc = "坐标类型不支持计算点"
-	case errors.Is(re, RteIncludeHisData):
-		desc = "记录是历史数据，可能是无效过期的脏数据"
-	case errors.Is(re, RteThreadCreateErr):
-		desc = "线程创建失败"
-	case errors.Is(re, RteXmlCrcError):
-		desc = "xml文件crc校验失败"
-	case errors.Is(re, RteOversizeIntervals):
-		desc = "intervals >"
-	case errors.Is(re, RteDatetimesMustAscendingOrder):
-		desc = "时间必须按升序排序"
-	case errors.Is(re, RteCantLoadPerf):
-		desc = "不能同性能计数服务取得联系"
-	case errors.Is(re, RtePerfTagNotFound):
-		desc = "性能计数点不存在"
-	case errors.Is(re, RteWaitDataEmpty):
-		desc = "数据为空"
-	case errors.Is(re, RteWaitDataFull):
-		desc = "数据满了"
-	case errors.Is(re, RteDataTypeCountLess):
-		desc = "数据类型数量最小值"
-	case errors.Is(re, RteMemorydbCreateFailed):
-		desc = "内存库创建失败"
-	case errors.Is(re, RteMemorydbFieldEncodeFailed):
-		desc = "内存库字段编码失败"
-	case errors.Is(re, RteRecordCreateFailed):
-		desc = "内存库记录创建失败"
-	case errors.Is(re, RteRemoveRecordErr):
-		desc = "内存库记录删除失败"
-	case errors.Is(re, RteMemorydbFileOpenField):
-		desc = "内存库打开文件失败"
-	case errors.Is(re, RteMemorydbFileWriteFailed):
-		desc = "内存库写入文件失败"
-	case errors.Is(re, RteFilterWtihFloatAndEqual):
-		desc = "含有浮点数不等式中不能有"
-	case errors.Is(re, RteDispatchPluginNotExsit):
-		desc = "转发服务器插件不存在"
-	case errors.Is(re, RteDispatchPluginFileNotExsit):
-		desc = "转发服务器插件DLL文件不存在"
-	case errors.Is(re, RteDispatchPluginAlreadyExsit):
-		desc = "转发服务器插件已存在"
-	case errors.Is(re, RteDispatchRegisterPluginFailure):
-		desc = "插件注册失败"
-	case errors.Is(re, RteDispatchStartPluginFailure):
-		desc = "启动插件失败"
-	case errors.Is(re, RteDispatchStopPluginFailure):
-		desc = "停止插件失败"
-	case errors.Is(re, RteDispatchSetPluginEnableStatusFailure):
-		desc = "设置插件状态失败"
-	case errors.Is(re, RteDispatchGetPluginCountFailure):
-		desc = "获取插件个数信息失败"
-	case errors.Is(re, RteDispatchConfigfileNotExist):
-		desc = "转发服务配置文件不存在"
-	case errors.Is(re, RteDispatchConfigDataParseErr):
-		desc = "转发服务配置数据解析错误"
-	case errors.Is(re, RteDispatchPluginAlreadyRunning):
-		desc = "转发服务器插件已经运行"
-	case errors.Is(re, RteDispatchPluginCannotRun):
-		desc = "转发服务器插件禁止运行"
-	case errors.Is(re, RteDispatchPluginContainerUnrun):
-		desc = "转发服务器插件容器未运行"
-	case errors.Is(re, RteDispatchPluginInterfaceErr):
-		desc = "转发服务器插件接口未实现"
-	case errors.Is(re, RteDispatchPluginSaveConfigErr):
-		desc = "转发服务器保存配置文件出错"
-	case errors.Is(re, RteDispatchPluginStartErr):
-		desc = "转发服务器插件启动时失败"
-	case errors.Is(re, RteDispatchPluginStopErr):
-		desc = "转发服务器插件停止时失败"
-	case errors.Is(re, RteDispatchParseDataPageErr):
-		desc = "不支持的数据页类型"
-	case errors.Is(re, RteDispatchNotRun):
-		desc = "转发服务未启用"
-	case errors.Is(re, RteBigJobIsCanceledBecauseArcRoll):
-		desc = "因存档文件滚动，后台任务被取消"
-	case errors.Is(re, RtePerfForbiddenOperation):
-		desc = "禁止对性能表的操作"
-	case errors.Is(re, RteReduplicateTagInDestTable):
-		desc = "目标表中存在同名的标签点（用于标签点移动）"
-	case errors.Is(re, RteProtocolnotimpl):
-		desc = "用户请求的报文未实现"
-	case errors.Is(re, RteCrcerror):
-		desc = "报文CRC校验错误"
-	case errors.Is(re, RteWrongUserpw):
-		desc = "验证用户名密码失败"
-	case errors.Is(re, RteChangeUserpw):
-		desc = "修改用户名密码失败"
-	case errors.Is(re, RteInvalidHandle):
-		desc = "无效的句柄"
-	case errors.Is(re, RteInvalidSocketHandle):
-		desc = "无效的套接字句柄"
-	case errors.Is(re, RteFalse):
-		desc = "操作未成功完成，具体原因查看小错误码。"
-	case errors.Is(re, RteScanPointNotFound):
-		desc = "要求访问的采集标签点不存在或无效"
-	case errors.Is(re, RteCalcPointNotFound):
-		desc = "要求访问的计算标签点不存在或无效"
-	case errors.Is(re, RteReduplicateId):
-		desc = "重复的标签点标识"
-	case errors.Is(re, RteHandleSubscribed):
-		desc = "句柄已经被订阅"
-	case errors.Is(re, RteOtherSdkDoing):
-		desc = "另一个API正在执行"
-	case errors.Is(re, RteBatchEnd):
-		desc = "分段数据返回结束"
-	case errors.Is(re, RteAuthNotFound):
-		desc = "信任连接段不存在"
-	case errors.Is(re, RteAuthExist):
-		desc = "连接地址段已经位于信任列表中"
-	case errors.Is(re, RteAuthFull):
-		desc = "信任连接段已满"
-	case errors.Is(re, RteUserFull):
-		desc = "用户已满"
-	case errors.Is(re, RteVersionUnmatch):
-		desc = "报文或数据版本不匹配"
-	case errors.Is(re, RteInvalidPriv):
-		desc = "无效的权限"
-	case errors.Is(re, RteInvalidMask):
-		desc = "无效的子网掩码"
-	case errors.Is(re, RteInvalidUsername):
-		desc = "无效的用户名"
-	case errors.Is(re, RteInvalidMark):
-		desc = "无法识别的报文头标记"
-	case errors.Is(re, RteUnexpectedMethod):
-		desc = "意外的消息 ID"
-	case errors.Is(re, RteInvalidParamIndex):
-		desc = "无效的系统参数索引值"
-	case errors.Is(re, RteDecodePacketError):
-		desc = "解包错误"
-	case errors.Is(re, RteEncodePacketError):
-		desc = "编包错误"
-	case errors.Is(re, RteBlacklistFull):
-		desc = "阻止连接段已满"
-	case errors.Is(re, RteBlacklistExist):
-		desc = "连接地址段已经位于黑名单中"
-	case errors.Is(re, RteBlacklistNotFound):
-		desc = "阻止连接段不存在"
-	case errors.Is(re, RteInBlacklist):
-		desc = "连接地址位于黑名单中，被主动拒绝"
-	case errors.Is(re, RteIncreaseFileFailed):
-		desc = "试图增大文件失败"
-	case errors.Is(re, RteRpcInterfaceFailed):
-		desc = "远程过程接口调用失败"
-	case errors.Is(re, RteConnectionFull):
-		desc = "连接已满"
-	case errors.Is(re, RteOneClientConnectionFull):
-		desc = "连接已达到单个客户端允许连接数的最大值"
-	case errors.Is(re, RteServerClutterPoolNotEnough):
-		desc = "网络数据交换空间不足"
-	case errors.Is(re, RteEquationClutterPoolNotEnough):
-		desc = "实时方程式交换空间不足"
-	case errors.Is(re, RteNamedTypeNameLenError):
-		desc = "自定义类型的名称过长"
-	case errors.Is(re, RteNamedTypeLengthNotMatch):
-		desc = "数值长度与自定义类型的定义不符"
-	case errors.Is(re, RteCanNotUpdateSummary):
-		desc = "无法更新卫星数据"
-	case errors.Is(re, RteTooManyArvexFile):
-		desc = "附属文件太多，无法继续创建附属文件"
-	case errors.Is(re, RteNotSupportedFeature):
-		desc = "测试版本，暂时不支持此功能"
-	case errors.Is(re, RteEnsureError):
-		desc = "验证信息失败，详细信息请查看数据库日志"
-	case errors.Is(re, RteOperatorIsCancel):
-		desc = "操作被取消"
-	case errors.Is(re, RteMsgbodyRevError):
-		desc = "报文体接收失败"
-	case errors.Is(re, RteUncompressFailed):
-		desc = "解压缩失败"
-	case errors.Is(re, RteCompressFailed):
-		desc = "压缩失败"
-	case errors.Is(re, RteSubscribeError):
-		desc = "订阅失败，前一个订阅线程尚未退出"
-	case errors.Is(re, RteSubscribeCancelError):
-		desc = "取消订阅失败"
-	case errors.Is(re, RteSubscribeCallbackFailed):
-		desc = "订阅回掉函数中不能调用取消订阅、断开连接"
-	case errors.Is(re, RteSubscribeGreaterMaxCount):
-		desc = "超过单连接可订阅标签点数量"
-	case errors.Is(re, RteKillConnectionFailed):
-		desc = "断开连接失败，无法断开自身连接"
-	case errors.Is(re, RteSubscribeNotMatch):
-		desc = "请求的方法与当前的订阅不匹配"
-	case errors.Is(re, RteNoSubscribe):
-		desc = "连接还未发起订阅，或者标签点还未订阅"
-	case errors.Is(re, RteAlreadySubscribe):
-		desc = "标签点已经被订阅"
-	case errors.Is(re, RteCalcPointUnsupportedWriteData):
-		desc = "计算点不支持写入数据"
-	case errors.Is(re, RteFeatureDeprecated):
-		desc = "不再支持此功能"
-	case errors.Is(re, RteInvalidValue):
-		desc = "无效的数据"
-	case errors.Is(re, RteVerifyVercodeFailed):
-		desc = "验证授权码失败"
-	case errors.Is(re, RteInvalidPageSize):
-		desc = "无效的数据页的大小"
-	case errors.Is(re, RteInvalidPrecision):
-		desc = "无效的时间戳精度"
-	case errors.Is(re, RteInvalidPageVersion):
-		desc = "无效的数据页版本"
-	case errors.Is(re, RtePageIsFull):
-		desc = "数据页已满"
-	case errors.Is(re, RtePageNotLoaded):
-		desc = "还未加载数据页"
-	case errors.Is(re, RtePageAlreadyLoaded):
-		desc = "已经加载了数据页"
-	case errors.Is(re, RtePageTooSmall):
-		desc = "数据页太小，有效空间小于数据长度"
-	case errors.Is(re, RtePageNoEnoughData):
-		desc = "数据页中没有足够的数据"
-	case errors.Is(re, RtePageInsertFailed):
-		desc = "数据页插入数据失败"
-	case errors.Is(re, RtePageNoEnoughSpace):
-		desc = "数据页没有足够的空间"
-	case errors.Is(re, RteModifingMetaData):
-		desc = "正在修改元数据，请稍后再试"
-	case errors.Is(re, RtePageSizeNotMatch):
-		desc = "数据页大小不匹配"
-	case errors.Is(re, RteSyncBegin):
-		desc = "元数据同步错误码起始值"
-	case errors.Is(re, RteSyncInvalidConfig):
-		desc = "元数据同步-无效的配置"
-	case errors.Is(re, RteSyncInvalidVersion):
-		desc = "元数据同步-无效的版本号"
-	case errors.Is(re, RteSyncConfirmExpired):
-		desc = "元数据同步-等待确认信息过期"
-	case errors.Is(re, RteSyncTooManyFwdinfo):
-		desc = "元数据同步-转发信息过多"
-	case errors.Is(re, RteSyncNotMaster):
-		desc = "元数据同步-不是主库"
-	case errors.Is(re, RteSyncSyncing):
-		desc = "元数据同步-正在同步"
-	case errors.Is(re, RteSyncUnsynced):
-		desc = "元数据同步-未同步"
-	case errors.Is(re, RteSyncTablePosConflict):
-		desc = "元数据同步-表位置冲突"
-	case errors.Is(re, RteSyncInvalidPointId):
-		desc = "元数据同步-无效的标签点ID"
-	case errors.Is(re, RteSyncInvalidTableId):
-		desc = "元数据同步-无效的表ID"
-	case errors.Is(re, RteSyncInvalidNamedTypeId):
-		desc = "元数据同步-无效的自定义类型ID"
-	case errors.Is(re, RteSyncRestoring):
-		desc = "元数据同步-正在重建元数据"
-	case errors.Is(re, RteSyncServerIsNotRunning):
-		desc = "元数据同步-网络服务不是运行状态"
-	case errors.Is(re, RteSyncWriteWalFailed):
-		desc = "元数据同步-写WAL失败"
-	case errors.Is(re, RteSyncEnd):
-		desc = "元数据同步错误码结束值"
-	case errors.Is(re, RteNetError):
-		desc = "网络错误的起始值"
-	case errors.Is(re, RteSockWsaeintr):
-		desc = "（阻塞）调用被 WSACancelBlockingCall() 函数取消"
-	case errors.Is(re, RteSockWsaeacces):
-		desc = "请求地址是广播地址，但是相应的 flags 没设置"
-	case errors.Is(re, RteSockWsaefault):
-		desc = "非法内存访问"
-	case errors.Is(re, RteSockWsaemfile):
-		desc = "无多余的描述符可用"
-	case errors.Is(re, RteSockWsaewouldblock):
-		desc = "套接字被标识为非阻塞，但操作将被阻塞"
-	case errors.Is(re, RteSockWsaeinprogress):
-		desc = "一个阻塞的 Windows Sockets 操作正在进行"
-	case errors.Is(re, RteSockWsaealready):
-		desc = "一个非阻塞的 connect() 调用已经在指定的套接字上进行"
-	case errors.Is(re, RteSockWsaenotsock):
-		desc = "描述符不是套接字描述符"
-	case errors.Is(re, RteSockWsaedestaddrreq):
-		desc = "要求（未指定）目的地址"
-	case errors.Is(re, RteSockWsaemsgsize):
-		desc = "套接字为基于消息的，消息太大（大于底层传输支持的最大值）"
-	case errors.Is(re, RteSockWsaeprototype):
-		desc = "对此套接字来说，指定协议是错误的类型"
-	case errors.Is(re, RteSockWsaeprotonosupport):
-		desc = "不支持指定协议"
-	case errors.Is(re, RteSockWsaesocktnosupport):
-		desc = "在此地址族中不支持指定套接字类型"
-	case errors.Is(re, RteSockWsaeopnotsupp):
-		desc = "MSG_OOB 被指定，但是套接字不是流风格的"
-	case errors.Is(re, RteSockWsaeafnosupport):
-		desc = "不支持指定的地址族"
-	case errors.Is(re, RteSockWsaeaddrinuse):
-		desc = "套接字的本地地址已被使用"
-	case errors.Is(re, RteSockWsaeaddrnotavail):
-		desc = "远程地址非法"
-	case errors.Is(re, RteSockWsaenetdown):
-		desc = "Windows Sockets 检测到网络系统已经失效"
-	case errors.Is(re, RteSockWsaenetunreach):
-		desc = "网络无法到达主机"
-	case errors.Is(re, RteSockWsaenetreset):
-		desc = "在操作进行时 keep-alive 活动检测到一个失败，连接被中断"
-	case errors.Is(re, RteSockWsaeconnaborted):
-		desc = "连接因超时或其他失败而中断"
-	case errors.Is(re, RteSockWsaeconnreset):
-		desc = "连接被复位"
-	case errors.Is(re, RteSockWsaenobufs):
-		desc = "无缓冲区空间可用"
-	case errors.Is(re, RteSockWsaeisconn):
-		desc = "连接已建立"
-	case errors.Is(re, RteSockWsaenotconn):
-		desc = "套接字未建立连接"
-	case errors.Is(re, RteSockWsaeshutdown):
-		desc = "套接字已 shutdown，连接已断开"
-	case errors.Is(re, RteSockWsaetimedout):
-		desc = "连接请求超时，未能建立连接"
-	case errors.Is(re, RteSockWsaeconnrefused):
-		desc = "连接被拒绝"
-	case errors.Is(re, RteSockWsaeclose):
-		desc = "连接被关闭"
-	case errors.Is(re, RteSockWsanotinitialised):
-		desc = "Windows Sockets DLL 未初始化"
-	case errors.Is(re, RteCErrnoError):
-		desc = "C语言errno错误的起始值"
-	case errors.Is(re, RteCErrnoEperm):
-		desc = "Operation not permitted"
-	case errors.Is(re, RteCErrnoEnoent):
-		desc = "No such file or directory"
-	case errors.Is(re, RteCErrnoEsrch):
-		desc = "No such process"
-	case errors.Is(re, RteCErrnoEintr):
-		desc = "Interrupted system call"
-	case errors.Is(re, RteCErrnoEio):
-		desc = "I/O error"
-	case errors.Is(re, RteCErrnoEnxio):
-		desc = "No such device or address"
-	case errors.Is(re, RteCErrnoE2big):
-		desc = "Argument list too long"
-	case errors.Is(re, RteCErrnoEnoexec):
-		desc = "Exec format error"
-	case errors.Is(re, RteCErrnoEbadf):
-		desc = "Bad file number"
-	case errors.Is(re, RteCErrnoEchild):
-		desc = "No child processes"
-	case errors.Is(re, RteCErrnoEagain):
-		desc = "Try again"
-	case errors.Is(re, RteCErrnoEnomem):
-		desc = "Out of memory"
-	case errors.Is(re, RteCErrnoEacces):
-		desc = "Permission denied"
-	case errors.Is(re, RteCErrnoEfault):
-		desc = "Bad address"
-	case errors.Is(re, RteCErrnoEnotblk):
-		desc = "Block device required"
-	case errors.Is(re, RteCErrnoEbusy):
-		desc = "Device or resource busy"
-	case errors.Is(re, RteCErrnoEexist):
-		desc = "File exists"
-	case errors.Is(re, RteCErrnoExdev):
-		desc = "Cross-device link"
-	case errors.Is(re, RteCErrnoEnodev):
-		desc = "No such device"
-	case errors.Is(re, RteCErrnoEnotdir):
-		desc = "Not a directory"
-	case errors.Is(re, RteCErrnoEisdir):
-		desc = "Is a directory"
-	case errors.Is(re, RteCErrnoEinval):
-		desc = "Invalid argument"
-	case errors.Is(re, RteCErrnoEnfile):
-		desc = "File table overflow"
-	case errors.Is(re, RteCErrnoEmfile):
-		desc = "Too many open files"
-	case errors.Is(re, RteCErrnoEnotty):
-		desc = "Not a typewriter"
-	case errors.Is(re, RteCErrnoEtxtbsy):
-		desc = "Text file busy"
-	case errors.Is(re, RteCErrnoEfbig):
-		desc = "File too large"
-	case errors.Is(re, RteCErrnoEnospc):
-		desc = "No space left on device"
-	case errors.Is(re, RteCErrnoEspipe):
-		desc = "Illegal seek"
-	case errors.Is(re, RteCErrnoErofs):
-		desc = "Read-only file system"
-	case errors.Is(re, RteCErrnoEmlink):
-		desc = "Too many links"
-	case errors.Is(re, RteCErrnoEpipe):
-		desc = "Broken pipe"
-	case errors.Is(re, RteCErrnoEdom):
-		desc = "Math argument out of domain of func"
-	case errors.Is(re, RteCErrnoErange):
-		desc = "Math result not representable"
-	case errors.Is(re, RteCErrnoEdeadlk):
-		desc = "Resource deadlock would occur"
-	case errors.Is(re, RteCErrnoEnametoolong):
-		desc = "File name too long"
-	case errors.Is(re, RteCErrnoEnolck):
-		desc = "No record locks available"
-	case errors.Is(re, RteCErrnoEnosys):
-		desc = "Function not implemented"
-	case errors.Is(re, RteCErrnoEnotempty):
-		desc = "Directory not empty"
-	case errors.Is(re, RteCErrnoEloop):
-		desc = "Too many symbolic links encountered"
-	case errors.Is(re, RteCErrnoEnomsg):
-		desc = "No message of desired type"
-	case errors.Is(re, RteCErrnoEidrm):
-		desc = "Identifier removed"
-	case errors.Is(re, RteCErrnoEchrng):
-		desc = "Channel number out of range"
-	case errors.Is(re, RteCErrnoEl2nsync):
-		desc = "Level 2 not synchronized"
-	case errors.Is(re, RteCErrnoEl3hlt):
-		desc = "Level 3 halted"
-	case errors.Is(re, RteCErrnoEl3rst):
-		desc = "Level 3 reset"
-	case errors.Is(re, RteCErrnoElnrng):
-		desc = "Link number out of range"
-	case errors.Is(re, RteCErrnoEunatch):
-		desc = "Protocol driver not attached"
-	case errors.Is(re, RteCErrnoEnocsi):
-		desc = "No CSI structure available"
-	case errors.Is(re, RteCErrnoEl2hlt):
-		desc = "Level 2 halted"
-	case errors.Is(re, RteCErrnoEbade):
-		desc = "Invalid exchange"
-	case errors.Is(re, RteCErrnoEbadr):
-		desc = "Invalid request descriptor"
-	case errors.Is(re, RteCErrnoExfull):
-		desc = "Exchange full"
-	case errors.Is(re, RteCErrnoEnoano):
-		desc = "No anode"
-	case errors.Is(re, RteCErrnoEbadrqc):
-		desc = "Invalid request code"
-	case errors.Is(re, RteCErrnoEbadslt):
-		desc = "Invalid slot"
-	case errors.Is(re, RteCErrnoEbfont):
-		desc = "Bad font file format"
-	case errors.Is(re, RteCErrnoEnostr):
-		desc = "Device not a stream"
-	case errors.Is(re, RteCErrnoEnodata):
-		desc = "No data available"
-	case errors.Is(re, RteCErrnoEtime):
-		desc = "Timer expired"
-	case errors.Is(re, RteCErrnoEnosr):
-		desc = "Out of streams resources"
-	case errors.Is(re, RteCErrnoEnonet):
-		desc = "Machine is not on the network"
-	case errors.Is(re, RteCErrnoEnopkg):
-		desc = "Package not installed"
-	case errors.Is(re, RteCErrnoEremote):
-		desc = "Object is remote"
-	case errors.Is(re, RteCErrnoEnolink):
-		desc = "Link has been severed"
-	case errors.Is(re, RteCErrnoEadv):
-		desc = "Advertise error"
-	case errors.Is(re, RteCErrnoEsrmnt):
-		desc = "Srmount error"
-	case errors.Is(re, RteCErrnoEcomm):
-		desc = "Communication error on send"
-	case errors.Is(re, RteCErrnoEproto):
-		desc = "Protocol error"
-	case errors.Is(re, RteCErrnoEmultihop):
-		desc = "Multihop attempted"
-	case errors.Is(re, RteCErrnoEdotdot):
-		desc = "RFS specific error"
-	case errors.Is(re, RteCErrnoEbadmsg):
-		desc = "Not a data message"
-	case errors.Is(re, RteCErrnoEoverflow):
-		desc = "Value too large for defined data type"
-	case errors.Is(re, RteCErrnoEnotuniq):
-		desc = "Name not unique on network"
-	case errors.Is(re, RteCErrnoEbadfd):
-		desc = "File descriptor in bad state"
-	case errors.Is(re, RteCErrnoEremchg):
-		desc = "Remote address changed"
-	case errors.Is(re, RteCErrnoElibacc):
-		desc = "Can not access a needed shared library"
-	case errors.Is(re, RteCErrnoElibbad):
-		desc = "Accessing a corrupted shared library"
-	case errors.Is(re, RteCErrnoElibscn):
-		desc = ".lib section in a.out corrupted"
-	case errors.Is(re, RteCErrnoElibmax):
-		desc = "Attempting to link in too many shared libraries"
-	case errors.Is(re, RteCErrnoElibexec):
-		desc = "Cannot exec a shared library directly"
-	case errors.Is(re, RteCErrnoEilseq):
-		desc = "Illegal byte sequence"
-	case errors.Is(re, RteCErrnoErestart):
-		desc = "Interrupted system call should be restarted"
-	case errors.Is(re, RteCErrnoEstrpipe):
-		desc = "Streams pipe error"
-	case errors.Is(re, RteCErrnoEusers):
-		desc = "Too many users"
-	case errors.Is(re, RteCErrnoEnotsock):
-		desc = "Socket operation on non-socket"
-	case errors.Is(re, RteCErrnoEdestaddrreq):
-		desc = "Destination address required"
-	case errors.Is(re, RteCErrnoEmsgsize):
-		desc = "Message too long"
-	case errors.Is(re, RteCErrnoEprototype):
-		desc = "Protocol wrong type for socket"
-	case errors.Is(re, RteCErrnoEnoprotoopt):
-		desc = "Protocol not available"
-	case errors.Is(re, RteCErrnoEprotonosupport):
-		desc = "Protocol not supported"
-	case errors.Is(re, RteCErrnoEsocktnosupport):
-		desc = "Socket type not supported"
-	case errors.Is(re, RteCErrnoEopnotsupp):
-		desc = "Operation not supported on transport endpoint"
-	case errors.Is(re, RteCErrnoEpfnosupport):
-		desc = "Protocol family not supported"
-	case errors.Is(re, RteCErrnoEafnosupport):
-		desc = "Address family not supported by protocol"
-	case errors.Is(re, RteCErrnoEaddrinuse):
-		desc = "Address already in use"
-	case errors.Is(re, RteCErrnoEaddrnotavail):
-		desc = "Cannot assign requested address"
-	case errors.Is(re, RteCErrnoEnetdown):
-		desc = "Network is down"
-	case errors.Is(re, RteCErrnoEnetunreach):
-		desc = "Network is unreachable"
-	case errors.Is(re, RteCErrnoEnetreset):
-		desc = "Network dropped connection because of reset"
-	case errors.Is(re, RteCErrnoEconnaborted):
-		desc = "Software caused connection abort"
-	case errors.Is(re, RteCErrnoEconnreset):
-		desc = "Connection reset by peer"
-	case errors.Is(re, RteCErrnoEnobufs):
-		desc = "No buffer space available"
-	case errors.Is(re, RteCErrnoEisconn):
-		desc = "Transport endpoint is already connected"
-	case errors.Is(re, RteCErrnoEnotconn):
-		desc = "Transport endpoint is not connected"
-	case errors.Is(re, RteCErrnoEshutdown):
-		desc = "Cannot send after transport endpoint shutdown"
-	case errors.Is(re, RteCErrnoEtoomanyrefs):
-		desc = "Too many references: cannot splice"
-	case errors.Is(re, RteCErrnoEtimedout):
-		desc = "Connection timed out"
-	case errors.Is(re, RteCErrnoEconnrefused):
-		desc = "Connection refused"
-	case errors.Is(re, RteCErrnoEhostdown):
-		desc = "Host is down"
-	case errors.Is(re, RteCErrnoEhostunreach):
-		desc = "No route to host"
-	case errors.Is(re, RteCErrnoEalready):
-		desc = "Operation already in progress"
-	case errors.Is(re, RteCErrnoEinprogress):
-		desc = "Operation now in progress"
-	case errors.Is(re, RteCErrnoEstale):
-		desc = "Stale file handle"
-	case errors.Is(re, RteCErrnoEuclean):
-		desc = "Structure needs cleaning"
-	case errors.Is(re, RteCErrnoEnotnam):
-		desc = "Not a XENIX named type file"
-	case errors.Is(re, RteCErrnoEnavail):
-		desc = "No XENIX semaphores available"
-	case errors.Is(re, RteCErrnoEisnam):
-		desc = "Is a named type file"
-	case errors.Is(re, RteCErrnoEremoteio):
-		desc = "Remote I/O error"
-	case errors.Is(re, RteCErrnoEdquot):
-		desc = "Quota exceeded"
-	case errors.Is(re, RteCErrnoEnomedium):
-		desc = "No medium found"
-	case errors.Is(re, RteCErrnoEmediumtype):
-		desc = "Wrong medium type"
-	case errors.Is(re, RteCErrnoEcanceled):
-		desc = "Operation Canceled"
-	case errors.Is(re, RteCErrnoEnokey):
-		desc = "Required key not available"
-	case errors.Is(re, RteCErrnoEkeyexpired):
-		desc = "Key has expired"
-	case errors.Is(re, RteCErrnoEkeyrevoked):
-		desc = "Key has been revoked"
-	case errors.Is(re, RteCErrnoEkeyrejected):
-		desc = "Key was rejected by service"
-	case errors.Is(re, RteCErrnoEownerdead):
-		desc = "Owner died"
-	case errors.Is(re, RteCErrnoEnotrecoverable):
-		desc = "State not recoverable"
-	case errors.Is(re, RteCErrnoErfkill):
-		desc = "Operation not possible due to RF-kill"
-	case errors.Is(re, RteCErrnoEhwpoison):
-		desc = "Memory page has hardware error"
-	case errors.Is(re, RteIpcError):
-		desc = "ipc error begin"
-	case errors.Is(re, RteIpcErrorEnd):
-		desc = "ipc error end"
-	default:
-		desc = "未知错误"
-	}
-	return desc
-}
-
-// GoError 将RtdbError转换成 error
-func (re RtdbError) GoError() error {
-	if re.IsOk() {
-		return nil
-	} else {
-		return re
-	}
-}
-
-func RteIsOk(rte RtdbError) bool {
-	return errors.Is(rte, RteOk)
-}
-
-const (
-	// RteUnknownError  未知错误
-	RteUnknownError = RtdbError(C.RtE_UNKNOWN_ERROR)
-
-	// RteOk  操作成功完成
-	RteOk = RtdbError(C.RtE_OK)
-
-	// RteWindowsError  Windows操作系统错误的起始值
-	RteWindowsError = RtdbError(C.RtE_WINDOWS_ERROR)
-
-	// RteWindowsErrorMax  Windows操作系统错误的结束值
-	RteWindowsErrorMax = RtdbError(C.RtE_WINDOWS_ERROR_MAX)
-
-	// RteInvalidOpenmode  无效的文件打开方式
-	RteInvalidOpenmode = RtdbError(C.RtE_INVALID_OPENMODE)
-
-	// RteOpenfileFailed  打开文件失败
-	RteOpenfileFailed = RtdbError(C.RtE_OPENFILE_FAILED)
-
-	// RteMovetoendFailed  移动文件指针到文件尾失败
-	RteMovetoendFailed = RtdbError(C.RtE_MOVETOEND_FAILED)
-
-	// RteDifferReadbytes  读取内容长度与要求不符
-	RteDifferReadbytes = RtdbError(C.RtE_DIFFER_READBYTES)
-
-	// RteGetfileposFailed  获取当前文件指针失败
-	RteGetfileposFailed = RtdbError(C.RtE_GETFILEPOS_FAILED)
-
-	// RteFlushfileFailed  刷新文件缓冲区失败
-	RteFlushfileFailed = RtdbError(C.RtE_FLUSHFILE_FAILED)
-
-	// RteSetsizeFailed  设置文件大小失败
-	RteSetsizeFailed = RtdbError(C.RtE_SETSIZE_FAILED)
-
-	// RteFileNotClosed  试图用未关闭的文件对象创建或打开文件
-	RteFileNotClosed = RtdbError(C.RtE_FILE_NOT_CLOSED)
-
-	// RteFileUnknown  创建或打开文件时必须指定文件名
-	RteFileUnknown = RtdbError(C.RtE_FILE_UNKNOWN)
-
-	// RteInvalidHeader  数据文件头信息错误
-	RteInvalidHeader = RtdbError(C.RtE_INVALID_HEADER)
-
-	// RteDisabledFile  数据文件无效，试图访问无效数据文件
-	RteDisabledFile = RtdbError(C.RtE_DISABLED_FILE)
-
-	// RteFileNotOpened  试图访问尚未打开的数据文件
-	RteFileNotOpened = RtdbError(C.RtE_FILE_NOT_OPENED)
-
-	// RtePointNotFound  要求访问的标签点不存在或无效
-	RtePointNotFound = RtdbError(C.RtE_POINT_NOT_FOUND)
-
-	// RteReadyblockNotFound  数据文件中找不到从指定数据块以后的可用的空块
-	RteReadyblockNotFound = RtdbError(C.RtE_READYBLOCK_NOT_FOUND)
-
-	// RteFileIsIncult  文件未被使用过
-	RteFileIsIncult = RtdbError(C.RtE_FILE_IS_INCULT)
-
-	// RteFileIsFull  数据文件已满
-	RteFileIsFull = RtdbError(C.RtE_FILE_IS_FULL)
-
-	// RteFileexIsFull  数据文件扩展区已满，无法继续装载数据
-	RteFileexIsFull = RtdbError(C.RtE_FILEEX_IS_FULL)
-
-	// RteInvalidDataType  无效的数据类型
-	RteInvalidDataType = RtdbError(C.RtE_INVALID_DATA_TYPE)
-
-	// RteDatablockNotFound  找不到符合时间条件的数据块
-	RteDatablockNotFound = RtdbError(C.RtE_DATABLOCK_NOT_FOUND)
-
-	// RteDataBetweenBlock  数据时间位于找到的块和下一个数据块之间
-	RteDataBetweenBlock = RtdbError(C.RtE_DATA_BETWEEN_BLOCK)
-
-	// RteCantModifyExistValue  不允许修改已存在的数据
-	RteCantModifyExistValue = RtdbError(C.RtE_CANT_MODIFY_EXIST_VALUE)
-
-	// RteWrongdataInBlock  块中有错误数据导致数据块头信息不符
-	RteWrongdataInBlock = RtdbError(C.RtE_WRONGDATA_IN_BLOCK)
-
-	// RteDatatimeNotIn  数据文件中没有该标签点指定时间的数据
-	RteDatatimeNotIn = RtdbError(C.RtE_DATATIME_NOT_IN)
-
-	// RteNullArchivePath  操作的数据文件路径为空
-	RteNullArchivePath = RtdbError(C.RtE_NULL_ARCHIVE_PATH)
-
-	// RteRegArchivePath  数据文件已被注册
-	RteRegArchivePath = RtdbError(C.RtE_REG_ARCHIVE_PATH)
-
-	// RteUnregArchivePath  未注册的数据文件
-	RteUnregArchivePath = RtdbError(C.RtE_UNREG_ARCHIVE_PATH)
-
-	// RteFileInexistence  指定的文件不存在
-	RteFileInexistence = RtdbError(C.RtE_FILE_INEXISTENCE)
-
-	// RteDataTypeNotMatch  数据类型不匹配
-	RteDataTypeNotMatch = RtdbError(C.RtE_DATA_TYPE_NOT_MATCH)
-
-	// RteFileIsReadonly  不允许修改只读数据文件中的数据
-	RteFileIsReadonly = RtdbError(C.RtE_FILE_IS_READONLY)
-
-	// RteTomanyArchiveFile  过多的数据文件
-	RteTomanyArchiveFile = RtdbError(C.RtE_TOMANY_ARCHIVE_FILE)
-
-	// RteNoPointsList  缺少标签点列表
-	RteNoPointsList = RtdbError(C.RtE_NO_POINTS_LIST)
-
-	// RteNoActivedArchive  缺少活动文档
-	RteNoActivedArchive = RtdbError(C.RtE_NO_ACTIVED_ARCHIVE)
-
-	// RteNoArchiveFile  缺少数据文档
-	RteNoArchiveFile = RtdbError(C.RtE_NO_ARCHIVE_FILE)
-
-	// RteNeedActivedArchive  只能在活动文档上执行该操作
-	RteNeedActivedArchive = RtdbError(C.RtE_NEED_ACTIVED_ARCHIVE)
-
-	// RteInvalidTimestamp  无效的时间戳
-	RteInvalidTimestamp = RtdbError(C.RtE_INVALID_TIMESTAMP)
-
-	// RteNeedMoreWritable  非只读文档个数太少
-	RteNeedMoreWritable = RtdbError(C.RtE_NEED_MORE_WRITABLE)
-
-	// RteNoArchiveForPut  找不到合适的追加历史数据的文档
-	RteNoArchiveForPut = RtdbError(C.RtE_NO_ARCHIVE_FOR_PUT)
-
-	// RteInvalidValueMode  无效的取值模式
-	RteInvalidValueMode = RtdbError(C.RtE_INVALID_VALUE_MODE)
-
-	// RteDataNotFound  找不到需要的数据
-	RteDataNotFound = RtdbError(C.RtE_DATA_NOT_FOUND)
-
-	// RteInvalidParameter  无效的参数
-	RteInvalidParameter = RtdbError(C.RtE_INVALID_PARAMETER)
-
-	// RteReduplicateTag  重复的标签点名
-	RteReduplicateTag = RtdbError(C.RtE_REDUPLICATE_TAG)
-
-	// RteReduplicateTabname  重复的表名称
-	RteReduplicateTabname = RtdbError(C.RtE_REDUPLICATE_TABNAME)
-
-	// RteReduplicateTabid  重复的表ID
-	RteReduplicateTabid = RtdbError(C.RtE_REDUPLICATE_TABID)
-
-	// RteTableNotFound  指定的表不存在
-	RteTableNotFound = RtdbError(C.RtE_TABLE_NOT_FOUND)
-
-	// RteUnsupportedClassof  不支持的标签点类别
-	RteUnsupportedClassof = RtdbError(C.RtE_UNSUPPORTED_CLASSOF)
-
-	// RteWrongOrDuplicTag  错误的或重复的标签点名
-	RteWrongOrDuplicTag = RtdbError(C.RtE_WRONG_OR_DUPLIC_TAG)
-
-	// RteReduplicatePt  重复的标签点标识
-	RteReduplicatePt = RtdbError(C.RtE_REDUPLICATE_PT)
-
-	// RtePointLicenseFull  标签点数超出了许可证规定的最大数目
-	RtePointLicenseFull = RtdbError(C.RtE_POINT_LICENSE_FULL)
-
-	// RteTableLicenseFull  标签点表个数超出了许可证规定的最大数目
-	RteTableLicenseFull = RtdbError(C.RtE_TABLE_LICENSE_FULL)
-
-	// RteWrongOrDuplicTabname  错误的或重复的表名称
-	RteWrongOrDuplicTabname = RtdbError(C.RtE_WRONG_OR_DUPLIC_TABNAME)
-
-	// RteInvalidFileFormat  无效的数据文件格式
-	RteInvalidFileFormat = RtdbError(C.RtE_INVALID_FILE_FORMAT)
-
-	// RteWrongTabname  错误的表名称
-	RteWrongTabname = RtdbError(C.RtE_WRONG_TABNAME)
-
-	// RteWrongTag  错误的标签点名
-	RteWrongTag = RtdbError(C.RtE_WRONG_TAG)
-
-	// RteNotInScope  数值超出了应属的范围
-	RteNotInScope = RtdbError(C.RtE_NOT_IN_SCOPE)
-
-	// RteCantLoadBase  不能同标签点信息服务取得联系
-	RteCantLoadBase = RtdbError(C.RtE_CANT_LOAD_BASE)
-
-	// RteCantLoadSnapshot  不能同快照数据服务取得联系
-	RteCantLoadSnapshot = RtdbError(C.RtE_CANT_LOAD_SNAPSHOT)
-
-	// RteCantLoadHistory  不能同历史数据服务取得联系
-	RteCantLoadHistory = RtdbError(C.RtE_CANT_LOAD_HISTORY)
-
-	// RteCantLoadEquation  不能同实施方程式服务取得联系
-	RteCantLoadEquation = RtdbError(C.RtE_CANT_LOAD_EQUATION)
-
-	// RteArraySizeNotMatch  数组尺寸不匹配
-	RteArraySizeNotMatch = RtdbError(C.RtE_ARRAY_SIZE_NOT_MATCH)
-
-	// RteInvalidHostAddress  无效的主机地址
-	RteInvalidHostAddress = RtdbError(C.RtE_INVALID_HOST_ADDRESS)
-
-	// RteConnectFalse  连接已断开
-	RteConnectFalse = RtdbError(C.RtE_CONNECT_FALSE)
-
-	// RteToomanyBytesRecved  接收到的数据长度超出了指定字节长度
-	RteToomanyBytesRecved = RtdbError(C.RtE_TOOMANY_BYTES_RECVED)
-
-	// RteReqidRespidNotMatch  应答与请求的ID不一致
-	RteReqidRespidNotMatch = RtdbError(C.RtE_REQID_RESPID_NOT_MATCH)
-
-	// RteLessBytesRecved  接收到的数据长度小于指定字节长度
-	RteLessBytesRecved = RtdbError(C.RtE_LESS_BYTES_RECVED)
-
-	// RteUnsupportedCalcMode  不支持的计算模式
-	RteUnsupportedCalcMode = RtdbError(C.RtE_UNSUPPORTED_CALC_MODE)
-
-	// RteUnsupportedDataType  不支持的标签点类型
-	RteUnsupportedDataType = RtdbError(C.RtE_UNSUPPORTED_DATA_TYPE)
-
-	// RteInvalidExpression  无效的表达式
-	RteInvalidExpression = RtdbError(C.RtE_INVALID_EXPRESSION)
-
-	// RteIncondDataNotFound  找不到符合条件的数据
-	RteIncondDataNotFound = RtdbError(C.RtE_INCOND_DATA_NOT_FOUND)
-
-	// RteValidDataNotFound  找不到需要的有效数据
-	RteValidDataNotFound = RtdbError(C.RtE_VALID_DATA_NOT_FOUND)
-
-	// RteValueOrStateIsNan  数据或状态不正常，为NAN
-	RteValueOrStateIsNan = RtdbError(C.RtE_VALUE_OR_STATE_IS_NAN)
-
-	// RteCreateMutexFailed  创建互斥对象失败
-	RteCreateMutexFailed = RtdbError(C.RtE_CREATE_MUTEX_FAILED)
-
-	// RteTlsallocfail  处理TLS时调用系统函数LocalAlloc()失败，可能因为内存不足导致
-	RteTlsallocfail = RtdbError(C.RtE_TLSALLOCFAIL)
-
-	// RteToManyPoints  正在调用的API函数不支持过多的标签点数量，请参考函数声明和开发手册
-	RteToManyPoints = RtdbError(C.RtE_TO_MANY_POINTS)
-
-	// RteLicInfoError  获取授权许可协议信息时发生错误
-	RteLicInfoError = RtdbError(C.RtE_LIC_INFO_ERROR)
-
-	// RteArchiveBufferFull  标签点的历史数据补写缓冲区已满，请稍后再补
-	RteArchiveBufferFull = RtdbError(C.RtE_ARCHIVE_BUFFER_FULL)
-
-	// RteUserNotExist  用户不存在
-	RteUserNotExist = RtdbError(C.RtE_USER_NOT_EXIST)
-
-	// RteUserIsLocked  帐户被锁定,需要管理员为您解锁
-	RteUserIsLocked = RtdbError(C.RtE_USER_IS_LOCKED)
-
-	// RteWrongPassword  错误的口令
-	RteWrongPassword = RtdbError(C.RtE_WRONG_PASSWORD)
-
-	// RteAccessIsDenied  访问被拒绝，请确定是否具有足够的权限
-	RteAccessIsDenied = RtdbError(C.RtE_ACCESS_IS_DENIED)
-
-	// RteHaveNotLogin  您尚未登录，请先登录
-	RteHaveNotLogin = RtdbError(C.RtE_HAVE_NOT_LOGIN)
-
-	// RteUserIsDeleted  帐户已被删除
-	RteUserIsDeleted = RtdbError(C.RtE_USER_IS_DELETED)
-
-	// RteUserAlreadyExist  帐户已存在
-	RteUserAlreadyExist = RtdbError(C.RtE_USER_ALREADY_EXIST)
-
-	// RteWrongCreateTabname  创建删除表失败
-	RteWrongCreateTabname = RtdbError(C.RtE_WRONG_CREATE_TABNAME)
-
-	// RteWrongFieldValue  标签点属性值有错误
-	RteWrongFieldValue = RtdbError(C.RtE_WRONG_FIELD_VALUE)
-
-	// RteInvalidTagId  无效的标签点ID
-	RteInvalidTagId = RtdbError(C.RtE_INVALID_TAG_ID)
-
-	// RteCheckNamedTypeNameError  无效的自定义类型名称或字段名称
-	RteCheckNamedTypeNameError = RtdbError(C.RtE_CHECK_NAMED_TYPE_NAME_ERROR)
-
-	// RteCantLoadDispatch  不能同转发服务器取得联系
-	RteCantLoadDispatch = RtdbError(C.RtE_CANT_LOAD_DISPATCH)
-
-	// RteConnectTimeOut  连接已超时，需要重新登录
-	RteConnectTimeOut = RtdbError(C.RtE_CONNECT_TIME_OUT)
-
-	// RteWrongLogin4  账户信息验证失败，还有4次尝试机会
-	RteWrongLogin4 = RtdbError(C.RtE_WRONG_LOGIN_4)
-
-	// RteWrongLogin3  账户信息验证失败，还有3次尝试机会
-	RteWrongLogin3 = RtdbError(C.RtE_WRONG_LOGIN_3)
-
-	// RteWrongLogin2  账户信息验证失败，还有2次尝试机会
-	RteWrongLogin2 = RtdbError(C.RtE_WRONG_LOGIN_2)
-
-	// RteWrongLogin1  账户信息验证失败，还有1次尝试机会
-	RteWrongLogin1 = RtdbError(C.RtE_WRONG_LOGIN_1)
-
-	// RteWrongDesc  错误的表描述
-	RteWrongDesc = RtdbError(C.RtE_WRONG_DESC)
-
-	// RteWrongUnit  错误的工程单位
-	RteWrongUnit = RtdbError(C.RtE_WRONG_UNIT)
-
-	// RteWrongChanger  错误的最后一次被修改的用户名
-	RteWrongChanger = RtdbError(C.RtE_WRONG_CHANGER)
-
-	// RteWrongCreator  错误的标签点创建者用户名
-	RteWrongCreator = RtdbError(C.RtE_WRONG_CREATOR)
-
-	// RteWrongFull  错误的标签点全名
-	RteWrongFull = RtdbError(C.RtE_WRONG_FULL)
-
-	// RteWrongSource  错误的数据源
-	RteWrongSource = RtdbError(C.RtE_WRONG_SOURCE)
-
-	// RteWrongInstrument  错误的设备标签
-	RteWrongInstrument = RtdbError(C.RtE_WRONG_INSTRUMENT)
-
-	// RteWrongUser  错误的创建者
-	RteWrongUser = RtdbError(C.RtE_WRONG_USER)
-
-	// RteWrongEquation  错误的实时方程式
-	RteWrongEquation = RtdbError(C.RtE_WRONG_EQUATION)
-
-	// RteWrongTypeName  错误的自定义类型名称
-	RteWrongTypeName = RtdbError(C.RtE_WRONG_TYPE_NAME)
-
-	// RteWrongEncode  编码转换时出错
-	RteWrongEncode = RtdbError(C.RtE_WRONG_ENCODE)
-
-	// RteWrongOthermask  错误的搜索类型转换mask值
-	RteWrongOthermask = RtdbError(C.RtE_WRONG_OTHERMASK)
-
-	// RteWrongType  错误的搜索类型
-	RteWrongType = RtdbError(C.RtE_WRONG_TYPE)
-
-	// RtePointHardwareLimited  由于硬件资源限制，创建或恢复标签点失败
-	RtePointHardwareLimited = RtdbError(C.RtE_POINT_HARDWARE_LIMITED)
-
-	// RteWaitingRecoverData  正在等待恢复数据完成，请稍后尝试连接
-	RteWaitingRecoverData = RtdbError(C.RtE_WAITING_RECOVER_DATA)
-
-	// RteReplicationLicMismatch  双活数据库授权不一致
-	RteReplicationLicMismatch = RtdbError(C.RtE_REPLICATION_LIC_MISMATCH)
-
-	// RteReadConfigFailed  读取配置文件失败
-	RteReadConfigFailed = RtdbError(C.RtE_READ_CONFIG_FAILED)
-
-	// RteUpdateConfigFailed  更新配置文件失败
-	RteUpdateConfigFailed = RtdbError(C.RtE_UPDATE_CONFIG_FAILED)
-
-	// RteFilterTooLong  filter超过最大长度
-	RteFilterTooLong = RtdbError(C.RtE_FILTER_TOO_LONG)
-
-	// RteGetArchiveNameFail  获取存档文件名失败
-	RteGetArchiveNameFail = RtdbError(C.RtE_GET_ARCHIVE_NAME_FAIL)
-
-	// RteAutoMoveFailed  自动移动存档文件失败
-	RteAutoMoveFailed = RtdbError(C.RtE_AUTO_MOVE_FAILED)
-
-	// RteTimeGreaterThanHotTailArc  创建/入列非闪盘存档文件的时间大于闪盘最早的存档文件
-	RteTimeGreaterThanHotTailArc = RtdbError(C.RtE_TIME_GREATER_THAN_HOT_TAIL_ARC)
-
-	// RteTimeLessThanColdBeginArc  创建/入列闪盘的存档文件时间小于非闪盘最新的存档文件
-	RteTimeLessThanColdBeginArc = RtdbError(C.RtE_TIME_LESS_THAN_COLD_BEGIN_ARC)
-
-	// RteRemoveEarliestArcFailed  删除最早的存档文件失败（存档文件列表为空）
-	RteRemoveEarliestArcFailed = RtdbError(C.RtE_REMOVE_EARLIEST_ARC_FAILED)
-
-	// RteNoFreeTableId  没有空闲的表ID可用
-	RteNoFreeTableId = RtdbError(C.RtE_NO_FREE_TABLE_ID)
-
-	// RteNoFreeTagPosition  没有空闲的标签点位址可用
-	RteNoFreeTagPosition = RtdbError(C.RtE_NO_FREE_TAG_POSITION)
-
-	// RteNoFreeScanTagPosition  没有空闲的采集标签点位址可用
-	RteNoFreeScanTagPosition = RtdbError(C.RtE_NO_FREE_SCAN_TAG_POSITION)
-
-	// RteNoFreeCalcTagPosition  没有空闲的计算标签点位址可用
-	RteNoFreeCalcTagPosition = RtdbError(C.RtE_NO_FREE_CALC_TAG_POSITION)
-
-	// RteInvalidIpcPosition  无效的位址被用于进程间内存共享
-	RteInvalidIpcPosition = RtdbError(C.RtE_INVALID_IPC_POSITION)
-
-	// RteWrongIpcPosition  错误的位址被用于进程间内存共享
-	RteWrongIpcPosition = RtdbError(C.RtE_WRONG_IPC_POSITION)
-
-	// RteIpcAccessException  共享内存访问异常
-	RteIpcAccessException = RtdbError(C.RtE_IPC_ACCESS_EXCEPTION)
-
-	// RteArvPageNotReady  没有空闲的历史数据缓存页
-	RteArvPageNotReady = RtdbError(C.RtE_ARV_PAGE_NOT_READY)
-
-	// RteArvexPageNotReady  没有空闲的补历史数据缓存页
-	RteArvexPageNotReady = RtdbError(C.RtE_ARVEX_PAGE_NOT_READY)
-
-	// RteInvalidPositionFromId  依据标签点ID获得的位址无效
-	RteInvalidPositionFromId = RtdbError(C.RtE_INVALID_POSITION_FROM_ID)
-
-	// RteNoActivePageAllocator  新的活动存档无法加载页分配器
-	RteNoActivePageAllocator = RtdbError(C.RtE_NO_ACTIVE_PAGE_ALLOCATOR)
-
-	// RteMapIsNotReady  内存映射尚未就绪
-	RteMapIsNotReady = RtdbError(C.RtE_MAP_IS_NOT_READY)
-
-	// RteFileMapFailed  文件映射到内存失败
-	RteFileMapFailed = RtdbError(C.RtE_FILE_MAP_FAILED)
-
-	// RteTimeRangeNotAllowed  不允许使用的时间区间
-	RteTimeRangeNotAllowed = RtdbError(C.RtE_TIME_RANGE_NOT_ALLOWED)
-
-	// RteNoDataForSummary  找不到用于统计的源数据
-	RteNoDataForSummary = RtdbError(C.RtE_NO_DATA_FOR_SUMMARY)
-
-	// RteCantOperateOnActived  不允许操作活动存档文件
-	RteCantOperateOnActived = RtdbError(C.RtE_CANT_OPERATE_ON_ACTIVED)
-
-	// RteScanPointLicenseFull  采集标签点数超出了许可证规定的最大数目
-	RteScanPointLicenseFull = RtdbError(C.RtE_SCAN_POINT_LICENSE_FULL)
-
-	// RteCalcPointLicenseFull  计算标签点数超出了许可证规定的最大数目
-	RteCalcPointLicenseFull = RtdbError(C.RtE_CALC_POINT_LICENSE_FULL)
-
-	// RteHistorianIsShuttingdown  历史数据服务正在停止
-	RteHistorianIsShuttingdown = RtdbError(C.RtE_HISTORIAN_IS_SHUTTINGDOWN)
-
-	// RteSnapshotIsShuttingdown  实时数据服务正在停止
-	RteSnapshotIsShuttingdown = RtdbError(C.RtE_SNAPSHOT_IS_SHUTTINGDOWN)
-
-	// RteEquationIsShuttingdown  实时方程式服务正在停止
-	RteEquationIsShuttingdown = RtdbError(C.RtE_EQUATION_IS_SHUTTINGDOWN)
-
-	// RteBaseIsShuttingdown  标签点信息服务正在停止
-	RteBaseIsShuttingdown = RtdbError(C.RtE_BASE_IS_SHUTTINGDOWN)
-
-	// RteServerIsShuttingdown  网络通信服务正在停止
-	RteServerIsShuttingdown = RtdbError(C.RtE_SERVER_IS_SHUTTINGDOWN)
-
-	// RteOutOfMemory  内存不足
-	RteOutOfMemory = RtdbError(C.RtE_OUT_OF_MEMORY)
-
-	// RteInvalidPage  无效的数据页，有可能是未加载
-	RteInvalidPage = RtdbError(C.RtE_INVALID_PAGE)
-
-	// RtePageIsEmpty  遇到空的数据页
-	RtePageIsEmpty = RtdbError(C.RtE_PAGE_IS_EMPTY)
-
-	// RteStrOrBlobTooLong  字符串或BLOB数据长度超出限制
-	RteStrOrBlobTooLong = RtdbError(C.RtE_STR_OR_BLOB_TOO_LONG)
-
-	// RteCreatedOrOverdue  尚未产生任何快照或快照已过期
-	RteCreatedOrOverdue = RtdbError(C.RtE_CREATED_OR_OVERDUE)
-
-	// RteArchiveInfoNotMatching  历史存档文件头部信息与实际不符
-	RteArchiveInfoNotMatching = RtdbError(C.RtE_ARCHIVE_INFO_NOT_MATCHING)
-
-	// RteTimeRangeOverlapping  指定的时间范围与已有存档文件重叠
-	RteTimeRangeOverlapping = RtdbError(C.RtE_TIME_RANGE_OVERLAPPING)
-
-	// RteCannotShiftToActived  找不到合适的存档文件用于切换成活动存档
-	RteCannotShiftToActived = RtdbError(C.RtE_CANNOT_SHIFT_TO_ACTIVED)
-
-	// RteIndexNotReady  历史存档文件对应的索引尚未就绪
-	RteIndexNotReady = RtdbError(C.RtE_INDEX_NOT_READY)
-
-	// RteIndexNodeNotMatch  索引节点与指向的内容不符
-	RteIndexNodeNotMatch = RtdbError(C.RtE_INDEX_NODE_NOT_MATCH)
-
-	// RteCanNotCreateIndex  无法创建索引节点
-	RteCanNotCreateIndex = RtdbError(C.RtE_CAN_NOT_CREATE_INDEX)
-
-	// RteCanNotRemoveIndex  无法删除索引节点
-	RteCanNotRemoveIndex = RtdbError(C.RtE_CAN_NOT_REMOVE_INDEX)
-
-	// RteInvalidFilterExpress  无效的过滤器表达式
-	RteInvalidFilterExpress = RtdbError(C.RtE_INVALID_FILTER_EXPRESS)
-
-	// RteMoreVarInFilterExp  过滤器表达式中的包含了过多的变量
-	RteMoreVarInFilterExp = RtdbError(C.RtE_MORE_VAR_IN_FILTER_EXP)
-
-	// RteInvalidArvPageAllocate  刚分配的历史数据缓存页ID与标签点事件对象ID不匹配
-	RteInvalidArvPageAllocate = RtdbError(C.RtE_INVALID_ARV_PAGE_ALLOCATE)
-
-	// RteInvalidArvexPageAllocate  刚分配的补历史数据缓存页ID与标签点事件对象ID不匹配
-	RteInvalidArvexPageAllocate = RtdbError(C.RtE_INVALID_ARVEX_PAGE_ALLOCATE)
-
-	// RteBigJobIsNotDone  正在执行重要的任务，请稍后再试
-	RteBigJobIsNotDone = RtdbError(C.RtE_BIG_JOB_IS_NOT_DONE)
-
-	// RteDatabaseNeedRestart  数据库需要重新启动以便应用新的参数
-	RteDatabaseNeedRestart = RtdbError(C.RtE_DATABASE_NEED_RESTART)
-
-	// RteInvalidTimeFormat  无效的时间格式字符串
-	RteInvalidTimeFormat = RtdbError(C.RtE_INVALID_TIME_FORMAT)
-
-	// RteDataPlaybackDone  历史数据回放过程已结束
-	RteDataPlaybackDone = RtdbError(C.RtE_DATA_PLAYBACK_DONE)
-
-	// RteBadEquation  错误的方程式
-	RteBadEquation = RtdbError(C.RtE_BAD_EQUATION)
-
-	// RteNotEnoughSapce  剩余磁盘空间不足
-	RteNotEnoughSapce = RtdbError(C.RtE_NOT_ENOUGH_SAPCE)
-
-	// RteActivedArchiveExist  已存在活动存档
-	RteActivedArchiveExist = RtdbError(C.RtE_ACTIVED_ARCHIVE_EXIST)
-
-	// RteArchiveHaveExFiles  指定的存档文件具有附属文件
-	RteArchiveHaveExFiles = RtdbError(C.RtE_ARCHIVE_HAVE_EX_FILES)
-
-	// RteArchiveIsNotLatest  指定的存档文件不是最晚的
-	RteArchiveIsNotLatest = RtdbError(C.RtE_ARCHIVE_IS_NOT_LATEST)
-
-	// RteDbSystemNotRunning  数据库管理系统尚未完全启动
-	RteDbSystemNotRunning = RtdbError(C.RtE_DB_SYSTEM_NOT_RUNNING)
-
-	// RteArchiveIsAltered  存档文件内容发生变更
-	RteArchiveIsAltered = RtdbError(C.RtE_ARCHIVE_IS_ALTERED)
-
-	// RteArchiveIsTooSmall  不允许创建太小的存档文件和附属文件
-	RteArchiveIsTooSmall = RtdbError(C.RtE_ARCHIVE_IS_TOO_SMALL)
-
-	// RteInvalidIndexNode  遇到无效的索引节点
-	RteInvalidIndexNode = RtdbError(C.RtE_INVALID_INDEX_NODE)
-
-	// RteModifySnapshotNotAllowed  不允许删除或修改快照事件
-	RteModifySnapshotNotAllowed = RtdbError(C.RtE_MODIFY_SNAPSHOT_NOT_ALLOWED)
-
-	// RteSearchInterrupted  因目标正被创建、删除或恢复，搜索被迫中断，请稍后再试
-	RteSearchInterrupted = RtdbError(C.RtE_SEARCH_INTERRUPTED)
-
-	// RteRecycleShutdown  回收站已失效，相关操作无法完成
-	RteRecycleShutdown = RtdbError(C.RtE_RECYCLE_SHUTDOWN)
-
-	// RteNeedToReindex  索引文件缺失，或部分索引节点被损坏，需要重建索引
-	RteNeedToReindex = RtdbError(C.RtE_NEED_TO_REINDEX)
-
-	// RteInvalidQuality  无效的质量码
-	RteInvalidQuality = RtdbError(C.RtE_INVALID_QUALITY)
-
-	// RteEquationNotReady  实时方程式服务正在解析，请稍后再试
-	RteEquationNotReady = RtdbError(C.RtE_EQUATION_NOT_READY)
-
-	// RteArchivesLicenseFull  存档文件数已达到许可证规定的最大数目
-	RteArchivesLicenseFull = RtdbError(C.RtE_ARCHIVES_LICENSE_FULL)
-
-	// RteRecycledLicenseFull  标签点回收站容量超出了许可证规定的最大数目
-	RteRecycledLicenseFull = RtdbError(C.RtE_RECYCLED_LICENSE_FULL)
-
-	// RteStrBlobLicenseFull  字符串或BLOB类型标签点数量超出了许可证规定的最大数目
-	RteStrBlobLicenseFull = RtdbError(C.RtE_STR_BLOB_LICENSE_FULL)
-
-	// RteNotSupportWhenDebug  此功能被某个调试选项禁用
-	RteNotSupportWhenDebug = RtdbError(C.RtE_NOT_SUPPORT_WHEN_DEBUG)
-
-	// RteMappingAlreadyLoaded  映射已经被加载，不允许重复加载
-	RteMappingAlreadyLoaded = RtdbError(C.RtE_MAPPING_ALREADY_LOADED)
-
-	// RteArchiveIsModified  存档文件被修改，动作被中断
-	RteArchiveIsModified = RtdbError(C.RtE_ARCHIVE_IS_MODIFIED)
-
-	// RteActiveArchiveFull  活动文档已满
-	RteActiveArchiveFull = RtdbError(C.RtE_ACTIVE_ARCHIVE_FULL)
-
-	// RteSplitNoData  拆分数据页后所给时间区间内没有数据
-	RteSplitNoData = RtdbError(C.RtE_SPLIT_NO_DATA)
-
-	// RteInvalidDirectory  指定的路径不存在或无效
-	RteInvalidDirectory = RtdbError(C.RtE_INVALID_DIRECTORY)
-
-	// RteArchiveLackExFiles  指定存档文件的部分附属文件缺失
-	RteArchiveLackExFiles = RtdbError(C.RtE_ARCHIVE_LACK_EX_FILES)
-
-	// RteBigJobIsCanceled  后台任务被取消
-	RteBigJobIsCanceled = RtdbError(C.RtE_BIG_JOB_IS_CANCELED)
-
-	// RteArvexBlobPageNotReady  没有空闲的blob补历史数据缓存页
-	RteArvexBlobPageNotReady = RtdbError(C.RtE_ARVEX_BLOB_PAGE_NOT_READY)
-
-	// RteInvalidArvexBlobPageAllocate  刚分配的blob补历史数据缓存页ID与标签点事件对象ID不匹配
-	RteInvalidArvexBlobPageAllocate = RtdbError(C.RtE_INVALID_ARVEX_BLOB_PAGE_ALLOCATE)
-
-	// RteTimestampEqualtoSnapshot  写入的时间与快照时间相同
-	RteTimestampEqualtoSnapshot = RtdbError(C.RtE_TIMESTAMP_EQUALTO_SNAPSHOT)
-
-	// RteTimestampEarlierThanSnapshot  写入的时间比当前快照时间较早
-	RteTimestampEarlierThanSnapshot = RtdbError(C.RtE_TIMESTAMP_EARLIER_THAN_SNAPSHOT)
-
-	// RteTimestampGreaterThanAllow  写入的时间超过了允许的时间
-	RteTimestampGreaterThanAllow = RtdbError(C.RtE_TIMESTAMP_GREATER_THAN_ALLOW)
-
-	// RteTimestampBegintimeGreagerThanEndtime  开始时间大于结束时间
-	RteTimestampBegintimeGreagerThanEndtime = RtdbError(C.RtE_TIMESTAMP_BEGINTIME_GREAGER_THAN_ENDTIME)
-
-	// RteTimestampBegintimeEqualtoEndtime  开始时间等于结束时间
-	RteTimestampBegintimeEqualtoEndtime = RtdbError(C.RtE_TIMESTAMP_BEGINTIME_EQUALTO_ENDTIME)
-
-	// RteInvalidCount  无效的count
-	RteInvalidCount = RtdbError(C.RtE_INVALID_COUNT)
-
-	// RteInvalidCapacity  无效的capacity
-	RteInvalidCapacity = RtdbError(C.RtE_INVALID_CAPACITY)
-
-	// RteInvalidPath  无效的路径
-	RteInvalidPath = RtdbError(C.RtE_INVALID_PATH)
-
-	// RteInvalidPosition  无效的position
-	RteInvalidPosition = RtdbError(C.RtE_INVALID_POSITION)
-
-	// RteInvalidArvPage  无效的rtdb_arv_page<RTDB_T>,未加载，或者size小于等于0
-	RteInvalidArvPage = RtdbError(C.RtE_INVALID_ARV_PAGE)
-
-	// RteInvalidHisinfoItemState  无效的历史信息条目
-	RteInvalidHisinfoItemState = RtdbError(C.RtE_INVALID_HISINFO_ITEM_STATE)
-
-	// RteInvalidInterval  无效的间隔
-	RteInvalidInterval = RtdbError(C.RtE_INVALID_INTERVAL)
-
-	// RteInvalidLength  无效的字符串长度
-	RteInvalidLength = RtdbError(C.RtE_INVALID_LENGTH)
-
-	// RteInvalidSerachMode  无效的search mode
-	RteInvalidSerachMode = RtdbError(C.RtE_INVALID_SERACH_MODE)
-
-	// RteInvalidFileId  无效的存档文件ID
-	RteInvalidFileId = RtdbError(C.RtE_INVALID_FILE_ID)
-
-	// RteInvalidMillisecond  无效的毫秒值/纳秒值
-	RteInvalidMillisecond = RtdbError(C.RtE_INVALID_MILLISECOND)
-
-	// RteInvalidDeadline  无效的截止时间
-	RteInvalidDeadline = RtdbError(C.RtE_INVALID_DEADLINE)
-
-	// RteInvalidJobname  无效的Job名称
-	RteInvalidJobname = RtdbError(C.RtE_INVALID_JOBNAME)
-
-	// RteInvalidJobstate  无效的Job状态
-	RteInvalidJobstate = RtdbError(C.RtE_INVALID_JOBSTATE)
-
-	// RteInvalidProcessRate  无效的Process速率
-	RteInvalidProcessRate = RtdbError(C.RtE_INVALID_PROCESS_RATE)
-
-	// RteInvalidTableId  无效的表ID
-	RteInvalidTableId = RtdbError(C.RtE_INVALID_TABLE_ID)
-
-	// RteInvalidDataSource  无效的数据源格式
-	RteInvalidDataSource = RtdbError(C.RtE_INVALID_DATA_SOURCE)
-
-	// RteInvalidTriggerMethod  无效的触发方式
-	RteInvalidTriggerMethod = RtdbError(C.RtE_INVALID_TRIGGER_METHOD)
-
-	// RteInvalidCalcTimeRes  无效的计算结果时间戳参考方式
-	RteInvalidCalcTimeRes = RtdbError(C.RtE_INVALID_CALC_TIME_RES)
-
-	// RteInvalidTriggerTimer  无效的定时触发触发周期,不能小于1秒
-	RteInvalidTriggerTimer = RtdbError(C.RtE_INVALID_TRIGGER_TIMER)
-
-	// RteInvalidLimit  工程上限不得低于工程下限
-	RteInvalidLimit = RtdbError(C.RtE_INVALID_LIMIT)
-
-	// RteInvalidCompTime  无效的压缩间隔，最长压缩间隔不得小于最短压缩间隔
-	RteInvalidCompTime = RtdbError(C.RtE_INVALID_COMP_TIME)
-
-	// RteInvalidExtTime  无效的例外间隔，最长例外间隔不得小于最短例外间隔
-	RteInvalidExtTime = RtdbError(C.RtE_INVALID_EXT_TIME)
-
-	// RteInvalidDigits  无效的数值位数，数值位数超出了范围,-20~10
-	RteInvalidDigits = RtdbError(C.RtE_INVALID_DIGITS)
-
-	// RteInvalidFullTagName  标签点全名有误，找不到表名与点名的分隔符“.”
-	RteInvalidFullTagName = RtdbError(C.RtE_INVALID_FULL_TAG_NAME)
-
-	// RteInvalidTableDesc  表描述信息过有误
-	RteInvalidTableDesc = RtdbError(C.RtE_INVALID_TABLE_DESC)
-
-	// RteInvalidUserCount  非法的用户个数，小于0
-	RteInvalidUserCount = RtdbError(C.RtE_INVALID_USER_COUNT)
-
-	// RteInvalidBlacklistCount  非法的黑名单个数，小于0
-	RteInvalidBlacklistCount = RtdbError(C.RtE_INVALID_BLACKLIST_COUNT)
-
-	// RteInvalidAuthorizationCount  非法的信任连接个数，小于0
-	RteInvalidAuthorizationCount = RtdbError(C.RtE_INVALID_AUTHORIZATION_COUNT)
-
-	// RteInvalidBigJobType  非法的大任务类型
-	RteInvalidBigJobType = RtdbError(C.RtE_INVALID_BIG_JOB_TYPE)
-
-	// RteInvalidSysParam  无效的系统参数，调用db_set_db_info2时，参数有误
-	RteInvalidSysParam = RtdbError(C.RtE_INVALID_SYS_PARAM)
-
-	// RteInvalidFileParam  无效的文件路径参数，调用db_set_db_info1时，参数有误
-	RteInvalidFileParam = RtdbError(C.RtE_INVALID_FILE_PARAM)
-
-	// RteInvalidFileSize  文件长度有误  < 1 baserecycle.dat、scanrecycle.dat、calcrecycle.dat、snaprecycle.dat
-	RteInvalidFileSize = RtdbError(C.RtE_INVALID_FILE_SIZE)
-
-	// RteInvalidTagType  标签点类型有误，合法（ rtdb_bool ~ rtdb_blob)，但是不属于相应函数的处理范围
-	RteInvalidTagType = RtdbError(C.RtE_INVALID_TAG_TYPE)
-
-	// RteInvalidRecyStructPos  回收站对象最后一个结构体位置非法
-	RteInvalidRecyStructPos = RtdbError(C.RtE_INVALID_RECY_STRUCT_POS)
-
-	// RteInvalidRecycleFile  scanrecycle.dat、baserecycle.dat  snaprecycle.dat文件不存在或失效
-	RteInvalidRecycleFile = RtdbError(C.RtE_INVALID_RECYCLE_FILE)
-
-	// RteInvalidSuffixName  无效的文件后缀名
-	RteInvalidSuffixName = RtdbError(C.RtE_INVALID_SUFFIX_NAME)
-
-	// RteInsertStringFalse  向数据页中插入字符串数据失败
-	RteInsertStringFalse = RtdbError(C.RtE_INSERT_STRING_FALSE)
-
-	// RteBlobPageFull  blob数据页已满
-	RteBlobPageFull = RtdbError(C.RtE_BLOB_PAGE_FULL)
-
-	// RteInvalidStringIteratorPointer  无效的str/blob迭代器指针
-	RteInvalidStringIteratorPointer = RtdbError(C.RtE_INVALID_STRING_ITERATOR_POINTER)
-
-	// RteNotEqualTagid  目标页标签点ID 与 当前ID不一致
-	RteNotEqualTagid = RtdbError(C.RtE_NOT_EQUAL_TAGID)
-
-	// RtePathsOfArchiveAndAutobackAreSame  存档文件路径与自动备份路径相同
-	RtePathsOfArchiveAndAutobackAreSame = RtdbError(C.RtE_PATHS_OF_ARCHIVE_AND_AUTOBACK_ARE_SAME)
-
-	// RteXmlParseFail  xml文件解析失败
-	RteXmlParseFail = RtdbError(C.RtE_XML_PARSE_FAIL)
-
-	// RteXmlElementsAbsent  xml清单文件文件内容缺失
-	RteXmlElementsAbsent = RtdbError(C.RtE_XML_ELEMENTS_ABSENT)
-
-	// RteXmlMismatchOnName  xml清单文件与本产品不匹配
-	RteXmlMismatchOnName = RtdbError(C.RtE_XML_MISMATCH_ON_NAME)
-
-	// RteXmlMismatchOnVersion  xml清单文件版本不匹配
-	RteXmlMismatchOnVersion = RtdbError(C.RtE_XML_MISMATCH_ON_VERSION)
-
-	// RteXmlMismatchOnDatasize  xml清单文件数据尺寸不匹配
-	RteXmlMismatchOnDatasize = RtdbError(C.RtE_XML_MISMATCH_ON_DATASIZE)
-
-	// RteXmlMismatchOnFileinfo  xml清单文件中数据文件信息不匹配
-	RteXmlMismatchOnFileinfo = RtdbError(C.RtE_XML_MISMATCH_ON_FILEINFO)
-
-	// RteXmlMismatchOnWindow  xml清单文件中所有数据文件的窗口大小必须一致
-	RteXmlMismatchOnWindow = RtdbError(C.RtE_XML_MISMATCH_ON_WINDOW)
-
-	// RteXmlMismatchOnTypecount  xml清单文件自定义数据类型的数量不匹配
-	RteXmlMismatchOnTypecount = RtdbError(C.RtE_XML_MISMATCH_ON_TYPECOUNT)
-
-	// RteXmlMismatchOnFieldcount  xml清单文件自定义数据类型的field不匹配
-	RteXmlMismatchOnFieldcount = RtdbError(C.RtE_XML_MISMATCH_ON_FIELDCOUNT)
-
-	// RteXmlFieldMustInType  xml清单文件中field标签必须嵌套在type标签中
-	RteXmlFieldMustInType = RtdbError(C.RtE_XML_FIELD_MUST_IN_TYPE)
-
-	// RteInvalidNamedTypeFieldCount  无效的FIELD数量
-	RteInvalidNamedTypeFieldCount = RtdbError(C.RtE_INVALID_NAMED_TYPE_FIELD_COUNT)
-
-	// RteReduplicateFieldName  重复的FIELD名字
-	RteReduplicateFieldName = RtdbError(C.RtE_REDUPLICATE_FIELD_NAME)
-
-	// RteInvalidNamedTypeName  无效的自定义数据类型的名字
-	RteInvalidNamedTypeName = RtdbError(C.RtE_INVALID_NAMED_TYPE_NAME)
-
-	// RteReduplicateNamedType  已经存在的自定义数据类型
-	RteReduplicateNamedType = RtdbError(C.RtE_REDUPLICATE_NAMED_TYPE)
-
-	// RteNotExistNamedType  不存在的自定义数据类型
-	RteNotExistNamedType = RtdbError(C.RtE_NOT_EXIST_NAMED_TYPE)
-
-	// RteUpdateXmlFailed  更新XML清单文件失败
-	RteUpdateXmlFailed = RtdbError(C.RtE_UPDATE_XML_FAILED)
-
-	// RteNamedTypeUsedWithPoint  有些标签点正在使用此自定义数据类型，不允许删除
-	RteNamedTypeUsedWithPoint = RtdbError(C.RtE_NAMED_TYPE_USED_WITH_POINT)
-
-	// RteNamedTypeUnsupportCalcPoint  自定义数据类型不支持计算点
-	RteNamedTypeUnsupportCalcPoint = RtdbError(C.RtE_NAMED_TYPE_UNSUPPORT_CALC_POINT)
-
-	// RteXmlMismatchOnMaxId  自定义数据类型的最大ID与实际的自定义数据类型数量不一致
-	RteXmlMismatchOnMaxId = RtdbError(C.RtE_XML_MISMATCH_ON_MAX_ID)
-
-	// RteNamedTypeLicenseFull  自定义数据类型的数量超出了许可证规定的最大数目
-	RteNamedTypeLicenseFull = RtdbError(C.RtE_NAMED_TYPE_LICENSE_FULL)
-
-	// RteNoFreeNamedTypeId  没有空闲的自定义数据类型的ID
-	RteNoFreeNamedTypeId = RtdbError(C.RtE_NO_FREE_NAMED_TYPE_ID)
-
-	// RteInvalidNamedTypeId  无效的自定义数据类型ID
-	RteInvalidNamedTypeId = RtdbError(C.RtE_INVALID_NAMED_TYPE_ID)
-
-	// RteInvalidNamedTypeFieldName  无效的自定义数据类型的字段名字
-	RteInvalidNamedTypeFieldName = RtdbError(C.RtE_INVALID_NAMED_TYPE_FIELD_NAME)
-
-	// RteNamedTypeUsedWithRecyclePoint  有些回收站中的标签点正在使用此自定义数据类型，不允许删除
-	RteNamedTypeUsedWithRecyclePoint = RtdbError(C.RtE_NAMED_TYPE_USED_WITH_RECYCLE_POINT)
-
-	// RteNamedTypeNameTooLong  自定义类型的名字超过了允许的最大长度
-	RteNamedTypeNameTooLong = RtdbError(C.RtE_NAMED_TYPE_NAME_TOO_LONG)
-
-	// RteNamedTypeFieldNameTooLong  自定义类型的field 名字超过了允许的最大长度
-	RteNamedTypeFieldNameTooLong = RtdbError(C.RtE_NAMED_TYPE_FIELD_NAME_TOO_LONG)
-
-	// RteInvalidNamedTypeFieldLength  无效的自定义数据类型的字段长度
-	RteInvalidNamedTypeFieldLength = RtdbError(C.RtE_INVALID_NAMED_TYPE_FIELD_LENGTH)
-
-	// RteInvalidSearchMask  无效的高级搜索的标签点属性mask
-	RteInvalidSearchMask = RtdbError(C.RtE_INVALID_SEARCH_MASK)
-
-	// RteRecycledSpaceNotEnough  标签点回收站空闲空间不足
-	RteRecycledSpaceNotEnough = RtdbError(C.RtE_RECYCLED_SPACE_NOT_ENOUGH)
-
-	// RteDynamicLoadedMemoryNotInit  动态加载的内存未初始化
-	RteDynamicLoadedMemoryNotInit = RtdbError(C.RtE_DYNAMIC_LOADED_MEMORY_NOT_INIT)
-
-	// RteForbidDynamicAllocType  内存库禁止动态分配类型
-	RteForbidDynamicAllocType = RtdbError(C.RtE_FORBID_DYNAMIC_ALLOC_TYPE)
-
-	// RteMemorydbIndexCreateFailed  内存库索引创建失败
-	RteMemorydbIndexCreateFailed = RtdbError(C.RtE_MEMORYDB_INDEX_CREATE_FAILED)
-
-	// RteWgMakeQueryReturnNull  whitedb make_query_rc返回null
-	RteWgMakeQueryReturnNull = RtdbError(C.RtE_WG_MAKE_QUERY_RETURN_NULL)
-
-	// RteThtreadPoolCreatedFailed  内存库创建线程池失败
-	RteThtreadPoolCreatedFailed = RtdbError(C.RtE_THTREAD_POOL_CREATED_FAILED)
-
-	// RteMemorydbRemoveRecordFailed  内存库删除记录失败
-	RteMemorydbRemoveRecordFailed = RtdbError(C.RtE_MEMORYDB_REMOVE_RECORD_FAILED)
-
-	// RteMemorydbConfigLoadFailed  内存库配置文件加载失败
-	RteMemorydbConfigLoadFailed = RtdbError(C.RtE_MEMORYDB_CONFIG_LOAD_FAILED)
-
-	// RteMemorydbProhibitDynamicAlloType  内存库禁止动态分配类型
-	RteMemorydbProhibitDynamicAlloType = RtdbError(C.RtE_MEMORYDB_PROHIBIT_DYNAMIC_ALLO_TYPE)
-
-	// RteMemorydbDynamicAllocTypeFailed  内存库动态分配类型失败
-	RteMemorydbDynamicAllocTypeFailed = RtdbError(C.RtE_MEMORYDB_DYNAMIC_ALLOC_TYPE_FAILED)
-
-	// RteMemorydbStorageFileNameParseFailed  内存库优先级文件名解析失败
-	RteMemorydbStorageFileNameParseFailed = RtdbError(C.RtE_MEMORYDB_STORAGE_FILE_NAME_PARSE_FAILED)
-
-	// RteMemorydbTtreeIndexDamage  内存库T树索引损坏
-	RteMemorydbTtreeIndexDamage = RtdbError(C.RtE_MEMORYDB_TTREE_INDEX_DAMAGE)
-
-	// RteMemorydbConfigFailed  内存库配置文件错误
-	RteMemorydbConfigFailed = RtdbError(C.RtE_MEMORYDB_CONFIG_FAILED)
-
-	// RteMemorydbValueCountNotMatch  内存库记录的值个数不匹配。
-	RteMemorydbValueCountNotMatch = RtdbError(C.RtE_MEMORYDB_VALUE_COUNT_NOT_MATCH)
-
-	// RteMemorydbFieldTypeNotMatch  内存库的字段类型不匹配
-	RteMemorydbFieldTypeNotMatch = RtdbError(C.RtE_MEMORYDB_FIELD_TYPE_NOT_MATCH)
-
-	// RteMemorydbMemoryAllocFailed  内存库内存分配失败
-	RteMemorydbMemoryAllocFailed = RtdbError(C.RtE_MEMORYDB_MEMORY_ALLOC_FAILED)
-
-	// RteMemorydbMethodParamErr  内存库方法参数错误
-	RteMemorydbMethodParamErr = RtdbError(C.RtE_MEMORYDB_METHOD_PARAM_ERR)
-
-	// RteMemorydbQueryResultAllocFailed  内存库查询结果缓存分配失败
-	RteMemorydbQueryResultAllocFailed = RtdbError(C.RtE_MEMORYDB_QUERY_RESULT_ALLOC_FAILED)
-
-	// RteFilePathLength  指定的文件路径长度错误
-	RteFilePathLength = RtdbError(C.RtE_FILE_PATH_LENGTH)
-
-	// RteMemorydbFileVersionMatch  内存库文件版本不匹配
-	RteMemorydbFileVersionMatch = RtdbError(C.RtE_MEMORYDB_FILE_VERSION_MATCH)
-
-	// RteMemorydbFileCrcError  内存库文件CRC错误
-	RteMemorydbFileCrcError = RtdbError(C.RtE_MEMORYDB_FILE_CRC_ERROR)
-
-	// RteMemorydbFileFlagMatch  内存库文件标志错误
-	RteMemorydbFileFlagMatch = RtdbError(C.RtE_MEMORYDB_FILE_FLAG_MATCH)
-
-	// RteMemorydbInexistence  存储库不存在
-	RteMemorydbInexistence = RtdbError(C.RtE_MEMORYDB_INEXISTENCE)
-
-	// RteMemorydbLoadFailed  存储库加载失败
-	RteMemorydbLoadFailed = RtdbError(C.RtE_MEMORYDB_LOAD_FAILED)
-
-	// RteNoDataInInterval  指定的查询区间内没有数据。
-	RteNoDataInInterval = RtdbError(C.RtE_NO_DATA_IN_INTERVAL)
-
-	// RteCantLoadMemorydb  不能与内存服务取得联系
-	RteCantLoadMemorydb = RtdbError(C.RtE_CANT_LOAD_MEMORYDB)
-
-	// RteQueryInWhitedb  查询内存库过程中出现了错误，这是whitedb内部错误
-	RteQueryInWhitedb = RtdbError(C.RtE_QUERY_IN_WHITEDB)
-
-	// RteNoDatabaseMemorydb  没有找到指定数据类型所对应的分库
-	RteNoDatabaseMemorydb = RtdbError(C.RtE_NO_DATABASE_MEMORYDB)
-
-	// RteRecordNotGet  从whitedb中获取记录失败
-	RteRecordNotGet = RtdbError(C.RtE_RECORD_NOT_GET)
-
-	// RteMemoryAllocErr  内存库用于接收快照的缓冲区分配失败
-	RteMemoryAllocErr = RtdbError(C.RtE_MEMORY_ALLOC_ERR)
-
-	// RteEventCreateFailed  用于内存库接收缓冲区的事件创建失败
-	RteEventCreateFailed = RtdbError(C.RtE_EVENT_CREATE_FAILED)
-
-	// RteGetPointFailed  获取标签点失败
-	RteGetPointFailed = RtdbError(C.RtE_GET_POINT_FAILED)
-
-	// RteMemoryInitFailed  内存库初始化失败
-	RteMemoryInitFailed = RtdbError(C.RtE_MEMORY_INIT_FAILED)
-
-	// RteDatatypeNotMatch  数据类型不匹配
-	RteDatatypeNotMatch = RtdbError(C.RtE_DATATYPE_NOT_MATCH)
-
-	// RteGetFieldErr  在whitedb获取记录的字段时出现了错误
-	RteGetFieldErr = RtdbError(C.RtE_GET_FIELD_ERR)
-
-	// RteMemorydbInternalErr  whitedb内部未知错误
-	RteMemorydbInternalErr = RtdbError(C.RtE_MEMORYDB_INTERNAL_ERR)
-
-	// RteMemorydbRecordCreatedFailed  内存库创建记录失败
-	RteMemorydbRecordCreatedFailed = RtdbError(C.RtE_MEMORYDB_RECORD_CREATED_FAILED)
-
-	// RteParseNormalTypeSnapshotErr  解析普通数据类型的快照失败
-	RteParseNormalTypeSnapshotErr = RtdbError(C.RtE_PARSE_NORMAL_TYPE_SNAPSHOT_ERR)
-
-	// RteParseNamedTypeSnapshotErr  解析自定义数据类型的快照失败
-	RteParseNamedTypeSnapshotErr = RtdbError(C.RtE_PARSE_NAMED_TYPE_SNAPSHOT_ERR)
-
-	// RteStringBlobTypeUnsupportCalcPoint  string、blob类型不支持计算点
-	RteStringBlobTypeUnsupportCalcPoint = RtdbError(C.RtE_STRING_BLOB_TYPE_UNSUPPORT_CALC_POINT)
-
-	// RteCoorTypeUnsupportCalcPoint  坐标类型不支持计算点
-	RteCoorTypeUnsupportCalcPoint = RtdbError(C.RtE_COOR_TYPE_UNSUPPORT_CALC_POINT)
-
-	// RteIncludeHisData  记录是历史数据，可能是无效过期的脏数据
-	RteIncludeHisData = RtdbError(C.RtE_INCLUDE_HIS_DATA)
-
-	// RteThreadCreateErr  线程创建失败
-	RteThreadCreateErr = RtdbError(C.RtE_THREAD_CREATE_ERR)
-
-	// RteXmlCrcError  xml文件crc校验失败
-	RteXmlCrcError = RtdbError(C.RtE_XML_CRC_ERROR)
-
-	// RteOversizeIntervals  intervals >
-	RteOversizeIntervals = RtdbError(C.RtE_OVERSIZE_INTERVALS)
-
-	// RteDatetimesMustAscendingOrder  时间必须按升序排序
-	RteDatetimesMustAscendingOrder = RtdbError(C.RtE_DATETIMES_MUST_ASCENDING_ORDER)
-
-	// RteCantLoadPerf  不能同性能计数服务取得联系
-	RteCantLoadPerf = RtdbError(C.RtE_CANT_LOAD_PERF)
-
-	// RtePerfTagNotFound  性能计数点不存在
-	RtePerfTagNotFound = RtdbError(C.RtE_PERF_TAG_NOT_FOUND)
-
-	// RteWaitDataEmpty  数据为空
-	RteWaitDataEmpty = RtdbError(C.RtE_WAIT_DATA_EMPTY)
-
-	// RteWaitDataFull  数据满了
-	RteWaitDataFull = RtdbError(C.RtE_WAIT_DATA_FULL)
-
-	// RteDataTypeCountLess  数据类型数量最小值
-	RteDataTypeCountLess = RtdbError(C.RtE_DATA_TYPE_COUNT_LESS)
-
-	// RteMemorydbCreateFailed  内存库创建失败
-	RteMemorydbCreateFailed = RtdbError(C.RtE_MEMORYDB_CREATE_FAILED)
-
-	// RteMemorydbFieldEncodeFailed  内存库字段编码失败
-	RteMemorydbFieldEncodeFailed = RtdbError(C.RtE_MEMORYDB_FIELD_ENCODE_FAILED)
-
-	// RteRecordCreateFailed  内存库记录创建失败
-	RteRecordCreateFailed = RtdbError(C.RtE_RECORD_CREATE_FAILED)
-
-	// RteRemoveRecordErr  内存库记录删除失败
-	RteRemoveRecordErr = RtdbError(C.RtE_REMOVE_RECORD_ERR)
-
-	// RteMemorydbFileOpenField  内存库打开文件失败
-	RteMemorydbFileOpenField = RtdbError(C.RtE_MEMORYDB_FILE_OPEN_FIELD)
-
-	// RteMemorydbFileWriteFailed  内存库写入文件失败
-	RteMemorydbFileWriteFailed = RtdbError(C.RtE_MEMORYDB_FILE_WRITE_FAILED)
-
-	// RteFilterWtihFloatAndEqual  含有浮点数不等式中不能有
-	RteFilterWtihFloatAndEqual = RtdbError(C.RtE_FILTER_WTIH_FLOAT_AND_EQUAL)
-
-	// RteDispatchPluginNotExsit  转发服务器插件不存在
-	RteDispatchPluginNotExsit = RtdbError(C.RtE_DISPATCH_PLUGIN_NOT_EXSIT)
-
-	// RteDispatchPluginFileNotExsit  转发服务器插件DLL文件不存在
-	RteDispatchPluginFileNotExsit = RtdbError(C.RtE_DISPATCH_PLUGIN_FILE_NOT_EXSIT)
-
-	// RteDispatchPluginAlreadyExsit  转发服务器插件已存在
-	RteDispatchPluginAlreadyExsit = RtdbError(C.RtE_DISPATCH_PLUGIN_ALREADY_EXSIT)
-
-	// RteDispatchRegisterPluginFailure  插件注册失败
-	RteDispatchRegisterPluginFailure = RtdbError(C.RtE_DISPATCH_REGISTER_PLUGIN_FAILURE)
-
-	// RteDispatchStartPluginFailure  启动插件失败
-	RteDispatchStartPluginFailure = RtdbError(C.RtE_DISPATCH_START_PLUGIN_FAILURE)
-
-	// RteDispatchStopPluginFailure  停止插件失败
-	RteDispatchStopPluginFailure = RtdbError(C.RtE_DISPATCH_STOP_PLUGIN_FAILURE)
-
-	// RteDispatchSetPluginEnableStatusFailure  设置插件状态失败
-	RteDispatchSetPluginEnableStatusFailure = RtdbError(C.RtE_DISPATCH_SET_PLUGIN_ENABLE_STATUS_FAILURE)
-
-	// RteDispatchGetPluginCountFailure  获取插件个数信息失败
-	RteDispatchGetPluginCountFailure = RtdbError(C.RtE_DISPATCH_GET_PLUGIN_COUNT_FAILURE)
-
-	// RteDispatchConfigfileNotExist  转发服务配置文件不存在
-	RteDispatchConfigfileNotExist = RtdbError(C.RtE_DISPATCH_CONFIGFILE_NOT_EXIST)
-
-	// RteDispatchConfigDataParseErr  转发服务配置数据解析错误
-	RteDispatchConfigDataParseErr = RtdbError(C.RtE_DISPATCH_CONFIG_DATA_PARSE_ERR)
-
-	// RteDispatchPluginAlreadyRunning  转发服务器插件已经运行
-	RteDispatchPluginAlreadyRunning = RtdbError(C.RtE_DISPATCH_PLUGIN_ALREADY_RUNNING)
-
-	// RteDispatchPluginCannotRun  转发服务器插件禁止运行
-	RteDispatchPluginCannotRun = RtdbError(C.RtE_DISPATCH_PLUGIN_CANNOT_RUN)
-
-	// RteDispatchPluginContainerUnrun  转发服务器插件容器未运行
-	RteDispatchPluginContainerUnrun = RtdbError(C.RtE_DISPATCH_PLUGIN_CONTAINER_UNRUN)
-
-	// RteDispatchPluginInterfaceErr  转发服务器插件接口未实现
-	RteDispatchPluginInterfaceErr = RtdbError(C.RtE_DISPATCH_PLUGIN_INTERFACE_ERR)
-
-	// RteDispatchPluginSaveConfigErr  转发服务器保存配置文件出错
-	RteDispatchPluginSaveConfigErr = RtdbError(C.RtE_DISPATCH_PLUGIN_SAVE_CONFIG_ERR)
-
-	// RteDispatchPluginStartErr  转发服务器插件启动时失败
-	RteDispatchPluginStartErr = RtdbError(C.RtE_DISPATCH_PLUGIN_START_ERR)
-
-	// RteDispatchPluginStopErr  转发服务器插件停止时失败
-	RteDispatchPluginStopErr = RtdbError(C.RtE_DISPATCH_PLUGIN_STOP_ERR)
-
-	// RteDispatchParseDataPageErr  不支持的数据页类型
-	RteDispatchParseDataPageErr = RtdbError(C.RtE_DISPATCH_PARSE_DATA_PAGE_ERR)
-
-	// RteDispatchNotRun  转发服务未启用
-	RteDispatchNotRun = RtdbError(C.RtE_DISPATCH_NOT_RUN)
-
-	// RteBigJobIsCanceledBecauseArcRoll  因存档文件滚动，后台任务被取消
-	RteBigJobIsCanceledBecauseArcRoll = RtdbError(C.RtE_BIG_JOB_IS_CANCELED_BECAUSE_ARC_ROLL)
-
-	// RtePerfForbiddenOperation  禁止对性能表的操作
-	RtePerfForbiddenOperation = RtdbError(C.RtE_PERF_FORBIDDEN_OPERATION)
-
-	// RteReduplicateTagInDestTable  目标表中存在同名的标签点（用于标签点移动）
-	RteReduplicateTagInDestTable = RtdbError(C.RtE_REDUPLICATE_TAG_IN_DEST_TABLE)
-
-	// RteProtocolnotimpl  用户请求的报文未实现
-	RteProtocolnotimpl = RtdbError(C.RtE_PROTOCOLNOTIMPL)
-
-	// RteCrcerror  报文CRC校验错误
-	RteCrcerror = RtdbError(C.RtE_CRCERROR)
-
-	// RteWrongUserpw  验证用户名密码失败
-	RteWrongUserpw = RtdbError(C.RtE_WRONG_USERPW)
-
-	// RteChangeUserpw  修改用户名密码失败
-	RteChangeUserpw = RtdbError(C.RtE_CHANGE_USERPW)
-
-	// RteInvalidHandle  无效的句柄
-	RteInvalidHandle = RtdbError(C.RtE_INVALID_HANDLE)
-
-	// RteInvalidSocketHandle  无效的套接字句柄
-	RteInvalidSocketHandle = RtdbError(C.RtE_INVALID_SOCKET_HANDLE)
-
-	// RteFalse  操作未成功完成，具体原因查看小错误码。
-	RteFalse = RtdbError(C.RtE_FALSE)
-
-	// RteScanPointNotFound  要求访问的采集标签点不存在或无效
-	RteScanPointNotFound = RtdbError(C.RtE_SCAN_POINT_NOT_FOUND)
-
-	// RteCalcPointNotFound  要求访问的计算标签点不存在或无效
-	RteCalcPointNotFound = RtdbError(C.RtE_CALC_POINT_NOT_FOUND)
-
-	// RteReduplicateId  重复的标签点标识
-	RteReduplicateId = RtdbError(C.RtE_REDUPLICATE_ID)
-
-	// RteHandleSubscribed  句柄已经被订阅
-	RteHandleSubscribed = RtdbError(C.RtE_HANDLE_SUBSCRIBED)
-
-	// RteOtherSdkDoing  另一个API正在执行
-	RteOtherSdkDoing = RtdbError(C.RtE_OTHER_SDK_DOING)
-
-	// RteBatchEnd  分段数据返回结束
-	RteBatchEnd = RtdbError(C.RtE_BATCH_END)
-
-	// RteAuthNotFound  信任连接段不存在
-	RteAuthNotFound = RtdbError(C.RtE_AUTH_NOT_FOUND)
-
-	// RteAuthExist  连接地址段已经位于信任列表中
-	RteAuthExist = RtdbError(C.RtE_AUTH_EXIST)
-
-	// RteAuthFull  信任连接段已满
-	RteAuthFull = RtdbError(C.RtE_AUTH_FULL)
-
-	// RteUserFull  用户已满
-	RteUserFull = RtdbError(C.RtE_USER_FULL)
-
-	// RteVersionUnmatch  报文或数据版本不匹配
-	RteVersionUnmatch = RtdbError(C.RtE_VERSION_UNMATCH)
-
-	// RteInvalidPriv  无效的权限
-	RteInvalidPriv = RtdbError(C.RtE_INVALID_PRIV)
-
-	// RteInvalidMask  无效的子网掩码
-	RteInvalidMask = RtdbError(C.RtE_INVALID_MASK)
-
-	// RteInvalidUsername  无效的用户名
-	RteInvalidUsername = RtdbError(C.RtE_INVALID_USERNAME)
-
-	// RteInvalidMark  无法识别的报文头标记
-	RteInvalidMark = RtdbError(C.RtE_INVALID_MARK)
-
-	// RteUnexpectedMethod  意外的消息 ID
-	RteUnexpectedMethod = RtdbError(C.RtE_UNEXPECTED_METHOD)
-
-	// RteInvalidParamIndex  无效的系统参数索引值
-	RteInvalidParamIndex = RtdbError(C.RtE_INVALID_PARAM_INDEX)
-
-	// RteDecodePacketError  解包错误
-	RteDecodePacketError = RtdbError(C.RtE_DECODE_PACKET_ERROR)
-
-	// RteEncodePacketError  编包错误
-	RteEncodePacketError = RtdbError(C.RtE_ENCODE_PACKET_ERROR)
-
-	// RteBlacklistFull  阻止连接段已满
-	RteBlacklistFull = RtdbError(C.RtE_BLACKLIST_FULL)
-
-	// RteBlacklistExist  连接地址段已经位于黑名单中
-	RteBlacklistExist = RtdbError(C.RtE_BLACKLIST_EXIST)
-
-	// RteBlacklistNotFound  阻止连接段不存在
-	RteBlacklistNotFound = RtdbError(C.RtE_BLACKLIST_NOT_FOUND)
-
-	// RteInBlacklist  连接地址位于黑名单中，被主动拒绝
-	RteInBlacklist = RtdbError(C.RtE_IN_BLACKLIST)
-
-	// RteIncreaseFileFailed  试图增大文件失败
-	RteIncreaseFileFailed = RtdbError(C.RtE_INCREASE_FILE_FAILED)
-
-	// RteRpcInterfaceFailed  远程过程接口调用失败
-	RteRpcInterfaceFailed = RtdbError(C.RtE_RPC_INTERFACE_FAILED)
-
-	// RteConnectionFull  连接已满
-	RteConnectionFull = RtdbError(C.RtE_CONNECTION_FULL)
-
-	// RteOneClientConnectionFull  连接已达到单个客户端允许连接数的最大值
-	RteOneClientConnectionFull = RtdbError(C.RtE_ONE_CLIENT_CONNECTION_FULL)
-
-	// RteServerClutterPoolNotEnough  网络数据交换空间不足
-	RteServerClutterPoolNotEnough = RtdbError(C.RtE_SERVER_CLUTTER_POOL_NOT_ENOUGH)
-
-	// RteEquationClutterPoolNotEnough  实时方程式交换空间不足
-	RteEquationClutterPoolNotEnough = RtdbError(C.RtE_EQUATION_CLUTTER_POOL_NOT_ENOUGH)
-
-	// RteNamedTypeNameLenError  自定义类型的名称过长
-	RteNamedTypeNameLenError = RtdbError(C.RtE_NAMED_TYPE_NAME_LEN_ERROR)
-
-	// RteNamedTypeLengthNotMatch  数值长度与自定义类型的定义不符
-	RteNamedTypeLengthNotMatch = RtdbError(C.RtE_NAMED_TYPE_LENGTH_NOT_MATCH)
-
-	// RteCanNotUpdateSummary  无法更新卫星数据
-	RteCanNotUpdateSummary = RtdbError(C.RtE_CAN_NOT_UPDATE_SUMMARY)
-
-	// RteTooManyArvexFile  附属文件太多，无法继续创建附属文件
-	RteTooManyArvexFile = RtdbError(C.RtE_TOO_MANY_ARVEX_FILE)
-
-	// RteNotSupportedFeature  测试版本，暂时不支持此功能
-	RteNotSupportedFeature = RtdbError(C.RtE_NOT_SUPPORTED_FEATURE)
-
-	// RteEnsureError  验证信息失败，详细信息请查看数据库日志
-	RteEnsureError = RtdbError(C.RtE_ENSURE_ERROR)
-
-	// RteOperatorIsCancel  操作被取消
-	RteOperatorIsCancel = RtdbError(C.RtE_OPERATOR_IS_CANCEL)
-
-	// RteMsgbodyRevError  报文体接收失败
-	RteMsgbodyRevError = RtdbError(C.RtE_MSGBODY_REV_ERROR)
-
-	// RteUncompressFailed  解压缩失败
-	RteUncompressFailed = RtdbError(C.RtE_UNCOMPRESS_FAILED)
-
-	// RteCompressFailed  压缩失败
-	RteCompressFailed = RtdbError(C.RtE_COMPRESS_FAILED)
-
-	// RteSubscribeError  订阅失败，前一个订阅线程尚未退出
-	RteSubscribeError = RtdbError(C.RtE_SUBSCRIBE_ERROR)
-
-	// RteSubscribeCancelError  取消订阅失败
-	RteSubscribeCancelError = RtdbError(C.RtE_SUBSCRIBE_CANCEL_ERROR)
-
-	// RteSubscribeCallbackFailed  订阅回掉函数中不能调用取消订阅、断开连接
-	RteSubscribeCallbackFailed = RtdbError(C.RtE_SUBSCRIBE_CALLBACK_FAILED)
-
-	// RteSubscribeGreaterMaxCount  超过单连接可订阅标签点数量
-	RteSubscribeGreaterMaxCount = RtdbError(C.RtE_SUBSCRIBE_GREATER_MAX_COUNT)
-
-	// RteKillConnectionFailed  断开连接失败，无法断开自身连接
-	RteKillConnectionFailed = RtdbError(C.RtE_KILL_CONNECTION_FAILED)
-
-	// RteSubscribeNotMatch  请求的方法与当前的订阅不匹配
-	RteSubscribeNotMatch = RtdbError(C.RtE_SUBSCRIBE_NOT_MATCH)
-
-	// RteNoSubscribe  连接还未发起订阅，或者标签点还未订阅
-	RteNoSubscribe = RtdbError(C.RtE_NO_SUBSCRIBE)
-
-	// RteAlreadySubscribe  标签点已经被订阅
-	RteAlreadySubscribe = RtdbError(C.RtE_ALREADY_SUBSCRIBE)
-
-	// RteCalcPointUnsupportedWriteData  计算点不支持写入数据
-	RteCalcPointUnsupportedWriteData = RtdbError(C.RtE_CALC_POINT_UNSUPPORTED_WRITE_DATA)
-
-	// RteFeatureDeprecated  不再支持此功能
-	RteFeatureDeprecated = RtdbError(C.RtE_FEATURE_DEPRECATED)
-
-	// RteInvalidValue  无效的数据
-	RteInvalidValue = RtdbError(C.RtE_INVALID_VALUE)
-
-	// RteVerifyVercodeFailed  验证授权码失败
-	RteVerifyVercodeFailed = RtdbError(C.RtE_VERIFY_VERCODE_FAILED)
-
-	// RteInvalidPageSize  无效的数据页的大小
-	RteInvalidPageSize = RtdbError(C.RtE_INVALID_PAGE_SIZE)
-
-	// RteInvalidPrecision  无效的时间戳精度
-	RteInvalidPrecision = RtdbError(C.RtE_INVALID_PRECISION)
-
-	// RteInvalidPageVersion  无效的数据页版本
-	RteInvalidPageVersion = RtdbError(C.RtE_INVALID_PAGE_VERSION)
-
-	// RtePageIsFull  数据页已满
-	RtePageIsFull = RtdbError(C.RtE_PAGE_IS_FULL)
-
-	// RtePageNotLoaded  还未加载数据页
-	RtePageNotLoaded = RtdbError(C.RtE_PAGE_NOT_LOADED)
-
-	// RtePageAlreadyLoaded  已经加载了数据页
-	RtePageAlreadyLoaded = RtdbError(C.RtE_PAGE_ALREADY_LOADED)
-
-	// RtePageTooSmall  数据页太小，有效空间小于数据长度
-	RtePageTooSmall = RtdbError(C.RtE_PAGE_TOO_SMALL)
-
-	// RtePageNoEnoughData  数据页中没有足够的数据
-	RtePageNoEnoughData = RtdbError(C.RtE_PAGE_NO_ENOUGH_DATA)
-
-	// RtePageInsertFailed  数据页插入数据失败
-	RtePageInsertFailed = RtdbError(C.RtE_PAGE_INSERT_FAILED)
-
-	// RtePageNoEnoughSpace  数据页没有足够的空间
-	RtePageNoEnoughSpace = RtdbError(C.RtE_PAGE_NO_ENOUGH_SPACE)
-
-	// RteModifingMetaData  正在修改元数据，请稍后再试
-	RteModifingMetaData = RtdbError(C.RtE_MODIFING_META_DATA)
-
-	// RtePageSizeNotMatch  数据页大小不匹配
-	RtePageSizeNotMatch = RtdbError(C.RtE_PAGE_SIZE_NOT_MATCH)
-
-	// RteSyncBegin  元数据同步错误码起始值
-	RteSyncBegin = RtdbError(C.RtE_SYNC_BEGIN)
-
-	// RteSyncInvalidConfig  元数据同步-无效的配置
-	RteSyncInvalidConfig = RtdbError(C.RtE_SYNC_INVALID_CONFIG)
-
-	// RteSyncInvalidVersion  元数据同步-无效的版本号
-	RteSyncInvalidVersion = RtdbError(C.RtE_SYNC_INVALID_VERSION)
-
-	// RteSyncConfirmExpired  元数据同步-等待确认信息过期
-	RteSyncConfirmExpired = RtdbError(C.RtE_SYNC_CONFIRM_EXPIRED)
-
-	// RteSyncTooManyFwdinfo  元数据同步-转发信息过多
-	RteSyncTooManyFwdinfo = RtdbError(C.RtE_SYNC_TOO_MANY_FWDINFO)
-
-	// RteSyncNotMaster  元数据同步-不是主库
-	RteSyncNotMaster = RtdbError(C.RtE_SYNC_NOT_MASTER)
-
-	// RteSyncSyncing  元数据同步-正在同步
-	RteSyncSyncing = RtdbError(C.RtE_SYNC_SYNCING)
-
-	// RteSyncUnsynced  元数据同步-未同步
-	RteSyncUnsynced = RtdbError(C.RtE_SYNC_UNSYNCED)
-
-	// RteSyncTablePosConflict  元数据同步-表位置冲突
-	RteSyncTablePosConflict = RtdbError(C.RtE_SYNC_TABLE_POS_CONFLICT)
-
-	// RteSyncInvalidPointId  元数据同步-无效的标签点ID
-	RteSyncInvalidPointId = RtdbError(C.RtE_SYNC_INVALID_POINT_ID)
-
-	// RteSyncInvalidTableId  元数据同步-无效的表ID
-	RteSyncInvalidTableId = RtdbError(C.RtE_SYNC_INVALID_TABLE_ID)
-
-	// RteSyncInvalidNamedTypeId  元数据同步-无效的自定义类型ID
-	RteSyncInvalidNamedTypeId = RtdbError(C.RtE_SYNC_INVALID_NAMED_TYPE_ID)
-
-	// RteSyncRestoring  元数据同步-正在重建元数据
-	RteSyncRestoring = RtdbError(C.RtE_SYNC_RESTORING)
-
-	// RteSyncServerIsNotRunning  元数据同步-网络服务不是运行状态
-	RteSyncServerIsNotRunning = RtdbError(C.RtE_SYNC_SERVER_IS_NOT_RUNNING)
-
-	// RteSyncWriteWalFailed  元数据同步-写WAL失败
-	RteSyncWriteWalFailed = RtdbError(C.RtE_SYNC_WRITE_WAL_FAILED)
-
-	// RteSyncEnd  元数据同步错误码结束值
-	RteSyncEnd = RtdbError(C.RtE_SYNC_END)
-
-	// RteNetError  网络错误的起始值
-	RteNetError = RtdbError(C.RtE_NET_ERROR)
-
-	// RteSockWsaeintr  （阻塞）调用被 WSACancelBlockingCall() 函数取消
-	RteSockWsaeintr = RtdbError(C.RtE_SOCK_WSAEINTR)
-
-	// RteSockWsaeacces  请求地址是广播地址，但是相应的 flags 没设置
-	RteSockWsaeacces = RtdbError(C.RtE_SOCK_WSAEACCES)
-
-	// RteSockWsaefault  非法内存访问
-	RteSockWsaefault = RtdbError(C.RtE_SOCK_WSAEFAULT)
-
-	// RteSockWsaemfile  无多余的描述符可用
-	RteSockWsaemfile = RtdbError(C.RtE_SOCK_WSAEMFILE)
-
-	// RteSockWsaewouldblock  套接字被标识为非阻塞，但操作将被阻塞
-	RteSockWsaewouldblock = RtdbError(C.RtE_SOCK_WSAEWOULDBLOCK)
-
-	// RteSockWsaeinprogress  一个阻塞的 Windows Sockets 操作正在进行
-	RteSockWsaeinprogress = RtdbError(C.RtE_SOCK_WSAEINPROGRESS)
-
-	// RteSockWsaealready  一个非阻塞的 connect() 调用已经在指定的套接字上进行
-	RteSockWsaealready = RtdbError(C.RtE_SOCK_WSAEALREADY)
-
-	// RteSockWsaenotsock  描述符不是套接字描述符
-	RteSockWsaenotsock = RtdbError(C.RtE_SOCK_WSAENOTSOCK)
-
-	// RteSockWsaedestaddrreq  要求（未指定）目的地址
-	RteSockWsaedestaddrreq = RtdbError(C.RtE_SOCK_WSAEDESTADDRREQ)
-
-	// RteSockWsaemsgsize  套接字为基于消息的，消息太大（大于底层传输支持的最大值）
-	RteSockWsaemsgsize = RtdbError(C.RtE_SOCK_WSAEMSGSIZE)
-
-	// RteSockWsaeprototype  对此套接字来说，指定协议是错误的类型
-	RteSockWsaeprototype = RtdbError(C.RtE_SOCK_WSAEPROTOTYPE)
-
-	// RteSockWsaeprotonosupport  不支持指定协议
-	RteSockWsaeprotonosupport = RtdbError(C.RtE_SOCK_WSAEPROTONOSUPPORT)
-
-	// RteSockWsaesocktnosupport  在此地址族中不支持指定套接字类型
-	RteSockWsaesocktnosupport = RtdbError(C.RtE_SOCK_WSAESOCKTNOSUPPORT)
-
-	// RteSockWsaeopnotsupp  MSG_OOB 被指定，但是套接字不是流风格的
-	RteSockWsaeopnotsupp = RtdbError(C.RtE_SOCK_WSAEOPNOTSUPP)
-
-	// RteSockWsaeafnosupport  不支持指定的地址族
-	RteSockWsaeafnosupport = RtdbError(C.RtE_SOCK_WSAEAFNOSUPPORT)
-
-	// RteSockWsaeaddrinuse  套接字的本地地址已被使用
-	RteSockWsaeaddrinuse = RtdbError(C.RtE_SOCK_WSAEADDRINUSE)
-
-	// RteSockWsaeaddrnotavail  远程地址非法
-	RteSockWsaeaddrnotavail = RtdbError(C.RtE_SOCK_WSAEADDRNOTAVAIL)
-
-	// RteSockWsaenetdown  Windows Sockets 检测到网络系统已经失效
-	RteSockWsaenetdown = RtdbError(C.RtE_SOCK_WSAENETDOWN)
-
-	// RteSockWsaenetunreach  网络无法到达主机
-	RteSockWsaenetunreach = RtdbError(C.RtE_SOCK_WSAENETUNREACH)
-
-	// RteSockWsaenetreset  在操作进行时 keep-alive 活动检测到一个失败，连接被中断
-	RteSockWsaenetreset = RtdbError(C.RtE_SOCK_WSAENETRESET)
-
-	// RteSockWsaeconnaborted  连接因超时或其他失败而中断
-	RteSockWsaeconnaborted = RtdbError(C.RtE_SOCK_WSAECONNABORTED)
-
-	// RteSockWsaeconnreset  连接被复位
-	RteSockWsaeconnreset = RtdbError(C.RtE_SOCK_WSAECONNRESET)
-
-	// RteSockWsaenobufs  无缓冲区空间可用
-	RteSockWsaenobufs = RtdbError(C.RtE_SOCK_WSAENOBUFS)
-
-	// RteSockWsaeisconn  连接已建立
-	RteSockWsaeisconn = RtdbError(C.RtE_SOCK_WSAEISCONN)
-
-	// RteSockWsaenotconn  套接字未建立连接
-	RteSockWsaenotconn = RtdbError(C.RtE_SOCK_WSAENOTCONN)
-
-	// RteSockWsaeshutdown  套接字已 shutdown，连接已断开
-	RteSockWsaeshutdown = RtdbError(C.RtE_SOCK_WSAESHUTDOWN)
-
-	// RteSockWsaetimedout  连接请求超时，未能建立连接
-	RteSockWsaetimedout = RtdbError(C.RtE_SOCK_WSAETIMEDOUT)
-
-	// RteSockWsaeconnrefused  连接被拒绝
-	RteSockWsaeconnrefused = RtdbError(C.RtE_SOCK_WSAECONNREFUSED)
-
-	// RteSockWsaeclose  连接被关闭
-	RteSockWsaeclose = RtdbError(C.RtE_SOCK_WSAECLOSE)
-
-	// RteSockWsanotinitialised  Windows Sockets DLL 未初始化
-	RteSockWsanotinitialised = RtdbError(C.RtE_SOCK_WSANOTINITIALISED)
-
-	// RteCErrnoError  C语言errno错误的起始值
-	RteCErrnoError = RtdbError(C.RtE_C_ERRNO_ERROR)
-
-	// RteCErrnoEperm  Operation not permitted
-	RteCErrnoEperm = RtdbError(C.RtE_C_ERRNO_EPERM)
-
-	// RteCErrnoEnoent  No such file or directory
-	RteCErrnoEnoent = RtdbError(C.RtE_C_ERRNO_ENOENT)
-
-	// RteCErrnoEsrch  No such process
-	RteCErrnoEsrch = RtdbError(C.RtE_C_ERRNO_ESRCH)
-
-	// RteCErrnoEintr  Interrupted system call
-	RteCErrnoEintr = RtdbError(C.RtE_C_ERRNO_EINTR)
-
-	// RteCErrnoEio  I/O error
-	RteCErrnoEio = RtdbError(C.RtE_C_ERRNO_EIO)
-
-	// RteCErrnoEnxio  No such device or address
-	RteCErrnoEnxio = RtdbError(C.RtE_C_ERRNO_ENXIO)
-
-	// RteCErrnoE2big  Argument list too long
-	RteCErrnoE2big = RtdbError(C.RtE_C_ERRNO_E2BIG)
-
-	// RteCErrnoEnoexec  Exec format error
-	RteCErrnoEnoexec = RtdbError(C.RtE_C_ERRNO_ENOEXEC)
-
-	// RteCErrnoEbadf  Bad file number
-	RteCErrnoEbadf = RtdbError(C.RtE_C_ERRNO_EBADF)
-
-	// RteCErrnoEchild  No child processes
-	RteCErrnoEchild = RtdbError(C.RtE_C_ERRNO_ECHILD)
-
-	// RteCErrnoEagain  Try again
-	RteCErrnoEagain = RtdbError(C.RtE_C_ERRNO_EAGAIN)
-
-	// RteCErrnoEnomem  Out of memory
-	RteCErrnoEnomem = RtdbError(C.RtE_C_ERRNO_ENOMEM)
-
-	// RteCErrnoEacces  Permission denied
-	RteCErrnoEacces = RtdbError(C.RtE_C_ERRNO_EACCES)
-
-	// RteCErrnoEfault  Bad address
-	RteCErrnoEfault = RtdbError(C.RtE_C_ERRNO_EFAULT)
-
-	// RteCErrnoEnotblk  Block device required
-	RteCErrnoEnotblk = RtdbError(C.RtE_C_ERRNO_ENOTBLK)
-
-	// RteCErrnoEbusy  Device or resource busy
-	RteCErrnoEbusy = RtdbError(C.RtE_C_ERRNO_EBUSY)
-
-	// RteCErrnoEexist  File exists
-	RteCErrnoEexist = RtdbError(C.RtE_C_ERRNO_EEXIST)
-
-	// RteCErrnoExdev  Cross-device link
-	RteCErrnoExdev = RtdbError(C.RtE_C_ERRNO_EXDEV)
-
-	// RteCErrnoEnodev  No such device
-	RteCErrnoEnodev = RtdbError(C.RtE_C_ERRNO_ENODEV)
-
-	// RteCErrnoEnotdir  Not a directory
-	RteCErrnoEnotdir = RtdbError(C.RtE_C_ERRNO_ENOTDIR)
-
-	// RteCErrnoEisdir  Is a directory
-	RteCErrnoEisdir = RtdbError(C.RtE_C_ERRNO_EISDIR)
-
-	// RteCErrnoEinval  Invalid argument
-	RteCErrnoEinval = RtdbError(C.RtE_C_ERRNO_EINVAL)
-
-	// RteCErrnoEnfile  File table overflow
-	RteCErrnoEnfile = RtdbError(C.RtE_C_ERRNO_ENFILE)
-
-	// RteCErrnoEmfile  Too many open files
-	RteCErrnoEmfile = RtdbError(C.RtE_C_ERRNO_EMFILE)
-
-	// RteCErrnoEnotty  Not a typewriter
-	RteCErrnoEnotty = RtdbError(C.RtE_C_ERRNO_ENOTTY)
-
-	// RteCErrnoEtxtbsy  Text file busy
-	RteCErrnoEtxtbsy = RtdbError(C.RtE_C_ERRNO_ETXTBSY)
-
-	// RteCErrnoEfbig  File too large
-	RteCErrnoEfbig = RtdbError(C.RtE_C_ERRNO_EFBIG)
-
-	// RteCErrnoEnospc  No space left on device
-	RteCErrnoEnospc = RtdbError(C.RtE_C_ERRNO_ENOSPC)
-
-	// RteCErrnoEspipe  Illegal seek
-	RteCErrnoEspipe = RtdbError(C.RtE_C_ERRNO_ESPIPE)
-
-	// RteCErrnoErofs  Read-only file system
-	RteCErrnoErofs = RtdbError(C.RtE_C_ERRNO_EROFS)
-
-	// RteCErrnoEmlink  Too many links
-	RteCErrnoEmlink = RtdbError(C.RtE_C_ERRNO_EMLINK)
-
-	// RteCErrnoEpipe  Broken pipe
-	RteCErrnoEpipe = RtdbError(C.RtE_C_ERRNO_EPIPE)
-
-	// RteCErrnoEdom  Math argument out of domain of func
-	RteCErrnoEdom = RtdbError(C.RtE_C_ERRNO_EDOM)
-
-	// RteCErrnoErange  Math result not representable
-	RteCErrnoErange = RtdbError(C.RtE_C_ERRNO_ERANGE)
-
-	// RteCErrnoEdeadlk  Resource deadlock would occur
-	RteCErrnoEdeadlk = RtdbError(C.RtE_C_ERRNO_EDEADLK)
-
-	// RteCErrnoEnametoolong  File name too long
-	RteCErrnoEnametoolong = RtdbError(C.RtE_C_ERRNO_ENAMETOOLONG)
-
-	// RteCErrnoEnolck  No record locks available
-	RteCErrnoEnolck = RtdbError(C.RtE_C_ERRNO_ENOLCK)
-
-	// RteCErrnoEnosys  Function not implemented
-	RteCErrnoEnosys = RtdbError(C.RtE_C_ERRNO_ENOSYS)
-
-	// RteCErrnoEnotempty  Directory not empty
-	RteCErrnoEnotempty = RtdbError(C.RtE_C_ERRNO_ENOTEMPTY)
-
-	// RteCErrnoEloop  Too many symbolic links encountered
-	RteCErrnoEloop = RtdbError(C.RtE_C_ERRNO_ELOOP)
-
-	// RteCErrnoEnomsg  No message of desired type
-	RteCErrnoEnomsg = RtdbError(C.RtE_C_ERRNO_ENOMSG)
-
-	// RteCErrnoEidrm  Identifier removed
-	RteCErrnoEidrm = RtdbError(C.RtE_C_ERRNO_EIDRM)
-
-	// RteCErrnoEchrng  Channel number out of range
-	RteCErrnoEchrng = RtdbError(C.RtE_C_ERRNO_ECHRNG)
-
-	// RteCErrnoEl2nsync  Level 2 not synchronized
-	RteCErrnoEl2nsync = RtdbError(C.RtE_C_ERRNO_EL2NSYNC)
-
-	// RteCErrnoEl3hlt  Level 3 halted
-	RteCErrnoEl3hlt = RtdbError(C.RtE_C_ERRNO_EL3HLT)
-
-	// RteCErrnoEl3rst  Level 3 reset
-	RteCErrnoEl3rst = RtdbError(C.RtE_C_ERRNO_EL3RST)
-
-	// RteCErrnoElnrng  Link number out of range
-	RteCErrnoElnrng = RtdbError(C.RtE_C_ERRNO_ELNRNG)
-
-	// RteCErrnoEunatch  Protocol driver not attached
-	RteCErrnoEunatch = RtdbError(C.RtE_C_ERRNO_EUNATCH)
-
-	// RteCErrnoEnocsi  No CSI structure available
-	RteCErrnoEnocsi = RtdbError(C.RtE_C_ERRNO_ENOCSI)
-
-	// RteCErrnoEl2hlt  Level 2 halted
-	RteCErrnoEl2hlt = RtdbError(C.RtE_C_ERRNO_EL2HLT)
-
-	// RteCErrnoEbade  Invalid exchange
-	RteCErrnoEbade = RtdbError(C.RtE_C_ERRNO_EBADE)
-
-	// RteCErrnoEbadr  Invalid request descriptor
-	RteCErrnoEbadr = RtdbError(C.RtE_C_ERRNO_EBADR)
-
-	// RteCErrnoExfull  Exchange full
-	RteCErrnoExfull = RtdbError(C.RtE_C_ERRNO_EXFULL)
-
-	// RteCErrnoEnoano  No anode
-	RteCErrnoEnoano = RtdbError(C.RtE_C_ERRNO_ENOANO)
-
-	// RteCErrnoEbadrqc  Invalid request code
-	RteCErrnoEbadrqc = RtdbError(C.RtE_C_ERRNO_EBADRQC)
-
-	// RteCErrnoEbadslt  Invalid slot
-	RteCErrnoEbadslt = RtdbError(C.RtE_C_ERRNO_EBADSLT)
-
-	// RteCErrnoEbfont  Bad font file format
-	RteCErrnoEbfont = RtdbError(C.RtE_C_ERRNO_EBFONT)
-
-	// RteCErrnoEnostr  Device not a stream
-	RteCErrnoEnostr = RtdbError(C.RtE_C_ERRNO_ENOSTR)
-
-	// RteCErrnoEnodata  No data available
-	RteCErrnoEnodata = RtdbError(C.RtE_C_ERRNO_ENODATA)
-
-	// RteCErrnoEtime  Timer expired
-	RteCErrnoEtime = RtdbError(C.RtE_C_ERRNO_ETIME)
-
-	// RteCErrnoEnosr  Out of streams resources
-	RteCErrnoEnosr = RtdbError(C.RtE_C_ERRNO_ENOSR)
-
-	// RteCErrnoEnonet  Machine is not on the network
-	RteCErrnoEnonet = RtdbError(C.RtE_C_ERRNO_ENONET)
-
-	// RteCErrnoEnopkg  Package not installed
-	RteCErrnoEnopkg = RtdbError(C.RtE_C_ERRNO_ENOPKG)
-
-	// RteCErrnoEremote  Object is remote
-	RteCErrnoEremote = RtdbError(C.RtE_C_ERRNO_EREMOTE)
-
-	// RteCErrnoEnolink  Link has been severed
-	RteCErrnoEnolink = RtdbError(C.RtE_C_ERRNO_ENOLINK)
-
-	// RteCErrnoEadv  Advertise error
-	RteCErrnoEadv = RtdbError(C.RtE_C_ERRNO_EADV)
-
-	// RteCErrnoEsrmnt  Srmount error
-	RteCErrnoEsrmnt = RtdbError(C.RtE_C_ERRNO_ESRMNT)
-
-	// RteCErrnoEcomm  Communication error on send
-	RteCErrnoEcomm = RtdbError(C.RtE_C_ERRNO_ECOMM)
-
-	// RteCErrnoEproto  Protocol error
-	RteCErrnoEproto = RtdbError(C.RtE_C_ERRNO_EPROTO)
-
-	// RteCErrnoEmultihop  Multihop attempted
-	RteCErrnoEmultihop = RtdbError(C.RtE_C_ERRNO_EMULTIHOP)
-
-	// RteCErrnoEdotdot  RFS specific error
-	RteCErrnoEdotdot = RtdbError(C.RtE_C_ERRNO_EDOTDOT)
-
-	// RteCErrnoEbadmsg  Not a data message
-	RteCErrnoEbadmsg = RtdbError(C.RtE_C_ERRNO_EBADMSG)
-
-	// RteCErrnoEoverflow  Value too large for defined data type
-	RteCErrnoEoverflow = RtdbError(C.RtE_C_ERRNO_EOVERFLOW)
-
-	// RteCErrnoEnotuniq  Name not unique on network
-	RteCErrnoEnotuniq = RtdbError(C.RtE_C_ERRNO_ENOTUNIQ)
-
-	// RteCErrnoEbadfd  File descriptor in bad state
-	RteCErrnoEbadfd = RtdbError(C.RtE_C_ERRNO_EBADFD)
-
-	// RteCErrnoEremchg  Remote address changed
-	RteCErrnoEremchg = RtdbError(C.RtE_C_ERRNO_EREMCHG)
-
-	// RteCErrnoElibacc  Can not access a needed shared library
-	RteCErrnoElibacc = RtdbError(C.RtE_C_ERRNO_ELIBACC)
-
-	// RteCErrnoElibbad  Accessing a corrupted shared library
-	RteCErrnoElibbad = RtdbError(C.RtE_C_ERRNO_ELIBBAD)
-
-	// RteCErrnoElibscn  .lib section in a.out corrupted
-	RteCErrnoElibscn = RtdbError(C.RtE_C_ERRNO_ELIBSCN)
-
-	// RteCErrnoElibmax  Attempting to link in too many shared libraries
-	RteCErrnoElibmax = RtdbError(C.RtE_C_ERRNO_ELIBMAX)
-
-	// RteCErrnoElibexec  Cannot exec a shared library directly
-	RteCErrnoElibexec = RtdbError(C.RtE_C_ERRNO_ELIBEXEC)
-
-	// RteCErrnoEilseq  Illegal byte sequence
-	RteCErrnoEilseq = RtdbError(C.RtE_C_ERRNO_EILSEQ)
-
-	// RteCErrnoErestart  Interrupted system call should be restarted
-	RteCErrnoErestart = RtdbError(C.RtE_C_ERRNO_ERESTART)
-
-	// RteCErrnoEstrpipe  Streams pipe error
-	RteCErrnoEstrpipe = RtdbError(C.RtE_C_ERRNO_ESTRPIPE)
-
-	// RteCErrnoEusers  Too many users
-	RteCErrnoEusers = RtdbError(C.RtE_C_ERRNO_EUSERS)
-
-	// RteCErrnoEnotsock  Socket operation on non-socket
-	RteCErrnoEnotsock = RtdbError(C.RtE_C_ERRNO_ENOTSOCK)
-
-	// RteCErrnoEdestaddrreq  Destination address required
-	RteCErrnoEdestaddrreq = RtdbError(C.RtE_C_ERRNO_EDESTADDRREQ)
-
-	// RteCErrnoEmsgsize  Message too long
-	RteCErrnoEmsgsize = RtdbError(C.RtE_C_ERRNO_EMSGSIZE)
-
-	// RteCErrnoEprototype  Protocol wrong type for socket
-	RteCErrnoEprototype = RtdbError(C.RtE_C_ERRNO_EPROTOTYPE)
-
-	// RteCErrnoEnoprotoopt  Protocol not available
-	RteCErrnoEnoprotoopt = RtdbError(C.RtE_C_ERRNO_ENOPROTOOPT)
-
-	// RteCErrnoEprotonosupport  Protocol not supported
-	RteCErrnoEprotonosupport = RtdbError(C.RtE_C_ERRNO_EPROTONOSUPPORT)
-
-	// RteCErrnoEsocktnosupport  Socket type not supported
-	RteCErrnoEsocktnosupport = RtdbError(C.RtE_C_ERRNO_ESOCKTNOSUPPORT)
-
-	// RteCErrnoEopnotsupp  Operation not supported on transport endpoint
-	RteCErrnoEopnotsupp = RtdbError(C.RtE_C_ERRNO_EOPNOTSUPP)
-
-	// RteCErrnoEpfnosupport  Protocol family not supported
-	RteCErrnoEpfnosupport = RtdbError(C.RtE_C_ERRNO_EPFNOSUPPORT)
-
-	// RteCErrnoEafnosupport  Address family not supported by protocol
-	RteCErrnoEafnosupport = RtdbError(C.RtE_C_ERRNO_EAFNOSUPPORT)
-
-	// RteCErrnoEaddrinuse  Address already in use
-	RteCErrnoEaddrinuse = RtdbError(C.RtE_C_ERRNO_EADDRINUSE)
-
-	// RteCErrnoEaddrnotavail  Cannot assign requested address
-	RteCErrnoEaddrnotavail = RtdbError(C.RtE_C_ERRNO_EADDRNOTAVAIL)
-
-	// RteCErrnoEnetdown  Network is down
-	RteCErrnoEnetdown = RtdbError(C.RtE_C_ERRNO_ENETDOWN)
-
-	// RteCErrnoEnetunreach  Network is unreachable
-	RteCErrnoEnetunreach = RtdbError(C.RtE_C_ERRNO_ENETUNREACH)
-
-	// RteCErrnoEnetreset  Network dropped connection because of reset
-	RteCErrnoEnetreset = RtdbError(C.RtE_C_ERRNO_ENETRESET)
-
-	// RteCErrnoEconnaborted  Software caused connection abort
-	RteCErrnoEconnaborted = RtdbError(C.RtE_C_ERRNO_ECONNABORTED)
-
-	// RteCErrnoEconnreset  Connection reset by peer
-	RteCErrnoEconnreset = RtdbError(C.RtE_C_ERRNO_ECONNRESET)
-
-	// RteCErrnoEnobufs  No buffer space available
-	RteCErrnoEnobufs = RtdbError(C.RtE_C_ERRNO_ENOBUFS)
-
-	// RteCErrnoEisconn  Transport endpoint is already connected
-	RteCErrnoEisconn = RtdbError(C.RtE_C_ERRNO_EISCONN)
-
-	// RteCErrnoEnotconn  Transport endpoint is not connected
-	RteCErrnoEnotconn = RtdbError(C.RtE_C_ERRNO_ENOTCONN)
-
-	// RteCErrnoEshutdown  Cannot send after transport endpoint shutdown
-	RteCErrnoEshutdown = RtdbError(C.RtE_C_ERRNO_ESHUTDOWN)
-
-	// RteCErrnoEtoomanyrefs  Too many references: cannot splice
-	RteCErrnoEtoomanyrefs = RtdbError(C.RtE_C_ERRNO_ETOOMANYREFS)
-
-	// RteCErrnoEtimedout  Connection timed out
-	RteCErrnoEtimedout = RtdbError(C.RtE_C_ERRNO_ETIMEDOUT)
-
-	// RteCErrnoEconnrefused  Connection refused
-	RteCErrnoEconnrefused = RtdbError(C.RtE_C_ERRNO_ECONNREFUSED)
-
-	// RteCErrnoEhostdown  Host is down
-	RteCErrnoEhostdown = RtdbError(C.RtE_C_ERRNO_EHOSTDOWN)
-
-	// RteCErrnoEhostunreach  No route to host
-	RteCErrnoEhostunreach = RtdbError(C.RtE_C_ERRNO_EHOSTUNREACH)
-
-	// RteCErrnoEalready  Operation already in progress
-	RteCErrnoEalready = RtdbError(C.RtE_C_ERRNO_EALREADY)
-
-	// RteCErrnoEinprogress  Operation now in progress
-	RteCErrnoEinprogress = RtdbError(C.RtE_C_ERRNO_EINPROGRESS)
-
-	// RteCErrnoEstale  Stale file handle
-	RteCErrnoEstale = RtdbError(C.RtE_C_ERRNO_ESTALE)
-
-	// RteCErrnoEuclean  Structure needs cleaning
-	RteCErrnoEuclean = RtdbError(C.RtE_C_ERRNO_EUCLEAN)
-
-	// RteCErrnoEnotnam  Not a XENIX named type file
-	RteCErrnoEnotnam = RtdbError(C.RtE_C_ERRNO_ENOTNAM)
-
-	// RteCErrnoEnavail  No XENIX semaphores available
-	RteCErrnoEnavail = RtdbError(C.RtE_C_ERRNO_ENAVAIL)
-
-	// RteCErrnoEisnam  Is a named type file
-	RteCErrnoEisnam = RtdbError(C.RtE_C_ERRNO_EISNAM)
-
-	// RteCErrnoEremoteio  Remote I/O error
-	RteCErrnoEremoteio = RtdbError(C.RtE_C_ERRNO_EREMOTEIO)
-
-	// RteCErrnoEdquot  Quota exceeded
-	RteCErrnoEdquot = RtdbError(C.RtE_C_ERRNO_EDQUOT)
-
-	// RteCErrnoEnomedium  No medium found
-	RteCErrnoEnomedium = RtdbError(C.RtE_C_ERRNO_ENOMEDIUM)
-
-	// RteCErrnoEmediumtype  Wrong medium type
-	RteCErrnoEmediumtype = RtdbError(C.RtE_C_ERRNO_EMEDIUMTYPE)
-
-	// RteCErrnoEcanceled  Operation Canceled
-	RteCErrnoEcanceled = RtdbError(C.RtE_C_ERRNO_ECANCELED)
-
-	// RteCErrnoEnokey  Required key not available
-	RteCErrnoEnokey = RtdbError(C.RtE_C_ERRNO_ENOKEY)
-
-	// RteCErrnoEkeyexpired  Key has expired
-	RteCErrnoEkeyexpired = RtdbError(C.RtE_C_ERRNO_EKEYEXPIRED)
-
-	// RteCErrnoEkeyrevoked  Key has been revoked
-	RteCErrnoEkeyrevoked = RtdbError(C.RtE_C_ERRNO_EKEYREVOKED)
-
-	// RteCErrnoEkeyrejected  Key was rejected by service
-	RteCErrnoEkeyrejected = RtdbError(C.RtE_C_ERRNO_EKEYREJECTED)
-
-	// RteCErrnoEownerdead  Owner died
-	RteCErrnoEownerdead = RtdbError(C.RtE_C_ERRNO_EOWNERDEAD)
-
-	// RteCErrnoEnotrecoverable  State not recoverable
-	RteCErrnoEnotrecoverable = RtdbError(C.RtE_C_ERRNO_ENOTRECOVERABLE)
-
-	// RteCErrnoErfkill  Operation not possible due to RF-kill
-	RteCErrnoErfkill = RtdbError(C.RtE_C_ERRNO_ERFKILL)
-
-	// RteCErrnoEhwpoison  Memory page has hardware error
-	RteCErrnoEhwpoison = RtdbError(C.RtE_C_ERRNO_EHWPOISON)
-
-	// RteIpcError  ipc error begin
-	RteIpcError = RtdbError(C.RtE_IPC_ERROR)
-
-	// RteIpcErrorEnd  ipc error end
-	RteIpcErrorEnd = RtdbError(C.RtE_IPC_ERROR_END)
-)
-
-// RtdbApiOption 用于设置API的工作模式的参数选项
-type RtdbApiOption uint32
-
-const (
-	// RtdbApiOptionAutoReconn api 在连接中断后是否自动重连, 0 不重连；1 重连。默认为 0 不重连
-	RtdbApiOptionAutoReconn = RtdbApiOption(C.RTDB_API_AUTO_RECONN)
-
-	// RtdbApiOptionConnTimeout api 连接超时值设置（单位：毫秒）,0 阻塞模式，无限等待，默认为1000
-	RtdbApiOptionConnTimeout = RtdbApiOption(C.RTDB_API_CONN_TIMEOUT)
-
-	// RtdbApiOptionSendTimeout api 发送超时值设置（单位：毫秒）,0 阻塞模式，无限等待，默认为1000
-	RtdbApiOptionSendTimeout = RtdbApiOption(C.RTDB_API_SEND_TIMEOUT)
-
-	// RtdbApiOptionRecvTimeout api 接收超时值设置（单位：毫秒）,0 阻塞模式，无限等待，默认为60000
-	RtdbApiOptionRecvTimeout = RtdbApiOption(C.RTDB_API_RECV_TIMEOUT)
-
-	// RtdbApiOptionUserTimeout api TCP_USER_TIMEOUT超时值设置（单位：毫秒），默认为10000，Linux内核2.6.37以上有效
-	RtdbApiOptionUserTimeout = RtdbApiOption(C.RTDB_API_USER_TIMEOUT)
-
-	// RtdbApiOptionDefaultPrecision api 默认的时间戳精度，当使用旧版相关的api，以及新版api中未设置时间戳精度时，则使用此默认时间戳精度。 默认为毫秒精度
-	RtdbApiOptionDefaultPrecision = RtdbApiOption(C.RTDB_API_DEFAULT_PRECISION)
-
-	// RtdbApiOptionServerPrecision api 连接3.0数据库时，设置3.0数据库的时间戳精度，0表示毫秒精度，非0表示纳秒精度，默认为毫秒精度
-	RtdbApiOptionServerPrecision = RtdbApiOption(C.RTDB_API_SERVER_PRECISION)
-)
-
-func (rao RtdbApiOption) Desc() string {
-	switch rao {
-	case RtdbApiOptionAutoReconn:
-		return "api 在连接中断后是否自动重连, 0 不重连；1 重连。默认为 0 不重连"
-	case RtdbApiOptionConnTimeout:
-		return "api 连接超时值设置（单位：毫秒）,0 阻塞模式，无限等待，默认为1000"
-	case RtdbApiOptionSendTimeout:
-		return "api 发送超时值设置（单位：毫秒）,0 阻塞模式，无限等待，默认为1000"
-	case RtdbApiOptionRecvTimeout:
-		return "api 接收超时值设置（单位：毫秒）,0 阻塞模式，无限等待，默认为60000"
-	case RtdbApiOptionUserTimeout:
-		return "api TCP_USER_TIMEOUT超时值设置（单位：毫秒），默认为10000，Linux内核2.6.37以上有效"
-	case RtdbApiOptionDefaultPrecision:
-		return "api 默认的时间戳精度，当使用旧版相关的api，以及新版api中未设置时间戳精度时，则使用此默认时间戳精度。 默认为毫秒精度"
-	case RtdbApiOptionServerPrecision:
-		return "api 连接3.0数据库时，设置3.0数据库的时间戳精度，0表示毫秒精度，非0表示纳秒精度，默认为毫秒精度"
-	default:
-		return "未知的RtdbApiOption"
-	}
-}
-
-// DatagramHandle 流句柄, 用于数据流订阅
-type DatagramHandle struct {
-	handle C.rtdb_datagram_handle
-}
-
-// ApiVersion 版本号
-type ApiVersion struct {
-	Major int32 // 主版本号
-	Minor int32 // 次版本号
-	Beta  int32 // 发布版本号
-}
-
-// PrivGroup 用户权限
-type PrivGroup uint32
-
-const (
-	// PrivGroupRtdbRO 只读
-	PrivGroupRtdbRO = PrivGroup(C.RTDB_RO)
-
-	// PrivGroupRtdbDW 数据记录
-	PrivGroupRtdbDW = PrivGroup(C.RTDB_DW)
-
-	// PrivGroupRtdbTA 标签点表管理员
-	PrivGroupRtdbTA = PrivGroup(C.RTDB_TA)
-
-	// PrivGroupRtdbSA 数据库管理员
-	PrivGroupRtdbSA = PrivGroup(C.RTDB_SA)
-)
-
-func (pg PrivGroup) Desc() string {
-	switch pg {
-	case PrivGroupRtdbRO:
-		return "只读"
-	case PrivGroupRtdbDW:
-		return "数据记录"
-	case PrivGroupRtdbTA:
-		return "标签点表管理员"
-	case PrivGroupRtdbSA:
-		return "数据库管理员"
-	default:
-		return "未知权限"
-	}
-}
-
-// ConnectHandle 连接句柄, 用于描述一个 API库 与 数据库 之间的连接
-type ConnectHandle int32
-
-// SocketHandle socket连接句柄
-type SocketHandle int32
-
-// RtdbParam 查询系统参数时对应的索引
-type RtdbParam int32
-
-// IsStringParam 判断系统参数是否为String类型，如果是则返回true，否则返回false
-func (p RtdbParam) IsStringParam() bool {
-	return p < RtdbParam(C.RTDB_PARAM_STR_LAST)
-}
-
-const (
-	// RtdbParamTableFile 标签点表文件全路径，字符串最大长度为 RTDB_MAX_PATH
-	RtdbParamTableFile = RtdbParam(C.RTDB_PARAM_TABLE_FILE)
-
-	// RtdbParamBaseFile 基本标签点文件全路径，字符串最大长度为 RTDB_MAX_PATH
-	RtdbParamBaseFile = RtdbParam(C.RTDB_PARAM_BASE_FILE)
-
-	// RtdbParamScanFile 采集标签点文件全路径，字符串最大长度为 RTDB_MAX_PATH
-	RtdbParamScanFile = RtdbParam(C.RTDB_PARAM_SCAN_FILE)
-
-	// RtdbParamCalcFile 计算标签点文件全路径，字符串最大长度为 RTDB_MAX_PATH
-	RtdbParamCalcFile = RtdbParam(C.RTDB_PARAM_CALC_FILE)
-
-	// RtdbParamSnapFile 标签点快照文件全路径，字符串最大长度为 RTDB_MAX_PATH
-	RtdbParamSnapFile = RtdbParam(C.RTDB_PARAM_SNAP_FILE)
-
-	// RtdbParamLicFile 协议文件全路径，字符串最大长度为 RTDB_MAX_PATH
-	RtdbParamLicFile = RtdbParam(C.RTDB_PARAM_LIC_FILE)
-
-	// RtdbParamHisFile 历史信息文件全路径，字符串最大长度为 RTDB_MAX_PATH
-	RtdbParamHisFile = RtdbParam(C.RTDB_PARAM_HIS_FILE)
-
-	// RtdbParamLogDir 服务器端日志文件全路径，字符串最大长度为 RTDB_MAX_PATH
-	RtdbParamLogDir = RtdbParam(C.RTDB_PARAM_LOG_DIR)
-
-	// RtdbParamUserFile 用户权限信息文件全路径，字符串最大长度为 RTDB_MAX_PATH
-	RtdbParamUserFile = RtdbParam(C.RTDB_PARAM_USER_FILE)
-
-	// RtdbParamServerFile 网络服务进程与其它进程交互所用的共享内存文件，字符串最大长度为 RTDB_MAX_PATH
-	RtdbParamServerFile = RtdbParam(C.RTDB_PARAM_SERVER_FILE)
-
-	// RtdbParamEqautionFile 方程式服务进程与其它进程交互所用的共享内存文件，字符串最大长度为 RTDB_MAX_PATH
-	RtdbParamEqautionFile = RtdbParam(C.RTDB_PARAM_EQAUTION_FILE)
-
-	// RtdbParamArvPagesFile 历史数据缓存文件全路径，字符串最大长度为 RTDB_MAX_PATH
-	RtdbParamArvPagesFile = RtdbParam(C.RTDB_PARAM_ARV_PAGES_FILE)
-
-	// RtdbParamArvexPagesFile 补历史数据缓存文件全路径，字符串最大长度为 RTDB_MAX_PATH
-	RtdbParamArvexPagesFile = RtdbParam(C.RTDB_PARAM_ARVEX_PAGES_FILE)
-
-	// RtdbParamArvexPagesBlobFile 补历史数据blob、str缓存文件全路径，字符串最大长度为 RTDB_MAX_PATH
-	RtdbParamArvexPagesBlobFile = RtdbParam(C.RTDB_PARAM_ARVEX_PAGES_BLOB_FILE)
-
-	// RtdbParamAuthFile 信任连接段信息文件路径，字符串最大长度为 RTDB_MAX_PATH
-	RtdbParamAuthFile = RtdbParam(C.RTDB_PARAM_AUTH_FILE)
-
-	// RtdbParamRecycledBaseFile 可回收基本标签点文件全路径，字符串最大长度为 RTDB_MAX_PATH
-	RtdbParamRecycledBaseFile = RtdbParam(C.RTDB_PARAM_RECYCLED_BASE_FILE)
-
-	// RtdbParamRecycledScanFile 可回收采集标签点文件全路径，字符串最大长度为 RTDB_MAX_PATH
-	RtdbParamRecycledScanFile = RtdbParam(C.RTDB_PARAM_RECYCLED_SCAN_FILE)
-
-	// RtdbParamRecycledCalcFile 可回收计算标签点文件全路径，字符串最大长度为 RTDB_MAX_PATH
-	RtdbParamRecycledCalcFile = RtdbParam(C.RTDB_PARAM_RECYCLED_CALC_FILE)
-
-	// RtdbParamAutoBackupPath 自动备份目的地全路径，必须以“\”或“/”结束，字符串最大长度为 RTDB_MAX_PATH
-	RtdbParamAutoBackupPath = RtdbParam(C.RTDB_PARAM_AUTO_BACKUP_PATH)
-
-	// RtdbParamServerSenderIp 镜像发送地址，字符串最大长度为 RTDB_MAX_HOSTNAME_SIZE
-	RtdbParamServerSenderIp = RtdbParam(C.RTDB_PARAM_SERVER_SENDER_IP)
-
-	// RtdbParamBlacklistFile 连接黑名单文件路径，字符串最大长度为 RTDB_MAX_PATH
-	RtdbParamBlacklistFile = RtdbParam(C.RTDB_PARAM_BLACKLIST_FILE)
-
-	// RtdbParamDbVersion 数据库版本
-	RtdbParamDbVersion = RtdbParam(C.RTDB_PARAM_DB_VERSION)
-
-	// RtdbParamLicUser 授权单位
-	RtdbParamLicUser = RtdbParam(C.RTDB_PARAM_LIC_USER)
-
-	// RtdbParamLicType 授权方式
-	RtdbParamLicType = RtdbParam(C.RTDB_PARAM_LIC_TYPE)
-
-	// RtdbParamIndexDir 索引文件存放目录，字符串最大长度为 RTDB_MAX_PATH
-	RtdbParamIndexDir = RtdbParam(C.RTDB_PARAM_INDEX_DIR)
-
-	// RtdbParamMirrorBufferPath 镜像缓存文件全路径，字符串最大长度为 RTDB_MAX_PATH
-	RtdbParamMirrorBufferPath = RtdbParam(C.RTDB_PARAM_MIRROR_BUFFER_PATH)
-
-	// RtdbParamMirrorExBufferPath 补写镜像缓存文件全路径，字符串最大长度为 RTDB_MAX_PATH
-	RtdbParamMirrorExBufferPath = RtdbParam(C.RTDB_PARAM_MIRROR_EX_BUFFER_PATH)
-
-	// RtdbParamEqautionPathFile 方程式长度超过规定长度时进行保存的文件全路径，字符串最大长度为 RTDB_MAX_PATH
-	RtdbParamEqautionPathFile = RtdbParam(C.RTDB_PARAM_EQAUTION_PATH_FILE)
-
-	// RtdbParamTagsFile 标签点关键属性文件全路径，字符串最大长度为 RTDB_MAX_PATH
-	RtdbParamTagsFile = RtdbParam(C.RTDB_PARAM_TAGS_FILE)
-
-	// RtdbParamRecycledSnapFile 可回收标签点快照事件文件全路径，字符串最大长度为 RTDB_MAX_PATH
-	RtdbParamRecycledSnapFile = RtdbParam(C.RTDB_PARAM_RECYCLED_SNAP_FILE)
-
-	// RtdbParamSwapPageFile 历史数据交换页文件全路径，字符串最大长度为 RTDB_MAX_PATH
-	RtdbParamSwapPageFile = RtdbParam(C.RTDB_PARAM_SWAP_PAGE_FILE)
-
-	// RtdbParamPageAllocatorFile 活动存档数据页分配器文件全路径，字符串最大长度为 RTDB_MAX_PATH, 该系统配置项2.1版数据库在使用，3.0数据库已去掉，但为了保证系统选项索引号, 与2.1一致，此处不能去掉。便于java sdk统一调用
-	RtdbParamPageAllocatorFile = RtdbParam(C.RTDB_PARAM_PAGE_ALLOCATOR_FILE)
-
-	// RtdbParamNamedTypeFile 自定义类型配置信息全路径，字符串最大长度为 RTDB_MAX_PATH
-	RtdbParamNamedTypeFile = RtdbParam(C.RTDB_PARAM_NAMED_TYPE_FILE)
-
-	// RtdbParamStrblobMirrorPath BLOB/STRING镜像数据缓存文件全路径，字符串最大长度为 RTDB_MAX_PATH
-	RtdbParamStrblobMirrorPath = RtdbParam(C.RTDB_PARAM_STRBLOB_MIRROR_PATH)
-
-	// RtdbParamStrblobMirrorExPath 补写BLOB/STRING镜像数据缓存文件全路径，字符串最大长度为 RTDB_MAX_PATH
-	RtdbParamStrblobMirrorExPath = RtdbParam(C.RTDB_PARAM_STRBLOB_MIRROR_EX_PATH)
-
-	// RtdbParamBufferDir 临时数据缓存路径
-	RtdbParamBufferDir = RtdbParam(C.RTDB_PARAM_BUFFER_DIR)
-
-	// RtdbParamPoolCacheFlie 曲线池索引文件全路径，字符串最大长度为 RTDB_MAX_PATH
-	RtdbParamPoolCacheFlie = RtdbParam(C.RTDB_PARAM_POOL_CACHE_FLIE)
-
-	// RtdbParamPoolDataFileDir 曲线池缓存文件目录，字符串最大长度为 RTDB_MAX_PATH
-	RtdbParamPoolDataFileDir = RtdbParam(C.RTDB_PARAM_POOL_DATA_FILE_DIR)
-
-	// RtdbParamArchiveFilePath 存档文件低速存储区路径，字符串最大长度为 RTDB_MAX_PATH
-	RtdbParamArchiveFilePath = RtdbParam(C.RTDB_PARAM_ARCHIVE_FILE_PATH)
-
-	// RtdbParamLicVersionType 授权版本
-	RtdbParamLicVersionType = RtdbParam(C.RTDB_PARAM_LIC_VERSION_TYPE)
-
-	// RtdbParamAutoMovePath 自动移动目的地全路径，必须以“\”或“/”结束，字符串最大长度为 RTDB_MAX_PATH
-	RtdbParamAutoMovePath = RtdbParam(C.RTDB_PARAM_AUTO_MOVE_PATH)
-
-	// RtdbParamReplicationBufferPath 双活：数据同步缓存文件全路径，字符串最大长度为 RTDB_MAX_PATH
-	RtdbParamReplicationBufferPath = RtdbParam(C.RTDB_PARAM_REPLICATION_BUFFER_PATH)
-
-	// RtdbParamReplicationExBufferPath 双活：数据同步补写数据缓存文件全路径，字符串最大长度为 RTDB_MAX_PATH
-	RtdbParamReplicationExBufferPath = RtdbParam(C.RTDB_PARAM_REPLICATION_EX_BUFFER_PATH)
-
-	// RtdbParamStrblobReplicationBufferPath 双活：数据同步BLOB/STRING数据缓存文件全路径，字符串最大长度为 RTDB_MAX_PATH
-	RtdbParamStrblobReplicationBufferPath = RtdbParam(C.RTDB_PARAM_STRBLOB_REPLICATION_BUFFER_PATH)
-
-	// RtdbParamStrblobReplicationExBufferPath 双活：数据同步补写BLOB/STRING数据缓存文件全路径，字符串最大长度为 RTDB_MAX_PATH
-	RtdbParamStrblobReplicationExBufferPath = RtdbParam(C.RTDB_PARAM_STRBLOB_REPLICATION_EX_BUFFER_PATH)
-
-	// RtdbParamReplicationGroupIp 双活：同步组地址，字符串最大长度为 RTDB_MAX_HOSTNAME_SIZE
-	RtdbParamReplicationGroupIp = RtdbParam(C.RTDB_PARAM_REPLICATION_GROUP_IP)
-
-	// RtdbParamArcFilenamePrefixWhenUsingDate 是否归档文件使用日期作为文件名
-	RtdbParamArcFilenamePrefixWhenUsingDate = RtdbParam(C.RTDB_PARAM_ARC_FILENAME_PREFIX_WHEN_USING_DATE)
-
-	// RtdbParamHotArchiveFilePath 存档文件高速存储区路径，字符串最大长度为 RTDB_MAX_PATH
-	RtdbParamHotArchiveFilePath = RtdbParam(C.RTDB_PARAM_HOT_ARCHIVE_FILE_PATH)
-
-	// RtdbParamLicTablesCount 协议中限定的标签点表数量
-	RtdbParamLicTablesCount = RtdbParam(C.RTDB_PARAM_LIC_TABLES_COUNT)
-
-	// RtdbParamLicTagsCount 协议中限定的所有标签点数量
-	RtdbParamLicTagsCount = RtdbParam(C.RTDB_PARAM_LIC_TAGS_COUNT)
-
-	// RtdbParamLicScanCount 协议中限定的采集标签点数量
-	RtdbParamLicScanCount = RtdbParam(C.RTDB_PARAM_LIC_SCAN_COUNT)
-
-	// RtdbParamLicCalcCount 协议中限定的计算标签点数量
-	RtdbParamLicCalcCount = RtdbParam(C.RTDB_PARAM_LIC_CALC_COUNT)
-
-	// RtdbParamLicArchicveCount 协议中限定的历史存档文件数量
-	RtdbParamLicArchicveCount = RtdbParam(C.RTDB_PARAM_LIC_ARCHICVE_COUNT)
-
-	// RtdbParamServerIpcSize 网络服务进程与其它进程进行交互所使用的共享内存池的字节尺寸（单位：B）
-	RtdbParamServerIpcSize = RtdbParam(C.RTDB_PARAM_SERVER_IPC_SIZE)
-
-	// RtdbParamEquationIpcSize 方程式服务进程与其它进程进行交互所使用的共享内存池的字节尺寸（单位：B）
-	RtdbParamEquationIpcSize = RtdbParam(C.RTDB_PARAM_EQUATION_IPC_SIZE)
-
-	// RtdbParamHashTableSize 标签点求余哈希表的尺寸
-	RtdbParamHashTableSize = RtdbParam(C.RTDB_PARAM_HASH_TABLE_SIZE)
-
-	// RtdbParamTagDeleteTimes 可整库删除标签点的次数
-	RtdbParamTagDeleteTimes = RtdbParam(C.RTDB_PARAM_TAG_DELETE_TIMES)
-
-	// RtdbParamServerPort 网络服务独立服务器端口
-	RtdbParamServerPort = RtdbParam(C.RTDB_PARAM_SERVER_PORT)
-
-	// RtdbParamServerSenderPort 网络服务镜像发送端口
-	RtdbParamServerSenderPort = RtdbParam(C.RTDB_PARAM_SERVER_SENDER_PORT)
-
-	// RtdbParamServerReceiverPort 网络服务镜像接收端口
-	RtdbParamServerReceiverPort = RtdbParam(C.RTDB_PARAM_SERVER_RECEIVER_PORT)
-
-	// RtdbParamServerMode 网络服务启动模式
-	RtdbParamServerMode = RtdbParam(C.RTDB_PARAM_SERVER_MODE)
-
-	// RtdbParamServerConnectionCount 协议中限定网络服务连接并发数量
-	RtdbParamServerConnectionCount = RtdbParam(C.RTDB_PARAM_SERVER_CONNECTION_COUNT)
-
-	// RtdbParamArvPagesNumber 历史数据缓存中的页数量
-	RtdbParamArvPagesNumber = RtdbParam(C.RTDB_PARAM_ARV_PAGES_NUMBER)
-
-	// RtdbParamArvexPagesNumber 补历史数据缓存中的页数量
-	RtdbParamArvexPagesNumber = RtdbParam(C.RTDB_PARAM_ARVEX_PAGES_NUMBER)
-
-	// RtdbParamExceptionAtServer 是否由服务器进行例外判定
-	RtdbParamExceptionAtServer = RtdbParam(C.RTDB_PARAM_EXCEPTION_AT_SERVER)
-
-	// RtdbParamArvPageRecycleDelay 历史数据缓存页回收延时（毫秒）
-	RtdbParamArvPageRecycleDelay = RtdbParam(C.RTDB_PARAM_ARV_PAGE_RECYCLE_DELAY)
-
-	// RtdbParamExArchiveSize 历史数据存档文件文件自动增长大小（单位：MB）
-	RtdbParamExArchiveSize = RtdbParam(C.RTDB_PARAM_EX_ARCHIVE_SIZE)
-
-	// RtdbParamArchiveBatchSize 历史存储值分段查询个数
-	RtdbParamArchiveBatchSize = RtdbParam(C.RTDB_PARAM_ARCHIVE_BATCH_SIZE)
-
-	// RtdbParamDatafilePagesize 系统数据文件页大小
-	RtdbParamDatafilePagesize = RtdbParam(C.RTDB_PARAM_DATAFILE_PAGESIZE)
-
-	// RtdbParamArvAsyncQueueNormalDoor 历史数据缓存队列中速归档区（单位：百分比）
-	RtdbParamArvAsyncQueueNormalDoor = RtdbParam(C.RTDB_PARAM_ARV_ASYNC_QUEUE_NORMAL_DOOR)
-
-	// RtdbParamIndexAlwaysInMemory 常驻内存的历史数据索引大小（单位：MB）
-	RtdbParamIndexAlwaysInMemory = RtdbParam(C.RTDB_PARAM_INDEX_ALWAYS_IN_MEMORY)
-
-	// RtdbParamDiskMinRestSize 最低可用磁盘空间（单位：MB）
-	RtdbParamDiskMinRestSize = RtdbParam(C.RTDB_PARAM_DISK_MIN_REST_SIZE)
-
-	// RtdbParamMinSizeOfArchive 历史存档文件和附属文件的最小尺寸（单位：MB）
-	RtdbParamMinSizeOfArchive = RtdbParam(C.RTDB_PARAM_MIN_SIZE_OF_ARCHIVE)
-
-	// RtdbParamDelayOfAutoMergeOrArrange 自动合并/整理最小延迟（单位：小时）
-	RtdbParamDelayOfAutoMergeOrArrange = RtdbParam(C.RTDB_PARAM_DELAY_OF_AUTO_MERGE_OR_ARRANGE)
-
-	// RtdbParamStartOfAutoMergeOrArrange 自动合并/整理开始时间（单位：点钟）
-	RtdbParamStartOfAutoMergeOrArrange = RtdbParam(C.RTDB_PARAM_START_OF_AUTO_MERGE_OR_ARRANGE)
-
-	// RtdbParamStopOfAutoMergeOrArrange 自动合并/整理停止时间（单位：点钟）
-	RtdbParamStopOfAutoMergeOrArrange = RtdbParam(C.RTDB_PARAM_STOP_OF_AUTO_MERGE_OR_ARRANGE)
-
-	// RtdbParamStartOfAutoBackup 自动备份开始时间（单位：点钟）
-	RtdbParamStartOfAutoBackup = RtdbParam(C.RTDB_PARAM_START_OF_AUTO_BACKUP)
-
-	// RtdbParamStopOfAutoBackup 自动备份停止时间（单位：点钟）
-	RtdbParamStopOfAutoBackup = RtdbParam(C.RTDB_PARAM_STOP_OF_AUTO_BACKUP)
-
-	// RtdbParamMaxLatencyOfSnapshot 允许服务器时间之后多少小时内的数据进入快照（单位：小时）
-	RtdbParamMaxLatencyOfSnapshot = RtdbParam(C.RTDB_PARAM_MAX_LATENCY_OF_SNAPSHOT)
-
-	// RtdbParamPageAllocatorReserveSize 活动页分配器预留大小（单位：KB）， 0 表示使用操作系统视图大小
-	RtdbParamPageAllocatorReserveSize = RtdbParam(C.RTDB_PARAM_PAGE_ALLOCATOR_RESERVE_SIZE)
-
-	// RtdbParamIncludeSnapshotInQuery 决定取样本值和统计值时，快照是否应该出现在查询结果中
-	RtdbParamIncludeSnapshotInQuery = RtdbParam(C.RTDB_PARAM_INCLUDE_SNAPSHOT_IN_QUERY)
-
-	// RtdbParamLicBlobCount 协议中限定的字符串或BLOB类型标签点数量
-	RtdbParamLicBlobCount = RtdbParam(C.RTDB_PARAM_LIC_BLOB_COUNT)
-
-	// RtdbParamMirrorBufferSize 镜像文件大小（单位：GB）
-	RtdbParamMirrorBufferSize = RtdbParam(C.RTDB_PARAM_MIRROR_BUFFER_SIZE)
-
-	// RtdbParamBlobArvexPagesNumber blob、str补历史的默认缓存页数量
-	RtdbParamBlobArvexPagesNumber = RtdbParam(C.RTDB_PARAM_BLOB_ARVEX_PAGES_NUMBER)
-
-	// RtdbParamMirrorEventQueueCapacity 镜像缓存队列容量
-	RtdbParamMirrorEventQueueCapacity = RtdbParam(C.RTDB_PARAM_MIRROR_EVENT_QUEUE_CAPACITY)
-
-	// RtdbParamNotifyNotEnoughSpace 提示磁盘空间不足，一旦启用，设置为ON，则通过API返回大错误码，否则只记录日志
-	RtdbParamNotifyNotEnoughSpace = RtdbParam(C.RTDB_PARAM_NOTIFY_NOT_ENOUGH_SPACE)
-
-	// RtdbParamArchiveFixedRange 历史数据存档文件的固定时间范围，默认为0表示不使用固定时间范围（单位：分钟）
-	RtdbParamArchiveFixedRange = RtdbParam(C.RTDB_PARAM_ARCHIVE_FIXED_RANGE)
-
-	// RtdbParamOneClinetMaxConnectionCount 单个客户端允许的最大连接数，默认为0表示不限制
-	RtdbParamOneClinetMaxConnectionCount = RtdbParam(C.RTDB_PARAM_ONE_CLINET_MAX_CONNECTION_COUNT)
-
-	// RtdbParamArvPagesCapacity 历史数据缓存所占字节大小，单位：字节
-	RtdbParamArvPagesCapacity = RtdbParam(C.RTDB_PARAM_ARV_PAGES_CAPACITY)
-
-	// RtdbParamArvexPagesCapacity 历史数据补写缓存所占字节大小，单位：字节
-	RtdbParamArvexPagesCapacity = RtdbParam(C.RTDB_PARAM_ARVEX_PAGES_CAPACITY)
-
-	// RtdbParamBlobArvexPagesCapacity blob、string类型标签点历史数据补写缓存所占字节大小，单位：字节
-	RtdbParamBlobArvexPagesCapacity = RtdbParam(C.RTDB_PARAM_BLOB_ARVEX_PAGES_CAPACITY)
-
-	// RtdbParamLockedPagesMem 指定分配给数据库用的内存大小，单位：MB
-	RtdbParamLockedPagesMem = RtdbParam(C.RTDB_PARAM_LOCKED_PAGES_MEM)
-
-	// RtdbParamLicRecycleCount 协议中回收站的容量
-	RtdbParamLicRecycleCount = RtdbParam(C.RTDB_PARAM_LIC_RECYCLE_COUNT)
-
-	// RtdbParamArchivedPolicy 快照数据和补写数据的归档策略
-	RtdbParamArchivedPolicy = RtdbParam(C.RTDB_PARAM_ARCHIVED_POLICY)
-
-	// RtdbParamNetworkIsolationAckByte 网络隔离装置ACK字节
-	RtdbParamNetworkIsolationAckByte = RtdbParam(C.RTDB_PARAM_NETWORK_ISOLATION_ACK_BYTE)
-
-	// RtdbParamEnableLogger 启用日志输出，0为不启用
-	RtdbParamEnableLogger = RtdbParam(C.RTDB_PARAM_ENABLE_LOGGER)
-
-	// RtdbParamLogEncode 启用日志加密，0为不启用
-	RtdbParamLogEncode = RtdbParam(C.RTDB_PARAM_LOG_ENCODE)
-
-	// RtdbParamLoginTry 启用登录失败次数验证，0为不启用
-	RtdbParamLoginTry = RtdbParam(C.RTDB_PARAM_LOGIN_TRY)
-
-	// RtdbParamUserLog 启用用户详细日志，0为不启用
-	RtdbParamUserLog = RtdbParam(C.RTDB_PARAM_USER_LOG)
-
-	// RtdbParamCoverWriteLog 启用日志覆盖写功能，0为不启用
-	RtdbParamCoverWriteLog = RtdbParam(C.RTDB_PARAM_COVER_WRITE_LOG)
-
-	// RtdbParamLicNamedTypeCount 协议中限定的自定义类型标签点数量
-	RtdbParamLicNamedTypeCount = RtdbParam(C.RTDB_PARAM_LIC_NAMED_TYPE_COUNT)
-
-	// RtdbParamMirrorReceiverThreadpoolSize 镜像接收线程数量
-	RtdbParamMirrorReceiverThreadpoolSize = RtdbParam(C.RTDB_PARAM_MIRROR_RECEIVER_THREADPOOL_SIZE)
-
-	// RtdbParamSnapshotUseArchiveInterface 按照补历史流程归档快照数据页
-	RtdbParamSnapshotUseArchiveInterface = RtdbParam(C.RTDB_PARAM_SNAPSHOT_USE_ARCHIVE_INTERFACE)
-
-	// RtdbParamNoArcdataWriteLog 归档无对应存档文件的数据时记录日志
-	RtdbParamNoArcdataWriteLog = RtdbParam(C.RTDB_PARAM_NO_ARCDATA_WRITE_LOG)
-
-	// RtdbParamPutArchiveThreadNum 补历史归档线程数
-	RtdbParamPutArchiveThreadNum = RtdbParam(C.RTDB_PARAM_PUT_ARCHIVE_THREAD_NUM)
-
-	// RtdbParamArvexDataArchivedThreshold 单次补写数据归档阈值
-	RtdbParamArvexDataArchivedThreshold = RtdbParam(C.RTDB_PARAM_ARVEX_DATA_ARCHIVED_THRESHOLD)
-
-	// RtdbParamSnapshotFlushBufferDelay 快照服务的共享缓存刷新到磁盘的周期
-	RtdbParamSnapshotFlushBufferDelay = RtdbParam(C.RTDB_PARAM_SNAPSHOT_FLUSH_BUFFER_DELAY)
-
-	// RtdbParamDataSpeed 查询时使用加速统计
-	RtdbParamDataSpeed = RtdbParam(C.RTDB_PARAM_DATA_SPEED)
-
-	// RtdbParamUseNewPlotAlgo 启用新的曲线算法
-	RtdbParamUseNewPlotAlgo = RtdbParam(C.RTDB_PARAM_USE_NEW_PLOT_ALGO)
-
-	// RtdbParamQueryThreadPoolSize 曲线查询线程池中线程数量
-	RtdbParamQueryThreadPoolSize = RtdbParam(C.RTDB_PARAM_QUERY_THREAD_POOL_SIZE)
-
-	// RtdbParamArchivedValues 使用查询线程池查询历史数据
-	RtdbParamArchivedValues = RtdbParam(C.RTDB_PARAM_ARCHIVED_VALUES)
-
-	// RtdbParamArchivedValuesCount 使用查询线程池查询历史数据的条数
-	RtdbParamArchivedValuesCount = RtdbParam(C.RTDB_PARAM_ARCHIVED_VALUES_COUNT)
-
-	// RtdbParamPoolUseFlag 启用曲线池
-	RtdbParamPoolUseFlag = RtdbParam(C.RTDB_PARAM_POOL_USE_FLAG)
-
-	// RtdbParamPoolOutLogFlag 输出曲线池日志
-	RtdbParamPoolOutLogFlag = RtdbParam(C.RTDB_PARAM_POOL_OUT_LOG_FLAG)
-
-	// RtdbParamPoolTimeUsePoolFlag 使用曲线池缓存计算插值
-	RtdbParamPoolTimeUsePoolFlag = RtdbParam(C.RTDB_PARAM_POOL_TIME_USE_POOL_FLAG)
-
-	// RtdbParamPoolMaxPointCount 曲线池的标签点容量
-	RtdbParamPoolMaxPointCount = RtdbParam(C.RTDB_PARAM_POOL_MAX_POINT_COUNT)
-
-	// RtdbParamPoolOneFileSavePointCount 曲线池每个数据文件的标签点容量
-	RtdbParamPoolOneFileSavePointCount = RtdbParam(C.RTDB_PARAM_POOL_ONE_FILE_SAVE_POINT_COUNT)
-
-	// RtdbParamPoolSaveMemorySize 曲线缓存退出时临时缓冲区大小
-	RtdbParamPoolSaveMemorySize = RtdbParam(C.RTDB_PARAM_POOL_SAVE_MEMORY_SIZE)
-
-	// RtdbParamPoolMinTimeUnitSeconds 曲线池缓存数据当前时间单位
-	RtdbParamPoolMinTimeUnitSeconds = RtdbParam(C.RTDB_PARAM_POOL_MIN_TIME_UNIT_SECONDS)
-
-	// RtdbParamPoolTimeUnitViewRate 曲线池查询数据最小时间单位显示系数
-	RtdbParamPoolTimeUnitViewRate = RtdbParam(C.RTDB_PARAM_POOL_TIME_UNIT_VIEW_RATE)
-
-	// RtdbParamPoolTimerIntervalSeconds 曲线池定时器刷新周期
-	RtdbParamPoolTimerIntervalSeconds = RtdbParam(C.RTDB_PARAM_POOL_TIMER_INTERVAL_SECONDS)
-
-	// RtdbParamPoolPerfTimerIntervalSeconds 曲线池性能计算点刷新周期
-	RtdbParamPoolPerfTimerIntervalSeconds = RtdbParam(C.RTDB_PARAM_POOL_PERF_TIMER_INTERVAL_SECONDS)
-
-	// RtdbParamArchiveInitFileSize 存档文件初始大小
-	RtdbParamArchiveInitFileSize = RtdbParam(C.RTDB_PARAM_ARCHIVE_INIT_FILE_SIZE)
-
-	// RtdbParamArchiveIncreaseMode 存档文件增长模式
-	RtdbParamArchiveIncreaseMode = RtdbParam(C.RTDB_PARAM_ARCHIVE_INCREASE_MODE)
-
-	// RtdbParamArchiveIncreaseSize 固定模式下文件增长大小
-	RtdbParamArchiveIncreaseSize = RtdbParam(C.RTDB_PARAM_ARCHIVE_INCREASE_SIZE)
-
-	// RtdbParamArchiveIncreasePercent 百分比模式下增长百分比
-	RtdbParamArchiveIncreasePercent = RtdbParam(C.RTDB_PARAM_ARCHIVE_INCREASE_PERCENT)
-
-	// RtdbParamAllowConvertSklToRbtIndex 跳跃链表转换到红黑树
-	RtdbParamAllowConvertSklToRbtIndex = RtdbParam(C.RTDB_PARAM_ALLOW_CONVERT_SKL_TO_RBT_INDEX)
-
-	// RtdbParamEarlyDataTime 冷数据时间
-	RtdbParamEarlyDataTime = RtdbParam(C.RTDB_PARAM_EARLY_DATA_TIME)
-
-	// RtdbParamEarlyIndexTime 自动转换索引时间
-	RtdbParamEarlyIndexTime = RtdbParam(C.RTDB_PARAM_EARLY_INDEX_TIME)
-
-	// RtdbParamArrangeRbtTime 整理存档文件时决定索引格式的时间轴
-	RtdbParamArrangeRbtTime = RtdbParam(C.RTDB_PARAM_ARRANGE_RBT_TIME)
-
-	// RtdbParamEnableBigData 将存档文件全部读取到内存中
-	RtdbParamEnableBigData = RtdbParam(C.RTDB_PARAM_ENABLE_BIG_DATA)
-
-	// RtdbParamAutoArrangePercent 自动整理存档文件时的实际使用率
-	RtdbParamAutoArrangePercent = RtdbParam(C.RTDB_PARAM_AUTO_ARRANGE_PERCENT)
-
-	// RtdbParamEarlyArrangeTime 自动整理存档文件的时间
-	RtdbParamEarlyArrangeTime = RtdbParam(C.RTDB_PARAM_EARLY_ARRANGE_TIME)
-
-	// RtdbParamMinAutoArrangeArcfilePercent 自动整理存档文件时的最小使用率
-	RtdbParamMinAutoArrangeArcfilePercent = RtdbParam(C.RTDB_PARAM_MIN_AUTO_ARRANGE_ARCFILE_PERCENT)
-
-	// RtdbParamArrangeArcWithMemory 在内存中整理存档文件
-	RtdbParamArrangeArcWithMemory = RtdbParam(C.RTDB_PARAM_ARRANGE_ARC_WITH_MEMORY)
-
-	// RtdbParamAraangeArcMaxMemPercent 整理存档文件最大内存使用率
-	RtdbParamAraangeArcMaxMemPercent = RtdbParam(C.RTDB_PARAM_ARAANGE_ARC_MAX_MEM_PERCENT)
-
-	// RtdbParamMaxDiskSpacePercent 磁盘最大使用率
-	RtdbParamMaxDiskSpacePercent = RtdbParam(C.RTDB_PARAM_MAX_DISK_SPACE_PERCENT)
-
-	// RtdbParamUseDispath windows 用 linux 已禁用,是否启用转发服务
-	RtdbParamUseDispath = RtdbParam(C.RTDB_PARAM_USE_DISPATH)
-
-	// RtdbParamUseSmartParam windows 用 linux 已禁用,是否使用推荐参数
-	RtdbParamUseSmartParam = RtdbParam(C.RTDB_PARAM_USE_SMART_PARAM)
-
-	// RtdbParamSubscribeSnapshotCount 单连接快照事件订阅个数
-	RtdbParamSubscribeSnapshotCount = RtdbParam(C.RTDB_PARAM_SUBSCRIBE_SNAPSHOT_COUNT)
-
-	// RtdbParamSubscribeQueueSize 订阅事件队列大小
-	RtdbParamSubscribeQueueSize = RtdbParam(C.RTDB_PARAM_SUBSCRIBE_QUEUE_SIZE)
-
-	// RtdbParamSubscribeTimeout 订阅事件超时时间
-	RtdbParamSubscribeTimeout = RtdbParam(C.RTDB_PARAM_SUBSCRIBE_TIMEOUT)
-
-	// RtdbParamMirrorCompressOnoff 镜像报文压缩是否打开
-	RtdbParamMirrorCompressOnoff = RtdbParam(C.RTDB_PARAM_MIRROR_COMPRESS_ONOFF)
-
-	// RtdbParamMirrorCompressType 镜像报文压缩类型
-	RtdbParamMirrorCompressType = RtdbParam(C.RTDB_PARAM_MIRROR_COMPRESS_TYPE)
-
-	// RtdbParamMirrorCompressMin 镜像报文压缩最小值
-	RtdbParamMirrorCompressMin = RtdbParam(C.RTDB_PARAM_MIRROR_COMPRESS_MIN)
-
-	// RtdbParamArchiveRollTime 存档文件滚动时间轴
-	RtdbParamArchiveRollTime = RtdbParam(C.RTDB_PARAM_ARCHIVE_ROLL_TIME)
-
-	// RtdbParamHandleTimeOut 连接超时断开，单位：秒
-	RtdbParamHandleTimeOut = RtdbParam(C.RTDB_PARAM_HANDLE_TIME_OUT)
-
-	// RtdbParamMoveArvTime 移动存档文件时决定移动存档的时间轴
-	RtdbParamMoveArvTime = RtdbParam(C.RTDB_PARAM_MOVE_ARV_TIME)
-
-	// RtdbParamUseNewInterpAlgo 启用新的插值算法
-	RtdbParamUseNewInterpAlgo = RtdbParam(C.RTDB_PARAM_USE_NEW_INTERP_ALGO)
-
-	// RtdbParamEnableReplication 启用双活，0为不启用，1为启用
-	RtdbParamEnableReplication = RtdbParam(C.RTDB_PARAM_ENABLE_REPLICATION)
-
-	// RtdbParamReplicationGroupPort 双活：同步组端口
-	RtdbParamReplicationGroupPort = RtdbParam(C.RTDB_PARAM_REPLICATION_GROUP_PORT)
-
-	// RtdbParamReplicationThreadSize 双活：同步线程数
-	RtdbParamReplicationThreadSize = RtdbParam(C.RTDB_PARAM_REPLICATION_THREAD_SIZE)
-
-	// RtdbParamForceArchiveIncompleteDataPageDelay 强制归档补历史缓存里面未满数据页的延迟时间
-	RtdbParamForceArchiveIncompleteDataPageDelay = RtdbParam(C.RTDB_PARAM_FORCE_ARCHIVE_INCOMPLETE_DATA_PAGE_DELAY)
-
-	// RtdbParamArchiveRollDiskPercentage 存档文件滚动存储空间百分比
-	RtdbParamArchiveRollDiskPercentage = RtdbParam(C.RTDB_PARAM_ARCHIVE_ROLL_DISK_PERCENTAGE)
-
-	// RtdbParamEnableIpv6 启用ipv6设置
-	RtdbParamEnableIpv6 = RtdbParam(C.RTDB_PARAM_ENABLE_IPV6)
-
-	// RtdbParamEnableUseArchivedValue 按条件获取历史值时，是否直接获取条件中点的历史值，0:获取插值，1:获取历史值
-	RtdbParamEnableUseArchivedValue = RtdbParam(C.RTDB_PARAM_ENABLE_USE_ARCHIVED_VALUE)
-
-	// RtdbParamTimestampType 获取服务器时间戳类型
-	RtdbParamTimestampType = RtdbParam(C.RTDB_PARAM_TIMESTAMP_TYPE)
-
-	// RtdbParamArcFilenameUsingDate 是否归档文件使用日期作为文件名
-	RtdbParamArcFilenameUsingDate = RtdbParam(C.RTDB_PARAM_ARC_FILENAME_USING_DATE)
-
-	// RtdbParamLogMaxSpace 日志文件占用的最大磁盘空间
-	RtdbParamLogMaxSpace = RtdbParam(C.RTDB_PARAM_LOG_MAX_SPACE)
-
-	// RtdbParamLogFileSize 单个日志文件大小
-	RtdbParamLogFileSize = RtdbParam(C.RTDB_PARAM_LOG_FILE_SIZE)
-
-	// RtdbParamIgnoreToWriteNoarcbuffer 是否丢弃补历史数据
-	RtdbParamIgnoreToWriteNoarcbuffer = RtdbParam(C.RTDB_PARAM_IGNORE_TO_WRITE_NOARCBUFFER)
-
-	// RtdbParamArchivesCountForCalc 统计存档文件平均大小的存档文件个数
-	RtdbParamArchivesCountForCalc = RtdbParam(C.RTDB_PARAM_ARCHIVES_COUNT_FOR_CALC)
-
-	// RtdbParamMaxBlobSize blob、str类型数据在数据库中允许的最大长度
-	RtdbParamMaxBlobSize = RtdbParam(C.RTDB_PARAM_MAX_BLOB_SIZE)
-)
-
-func (rp RtdbParam) Desc() string {
-	switch rp {
-	case RtdbParamTableFile:
-		return "标签点表文件全路径，字符串最大长度为 RTDB_MAX_PATH"
-	case RtdbParamBaseFile:
-		return "基本标签点文件全路径，字符串最大长度为 RTDB_MAX_PATH"
-	case RtdbParamScanFile:
-		return "采集标签点文件全路径，字符串最大长度为 RTDB_MAX_PATH"
-	case RtdbParamCalcFile:
-		return "计算标签点文件全路径，字符串最大长度为 RTDB_MAX_PATH"
-	case RtdbParamSnapFile:
-		return "标签点快照文件全路径，字符串最大长度为 RTDB_MAX_PATH"
-	case RtdbParamLicFile:
-		return "协议文件全路径，字符串最大长度为 RTDB_MAX_PATH"
-	case RtdbParamHisFile:
-		return "历史信息文件全路径，字符串最大长度为 RTDB_MAX_PATH"
-	case RtdbParamLogDir:
-		return "服务器端日志文件全路径，字符串最大长度为 RTDB_MAX_PATH"
-	case RtdbParamUserFile:
-		return "用户权限信息文件全路径，字符串最大长度为 RTDB_MAX_PATH"
-	case RtdbParamServerFile:
-		return "网络服务进程与其它进程交互所用的共享内存文件，字符串最大长度为 RTDB_MAX_PATH"
-	case RtdbParamEqautionFile:
-		return "方程式服务进程与其它进程交互所用的共享内存文件，字符串最大长度为 RTDB_MAX_PATH"
-	case RtdbParamArvPagesFile:
-		return "历史数据缓存文件全路径，字符串最大长度为 RTDB_MAX_PATH"
-	case RtdbParamArvexPagesFile:
-		return "补历史数据缓存文件全路径，字符串最大长度为 RTDB_MAX_PATH"
-	case RtdbParamArvexPagesBlobFile:
-		return "补历史数据blob、str缓存文件全路径，字符串最大长度为 RTDB_MAX_PATH"
-	case RtdbParamAuthFile:
-		return "信任连接段信息文件路径，字符串最大长度为 RTDB_MAX_PATH"
-	case RtdbParamRecycledBaseFile:
-		return "可回收基本标签点文件全路径，字符串最大长度为 RTDB_MAX_PATH"
-	case RtdbParamRecycledScanFile:
-		return "可回收采集标签点文件全路径，字符串最大长度为 RTDB_MAX_PATH"
-	case RtdbParamRecycledCalcFile:
-		return "可回收计算标签点文件全路径，字符串最大长度为 RTDB_MAX_PATH"
-	case RtdbParamAutoBackupPath:
-		return "自动备份目的地全路径，必须以“\\”或“/”结束，字符串最大长度为 RTDB_MAX_PATH"
-	case RtdbParamServerSenderIp:
-		return "镜像发送地址，字符串最大长度为 RTDB_MAX_HOSTNAME_SIZE"
-	case RtdbParamBlacklistFile:
-		return "连接黑名单文件路径，字符串最大长度为 RTDB_MAX_PATH"
-	case RtdbParamDbVersion:
-		return "数据库版本"
-	case RtdbParamLicUser:
-		return "授权单位"
-	case RtdbParamLicType:
-		return "授权方式"
-	case RtdbParamIndexDir:
-		return "索引文件存放目录，字符串最大长度为 RTDB_MAX_PATH"
-	case RtdbParamMirrorBufferPath:
-		return "镜像缓存文件全路径，字符串最大长度为 RTDB_MAX_PATH"
-	case RtdbParamMirrorExBufferPath:
-		return "补写镜像缓存文件全路径，字符串最大长度为 RTDB_MAX_PATH"
-	case RtdbParamEqautionPathFile:
-		return "方程式长度超过规定长度时进行保存的文件全路径，字符串最大长度为 RTDB_MAX_PATH"
-	case RtdbParamTagsFile:
-		return "标签点关键属性文件全路径，字符串最大长度为 RTDB_MAX_PATH"
-	case RtdbParamRecycledSnapFile:
-		return "可回收标签点快照事件文件全路径，字符串最大长度为 RTDB_MAX_PATH"
-	case RtdbParamSwapPageFile:
-		return "历史数据交换页文件全路径，字符串最大长度为 RTDB_MAX_PATH"
-	case RtdbParamPageAllocatorFile:
-		return "活动存档数据页分配器文件全路径，字符串最大长度为 RTDB_MAX_PATH, 该系统配置项2.1版数据库在使用，3.0数据库已去掉，但为了保证系统选项索引号, 与2.1一致，此处不能去掉。便于java sdk统一调用"
-	case RtdbParamNamedTypeFile:
-		return "自定义类型配置信息全路径，字符串最大长度为 RTDB_MAX_PATH"
-	case RtdbParamStrblobMirrorPath:
-		return "BLOB/STRING镜像数据缓存文件全路径，字符串最大长度为 RTDB_MAX_PATH"
-	case RtdbParamStrblobMirrorExPath:
-		return "补写BLOB/STRING镜像数据缓存文件全路径，字符串最大长度为 RTDB_MAX_PATH"
-	case RtdbParamBufferDir:
-		return "临时数据缓存路径"
-	case RtdbParamPoolCacheFlie:
-		return "曲线池索引文件全路径，字符串最大长度为 RTDB_MAX_PATH"
-	case RtdbParamPoolDataFileDir:
-		return "曲线池缓存文件目录，字符串最大长度为 RTDB_MAX_PATH"
-	case RtdbParamArchiveFilePath:
-		return "存档文件低速存储区路径，字符串最大长度为 RTDB_MAX_PATH"
-	case RtdbParamLicVersionType:
-		return "授权版本"
-	case RtdbParamAutoMovePath:
-		return "自动移动目的地全路径，必须以“\\”或“/”结束，字符串最大长度为 RTDB_MAX_PATH"
-	case RtdbParamReplicationBufferPath:
-		return "双活：数据同步缓存文件全路径，字符串最大长度为 RTDB_MAX_PATH"
-	case RtdbParamReplicationExBufferPath:
-		return "双活：数据同步补写数据缓存文件全路径，字符串最大长度为 RTDB_MAX_PATH"
-	case RtdbParamStrblobReplicationBufferPath:
-		return "双活：数据同步BLOB/STRING数据缓存文件全路径，字符串最大长度为 RTDB_MAX_PATH"
-	case RtdbParamStrblobReplicationExBufferPath:
-		return "双活：数据同步补写BLOB/STRING数据缓存文件全路径，字符串最大长度为 RTDB_MAX_PATH"
-	case RtdbParamReplicationGroupIp:
-		return "双活：同步组地址，字符串最大长度为 RTDB_MAX_HOSTNAME_SIZE"
-	case RtdbParamArcFilenamePrefixWhenUsingDate:
-		return "是否归档文件使用日期作为文件名"
-	case RtdbParamHotArchiveFilePath:
-		return "存档文件高速存储区路径，字符串最大长度为 RTDB_MAX_PATH"
-	case RtdbParamLicTablesCount:
-		return "协议中限定的标签点表数量"
-	case RtdbParamLicTagsCount:
-		return "协议中限定的所有标签点数量"
-	case RtdbParamLicScanCount:
-		return "协议中限定的采集标签点数量"
-	case RtdbParamLicCalcCount:
-		return "协议中限定的计算标签点数量"
-	case RtdbParamLicArchicveCount:
-		return "协议中限定的历史存档文件数量"
-	case RtdbParamServerIpcSize:
-		return "网络服务进程与其它进程进行交互所使用的共享内存池的字节尺寸（单位：B）"
-	case RtdbParamEquationIpcSize:
-		return "方程式服务进程与其它进程进行交互所使用的共享内存池的字节尺寸（单位：B）"
-	case RtdbParamHashTableSize:
-		return "标签点求余哈希表的尺寸"
-	case RtdbParamTagDeleteTimes:
-		return "可整库删除标签点的次数"
-	case RtdbParamServerPort:
-		return "网络服务独立服务器端口"
-	case RtdbParamServerSenderPort:
-		return "网络服务镜像发送端口"
-	case RtdbParamServerReceiverPort:
-		return "网络服务镜像接收端口"
-	case RtdbParamServerMode:
-		return "网络服务启动模式"
-	case RtdbParamServerConnectionCount:
-		return "协议中限定网络服务连接并发数量"
-	case RtdbParamArvPagesNumber:
-		return "历史数据缓存中的页数量"
-	case RtdbParamArvexPagesNumber:
-		return "补历史数据缓存中的页数量"
-	case RtdbParamExceptionAtServer:
-		return "是否由服务器进行例外判定"
-	case RtdbParamArvPageRecycleDelay:
-		return "历史数据缓存页回收延时（毫秒）"
-	case RtdbParamExArchiveSize:
-		return "历史数据存档文件文件自动增长大小（单位：MB）"
-	case RtdbParamArchiveBatchSize:
-		return "历史存储值分段查询个数"
-	case RtdbParamDatafilePagesize:
-		return "系统数据文件页大小"
-	case RtdbParamArvAsyncQueueNormalDoor:
-		return "历史数据缓存队列中速归档区（单位：百分比）"
-	case RtdbParamIndexAlwaysInMemory:
-		return "常驻内存的历史数据索引大小（单位：MB）"
-	case RtdbParamDiskMinRestSize:
-		return "最低可用磁盘空间（单位：MB）"
-	case RtdbParamMinSizeOfArchive:
-		return "历史存档文件和附属文件的最小尺寸（单位：MB）"
-	case RtdbParamDelayOfAutoMergeOrArrange:
-		return "自动合并/整理最小延迟（单位：小时）"
-	case RtdbParamStartOfAutoMergeOrArrange:
-		return "自动合并/整理开始时间（单位：点钟）"
-	case RtdbParamStopOfAutoMergeOrArrange:
-		return "自动合并/整理停止时间（单位：点钟）"
-	case RtdbParamStartOfAutoBackup:
-		return "自动备份开始时间（单位：点钟）"
-	case RtdbParamStopOfAutoBackup:
-		return "自动备份停止时间（单位：点钟）"
-	case RtdbParamMaxLatencyOfSnapshot:
-		return "允许服务器时间之后多少小时内的数据进入快照（单位：小时）"
-	case RtdbParamPageAllocatorReserveSize:
-		return "活动页分配器预留大小（单位：KB）， 0 表示使用操作系统视图大小"
-	case RtdbParamIncludeSnapshotInQuery:
-		return "决定取样本值和统计值时，快照是否应该出现在查询结果中"
-	case RtdbParamLicBlobCount:
-		return "协议中限定的字符串或BLOB类型标签点数量"
-	case RtdbParamMirrorBufferSize:
-		return "镜像文件大小（单位：GB）"
-	case RtdbParamBlobArvexPagesNumber:
-		return "blob、str补历史的默认缓存页数量"
-	case RtdbParamMirrorEventQueueCapacity:
-		return "镜像缓存队列容量"
-	case RtdbParamNotifyNotEnoughSpace:
-		return "提示磁盘空间不足，一旦启用，设置为ON，则通过API返回大错误码，否则只记录日志"
-	case RtdbParamArchiveFixedRange:
-		return "历史数据存档文件的固定时间范围，默认为0表示不使用固定时间范围（单位：分钟）"
-	case RtdbParamOneClinetMaxConnectionCount:
-		return "单个客户端允许的最大连接数，默认为0表示不限制"
-	case RtdbParamArvPagesCapacity:
-		return "历史数据缓存所占字节大小，单位：字节"
-	case RtdbParamArvexPagesCapacity:
-		return "历史数据补写缓存所占字节大小，单位：字节"
-	case RtdbParamBlobArvexPagesCapacity:
-		return "blob、string类型标签点历史数据补写缓存所占字节大小，单位：字节"
-	case RtdbParamLockedPagesMem:
-		return "指定分配给数据库用的内存大小，单位：MB"
-	case RtdbParamLicRecycleCount:
-		return "协议中回收站的容量"
-	case RtdbParamArchivedPolicy:
-		return "快照数据和补写数据的归档策略"
-	case RtdbParamNetworkIsolationAckByte:
-		return "网络隔离装置ACK字节"
-	case RtdbParamEnableLogger:
-		return "启用日志输出，0为不启用"
-	case RtdbParamLogEncode:
-		return "启用日志加密，0为不启用"
-	case RtdbParamLoginTry:
-		return "启用登录失败次数验证，0为不启用"
-	case RtdbParamUserLog:
-		return "启用用户详细日志，0为不启用"
-	case RtdbParamCoverWriteLog:
-		return "启用日志覆盖写功能，0为不启用"
-	case RtdbParamLicNamedTypeCount:
-		return "协议中限定的自定义类型标签点数量"
-	case RtdbParamMirrorReceiverThreadpoolSize:
-		return "镜像接收线程数量"
-	case RtdbParamSnapshotUseArchiveInterface:
-		return "按照补历史流程归档快照数据页"
-	case RtdbParamNoArcdataWriteLog:
-		return "归档无对应存档文件的数据时记录日志"
-	case RtdbParamPutArchiveThreadNum:
-		return "补历史归档线程数"
-	case RtdbParamArvexDataArchivedThreshold:
-		return "单次补写数据归档阈值"
-	case RtdbParamSnapshotFlushBufferDelay:
-		return "快照服务的共享缓存刷新到磁盘的周期"
-	case RtdbParamDataSpeed:
-		return "查询时使用加速统计"
-	case RtdbParamUseNewPlotAlgo:
-		return "启用新的曲线算法"
-	case RtdbParamQueryThreadPoolSize:
-		return "曲线查询线程池中线程数量"
-	case RtdbParamArchivedValues:
-		return "使用查询线程池查询历史数据"
-	case RtdbParamArchivedValuesCount:
-		return "使用查询线程池查询历史数据的条数"
-	case RtdbParamPoolUseFlag:
-		return "启用曲线池"
-	case RtdbParamPoolOutLogFlag:
-		return "输出曲线池日志"
-	case RtdbParamPoolTimeUsePoolFlag:
-		return "使用曲线池缓存计算插值"
-	case RtdbParamPoolMaxPointCount:
-		return "曲线池的标签点容量"
-	case RtdbParamPoolOneFileSavePointCount:
-		return "曲线池每个数据文件的标签点容量"
-	case RtdbParamPoolSaveMemorySize:
-		return "曲线缓存退出时临时缓冲区大小"
-	case RtdbParamPoolMinTimeUnitSeconds:
-		return "曲线池缓存数据当前时间单位"
-	case RtdbParamPoolTimeUnitViewRate:
-		return "曲线池查询数据最小时间单位显示系数"
-	case RtdbParamPoolTimerIntervalSeconds:
-		return "曲线池定时器刷新周期"
-	case RtdbParamPoolPerfTimerIntervalSeconds:
-		return "曲线池性能计算点刷新周期"
-	case RtdbParamArchiveInitFileSize:
-		return "存档文件初始大小"
-	case RtdbParamArchiveIncreaseMode:
-		return "存档文件增长模式"
-	case RtdbParamArchiveIncreaseSize:
-		return "固定模式下文件增长大小"
-	case RtdbParamArchiveIncreasePercent:
-		return "百分比模式下增长百分比"
-	case RtdbParamAllowConvertSklToRbtIndex:
-		return "跳跃链表转换到红黑树"
-	case RtdbParamEarlyDataTime:
-		return "冷数据时间"
-	case RtdbParamEarlyIndexTime:
-		return "自动转换索引时间"
-	case RtdbParamArrangeRbtTime:
-		return "整理存档文件时决定索引格式的时间轴"
-	case RtdbParamEnableBigData:
-		return "将存档文件全部读取到内存中"
-	case RtdbParamAutoArrangePercent:
-		return "自动整理存档文件时的实际使用率"
-	case RtdbParamEarlyArrangeTime:
-		return "自动整理存档文件的时间"
-	case RtdbParamMinAutoArrangeArcfilePercent:
-		return "自动整理存档文件时的最小使用率"
-	case RtdbParamArrangeArcWithMemory:
-		return "在内存中整理存档文件"
-	case RtdbParamAraangeArcMaxMemPercent:
-		return "整理存档文件最大内存使用率"
-	case RtdbParamMaxDiskSpacePercent:
-		return "磁盘最大使用率"
-	case RtdbParamUseDispath:
-		return "windows 用 linux 已禁用,是否启用转发服务"
-	case RtdbParamUseSmartParam:
-		return "windows 用 linux 已禁用,是否使用推荐参数"
-	case RtdbParamSubscribeSnapshotCount:
-		return "单连接快照事件订阅个数"
-	case RtdbParamSubscribeQueueSize:
-		return "订阅事件队列大小"
-	case RtdbParamSubscribeTimeout:
-		return "订阅事件超时时间"
-	case RtdbParamMirrorCompressOnoff:
-		return "镜像报文压缩是否打开"
-	case RtdbParamMirrorCompressType:
-		return "镜像报文压缩类型"
-	case RtdbParamMirrorCompressMin:
-		return "镜像报文压缩最小值"
-	case RtdbParamArchiveRollTime:
-		return "存档文件滚动时间轴"
-	case RtdbParamHandleTimeOut:
-		return "连接超时断开，单位：秒"
-	case RtdbParamMoveArvTime:
-		return "移动存档文件时决定移动存档的时间轴"
-	case RtdbParamUseNewInterpAlgo:
-		return "启用新的插值算法"
-	case RtdbParamEnableReplication:
-		return "启用双活，0为不启用，1为启用"
-	case RtdbParamReplicationGroupPort:
-		return "双活：同步组端口"
-	case RtdbParamReplicationThreadSize:
-		return "双活：同步线程数"
-	case RtdbParamForceArchiveIncompleteDataPageDelay:
-		return "强制归档补历史缓存里面未满数据页的延迟时间"
-	case RtdbParamArchiveRollDiskPercentage:
-		return "存档文件滚动存储空间百分比"
-	case RtdbParamEnableIpv6:
-		return "启用ipv6设置"
-	case RtdbParamEnableUseArchivedValue:
-		return "按条件获取历史值时，是否直接获取条件中点的历史值，0:获取插值，1:获取历史值"
-	case RtdbParamTimestampType:
-		return "获取服务器时间戳类型"
-	case RtdbParamArcFilenameUsingDate:
-		return "是否归档文件使用日期作为文件名"
-	case RtdbParamLogMaxSpace:
-		return "日志文件占用的最大磁盘空间"
-	case RtdbParamLogFileSize:
-		return "单个日志文件大小"
-	case RtdbParamIgnoreToWriteNoarcbuffer:
-		return "是否丢弃补历史数据"
-	case RtdbParamArchivesCountForCalc:
-		return "统计存档文件平均大小的存档文件个数"
-	case RtdbParamMaxBlobSize:
-		return "blob、str类型数据在数据库中允许的最大长度"
-	default:
-		return "未知系统参数"
-	}
-}
-
-// ParamString 字符串类型系统参数
-type ParamString string
-
-// ParamInt 数值类型系统参数
-type ParamInt uint32
-
-// RtdbConst 一些常量
-type RtdbConst int32
-
-const (
-	// RtdbConstTagSize 标签点名称占用字节数
-	RtdbConstTagSize = RtdbConst(C.RTDB_TAG_SIZE)
-
-	// RtdbConstDescSize 标签点描述占用字节数
-	RtdbConstDescSize = RtdbConst(C.RTDB_DESC_SIZE)
-
-	// RtdbConstUnitSize 标签点单位占用字节数
-	RtdbConstUnitSize = RtdbConst(C.RTDB_UNIT_SIZE)
-
-	// RtdbConstUserSize 用户名占用字节数
-	RtdbConstUserSize = RtdbConst(C.RTDB_USER_SIZE)
-
-	// RtdbConstSourceSize 标签点数据源占用字节数
-	RtdbConstSourceSize = RtdbConst(C.RTDB_SOURCE_SIZE)
-
-	// RtdbConstInstrumentSize 标签点所属设备占用字节数
-	RtdbConstInstrumentSize = RtdbConst(C.RTDB_INSTRUMENT_SIZE)
-
-	// RtdbConstLocationsSize  采集标签点位址个数
-	RtdbConstLocationsSize = RtdbConst(C.RTDB_LOCATIONS_SIZE)
-
-	// RtdbConstUserintSize  采集标签点用户自定义整数个数
-	RtdbConstUserintSize = RtdbConst(C.RTDB_USERINT_SIZE)
-
-	// RtdbConstUserrealSize  采集标签点用户自定义浮点数个数
-	RtdbConstUserrealSize = RtdbConst(C.RTDB_USERREAL_SIZE)
-
-	// RtdbConstEquationSize 计算标签点方程式占用字节数
-	RtdbConstEquationSize = RtdbConst(C.RTDB_EQUATION_SIZE)
-
-	// RtdbConstTypeNameSize 自定义类型名称占用字节数
-	RtdbConstTypeNameSize = RtdbConst(C.RTDB_TYPE_NAME_SIZE)
-
-	// RtdbConstPackOfSnapshot   事件快照备用字节空间
-	RtdbConstPackOfSnapshot = RtdbConst(C.RTDB_PACK_OF_SNAPSHOT)
-
-	// RtdbConstPackOfPoint 标签点备用字节空间
-	RtdbConstPackOfPoint = RtdbConst(C.RTDB_PACK_OF_POINT)
-
-	// RtdbConstPackOfBasePoint 基本标签点备用字节空间
-	RtdbConstPackOfBasePoint = RtdbConst(C.RTDB_PACK_OF_BASE_POINT)
-
-	// RtdbConstPackOfScan 采集标签点备用字节空间
-	RtdbConstPackOfScan = RtdbConst(C.RTDB_PACK_OF_SCAN)
-
-	// RtdbConstPackOfCalc 计算标签点备用字节空间
-	RtdbConstPackOfCalc = RtdbConst(C.RTDB_PACK_OF_CALC)
-
-	// RtdbConstFileNameSize 文件名字符串字节长度
-	RtdbConstFileNameSize = RtdbConst(C.RTDB_FILE_NAME_SIZE)
-
-	// RtdbConstPathSize  路径字符串字节长度
-	RtdbConstPathSize = RtdbConst(C.RTDB_PATH_SIZE)
-
-	// RtdbConstMaxUserCount 最大用户个数
-	RtdbConstMaxUserCount = RtdbConst(C.RTDB_MAX_USER_COUNT)
-
-	// RtdbConstMaxAuthCount 最大信任连接段个数
-	RtdbConstMaxAuthCount = RtdbConst(C.RTDB_MAX_AUTH_COUNT)
-
-	// RtdbConstMaxBlacklistLen 连接黑名单最大长度
-	RtdbConstMaxBlacklistLen = RtdbConst(C.RTDB_MAX_BLACKLIST_LEN)
-
-	// RtdbConstMaxSubscribeSnapshots  单个连接最大订阅快照数量
-	RtdbConstMaxSubscribeSnapshots = RtdbConst(C.RTDB_MAX_SUBSCRIBE_SNAPSHOTS)
-
-	// RtdbConstApiServerDescriptionLen  API_SERVER中，decription描述字段的长度
-	RtdbConstApiServerDescriptionLen = RtdbConst(C.RTDB_API_SERVER_DESCRIPTION_LEN)
-
-	// RtdbConstMinEquationSize 缩减长度后的方程式占用字节数
-	RtdbConstMinEquationSize = RtdbConst(C.RTDB_MIN_EQUATION_SIZE)
-
-	// RtdbConstPackOfMinCalc 缩减长度后的计算标签点备用字节空间
-	RtdbConstPackOfMinCalc = RtdbConst(C.RTDB_PACK_OF_MIN_CALC)
+//go:build cgo
 
-	// RtdbConstMaxEquationSize 最大长度的方程式占用字节数
-	RtdbConstMaxEquationSize = RtdbConst(C.RTDB_MAX_EQUATION_SIZE)
-
-	// RtdbConstPackOfMaxCalc 最大长度的计算标签点备用字节空间
-	RtdbConstPackOfMaxCalc = RtdbConst(C.RTDB_PACK_OF_MAX_CALC)
-
-	// RtdbConstMaxJsonSize  允许的json字符串的最大长度
-	RtdbConstMaxJsonSize = RtdbConst(C.RTDB_MAX_JSON_SIZE)
-
-	// RtdbConstIpv6AddrSize ipv6地址空间大小
-	RtdbConstIpv6AddrSize = RtdbConst(C.RTDB_IPV6_ADDR_SIZE)
-
-	// RtdbConstOutputPluginLibVersionLength 输入输出适配器插件库版本号长度64Bytes
-	RtdbConstOutputPluginLibVersionLength = RtdbConst(C.RTDB_OUTPUT_PLUGIN_LIB_VERSION_LENGTH)
-
-	// RtdbConstOutputPluginLibNameLength  输入输出适配器插件库名长度128Bytes
-	RtdbConstOutputPluginLibNameLength = RtdbConst(C.RTDB_OUTPUT_PLUGIN_LIB_NAME_LENGTH)
-
-	// RtdbConstOutputPluginActorNameLength  输入输出适配器执行实例长度128Bytes
-	RtdbConstOutputPluginActorNameLength = RtdbConst(C.RTDB_OUTPUT_PLUGIN_ACTOR_NAME_LENGTH)
-
-	// RtdbConstOutputPluginNameLength 输入输出适配器插件名长度255Bytes
-	RtdbConstOutputPluginNameLength = RtdbConst(C.RTDB_OUTPUT_PLUGIN_NAME_LENGTH)
-
-	// RtdbConstOutputPluginDirLength 输入输出适配器路径长度255Bytes
-	RtdbConstOutputPluginDirLength = RtdbConst(C.RTDB_OUTPUT_PLUGIN_DIR_LENGTH)
+package rtdb_api
 
-	// RtdbConstPerOfUsefulMemSize 历史数据缓存/补历史数据缓存/blob补历史数据缓存/内存大小上限占可用内存百分比值占用的字节数
-	RtdbConstPerOfUsefulMemSize = RtdbConst(C.RTDB_PER_OF_USEFUL_MEM_SIZE)
+// #cgo CFLAGS: -DPNG_DEBUG=1 -I./cinclude
+// #cgo CXXFLAGS: -std=c++11
+// #include <stdlib.h>
+// #include "api.h"
+// #include "gofn.h"
+import "C"
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"strings"
+	"time"
+	"unsafe"
 )
 
-// DateTimeType 32位时间戳类型，秒级时间戳
-type DateTimeType C.rtdb_datetime_type
-
-// TimestampType 64位时间戳类型，秒级时间戳
-type TimestampType C.rtdb_timestamp_type
-
-// SubtimeType 时间戳，小于秒的部分，根据设置的全局时间戳精度，表示毫秒、微秒、纳秒的部分
-type SubtimeType C.rtdb_subtime_type
-
-// RtdbHostConnectInfo 连接到RTDB数据库服务器的连接信息
-// 备注， IPv6版本兼容此 RtdbHostConnectInfo ， 因此暂时注释掉
-// type RtdbHostConnectInfo struct {
-// 	IpAddr      int32        // 连接的客户端IP地址
-// 	Port        uint16       // 连接端口
-// 	Job         int32        // 连接最近处理的任务
-// 	JobTime     DateTimeType // 最近处理任务的时间
-// 	ConnectTime DateTimeType // 客户端连接时间
-// 	Client      string       // 连接的客户端主机名称
-// 	Process     string       // 连接的客户端程序名
-// 	User        string       // 登录的用户
-// 	Length      int32        // 记录用户名长度，用于加密传输
-// }
-// func cToRtdbHostConnectInfo(cInfo *C.RTDB_HOST_CONNECT_INFO) RtdbHostConnectInfo {
-// 	goInfo := RtdbHostConnectInfo{
-// 		IpAddr:      int32(cInfo.ipaddr),
-// 		Port:        uint16(cInfo.port),
-// 		Job:         int32(cInfo.job),
-// 		JobTime:     DateTimeType(cInfo.job_time),
-// 		ConnectTime: DateTimeType(cInfo.connect_time),
-// 		Client:      CCharArrayToString(&cInfo.client[0], len(cInfo.client)),
-// 		Process:     CCharArrayToString(&cInfo.process[0], len(cInfo.process)),
-// 		User:        CCharArrayToString(&cInfo.user[0], len(cInfo.user)),
-// 		Length:      int32(cInfo.length),
-// 	}
-// 	return goInfo
-// }
+// loadNativeLibrary 加载指定路径的动态库，失败时保留原来已加载的动态库
+func loadNativeLibrary(path string) error {
+	ok := C.int(0)
+	if runtime.GOOS == "windows" {
+		cPath, err := UTF16PtrFromString(path)
+		if err != nil {
+			return errors.New("字符转换失败：" + err.Error())
+		}
+		ok = C.load_library_windows((*C.wchar_t)(unsafe.Pointer(cPath)))
+	} else {
+		cPath := C.CString(path)
+		defer C.free(unsafe.Pointer(cPath))
+		ok = C.load_library_linux(cPath)
+	}
+	if ok == 0 {
+		return fmt.Errorf("加载动态库%s失败：%s", path, C.GoString(C.load_library_error()))
+	}
+	return nil
+}
 
-type RtdbHostConnectInfoIpv6 struct {
-	IpAddr      int32        // 连接的客户端IP地址
-	IpAddr6     string       // ipv6地址
-	Port        uint16       // 连接端口
-	Job         int32        // 连接最近处理的任务
-	JobTime     DateTimeType // 最近处理任务的时间
-	ConnectTime DateTimeType // 客户端连接时间
-	Client      string       // 连接的客户端主机名称
-	Process     string       // 连接的客户端程序名
-	User        string       // 登录的用户
-	Length      int32        // 记录用户名长度，用于加密传输
+// nativeLibraryLoaded 动态库是否已加载
+func nativeLibraryLoaded() bool {
+	return C.library_loaded() != 0
 }
 
 func cToRtdbHostConnectInfoIpv6(cInfo *C.RTDB_HOST_CONNECT_INFO_IPV6) RtdbHostConnectInfoIpv6 {
@@ -4217,34 +58,6 @@ func cToRtdbHostConnectInfoIpv6(cInfo *C.RTDB_HOST_CONNECT_INFO_IPV6) RtdbHostCo
 	return goInfo
 }
 
-// RtdbOsType 操作系统类型
-type RtdbOsType int8
-
-const (
-	RtdbOsWindows = RtdbOsType(C.RTDB_OS_WINDOWS)
-	RtdbOsLinux   = RtdbOsType(C.RTDB_OS_LINUX)
-	RtdbOsInvalid = RtdbOsType(C.RTDB_OS_INVALID)
-)
-
-func (ost RtdbOsType) Desc() string {
-	switch ost {
-	case RtdbOsWindows:
-		return "windows"
-	case RtdbOsLinux:
-		return "linux"
-	case RtdbOsInvalid:
-		return "未知操作系统"
-	default:
-		return "无效OsType"
-	}
-}
-
-// RtdbHandleInfo 连接信息
-type RtdbHandleInfo struct {
-	OsType RtdbOsType // 当前连接数据库的系统，参考 RTDB_OS_TYPE
-	NewDB  Switch     // 当前连接数据库的版本，0(OFF)表示旧版本，1(ON)表示新版本
-}
-
 func cToRtdbHandleInfo(cOsType *C.RTDB_HANDLE_INFO) RtdbHandleInfo {
 	goHandleInfo := RtdbHandleInfo{
 		OsType: RtdbOsType(cOsType.os_type),
@@ -4253,13 +66,6 @@ func cToRtdbHandleInfo(cOsType *C.RTDB_HANDLE_INFO) RtdbHandleInfo {
 	return goHandleInfo
 }
 
-// RtdbUserInfo 用户信息
-type RtdbUserInfo struct {
-	User      string    // 用户名
-	Privilege PrivGroup // 权限
-	IsLocked  Switch    // 是否锁定
-}
-
 func cToRtdbUserInfo(cInfo *C.RTDB_USER_INFO) RtdbUserInfo {
 	goInfo := RtdbUserInfo{
 		User:      CCharArrayToString(&cInfo.user[0], len(cInfo.user)),
@@ -4269,53 +75,6 @@ func cToRtdbUserInfo(cInfo *C.RTDB_USER_INFO) RtdbUserInfo {
 	return goInfo
 }
 
-// BlackList 黑名单
-type BlackList struct {
-	Addr string
-	Mask string
-	Desc string
-}
-
-// AuthorizationsList 白名单
-type AuthorizationsList struct {
-	Addr string
-	Mask string
-	Desc string
-	Priv PrivGroup
-}
-
-// DirItem 目录项，表示目录下面的子条目，可能是子目录也可能是子文件
-type DirItem struct {
-	Path  string        // 文件、子目录全路径
-	IsDir Switch        // ON(1)为目录，OFF(0)为文件
-	ATime TimestampType // 访问时间
-	CTime TimestampType // 建立时间
-	MTime TimestampType // 修改时间
-	Size  int64         // 文件大小
-}
-
-const (
-	// RtdbMaxPath 系统支持的最大路径长度
-	RtdbMaxPath = int32(2048)
-
-	// RtdbMaxHostnameSize 系统支持的最大主机名长度
-	RtdbMaxHostnameSize = int32(1024)
-)
-
-// Quality 质量码
-type Quality int16
-
-// TableID 表ID
-type TableID int32
-
-// RtdbTable 表
-type RtdbTable struct {
-	ID   TableID // 表ID
-	Type int32   // 表类型，暂时无用
-	Name string  // 表名
-	Desc string  // 表描述
-}
-
 func cToRtdbTable(table *C.RTDB_TABLE) RtdbTable {
 	rtn := RtdbTable{
 		ID:   TableID(table.id),
@@ -4326,444 +85,6 @@ func cToRtdbTable(table *C.RTDB_TABLE) RtdbTable {
 	return rtn
 }
 
-// PointID 点ID
-type PointID int32
-
-// RtdbType 数据类型
-type RtdbType int32
-
-const (
-	// RtdbTypeAny 任意类型, 备注，只有标签点搜索的时候可以使用, 如：RawRtdbbSearchExWarp
-	RtdbTypeAny = RtdbType(-1)
-
-	// RtdbTypeBool 布尔类型，0值或1值
-	RtdbTypeBool = RtdbType(C.RTDB_BOOL)
-
-	// RtdbTypeUint8 无符号8位整数，占用1字节
-	RtdbTypeUint8 = RtdbType(C.RTDB_UINT8)
-
-	// RtdbTypeInt8 有符号8位整数，占用1字节
-	RtdbTypeInt8 = RtdbType(C.RTDB_INT8)
-
-	// RtdbTypeChar 单字节字符，占用1字节
-	RtdbTypeChar = RtdbType(C.RTDB_CHAR)
-
-	// RtdbTypeUint16 无符号16位整数，占用2字节
-	RtdbTypeUint16 = RtdbType(C.RTDB_UINT16)
-
-	// RtdbTypeInt16 有符号16位整数，占用2字节
-	RtdbTypeInt16 = RtdbType(C.RTDB_INT16)
-
-	// RtdbTypeUint32 无符号32位整数，占用4字节
-	RtdbTypeUint32 = RtdbType(C.RTDB_UINT32)
-
-	// RtdbTypeInt32 有符号32位整数，占用4字节
-	RtdbTypeInt32 = RtdbType(C.RTDB_INT32)
-
-	// RtdbTypeInt64 有符号64位整数，占用8字节
-	RtdbTypeInt64 = RtdbType(C.RTDB_INT64)
-
-	// RtdbTypeReal16 16位浮点数，占用2字节
-	RtdbTypeReal16 = RtdbType(C.RTDB_REAL16)
-
-	// RtdbTypeReal32 32位单精度浮点数，占用4字节
-	RtdbTypeReal32 = RtdbType(C.RTDB_REAL32)
-
-	// RtdbTypeReal64 64位双精度浮点数，占用8字节
-	RtdbTypeReal64 = RtdbType(C.RTDB_REAL64)
-
-	// RtdbTypeCoor 二维坐标，具有x、y两个维度的浮点数，占用8字节
-	RtdbTypeCoor = RtdbType(C.RTDB_COOR)
-
-	// RtdbTypeString 字符串，长度不超过存储页面大小
-	RtdbTypeString = RtdbType(C.RTDB_STRING)
-
-	// RtdbTypeBlob 二进制数据块，占用字节不超过存储页面大小
-	RtdbTypeBlob = RtdbType(C.RTDB_BLOB)
-
-	// RtdbTypeNamedT 自定义类型，由用户创建时确定字节长度
-	RtdbTypeNamedT = RtdbType(C.RTDB_NAMED_T)
-
-	// RtdbTypeDatetime 时间格式类型
-	RtdbTypeDatetime = RtdbType(C.RTDB_DATETIME)
-
-	// RtdbTypeFp16 定点数，占用2字节
-	RtdbTypeFp16 = RtdbType(C.RTDB_FP16)
-
-	// RtdbTypeFp32 定点数，占用4字节
-	RtdbTypeFp32 = RtdbType(C.RTDB_FP32)
-
-	// RtdbTypeFp64 定点数，占用8字节
-	RtdbTypeFp64 = RtdbType(C.RTDB_FP64)
-)
-
-// RtdbMirror 镜像选项
-type RtdbMirror int8
-
-const (
-	// RtdbMirrorPointOff 镜像关闭
-	RtdbMirrorPointOff = RtdbMirror(C.RTDB_POINT_OFF)
-
-	// RtdbPointSendRecv 镜像收发
-	RtdbPointSendRecv = RtdbMirror(C.RTDB_POINT_SEND_RECV)
-
-	// RtdbPointRecv 镜像接收
-	RtdbPointRecv = RtdbMirror(C.RTDB_POINT_RECV)
-
-	// RtdbPointSend 镜像发送
-	RtdbPointSend = RtdbMirror(C.RTDB_POINT_SEND)
-)
-
-// RtdbPrecision 时间戳精度
-type RtdbPrecision int8
-
-const (
-	// RtdbPrecisionAny 所有时间戳精度，只有标签点搜索的时候可用，例如: RawRtdbbSearchExWarp
-	RtdbPrecisionAny = RtdbPrecision(-1)
-
-	// RtdbPrecisionSecond 秒
-	RtdbPrecisionSecond = RtdbPrecision(0)
-
-	// RtdbPrecisionMilli 毫秒
-	RtdbPrecisionMilli = RtdbPrecision(1)
-
-	// RtdbPrecisionMicro 微秒
-	RtdbPrecisionMicro = RtdbPrecision(2)
-
-	// RtdbPrecisionNano 纳秒
-	RtdbPrecisionNano = RtdbPrecision(3)
-)
-
-// RtdbClass 点类型
-type RtdbClass uint32
-
-const (
-	// RtdbClassBase 基本标签点，所有类别标签点均在基本标签点的属性集上扩展自己的属性集。
-	RtdbClassBase = RtdbClass(C.RTDB_BASE)
-
-	// RtdbClassScan 采集标签点。
-	RtdbClassScan = RtdbClass(C.RTDB_SCAN)
-
-	// RtdbClassCalc 计算标签点
-	RtdbClassCalc = RtdbClass(C.RTDB_CALC)
-
-	// RtdbClassAlarm 报警标签点
-	RtdbClassAlarm = RtdbClass(C.RTDB_ALARM)
-)
-
-// RtdbTrigger 计算标签点触发机制
-type RtdbTrigger uint8
-
-const (
-	// RtdbNullTrigger 无触发
-	RtdbNullTrigger = RtdbTrigger(C.RTDB_NULL_TRIGGER)
-
-	// RtdbEventTrigger 事件触发
-	RtdbEventTrigger = RtdbTrigger(C.RTDB_EVENT_TRIGGER)
-
-	// RtdbTimerTrigger 周期触发
-	RtdbTimerTrigger = RtdbTrigger(C.RTDB_TIMER_TRIGGER)
-
-	// RtdbFixtimeTrigger 定时触发
-	RtdbFixtimeTrigger = RtdbTrigger(C.RTDB_FIXTIME_TRIGGER)
-)
-
-// RtdbTimeCopy 计算结果时间戳参考
-type RtdbTimeCopy uint8
-
-const (
-	// RtdbCalcTime 采用计算时间
-	RtdbCalcTime = RtdbTimeCopy(C.RTDB_CALC_TIME)
-
-	// RtdbLatestTime 采用最晚标签点时间
-	RtdbLatestTime = RtdbTimeCopy(C.RTDB_LATEST_TIME)
-
-	// RtdbEarliestTime 采用最早标签点时间
-	RtdbEarliestTime = RtdbTimeCopy(C.RTDB_EARLIEST_TIME)
-)
-
-// RtdbPoint 基本标签点属性集
-type RtdbPoint struct {
-	// 标签点名称。
-	// 用于在表中唯一标识一个标签点；
-	// 该属性允许被修改；
-	// 命名规则：
-	// 1、第一个字符必须是26个字母之一或数字0-9之一或者"_"、"%"；
-	// 2、不允许使用控制字符，比如换行符或制表符；
-	// 3、不允许使用以下字符（'*'、'''、'?'、';'、'('、')'、'{'、'}'、'['、']'、'|'、'\'、'`'、'''、'"'、'.'）；
-	// 4、字节长度不要超出 \b RTDB_TAG_SIZE，如果超出，系统会自动将后面的字符截断。
-	Tag string
-
-	// 全库唯一标识。
-	// 只读属性，创建标签点时系统会自动为标签点分配的唯一标识，即标签点ID，标签点ID一经创建后永不更改。
-	ID PointID
-
-	// 标签点的数值类型。
-	// 只读属性，在创建标签点时指定。
-	Type RtdbType
-
-	// 标签点所属表ID。
-	Table TableID
-
-	// 有关标签点的描述性文字。
-	// 字节长度不要超出 \b RTDB_DESC_SIZE，多余的部分会被截断。
-	Desc string
-
-	// 工程单位。
-	// 字节长度不要超出 \b RTDB_UNIT_SIZE，多余的部分会被截断。
-	Unit string
-
-	// 是否存档。
-	// 缺省值：ON，1；
-	// ON或1表示存档，OFF或0表示不存档。
-	Archive Switch
-
-	// 数值位数。
-	// 缺省值：-5；
-	// 范围：>=-20、<=10；
-	// 用来控制数值的显示格式；
-	// 如果为0或正数，表示数值的小数位数，如果为负数，表示数值的有效位数。
-	Digits int16
-
-	// 停机状态字（Shutdown）
-	// 缺省值：0；
-	// 定义该点在停机状态下是否补写停机状态值。
-	// 1 表示补写；0 表示不补写。
-	Shutdown Switch
-
-	// 量程下限。
-	// 缺省值：0；
-	// 单位：标签点工程单位。
-	LowLimit float32
-
-	// 量程上限。
-	// 缺省值：100；
-	// 单位：标签点工程单位。
-	HighLimit float32
-
-	// 是否阶跃。
-	// 缺省值：OFF，0；
-	// 该属性决定了中间值的计算是用阶梯还是连续的内插值替换；
-	// 缺省情况下该属性为OFF，即中间值的计算是用内插值替换；
-	// 如果被设置为ON，则中间值的数值同前一个有记录的数值相同。
-	// 在历史数据检索中，本设置可能被外部输入的阶跃开关覆盖。
-	Step Switch
-
-	// 典型值。
-	// 缺省值：50；
-	// 大于等于量程下限，小于等于量程上限。
-	Typical float32
-
-	// 是否压缩。
-	// 缺省值：ON，1；
-	// 如果该属性被关闭（OFF，0），任何到达数据存储服务器Server的数据都会被提交到历史数据库；否则（ON，1），只有满足压缩条件的数据才会被提交到历史数据库。
-	// 需要手工录入的标签点应该将该属性设置为OFF，0。
-	Compress Switch
-
-	// 压缩偏差。
-	// 单位：标签点工程单位；
-	// 缺省值：1；
-	// 当有新的数值被提交到数据存储服务器Server，如果从上一个被提交到历史数据库的数值开始有数值超出了压缩偏差外，则上一个被提交到数据存储服务器Server的数值被视为关键数值；
-	// 该属性与[压缩偏差百分比（the percent of compression deviation）]属性含义相同，该属性与量程（high
-	// limit - low limit）的百分比即[压缩偏差百分比（the percent of compression
-	// deviation）]属性的值；
-	// 对该属性的修改将导致对[压缩偏差百分比（the percent of compression
-	// deviation）]的修改，同样，对[压缩偏差百分比（the percent of compression
-	// deviation）]的修改也将修改该属性，如果两个同时被修改，[压缩偏差百分比（the percent of compression
-	// deviation）]具有更高的优先权。
-	CompDev float32
-
-	// 压缩偏差百分比。
-	// 单位：百分比；
-	// \see compdev。
-	CompDevPercent float32
-
-	// 最大压缩间隔。
-	// 单位：秒；
-	// 缺省值：28800；
-	// 如果某个数值与上一个被提交到历史数据库的数值的时间间隔大于或等于最大压缩间隔，无论是否满足压缩条件，该数值都应该被视为关键数值从而被提交到历史数据库的数据队列；
-	// 数据库中两个标签点间的时间间隔有可能超出该属性值，因为数据存储服务器Server可能长时间接收不到提交的数据，而且任何系统绝对不会自己创造数据。
-	CompTimeMax int32
-
-	// 最短压缩间隔。
-	// 单位：秒；
-	// 缺省值：0；
-	// 如果某个数值与上一个被提交到历史数据库的数值的时间间隔小于最短压缩间隔，该数值会被忽略；
-	// 该属性有降噪（suppress noise）的作用。
-	CompTimeMin int32
-
-	// 例外偏差。
-	// 单位：标签点工程单位；
-	// 缺省值：0.5；
-	// 如果某个数值与上一个被提交到数据存储服务器Server的数值的偏差大于该例外偏差（以数值的工程单位为准），则该数值被视为例外数值，应该被提交到数据存储服务器Server；
-	// 建议例外偏差应该小于等于压缩偏差的一半；
-	// 该属性与[例外偏差百分比（The Percent of Exception Deviation）]属性含义相同，该属性与量程（high
-	// limit - low limit）的百分比即[例外偏差百分比（The Percent of Exception
-	// Deviation）]属性的值；
-	// 对该属性的修改将导致对[例外偏差百分比（The Percent of Exception
-	// Deviation）]的修改，同样，对[例外偏差百分比（The Percent of Exception
-	// Deviation）]的修改也将修改该属性，如果两个同时被修改，[例外偏差百分比（The Percent of Exception
-	// Deviation）]具有更高的优先权。
-	ExcDev float32
-
-	// 例外偏差百分比。
-	// 单位：百分比；
-	// \see excdev。
-	ExcDevPercent float32
-
-	// 最大例外间隔。
-	// 单位：秒；
-	// 缺省值：600；
-	// 如果某个数值与上一个被提交到数据存储服务器Server的数值的时间间隔大于或等于最大例外间隔，无论是否满足例外条件，该数值都应该被视为例外数值从而被提交到数据存储服务器Server；
-	// 数据库中两个标签点间的时间间隔有可能超出该属性值，因为接口可能长时间采集不到数据，而且任何系统绝对不会自己创造数据；
-	// 如果要关闭例外过滤，设置该属性为0即可。
-	ExcTimeMax int32
-
-	// 最短例外间隔。
-	// 单位：秒；
-	// 缺省值：0；
-	// 如果某个数值与上一个被提交到数据存储服务器Server的数值的时间间隔小于最短例外间隔，无论是否满足例外条件，该数值都会被忽略；
-	// 该属性有降噪（suppress noise）的作用。
-	ExcTimeMin int32
-
-	// 标签点类别。
-	// RTDB_CLASS类型的组合，最多可以扩展至32种类型的组合；
-	// 所有类别标签点均继承自"基本"类型标签点。
-	// 不同类别的标签点具有不同的属性集，"采集"类别的标签点具有"设备标签"、"位号"、"自定义整数"和"自定义浮点数"等扩展属性，"计算"类别的标签点具有"扩展描述"、"触发机制"等扩展属性。
-	Class RtdbClass
-
-	// 标签点属性最后一次被修改的时间。
-	ChangeDate DateTimeType
-
-	// 标签点属性最后一次被修改的用户名。
-	Changer string
-
-	// 标签点被创建的时间。
-	CreateDate DateTimeType
-
-	// 标签点创建者的用户名。
-	Creator string
-
-	// 镜像收发控制。
-	// 默认值：关闭，0
-	// 开启镜像收发，1，既接收，又发送
-	// 开启镜像接收，2，只接收，不发送
-	// 开启镜像发送，3，只发送，不接收
-	Mirror RtdbMirror
-
-	// 时间戳精度。
-	// 默认值：秒，0；
-	// 用于设定标签点的历史值在存储中精确到"秒"（0）还是"毫秒/纳秒"（1）。
-	// 标签点一经创建就不允许修改该属性。
-	MilliSecond int8
-
-	// 采集点扩展属性集存储地址索引。
-	ScanIndex uint32
-
-	// 计算点扩展属性集存储地址索引。
-	CalcIndex uint32
-
-	// 报警点扩展属性集存储地址索引。
-	AlarmIndex uint32
-
-	// 标签点全名，格式为“表名称.标签点名称”。
-	TableDotTag string
-
-	// 统计加速。
-	// 默认值：关，0；
-	// 用于设定是否生成标签点统计信息，从而加速历史数据统计过程。
-	Summary Switch
-
-	// 标签点对应自定义类型id，只用标签点类别为自定义类型时，才有意义。
-	NamedTypeID uint16
-
-	// 时间戳精度，0秒、1毫秒、2微秒、3纳秒
-	Precision RtdbPrecision
-}
-
-// NewDefaultPoint 新建基本点结构，使用默认参数
-func NewDefaultPoint(tag string, rtdbType RtdbType, tableID TableID, class RtdbClass, precision RtdbPrecision) *RtdbPoint {
-	rtn := RtdbPoint{
-		Tag:            tag,
-		Type:           rtdbType,
-		Table:          tableID,
-		Desc:           "",
-		Unit:           "",
-		Archive:        ON,
-		Digits:         -5,
-		Shutdown:       OFF,
-		LowLimit:       0,
-		HighLimit:      100,
-		Step:           OFF,
-		Typical:        50,
-		Compress:       ON,
-		CompDev:        1,
-		CompDevPercent: 0,
-		CompTimeMax:    28800,
-		CompTimeMin:    0,
-		ExcDev:         0.5,
-		ExcDevPercent:  0,
-		ExcTimeMax:     600,
-		ExcTimeMin:     0,
-		Class:          class,
-		Mirror:         RtdbMirrorPointOff,
-		Summary:        OFF,
-		NamedTypeID:    0,
-		Precision:      precision,
-	}
-	switch rtn.Precision {
-	case RtdbPrecisionSecond:
-		rtn.MilliSecond = 0
-	case RtdbPrecisionMilli, RtdbPrecisionMicro, RtdbPrecisionNano:
-		rtn.MilliSecond = 1
-	}
-	return &rtn
-}
-
-// NewRtdbPoint 创建基本点结构
-func NewRtdbPoint(
-	tag string, rtdbType RtdbType, tableID TableID, desc, unit string, archive Switch, digits int16,
-	shutdown Switch, lowLimit float32, highLimit float32, step Switch, typical float32, compress Switch,
-	compDev float32, compDevPercent float32, compTimeMax int32, compTimeMin int32,
-	excDev float32, excDevPercent float32, excTimeMax int32, excTimeMin int32, class RtdbClass,
-	mirror RtdbMirror, summary Switch, precision RtdbPrecision,
-) *RtdbPoint {
-	rtn := RtdbPoint{
-		Tag:            tag,
-		Type:           rtdbType,
-		Table:          tableID,
-		Desc:           desc,
-		Unit:           unit,
-		Archive:        archive,
-		Digits:         digits,
-		Shutdown:       shutdown,
-		LowLimit:       lowLimit,
-		HighLimit:      highLimit,
-		Step:           step,
-		Typical:        typical,
-		Compress:       compress,
-		CompDev:        compDev,
-		CompDevPercent: compDevPercent,
-		CompTimeMax:    compTimeMax,
-		CompTimeMin:    compTimeMin,
-		ExcDev:         excDev,
-		ExcDevPercent:  excDevPercent,
-		ExcTimeMax:     excTimeMax,
-		ExcTimeMin:     excTimeMin,
-		Class:          class,
-		Mirror:         mirror,
-		Summary:        summary,
-		Precision:      precision,
-	}
-	switch rtn.Precision {
-	case RtdbPrecisionSecond:
-		rtn.MilliSecond = 0
-	case RtdbPrecisionMilli, RtdbPrecisionMicro, RtdbPrecisionNano:
-		rtn.MilliSecond = 1
-	}
-	return &rtn
-}
-
 func goToCRtdbPoint(p *RtdbPoint) *C.RTDB_POINT {
 	if p == nil {
 		return nil
@@ -4857,47 +178,6 @@ func cToRtdbPoint(p *C.RTDB_POINT) *RtdbPoint {
 	return &rtn
 }
 
-// RtdbScan 采集标签点扩展属性集
-type RtdbScan struct {
-	// 全库唯一标识。0表示无效
-	ID PointID
-
-	// 数据源。
-	// 缺省值：空（NULL）；
-	// 将标签点同某些接口或某些模块相关联；
-	// 每个数据源字符串只允许由26个字母（大小写敏感）和数字（0-9）组成，字节长度不要超出 \b RTDB_SOURCE_SIZE，多余的部分会被截断。
-	Source string
-
-	// 是否采集。
-	// 缺省值：ON，1；
-	// 该属性可能会被某些接口用到，如果该属性被关闭（OFF，0），从接口传来的数据可能不会被报告到数据库。
-	Scan Switch
-
-	// 设备标签。
-	// 缺省值：空（NULL）；
-	// 字节长度不要超出 \b RTDB_INSTRUMENT_SIZE，多余的部分会被截断。
-	Instrument string
-
-	// 共包含五个设备位址，缺省值全部为0。
-	Locations [RtdbConstLocationsSize]int32
-
-	// 共包含两个自定义整数，缺省值全部为0。
-	UserInts [RtdbConstUserintSize]int32
-
-	// 共包含两个自定义单精度浮点数，缺省值全部为0。
-	UserReals [RtdbConstUserrealSize]float32
-}
-
-// NewRtdbScan 新建采集点结构
-func NewRtdbScan(source string, scan Switch, instrument string) *RtdbScan {
-	rtn := RtdbScan{
-		Source:     source,
-		Scan:       scan,
-		Instrument: instrument,
-	}
-	return &rtn
-}
-
 func cToRtdbScan(p *C.RTDB_SCAN_POINT) *RtdbScan {
 	if p == nil {
 		return nil
@@ -4945,41 +225,6 @@ func goToCRtdbScan(p *RtdbScan) *C.RTDB_SCAN_POINT {
 	return &rtn
 }
 
-// RtdbCalc 最大长度计算点扩展属性集
-type RtdbCalc struct {
-	// 全库唯一标识。0表示无效。
-	ID PointID
-
-	// 实时方程式。
-	// 缺省值：空（NULL）；
-	// 字节长度不要超出 \b RTDB_MAX_EQUATION_SIZE，长度超长的方程式将被拒绝设置入库，返回一个错误，避免错误的方程式进入系统，引发不安全因素。
-	Equation string
-
-	// 计算触发机制。枚举值参见 \b RTDB_TRIGGER。
-	// 仅对"计算"类别标签点起作用，用于设置实时方程式服务对单个计算点的计算触发采用"事件触发"还是"周期触发"，
-	// 对于"周期触发"以"事件触发"作为其先决判断条件，如果"事件触发"不满足，则不进行"周期触发"。
-	Trigger RtdbTrigger
-
-	// 计算结果时间戳参考, 枚举值参见 \b RTDB_TIME_COPY
-	// 0: 表示采用计算时间作为计算结果时间戳；
-	// 1: 表示采用输入标签点中的最晚时间作为计算结果时间戳；
-	// 2: 表示采用输入标签点中的最早时间作为计算结果时间戳。
-	TimeCopy RtdbTimeCopy
-
-	// 对于“周期触发”的计算点，设定其计算周期，单位：秒
-	Period int32
-}
-
-func NewRtdbCalc(equation string, trigger RtdbTrigger, timeCopy RtdbTimeCopy, period int32) *RtdbCalc {
-	rtn := RtdbCalc{
-		Equation: equation,
-		Trigger:  trigger,
-		TimeCopy: timeCopy,
-		Period:   period,
-	}
-	return &rtn
-}
-
 func cToRtdbCalc(p *C.RTDB_MAX_CALC_POINT) *RtdbCalc {
 	if p == nil {
 		return nil
@@ -5011,348 +256,6 @@ func goToCRtdbCalc(p *RtdbCalc) *C.RTDB_MAX_CALC_POINT {
 	return &rtn
 }
 
-type RtdbSortFlag uint32
-
-const (
-	// RtdbSortFlagDescend 降序
-	RtdbSortFlagDescend = RtdbSortFlag(C.RTDB_SORT_FLAG_DESCEND)
-
-	// RtdbSortFlagCaseSensitive 大小写敏感
-	RtdbSortFlagCaseSensitive = RtdbSortFlag(C.RTDB_SORT_FLAG_CASE_SENSITIVE)
-
-	// RtdbSortFlagRecycled 用于回收站标签点排序
-	RtdbSortFlagRecycled = RtdbSortFlag(C.RTDB_SORT_FLAG_RECYCLED)
-)
-
-type RtdbSearch int32
-
-const (
-	RtdbSearchAny            = RtdbSearch(C.RTDB_SEARCH_NULL)           //!< 不使用任何标签点属性作为搜索条件
-	RtdbSearchNull           = RtdbSearch(C.RTDB_SEARCH_NULL)           //!< 不使用任何标签点属性作为搜索条件
-	RtdbSearchCompdev        = RtdbSearch(C.RTDB_SEARCH_COMPDEV)        //!< 使用压缩偏差作为搜索条件
-	RtdbSearchCompmax        = RtdbSearch(C.RTDB_SEARCH_COMPMAX)        //!< 最大压缩间隔
-	RtdbSearchCompmin        = RtdbSearch(C.RTDB_SEARCH_COMPMIN)        //!< 最小压缩间隔
-	RtdbSearchExcdev         = RtdbSearch(C.RTDB_SEARCH_EXCDEV)         //!< 例外偏差
-	RtdbSearchExcmax         = RtdbSearch(C.RTDB_SEARCH_EXCMAX)         //!< 最大例外间隔
-	RtdbSearchExcmin         = RtdbSearch(C.RTDB_SEARCH_EXCMIN)         //!< 最小例外间隔
-	RtdbSearchSummary        = RtdbSearch(C.RTDB_SEARCH_SUMMARY)        //!< 是否加速
-	RtdbSearchMirror         = RtdbSearch(C.RTDB_SEARCH_MIRROR)         //!< 是否镜像
-	RtdbSearchCompress       = RtdbSearch(C.RTDB_SEARCH_COMPRESS)       //!< 是否压缩
-	RtdbSearchStep           = RtdbSearch(C.RTDB_SEARCH_STEP)           //!< 是否阶跃
-	RtdbSearchShutdown       = RtdbSearch(C.RTDB_SEARCH_SHUTDOWN)       //!< 是否停机补写
-	RtdbSearchArchive        = RtdbSearch(C.RTDB_SEARCH_ARCHIVE)        //!< 是否归档
-	RtdbSearchChanger        = RtdbSearch(C.RTDB_SEARCH_CHANGER)        //!< 修改人
-	RtdbSearchCreator        = RtdbSearch(C.RTDB_SEARCH_CREATOR)        //!< 创建人
-	RtdbSearchLowlimit       = RtdbSearch(C.RTDB_SEARCH_LOWLIMIT)       //!< 量程下限
-	RtdbSearchHighlimit      = RtdbSearch(C.RTDB_SEARCH_HIGHLIMIT)      //!< 量程上限
-	RtdbSearchTypical        = RtdbSearch(C.RTDB_SEARCH_TYPICAL)        //!< 典型值
-	RtdbSearchChangedate     = RtdbSearch(C.RTDB_SEARCH_CHANGEDATE)     //!< 修改时间
-	RtdbSearchCreatedate     = RtdbSearch(C.RTDB_SEARCH_CREATEDATE)     //!< 创建时间
-	RtdbSearchDigits         = RtdbSearch(C.RTDB_SEARCH_DIGITS)         //!< 数值位数
-	RtdbSearchCompdevpercent = RtdbSearch(C.RTDB_SEARCH_COMPDEVPERCENT) //!< 压缩偏差百分比
-	RtdbSearchExcdevpercent  = RtdbSearch(C.RTDB_SEARCH_EXCDEVPERCENT)  //!< 例外偏差百分比
-	RtdbSearchScan_begin     = RtdbSearch(C.RTDB_SEARCH_SCAN_BEGIN)     //!< 辅助作用，不能作为搜索条件
-	RtdbSearchScan           = RtdbSearch(C.RTDB_SEARCH_SCAN)           //!< 是否采集
-	RtdbSearchLocation1      = RtdbSearch(C.RTDB_SEARCH_LOCATION1)      //!< 设备位址1
-	RtdbSearchLocation2      = RtdbSearch(C.RTDB_SEARCH_LOCATION2)      //!< 设备位址2
-	RtdbSearchLocation3      = RtdbSearch(C.RTDB_SEARCH_LOCATION3)      //!< 设备位址3
-	RtdbSearchLocation4      = RtdbSearch(C.RTDB_SEARCH_LOCATION4)      //!< 设备位址4
-	RtdbSearchLocation5      = RtdbSearch(C.RTDB_SEARCH_LOCATION5)      //!< 设备位址5
-	RtdbSearchUserint1       = RtdbSearch(C.RTDB_SEARCH_USERINT1)       //!< 自定义整数1
-	RtdbSearchUserint2       = RtdbSearch(C.RTDB_SEARCH_USERINT2)       //!< 自定义整数2
-	RtdbSearchUserreal1      = RtdbSearch(C.RTDB_SEARCH_USERREAL1)      //!< 自定义单精度浮点数1
-	RtdbSearchUserreal2      = RtdbSearch(C.RTDB_SEARCH_USERREAL2)      //!< 自定义单精度浮点数2
-	RtdbSearchScan_end       = RtdbSearch(C.RTDB_SEARCH_SCAN_END)       //!< 辅助作用，不能作为搜索条件
-	RtdbSearchCalc_begin     = RtdbSearch(C.RTDB_SEARCH_CALC_BEGIN)     //!< 辅助作用，不能作为搜索条件
-	RtdbSearchEquation       = RtdbSearch(C.RTDB_SEARCH_EQUATION)       //!< 方程式
-	RtdbSearchTrigger        = RtdbSearch(C.RTDB_SEARCH_TRIGGER)        //!< 计算触发机制
-	RtdbSearchTimecopy       = RtdbSearch(C.RTDB_SEARCH_TIMECOPY)       //!< 计算结果时间戳参考
-	RtdbSearchPeriod         = RtdbSearch(C.RTDB_SEARCH_PERIOD)         //!< 计算周期
-	RtdbSearchCalc_end       = RtdbSearch(C.RTDB_SEARCH_CALC_END)       //!< 辅助作用，不能作为搜索条件
-)
-
-func (rs RtdbSearch) Desc() string {
-	switch rs {
-	case RtdbSearchNull:
-		return "不使用任何标签点属性作为搜索条件"
-	case RtdbSearchCompdev:
-		return "使用压缩偏差作为搜索条件"
-	case RtdbSearchCompmax:
-		return "最大压缩间隔"
-	case RtdbSearchCompmin:
-		return "最小压缩间隔"
-	case RtdbSearchExcdev:
-		return "例外偏差"
-	case RtdbSearchExcmax:
-		return "最大例外间隔"
-	case RtdbSearchExcmin:
-		return "最小例外间隔"
-	case RtdbSearchSummary:
-		return "是否统计加速"
-	case RtdbSearchMirror:
-		return "是否镜像"
-	case RtdbSearchCompress:
-		return "是否压缩"
-	case RtdbSearchStep:
-		return "是否阶跃"
-	case RtdbSearchShutdown:
-		return "是否停机补写"
-	case RtdbSearchArchive:
-		return "是否归档"
-	case RtdbSearchChanger:
-		return "修改人"
-	case RtdbSearchCreator:
-		return "创建人"
-	case RtdbSearchLowlimit:
-		return "量程下限"
-	case RtdbSearchHighlimit:
-		return "量程上限"
-	case RtdbSearchTypical:
-		return "典型值"
-	case RtdbSearchChangedate:
-		return "修改时间"
-	case RtdbSearchCreatedate:
-		return "创建时间"
-	case RtdbSearchDigits:
-		return "数值位数"
-	case RtdbSearchCompdevpercent:
-		return "压缩偏差百分比"
-	case RtdbSearchExcdevpercent:
-		return "例外偏差百分比"
-	case RtdbSearchScan_begin:
-		return "辅助作用，不能作为搜索条件"
-	case RtdbSearchScan:
-		return "是否采集"
-	case RtdbSearchLocation1:
-		return "设备位址1"
-	case RtdbSearchLocation2:
-		return "设备位址2"
-	case RtdbSearchLocation3:
-		return "设备位址3"
-	case RtdbSearchLocation4:
-		return "设备位址4"
-	case RtdbSearchLocation5:
-		return "设备位址5"
-	case RtdbSearchUserint1:
-		return "自定义整数1"
-	case RtdbSearchUserint2:
-		return "自定义整数2"
-	case RtdbSearchUserreal1:
-		return "自定义单精度浮点数1"
-	case RtdbSearchUserreal2:
-		return "自定义单精度浮点数2"
-	case RtdbSearchScan_end:
-		return "辅助作用，不能作为搜索条件"
-	case RtdbSearchCalc_begin:
-		return "辅助作用，不能作为搜索条件"
-	case RtdbSearchEquation:
-		return "方程式"
-	case RtdbSearchTrigger:
-		return "计算触发机制"
-	case RtdbSearchTimecopy:
-		return "计算结果时间戳参考"
-	case RtdbSearchPeriod:
-		return "计算周期"
-	case RtdbSearchCalc_end:
-		return "辅助作用，不能作为搜索条件"
-	default:
-		return "未知搜索条件"
-	}
-}
-
-type RtdbTagIndex uint32
-
-const (
-	// RtdbTagIndexTag tag
-	RtdbTagIndexTag = RtdbTagIndex(C.RTDB_TAG_INDEX_TAG)
-
-	// RtdbTagIndexId id
-	RtdbTagIndexId = RtdbTagIndex(C.RTDB_TAG_INDEX_ID)
-
-	// RtdbTagIndexType type
-	RtdbTagIndexType = RtdbTagIndex(C.RTDB_TAG_INDEX_TYPE)
-
-	// RtdbTagIndexTable table
-	RtdbTagIndexTable = RtdbTagIndex(C.RTDB_TAG_INDEX_TABLE)
-
-	// RtdbTagIndexDesc desc
-	RtdbTagIndexDesc = RtdbTagIndex(C.RTDB_TAG_INDEX_DESC)
-
-	// RtdbTagIndexUnit unit
-	RtdbTagIndexUnit = RtdbTagIndex(C.RTDB_TAG_INDEX_UNIT)
-
-	// RtdbTagIndexArchive archive
-	RtdbTagIndexArchive = RtdbTagIndex(C.RTDB_TAG_INDEX_ARCHIVE)
-
-	// RtdbTagIndexDigits digits
-	RtdbTagIndexDigits = RtdbTagIndex(C.RTDB_TAG_INDEX_DIGITS)
-
-	// RtdbTagIndexShutdown shutdown
-	RtdbTagIndexShutdown = RtdbTagIndex(C.RTDB_TAG_INDEX_SHUTDOWN)
-
-	// RtdbTagIndexLowlimit lowlimit
-	RtdbTagIndexLowlimit = RtdbTagIndex(C.RTDB_TAG_INDEX_LOWLIMIT)
-
-	// RtdbTagIndexHighlimit highlimit
-	RtdbTagIndexHighlimit = RtdbTagIndex(C.RTDB_TAG_INDEX_HIGHLIMIT)
-
-	// RtdbTagIndexStep step
-	RtdbTagIndexStep = RtdbTagIndex(C.RTDB_TAG_INDEX_STEP)
-
-	// RtdbTagIndexTypical typical
-	RtdbTagIndexTypical = RtdbTagIndex(C.RTDB_TAG_INDEX_TYPICAL)
-
-	// RtdbTagIndexCompress compress
-	RtdbTagIndexCompress = RtdbTagIndex(C.RTDB_TAG_INDEX_COMPRESS)
-
-	// RtdbTagIndexCompdev compdev
-	RtdbTagIndexCompdev = RtdbTagIndex(C.RTDB_TAG_INDEX_COMPDEV)
-
-	// RtdbTagIndexCompdevpercent compdevpercent
-	RtdbTagIndexCompdevpercent = RtdbTagIndex(C.RTDB_TAG_INDEX_COMPDEVPERCENT)
-
-	// RtdbTagIndexComptimemax comptimemax
-	RtdbTagIndexComptimemax = RtdbTagIndex(C.RTDB_TAG_INDEX_COMPTIMEMAX)
-
-	// RtdbTagIndexComptimemin comptimemin
-	RtdbTagIndexComptimemin = RtdbTagIndex(C.RTDB_TAG_INDEX_COMPTIMEMIN)
-
-	// RtdbTagIndexExcdev excdev
-	RtdbTagIndexExcdev = RtdbTagIndex(C.RTDB_TAG_INDEX_EXCDEV)
-
-	// RtdbTagIndexExcdevpercent excdevpercent
-	RtdbTagIndexExcdevpercent = RtdbTagIndex(C.RTDB_TAG_INDEX_EXCDEVPERCENT)
-
-	// RtdbTagIndexExctimemax exctimemax
-	RtdbTagIndexExctimemax = RtdbTagIndex(C.RTDB_TAG_INDEX_EXCTIMEMAX)
-
-	// RtdbTagIndexExctimemin exctimemin
-	RtdbTagIndexExctimemin = RtdbTagIndex(C.RTDB_TAG_INDEX_EXCTIMEMIN)
-
-	// RtdbTagIndexClassof classof
-	RtdbTagIndexClassof = RtdbTagIndex(C.RTDB_TAG_INDEX_CLASSOF)
-
-	// RtdbTagIndexChangedate changedate
-	RtdbTagIndexChangedate = RtdbTagIndex(C.RTDB_TAG_INDEX_CHANGEDATE)
-
-	// RtdbTagIndexChanger changer
-	RtdbTagIndexChanger = RtdbTagIndex(C.RTDB_TAG_INDEX_CHANGER)
-
-	// RtdbTagIndexCreatedate createdate
-	RtdbTagIndexCreatedate = RtdbTagIndex(C.RTDB_TAG_INDEX_CREATEDATE)
-
-	// RtdbTagIndexCreator creator
-	RtdbTagIndexCreator = RtdbTagIndex(C.RTDB_TAG_INDEX_CREATOR)
-
-	// RtdbTagIndexMirror mirror
-	RtdbTagIndexMirror = RtdbTagIndex(C.RTDB_TAG_INDEX_MIRROR)
-
-	// RtdbTagIndexMs ms
-	RtdbTagIndexMs = RtdbTagIndex(C.RTDB_TAG_INDEX_MS)
-
-	// RtdbTagIndexFullname fullname
-	RtdbTagIndexFullname = RtdbTagIndex(C.RTDB_TAG_INDEX_FULLNAME)
-
-	// RtdbTagIndexSummary summary
-	RtdbTagIndexSummary = RtdbTagIndex(C.RTDB_TAG_INDEX_SUMMARY)
-
-	// RtdbTagIndexDatetimeformat datetimeformat
-	RtdbTagIndexDatetimeformat = RtdbTagIndex(C.RTDB_TAG_INDEX_DATETIMEFORMAT)
-
-	// RtdbTagIndexSource source
-	RtdbTagIndexSource = RtdbTagIndex(C.RTDB_TAG_INDEX_SOURCE)
-
-	// RtdbTagIndexScan scan
-	RtdbTagIndexScan = RtdbTagIndex(C.RTDB_TAG_INDEX_SCAN)
-
-	// RtdbTagIndexInstrument instrument
-	RtdbTagIndexInstrument = RtdbTagIndex(C.RTDB_TAG_INDEX_INSTRUMENT)
-
-	// RtdbTagIndexLocation1 location1
-	RtdbTagIndexLocation1 = RtdbTagIndex(C.RTDB_TAG_INDEX_LOCATION1)
-
-	// RtdbTagIndexLocation2 location2
-	RtdbTagIndexLocation2 = RtdbTagIndex(C.RTDB_TAG_INDEX_LOCATION2)
-
-	// RtdbTagIndexLocation3 location3
-	RtdbTagIndexLocation3 = RtdbTagIndex(C.RTDB_TAG_INDEX_LOCATION3)
-
-	// RtdbTagIndexLocation4 location4
-	RtdbTagIndexLocation4 = RtdbTagIndex(C.RTDB_TAG_INDEX_LOCATION4)
-
-	// RtdbTagIndexLocation5 location5
-	RtdbTagIndexLocation5 = RtdbTagIndex(C.RTDB_TAG_INDEX_LOCATION5)
-
-	// RtdbTagIndexUserint1 userint1
-	RtdbTagIndexUserint1 = RtdbTagIndex(C.RTDB_TAG_INDEX_USERINT1)
-
-	// RtdbTagIndexUserint2 userint2
-	RtdbTagIndexUserint2 = RtdbTagIndex(C.RTDB_TAG_INDEX_USERINT2)
-
-	// RtdbTagIndexUserreal1 userreal1
-	RtdbTagIndexUserreal1 = RtdbTagIndex(C.RTDB_TAG_INDEX_USERREAL1)
-
-	// RtdbTagIndexUserreal2 userreal2
-	RtdbTagIndexUserreal2 = RtdbTagIndex(C.RTDB_TAG_INDEX_USERREAL2)
-
-	// RtdbTagIndexEquation equation
-	RtdbTagIndexEquation = RtdbTagIndex(C.RTDB_TAG_INDEX_EQUATION)
-
-	// RtdbTagIndexTrigger trigger
-	RtdbTagIndexTrigger = RtdbTagIndex(C.RTDB_TAG_INDEX_TRIGGER)
-
-	// RtdbTagIndexTimecopy timecopy
-	RtdbTagIndexTimecopy = RtdbTagIndex(C.RTDB_TAG_INDEX_TIMECOPY)
-
-	// RtdbTagIndexPeriod period
-	RtdbTagIndexPeriod = RtdbTagIndex(C.RTDB_TAG_INDEX_PERIOD)
-
-	// RtdbTagIndexTimestamp timestamp
-	RtdbTagIndexTimestamp = RtdbTagIndex(C.RTDB_TAG_INDEX_TIMESTAMP)
-
-	// RtdbTagIndexValue value
-	RtdbTagIndexValue = RtdbTagIndex(C.RTDB_TAG_INDEX_VALUE)
-
-	// RtdbTagIndexQuality quality
-	RtdbTagIndexQuality = RtdbTagIndex(C.RTDB_TAG_INDEX_QUALITY)
-)
-
-// RtdbSubscribeOption 订阅选项
-type RtdbSubscribeOption int32
-
-const (
-	// RtdbSubscribeOptionAutoConn 自动重连
-	RtdbSubscribeOptionAutoConn = RtdbSubscribeOption(C.RTDB_O_AUTOCONN)
-)
-
-// RtdbTagChangeReason 标签点变更原因，用于标签点订阅
-type RtdbTagChangeReason int32
-
-const (
-	RtdbTagCreated  = RtdbTagChangeReason(C.RTDB_TAG_CREATED)
-	RtdbTagUpdated  = RtdbTagChangeReason(C.RTDB_TAG_UPDATED)
-	RtdbTagRemoved  = RtdbTagChangeReason(C.RTDB_TAG_REMOVED)
-	RtdbTagRecoverd = RtdbTagChangeReason(C.RTDB_TAG_RECOVERD)
-	RtdbTagPurged   = RtdbTagChangeReason(C.RTDB_TAG_PURGED)
-	RtdbTabUpdated  = RtdbTagChangeReason(C.RTDB_TAB_UPDATED)
-	RtdbTabRemoved  = RtdbTagChangeReason(C.RTDB_TAB_REMOVED)
-)
-
-// RtdbDataTypeField 自定义类型字段项
-type RtdbDataTypeField struct {
-	// 自定义类型的字段的名称
-	Name string
-
-	// 字段的类型
-	Type RtdbType
-
-	// 字段类型的长度, 单位字节
-	Length int32
-
-	// 字段类型的描述
-	Desc string
-}
-
 func goToCRtdbDataTypeField(field *RtdbDataTypeField) *C.RTDB_DATA_TYPE_FIELD {
 	rtn := C.RTDB_DATA_TYPE_FIELD{}
 	GoStringToCCharArray(field.Name, &rtn.name[0], int(C.RTDB_TYPE_NAME_SIZE))
@@ -5372,85 +275,6 @@ func cToRtdbDataTypeField(field *C.RTDB_DATA_TYPE_FIELD) *RtdbDataTypeField {
 	return &rtn
 }
 
-// RtdbSyncRole 元数据同步角色
-type RtdbSyncRole int8
-
-const (
-	// RtdbSyncRoleOffline 离线
-	RtdbSyncRoleOffline = RtdbSyncRole(C.RTDB_SYNC_ROLE_OFFLINE)
-
-	// RtdbSyncRoleUnsynced 未同步
-	RtdbSyncRoleUnsynced = RtdbSyncRole(C.RTDB_SYNC_ROLE_UNSYNCED)
-
-	// RtdbSyncRoleSyncing 同步中
-	RtdbSyncRoleSyncing = RtdbSyncRole(C.RTDB_SYNC_ROLE_SYNCING)
-
-	// RtdbSyncRoleSlave 备库
-	RtdbSyncRoleSlave = RtdbSyncRole(C.RTDB_SYNC_ROLE_SLAVE)
-
-	// RtdbSyncRoleMaster 主库
-	RtdbSyncRoleMaster = RtdbSyncRole(C.RTDB_SYNC_ROLE_MASTER)
-)
-
-func (rs RtdbSyncRole) Desc() string {
-	switch rs {
-	case RtdbSyncRoleOffline:
-		return "离线"
-	case RtdbSyncRoleUnsynced:
-		return "未同步"
-	case RtdbSyncRoleSyncing:
-		return "同步中"
-	case RtdbSyncRoleSlave:
-		return "备库"
-	case RtdbSyncRoleMaster:
-		return "主库"
-	default:
-		return "未知角色"
-	}
-}
-
-// RtdbSyncStatus 元数据同步状态
-type RtdbSyncStatus int8
-
-const (
-	// RtdbSyncStatusInit 正常
-	RtdbSyncStatusInit = RtdbSyncStatus(C.RTDB_SYNC_STATUS_INIT)
-
-	// RtdbSyncStatusStart 启动同步
-	RtdbSyncStatusStart = RtdbSyncStatus(C.RTDB_SYNC_STATUS_START)
-
-	// RtdbSyncStatusFile 同步文件
-	RtdbSyncStatusFile = RtdbSyncStatus(C.RTDB_SYNC_STATUS_FILE)
-
-	// RtdbSyncStatusCache 同步缓存
-	RtdbSyncStatusCache = RtdbSyncStatus(C.RTDB_SYNC_STATUS_CACHE)
-)
-
-func (ss RtdbSyncStatus) Desc() string {
-	switch ss {
-	case RtdbSyncStatusInit:
-		return "正常"
-	case RtdbSyncStatusStart:
-		return "启动同步"
-	case RtdbSyncStatusFile:
-		return "同步文件"
-	case RtdbSyncStatusCache:
-		return "同步缓存"
-	default:
-		return "未知同步状态"
-	}
-}
-
-// RtdbSyncInfo 节点的元数据同步信息
-type RtdbSyncInfo struct {
-	Role     RtdbSyncRole   // 角色
-	Status   RtdbSyncStatus // 状态
-	IP       uint32         // IP地址
-	IpString string         // StringIP地址
-	Version  uint64         // 同步版本
-	DataSize uint64         // 堆积数据大小
-}
-
 func cToGoRtdbSyncInfo(info *C.RTDB_SYNC_INFO) *RtdbSyncInfo {
 	rtn := RtdbSyncInfo{
 		Role:     RtdbSyncRole(info.role),
@@ -5459,78 +283,8 @@ func cToGoRtdbSyncInfo(info *C.RTDB_SYNC_INFO) *RtdbSyncInfo {
 		IpString: fmt.Sprintf("%d.%d.%d.%d", byte(info.ip>>24), byte(info.ip>>16), byte(info.ip>>8), byte(info.ip)),
 		Version:  uint64(info.version),
 		DataSize: uint64(info.data_size),
-	}
-	return &rtn
-}
-
-// RtdbSubscribeChangeType 元数据同步状态
-type RtdbSubscribeChangeType int32
-
-const (
-	// RtdbSubscribeChangeTypeAdd 增加订阅
-	RtdbSubscribeChangeTypeAdd = RtdbSubscribeChangeType(C.RTDB_SUBSCRIBE_ADD)
-
-	// RtdbSubscribeChangeTypeUpdate 更新订阅信息
-	RtdbSubscribeChangeTypeUpdate = RtdbSubscribeChangeType(C.RTDB_SUBSCRIBE_UPDATE)
-
-	// RtdbSubscribeChangeTypeRemove 移除订阅
-	RtdbSubscribeChangeTypeRemove = RtdbSubscribeChangeType(C.RTDB_SUBSCRIBE_REMOVE)
-)
-
-// RtdbArchiveState 历史存档文件状态
-type RtdbArchiveState int32
-
-const (
-	// RtdbArchiveStateInvalid 无效
-	RtdbArchiveStateInvalid = RtdbArchiveState(C.RTDB_INVALID_ARCHIVE)
-
-	// RtdbArchiveStateActived 活动
-	RtdbArchiveStateActived = RtdbArchiveState(C.RTDB_ACTIVED_ARCHIVE)
-
-	// RtdbArchiveStateNormal 普通
-	RtdbArchiveStateNormal = RtdbArchiveState(C.RTDB_NORMAL_ARCHIVE)
-
-	// RtdbArchiveStateReadonly 只读
-	RtdbArchiveStateReadonly = RtdbArchiveState(C.RTDB_READONLY_ARCHIVE)
-)
-
-// RtdbHeaderPage 历史数据存档文件头部信息
-type RtdbHeaderPage struct {
-	DbVer         int32        // 所属数据库版本
-	DataVer       int32        //数据格式变更版本
-	Begin         DateTimeType // 数据起始时间
-	End           DateTimeType // 数据结束时间
-	RealSize      int64        // 实际写入的字节数 用来统计实际使用率
-	CreateTime    DateTimeType // 创建时间
-	ModifyTime    DateTimeType // 修改时间
-	MergeTime     DateTimeType // 上次合并时间
-	ArrangeTime   DateTimeType // 上次整理时间
-	ReindexTime   DateTimeType // 上次重建索引时间
-	BackupTime    DateTimeType // 上次备份时间
-	RatedCapacity int64        // 创建时容量（额定容量）
-	Capacity      int64        // 当前容量，文件内包含的总数据页数，不包括头页。
-	Size          int64        // 实际使用量，已被占用的数据页数，不包括头页。
-	ExCapacity    int64        // 附属文件容量
-	IsMain        byte         // 是主文件还是附属文件
-	PageSize      byte         // 单个页的字节尺寸，单位为KB
-	IdOrCount     byte         // 主文件在这里存放附属文件数量，附属文件在这里存放自身的ID。
-	AutoMerge     byte         // 启用自动合并
-	AutoArrange   byte         // 启用自动整理
-	Merged        byte         // 1：已合并，0：尚未合并或合并后又产生了新的附属文件。
-	Arranged      byte         // 1：已整理，0：尚未整理过或整理后内容发生变更。
-	IndexType     byte         // 索引类型，0 为红黑树，1为跳跃链表。默认为红黑树
-	FileName      string       // 在这里存放自己的文件名。
-	Crc32         uint32       // 以上内容的CRC32校验码，暂不启用。
-	IndexInMem    byte         // 索引是否加载到内存中
-	ManageType    byte         // 被管理类型，RTDB_ARCHIVE_MANAGE_TYPE， 0:兼容模式  1:未管理 2:管理中
-	CompressType  byte         // 压缩类型，RTDB_ARCHIVE_COMPRESS_TYPE， 0:未压缩 1:无损压缩 2:两阶段压缩
-	Status        RtdbError    // 存档文件的当前状态，表示存档文件操作中遇到的异常错误码，正常为RtE_OK，可能遇到的异常有：RtE_INDEX_NOT_READY, RtE_CAN_NOT_CREATE_INDEX, RtE_NOT_ENOUGH_SPACE
-	UsedSize      int64        // 实际使用字节数，不包括存档文件头
-	BlockCount    int64        // 数据块数量
-	DelBlockSize  int64        // 逻辑上删除的数据块字节数
-	TotalCount    int64        // 数据总条数
-	BigPageSize   int16        // 数据页大小，单位为KB
-	VerCode       string       // 授权信息
+	}
+	return &rtn
 }
 
 func cToGoRtdbHeaderPage(page *C.RTDB_HEADER_PAGE) *RtdbHeaderPage {
@@ -5614,26 +368,6 @@ func goToCRtdbHeaderPage(page *RtdbHeaderPage) *C.RTDB_HEADER_PAGE {
 	return &rtn
 }
 
-type RtdbArchivePerfData struct {
-	WriteCount      uint32  // 历史服务查询的写磁盘次数
-	ReadCount       uint32  // 历史服务查询的读磁盘次数
-	WriteTime       float32 // 历史服务查询的写磁盘时间
-	ReadTime        float32 // 历史服务查询的读磁盘时间
-	IndexWriteCount uint32  // 历史服务查询的写索引次数
-	IndexReadCount  uint32  // 历史服务查询的读索引次数
-	IndexWriteTime  float32 // 历史服务查询的写索引时间
-	IndexReadTime   float32 // 历史服务查询的读索引时间
-	ArcListLockTime float32 // 历史服务查询的文件列表锁时间
-	ArcLockTime     float32 // 历史服务查询的存档文件锁时间
-	IndexLockTime   float32 // 历史服务查询的索引锁时间
-	TotalLockTime   float32 // 历史服务查询的锁的总时间
-	WriteSize       float32 // 历史服务查询的写入数据量，单位KB
-	ReadSize        float32 // 历史服务查询的读取数据量，单位KB
-	WriteRealSize   float32 // 历史服务查询的写入有效数据量，单位KB
-	ReadRealSize    float32 // 历史服务查询的读取有效数据量，单位KB
-
-}
-
 func cToGoRtdbArchivePerfData(p *C.RTDB_ARCHIVE_PERF_DATA) *RtdbArchivePerfData {
 	rtn := RtdbArchivePerfData{
 		WriteCount:      uint32(p.write_count),
@@ -5677,476 +411,6 @@ func goToCRtdbArchivePerfData(p *RtdbArchivePerfData) *C.RTDB_ARCHIVE_PERF_DATA
 	return &rtn
 }
 
-// BigJobName 数据库对应服务的大任务，每个服务最多同时执行一个大任务
-type BigJobName int16
-
-const (
-	// BigJobNameMerge 合并附属文件到主文件
-	BigJobNameMerge = BigJobName(C.RTDB_MERGE)
-
-	// BigJobNameArrange 整理存档文件，整理过程中会完成合并
-	BigJobNameArrange = BigJobName(C.RTDB_ARRANGE)
-
-	// BigJobNameReindex 重建索引
-	BigJobNameReindex = BigJobName(C.RTDB_REINDEX)
-
-	// BigJobNameBackup 备份
-	BigJobNameBackup = BigJobName(C.RTDB_BACKUP)
-
-	// BigJobNameReactive 激活为活动存档
-	BigJobNameReactive = BigJobName(C.RTDB_REACTIVE)
-
-	// BigJobNameArchive 移动存档文件
-	BigJobNameArchive = BigJobName(C.RTDB_MOVE_ARCHIVE)
-
-	// BigJobNameConvertIndex 转换存档文件索引类型
-	BigJobNameConvertIndex = BigJobName(C.RTDB_CONVERT_INDEX)
-
-	// BigJobNameCompress 压缩
-	BigJobNameCompress = BigJobName(C.RTDB_COMPRESS)
-
-	// BigJobNameMoveArchiveAuto  自动移动存档文件（用于存档文件滚动存储）
-	BigJobNameMoveArchiveAuto = BigJobName(C.RTDB_MOVE_ARCHIVE_AUTO)
-
-	// BigJobNameCompute  历史计算
-	BigJobNameCompute = BigJobName(C.RTDB_COMPUTE)
-
-	// BigJobNameUpdateTable 修改表名称
-	BigJobNameUpdateTable = BigJobName(C.RTDB_UPDATE_TABLE)
-
-	// BigJobNameRemoveTable 删除表
-	BigJobNameRemoveTable = BigJobName(C.RTDB_REMOVE_TABLE)
-)
-
-type RtdbProcess int32
-
-const (
-	// RtdbProcessHistorian 历史服务
-	RtdbProcessHistorian = RtdbProcess(C.RTDB_PROCESS_HISTORIAN)
-
-	// RtdbProcessEquation 方程式服务
-	RtdbProcessEquation = RtdbProcess(C.RTDB_PROCESS_EQUATION)
-
-	// RtdbProcessBase 标签点服务
-	RtdbProcessBase = RtdbProcess(C.RTDB_PROCESS_BASE)
-)
-
-// RtdbPerfTagID 性能计数点的ID
-type RtdbPerfTagID int32
-
-const (
-	// PftCpuUsageOfLogger 日志服务CPU使用
-	PftCpuUsageOfLogger = RtdbPerfTagID(C.PFT_CPU_USAGE_OF_LOGGER)
-
-	// PftMemBytesOfLogger 日志服务内存
-	PftMemBytesOfLogger = RtdbPerfTagID(C.PFT_MEM_BYTES_OF_LOGGER)
-
-	// PftVmemBytesOfLogger 日志服务虚拟内存
-	PftVmemBytesOfLogger = RtdbPerfTagID(C.PFT_VMEM_BYTES_OF_LOGGER)
-
-	// PftReadBytesOfLogger 日志服务 I/O 读取字节
-	PftReadBytesOfLogger = RtdbPerfTagID(C.PFT_READ_BYTES_OF_LOGGER)
-
-	// PftWriteBytesOfLogger 日志服务 I/O 写入字节
-	PftWriteBytesOfLogger = RtdbPerfTagID(C.PFT_WRITE_BYTES_OF_LOGGER)
-
-	// PftCpuUsageOfHistorian 历史数据服务CPU使用
-	PftCpuUsageOfHistorian = RtdbPerfTagID(C.PFT_CPU_USAGE_OF_HISTORIAN)
-
-	// PftMemBytesOfHistorian 历史数据服务内存
-	PftMemBytesOfHistorian = RtdbPerfTagID(C.PFT_MEM_BYTES_OF_HISTORIAN)
-
-	// PftVmemBytesOfHistorian 历史数据服务虚拟内存
-	PftVmemBytesOfHistorian = RtdbPerfTagID(C.PFT_VMEM_BYTES_OF_HISTORIAN)
-
-	// PftReadBytesOfHistorian 历史数据服务 I/O 读取字节
-	PftReadBytesOfHistorian = RtdbPerfTagID(C.PFT_READ_BYTES_OF_HISTORIAN)
-
-	// PftWriteBytesOfHistorian 历史数据服务 I/O 写入字节
-	PftWriteBytesOfHistorian = RtdbPerfTagID(C.PFT_WRITE_BYTES_OF_HISTORIAN)
-
-	// PftCpuUsageOfSnapshot 快照数据服务CPU使用
-	PftCpuUsageOfSnapshot = RtdbPerfTagID(C.PFT_CPU_USAGE_OF_SNAPSHOT)
-
-	// PftMemBytesOfSnapshot 快照数据服务内存
-	PftMemBytesOfSnapshot = RtdbPerfTagID(C.PFT_MEM_BYTES_OF_SNAPSHOT)
-
-	// PftVmemBytesOfSnapshot 快照数据服务虚拟内存
-	PftVmemBytesOfSnapshot = RtdbPerfTagID(C.PFT_VMEM_BYTES_OF_SNAPSHOT)
-
-	// PftReadBytesOfSnapshot 快照数据服务 I/O 读取字节
-	PftReadBytesOfSnapshot = RtdbPerfTagID(C.PFT_READ_BYTES_OF_SNAPSHOT)
-
-	// PftWriteBytesOfSnapshot 快照数据服务 I/O 写入字节
-	PftWriteBytesOfSnapshot = RtdbPerfTagID(C.PFT_WRITE_BYTES_OF_SNAPSHOT)
-
-	// PftCpuUsageOfEquation 实时方程式服务CPU使用
-	PftCpuUsageOfEquation = RtdbPerfTagID(C.PFT_CPU_USAGE_OF_EQUATION)
-
-	// PftMemBytesOfEquation 实时方程式服务内存
-	PftMemBytesOfEquation = RtdbPerfTagID(C.PFT_MEM_BYTES_OF_EQUATION)
-
-	// PftVmemBytesOfEquation 实时方程式服务虚拟内存
-	PftVmemBytesOfEquation = RtdbPerfTagID(C.PFT_VMEM_BYTES_OF_EQUATION)
-
-	// PftReadBytesOfEquation 实时方程式服务 I/O 读取字节
-	PftReadBytesOfEquation = RtdbPerfTagID(C.PFT_READ_BYTES_OF_EQUATION)
-
-	// PftWriteBytesOfEquation 实时方程式服务 I/O 写入字节
-	PftWriteBytesOfEquation = RtdbPerfTagID(C.PFT_WRITE_BYTES_OF_EQUATION)
-
-	// PftCpuUsageOfBase 标签点信息服务CPU使用
-	PftCpuUsageOfBase = RtdbPerfTagID(C.PFT_CPU_USAGE_OF_BASE)
-
-	// PftMemBytesOfBase 标签点信息服务内存
-	PftMemBytesOfBase = RtdbPerfTagID(C.PFT_MEM_BYTES_OF_BASE)
-
-	// PftVmemBytesOfBase 标签点信息服务虚拟内存
-	PftVmemBytesOfBase = RtdbPerfTagID(C.PFT_VMEM_BYTES_OF_BASE)
-
-	// PftReadBytesOfBase 标签点信息服务 I/O 读取字节
-	PftReadBytesOfBase = RtdbPerfTagID(C.PFT_READ_BYTES_OF_BASE)
-
-	// PftWriteBytesOfBase 标签点信息服务 I/O 写入字节
-	PftWriteBytesOfBase = RtdbPerfTagID(C.PFT_WRITE_BYTES_OF_BASE)
-
-	// PftCpuUsageOfServer 网络服务CPU使用
-	PftCpuUsageOfServer = RtdbPerfTagID(C.PFT_CPU_USAGE_OF_SERVER)
-
-	// PftMemBytesOfServer 网络服务内存
-	PftMemBytesOfServer = RtdbPerfTagID(C.PFT_MEM_BYTES_OF_SERVER)
-
-	// PftVmemBytesOfServer 网络服务虚拟内存
-	PftVmemBytesOfServer = RtdbPerfTagID(C.PFT_VMEM_BYTES_OF_SERVER)
-
-	// PftReadBytesOfServer 网络服务 I/O 读取字节
-	PftReadBytesOfServer = RtdbPerfTagID(C.PFT_READ_BYTES_OF_SERVER)
-
-	// PftWriteBytesOfServer 网络服务 I/O 写入字节
-	PftWriteBytesOfServer = RtdbPerfTagID(C.PFT_WRITE_BYTES_OF_SERVER)
-
-	// PftArvAsyncQueue 历史数据队列地址
-	PftArvAsyncQueue = RtdbPerfTagID(C.PFT_ARV_ASYNC_QUEUE)
-
-	// PftArvAsyncQueueUsage 历史数据队列使用率
-	PftArvAsyncQueueUsage = RtdbPerfTagID(C.PFT_ARV_ASYNC_QUEUE_USAGE)
-
-	// PftArvexAsyncQueue 补历史数据队列地址
-	PftArvexAsyncQueue = RtdbPerfTagID(C.PFT_ARVEX_ASYNC_QUEUE)
-
-	// PftArvexAsyncQueueUsage 补历史数据队列使用率
-	PftArvexAsyncQueueUsage = RtdbPerfTagID(C.PFT_ARVEX_ASYNC_QUEUE_USAGE)
-
-	// PftEventsInputRate 普通事件入库速度（KB/秒）
-	PftEventsInputRate = RtdbPerfTagID(C.PFT_EVENTS_INPUT_RATE)
-
-	// PftEventsOutputRate 普通事件归档速度（KB/秒）
-	PftEventsOutputRate = RtdbPerfTagID(C.PFT_EVENTS_OUTPUT_RATE)
-
-	// PftFillInInputRate 补历史事件入库速度（KB/秒）
-	PftFillInInputRate = RtdbPerfTagID(C.PFT_FILL_IN_INPUT_RATE)
-
-	// PftFillInOutputRate 补历史事件归档速度（KB/秒）
-	PftFillInOutputRate = RtdbPerfTagID(C.PFT_FILL_IN_OUTPUT_RATE)
-
-	// PftArvCacheUsage 历史数据缓存使用率
-	PftArvCacheUsage = RtdbPerfTagID(C.PFT_ARV_CACHE_USAGE)
-
-	// PftArvexCacheUsage 补历史数据缓存使用率
-	PftArvexCacheUsage = RtdbPerfTagID(C.PFT_ARVEX_CACHE_USAGE)
-
-	// PftMirrorSnapshotsQueue 快照数据的镜像队列地址
-	PftMirrorSnapshotsQueue = RtdbPerfTagID(C.PFT_MIRROR_SNAPSHOTS_QUEUE)
-
-	// PftMirrorSnapshotsQueueUsage 快照数据的镜像队列使用率
-	PftMirrorSnapshotsQueueUsage = RtdbPerfTagID(C.PFT_MIRROR_SNAPSHOTS_QUEUE_USAGE)
-
-	// PftArvexBlobAsyncQueue str、blob补历史数据队列地址
-	PftArvexBlobAsyncQueue = RtdbPerfTagID(C.PFT_ARVEX_BLOB_ASYNC_QUEUE)
-
-	// PftArvexBlobAsyncQueueUsage str、blob补历史数据队列使用率
-	PftArvexBlobAsyncQueueUsage = RtdbPerfTagID(C.PFT_ARVEX_BLOB_ASYNC_QUEUE_USAGE)
-
-	// PftArvexBlobCacheUsage str、blob补历史数据缓存使用率
-	PftArvexBlobCacheUsage = RtdbPerfTagID(C.PFT_ARVEX_BLOB_CACHE_USAGE)
-
-	// PftMirrorBufferSize 快照数据的镜像缓存文件
-	PftMirrorBufferSize = RtdbPerfTagID(C.PFT_MIRROR_BUFFER_SIZE)
-
-	// PftClutterPoolUsage 消息交换池利用率
-	PftClutterPoolUsage = RtdbPerfTagID(C.PFT_CLUTTER_POOL_USAGE)
-
-	// PftMaxBlockInClutterPool 消息交换池的最大可用额度
-	PftMaxBlockInClutterPool = RtdbPerfTagID(C.PFT_MAX_BLOCK_IN_CLUTTER_POOL)
-
-	// PftArvArchivedTime 历史数据归档耗时
-	PftArvArchivedTime = RtdbPerfTagID(C.PFT_ARV_ARCHIVED_TIME)
-
-	// PftArvexArchivedTime 补历史数据归档耗时
-	PftArvexArchivedTime = RtdbPerfTagID(C.PFT_ARVEX_ARCHIVED_TIME)
-
-	// PftArvexBlobArchivedTime str、blob补历史数据归档耗时
-	PftArvexBlobArchivedTime = RtdbPerfTagID(C.PFT_ARVEX_BLOB_ARCHIVED_TIME)
-
-	// PftArvArchivedPageCount 历史数据归档的数据页数量
-	PftArvArchivedPageCount = RtdbPerfTagID(C.PFT_ARV_ARCHIVED_PAGE_COUNT)
-
-	// PftArvexArchivedPageCount 补历史数据归档的数据页数量
-	PftArvexArchivedPageCount = RtdbPerfTagID(C.PFT_ARVEX_ARCHIVED_PAGE_COUNT)
-
-	// PftArvexBlobArchivedPageCount str、blob补历史数据归档的数据页数量
-	PftArvexBlobArchivedPageCount = RtdbPerfTagID(C.PFT_ARVEX_BLOB_ARCHIVED_PAGE_COUNT)
-
-	// PftMirrorArvValuesQueue 补写历史数据的镜像队列地址
-	PftMirrorArvValuesQueue = RtdbPerfTagID(C.PFT_MIRROR_ARV_VALUES_QUEUE)
-
-	// PftMirrorArvValuesQueueUsage 补写历史数据的镜像队列使用率
-	PftMirrorArvValuesQueueUsage = RtdbPerfTagID(C.PFT_MIRROR_ARV_VALUES_QUEUE_USAGE)
-
-	// PftMirrorArvBufferSize 补写历史数据的镜像缓存文件
-	PftMirrorArvBufferSize = RtdbPerfTagID(C.PFT_MIRROR_ARV_BUFFER_SIZE)
-
-	// PftArvWriteCount 历史数据归档写磁盘次数
-	PftArvWriteCount = RtdbPerfTagID(C.PFT_ARV_WRITE_COUNT)
-
-	// PftArvReadCount 历史数据归档读磁盘次数
-	PftArvReadCount = RtdbPerfTagID(C.PFT_ARV_READ_COUNT)
-
-	// PftArvWriteTime 历史数据归档写磁盘时间
-	PftArvWriteTime = RtdbPerfTagID(C.PFT_ARV_WRITE_TIME)
-
-	// PftArvReadTime 历史数据归档读磁盘时间
-	PftArvReadTime = RtdbPerfTagID(C.PFT_ARV_READ_TIME)
-
-	// PftArvIndexWriteCount 历史数据归档写索引次数
-	PftArvIndexWriteCount = RtdbPerfTagID(C.PFT_ARV_INDEX_WRITE_COUNT)
-
-	// PftArvIndexReadCount 历史数据归档读索引次数
-	PftArvIndexReadCount = RtdbPerfTagID(C.PFT_ARV_INDEX_READ_COUNT)
-
-	// PftArvIndexWriteTime 历史数据归档写索引时间
-	PftArvIndexWriteTime = RtdbPerfTagID(C.PFT_ARV_INDEX_WRITE_TIME)
-
-	// PftArvIndexReadTime 历史数据归档读索引时间
-	PftArvIndexReadTime = RtdbPerfTagID(C.PFT_ARV_INDEX_READ_TIME)
-
-	// PftArvArcListLockTime 历史数据归档列表锁时间
-	PftArvArcListLockTime = RtdbPerfTagID(C.PFT_ARV_ARC_LIST_LOCK_TIME)
-
-	// PftArvArcLockTime 历史数据归档文件锁时间
-	PftArvArcLockTime = RtdbPerfTagID(C.PFT_ARV_ARC_LOCK_TIME)
-
-	// PftArvIndexLockTime 历史数据归档索引锁时间
-	PftArvIndexLockTime = RtdbPerfTagID(C.PFT_ARV_INDEX_LOCK_TIME)
-
-	// PftArvTotalLockTime 历史数据归档锁总时间
-	PftArvTotalLockTime = RtdbPerfTagID(C.PFT_ARV_TOTAL_LOCK_TIME)
-
-	// PftArvWriteSize 历史数据归档写磁盘数据量
-	PftArvWriteSize = RtdbPerfTagID(C.PFT_ARV_WRITE_SIZE)
-
-	// PftArvReadSize 历史数据归档读磁盘数据量
-	PftArvReadSize = RtdbPerfTagID(C.PFT_ARV_READ_SIZE)
-
-	// PftArvWriteRealSize 历史数据归档写磁盘有效数据量
-	PftArvWriteRealSize = RtdbPerfTagID(C.PFT_ARV_WRITE_REAL_SIZE)
-
-	// PftArvReadRealSize 历史数据归档读磁盘有效数据量
-	PftArvReadRealSize = RtdbPerfTagID(C.PFT_ARV_READ_REAL_SIZE)
-
-	// PftArvexWriteCount 补历史数据归档写磁盘次数
-	PftArvexWriteCount = RtdbPerfTagID(C.PFT_ARVEX_WRITE_COUNT)
-
-	// PftArvexReadCount 补历史数据归档读磁盘次数
-	PftArvexReadCount = RtdbPerfTagID(C.PFT_ARVEX_READ_COUNT)
-
-	// PftArvexWriteTime 补历史数据归档写磁盘时间
-	PftArvexWriteTime = RtdbPerfTagID(C.PFT_ARVEX_WRITE_TIME)
-
-	// PftArvexReadTime 补历史数据归档读磁盘时间
-	PftArvexReadTime = RtdbPerfTagID(C.PFT_ARVEX_READ_TIME)
-
-	// PftArvexIndexWriteCount 补历史数据归档写索引次数
-	PftArvexIndexWriteCount = RtdbPerfTagID(C.PFT_ARVEX_INDEX_WRITE_COUNT)
-
-	// PftArvexIndexReadCount 补历史数据归档读索引次数
-	PftArvexIndexReadCount = RtdbPerfTagID(C.PFT_ARVEX_INDEX_READ_COUNT)
-
-	// PftArvexIndexWriteTime 补历史数据归档写索引时间
-	PftArvexIndexWriteTime = RtdbPerfTagID(C.PFT_ARVEX_INDEX_WRITE_TIME)
-
-	// PftArvexIndexReadTime 补历史数据归档读索引时间
-	PftArvexIndexReadTime = RtdbPerfTagID(C.PFT_ARVEX_INDEX_READ_TIME)
-
-	// PftArvexArcListLockTime 补历史数据归档列表锁时间
-	PftArvexArcListLockTime = RtdbPerfTagID(C.PFT_ARVEX_ARC_LIST_LOCK_TIME)
-
-	// PftArvexArcLockTime 补历史数据归档文件锁时间
-	PftArvexArcLockTime = RtdbPerfTagID(C.PFT_ARVEX_ARC_LOCK_TIME)
-
-	// PftArvexIndexLockTime 补历史数据归档索引锁时间
-	PftArvexIndexLockTime = RtdbPerfTagID(C.PFT_ARVEX_INDEX_LOCK_TIME)
-
-	// PftArvexTotalLockTime 补历史数据归档锁总时间
-	PftArvexTotalLockTime = RtdbPerfTagID(C.PFT_ARVEX_TOTAL_LOCK_TIME)
-
-	// PftArvexWriteSize 补历史数据归档写磁盘数据量
-	PftArvexWriteSize = RtdbPerfTagID(C.PFT_ARVEX_WRITE_SIZE)
-
-	// PftArvexReadSize 补历史数据归档读磁盘数据量
-	PftArvexReadSize = RtdbPerfTagID(C.PFT_ARVEX_READ_SIZE)
-
-	// PftArvexWriteRealSize 补历史数据归档写磁盘有效数据量
-	PftArvexWriteRealSize = RtdbPerfTagID(C.PFT_ARVEX_WRITE_REAL_SIZE)
-
-	// PftArvexReadRealSize 补历史数据归档读磁盘有效数据量
-	PftArvexReadRealSize = RtdbPerfTagID(C.PFT_ARVEX_READ_REAL_SIZE)
-
-	// PftPlotPoolPointCount 曲线缓存标签点数量
-	PftPlotPoolPointCount = RtdbPerfTagID(C.PFT_PLOT_POOL_POINT_COUNT)
-
-	// PftPlotPoolWeightedPointCount 曲线缓存权重点数量
-	PftPlotPoolWeightedPointCount = RtdbPerfTagID(C.PFT_PLOT_POOL_WEIGHTED_POINT_COUNT)
-
-	// PftPlotPoolTotalMemSize 曲线缓存总内存数
-	PftPlotPoolTotalMemSize = RtdbPerfTagID(C.PFT_PLOT_POOL_TOTAL_MEM_SIZE)
-
-	// PftPlotPoolCachedHitPercent 曲线缓存命中率
-	PftPlotPoolCachedHitPercent = RtdbPerfTagID(C.PFT_PLOT_POOL_CACHED_HIT_PERCENT)
-
-	// PftOsCpuUsage 数据库所在操作系统的CPU使用率
-	PftOsCpuUsage = RtdbPerfTagID(C.PFT_OS_CPU_USAGE)
-
-	// PftOsMemSize 数据库所在操作系统的物理内存大小，单位MB
-	PftOsMemSize = RtdbPerfTagID(C.PFT_OS_MEM_SIZE)
-
-	// PftOsMemUsage 数据库所在操作系统的物理内存使用率
-	PftOsMemUsage = RtdbPerfTagID(C.PFT_OS_MEM_USAGE)
-
-	// PftQueryPoolWaitTasksSize 查询线程池中等待执行的任务数
-	PftQueryPoolWaitTasksSize = RtdbPerfTagID(C.PFT_QUERY_POOL_WAIT_TASKS_SIZE)
-
-	// PftMirrorEnqueue 镜像每秒入队的数量，单位字节
-	PftMirrorEnqueue = RtdbPerfTagID(C.PFT_MIRROR_ENQUEUE)
-
-	// PftMirrorOutqueue 镜像每秒出对的数量，单位字节
-	PftMirrorOutqueue = RtdbPerfTagID(C.PFT_MIRROR_OUTQUEUE)
-
-	// PftMirrorSendCprs 镜像每秒压缩的数量，单位字节
-	PftMirrorSendCprs = RtdbPerfTagID(C.PFT_MIRROR_SEND_CPRS)
-
-	// PftMirrorRecvCprs 镜像每秒收到的压缩数量，单位字节
-	PftMirrorRecvCprs = RtdbPerfTagID(C.PFT_MIRROR_RECV_CPRS)
-
-	// PftMirrorRecvUncprs 镜像每秒解压缩的数量，单位字节
-	PftMirrorRecvUncprs = RtdbPerfTagID(C.PFT_MIRROR_RECV_UNCPRS)
-
-	// PftMirrorCprsSpan 镜像报文每秒的压缩耗时总和，单位毫秒
-	PftMirrorCprsSpan = RtdbPerfTagID(C.PFT_MIRROR_CPRS_SPAN)
-
-	// PftMirrorCompressRate 1秒内镜像的压缩率
-	PftMirrorCompressRate = RtdbPerfTagID(C.PFT_MIRROR_COMPRESS_RATE)
-
-	// PftApiCprsRate API报文压缩率
-	PftApiCprsRate = RtdbPerfTagID(C.PFT_API_CPRS_RATE)
-
-	// PftServerCprsRate Server报文压缩率
-	PftServerCprsRate = RtdbPerfTagID(C.PFT_SERVER_CPRS_RATE)
-
-	// PftTagSubscribeCustomerCount 标签点信息订阅客户端数量
-	PftTagSubscribeCustomerCount = RtdbPerfTagID(C.PFT_TAG_SUBSCRIBE_CUSTOMER_COUNT)
-
-	// PftTagSubscribeSendEventCount 标签点信息订阅发送事件数量
-	PftTagSubscribeSendEventCount = RtdbPerfTagID(C.PFT_TAG_SUBSCRIBE_SEND_EVENT_COUNT)
-
-	// PftSnapSubscribeCustomerCount 快照信息订阅客户端数量
-	PftSnapSubscribeCustomerCount = RtdbPerfTagID(C.PFT_SNAP_SUBSCRIBE_CUSTOMER_COUNT)
-
-	// PftSnapSubscribeSendEventCount 快照信息订阅发送事件数量
-	PftSnapSubscribeSendEventCount = RtdbPerfTagID(C.PFT_SNAP_SUBSCRIBE_SEND_EVENT_COUNT)
-
-	// PftSnapSubscribePointCount 快照信息订阅标签点数量
-	PftSnapSubscribePointCount = RtdbPerfTagID(C.PFT_SNAP_SUBSCRIBE_POINT_COUNT)
-
-	// PftConnectSubscribeCustomerCount API监视订阅客户端数量
-	PftConnectSubscribeCustomerCount = RtdbPerfTagID(C.PFT_CONNECT_SUBSCRIBE_CUSTOMER_COUNT)
-
-	// PftConnectSubscribeSendEventCount API监视订阅发送事件数量
-	PftConnectSubscribeSendEventCount = RtdbPerfTagID(C.PFT_CONNECT_SUBSCRIBE_SEND_EVENT_COUNT)
-
-	// PftNamedTypeCreateSubscribeCustomerCount 创建自定义类型订阅客户端数量
-	PftNamedTypeCreateSubscribeCustomerCount = RtdbPerfTagID(C.PFT_NAMED_TYPE_CREATE_SUBSCRIBE_CUSTOMER_COUNT)
-
-	// PftNamedTypeCreateSendEventCount 创建自定义类型订阅发送事件数量
-	PftNamedTypeCreateSendEventCount = RtdbPerfTagID(C.PFT_NAMED_TYPE_CREATE_SEND_EVENT_COUNT)
-
-	// PftNamedTypeRemoveSubscribeCustomerCount 删除自定义类型订阅客户端数量
-	PftNamedTypeRemoveSubscribeCustomerCount = RtdbPerfTagID(C.PFT_NAMED_TYPE_REMOVE_SUBSCRIBE_CUSTOMER_COUNT)
-
-	// PftNamedTypeRemoveSendEventCount 删除自定义类型订阅发送事件数量
-	PftNamedTypeRemoveSendEventCount = RtdbPerfTagID(C.PFT_NAMED_TYPE_REMOVE_SEND_EVENT_COUNT)
-
-	// PftDoubleActiveSyncSendCount 双活同步每秒同步发送的数据量
-	PftDoubleActiveSyncSendCount = RtdbPerfTagID(C.PFT_DOUBLE_ACTIVE_SYNC_SEND_COUNT)
-
-	// PftDoubleActiveSyncReceiveCount 双活同步每秒同步接授的数据量
-	PftDoubleActiveSyncReceiveCount = RtdbPerfTagID(C.PFT_DOUBLE_ACTIVE_SYNC_RECEIVE_COUNT)
-
-	// PftIsReceivingNormalDataFromPeer 双活系统正在接收普通类型数据
-	PftIsReceivingNormalDataFromPeer = RtdbPerfTagID(C.PFT_IS_RECEIVING_NORMAL_DATA_FROM_PEER)
-
-	// PftIsReceivingBlobDataFromPeer 双活系统正在接收blob类型数据
-	PftIsReceivingBlobDataFromPeer = RtdbPerfTagID(C.PFT_IS_RECEIVING_BLOB_DATA_FROM_PEER)
-
-	// PftReplicatorBufferBlockCount 双活本地的同步历史缓存还有多少数据块
-	PftReplicatorBufferBlockCount = RtdbPerfTagID(C.PFT_REPLICATOR_BUFFER_BLOCK_COUNT)
-
-	// PftReplicatorExBufferBlockCount 双活本地的同步补历史缓存还有多少数据块
-	PftReplicatorExBufferBlockCount = RtdbPerfTagID(C.PFT_REPLICATOR_EX_BUFFER_BLOCK_COUNT)
-
-	// PftReplicatorBlobBufferBlockCount 双活本地的同步历史缓存(blob string 数据)还有多少数据块
-	PftReplicatorBlobBufferBlockCount = RtdbPerfTagID(C.PFT_REPLICATOR_BLOB_BUFFER_BLOCK_COUNT)
-
-	// PftReplicatorBlobExBufferBlockCount 双活本地的同步补历史缓存(blob string 数据)还有多少数据块
-	PftReplicatorBlobExBufferBlockCount = RtdbPerfTagID(C.PFT_REPLICATOR_BLOB_EX_BUFFER_BLOCK_COUNT)
-
-	// PftSnapshotPutRate 每秒写入快照记录数，单位 条
-	PftSnapshotPutRate = RtdbPerfTagID(C.PFT_SNAPSHOT_PUT_RATE)
-
-	// PftSnapshotGetRate 每秒读取快照记录数，单位 条
-	PftSnapshotGetRate = RtdbPerfTagID(C.PFT_SNAPSHOT_GET_RATE)
-
-	// PftHistorianPutRate 每秒写入历史记录数，单位 条
-	PftHistorianPutRate = RtdbPerfTagID(C.PFT_HISTORIAN_PUT_RATE)
-
-	// PftHistorianGetRate 每秒读取历史记录数，单位 条
-	PftHistorianGetRate = RtdbPerfTagID(C.PFT_HISTORIAN_GET_RATE)
-
-	// PftHistorianWriteRecordCount 每秒写入历史数据块数
-	PftHistorianWriteRecordCount = RtdbPerfTagID(C.PFT_HISTORIAN_WRITE_RECORD_COUNT)
-
-	// PftHistorianReadRecordCount 每秒读取历史数据块数
-	PftHistorianReadRecordCount = RtdbPerfTagID(C.PFT_HISTORIAN_READ_RECORD_COUNT)
-
-	// PftServerNetworkReadBytes 网络服务网络 IO 每秒读取字节数
-	PftServerNetworkReadBytes = RtdbPerfTagID(C.PFT_SERVER_NETWORK_READ_BYTES)
-
-	// PftServerNetworkWriteBytes 网络服务网络 IO 每秒写入字节数
-	PftServerNetworkWriteBytes = RtdbPerfTagID(C.PFT_SERVER_NETWORK_WRITE_BYTES)
-
-	// PftEnd 信息数量
-	PftEnd = RtdbPerfTagID(C.PFT_END)
-)
-
-// RtdbPerfTagInfo 性能计数点的信息
-type RtdbPerfTagInfo struct {
-	PerfID  RtdbPerfTagID // 性能计数点的ID 参考RTDB_PERF_TAG_ID
-	TagName string        // 性能计数点的名字
-	Desc    string        // 性能计数点的描述
-	Unit    string        // 性能计数点数值的单位
-	Type    RtdbType      // 性能计数点的数值类型
-}
-
 func goToCRtdbPerfTagInfo(info *RtdbPerfTagInfo) *C.RTDB_PERF_TAG_INFO {
 	rtn := C.RTDB_PERF_TAG_INFO{}
 	rtn.perf_id = C.int(info.PerfID)
@@ -6168,25 +432,6 @@ func cToGoRtdbPerfTagInfo(info *C.RTDB_PERF_TAG_INFO) *RtdbPerfTagInfo {
 	return &rtn
 }
 
-// RtdbGraphFlag 标签点拓扑图类型
-type RtdbGraphFlag int32
-
-const (
-	// RtdbGraphFlagAll 任何有关联的标签的关系图
-	RtdbGraphFlagAll = RtdbGraphFlag(C.RTDB_GRAPH_ALL)
-
-	// RtdgGraphFlagDirect 有直接关系的关系图
-	RtdgGraphFlagDirect = RtdbGraphFlag(C.RTDB_GRAPH_DIRECT)
-)
-
-// RtdbGraph 计算标签点方程式拓扑图键值对信息
-type RtdbGraph struct {
-	ID       int32  // 标签点ID
-	ParentID int32  // 标签点父ID，即父ID方程式中引用了子ID做运算
-	Tag      string // 标签点名称
-	ErrorMsg string // 无效标签点错误信息
-}
-
 func cToGoRtdbGraph(graph *RtdbGraph) *C.RTDB_GRAPH {
 	rtn := C.RTDB_GRAPH{}
 	rtn.id = C.int(graph.ID)
@@ -6206,63 +451,6 @@ func goToCRtdbGraph(graph *C.RTDB_GRAPH) *RtdbGraph {
 	return &rtn
 }
 
-// RtdbHisMode 历史数据搜索方式
-type RtdbHisMode int32
-
-const (
-	// RtdbHisModeNext 寻找下一个最近的数据
-	RtdbHisModeNext = RtdbHisMode(C.RTDB_NEXT)
-	// RtdbHisModePrevious 寻找上一个最近的数据
-	RtdbHisModePrevious = RtdbHisMode(C.RTDB_PREVIOUS)
-	// RtdbHisModeExact 取指定时间的数据，如果没有则返回错误 \b RtE_DATA_NOT_FOUND
-	RtdbHisModeExact = RtdbHisMode(C.RTDB_EXACT)
-	// RtdbHisModeInter 取指定时间的内插值数据
-	RtdbHisModeInter = RtdbHisMode(C.RTDB_INTER)
-	// RtdbHisModeExactOrNext 取指定时间的数据，如果没有则取下一条数据。如果都没有数据则返回错误 \b RtE_DATA_NOT_FOUND
-	RtdbHisModeExactOrNext = RtdbHisMode(C.RTDB_EXACT_OR_NEXT)
-	// RtdbHisModeExactOrPrev 取指定时间的数据，如果没有则取上一条数据。如果都没有数据则返回错误 \b RtE_DATA_NOT_FOUND
-	RtdbHisModeExactOrPrev = RtdbHisMode(C.RTDB_EXACT_OR_PREV)
-	// RtdbHisModeInterOrNext 取指定时间的内插值数据, 如果没有则取下一条数据。如果都没有数据则返回错误 \b RtE_DATA_NOT_FOUND
-	RtdbHisModeInterOrNext = RtdbHisMode(C.RTDB_INTER_OR_NEXT)
-)
-
-/*
-//   - \param max_value         双精度浮点型，输出，表示统计时间段内的最大数值。
-//   - \param min_value         双精度浮点型，输出，表示统计时间段内的最小数值。
-//   - \param total_value       双精度浮点型，输出，表示统计时间段内的累计值，结果的单位为标签点的工程单位。
-//   - \param calc_avg          双精度浮点型，输出，表示统计时间段内的算术平均值。
-//   - \param power_avg         双精度浮点型，输出，表示统计时间段内的加权平均值。
-//   - 此时前者表示结束时间，后者表示起始时间。
-//   - 如果输出的最大值或最小值的时间戳秒值为 0，
-//   - 则表明仅有累计值和加权平均值输出有效，其余统计结果无效。
-//   - 本接口对数据类型为 RTDB_COOR、RTDB_BLOB、RTDB_STRING 的标签点无效。
-*/
-
-type RtdbSummaryData struct {
-	FirstTime    TimestampType // 首部时间戳精度，秒级
-	LastTime     TimestampType // 尾部时间戳精度，秒级
-	MaxTime      TimestampType // 最大时间戳精度，秒级
-	MinTime      TimestampType // 最小时间戳精度，秒级
-	FirstSubtime SubtimeType   // 首部时间戳精度，纳秒级
-	LastSubtime  SubtimeType   // 尾部时间戳精度，纳秒级
-	MaxSubtime   SubtimeType   // 最大时间戳精度，纳秒级
-	MinSubtime   SubtimeType   // 最小时间戳精度，纳秒级
-	FirstValue   float64       // 首部值
-	LastValue    float64       // 尾部值
-	MaxValue     float64       // 最大值
-	MinValue     float64       // 最小值
-	FirstQuality int16         // 首部质量
-	LastQuality  int16         // 尾部质量
-	MaxQuality   int16         // 最大质量
-	MinQuality   int16         // 最小质量
-	Power        float64       // 加权值
-	PowerAvg     float64       // 加权平均值
-	Total        float64       // 累计值
-	CalcAvg      float64       // 算数平均值
-	Count        int32         // 个数
-	ValidCount   int32         // 有效个数
-}
-
 func cToGoRtdbSummaryData(data *C.RTDB_SUMMARY_DATA) *RtdbSummaryData {
 	rtn := RtdbSummaryData{
 		FirstTime:    TimestampType(data.first_time),
@@ -6318,9 +506,9 @@ func goToCRtdbSummaryData(data *RtdbSummaryData) *C.RTDB_SUMMARY_DATA {
 	return &rtn
 }
 
-/////////////////////////////// 上面是结构定义 ////////////////////////////////////
+/////////////////////////////// 上面是结构转换 ////////////////////////////////////
 /////////////////////////////// -- 躺平的分隔线 -- ////////////////////////////////
-// 别问为啥不分文件，问就是懒 // 基本上是1:1还原的C端API // 这套API性能高但比较复杂 ///////
+// 类型定义位于types.go // 基本上是1:1还原的C端API // 这套API性能高但比较复杂 ////////////
 /////////////////////////////// -- 躺平的分隔线 -- ////////////////////////////////
 /////////////////////////////// 下面是函数实现 ////////////////////////////////////
 
@@ -6374,7 +562,7 @@ func RawRtdbCreateDatagramHandleWarp(port int32, remoteHost string) (DatagramHan
 	cRemoteHost := C.CString(remoteHost)
 	defer C.free(unsafe.Pointer(cRemoteHost))
 	err := C.rtdb_create_datagram_handle_warp(cPort, cRemoteHost, &handle)
-	return DatagramHandle{handle: handle}, RtdbError(err)
+	return DatagramHandle{handle: unsafe.Pointer(handle)}, RtdbError(err)
 }
 
 // RawRtdbRemoveDatagramHandleWarp 删除数据流
@@ -6385,7 +573,7 @@ func RawRtdbCreateDatagramHandleWarp(port int32, remoteHost string) (DatagramHan
 // raw_fn:
 //   - rtdb_error RTDBAPI_CALLRULE rtdb_remove_datagram_handle_warp(rtdb_datagram_handle handle)
 func RawRtdbRemoveDatagramHandleWarp(handle DatagramHandle) RtdbError {
-	err := C.rtdb_remove_datagram_handle_warp(handle.handle)
+	err := C.rtdb_remove_datagram_handle_warp(C.rtdb_datagram_handle(handle.handle))
 	return RtdbError(err)
 }
 
@@ -6409,7 +597,7 @@ func RawRtdbRecvDatagramWarp(handle DatagramHandle, cacheLen int32, remoteAddr s
 	cRemoteAddr := C.CString(remoteAddr)
 	defer C.free(unsafe.Pointer(cRemoteAddr))
 	cTimeout := C.rtdb_int32(timeout)
-	err := C.rtdb_recv_datagram_warp(cMessage, &messageLen, handle.handle, cRemoteAddr, cTimeout)
+	err := C.rtdb_recv_datagram_warp(cMessage, &messageLen, C.rtdb_datagram_handle(handle.handle), cRemoteAddr, cTimeout)
 	return message[:messageLen], RtdbError(err)
 }
 
@@ -7564,6 +1752,254 @@ func RawRtdbFormatQualityWarp(handle ConnectHandle, qualities []Quality) ([]stri
 	return rtnDefinitions, RtdbError(err)
 }
 
+// RawRtdbWriteNamedTypeFieldByName32Warp 按名称填充自定义类型数值中字段的内容
+//
+// input:
+//   - handle 连接句柄
+//   - typeName 自定义类型的名称
+//   - fieldName 字段的名称
+//   - fieldType 字段的类型，RtdbType所支持的基础类型
+//   - object 自定义类型数值，长度与自定义类型一致，字段内容直接填充到object中
+//   - field 需要填充的字段数值
+//
+// raw_fn:
+//   - rtdb_error RTDBAPI_CALLRULE rtdb_write_named_type_field_by_name32_warp(rtdb_int32 handle, const char* type_name, const char* field_name, rtdb_int32 field_type, void* object, rtdb_length_type object_len, const void* field, rtdb_length_type field_len)
+func RawRtdbWriteNamedTypeFieldByName32Warp(handle ConnectHandle, typeName string, fieldName string, fieldType RtdbType, object []byte, field []byte) RtdbError {
+	cHandle := C.rtdb_int32(handle)
+	cTypeName := C.CString(typeName)
+	defer C.free(unsafe.Pointer(cTypeName))
+	cFieldName := C.CString(fieldName)
+	defer C.free(unsafe.Pointer(cFieldName))
+	cFieldType := C.rtdb_int32(fieldType)
+	cObject := unsafe.Pointer(unsafe.SliceData(object))
+	cObjectLen := C.rtdb_length_type(len(object))
+	cField := unsafe.Pointer(unsafe.SliceData(field))
+	cFieldLen := C.rtdb_length_type(len(field))
+	err := C.rtdb_write_named_type_field_by_name32_warp(cHandle, cTypeName, cFieldName, cFieldType, cObject, cObjectLen, cField, cFieldLen)
+	return RtdbError(err)
+}
+
+// RawRtdbWriteNamedTypeFieldByPos32Warp 按位置填充自定义类型数值中字段的内容
+//
+// input:
+//   - handle 连接句柄
+//   - typeName 自定义类型的名称
+//   - fieldPos 字段的位置，从0开始
+//   - fieldType 字段的类型，RtdbType所支持的基础类型
+//   - object 自定义类型数值，长度与自定义类型一致，字段内容直接填充到object中
+//   - field 需要填充的字段数值
+//
+// raw_fn:
+//   - rtdb_error RTDBAPI_CALLRULE rtdb_write_named_type_field_by_pos32_warp(rtdb_int32 handle, const char* type_name, rtdb_int32 field_pos, rtdb_int32 field_type, void* object, rtdb_length_type object_len, const void* field, rtdb_length_type field_len)
+func RawRtdbWriteNamedTypeFieldByPos32Warp(handle ConnectHandle, typeName string, fieldPos int32, fieldType RtdbType, object []byte, field []byte) RtdbError {
+	cHandle := C.rtdb_int32(handle)
+	cTypeName := C.CString(typeName)
+	defer C.free(unsafe.Pointer(cTypeName))
+	cFieldPos := C.rtdb_int32(fieldPos)
+	cFieldType := C.rtdb_int32(fieldType)
+	cObject := unsafe.Pointer(unsafe.SliceData(object))
+	cObjectLen := C.rtdb_length_type(len(object))
+	cField := unsafe.Pointer(unsafe.SliceData(field))
+	cFieldLen := C.rtdb_length_type(len(field))
+	err := C.rtdb_write_named_type_field_by_pos32_warp(cHandle, cTypeName, cFieldPos, cFieldType, cObject, cObjectLen, cField, cFieldLen)
+	return RtdbError(err)
+}
+
+// RawRtdbReadNamedTypeFieldByName32Warp 按名称提取自定义类型数值中字段的内容
+//
+// input:
+//   - handle 连接句柄
+//   - typeName 自定义类型的名称
+//   - fieldName 字段的名称
+//   - fieldType 字段的类型，RtdbType所支持的基础类型
+//   - object 自定义类型数值
+//   - fieldLen 字段数值的长度
+//
+// output:
+//   - []byte(field) 字段数值
+//
+// raw_fn:
+//   - rtdb_error RTDBAPI_CALLRULE rtdb_read_named_type_field_by_name32_warp(rtdb_int32 handle, const char* type_name, const char* field_name, rtdb_int32 field_type, const void* object, rtdb_length_type object_len, void* field, rtdb_length_type field_len)
+func RawRtdbReadNamedTypeFieldByName32Warp(handle ConnectHandle, typeName string, fieldName string, fieldType RtdbType, object []byte, fieldLen int32) ([]byte, RtdbError) {
+	cHandle := C.rtdb_int32(handle)
+	cTypeName := C.CString(typeName)
+	defer C.free(unsafe.Pointer(cTypeName))
+	cFieldName := C.CString(fieldName)
+	defer C.free(unsafe.Pointer(cFieldName))
+	cFieldType := C.rtdb_int32(fieldType)
+	cObject := unsafe.Pointer(unsafe.SliceData(object))
+	cObjectLen := C.rtdb_length_type(len(object))
+	field := make([]byte, fieldLen)
+	cField := unsafe.Pointer(unsafe.SliceData(field))
+	cFieldLen := C.rtdb_length_type(fieldLen)
+	err := C.rtdb_read_named_type_field_by_name32_warp(cHandle, cTypeName, cFieldName, cFieldType, cObject, cObjectLen, cField, cFieldLen)
+	return field, RtdbError(err)
+}
+
+// RawRtdbReadNamedTypeFieldByPos32Warp 按位置提取自定义类型数值中字段的内容
+//
+// input:
+//   - handle 连接句柄
+//   - typeName 自定义类型的名称
+//   - fieldPos 字段的位置，从0开始
+//   - fieldType 字段的类型，RtdbType所支持的基础类型
+//   - object 自定义类型数值
+//   - fieldLen 字段数值的长度
+//
+// output:
+//   - []byte(field) 字段数值
+//
+// raw_fn:
+//   - rtdb_error RTDBAPI_CALLRULE rtdb_read_named_type_field_by_pos32_warp(rtdb_int32 handle, const char* type_name, rtdb_int32 field_pos, rtdb_int32 field_type, const void* object, rtdb_length_type object_len, void* field, rtdb_length_type field_len)
+func RawRtdbReadNamedTypeFieldByPos32Warp(handle ConnectHandle, typeName string, fieldPos int32, fieldType RtdbType, object []byte, fieldLen int32) ([]byte, RtdbError) {
+	cHandle := C.rtdb_int32(handle)
+	cTypeName := C.CString(typeName)
+	defer C.free(unsafe.Pointer(cTypeName))
+	cFieldPos := C.rtdb_int32(fieldPos)
+	cFieldType := C.rtdb_int32(fieldType)
+	cObject := unsafe.Pointer(unsafe.SliceData(object))
+	cObjectLen := C.rtdb_length_type(len(object))
+	field := make([]byte, fieldLen)
+	cField := unsafe.Pointer(unsafe.SliceData(field))
+	cFieldLen := C.rtdb_length_type(fieldLen)
+	err := C.rtdb_read_named_type_field_by_pos32_warp(cHandle, cTypeName, cFieldPos, cFieldType, cObject, cObjectLen, cField, cFieldLen)
+	return field, RtdbError(err)
+}
+
+// RawRtdbNamedTypeNameFieldCheckWarp 检查自定义类型名称及字段命名是否符合规则
+// 规则：1. 只允许使用26个英文字母,数字0-9，下划线；2. 必须以字母作为首字母；3. 大小写不敏感。
+//
+// input:
+//   - checkName 需要检查的名称
+//   - flag 标志0--类型名称，其他 -- 字段名称，暂不启用
+//
+// raw_fn:
+//   - rtdb_error RTDBAPI_CALLRULE rtdb_named_type_name_field_check_warp(const char* check_name, rtdb_byte flag)
+func RawRtdbNamedTypeNameFieldCheckWarp(checkName string, flag byte) RtdbError {
+	cCheckName := C.CString(checkName)
+	defer C.free(unsafe.Pointer(cCheckName))
+	err := C.rtdb_named_type_name_field_check_warp(cCheckName, C.rtdb_byte(flag))
+	return RtdbError(err)
+}
+
+// RawRtdbWriteNamedTypeFieldByNameWarp 按名称填充自定义类型数值中字段的内容
+// 备注：已废弃，长度为16位整数，请使用 RawRtdbWriteNamedTypeFieldByName32Warp
+//
+// input:
+//   - handle 连接句柄
+//   - typeName 自定义类型的名称
+//   - fieldName 字段的名称
+//   - fieldType 字段的类型，RtdbType所支持的基础类型
+//   - object 自定义类型数值，长度与自定义类型一致，字段内容直接填充到object中
+//   - field 需要填充的字段数值
+//
+// raw_fn:
+//   - rtdb_error RTDBAPI_CALLRULE rtdb_write_named_type_field_by_name_warp(rtdb_int32 handle, const char* type_name, const char* field_name, rtdb_int32 field_type, void* object, rtdb_int16 object_len, const void* field, rtdb_int16 field_len)
+func RawRtdbWriteNamedTypeFieldByNameWarp(handle ConnectHandle, typeName string, fieldName string, fieldType RtdbType, object []byte, field []byte) RtdbError {
+	cHandle := C.rtdb_int32(handle)
+	cTypeName := C.CString(typeName)
+	defer C.free(unsafe.Pointer(cTypeName))
+	cFieldName := C.CString(fieldName)
+	defer C.free(unsafe.Pointer(cFieldName))
+	cFieldType := C.rtdb_int32(fieldType)
+	cObject := unsafe.Pointer(unsafe.SliceData(object))
+	cObjectLen := C.rtdb_int16(len(object))
+	cField := unsafe.Pointer(unsafe.SliceData(field))
+	cFieldLen := C.rtdb_int16(len(field))
+	err := C.rtdb_write_named_type_field_by_name_warp(cHandle, cTypeName, cFieldName, cFieldType, cObject, cObjectLen, cField, cFieldLen)
+	return RtdbError(err)
+}
+
+// RawRtdbWriteNamedTypeFieldByPosWarp 按位置填充自定义类型数值中字段的内容
+// 备注：已废弃，长度为16位整数，请使用 RawRtdbWriteNamedTypeFieldByPos32Warp
+//
+// input:
+//   - handle 连接句柄
+//   - typeName 自定义类型的名称
+//   - fieldPos 字段的位置，从0开始
+//   - fieldType 字段的类型，RtdbType所支持的基础类型
+//   - object 自定义类型数值，长度与自定义类型一致，字段内容直接填充到object中
+//   - field 需要填充的字段数值
+//
+// raw_fn:
+//   - rtdb_error RTDBAPI_CALLRULE rtdb_write_named_type_field_by_pos_warp(rtdb_int32 handle, const char* type_name, rtdb_int32 field_pos, rtdb_int32 field_type, void* object, rtdb_int16 object_len, const void* field, rtdb_int16 field_len)
+func RawRtdbWriteNamedTypeFieldByPosWarp(handle ConnectHandle, typeName string, fieldPos int32, fieldType RtdbType, object []byte, field []byte) RtdbError {
+	cHandle := C.rtdb_int32(handle)
+	cTypeName := C.CString(typeName)
+	defer C.free(unsafe.Pointer(cTypeName))
+	cFieldPos := C.rtdb_int32(fieldPos)
+	cFieldType := C.rtdb_int32(fieldType)
+	cObject := unsafe.Pointer(unsafe.SliceData(object))
+	cObjectLen := C.rtdb_int16(len(object))
+	cField := unsafe.Pointer(unsafe.SliceData(field))
+	cFieldLen := C.rtdb_int16(len(field))
+	err := C.rtdb_write_named_type_field_by_pos_warp(cHandle, cTypeName, cFieldPos, cFieldType, cObject, cObjectLen, cField, cFieldLen)
+	return RtdbError(err)
+}
+
+// RawRtdbReadNamedTypeFieldByNameWarp 按名称提取自定义类型数值中字段的内容
+// 备注：已废弃，长度为16位整数，请使用 RawRtdbReadNamedTypeFieldByName32Warp
+//
+// input:
+//   - handle 连接句柄
+//   - typeName 自定义类型的名称
+//   - fieldName 字段的名称
+//   - fieldType 字段的类型，RtdbType所支持的基础类型
+//   - object 自定义类型数值
+//   - fieldLen 字段数值的长度
+//
+// output:
+//   - []byte(field) 字段数值
+//
+// raw_fn:
+//   - rtdb_error RTDBAPI_CALLRULE rtdb_read_named_type_field_by_name_warp(rtdb_int32 handle, const char* type_name, const char* field_name, rtdb_int32 field_type, const void* object, rtdb_int16 object_len, void* field, rtdb_int16 field_len)
+func RawRtdbReadNamedTypeFieldByNameWarp(handle ConnectHandle, typeName string, fieldName string, fieldType RtdbType, object []byte, fieldLen int32) ([]byte, RtdbError) {
+	cHandle := C.rtdb_int32(handle)
+	cTypeName := C.CString(typeName)
+	defer C.free(unsafe.Pointer(cTypeName))
+	cFieldName := C.CString(fieldName)
+	defer C.free(unsafe.Pointer(cFieldName))
+	cFieldType := C.rtdb_int32(fieldType)
+	cObject := unsafe.Pointer(unsafe.SliceData(object))
+	cObjectLen := C.rtdb_int16(len(object))
+	field := make([]byte, fieldLen)
+	cField := unsafe.Pointer(unsafe.SliceData(field))
+	cFieldLen := C.rtdb_int16(fieldLen)
+	err := C.rtdb_read_named_type_field_by_name_warp(cHandle, cTypeName, cFieldName, cFieldType, cObject, cObjectLen, cField, cFieldLen)
+	return field, RtdbError(err)
+}
+
+// RawRtdbReadNamedTypeFieldByPosWarp 按位置提取自定义类型数值中字段的内容
+// 备注：已废弃，长度为16位整数，请使用 RawRtdbReadNamedTypeFieldByPos32Warp
+//
+// input:
+//   - handle 连接句柄
+//   - typeName 自定义类型的名称
+//   - fieldPos 字段的位置，从0开始
+//   - fieldType 字段的类型，RtdbType所支持的基础类型
+//   - object 自定义类型数值
+//   - fieldLen 字段数值的长度
+//
+// output:
+//   - []byte(field) 字段数值
+//
+// raw_fn:
+//   - rtdb_error RTDBAPI_CALLRULE rtdb_read_named_type_field_by_pos_warp(rtdb_int32 handle, const char* type_name, rtdb_int32 field_pos, rtdb_int32 field_type, const void* object, rtdb_int16 object_len, void* field, rtdb_int16 field_len)
+func RawRtdbReadNamedTypeFieldByPosWarp(handle ConnectHandle, typeName string, fieldPos int32, fieldType RtdbType, object []byte, fieldLen int32) ([]byte, RtdbError) {
+	cHandle := C.rtdb_int32(handle)
+	cTypeName := C.CString(typeName)
+	defer C.free(unsafe.Pointer(cTypeName))
+	cFieldPos := C.rtdb_int32(fieldPos)
+	cFieldType := C.rtdb_int32(fieldType)
+	cObject := unsafe.Pointer(unsafe.SliceData(object))
+	cObjectLen := C.rtdb_int16(len(object))
+	field := make([]byte, fieldLen)
+	cField := unsafe.Pointer(unsafe.SliceData(field))
+	cFieldLen := C.rtdb_int16(fieldLen)
+	err := C.rtdb_read_named_type_field_by_pos_warp(cHandle, cTypeName, cFieldPos, cFieldType, cObject, cObjectLen, cField, cFieldLen)
+	return field, RtdbError(err)
+}
+
 // RawRtdbJudgeConnectStatusWarp 判断连接是否可用
 //
 // input:
@@ -1,3 +1,5 @@
+//go:build cgo
+
 package rtdb_api
 
 import (
@@ -5,11 +7,6 @@ import (
 	"testing"
 )
 
-const Hostname = "159.75.187.68"
-const Port = 6327
-const Username = "sa"
-const Password = "golden"
-
 func TestNULL(t *testing.T) {}
 
 // 获取 API库 版本
@@ -0,0 +1,100 @@
+package rtdb_api
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+// duplicateArchiveBackend 模拟秒精度的服务端: 历史数据可以有相同的时间戳, 查询时忽略纳秒部分
+type duplicateArchiveBackend struct {
+	Backend
+	datetimes []TimestampType
+	reads     *int
+}
+
+func (b duplicateArchiveBackend) RawRtdbhGetArchivedValues64Warp(handle ConnectHandle, id PointID, count int32, datetime1 TimestampType, subtime1 SubtimeType, datetime2 TimestampType, subtime2 SubtimeType) ([]TimestampType, []SubtimeType, []float64, []int64, []Quality, RtdbError) {
+	*b.reads++
+	datetimes, subtimes, values, states, qualities := make([]TimestampType, 0), make([]SubtimeType, 0), make([]float64, 0), make([]int64, 0), make([]Quality, 0)
+	for i, datetime := range b.datetimes {
+		if datetime < datetime1 || datetime > datetime2 || len(datetimes) == int(count) {
+			continue
+		}
+		datetimes, subtimes, values, states, qualities = append(datetimes, datetime), append(subtimes, 0), append(values, float64(i)), append(states, 0), append(qualities, QualityGood)
+	}
+	return datetimes, subtimes, values, states, qualities, RteOk
+}
+
+// 分页边界落在相同的时间戳上时, 不丢失也不重复数值
+func TestArchivedValues_DuplicateTimestamps(t *testing.T) {
+	defer func(size int) { archivedValuesPageSize = size }(archivedValuesPageSize)
+	archivedValuesPageSize = 3
+
+	base := TimestampType(1700000000)
+	reads := 0
+	backend := duplicateArchiveBackend{
+		Backend:   NewMemoryBackend(),
+		datetimes: []TimestampType{base, base, base, base + 1, base + 1, base + 1, base + 1, base + 2, base + 3, base + 3},
+		reads:     &reads,
+	}
+	conn, err := LoginWithBackend(backend, "127.0.0.1", Port, Username, Password)
+	if err != nil {
+		t.Fatal("登录用户失败", err)
+	}
+	defer func() { _ = conn.Logout() }()
+	table, err := conn.CreateTable("archived", "分批读取历史数据")
+	if err != nil {
+		t.Fatal(err)
+	}
+	info, err := conn.AddPoint(NewPointInfo("dup", table.ID, ValueTypeFloat64, PointBase, RtdbPrecisionSecond, "", ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	read := func(start time.Time) []float64 {
+		reads = 0
+		values := make([]float64, 0)
+		for tvq, err := range conn.ArchivedValues(info, start, time.Unix(int64(base)+10, 0)) {
+			if err != nil {
+				t.Fatal(err)
+			}
+			if values = append(values, tvq.Value.FloatValue); len(values) > len(backend.datetimes) {
+				t.Fatal("重复读取了历史数据", values)
+			}
+		}
+		return values
+	}
+
+	// 每一批的最后一个时间戳都有没读完的数值
+	if values := read(time.Unix(int64(base), 0)); !slices.Equal(values, []float64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}) {
+		t.Fatal("分批读取历史数据错误", values)
+	}
+	if reads > len(backend.datetimes) {
+		t.Error("读取次数过多", reads)
+	}
+
+	// 开始时间向上取整到秒, 不会读到开始时间之前的数值
+	if values := read(time.Unix(int64(base), int64(500*time.Millisecond))); !slices.Equal(values, []float64{3, 4, 5, 6, 7, 8, 9}) {
+		t.Fatal("开始时间取整错误", values)
+	}
+}
+
+func TestCeilPrecision(t *testing.T) {
+	t0 := time.Unix(1700000000, 123456789)
+	for _, c := range []struct {
+		precision RtdbPrecision
+		want      time.Time
+	}{
+		{RtdbPrecisionSecond, time.Unix(1700000001, 0)},
+		{RtdbPrecisionMilli, time.Unix(1700000000, 124000000)},
+		{RtdbPrecisionMicro, time.Unix(1700000000, 123457000)},
+		{RtdbPrecisionNano, t0},
+	} {
+		if got := ceilPrecision(t0, c.precision); !got.Equal(c.want) {
+			t.Error("向上取整错误", c.precision, got)
+		}
+	}
+	if got := ceilPrecision(time.Unix(1700000000, 0), RtdbPrecisionSecond); !got.Equal(time.Unix(1700000000, 0)) {
+		t.Error("整秒不需要取整", got)
+	}
+}
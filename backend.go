@@ -0,0 +1,632 @@
+package rtdb_api
+
+import "errors"
+
+// Backend 数据库后端, easy封装中对数据库的所有调用都经过Backend完成
+//   - NativeBackend: 基于CGO调用C动态库, 需要开启CGO, 方法与api.go中的同名函数1比1对应
+//   - MemoryBackend: 纯Go实现的内存数据库, 不依赖CGO, 用于测试和不需要连接真实数据库的工具
+//   - 自定义后端可以内嵌 UnimplementedBackend, 只实现需要的方法
+type Backend interface {
+	// 连接、用户、安全、系统参数与文件
+	RawRtdbGetApiVersionWarp() (ApiVersion, RtdbError)
+	RawRtdbSetOptionWarp(optionType RtdbApiOption, value int32) RtdbError
+	RawRtdbConnectWarp(hostname string, port int32) (ConnectHandle, RtdbError)
+	RawRtdbLoginWarp(handle ConnectHandle, user string, password string) (PrivGroup, RtdbError)
+	RawRtdbDisconnectWarp(handle ConnectHandle) RtdbError
+	RawRtdbGetDbInfo1Warp(handle ConnectHandle, param RtdbParam) (ParamString, RtdbError)
+	RawRtdbGetDbInfo2Warp(handle ConnectHandle, param RtdbParam) (ParamInt, RtdbError)
+	RawRtdbSetDbInfo1Warp(handle ConnectHandle, param RtdbParam, value ParamString) RtdbError
+	RawRtdbSetDbInfo2Warp(handle ConnectHandle, param RtdbParam, value ParamInt) RtdbError
+	RawRtdbConnectionCountWarp(handle ConnectHandle, nodeNumber int32) (int32, RtdbError)
+	RawRtdbGetConnectionsWarp(handle ConnectHandle, nodeNumber int32, count int32) ([]SocketHandle, RtdbError)
+	RawRtdbGetOwnConnectionWarp(handle ConnectHandle, nodeNumber int32) (SocketHandle, RtdbError)
+	RawRtdbGetConnectionInfoIpv6Warp(handle ConnectHandle, nodeNumber int32, socket SocketHandle) (RtdbHostConnectInfoIpv6, RtdbError)
+	RawRtdbOsType(handle ConnectHandle) (RtdbOsType, RtdbError)
+	RawRtdbChangePasswordWarp(handle ConnectHandle, user string, password string) RtdbError
+	RawRtdbChangeMyPasswordWarp(handle ConnectHandle, oldPwd string, newPwd string) RtdbError
+	RawRtdbGetPrivWarp(handle ConnectHandle) (PrivGroup, RtdbError)
+	RawRtdbChangePrivWarp(handle ConnectHandle, user string, priv PrivGroup) RtdbError
+	RawRtdbAddUserWarp(handle ConnectHandle, user string, password string, priv PrivGroup) RtdbError
+	RawRtdbRemoveUserWarp(handle ConnectHandle, user string) RtdbError
+	RawRtdbLockUserWarp(handle ConnectHandle, user string, lock Switch) RtdbError
+	RawRtdbGetUsersWarp(handle ConnectHandle) ([]RtdbUserInfo, RtdbError)
+	RawRtdbAddBlacklistWarp(handle ConnectHandle, addr string, mask string, desc string) RtdbError
+	RawRtdbUpdateBlacklistWarp(handle ConnectHandle, oldAddr string, oldMask string, newAddr string, newMask string, newDesc string) RtdbError
+	RawRtdbRemoveBlacklistWarp(handle ConnectHandle, addr string, mask string) RtdbError
+	RawRtdbGetBlacklistWarp(handle ConnectHandle) ([]BlackList, RtdbError)
+	RawRtdbAddAuthorizationWarp(handle ConnectHandle, addr string, mask string, desc string, priv PrivGroup) RtdbError
+	RawRtdbUpdateAuthorizationWarp(handle ConnectHandle, oldAddr string, oldMask string, newAddr string, newMask string, newDesc string, priv PrivGroup) RtdbError
+	RawRtdbRemoveAuthorizationWarp(handle ConnectHandle, addr string, mask string) RtdbError
+	RawRtdbGetAuthorizationsWarp(handle ConnectHandle) ([]AuthorizationsList, RtdbError)
+	RawRtdbHostTime64Warp(handle ConnectHandle) (TimestampType, RtdbError)
+	RawRtdbFormatTimespanWarp(timespan int32) (string, RtdbError)
+	RawRtdbParseTimespanWarp(tStr string) (DateTimeType, RtdbError)
+	RawRtdbParseTimeWarp(tStr string) (TimestampType, SubtimeType, RtdbError)
+	RawRtdbSetTimeoutWarp(handle ConnectHandle, socket SocketHandle, timeout DateTimeType) RtdbError
+	RawRtdbGetTimeoutWarp(handle ConnectHandle, socket SocketHandle) (DateTimeType, RtdbError)
+	RawRtdbKillConnectionWarp(handle ConnectHandle, socket SocketHandle) RtdbError
+	RawRtdbGetLogicalDriversWarp(handle ConnectHandle) ([]string, RtdbError)
+	RawRtdbOpenPathWarp(handle ConnectHandle, dir string) RtdbError
+	RawRtdbReadPath64Warp(handle ConnectHandle) (DirItem, RtdbError)
+	RawRtdbClosePathWarp(handle ConnectHandle) RtdbError
+	RawRtdbMkdirWarp(handle ConnectHandle, dirName string) RtdbError
+	RawRtdbGetFileSizeWarp(handle ConnectHandle, filePath string) (int64, RtdbError)
+	RawRtdbReadFileWarp(handle ConnectHandle, filePath string, pos int64, cacheSize int64) ([]byte, RtdbError)
+	RawRtdbGetMaxBlobLenWarp(handle ConnectHandle) (int32, RtdbError)
+	RawRtdbFormatQualityWarp(handle ConnectHandle, qualities []Quality) ([]string, RtdbError)
+	RawRtdbJudgeConnectStatusWarp(handle ConnectHandle) RtdbError
+
+	// 表、标签点与自定义类型
+	RawRtdbbAppendTableWarp(handle ConnectHandle, tableName, tableDesc string) (RtdbTable, RtdbError)
+	RawRtdbbRemoveTableByIdWarp(handle ConnectHandle, tableID TableID) RtdbError
+	RawRtdbbTablesCountWarp(handle ConnectHandle) (int32, RtdbError)
+	RawRtdbbGetTablesWarp(handle ConnectHandle, count int32) ([]TableID, RtdbError)
+	RawRtdbbGetTablePropertyByIdWarp(handle ConnectHandle, tableID TableID) (RtdbTable, RtdbError)
+	RawRtdbbInsertMaxPointWarp(handle ConnectHandle, base *RtdbPoint, scan *RtdbScan, calc *RtdbCalc) (*RtdbPoint, *RtdbScan, *RtdbCalc, RtdbError)
+	RawRtdbbRemovePointByIdWarp(handle ConnectHandle, id PointID) RtdbError
+	RawRtdbbInsertNamedTypePointWarp(handle ConnectHandle, base *RtdbPoint, scan *RtdbScan, name string) (*RtdbPoint, *RtdbScan, RtdbError)
+	RawRtdbbMovePointByIdWarp(handle ConnectHandle, id PointID, tableName string) RtdbError
+	RawRtdbbGetMaxPointsPropertyWarp(handle ConnectHandle, ids []PointID) ([]RtdbPoint, []RtdbScan, []RtdbCalc, []RtdbError, RtdbError)
+	RawRtdbbSearchExWarp(handle ConnectHandle, maxCount int32, tagMask, tableMask, source, unit, desc, instrument, typeMask string, classOfMask RtdbType, timeUnitMask RtdbPrecision, otherTypeMask RtdbSearch, otherTypeMaskValue string, model RtdbSortFlag) ([]PointID, RtdbError)
+	RawRtdbbSearchPointsCountWarp(handle ConnectHandle, tagMask, tableMask, source, unit, desc, instrument, typeMask string, classOfMask RtdbType, timeUnitMask RtdbPrecision, otherTypeMask RtdbSearch, otherTypeMaskValue string) (int32, RtdbError)
+	RawRtdbbUpdateMaxPointPropertyWarp(handle ConnectHandle, base *RtdbPoint, scan *RtdbScan, calc *RtdbCalc) RtdbError
+	RawRtdbbFindPointsExWarp(handle ConnectHandle, tableDotTags []string) ([]PointID, []RtdbType, []RtdbClass, []RtdbPrecision, []RtdbError, RtdbError)
+	RawRtdbbUpdateTableNameWarp(handle ConnectHandle, id TableID, name string) RtdbError
+	RawRtdbbUpdateTableDescByIdWarp(handle ConnectHandle, id TableID, desc string) RtdbError
+	RawRtdbbRecoverPointWarp(handle ConnectHandle, tableID TableID, pointID PointID) RtdbError
+	RawRtdbbPurgePointWarp(handle ConnectHandle, id PointID) RtdbError
+	RawRtdbbGetRecycledPointsCountWarp(handle ConnectHandle) (int32, RtdbError)
+	RawRtdbbGetRecycledPointsWarp(handle ConnectHandle, count int32) ([]PointID, RtdbError)
+	RawRtdbbSearchRecycledPointsInBatchesWarp(handle ConnectHandle, start int32, count int32, tagMask, fullMask, source, unit, desc, instrument string, mode RtdbSortFlag) ([]PointID, RtdbError)
+	RawRtdbbGetRecycledMaxPointPropertyWarp(handle ConnectHandle, id PointID) (*RtdbPoint, *RtdbScan, *RtdbCalc, RtdbError)
+	RawRtdbbClearRecyclerWarp(handle ConnectHandle) RtdbError
+	RawRtdbbCreateNamedTypeWarp(handle ConnectHandle, name string, desc string, fields ...RtdbDataTypeField) RtdbError
+	RawRtdbbGetNamedTypesCountWarp(handle ConnectHandle) (int32, RtdbError)
+	RawRtdbbGetAllNamedTypesWarp(handle ConnectHandle, count int32) ([]string, []int32, RtdbError)
+	RawRtdbbGetNamedTypeWarp(handle ConnectHandle, name string, fieldCount int32) ([]RtdbDataTypeField, int32, string, RtdbError)
+	RawRtdbbRemoveNamedTypeWarp(handle ConnectHandle, name string) RtdbError
+	RawRtdbbGetNamedTypeNamesPropertyWarp(handle ConnectHandle, ids []PointID) ([]string, []int32, []RtdbError, RtdbError)
+	RawRtdbbGetRecycledNamedTypeNamesPropertyWarp(handle ConnectHandle, ids []PointID) ([]string, []int32, []RtdbError, RtdbError)
+	RawRtdbbGetNamedTypePointsCountWarp(handle ConnectHandle, name string) (int32, RtdbError)
+	RawRtdbbGetBaseTypePointsCountWarp(handle ConnectHandle, rtdbType RtdbType) (int32, RtdbError)
+	RawRtdbbModifyNamedTypeWarp(handle ConnectHandle, name string, modifyName *string, modifyDesc *string, fieldNames []string, fieldDescs []string) RtdbError
+	RawRtdbWriteNamedTypeFieldByName32Warp(handle ConnectHandle, typeName string, fieldName string, fieldType RtdbType, object []byte, field []byte) RtdbError
+	RawRtdbWriteNamedTypeFieldByPos32Warp(handle ConnectHandle, typeName string, fieldPos int32, fieldType RtdbType, object []byte, field []byte) RtdbError
+	RawRtdbReadNamedTypeFieldByName32Warp(handle ConnectHandle, typeName string, fieldName string, fieldType RtdbType, object []byte, fieldLen int32) ([]byte, RtdbError)
+	RawRtdbReadNamedTypeFieldByPos32Warp(handle ConnectHandle, typeName string, fieldPos int32, fieldType RtdbType, object []byte, fieldLen int32) ([]byte, RtdbError)
+	RawRtdbNamedTypeNameFieldCheckWarp(checkName string, flag byte) RtdbError
+	RawRtdbbGetMetaSyncInfoWarp(handle ConnectHandle, nodeNumber int32) ([]RtdbSyncInfo, []RtdbError, RtdbError)
+
+	// 存档文件
+	RawRtdbaGetArchivesCountWarp(handle ConnectHandle) (int32, RtdbError)
+	RawRtdbaGetArchivesWarp(handle ConnectHandle, maxCount int32) ([]string, []string, []RtdbArchiveState, RtdbError)
+
+	// 快照
+	RawRtdbsPutSnapshots64Warp(handle ConnectHandle, ids []PointID, datetimes []TimestampType, subtimes []SubtimeType, values []float64, states []int64, qualities []Quality) ([]RtdbError, RtdbError)
+	RawRtdbsFixSnapshots64Warp(handle ConnectHandle, ids []PointID, datetimes []TimestampType, subtimes []SubtimeType, values []float64, states []int64, qualities []Quality) ([]RtdbError, RtdbError)
+	RawRtdbsPutCoorSnapshots64Warp(handle ConnectHandle, ids []PointID, datetimes []TimestampType, subtimes []SubtimeType, xs []float32, ys []float32, qualities []Quality) ([]RtdbError, RtdbError)
+	RawRtdbsFixCoorSnapshots64Warp(handle ConnectHandle, ids []PointID, datetimes []TimestampType, subtimes []SubtimeType, xs []float32, ys []float32, qualities []Quality) ([]RtdbError, RtdbError)
+	RawRtdbsPutBlobSnapshots64Warp(handle ConnectHandle, ids []PointID, datetimes []TimestampType, subtimes []SubtimeType, blobs [][]byte, qualities []Quality) ([]RtdbError, RtdbError)
+	RawRtdbsPutDatetimeSnapshots64Warp(handle ConnectHandle, ids []PointID, datetimes []TimestampType, subtimes []SubtimeType, dtValues []string, qualities []Quality) ([]RtdbError, RtdbError)
+	RawRtdbsPutNamedTypeSnapshots64Warp(handle ConnectHandle, ids []PointID, datetimes []TimestampType, subtimes []SubtimeType, objects [][]byte, qualities []Quality) ([]RtdbError, RtdbError)
+	RawRtdbsGetSnapshots64Warp(handle ConnectHandle, ids []PointID) ([]TimestampType, []SubtimeType, []float64, []int64, []Quality, []RtdbError, RtdbError)
+	RawRtdbsGetCoorSnapshots64Warp(handle ConnectHandle, ids []PointID) ([]TimestampType, []SubtimeType, []float32, []float32, []Quality, []RtdbError, RtdbError)
+	RawRtdbsGetBlobSnapshots64Warp(handle ConnectHandle, ids []PointID, maxLen int32) ([]TimestampType, []SubtimeType, [][]byte, []Quality, []RtdbError, RtdbError)
+	RawRtdbsGetDatetimeSnapshots64Warp(handle ConnectHandle, ids []PointID, typ int16) ([]TimestampType, []SubtimeType, []string, []Quality, []RtdbError, RtdbError)
+	RawRtdbsGetNamedTypeSnapshots64Warp(handle ConnectHandle, ids []PointID, lens []int32) ([]TimestampType, []SubtimeType, [][]byte, []Quality, []RtdbError, RtdbError)
+
+	// 订阅
+	RawRtdbsSubscribeSnapshotsEx64Warp(handle ConnectHandle, ids []PointID, options RtdbSubscribeOption, callback SnapshotEventFunc) ([]RtdbError, RtdbError)
+	RawRtdbsSubscribeDeltaSnapshots64Warp(handle ConnectHandle, ids []PointID, deltaValues []float64, deltaStates []int64, options RtdbSubscribeOption, callback SnapshotEventFunc) ([]RtdbError, RtdbError)
+	RawRtdbsChangeSubscribeSnapshotsWarp(handle ConnectHandle, ids []PointID, deltaValues []float64, deltaStates []int64, changedTypes []RtdbSubscribeChangeType) ([]RtdbError, RtdbError)
+	RawRtdbsCancelSubscribeSnapshotsWarp(handle ConnectHandle) RtdbError
+
+	// 历史
+	RawRtdbhGetSingleValue64Warp(handle ConnectHandle, id PointID, mode RtdbHisMode, datetime TimestampType, subtime SubtimeType) (TimestampType, SubtimeType, float64, int64, Quality, RtdbError)
+	RawRtdbhGetSingleCoorValue64Warp(handle ConnectHandle, id PointID, mode RtdbHisMode, datetime TimestampType, subtime SubtimeType) (TimestampType, SubtimeType, float32, float32, Quality, RtdbError)
+	RawRtdbhGetSingleBlobValue64Warp(handle ConnectHandle, id PointID, mode RtdbHisMode, datetime TimestampType, subtime SubtimeType, maxLen int32) (TimestampType, SubtimeType, []byte, Quality, RtdbError)
+	RawRtdbhGetSingleDatetimeValue64Warp(handle ConnectHandle, id PointID, mode RtdbHisMode, datetime TimestampType, subtime SubtimeType, dtType int16) (TimestampType, SubtimeType, []byte, Quality, RtdbError)
+	RawRtdbhGetSingleNamedTypeValue64Warp(handle ConnectHandle, id PointID, mode RtdbHisMode, datetime TimestampType, subtime SubtimeType, length int32) (TimestampType, SubtimeType, []byte, Quality, RtdbError)
+	RawRtdbhGetArchivedValues64Warp(handle ConnectHandle, id PointID, count int32, datetime1 TimestampType, subtime1 SubtimeType, datetime2 TimestampType, subtime2 SubtimeType) ([]TimestampType, []SubtimeType, []float64, []int64, []Quality, RtdbError)
+	RawRtdbhGetArchivedCoorValues64Warp(handle ConnectHandle, id PointID, count int32, datetime1 TimestampType, subtime1 SubtimeType, datetime2 TimestampType, subtime2 SubtimeType) ([]TimestampType, []SubtimeType, []float32, []float32, []Quality, RtdbError)
+	RawRtdbhGetArchivedBlobValues64Warp(handle ConnectHandle, id PointID, maxLen int32, maxCount int32, datetime1 TimestampType, subtime1 SubtimeType, datetime2 TimestampType, subtime2 SubtimeType) ([]TimestampType, []SubtimeType, [][]byte, []Quality, RtdbError)
+	RawRtdbhGetArchivedDatetimeValues64Warp(handle ConnectHandle, id PointID, maxCount int32, datetime1 TimestampType, subtime1 SubtimeType, datetime2 TimestampType, subtime2 SubtimeType, dtType int16) ([]TimestampType, []SubtimeType, [][]byte, []Quality, RtdbError)
+	RawRtdbhGetArchivedNamedTypeValues64Warp(handle ConnectHandle, id PointID, datetime1 TimestampType, subtime1 SubtimeType, datetime2 TimestampType, subtime2 SubtimeType, length int32, maxCount int32) ([]TimestampType, []SubtimeType, [][]byte, []Quality, RtdbError)
+	RawRtdbhGetInterpoValues64Warp(handle ConnectHandle, id PointID, count int32, datetime1 TimestampType, subtime1 SubtimeType, datetime2 TimestampType, subtime2 SubtimeType) ([]TimestampType, []SubtimeType, []float64, []int64, []Quality, RtdbError)
+	RawRtdbhSummaryDataWarp(handle ConnectHandle, id PointID, datetime1 TimestampType, subtime1 SubtimeType, datetime2 TimestampType, subtime2 SubtimeType) (*RtdbSummaryData, RtdbError)
+	RawRtdbhPutArchivedDatetimeValues64Warp(handle ConnectHandle, ids []PointID, datetimes []TimestampType, subtimes []SubtimeType, dtValues []string, qualities []Quality) ([]RtdbError, RtdbError)
+	RawRtdbhPutArchivedValues64Warp(handle ConnectHandle, ids []PointID, datetimes []TimestampType, subtimes []SubtimeType, values []float64, states []int64, qualities []Quality) ([]RtdbError, RtdbError)
+	RawRtdbhPutArchivedCoorValues64Warp(handle ConnectHandle, ids []PointID, datetimes []TimestampType, subtimes []SubtimeType, xs []float32, ys []float32, qualities []Quality) ([]RtdbError, RtdbError)
+	RawRtdbhPutArchivedBlobValues64Warp(handle ConnectHandle, ids []PointID, datetimes []TimestampType, subtimes []SubtimeType, blobs [][]byte, qualities []Quality) ([]RtdbError, RtdbError)
+	RawRtdbhPutArchivedNamedTypeValues64Warp(handle ConnectHandle, ids []PointID, datetimes []TimestampType, subtimes []SubtimeType, objects [][]byte, qualities []Quality) ([]RtdbError, RtdbError)
+}
+
+// errNoDefaultBackend 未开启CGO时没有默认后端
+var errNoDefaultBackend = errors.New("未开启CGO，无法使用动态库，请通过LoginWithBackend指定后端")
+
+// UnimplementedBackend 所有方法均返回 RteNotSupportedFeature 的后端, 用于内嵌到自定义后端中
+type UnimplementedBackend struct{}
+
+func (UnimplementedBackend) RawRtdbGetApiVersionWarp() (ApiVersion, RtdbError) {
+	return ApiVersion{}, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbSetOptionWarp(optionType RtdbApiOption, value int32) RtdbError {
+	return RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbConnectWarp(hostname string, port int32) (ConnectHandle, RtdbError) {
+	return 0, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbLoginWarp(handle ConnectHandle, user string, password string) (PrivGroup, RtdbError) {
+	return 0, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbDisconnectWarp(handle ConnectHandle) RtdbError {
+	return RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbGetDbInfo1Warp(handle ConnectHandle, param RtdbParam) (ParamString, RtdbError) {
+	return "", RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbGetDbInfo2Warp(handle ConnectHandle, param RtdbParam) (ParamInt, RtdbError) {
+	return 0, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbSetDbInfo1Warp(handle ConnectHandle, param RtdbParam, value ParamString) RtdbError {
+	return RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbSetDbInfo2Warp(handle ConnectHandle, param RtdbParam, value ParamInt) RtdbError {
+	return RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbConnectionCountWarp(handle ConnectHandle, nodeNumber int32) (int32, RtdbError) {
+	return 0, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbGetConnectionsWarp(handle ConnectHandle, nodeNumber int32, count int32) ([]SocketHandle, RtdbError) {
+	return nil, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbGetOwnConnectionWarp(handle ConnectHandle, nodeNumber int32) (SocketHandle, RtdbError) {
+	return 0, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbGetConnectionInfoIpv6Warp(handle ConnectHandle, nodeNumber int32, socket SocketHandle) (RtdbHostConnectInfoIpv6, RtdbError) {
+	return RtdbHostConnectInfoIpv6{}, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbOsType(handle ConnectHandle) (RtdbOsType, RtdbError) {
+	return 0, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbChangePasswordWarp(handle ConnectHandle, user string, password string) RtdbError {
+	return RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbChangeMyPasswordWarp(handle ConnectHandle, oldPwd string, newPwd string) RtdbError {
+	return RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbGetPrivWarp(handle ConnectHandle) (PrivGroup, RtdbError) {
+	return 0, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbChangePrivWarp(handle ConnectHandle, user string, priv PrivGroup) RtdbError {
+	return RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbAddUserWarp(handle ConnectHandle, user string, password string, priv PrivGroup) RtdbError {
+	return RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbRemoveUserWarp(handle ConnectHandle, user string) RtdbError {
+	return RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbLockUserWarp(handle ConnectHandle, user string, lock Switch) RtdbError {
+	return RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbGetUsersWarp(handle ConnectHandle) ([]RtdbUserInfo, RtdbError) {
+	return nil, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbAddBlacklistWarp(handle ConnectHandle, addr string, mask string, desc string) RtdbError {
+	return RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbUpdateBlacklistWarp(handle ConnectHandle, oldAddr string, oldMask string, newAddr string, newMask string, newDesc string) RtdbError {
+	return RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbRemoveBlacklistWarp(handle ConnectHandle, addr string, mask string) RtdbError {
+	return RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbGetBlacklistWarp(handle ConnectHandle) ([]BlackList, RtdbError) {
+	return nil, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbAddAuthorizationWarp(handle ConnectHandle, addr string, mask string, desc string, priv PrivGroup) RtdbError {
+	return RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbUpdateAuthorizationWarp(handle ConnectHandle, oldAddr string, oldMask string, newAddr string, newMask string, newDesc string, priv PrivGroup) RtdbError {
+	return RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbRemoveAuthorizationWarp(handle ConnectHandle, addr string, mask string) RtdbError {
+	return RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbGetAuthorizationsWarp(handle ConnectHandle) ([]AuthorizationsList, RtdbError) {
+	return nil, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbHostTime64Warp(handle ConnectHandle) (TimestampType, RtdbError) {
+	return 0, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbFormatTimespanWarp(timespan int32) (string, RtdbError) {
+	return "", RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbParseTimespanWarp(tStr string) (DateTimeType, RtdbError) {
+	return 0, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbParseTimeWarp(tStr string) (TimestampType, SubtimeType, RtdbError) {
+	return 0, 0, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbSetTimeoutWarp(handle ConnectHandle, socket SocketHandle, timeout DateTimeType) RtdbError {
+	return RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbGetTimeoutWarp(handle ConnectHandle, socket SocketHandle) (DateTimeType, RtdbError) {
+	return 0, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbKillConnectionWarp(handle ConnectHandle, socket SocketHandle) RtdbError {
+	return RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbGetLogicalDriversWarp(handle ConnectHandle) ([]string, RtdbError) {
+	return nil, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbOpenPathWarp(handle ConnectHandle, dir string) RtdbError {
+	return RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbReadPath64Warp(handle ConnectHandle) (DirItem, RtdbError) {
+	return DirItem{}, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbClosePathWarp(handle ConnectHandle) RtdbError {
+	return RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbMkdirWarp(handle ConnectHandle, dirName string) RtdbError {
+	return RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbGetFileSizeWarp(handle ConnectHandle, filePath string) (int64, RtdbError) {
+	return 0, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbReadFileWarp(handle ConnectHandle, filePath string, pos int64, cacheSize int64) ([]byte, RtdbError) {
+	return nil, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbGetMaxBlobLenWarp(handle ConnectHandle) (int32, RtdbError) {
+	return 0, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbFormatQualityWarp(handle ConnectHandle, qualities []Quality) ([]string, RtdbError) {
+	return nil, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbJudgeConnectStatusWarp(handle ConnectHandle) RtdbError {
+	return RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbbAppendTableWarp(handle ConnectHandle, tableName, tableDesc string) (RtdbTable, RtdbError) {
+	return RtdbTable{}, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbbRemoveTableByIdWarp(handle ConnectHandle, tableID TableID) RtdbError {
+	return RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbbTablesCountWarp(handle ConnectHandle) (int32, RtdbError) {
+	return 0, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbbGetTablesWarp(handle ConnectHandle, count int32) ([]TableID, RtdbError) {
+	return nil, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbbGetTablePropertyByIdWarp(handle ConnectHandle, tableID TableID) (RtdbTable, RtdbError) {
+	return RtdbTable{}, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbbInsertMaxPointWarp(handle ConnectHandle, base *RtdbPoint, scan *RtdbScan, calc *RtdbCalc) (*RtdbPoint, *RtdbScan, *RtdbCalc, RtdbError) {
+	return nil, nil, nil, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbbRemovePointByIdWarp(handle ConnectHandle, id PointID) RtdbError {
+	return RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbbInsertNamedTypePointWarp(handle ConnectHandle, base *RtdbPoint, scan *RtdbScan, name string) (*RtdbPoint, *RtdbScan, RtdbError) {
+	return nil, nil, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbbMovePointByIdWarp(handle ConnectHandle, id PointID, tableName string) RtdbError {
+	return RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbbGetMaxPointsPropertyWarp(handle ConnectHandle, ids []PointID) ([]RtdbPoint, []RtdbScan, []RtdbCalc, []RtdbError, RtdbError) {
+	return nil, nil, nil, nil, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbbSearchExWarp(handle ConnectHandle, maxCount int32, tagMask, tableMask, source, unit, desc, instrument, typeMask string, classOfMask RtdbType, timeUnitMask RtdbPrecision, otherTypeMask RtdbSearch, otherTypeMaskValue string, model RtdbSortFlag) ([]PointID, RtdbError) {
+	return nil, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbbSearchPointsCountWarp(handle ConnectHandle, tagMask, tableMask, source, unit, desc, instrument, typeMask string, classOfMask RtdbType, timeUnitMask RtdbPrecision, otherTypeMask RtdbSearch, otherTypeMaskValue string) (int32, RtdbError) {
+	return 0, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbbUpdateMaxPointPropertyWarp(handle ConnectHandle, base *RtdbPoint, scan *RtdbScan, calc *RtdbCalc) RtdbError {
+	return RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbbFindPointsExWarp(handle ConnectHandle, tableDotTags []string) ([]PointID, []RtdbType, []RtdbClass, []RtdbPrecision, []RtdbError, RtdbError) {
+	return nil, nil, nil, nil, nil, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbbUpdateTableNameWarp(handle ConnectHandle, id TableID, name string) RtdbError {
+	return RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbbUpdateTableDescByIdWarp(handle ConnectHandle, id TableID, desc string) RtdbError {
+	return RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbbRecoverPointWarp(handle ConnectHandle, tableID TableID, pointID PointID) RtdbError {
+	return RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbbPurgePointWarp(handle ConnectHandle, id PointID) RtdbError {
+	return RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbbGetRecycledPointsCountWarp(handle ConnectHandle) (int32, RtdbError) {
+	return 0, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbbGetRecycledPointsWarp(handle ConnectHandle, count int32) ([]PointID, RtdbError) {
+	return nil, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbbSearchRecycledPointsInBatchesWarp(handle ConnectHandle, start int32, count int32, tagMask, fullMask, source, unit, desc, instrument string, mode RtdbSortFlag) ([]PointID, RtdbError) {
+	return nil, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbbGetRecycledMaxPointPropertyWarp(handle ConnectHandle, id PointID) (*RtdbPoint, *RtdbScan, *RtdbCalc, RtdbError) {
+	return nil, nil, nil, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbbClearRecyclerWarp(handle ConnectHandle) RtdbError {
+	return RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbbCreateNamedTypeWarp(handle ConnectHandle, name string, desc string, fields ...RtdbDataTypeField) RtdbError {
+	return RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbbGetNamedTypesCountWarp(handle ConnectHandle) (int32, RtdbError) {
+	return 0, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbbGetAllNamedTypesWarp(handle ConnectHandle, count int32) ([]string, []int32, RtdbError) {
+	return nil, nil, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbbGetNamedTypeWarp(handle ConnectHandle, name string, fieldCount int32) ([]RtdbDataTypeField, int32, string, RtdbError) {
+	return nil, 0, "", RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbbRemoveNamedTypeWarp(handle ConnectHandle, name string) RtdbError {
+	return RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbbGetNamedTypeNamesPropertyWarp(handle ConnectHandle, ids []PointID) ([]string, []int32, []RtdbError, RtdbError) {
+	return nil, nil, nil, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbbGetRecycledNamedTypeNamesPropertyWarp(handle ConnectHandle, ids []PointID) ([]string, []int32, []RtdbError, RtdbError) {
+	return nil, nil, nil, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbbGetNamedTypePointsCountWarp(handle ConnectHandle, name string) (int32, RtdbError) {
+	return 0, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbbGetBaseTypePointsCountWarp(handle ConnectHandle, rtdbType RtdbType) (int32, RtdbError) {
+	return 0, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbbModifyNamedTypeWarp(handle ConnectHandle, name string, modifyName *string, modifyDesc *string, fieldNames []string, fieldDescs []string) RtdbError {
+	return RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbWriteNamedTypeFieldByName32Warp(handle ConnectHandle, typeName string, fieldName string, fieldType RtdbType, object []byte, field []byte) RtdbError {
+	return RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbWriteNamedTypeFieldByPos32Warp(handle ConnectHandle, typeName string, fieldPos int32, fieldType RtdbType, object []byte, field []byte) RtdbError {
+	return RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbReadNamedTypeFieldByName32Warp(handle ConnectHandle, typeName string, fieldName string, fieldType RtdbType, object []byte, fieldLen int32) ([]byte, RtdbError) {
+	return nil, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbReadNamedTypeFieldByPos32Warp(handle ConnectHandle, typeName string, fieldPos int32, fieldType RtdbType, object []byte, fieldLen int32) ([]byte, RtdbError) {
+	return nil, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbNamedTypeNameFieldCheckWarp(checkName string, flag byte) RtdbError {
+	return RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbbGetMetaSyncInfoWarp(handle ConnectHandle, nodeNumber int32) ([]RtdbSyncInfo, []RtdbError, RtdbError) {
+	return nil, nil, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbsPutSnapshots64Warp(handle ConnectHandle, ids []PointID, datetimes []TimestampType, subtimes []SubtimeType, values []float64, states []int64, qualities []Quality) ([]RtdbError, RtdbError) {
+	return nil, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbsFixSnapshots64Warp(handle ConnectHandle, ids []PointID, datetimes []TimestampType, subtimes []SubtimeType, values []float64, states []int64, qualities []Quality) ([]RtdbError, RtdbError) {
+	return nil, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbsPutCoorSnapshots64Warp(handle ConnectHandle, ids []PointID, datetimes []TimestampType, subtimes []SubtimeType, xs []float32, ys []float32, qualities []Quality) ([]RtdbError, RtdbError) {
+	return nil, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbsFixCoorSnapshots64Warp(handle ConnectHandle, ids []PointID, datetimes []TimestampType, subtimes []SubtimeType, xs []float32, ys []float32, qualities []Quality) ([]RtdbError, RtdbError) {
+	return nil, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbsPutBlobSnapshots64Warp(handle ConnectHandle, ids []PointID, datetimes []TimestampType, subtimes []SubtimeType, blobs [][]byte, qualities []Quality) ([]RtdbError, RtdbError) {
+	return nil, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbsPutDatetimeSnapshots64Warp(handle ConnectHandle, ids []PointID, datetimes []TimestampType, subtimes []SubtimeType, dtValues []string, qualities []Quality) ([]RtdbError, RtdbError) {
+	return nil, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbsPutNamedTypeSnapshots64Warp(handle ConnectHandle, ids []PointID, datetimes []TimestampType, subtimes []SubtimeType, objects [][]byte, qualities []Quality) ([]RtdbError, RtdbError) {
+	return nil, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbsGetSnapshots64Warp(handle ConnectHandle, ids []PointID) ([]TimestampType, []SubtimeType, []float64, []int64, []Quality, []RtdbError, RtdbError) {
+	return nil, nil, nil, nil, nil, nil, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbsGetCoorSnapshots64Warp(handle ConnectHandle, ids []PointID) ([]TimestampType, []SubtimeType, []float32, []float32, []Quality, []RtdbError, RtdbError) {
+	return nil, nil, nil, nil, nil, nil, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbsGetBlobSnapshots64Warp(handle ConnectHandle, ids []PointID, maxLen int32) ([]TimestampType, []SubtimeType, [][]byte, []Quality, []RtdbError, RtdbError) {
+	return nil, nil, nil, nil, nil, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbsGetDatetimeSnapshots64Warp(handle ConnectHandle, ids []PointID, typ int16) ([]TimestampType, []SubtimeType, []string, []Quality, []RtdbError, RtdbError) {
+	return nil, nil, nil, nil, nil, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbsGetNamedTypeSnapshots64Warp(handle ConnectHandle, ids []PointID, lens []int32) ([]TimestampType, []SubtimeType, [][]byte, []Quality, []RtdbError, RtdbError) {
+	return nil, nil, nil, nil, nil, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbsSubscribeSnapshotsEx64Warp(handle ConnectHandle, ids []PointID, options RtdbSubscribeOption, callback SnapshotEventFunc) ([]RtdbError, RtdbError) {
+	return nil, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbsSubscribeDeltaSnapshots64Warp(handle ConnectHandle, ids []PointID, deltaValues []float64, deltaStates []int64, options RtdbSubscribeOption, callback SnapshotEventFunc) ([]RtdbError, RtdbError) {
+	return nil, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbsChangeSubscribeSnapshotsWarp(handle ConnectHandle, ids []PointID, deltaValues []float64, deltaStates []int64, changedTypes []RtdbSubscribeChangeType) ([]RtdbError, RtdbError) {
+	return nil, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbsCancelSubscribeSnapshotsWarp(handle ConnectHandle) RtdbError {
+	return RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbaGetArchivesCountWarp(handle ConnectHandle) (int32, RtdbError) {
+	return 0, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbaGetArchivesWarp(handle ConnectHandle, maxCount int32) ([]string, []string, []RtdbArchiveState, RtdbError) {
+	return nil, nil, nil, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbhGetSingleValue64Warp(handle ConnectHandle, id PointID, mode RtdbHisMode, datetime TimestampType, subtime SubtimeType) (TimestampType, SubtimeType, float64, int64, Quality, RtdbError) {
+	return 0, 0, 0, 0, 0, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbhGetSingleCoorValue64Warp(handle ConnectHandle, id PointID, mode RtdbHisMode, datetime TimestampType, subtime SubtimeType) (TimestampType, SubtimeType, float32, float32, Quality, RtdbError) {
+	return 0, 0, 0, 0, 0, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbhGetSingleBlobValue64Warp(handle ConnectHandle, id PointID, mode RtdbHisMode, datetime TimestampType, subtime SubtimeType, maxLen int32) (TimestampType, SubtimeType, []byte, Quality, RtdbError) {
+	return 0, 0, nil, 0, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbhGetSingleDatetimeValue64Warp(handle ConnectHandle, id PointID, mode RtdbHisMode, datetime TimestampType, subtime SubtimeType, dtType int16) (TimestampType, SubtimeType, []byte, Quality, RtdbError) {
+	return 0, 0, nil, 0, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbhGetSingleNamedTypeValue64Warp(handle ConnectHandle, id PointID, mode RtdbHisMode, datetime TimestampType, subtime SubtimeType, length int32) (TimestampType, SubtimeType, []byte, Quality, RtdbError) {
+	return 0, 0, nil, 0, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbhGetArchivedValues64Warp(handle ConnectHandle, id PointID, count int32, datetime1 TimestampType, subtime1 SubtimeType, datetime2 TimestampType, subtime2 SubtimeType) ([]TimestampType, []SubtimeType, []float64, []int64, []Quality, RtdbError) {
+	return nil, nil, nil, nil, nil, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbhGetArchivedCoorValues64Warp(handle ConnectHandle, id PointID, count int32, datetime1 TimestampType, subtime1 SubtimeType, datetime2 TimestampType, subtime2 SubtimeType) ([]TimestampType, []SubtimeType, []float32, []float32, []Quality, RtdbError) {
+	return nil, nil, nil, nil, nil, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbhGetArchivedBlobValues64Warp(handle ConnectHandle, id PointID, maxLen int32, maxCount int32, datetime1 TimestampType, subtime1 SubtimeType, datetime2 TimestampType, subtime2 SubtimeType) ([]TimestampType, []SubtimeType, [][]byte, []Quality, RtdbError) {
+	return nil, nil, nil, nil, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbhGetArchivedDatetimeValues64Warp(handle ConnectHandle, id PointID, maxCount int32, datetime1 TimestampType, subtime1 SubtimeType, datetime2 TimestampType, subtime2 SubtimeType, dtType int16) ([]TimestampType, []SubtimeType, [][]byte, []Quality, RtdbError) {
+	return nil, nil, nil, nil, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbhGetArchivedNamedTypeValues64Warp(handle ConnectHandle, id PointID, datetime1 TimestampType, subtime1 SubtimeType, datetime2 TimestampType, subtime2 SubtimeType, length int32, maxCount int32) ([]TimestampType, []SubtimeType, [][]byte, []Quality, RtdbError) {
+	return nil, nil, nil, nil, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbhGetInterpoValues64Warp(handle ConnectHandle, id PointID, count int32, datetime1 TimestampType, subtime1 SubtimeType, datetime2 TimestampType, subtime2 SubtimeType) ([]TimestampType, []SubtimeType, []float64, []int64, []Quality, RtdbError) {
+	return nil, nil, nil, nil, nil, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbhSummaryDataWarp(handle ConnectHandle, id PointID, datetime1 TimestampType, subtime1 SubtimeType, datetime2 TimestampType, subtime2 SubtimeType) (*RtdbSummaryData, RtdbError) {
+	return nil, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbhPutArchivedDatetimeValues64Warp(handle ConnectHandle, ids []PointID, datetimes []TimestampType, subtimes []SubtimeType, dtValues []string, qualities []Quality) ([]RtdbError, RtdbError) {
+	return nil, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbhPutArchivedValues64Warp(handle ConnectHandle, ids []PointID, datetimes []TimestampType, subtimes []SubtimeType, values []float64, states []int64, qualities []Quality) ([]RtdbError, RtdbError) {
+	return nil, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbhPutArchivedCoorValues64Warp(handle ConnectHandle, ids []PointID, datetimes []TimestampType, subtimes []SubtimeType, xs []float32, ys []float32, qualities []Quality) ([]RtdbError, RtdbError) {
+	return nil, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbhPutArchivedBlobValues64Warp(handle ConnectHandle, ids []PointID, datetimes []TimestampType, subtimes []SubtimeType, blobs [][]byte, qualities []Quality) ([]RtdbError, RtdbError) {
+	return nil, RteNotSupportedFeature
+}
+
+func (UnimplementedBackend) RawRtdbhPutArchivedNamedTypeValues64Warp(handle ConnectHandle, ids []PointID, datetimes []TimestampType, subtimes []SubtimeType, objects [][]byte, qualities []Quality) ([]RtdbError, RtdbError) {
+	return nil, RteNotSupportedFeature
+}
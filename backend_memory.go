@@ -0,0 +1,1947 @@
+package rtdb_api
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// MemoryBackend 纯Go实现的内存数据库后端, 不依赖CGO
+//   - 支持表、标签点、自定义类型、回收站、快照与历史数据的读写
+//   - 不校验用户名和密码, 登录用户拥有 PrivGroupRtdbSA 权限
+//   - 写入快照时同时写入历史, 早于快照的数据返回 RteTimestampEarlierThanSnapshot, 与真实数据库保持一致
+//   - 支持快照订阅, 快照改变后在单独的goroutine中依次回调, 按容差订阅时变化不超过容差值的快照不推送
+//   - 未实现的方法返回 RteNotSupportedFeature
+type MemoryBackend struct {
+	UnimplementedBackend
+
+	mu          sync.Mutex
+	nextHandle  ConnectHandle
+	sessions    map[ConnectHandle]*memorySession
+	nextTableID TableID
+	tables      map[TableID]*RtdbTable
+	nextPointID PointID
+	points      map[PointID]*memoryPoint
+	recycled    map[PointID]*memoryPoint
+	namedTypes  map[string]*memoryNamedType
+
+	subscriptions map[ConnectHandle]*memorySubscription
+}
+
+// memorySession 内存后端的连接
+type memorySession struct {
+	hostname    string
+	port        int32
+	user        string
+	priv        PrivGroup
+	timeout     DateTimeType
+	connectTime time.Time
+}
+
+// memoryPoint 内存后端的标签点
+type memoryPoint struct {
+	base      RtdbPoint
+	scan      RtdbScan
+	calc      RtdbCalc
+	namedType string
+	snapshot  *memoryValue
+	archive   []memoryValue // 按时间升序排列
+}
+
+// memoryValue 内存后端的数值
+type memoryValue struct {
+	datetime TimestampType
+	subtime  SubtimeType
+	value    float64
+	state    int64
+	x        float32
+	y        float32
+	data     []byte
+	quality  Quality
+}
+
+// memoryNamedType 内存后端的自定义类型
+type memoryNamedType struct {
+	desc   string
+	fields []RtdbDataTypeField
+}
+
+// NewMemoryBackend 创建内存数据库后端
+func NewMemoryBackend() *MemoryBackend {
+	return &MemoryBackend{
+		sessions:   make(map[ConnectHandle]*memorySession),
+		tables:     make(map[TableID]*RtdbTable),
+		points:     make(map[PointID]*memoryPoint),
+		recycled:   make(map[PointID]*memoryPoint),
+		namedTypes: make(map[string]*memoryNamedType),
+
+		subscriptions: make(map[ConnectHandle]*memorySubscription),
+	}
+}
+
+// before 时间戳比较
+func (v *memoryValue) before(datetime TimestampType, subtime SubtimeType) bool {
+	return v.datetime < datetime || (v.datetime == datetime && v.subtime < subtime)
+}
+
+// equal 时间戳是否相同
+func (v *memoryValue) equal(datetime TimestampType, subtime SubtimeType) bool {
+	return v.datetime == datetime && v.subtime == subtime
+}
+
+// session 获取连接, 调用方需要持有锁
+func (m *MemoryBackend) session(handle ConnectHandle) (*memorySession, RtdbError) {
+	s, ok := m.sessions[handle]
+	if !ok {
+		return nil, RteInvalidHandle
+	}
+	return s, RteOk
+}
+
+// tableByName 根据表名查找表, 调用方需要持有锁
+func (m *MemoryBackend) tableByName(name string) *RtdbTable {
+	for _, table := range m.tables {
+		if table.Name == name {
+			return table
+		}
+	}
+	return nil
+}
+
+// pointByTag 根据表ID和点名查找标签点, 调用方需要持有锁
+func (m *MemoryBackend) pointByTag(tableID TableID, tag string) *memoryPoint {
+	for _, p := range m.points {
+		if p.base.Table == tableID && p.base.Tag == tag {
+			return p
+		}
+	}
+	return nil
+}
+
+// fillTableDotTag 填充 表名.点名, 调用方需要持有锁
+func (m *MemoryBackend) fillTableDotTag(p *memoryPoint) {
+	if table, ok := m.tables[p.base.Table]; ok {
+		p.base.TableDotTag = table.Name + "." + p.base.Tag
+	}
+}
+
+// insert 插入标签点, 调用方需要持有锁
+func (m *MemoryBackend) insert(s *memorySession, base *RtdbPoint, scan *RtdbScan, calc *RtdbCalc, namedType string) (*memoryPoint, RtdbError) {
+	if base == nil {
+		return nil, RteInvalidParameter
+	}
+	if _, ok := m.tables[base.Table]; !ok {
+		return nil, RteTableNotFound
+	}
+	if m.pointByTag(base.Table, base.Tag) != nil {
+		return nil, RteReduplicateTag
+	}
+	m.nextPointID++
+	now := DateTimeType(time.Now().Unix())
+	p := &memoryPoint{base: *base, namedType: namedType}
+	p.base.ID = m.nextPointID
+	p.base.CreateDate, p.base.ChangeDate = now, now
+	p.base.Creator, p.base.Changer = s.user, s.user
+	if scan != nil {
+		p.scan = *scan
+	}
+	if calc != nil {
+		p.calc = *calc
+	}
+	p.scan.ID, p.calc.ID = p.base.ID, p.base.ID
+	m.fillTableDotTag(p)
+	m.points[p.base.ID] = p
+	return p, RteOk
+}
+
+// copyPoint 复制标签点属性
+func copyPoint(p *memoryPoint) (*RtdbPoint, *RtdbScan, *RtdbCalc) {
+	base, scan, calc := p.base, p.scan, p.calc
+	return &base, &scan, &calc
+}
+
+// matchMask 通配符匹配, 支持 * 和 ?, 空字符串匹配所有
+func matchMask(mask string, s string, caseSensitive bool) bool {
+	if mask == "" || mask == "*" {
+		return true
+	}
+	if !caseSensitive {
+		mask, s = strings.ToLower(mask), strings.ToLower(s)
+	}
+	m, n := []rune(mask), []rune(s)
+	mi, si, star, mark := 0, 0, -1, 0
+	for si < len(n) {
+		switch {
+		case mi < len(m) && (m[mi] == '?' || m[mi] == n[si]):
+			mi++
+			si++
+		case mi < len(m) && m[mi] == '*':
+			star, mark = mi, si
+			mi++
+		case star != -1:
+			mi = star + 1
+			mark++
+			si = mark
+		default:
+			return false
+		}
+	}
+	for mi < len(m) && m[mi] == '*' {
+		mi++
+	}
+	return mi == len(m)
+}
+
+// search 搜索标签点, 调用方需要持有锁
+func (m *MemoryBackend) search(points map[PointID]*memoryPoint, tagMask, tableMask, source, unit, desc, instrument, typeMask string, classOfMask RtdbType, timeUnitMask RtdbPrecision, model RtdbSortFlag) []PointID {
+	caseSensitive := model&RtdbSortFlagCaseSensitive != 0
+	matched := make([]*memoryPoint, 0)
+	for _, p := range points {
+		tableName := ""
+		if table, ok := m.tables[p.base.Table]; ok {
+			tableName = table.Name
+		}
+		if !matchMask(tagMask, p.base.Tag, caseSensitive) ||
+			!matchMask(tableMask, tableName, caseSensitive) ||
+			!matchMask(source, p.scan.Source, caseSensitive) ||
+			!matchMask(unit, p.base.Unit, caseSensitive) ||
+			!matchMask(desc, p.base.Desc, caseSensitive) ||
+			!matchMask(instrument, p.scan.Instrument, caseSensitive) ||
+			!matchMask(typeMask, p.namedType, caseSensitive) {
+			continue
+		}
+		if classOfMask != RtdbTypeAny && p.base.Type != classOfMask {
+			continue
+		}
+		if timeUnitMask != RtdbPrecisionAny && p.base.Precision != timeUnitMask {
+			continue
+		}
+		matched = append(matched, p)
+	}
+	sort.Slice(matched, func(i, j int) bool {
+		a, b := matched[i].base.TableDotTag, matched[j].base.TableDotTag
+		if !caseSensitive {
+			a, b = strings.ToLower(a), strings.ToLower(b)
+		}
+		if a == b {
+			return matched[i].base.ID < matched[j].base.ID
+		}
+		if model&RtdbSortFlagDescend != 0 {
+			return a > b
+		}
+		return a < b
+	})
+	ids := make([]PointID, 0, len(matched))
+	for _, p := range matched {
+		ids = append(ids, p.base.ID)
+	}
+	return ids
+}
+
+// archiveValue 写入历史数据, 相同时间戳的数据会被覆盖
+func (p *memoryPoint) archiveValue(v memoryValue) {
+	i := sort.Search(len(p.archive), func(i int) bool {
+		return !p.archive[i].before(v.datetime, v.subtime)
+	})
+	if i < len(p.archive) && p.archive[i].equal(v.datetime, v.subtime) {
+		p.archive[i] = v
+		return
+	}
+	p.archive = append(p.archive, memoryValue{})
+	copy(p.archive[i+1:], p.archive[i:])
+	p.archive[i] = v
+}
+
+// putSnapshot 写入快照, fix为true时允许修改早于快照的数据
+func (p *memoryPoint) putSnapshot(v memoryValue, fix bool) RtdbError {
+	if p.snapshot != nil && v.before(p.snapshot.datetime, p.snapshot.subtime) {
+		if !fix {
+			return RteTimestampEarlierThanSnapshot
+		}
+		p.archiveValue(v)
+		return RteOk
+	}
+	snapshot := v
+	p.snapshot = &snapshot
+	p.archiveValue(v)
+	return RteOk
+}
+
+// putValues 批量写入快照或历史, 调用方不需要持有锁
+func (m *MemoryBackend) putValues(handle ConnectHandle, ids []PointID, values []memoryValue, write func(p *memoryPoint, v memoryValue) RtdbError) ([]RtdbError, RtdbError) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, rte := m.session(handle); !RteIsOk(rte) {
+		return nil, rte
+	}
+	rtes := make([]RtdbError, len(ids))
+	changed := make([]PointID, 0)
+	for i, id := range ids {
+		p, ok := m.points[id]
+		if !ok {
+			rtes[i] = RtePointNotFound
+			continue
+		}
+		snapshot := p.snapshot
+		if rtes[i] = write(p, values[i]); p.snapshot != snapshot {
+			changed = append(changed, id)
+		}
+	}
+	m.publish(changed)
+	return rtes, RteOk
+}
+
+// snapshotWriter 快照写入函数
+func snapshotWriter(fix bool) func(p *memoryPoint, v memoryValue) RtdbError {
+	return func(p *memoryPoint, v memoryValue) RtdbError {
+		return p.putSnapshot(v, fix)
+	}
+}
+
+// archiveWriter 历史写入函数
+func archiveWriter(p *memoryPoint, v memoryValue) RtdbError {
+	p.archiveValue(v)
+	return RteOk
+}
+
+// numberValues 组装数值类型数据
+func numberValues(datetimes []TimestampType, subtimes []SubtimeType, values []float64, states []int64, qualities []Quality) []memoryValue {
+	rtn := make([]memoryValue, len(datetimes))
+	for i := range datetimes {
+		rtn[i] = memoryValue{datetime: datetimes[i], subtime: subtimes[i], value: values[i], state: states[i], quality: qualities[i]}
+	}
+	return rtn
+}
+
+// coorValues 组装坐标类型数据
+func coorValues(datetimes []TimestampType, subtimes []SubtimeType, xs []float32, ys []float32, qualities []Quality) []memoryValue {
+	rtn := make([]memoryValue, len(datetimes))
+	for i := range datetimes {
+		rtn[i] = memoryValue{datetime: datetimes[i], subtime: subtimes[i], x: xs[i], y: ys[i], quality: qualities[i]}
+	}
+	return rtn
+}
+
+// bytesValues 组装String/Blob/自定义类型数据
+func bytesValues(datetimes []TimestampType, subtimes []SubtimeType, datas [][]byte, qualities []Quality) []memoryValue {
+	rtn := make([]memoryValue, len(datetimes))
+	for i := range datetimes {
+		rtn[i] = memoryValue{datetime: datetimes[i], subtime: subtimes[i], data: append([]byte(nil), datas[i]...), quality: qualities[i]}
+	}
+	return rtn
+}
+
+// datetimeValues 组装日期类型数据
+func datetimeValues(datetimes []TimestampType, subtimes []SubtimeType, dtValues []string, qualities []Quality) []memoryValue {
+	rtn := make([]memoryValue, len(datetimes))
+	for i := range datetimes {
+		rtn[i] = memoryValue{datetime: datetimes[i], subtime: subtimes[i], data: []byte(dtValues[i]), quality: qualities[i]}
+	}
+	return rtn
+}
+
+// findValue 根据历史模式查找数据
+func (p *memoryPoint) findValue(mode RtdbHisMode, datetime TimestampType, subtime SubtimeType) (memoryValue, bool) {
+	i := sort.Search(len(p.archive), func(i int) bool {
+		return !p.archive[i].before(datetime, subtime)
+	})
+	exact := i < len(p.archive) && p.archive[i].equal(datetime, subtime)
+	prev := func() (memoryValue, bool) {
+		if i > 0 {
+			return p.archive[i-1], true
+		}
+		return memoryValue{}, false
+	}
+	next := func() (memoryValue, bool) {
+		j := i
+		if exact {
+			j++
+		}
+		if j < len(p.archive) {
+			return p.archive[j], true
+		}
+		return memoryValue{}, false
+	}
+	switch mode {
+	case RtdbHisModeNext:
+		return next()
+	case RtdbHisModePrevious:
+		return prev()
+	case RtdbHisModeExact:
+		if exact {
+			return p.archive[i], true
+		}
+	case RtdbHisModeExactOrNext:
+		if exact {
+			return p.archive[i], true
+		}
+		return next()
+	case RtdbHisModeExactOrPrev:
+		if exact {
+			return p.archive[i], true
+		}
+		return prev()
+	case RtdbHisModeInter, RtdbHisModeInterOrNext:
+		if exact {
+			return p.archive[i], true
+		}
+		before, okBefore := prev()
+		after, okAfter := next()
+		if okBefore && okAfter {
+			return p.interpolate(before, after, datetime, subtime), true
+		}
+		if mode == RtdbHisModeInterOrNext {
+			return next()
+		}
+	}
+	return memoryValue{}, false
+}
+
+// interpolate 计算内插值, 阶跃点或整数类型取前值
+func (p *memoryPoint) interpolate(before memoryValue, after memoryValue, datetime TimestampType, subtime SubtimeType) memoryValue {
+	rtn := before
+	rtn.datetime, rtn.subtime = datetime, subtime
+	switch p.base.Type {
+	case RtdbTypeReal16, RtdbTypeReal32, RtdbTypeReal64, RtdbTypeFp16, RtdbTypeFp32, RtdbTypeFp64:
+	default:
+		return rtn
+	}
+	if p.base.Step == ON {
+		return rtn
+	}
+	t0 := float64(before.datetime)*1e9 + float64(before.subtime)
+	t1 := float64(after.datetime)*1e9 + float64(after.subtime)
+	t := float64(datetime)*1e9 + float64(subtime)
+	if t1 > t0 {
+		rtn.value = before.value + (after.value-before.value)*(t-t0)/(t1-t0)
+	}
+	if after.quality != QualityGood {
+		rtn.quality = after.quality
+	}
+	return rtn
+}
+
+func (m *MemoryBackend) RawRtdbSetOptionWarp(optionType RtdbApiOption, value int32) RtdbError {
+	return RteOk
+}
+
+func (m *MemoryBackend) RawRtdbConnectWarp(hostname string, port int32) (ConnectHandle, RtdbError) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.nextHandle++
+	m.sessions[m.nextHandle] = &memorySession{hostname: hostname, port: port, connectTime: time.Now()}
+	return m.nextHandle, RteOk
+}
+
+func (m *MemoryBackend) RawRtdbLoginWarp(handle ConnectHandle, user string, password string) (PrivGroup, RtdbError) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	s, rte := m.session(handle)
+	if !RteIsOk(rte) {
+		return 0, rte
+	}
+	s.user, s.priv = user, PrivGroupRtdbSA
+	return s.priv, RteOk
+}
+
+func (m *MemoryBackend) RawRtdbDisconnectWarp(handle ConnectHandle) RtdbError {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, rte := m.session(handle); !RteIsOk(rte) {
+		return rte
+	}
+	delete(m.sessions, handle)
+	if sub, ok := m.subscriptions[handle]; ok {
+		delete(m.subscriptions, handle)
+		sub.close()
+	}
+	return RteOk
+}
+
+func (m *MemoryBackend) RawRtdbJudgeConnectStatusWarp(handle ConnectHandle) RtdbError {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	_, rte := m.session(handle)
+	return rte
+}
+
+func (m *MemoryBackend) RawRtdbbGetMetaSyncInfoWarp(handle ConnectHandle, nodeNumber int32) ([]RtdbSyncInfo, []RtdbError, RtdbError) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	s, rte := m.session(handle)
+	if !RteIsOk(rte) {
+		return nil, nil, rte
+	}
+	info := RtdbSyncInfo{Role: RtdbSyncRoleMaster, IpString: s.hostname}
+	return []RtdbSyncInfo{info}, []RtdbError{RteOk}, RteOk
+}
+
+func (m *MemoryBackend) RawRtdbGetOwnConnectionWarp(handle ConnectHandle, nodeNumber int32) (SocketHandle, RtdbError) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, rte := m.session(handle); !RteIsOk(rte) {
+		return 0, rte
+	}
+	return SocketHandle(handle), RteOk
+}
+
+func (m *MemoryBackend) RawRtdbConnectionCountWarp(handle ConnectHandle, nodeNumber int32) (int32, RtdbError) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, rte := m.session(handle); !RteIsOk(rte) {
+		return 0, rte
+	}
+	return int32(len(m.sessions)), RteOk
+}
+
+func (m *MemoryBackend) RawRtdbGetConnectionsWarp(handle ConnectHandle, nodeNumber int32, count int32) ([]SocketHandle, RtdbError) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, rte := m.session(handle); !RteIsOk(rte) {
+		return nil, rte
+	}
+	sockets := make([]SocketHandle, 0, len(m.sessions))
+	for h := range m.sessions {
+		sockets = append(sockets, SocketHandle(h))
+	}
+	sort.Slice(sockets, func(i, j int) bool { return sockets[i] < sockets[j] })
+	return SafeSlice(sockets, 0, count), RteOk
+}
+
+func (m *MemoryBackend) RawRtdbGetConnectionInfoIpv6Warp(handle ConnectHandle, nodeNumber int32, socket SocketHandle) (RtdbHostConnectInfoIpv6, RtdbError) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, rte := m.session(handle); !RteIsOk(rte) {
+		return RtdbHostConnectInfoIpv6{}, rte
+	}
+	s, rte := m.session(ConnectHandle(socket))
+	if !RteIsOk(rte) {
+		return RtdbHostConnectInfoIpv6{}, rte
+	}
+	return RtdbHostConnectInfoIpv6{
+		IpAddr6:     s.hostname,
+		Port:        uint16(s.port),
+		ConnectTime: DateTimeType(s.connectTime.Unix()),
+		User:        s.user,
+		Length:      int32(len(s.user)),
+	}, RteOk
+}
+
+func (m *MemoryBackend) RawRtdbGetTimeoutWarp(handle ConnectHandle, socket SocketHandle) (DateTimeType, RtdbError) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	s, rte := m.session(ConnectHandle(socket))
+	if !RteIsOk(rte) {
+		return 0, rte
+	}
+	return s.timeout, RteOk
+}
+
+func (m *MemoryBackend) RawRtdbSetTimeoutWarp(handle ConnectHandle, socket SocketHandle, timeout DateTimeType) RtdbError {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	s, rte := m.session(ConnectHandle(socket))
+	if !RteIsOk(rte) {
+		return rte
+	}
+	s.timeout = timeout
+	return RteOk
+}
+
+func (m *MemoryBackend) RawRtdbKillConnectionWarp(handle ConnectHandle, socket SocketHandle) RtdbError {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, rte := m.session(ConnectHandle(socket)); !RteIsOk(rte) {
+		return rte
+	}
+	delete(m.sessions, ConnectHandle(socket))
+	return RteOk
+}
+
+func (m *MemoryBackend) RawRtdbOsType(handle ConnectHandle) (RtdbOsType, RtdbError) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, rte := m.session(handle); !RteIsOk(rte) {
+		return RtdbOsInvalid, rte
+	}
+	return RtdbOsLinux, RteOk
+}
+
+func (m *MemoryBackend) RawRtdbGetMaxBlobLenWarp(handle ConnectHandle) (int32, RtdbError) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, rte := m.session(handle); !RteIsOk(rte) {
+		return 0, rte
+	}
+	return 1024, RteOk
+}
+
+func (m *MemoryBackend) RawRtdbGetPrivWarp(handle ConnectHandle) (PrivGroup, RtdbError) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	s, rte := m.session(handle)
+	if !RteIsOk(rte) {
+		return 0, rte
+	}
+	return s.priv, RteOk
+}
+
+func (m *MemoryBackend) RawRtdbHostTime64Warp(handle ConnectHandle) (TimestampType, RtdbError) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, rte := m.session(handle); !RteIsOk(rte) {
+		return 0, rte
+	}
+	return TimestampType(time.Now().Unix()), RteOk
+}
+
+func (m *MemoryBackend) RawRtdbParseTimeWarp(tStr string) (TimestampType, SubtimeType, RtdbError) {
+	t, err := time.ParseInLocation("2006-01-02 15:04:05.999999999", strings.TrimSpace(tStr), time.Local)
+	if err != nil {
+		return 0, 0, RteInvalidParameter
+	}
+	return TimestampType(t.Unix()), SubtimeType(t.Nanosecond()), RteOk
+}
+
+func (m *MemoryBackend) RawRtdbFormatQualityWarp(handle ConnectHandle, qualities []Quality) ([]string, RtdbError) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, rte := m.session(handle); !RteIsOk(rte) {
+		return nil, rte
+	}
+	descs := make([]string, 0, len(qualities))
+	for _, q := range qualities {
+		switch {
+		case q == QualityGood:
+			descs = append(descs, "GOOD")
+		case q == QualityNoData:
+			descs = append(descs, "NODATA")
+		case q == QualityCreated:
+			descs = append(descs, "CREATED")
+		case q == QualityShutdown:
+			descs = append(descs, "SHUTDOWN")
+		case q == QualityCalcOff:
+			descs = append(descs, "CALCOFF")
+		case q == QualityBad:
+			descs = append(descs, "BAD")
+		case q == QualityDivByZero:
+			descs = append(descs, "DIVBYZERO")
+		case q == QualityRemoved:
+			descs = append(descs, "REMOVED")
+		case q >= QualityOpc && q < QualityUser:
+			descs = append(descs, fmt.Sprintf("OPC[%d]", q-QualityOpc))
+		default:
+			descs = append(descs, fmt.Sprintf("USER[%d]", q))
+		}
+	}
+	return descs, RteOk
+}
+
+func (m *MemoryBackend) RawRtdbbTablesCountWarp(handle ConnectHandle) (int32, RtdbError) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, rte := m.session(handle); !RteIsOk(rte) {
+		return 0, rte
+	}
+	return int32(len(m.tables)), RteOk
+}
+
+func (m *MemoryBackend) RawRtdbbGetTablesWarp(handle ConnectHandle, count int32) ([]TableID, RtdbError) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, rte := m.session(handle); !RteIsOk(rte) {
+		return nil, rte
+	}
+	ids := make([]TableID, 0, len(m.tables))
+	for id := range m.tables {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return SafeSlice(ids, 0, count), RteOk
+}
+
+func (m *MemoryBackend) RawRtdbbAppendTableWarp(handle ConnectHandle, tableName, tableDesc string) (RtdbTable, RtdbError) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, rte := m.session(handle); !RteIsOk(rte) {
+		return RtdbTable{}, rte
+	}
+	if m.tableByName(tableName) != nil {
+		return RtdbTable{}, RteReduplicateTabname
+	}
+	m.nextTableID++
+	table := &RtdbTable{ID: m.nextTableID, Name: tableName, Desc: tableDesc}
+	m.tables[table.ID] = table
+	return *table, RteOk
+}
+
+func (m *MemoryBackend) RawRtdbbGetTablePropertyByIdWarp(handle ConnectHandle, tableID TableID) (RtdbTable, RtdbError) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, rte := m.session(handle); !RteIsOk(rte) {
+		return RtdbTable{}, rte
+	}
+	table, ok := m.tables[tableID]
+	if !ok {
+		return RtdbTable{}, RteTableNotFound
+	}
+	return *table, RteOk
+}
+
+func (m *MemoryBackend) RawRtdbbRemoveTableByIdWarp(handle ConnectHandle, tableID TableID) RtdbError {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, rte := m.session(handle); !RteIsOk(rte) {
+		return rte
+	}
+	if _, ok := m.tables[tableID]; !ok {
+		return RteTableNotFound
+	}
+	for id, p := range m.points {
+		if p.base.Table == tableID {
+			delete(m.points, id)
+			m.recycled[id] = p
+		}
+	}
+	delete(m.tables, tableID)
+	return RteOk
+}
+
+func (m *MemoryBackend) RawRtdbbUpdateTableNameWarp(handle ConnectHandle, id TableID, name string) RtdbError {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, rte := m.session(handle); !RteIsOk(rte) {
+		return rte
+	}
+	table, ok := m.tables[id]
+	if !ok {
+		return RteTableNotFound
+	}
+	if other := m.tableByName(name); other != nil && other.ID != id {
+		return RteReduplicateTabname
+	}
+	table.Name = name
+	for _, p := range m.points {
+		if p.base.Table == id {
+			m.fillTableDotTag(p)
+		}
+	}
+	return RteOk
+}
+
+func (m *MemoryBackend) RawRtdbbUpdateTableDescByIdWarp(handle ConnectHandle, id TableID, desc string) RtdbError {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, rte := m.session(handle); !RteIsOk(rte) {
+		return rte
+	}
+	table, ok := m.tables[id]
+	if !ok {
+		return RteTableNotFound
+	}
+	table.Desc = desc
+	return RteOk
+}
+
+func (m *MemoryBackend) RawRtdbbInsertMaxPointWarp(handle ConnectHandle, base *RtdbPoint, scan *RtdbScan, calc *RtdbCalc) (*RtdbPoint, *RtdbScan, *RtdbCalc, RtdbError) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	s, rte := m.session(handle)
+	if !RteIsOk(rte) {
+		return nil, nil, nil, rte
+	}
+	if base != nil && base.Type == RtdbTypeNamedT {
+		return nil, nil, nil, RteInvalidDataType
+	}
+	p, rte := m.insert(s, base, scan, calc, "")
+	if !RteIsOk(rte) {
+		return nil, nil, nil, rte
+	}
+	b, sc, ca := copyPoint(p)
+	return b, sc, ca, RteOk
+}
+
+func (m *MemoryBackend) RawRtdbbInsertNamedTypePointWarp(handle ConnectHandle, base *RtdbPoint, scan *RtdbScan, name string) (*RtdbPoint, *RtdbScan, RtdbError) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	s, rte := m.session(handle)
+	if !RteIsOk(rte) {
+		return nil, nil, rte
+	}
+	if _, ok := m.namedTypes[name]; !ok {
+		return nil, nil, RteNotExistNamedType
+	}
+	p, rte := m.insert(s, base, scan, nil, name)
+	if !RteIsOk(rte) {
+		return nil, nil, rte
+	}
+	p.base.Type = RtdbTypeNamedT
+	b, sc, _ := copyPoint(p)
+	return b, sc, RteOk
+}
+
+func (m *MemoryBackend) RawRtdbbGetMaxPointsPropertyWarp(handle ConnectHandle, ids []PointID) ([]RtdbPoint, []RtdbScan, []RtdbCalc, []RtdbError, RtdbError) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, rte := m.session(handle); !RteIsOk(rte) {
+		return nil, nil, nil, nil, rte
+	}
+	bases := make([]RtdbPoint, len(ids))
+	scans := make([]RtdbScan, len(ids))
+	calcs := make([]RtdbCalc, len(ids))
+	rtes := make([]RtdbError, len(ids))
+	for i, id := range ids {
+		p, ok := m.points[id]
+		if !ok {
+			rtes[i] = RtePointNotFound
+			continue
+		}
+		bases[i], scans[i], calcs[i] = p.base, p.scan, p.calc
+	}
+	return bases, scans, calcs, rtes, RteOk
+}
+
+func (m *MemoryBackend) RawRtdbbUpdateMaxPointPropertyWarp(handle ConnectHandle, base *RtdbPoint, scan *RtdbScan, calc *RtdbCalc) RtdbError {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	s, rte := m.session(handle)
+	if !RteIsOk(rte) {
+		return rte
+	}
+	if base == nil {
+		return RteInvalidParameter
+	}
+	p, ok := m.points[base.ID]
+	if !ok {
+		return RtePointNotFound
+	}
+	if other := m.pointByTag(p.base.Table, base.Tag); other != nil && other != p {
+		return RteReduplicateTag
+	}
+	updated := *base
+	updated.Table, updated.Type = p.base.Table, p.base.Type
+	updated.CreateDate, updated.Creator = p.base.CreateDate, p.base.Creator
+	updated.ChangeDate, updated.Changer = DateTimeType(time.Now().Unix()), s.user
+	p.base = updated
+	if scan != nil {
+		p.scan = *scan
+		p.scan.ID = p.base.ID
+	}
+	if calc != nil {
+		p.calc = *calc
+		p.calc.ID = p.base.ID
+	}
+	m.fillTableDotTag(p)
+	return RteOk
+}
+
+func (m *MemoryBackend) RawRtdbbFindPointsExWarp(handle ConnectHandle, tableDotTags []string) ([]PointID, []RtdbType, []RtdbClass, []RtdbPrecision, []RtdbError, RtdbError) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, rte := m.session(handle); !RteIsOk(rte) {
+		return nil, nil, nil, nil, nil, rte
+	}
+	ids := make([]PointID, len(tableDotTags))
+	types := make([]RtdbType, len(tableDotTags))
+	classes := make([]RtdbClass, len(tableDotTags))
+	precisions := make([]RtdbPrecision, len(tableDotTags))
+	rtes := make([]RtdbError, len(tableDotTags))
+	for i, tableDotTag := range tableDotTags {
+		tableName, tag, ok := strings.Cut(tableDotTag, ".")
+		if !ok {
+			rtes[i] = RteInvalidFullTagName
+			continue
+		}
+		table := m.tableByName(tableName)
+		if table == nil {
+			rtes[i] = RteTableNotFound
+			continue
+		}
+		p := m.pointByTag(table.ID, tag)
+		if p == nil {
+			rtes[i] = RtePointNotFound
+			continue
+		}
+		ids[i], types[i], classes[i], precisions[i] = p.base.ID, p.base.Type, p.base.Class, p.base.Precision
+	}
+	return ids, types, classes, precisions, rtes, RteOk
+}
+
+func (m *MemoryBackend) RawRtdbbMovePointByIdWarp(handle ConnectHandle, id PointID, tableName string) RtdbError {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, rte := m.session(handle); !RteIsOk(rte) {
+		return rte
+	}
+	p, ok := m.points[id]
+	if !ok {
+		return RtePointNotFound
+	}
+	table := m.tableByName(tableName)
+	if table == nil {
+		return RteTableNotFound
+	}
+	if m.pointByTag(table.ID, p.base.Tag) != nil {
+		return RteReduplicateTagInDestTable
+	}
+	p.base.Table = table.ID
+	m.fillTableDotTag(p)
+	return RteOk
+}
+
+func (m *MemoryBackend) RawRtdbbRemovePointByIdWarp(handle ConnectHandle, id PointID) RtdbError {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, rte := m.session(handle); !RteIsOk(rte) {
+		return rte
+	}
+	p, ok := m.points[id]
+	if !ok {
+		return RtePointNotFound
+	}
+	delete(m.points, id)
+	m.recycled[id] = p
+	return RteOk
+}
+
+func (m *MemoryBackend) RawRtdbbSearchPointsCountWarp(handle ConnectHandle, tagMask, tableMask, source, unit, desc, instrument, typeMask string, classOfMask RtdbType, timeUnitMask RtdbPrecision, otherTypeMask RtdbSearch, otherTypeMaskValue string) (int32, RtdbError) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, rte := m.session(handle); !RteIsOk(rte) {
+		return 0, rte
+	}
+	if otherTypeMask != RtdbSearchNull {
+		return 0, RteNotSupportedFeature
+	}
+	ids := m.search(m.points, tagMask, tableMask, source, unit, desc, instrument, typeMask, classOfMask, timeUnitMask, 0)
+	return int32(len(ids)), RteOk
+}
+
+func (m *MemoryBackend) RawRtdbbSearchExWarp(handle ConnectHandle, maxCount int32, tagMask, tableMask, source, unit, desc, instrument, typeMask string, classOfMask RtdbType, timeUnitMask RtdbPrecision, otherTypeMask RtdbSearch, otherTypeMaskValue string, model RtdbSortFlag) ([]PointID, RtdbError) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, rte := m.session(handle); !RteIsOk(rte) {
+		return nil, rte
+	}
+	if otherTypeMask != RtdbSearchNull {
+		return nil, RteNotSupportedFeature
+	}
+	ids := m.search(m.points, tagMask, tableMask, source, unit, desc, instrument, typeMask, classOfMask, timeUnitMask, model)
+	return SafeSlice(ids, 0, maxCount), RteOk
+}
+
+func (m *MemoryBackend) RawRtdbbGetBaseTypePointsCountWarp(handle ConnectHandle, rtdbType RtdbType) (int32, RtdbError) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, rte := m.session(handle); !RteIsOk(rte) {
+		return 0, rte
+	}
+	count := int32(0)
+	for _, p := range m.points {
+		if p.base.Type == rtdbType {
+			count++
+		}
+	}
+	return count, RteOk
+}
+
+func (m *MemoryBackend) RawRtdbbGetRecycledPointsCountWarp(handle ConnectHandle) (int32, RtdbError) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, rte := m.session(handle); !RteIsOk(rte) {
+		return 0, rte
+	}
+	return int32(len(m.recycled)), RteOk
+}
+
+func (m *MemoryBackend) RawRtdbbGetRecycledPointsWarp(handle ConnectHandle, count int32) ([]PointID, RtdbError) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, rte := m.session(handle); !RteIsOk(rte) {
+		return nil, rte
+	}
+	ids := make([]PointID, 0, len(m.recycled))
+	for id := range m.recycled {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return SafeSlice(ids, 0, count), RteOk
+}
+
+func (m *MemoryBackend) RawRtdbbGetRecycledMaxPointPropertyWarp(handle ConnectHandle, id PointID) (*RtdbPoint, *RtdbScan, *RtdbCalc, RtdbError) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, rte := m.session(handle); !RteIsOk(rte) {
+		return nil, nil, nil, rte
+	}
+	p, ok := m.recycled[id]
+	if !ok {
+		return &RtdbPoint{}, &RtdbScan{}, &RtdbCalc{}, RtePointNotFound
+	}
+	b, sc, ca := copyPoint(p)
+	return b, sc, ca, RteOk
+}
+
+func (m *MemoryBackend) RawRtdbbSearchRecycledPointsInBatchesWarp(handle ConnectHandle, start int32, count int32, tagMask, fullMask, source, unit, desc, instrument string, mode RtdbSortFlag) ([]PointID, RtdbError) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, rte := m.session(handle); !RteIsOk(rte) {
+		return nil, rte
+	}
+	ids := make([]PointID, 0)
+	for _, id := range m.search(m.recycled, tagMask, "", source, unit, desc, instrument, "", RtdbTypeAny, RtdbPrecisionAny, mode) {
+		if matchMask(fullMask, m.recycled[id].base.TableDotTag, mode&RtdbSortFlagCaseSensitive != 0) {
+			ids = append(ids, id)
+		}
+	}
+	return SafeSlice(ids, start, count), RteOk
+}
+
+func (m *MemoryBackend) RawRtdbbRecoverPointWarp(handle ConnectHandle, tableID TableID, pointID PointID) RtdbError {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, rte := m.session(handle); !RteIsOk(rte) {
+		return rte
+	}
+	p, ok := m.recycled[pointID]
+	if !ok {
+		return RtePointNotFound
+	}
+	if _, ok := m.tables[tableID]; !ok {
+		return RteTableNotFound
+	}
+	if m.pointByTag(tableID, p.base.Tag) != nil {
+		return RteReduplicateTagInDestTable
+	}
+	delete(m.recycled, pointID)
+	p.base.Table = tableID
+	m.fillTableDotTag(p)
+	m.points[pointID] = p
+	return RteOk
+}
+
+func (m *MemoryBackend) RawRtdbbPurgePointWarp(handle ConnectHandle, id PointID) RtdbError {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, rte := m.session(handle); !RteIsOk(rte) {
+		return rte
+	}
+	if _, ok := m.recycled[id]; !ok {
+		return RtePointNotFound
+	}
+	delete(m.recycled, id)
+	return RteOk
+}
+
+func (m *MemoryBackend) RawRtdbbClearRecyclerWarp(handle ConnectHandle) RtdbError {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, rte := m.session(handle); !RteIsOk(rte) {
+		return rte
+	}
+	m.recycled = make(map[PointID]*memoryPoint)
+	return RteOk
+}
+
+func (m *MemoryBackend) RawRtdbbCreateNamedTypeWarp(handle ConnectHandle, name string, desc string, fields ...RtdbDataTypeField) RtdbError {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, rte := m.session(handle); !RteIsOk(rte) {
+		return rte
+	}
+	if name == "" {
+		return RteInvalidNamedTypeName
+	}
+	if len(fields) == 0 {
+		return RteInvalidNamedTypeFieldCount
+	}
+	if _, ok := m.namedTypes[name]; ok {
+		return RteReduplicateNamedType
+	}
+	names := make(map[string]bool)
+	fields = append([]RtdbDataTypeField(nil), fields...)
+	for i, field := range fields {
+		if names[field.Name] {
+			return RteReduplicateFieldName
+		}
+		names[field.Name] = true
+		// 与服务端一致, 基本类型的长度由类型决定, 只有string与blob使用指定的长度
+		if size := RtdbTypeSize(field.Type); size != 0 {
+			fields[i].Length = size
+		} else if field.Length <= 0 {
+			return RteInvalidNamedTypeFieldLength
+		}
+	}
+	m.namedTypes[name] = &memoryNamedType{desc: desc, fields: fields}
+	return RteOk
+}
+
+func (m *MemoryBackend) RawRtdbbGetNamedTypesCountWarp(handle ConnectHandle) (int32, RtdbError) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, rte := m.session(handle); !RteIsOk(rte) {
+		return 0, rte
+	}
+	return int32(len(m.namedTypes)), RteOk
+}
+
+func (m *MemoryBackend) RawRtdbbGetAllNamedTypesWarp(handle ConnectHandle, count int32) ([]string, []int32, RtdbError) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, rte := m.session(handle); !RteIsOk(rte) {
+		return nil, nil, rte
+	}
+	names := make([]string, 0, len(m.namedTypes))
+	for name := range m.namedTypes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	names = SafeSlice(names, 0, count)
+	counts := make([]int32, 0, len(names))
+	for _, name := range names {
+		counts = append(counts, int32(len(m.namedTypes[name].fields)))
+	}
+	return names, counts, RteOk
+}
+
+func (m *MemoryBackend) RawRtdbbGetNamedTypeWarp(handle ConnectHandle, name string, fieldCount int32) ([]RtdbDataTypeField, int32, string, RtdbError) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, rte := m.session(handle); !RteIsOk(rte) {
+		return nil, 0, "", rte
+	}
+	typ, ok := m.namedTypes[name]
+	if !ok {
+		return nil, 0, "", RteNotExistNamedType
+	}
+	length := int32(0)
+	for _, field := range typ.fields {
+		length += field.Length
+	}
+	fields := append([]RtdbDataTypeField(nil), SafeSlice(typ.fields, 0, fieldCount)...)
+	return fields, length, typ.desc, RteOk
+}
+
+func (m *MemoryBackend) RawRtdbbRemoveNamedTypeWarp(handle ConnectHandle, name string) RtdbError {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, rte := m.session(handle); !RteIsOk(rte) {
+		return rte
+	}
+	if _, ok := m.namedTypes[name]; !ok {
+		return RteNotExistNamedType
+	}
+	for _, p := range m.points {
+		if p.namedType == name {
+			return RteNamedTypeUsedWithPoint
+		}
+	}
+	for _, p := range m.recycled {
+		if p.namedType == name {
+			return RteNamedTypeUsedWithRecyclePoint
+		}
+	}
+	delete(m.namedTypes, name)
+	return RteOk
+}
+
+func (m *MemoryBackend) RawRtdbbModifyNamedTypeWarp(handle ConnectHandle, name string, modifyName *string, modifyDesc *string, fieldNames []string, fieldDescs []string) RtdbError {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, rte := m.session(handle); !RteIsOk(rte) {
+		return rte
+	}
+	typ, ok := m.namedTypes[name]
+	if !ok {
+		return RteNotExistNamedType
+	}
+	if modifyName != nil && *modifyName != name {
+		if _, ok := m.namedTypes[*modifyName]; ok {
+			return RteReduplicateNamedType
+		}
+	}
+	for i, fieldName := range fieldNames {
+		found := false
+		for j := range typ.fields {
+			if typ.fields[j].Name == fieldName {
+				typ.fields[j].Desc = fieldDescs[i]
+				found = true
+			}
+		}
+		if !found {
+			return RteInvalidNamedTypeFieldName
+		}
+	}
+	if modifyDesc != nil {
+		typ.desc = *modifyDesc
+	}
+	if modifyName != nil && *modifyName != name {
+		delete(m.namedTypes, name)
+		m.namedTypes[*modifyName] = typ
+		for _, points := range []map[PointID]*memoryPoint{m.points, m.recycled} {
+			for _, p := range points {
+				if p.namedType == name {
+					p.namedType = *modifyName
+				}
+			}
+		}
+	}
+	return RteOk
+}
+
+// namedTypeField 查找自定义类型的字段
+//
+// output:
+//   - RtdbDataTypeField(field) 字段定义
+//   - int(offset) 字段在数值中的偏移
+func (m *MemoryBackend) namedTypeField(handle ConnectHandle, typeName string, match func(pos int, field RtdbDataTypeField) bool, fieldType RtdbType, object []byte) (RtdbDataTypeField, int, RtdbError) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, rte := m.session(handle); !RteIsOk(rte) {
+		return RtdbDataTypeField{}, 0, rte
+	}
+	typ, ok := m.namedTypes[typeName]
+	if !ok {
+		return RtdbDataTypeField{}, 0, RteNotExistNamedType
+	}
+	length, offset, found := 0, 0, -1
+	for i, field := range typ.fields {
+		if found < 0 && match(i, field) {
+			found, offset = i, length
+		}
+		length += int(field.Length)
+	}
+	if found < 0 {
+		return RtdbDataTypeField{}, 0, RteInvalidNamedTypeFieldName
+	}
+	if len(object) != length {
+		return RtdbDataTypeField{}, 0, RteNamedTypeLengthNotMatch
+	}
+	field := typ.fields[found]
+	if field.Type != fieldType {
+		return RtdbDataTypeField{}, 0, RteDataTypeNotMatch
+	}
+	return field, offset, RteOk
+}
+
+// fieldByName 按名称匹配字段, 大小写不敏感
+func fieldByName(name string) func(pos int, field RtdbDataTypeField) bool {
+	return func(pos int, field RtdbDataTypeField) bool {
+		return strings.EqualFold(field.Name, name)
+	}
+}
+
+// fieldByPos 按位置匹配字段
+func fieldByPos(fieldPos int32) func(pos int, field RtdbDataTypeField) bool {
+	return func(pos int, field RtdbDataTypeField) bool {
+		return pos == int(fieldPos)
+	}
+}
+
+// writeNamedTypeField 填充字段, 不足长度的部分以0填充
+func writeNamedTypeField(field RtdbDataTypeField, offset int, object []byte, value []byte) RtdbError {
+	if len(value) > int(field.Length) {
+		return RteInvalidNamedTypeFieldLength
+	}
+	dst := object[offset : offset+int(field.Length)]
+	clear(dst[copy(dst, value):])
+	return RteOk
+}
+
+// readNamedTypeField 提取字段
+func readNamedTypeField(field RtdbDataTypeField, offset int, object []byte, fieldLen int32) ([]byte, RtdbError) {
+	if fieldLen < field.Length && RtdbTypeSize(field.Type) != 0 {
+		return nil, RteInvalidNamedTypeFieldLength
+	}
+	value := make([]byte, fieldLen)
+	copy(value, object[offset:offset+int(field.Length)])
+	return value, RteOk
+}
+
+func (m *MemoryBackend) RawRtdbWriteNamedTypeFieldByName32Warp(handle ConnectHandle, typeName string, fieldName string, fieldType RtdbType, object []byte, field []byte) RtdbError {
+	f, offset, rte := m.namedTypeField(handle, typeName, fieldByName(fieldName), fieldType, object)
+	if !RteIsOk(rte) {
+		return rte
+	}
+	return writeNamedTypeField(f, offset, object, field)
+}
+
+func (m *MemoryBackend) RawRtdbWriteNamedTypeFieldByPos32Warp(handle ConnectHandle, typeName string, fieldPos int32, fieldType RtdbType, object []byte, field []byte) RtdbError {
+	f, offset, rte := m.namedTypeField(handle, typeName, fieldByPos(fieldPos), fieldType, object)
+	if !RteIsOk(rte) {
+		return rte
+	}
+	return writeNamedTypeField(f, offset, object, field)
+}
+
+func (m *MemoryBackend) RawRtdbReadNamedTypeFieldByName32Warp(handle ConnectHandle, typeName string, fieldName string, fieldType RtdbType, object []byte, fieldLen int32) ([]byte, RtdbError) {
+	f, offset, rte := m.namedTypeField(handle, typeName, fieldByName(fieldName), fieldType, object)
+	if !RteIsOk(rte) {
+		return nil, rte
+	}
+	return readNamedTypeField(f, offset, object, fieldLen)
+}
+
+func (m *MemoryBackend) RawRtdbReadNamedTypeFieldByPos32Warp(handle ConnectHandle, typeName string, fieldPos int32, fieldType RtdbType, object []byte, fieldLen int32) ([]byte, RtdbError) {
+	f, offset, rte := m.namedTypeField(handle, typeName, fieldByPos(fieldPos), fieldType, object)
+	if !RteIsOk(rte) {
+		return nil, rte
+	}
+	return readNamedTypeField(f, offset, object, fieldLen)
+}
+
+// RawRtdbNamedTypeNameFieldCheckWarp 按 rtdb_named_type_name_field_check 文档中的规则检查: 只允许字母、数字和下划线, 以字母开头
+func (m *MemoryBackend) RawRtdbNamedTypeNameFieldCheckWarp(checkName string, flag byte) RtdbError {
+	if checkName == "" {
+		return RteCheckNamedTypeNameError
+	}
+	for i, r := range checkName {
+		letter := (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+		if i == 0 && !letter {
+			return RteCheckNamedTypeNameError
+		}
+		if !letter && !(r >= '0' && r <= '9') && r != '_' {
+			return RteCheckNamedTypeNameError
+		}
+	}
+	return RteOk
+}
+
+func (m *MemoryBackend) RawRtdbbGetNamedTypePointsCountWarp(handle ConnectHandle, name string) (int32, RtdbError) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, rte := m.session(handle); !RteIsOk(rte) {
+		return 0, rte
+	}
+	if _, ok := m.namedTypes[name]; !ok {
+		return 0, RteNotExistNamedType
+	}
+	count := int32(0)
+	for _, p := range m.points {
+		if p.namedType == name {
+			count++
+		}
+	}
+	return count, RteOk
+}
+
+// namedTypeNames 获取标签点的自定义类型名称, 调用方需要持有锁
+func (m *MemoryBackend) namedTypeNames(points map[PointID]*memoryPoint, ids []PointID) ([]string, []int32, []RtdbError) {
+	names := make([]string, len(ids))
+	counts := make([]int32, len(ids))
+	rtes := make([]RtdbError, len(ids))
+	for i, id := range ids {
+		p, ok := points[id]
+		if !ok {
+			rtes[i] = RtePointNotFound
+			continue
+		}
+		typ, ok := m.namedTypes[p.namedType]
+		if !ok {
+			rtes[i] = RteNotExistNamedType
+			continue
+		}
+		names[i], counts[i] = p.namedType, int32(len(typ.fields))
+	}
+	return names, counts, rtes
+}
+
+func (m *MemoryBackend) RawRtdbbGetNamedTypeNamesPropertyWarp(handle ConnectHandle, ids []PointID) ([]string, []int32, []RtdbError, RtdbError) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, rte := m.session(handle); !RteIsOk(rte) {
+		return nil, nil, nil, rte
+	}
+	names, counts, rtes := m.namedTypeNames(m.points, ids)
+	return names, counts, rtes, RteOk
+}
+
+func (m *MemoryBackend) RawRtdbbGetRecycledNamedTypeNamesPropertyWarp(handle ConnectHandle, ids []PointID) ([]string, []int32, []RtdbError, RtdbError) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, rte := m.session(handle); !RteIsOk(rte) {
+		return nil, nil, nil, rte
+	}
+	names, counts, rtes := m.namedTypeNames(m.recycled, ids)
+	return names, counts, rtes, RteOk
+}
+
+func (m *MemoryBackend) RawRtdbsPutSnapshots64Warp(handle ConnectHandle, ids []PointID, datetimes []TimestampType, subtimes []SubtimeType, values []float64, states []int64, qualities []Quality) ([]RtdbError, RtdbError) {
+	return m.putValues(handle, ids, numberValues(datetimes, subtimes, values, states, qualities), snapshotWriter(false))
+}
+
+func (m *MemoryBackend) RawRtdbsFixSnapshots64Warp(handle ConnectHandle, ids []PointID, datetimes []TimestampType, subtimes []SubtimeType, values []float64, states []int64, qualities []Quality) ([]RtdbError, RtdbError) {
+	return m.putValues(handle, ids, numberValues(datetimes, subtimes, values, states, qualities), snapshotWriter(true))
+}
+
+func (m *MemoryBackend) RawRtdbsPutCoorSnapshots64Warp(handle ConnectHandle, ids []PointID, datetimes []TimestampType, subtimes []SubtimeType, xs []float32, ys []float32, qualities []Quality) ([]RtdbError, RtdbError) {
+	return m.putValues(handle, ids, coorValues(datetimes, subtimes, xs, ys, qualities), snapshotWriter(false))
+}
+
+func (m *MemoryBackend) RawRtdbsFixCoorSnapshots64Warp(handle ConnectHandle, ids []PointID, datetimes []TimestampType, subtimes []SubtimeType, xs []float32, ys []float32, qualities []Quality) ([]RtdbError, RtdbError) {
+	return m.putValues(handle, ids, coorValues(datetimes, subtimes, xs, ys, qualities), snapshotWriter(true))
+}
+
+func (m *MemoryBackend) RawRtdbsPutBlobSnapshots64Warp(handle ConnectHandle, ids []PointID, datetimes []TimestampType, subtimes []SubtimeType, blobs [][]byte, qualities []Quality) ([]RtdbError, RtdbError) {
+	return m.putValues(handle, ids, bytesValues(datetimes, subtimes, blobs, qualities), snapshotWriter(false))
+}
+
+func (m *MemoryBackend) RawRtdbsPutNamedTypeSnapshots64Warp(handle ConnectHandle, ids []PointID, datetimes []TimestampType, subtimes []SubtimeType, objects [][]byte, qualities []Quality) ([]RtdbError, RtdbError) {
+	return m.putValues(handle, ids, bytesValues(datetimes, subtimes, objects, qualities), snapshotWriter(false))
+}
+
+func (m *MemoryBackend) RawRtdbsPutDatetimeSnapshots64Warp(handle ConnectHandle, ids []PointID, datetimes []TimestampType, subtimes []SubtimeType, dtValues []string, qualities []Quality) ([]RtdbError, RtdbError) {
+	return m.putValues(handle, ids, datetimeValues(datetimes, subtimes, dtValues, qualities), snapshotWriter(false))
+}
+
+// snapshots 批量读取快照, 没有快照的标签点返回创建时间与 QualityCreated
+func (m *MemoryBackend) snapshots(handle ConnectHandle, ids []PointID) ([]memoryValue, []RtdbError, RtdbError) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, rte := m.session(handle); !RteIsOk(rte) {
+		return nil, nil, rte
+	}
+	values, rtes := make([]memoryValue, len(ids)), make([]RtdbError, len(ids))
+	for i, id := range ids {
+		p, ok := m.points[id]
+		switch {
+		case !ok:
+			rtes[i] = RtePointNotFound
+		case p.snapshot == nil:
+			values[i] = memoryValue{datetime: TimestampType(p.base.CreateDate), quality: QualityCreated}
+		default:
+			values[i] = *p.snapshot
+			values[i].data = append([]byte(nil), p.snapshot.data...)
+		}
+	}
+	return values, rtes, RteOk
+}
+
+func (m *MemoryBackend) RawRtdbsGetSnapshots64Warp(handle ConnectHandle, ids []PointID) ([]TimestampType, []SubtimeType, []float64, []int64, []Quality, []RtdbError, RtdbError) {
+	vs, rtes, rte := m.snapshots(handle, ids)
+	datetimes, subtimes, values, states, qualities := make([]TimestampType, len(vs)), make([]SubtimeType, len(vs)), make([]float64, len(vs)), make([]int64, len(vs)), make([]Quality, len(vs))
+	for i, v := range vs {
+		datetimes[i], subtimes[i], values[i], states[i], qualities[i] = v.datetime, v.subtime, v.value, v.state, v.quality
+	}
+	return datetimes, subtimes, values, states, qualities, rtes, rte
+}
+
+func (m *MemoryBackend) RawRtdbsGetCoorSnapshots64Warp(handle ConnectHandle, ids []PointID) ([]TimestampType, []SubtimeType, []float32, []float32, []Quality, []RtdbError, RtdbError) {
+	vs, rtes, rte := m.snapshots(handle, ids)
+	datetimes, subtimes, xs, ys, qualities := make([]TimestampType, len(vs)), make([]SubtimeType, len(vs)), make([]float32, len(vs)), make([]float32, len(vs)), make([]Quality, len(vs))
+	for i, v := range vs {
+		datetimes[i], subtimes[i], xs[i], ys[i], qualities[i] = v.datetime, v.subtime, v.x, v.y, v.quality
+	}
+	return datetimes, subtimes, xs, ys, qualities, rtes, rte
+}
+
+// snapshotDatas 批量读取String、Blob、Datetime、自定义类型快照, 超过maxLen的部分被截断
+func (m *MemoryBackend) snapshotDatas(handle ConnectHandle, ids []PointID, maxLen func(i int) int32) ([]TimestampType, []SubtimeType, [][]byte, []Quality, []RtdbError, RtdbError) {
+	vs, rtes, rte := m.snapshots(handle, ids)
+	datetimes, subtimes, datas, qualities := make([]TimestampType, len(vs)), make([]SubtimeType, len(vs)), make([][]byte, len(vs)), make([]Quality, len(vs))
+	for i, v := range vs {
+		if n := maxLen(i); n >= 0 && len(v.data) > int(n) {
+			v.data = v.data[:n]
+		}
+		datetimes[i], subtimes[i], datas[i], qualities[i] = v.datetime, v.subtime, v.data, v.quality
+	}
+	return datetimes, subtimes, datas, qualities, rtes, rte
+}
+
+func (m *MemoryBackend) RawRtdbsGetBlobSnapshots64Warp(handle ConnectHandle, ids []PointID, maxLen int32) ([]TimestampType, []SubtimeType, [][]byte, []Quality, []RtdbError, RtdbError) {
+	return m.snapshotDatas(handle, ids, func(int) int32 { return maxLen })
+}
+
+func (m *MemoryBackend) RawRtdbsGetDatetimeSnapshots64Warp(handle ConnectHandle, ids []PointID, typ int16) ([]TimestampType, []SubtimeType, []string, []Quality, []RtdbError, RtdbError) {
+	datetimes, subtimes, datas, qualities, rtes, rte := m.snapshotDatas(handle, ids, func(int) int32 { return -1 })
+	dtValues := make([]string, len(datas))
+	for i, data := range datas {
+		dtValues[i] = string(data)
+	}
+	return datetimes, subtimes, dtValues, qualities, rtes, rte
+}
+
+func (m *MemoryBackend) RawRtdbsGetNamedTypeSnapshots64Warp(handle ConnectHandle, ids []PointID, lens []int32) ([]TimestampType, []SubtimeType, [][]byte, []Quality, []RtdbError, RtdbError) {
+	return m.snapshotDatas(handle, ids, func(i int) int32 { return lens[i] })
+}
+
+func (m *MemoryBackend) RawRtdbhPutArchivedValues64Warp(handle ConnectHandle, ids []PointID, datetimes []TimestampType, subtimes []SubtimeType, values []float64, states []int64, qualities []Quality) ([]RtdbError, RtdbError) {
+	return m.putValues(handle, ids, numberValues(datetimes, subtimes, values, states, qualities), archiveWriter)
+}
+
+func (m *MemoryBackend) RawRtdbhPutArchivedCoorValues64Warp(handle ConnectHandle, ids []PointID, datetimes []TimestampType, subtimes []SubtimeType, xs []float32, ys []float32, qualities []Quality) ([]RtdbError, RtdbError) {
+	return m.putValues(handle, ids, coorValues(datetimes, subtimes, xs, ys, qualities), archiveWriter)
+}
+
+func (m *MemoryBackend) RawRtdbhPutArchivedBlobValues64Warp(handle ConnectHandle, ids []PointID, datetimes []TimestampType, subtimes []SubtimeType, blobs [][]byte, qualities []Quality) ([]RtdbError, RtdbError) {
+	return m.putValues(handle, ids, bytesValues(datetimes, subtimes, blobs, qualities), archiveWriter)
+}
+
+func (m *MemoryBackend) RawRtdbhPutArchivedNamedTypeValues64Warp(handle ConnectHandle, ids []PointID, datetimes []TimestampType, subtimes []SubtimeType, objects [][]byte, qualities []Quality) ([]RtdbError, RtdbError) {
+	return m.putValues(handle, ids, bytesValues(datetimes, subtimes, objects, qualities), archiveWriter)
+}
+
+func (m *MemoryBackend) RawRtdbhPutArchivedDatetimeValues64Warp(handle ConnectHandle, ids []PointID, datetimes []TimestampType, subtimes []SubtimeType, dtValues []string, qualities []Quality) ([]RtdbError, RtdbError) {
+	return m.putValues(handle, ids, datetimeValues(datetimes, subtimes, dtValues, qualities), archiveWriter)
+}
+
+// singleValue 读取单个历史值
+func (m *MemoryBackend) singleValue(handle ConnectHandle, id PointID, mode RtdbHisMode, datetime TimestampType, subtime SubtimeType) (memoryValue, RtdbError) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, rte := m.session(handle); !RteIsOk(rte) {
+		return memoryValue{}, rte
+	}
+	p, ok := m.points[id]
+	if !ok {
+		return memoryValue{}, RtePointNotFound
+	}
+	v, ok := p.findValue(mode, datetime, subtime)
+	if !ok {
+		return memoryValue{}, RteDataNotFound
+	}
+	return v, RteOk
+}
+
+func (m *MemoryBackend) RawRtdbhGetSingleValue64Warp(handle ConnectHandle, id PointID, mode RtdbHisMode, datetime TimestampType, subtime SubtimeType) (TimestampType, SubtimeType, float64, int64, Quality, RtdbError) {
+	v, rte := m.singleValue(handle, id, mode, datetime, subtime)
+	return v.datetime, v.subtime, v.value, v.state, v.quality, rte
+}
+
+func (m *MemoryBackend) RawRtdbhGetSingleCoorValue64Warp(handle ConnectHandle, id PointID, mode RtdbHisMode, datetime TimestampType, subtime SubtimeType) (TimestampType, SubtimeType, float32, float32, Quality, RtdbError) {
+	v, rte := m.singleValue(handle, id, mode, datetime, subtime)
+	return v.datetime, v.subtime, v.x, v.y, v.quality, rte
+}
+
+func (m *MemoryBackend) RawRtdbhGetSingleBlobValue64Warp(handle ConnectHandle, id PointID, mode RtdbHisMode, datetime TimestampType, subtime SubtimeType, maxLen int32) (TimestampType, SubtimeType, []byte, Quality, RtdbError) {
+	v, rte := m.singleValue(handle, id, mode, datetime, subtime)
+	if len(v.data) > int(maxLen) {
+		v.data = v.data[:maxLen]
+	}
+	return v.datetime, v.subtime, append([]byte(nil), v.data...), v.quality, rte
+}
+
+func (m *MemoryBackend) RawRtdbhGetSingleDatetimeValue64Warp(handle ConnectHandle, id PointID, mode RtdbHisMode, datetime TimestampType, subtime SubtimeType, dtType int16) (TimestampType, SubtimeType, []byte, Quality, RtdbError) {
+	v, rte := m.singleValue(handle, id, mode, datetime, subtime)
+	return v.datetime, v.subtime, append([]byte(nil), v.data...), v.quality, rte
+}
+
+func (m *MemoryBackend) RawRtdbhGetSingleNamedTypeValue64Warp(handle ConnectHandle, id PointID, mode RtdbHisMode, datetime TimestampType, subtime SubtimeType, length int32) (TimestampType, SubtimeType, []byte, Quality, RtdbError) {
+	v, rte := m.singleValue(handle, id, mode, datetime, subtime)
+	if len(v.data) > int(length) {
+		v.data = v.data[:length]
+	}
+	return v.datetime, v.subtime, append([]byte(nil), v.data...), v.quality, rte
+}
+
+// archivedValues 读取一段时间内的历史数据, 包含起止时间, 最多返回count个
+func (m *MemoryBackend) archivedValues(handle ConnectHandle, id PointID, count int32, datetime1 TimestampType, subtime1 SubtimeType, datetime2 TimestampType, subtime2 SubtimeType) ([]memoryValue, RtdbError) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, rte := m.session(handle); !RteIsOk(rte) {
+		return nil, rte
+	}
+	p, ok := m.points[id]
+	if !ok {
+		return nil, RtePointNotFound
+	}
+	i := sort.Search(len(p.archive), func(i int) bool {
+		return !p.archive[i].before(datetime1, subtime1)
+	})
+	values := make([]memoryValue, 0)
+	for ; i < len(p.archive) && len(values) < int(count); i++ {
+		v := p.archive[i]
+		if !v.before(datetime2, subtime2) && !v.equal(datetime2, subtime2) {
+			break
+		}
+		v.data = append([]byte(nil), v.data...)
+		values = append(values, v)
+	}
+	return values, RteOk
+}
+
+func (m *MemoryBackend) RawRtdbhGetArchivedValues64Warp(handle ConnectHandle, id PointID, count int32, datetime1 TimestampType, subtime1 SubtimeType, datetime2 TimestampType, subtime2 SubtimeType) ([]TimestampType, []SubtimeType, []float64, []int64, []Quality, RtdbError) {
+	vs, rte := m.archivedValues(handle, id, count, datetime1, subtime1, datetime2, subtime2)
+	datetimes, subtimes, values, states, qualities := make([]TimestampType, len(vs)), make([]SubtimeType, len(vs)), make([]float64, len(vs)), make([]int64, len(vs)), make([]Quality, len(vs))
+	for i, v := range vs {
+		datetimes[i], subtimes[i], values[i], states[i], qualities[i] = v.datetime, v.subtime, v.value, v.state, v.quality
+	}
+	return datetimes, subtimes, values, states, qualities, rte
+}
+
+func (m *MemoryBackend) RawRtdbhGetArchivedCoorValues64Warp(handle ConnectHandle, id PointID, count int32, datetime1 TimestampType, subtime1 SubtimeType, datetime2 TimestampType, subtime2 SubtimeType) ([]TimestampType, []SubtimeType, []float32, []float32, []Quality, RtdbError) {
+	vs, rte := m.archivedValues(handle, id, count, datetime1, subtime1, datetime2, subtime2)
+	datetimes, subtimes, xs, ys, qualities := make([]TimestampType, len(vs)), make([]SubtimeType, len(vs)), make([]float32, len(vs)), make([]float32, len(vs)), make([]Quality, len(vs))
+	for i, v := range vs {
+		datetimes[i], subtimes[i], xs[i], ys[i], qualities[i] = v.datetime, v.subtime, v.x, v.y, v.quality
+	}
+	return datetimes, subtimes, xs, ys, qualities, rte
+}
+
+// archivedDatas 读取一段时间内的String、Blob、Datetime、自定义类型历史数据, 超过maxLen的部分被截断
+func (m *MemoryBackend) archivedDatas(handle ConnectHandle, id PointID, maxLen int32, maxCount int32, datetime1 TimestampType, subtime1 SubtimeType, datetime2 TimestampType, subtime2 SubtimeType) ([]TimestampType, []SubtimeType, [][]byte, []Quality, RtdbError) {
+	vs, rte := m.archivedValues(handle, id, maxCount, datetime1, subtime1, datetime2, subtime2)
+	datetimes, subtimes, datas, qualities := make([]TimestampType, len(vs)), make([]SubtimeType, len(vs)), make([][]byte, len(vs)), make([]Quality, len(vs))
+	for i, v := range vs {
+		if maxLen >= 0 && len(v.data) > int(maxLen) {
+			v.data = v.data[:maxLen]
+		}
+		datetimes[i], subtimes[i], datas[i], qualities[i] = v.datetime, v.subtime, v.data, v.quality
+	}
+	return datetimes, subtimes, datas, qualities, rte
+}
+
+func (m *MemoryBackend) RawRtdbhGetArchivedBlobValues64Warp(handle ConnectHandle, id PointID, maxLen int32, maxCount int32, datetime1 TimestampType, subtime1 SubtimeType, datetime2 TimestampType, subtime2 SubtimeType) ([]TimestampType, []SubtimeType, [][]byte, []Quality, RtdbError) {
+	return m.archivedDatas(handle, id, maxLen, maxCount, datetime1, subtime1, datetime2, subtime2)
+}
+
+func (m *MemoryBackend) RawRtdbhGetArchivedDatetimeValues64Warp(handle ConnectHandle, id PointID, maxCount int32, datetime1 TimestampType, subtime1 SubtimeType, datetime2 TimestampType, subtime2 SubtimeType, dtType int16) ([]TimestampType, []SubtimeType, [][]byte, []Quality, RtdbError) {
+	return m.archivedDatas(handle, id, -1, maxCount, datetime1, subtime1, datetime2, subtime2)
+}
+
+func (m *MemoryBackend) RawRtdbhGetArchivedNamedTypeValues64Warp(handle ConnectHandle, id PointID, datetime1 TimestampType, subtime1 SubtimeType, datetime2 TimestampType, subtime2 SubtimeType, length int32, maxCount int32) ([]TimestampType, []SubtimeType, [][]byte, []Quality, RtdbError) {
+	return m.archivedDatas(handle, id, length, maxCount, datetime1, subtime1, datetime2, subtime2)
+}
+
+// memoryNanos 时间戳转换成纳秒
+func memoryNanos(datetime TimestampType, subtime SubtimeType) int64 {
+	return int64(datetime)*int64(time.Second) + int64(subtime)
+}
+
+func (m *MemoryBackend) RawRtdbhGetInterpoValues64Warp(handle ConnectHandle, id PointID, count int32, datetime1 TimestampType, subtime1 SubtimeType, datetime2 TimestampType, subtime2 SubtimeType) ([]TimestampType, []SubtimeType, []float64, []int64, []Quality, RtdbError) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, rte := m.session(handle); !RteIsOk(rte) {
+		return nil, nil, nil, nil, nil, rte
+	}
+	p, ok := m.points[id]
+	if !ok {
+		return nil, nil, nil, nil, nil, RtePointNotFound
+	}
+	if count <= 0 {
+		return nil, nil, nil, nil, nil, RteInvalidParameter
+	}
+	start, end := memoryNanos(datetime1, subtime1), memoryNanos(datetime2, subtime2)
+	datetimes, subtimes, values, states, qualities := make([]TimestampType, count), make([]SubtimeType, count), make([]float64, count), make([]int64, count), make([]Quality, count)
+	for i := range count {
+		t := start
+		if count > 1 {
+			t += (end - start) / int64(count-1) * int64(i)
+		}
+		datetime, subtime := TimestampType(t/int64(time.Second)), SubtimeType(t%int64(time.Second))
+		v, ok := p.findValue(RtdbHisModeInter, datetime, subtime)
+		if !ok {
+			v = memoryValue{quality: QualityNoData}
+		}
+		datetimes[i], subtimes[i], values[i], states[i], qualities[i] = datetime, subtime, v.value, v.state, v.quality
+	}
+	return datetimes, subtimes, values, states, qualities, RteOk
+}
+
+// RawRtdbhSummaryDataWarp 内存后端的统计值
+//   - 最大值、最小值、算术平均值只统计质量码正常的数据
+//   - Power 为按时间加权的积分(数值×秒), 阶跃点与整数类型按前值计算, 其他按梯形计算, PowerAvg 为 Power 除以首尾数据的时间跨度, Total 为按秒累计的积分(与 Power 相同)
+//   - 起止时间为0时表示最早和最近的数据
+//   - 起止时间不插值, 只统计时间段内的数据
+func (m *MemoryBackend) RawRtdbhSummaryDataWarp(handle ConnectHandle, id PointID, datetime1 TimestampType, subtime1 SubtimeType, datetime2 TimestampType, subtime2 SubtimeType) (*RtdbSummaryData, RtdbError) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, rte := m.session(handle); !RteIsOk(rte) {
+		return nil, rte
+	}
+	p, ok := m.points[id]
+	if !ok {
+		return nil, RtePointNotFound
+	}
+	step, float := p.base.Step == ON, true
+	switch p.base.Type {
+	case RtdbTypeReal16, RtdbTypeReal32, RtdbTypeReal64, RtdbTypeFp16, RtdbTypeFp32, RtdbTypeFp64:
+	case RtdbTypeBool, RtdbTypeUint8, RtdbTypeInt8, RtdbTypeChar, RtdbTypeUint16, RtdbTypeInt16, RtdbTypeUint32, RtdbTypeInt32, RtdbTypeInt64:
+		step, float = true, false
+	default:
+		return nil, RteInvalidDataType
+	}
+	number := func(v memoryValue) float64 {
+		if float {
+			return v.value
+		}
+		return float64(v.state)
+	}
+
+	summary := &RtdbSummaryData{}
+	sum := 0.0
+	var prev *memoryValue
+	for i := range p.archive {
+		v := &p.archive[i]
+		if datetime1 != 0 && v.before(datetime1, subtime1) {
+			continue
+		}
+		if datetime2 != 0 && !v.before(datetime2, subtime2) && !v.equal(datetime2, subtime2) {
+			break
+		}
+		value := number(*v)
+		if summary.Count == 0 {
+			summary.FirstTime, summary.FirstSubtime, summary.FirstValue, summary.FirstQuality = v.datetime, v.subtime, value, int16(v.quality)
+		}
+		summary.LastTime, summary.LastSubtime, summary.LastValue, summary.LastQuality = v.datetime, v.subtime, value, int16(v.quality)
+		summary.Count++
+		if v.quality != QualityGood {
+			prev = nil
+			continue
+		}
+		if summary.ValidCount == 0 || value > summary.MaxValue {
+			summary.MaxTime, summary.MaxSubtime, summary.MaxValue, summary.MaxQuality = v.datetime, v.subtime, value, int16(v.quality)
+		}
+		if summary.ValidCount == 0 || value < summary.MinValue {
+			summary.MinTime, summary.MinSubtime, summary.MinValue, summary.MinQuality = v.datetime, v.subtime, value, int16(v.quality)
+		}
+		summary.ValidCount++
+		sum += value
+		if prev != nil {
+			seconds := float64(memoryNanos(v.datetime, v.subtime)-memoryNanos(prev.datetime, prev.subtime)) / float64(time.Second)
+			if step {
+				summary.Power += number(*prev) * seconds
+			} else {
+				summary.Power += (number(*prev) + value) / 2 * seconds
+			}
+		}
+		prev = v
+	}
+	summary.Total = summary.Power
+	if summary.ValidCount > 0 {
+		summary.CalcAvg = sum / float64(summary.ValidCount)
+		span := float64(memoryNanos(summary.LastTime, summary.LastSubtime)-memoryNanos(summary.FirstTime, summary.FirstSubtime)) / float64(time.Second)
+		if span > 0 {
+			summary.PowerAvg = summary.Power / span
+		} else {
+			summary.PowerAvg = summary.CalcAvg
+		}
+	}
+	return summary, RteOk
+}
+
+// memorySubscription 内存后端的快照订阅, 事件按产生的顺序在单独的goroutine中回调
+type memorySubscription struct {
+	handle   ConnectHandle
+	callback SnapshotEventFunc
+	deltas   map[PointID]memoryDelta // 订阅的标签点及其容差值
+
+	mu     sync.Mutex
+	queue  []*SnapshotEvent
+	signal chan struct{}
+	stop   chan struct{}
+	once   sync.Once
+}
+
+// memoryDelta 订阅的容差值
+type memoryDelta struct {
+	value float64
+	state int64
+	last  *memoryValue // 最后推送的快照
+}
+
+// exceeded 快照相对最后推送的快照的变化是否超过容差值, 容差值均为0时总是推送
+func (d memoryDelta) exceeded(v *memoryValue) bool {
+	if d.last == nil || (d.value == 0 && d.state == 0) || v.quality != d.last.quality {
+		return true
+	}
+	state := v.state - d.last.state
+	if state < 0 {
+		state = -state
+	}
+	return math.Abs(v.value-d.last.value) > d.value || state > d.state
+}
+
+// push 将事件加入队列, 调用方需要持有 MemoryBackend 的锁
+func (s *memorySubscription) push(event *SnapshotEvent) {
+	s.mu.Lock()
+	s.queue = append(s.queue, event)
+	s.mu.Unlock()
+	select {
+	case s.signal <- struct{}{}:
+	default:
+	}
+}
+
+// close 停止回调, 正在执行的回调不受影响
+func (s *memorySubscription) close() {
+	s.once.Do(func() { close(s.stop) })
+}
+
+// run 依次回调队列中的事件, 回调返回非RteOk时退出订阅
+func (s *memorySubscription) run(m *MemoryBackend) {
+	for {
+		select {
+		case <-s.stop:
+			return
+		case <-s.signal:
+		}
+		for {
+			s.mu.Lock()
+			if len(s.queue) == 0 {
+				s.mu.Unlock()
+				break
+			}
+			event := s.queue[0]
+			s.queue = s.queue[1:]
+			s.mu.Unlock()
+
+			select {
+			case <-s.stop:
+				return
+			default:
+			}
+			if rte := s.callback(s.handle, event); !RteIsOk(rte) {
+				m.mu.Lock()
+				if m.subscriptions[s.handle] == s {
+					delete(m.subscriptions, s.handle)
+				}
+				m.mu.Unlock()
+				s.close()
+				return
+			}
+		}
+	}
+}
+
+// publish 向订阅了快照改变的标签点的连接推送事件, 调用方需要持有锁
+func (m *MemoryBackend) publish(ids []PointID) {
+	if len(ids) == 0 {
+		return
+	}
+	for _, sub := range m.subscriptions {
+		event := &SnapshotEvent{Type: RtdbEventData}
+		for _, id := range ids {
+			delta, ok := sub.deltas[id]
+			v := m.points[id].snapshot
+			if !ok || !delta.exceeded(v) {
+				continue
+			}
+			delta.last = v
+			sub.deltas[id] = delta
+			event.IDs = append(event.IDs, id)
+			event.Datetimes = append(event.Datetimes, v.datetime)
+			event.Subtimes = append(event.Subtimes, v.subtime)
+			event.Values = append(event.Values, v.value)
+			event.States = append(event.States, v.state)
+			event.Qualities = append(event.Qualities, v.quality)
+			event.Errors = append(event.Errors, RteOk)
+		}
+		if len(event.IDs) != 0 {
+			sub.push(event)
+		}
+	}
+}
+
+func (m *MemoryBackend) RawRtdbsSubscribeSnapshotsEx64Warp(handle ConnectHandle, ids []PointID, options RtdbSubscribeOption, callback SnapshotEventFunc) ([]RtdbError, RtdbError) {
+	return m.subscribe(handle, ids, make([]float64, len(ids)), make([]int64, len(ids)), callback)
+}
+
+func (m *MemoryBackend) RawRtdbsSubscribeDeltaSnapshots64Warp(handle ConnectHandle, ids []PointID, deltaValues []float64, deltaStates []int64, options RtdbSubscribeOption, callback SnapshotEventFunc) ([]RtdbError, RtdbError) {
+	return m.subscribe(handle, ids, deltaValues, deltaStates, callback)
+}
+
+// subscribe 按容差订阅快照, 容差值为0时快照改变即推送
+func (m *MemoryBackend) subscribe(handle ConnectHandle, ids []PointID, deltaValues []float64, deltaStates []int64, callback SnapshotEventFunc) ([]RtdbError, RtdbError) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, rte := m.session(handle); !RteIsOk(rte) {
+		return nil, rte
+	}
+	if _, ok := m.subscriptions[handle]; ok {
+		return nil, RteHandleSubscribed
+	}
+	if len(ids) > int(RtdbConstMaxSubscribeSnapshots) {
+		return nil, RteSubscribeGreaterMaxCount
+	}
+	sub := &memorySubscription{
+		handle:   handle,
+		callback: callback,
+		deltas:   make(map[PointID]memoryDelta, len(ids)),
+		signal:   make(chan struct{}, 1),
+		stop:     make(chan struct{}),
+	}
+	rtes := make([]RtdbError, len(ids))
+	for i, id := range ids {
+		if _, ok := m.points[id]; !ok {
+			rtes[i] = RtePointNotFound
+			continue
+		}
+		sub.deltas[id] = memoryDelta{value: deltaValues[i], state: deltaStates[i]}
+	}
+	m.subscriptions[handle] = sub
+	go sub.run(m)
+	return rtes, RteOk
+}
+
+func (m *MemoryBackend) RawRtdbsChangeSubscribeSnapshotsWarp(handle ConnectHandle, ids []PointID, deltaValues []float64, deltaStates []int64, changedTypes []RtdbSubscribeChangeType) ([]RtdbError, RtdbError) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, rte := m.session(handle); !RteIsOk(rte) {
+		return nil, rte
+	}
+	sub, ok := m.subscriptions[handle]
+	if !ok {
+		return nil, RteNoSubscribe
+	}
+	rtes := make([]RtdbError, len(ids))
+	event := &SnapshotEvent{
+		Type:      RtdbEventChanged,
+		IDs:       append([]PointID(nil), ids...),
+		Datetimes: make([]TimestampType, len(ids)),
+		Values:    append([]float64(nil), deltaValues...),
+		States:    append([]int64(nil), deltaStates...),
+		Errors:    rtes,
+	}
+	for i, id := range ids {
+		event.Datetimes[i] = TimestampType(changedTypes[i])
+		_, subscribed := sub.deltas[id]
+		switch changedTypes[i] {
+		case RtdbSubscribeChangeTypeAdd:
+			switch _, exists := m.points[id]; {
+			case !exists:
+				rtes[i] = RtePointNotFound
+			case subscribed:
+				rtes[i] = RteAlreadySubscribe
+			case len(sub.deltas) >= int(RtdbConstMaxSubscribeSnapshots):
+				rtes[i] = RteSubscribeGreaterMaxCount
+			default:
+				sub.deltas[id] = memoryDelta{value: deltaValues[i], state: deltaStates[i]}
+			}
+		case RtdbSubscribeChangeTypeUpdate:
+			if !subscribed {
+				rtes[i] = RteNoSubscribe
+			} else {
+				sub.deltas[id] = memoryDelta{value: deltaValues[i], state: deltaStates[i], last: sub.deltas[id].last}
+			}
+		case RtdbSubscribeChangeTypeRemove:
+			if !subscribed {
+				rtes[i] = RteNoSubscribe
+			} else {
+				delete(sub.deltas, id)
+			}
+		default:
+			rtes[i] = RteInvalidParameter
+		}
+	}
+	sub.push(event)
+	return append([]RtdbError(nil), rtes...), RteOk
+}
+
+func (m *MemoryBackend) RawRtdbsCancelSubscribeSnapshotsWarp(handle ConnectHandle) RtdbError {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, rte := m.session(handle); !RteIsOk(rte) {
+		return rte
+	}
+	sub, ok := m.subscriptions[handle]
+	if !ok {
+		return RteNoSubscribe
+	}
+	delete(m.subscriptions, handle)
+	sub.close()
+	return RteOk
+}
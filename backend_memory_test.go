@@ -0,0 +1,265 @@
+package rtdb_api
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// 内存后端读写数据
+func TestMemoryBackend_WriteReadValue(t *testing.T) {
+	conn, err := LoginWithBackend(NewMemoryBackend(), "127.0.0.1", Port, Username, Password)
+	if err != nil {
+		t.Fatal("登录用户失败", err)
+	}
+	defer func() { _ = conn.Logout() }()
+
+	table, err := conn.CreateTable("memory", "内存表")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := conn.CreateTable("memory", "内存表"); !errors.Is(err, RteReduplicateTabname) {
+		t.Fatal("期望表名重复", err)
+	}
+
+	info, err := conn.AddPoint(NewPointInfo("temp", table.ID, ValueTypeFloat64, PointBase, RtdbPrecisionNano, "℃", "温度"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Unix(1700000000, 0)
+	if err := conn.WriteValue(info, false, NewTvqFloat64(now, 10, QualityGood)); err != nil {
+		t.Fatal(err)
+	}
+	if err := conn.WriteValue(info, false, NewTvqFloat64(now.Add(10*time.Second), 20, QualityGood)); err != nil {
+		t.Fatal(err)
+	}
+	// 早于快照的数据通过存档写入
+	if _, err := conn.WriteValues(info, false, []TVQ{NewTvqFloat64(now.Add(-10*time.Second), 5, QualityGood)}); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		mode  RtdbHisMode
+		ts    time.Time
+		value float64
+	}{
+		{RtdbHisModeExact, now, 10},
+		{RtdbHisModeExact, now.Add(-10 * time.Second), 5},
+		{RtdbHisModeInter, now.Add(5 * time.Second), 15},
+		{RtdbHisModeNext, now, 20},
+		{RtdbHisModePrevious, now, 5},
+		{RtdbHisModeExactOrPrev, now.Add(time.Second), 10},
+	}
+	for _, tt := range tests {
+		tvq, err := conn.ReadValue(info, tt.mode, tt.ts)
+		if err != nil {
+			t.Fatal(tt.mode, err)
+		}
+		if tvq.Value.FloatValue != tt.value {
+			t.Errorf("模式%d: 期望%v, 实际%v", tt.mode, tt.value, tvq.Value.FloatValue)
+		}
+	}
+	if _, err := conn.ReadValue(info, RtdbHisModeNext, now.Add(time.Minute)); !errors.Is(err, RteDataNotFound) {
+		t.Error("期望没有数据", err)
+	}
+}
+
+// 内存后端标签点的查找、搜索与回收
+func TestMemoryBackend_Points(t *testing.T) {
+	conn, err := LoginWithBackend(NewMemoryBackend(), "127.0.0.1", Port, Username, Password)
+	if err != nil {
+		t.Fatal("登录用户失败", err)
+	}
+	defer func() { _ = conn.Logout() }()
+
+	table, err := conn.CreateTable("memory", "内存表")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"a1", "a2", "b1"} {
+		if _, err := conn.AddPoint(NewPointInfo(name, table.ID, ValueTypeInt32, PointBase, RtdbPrecisionMicro, "", "")); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	infos, errs, err := conn.FindPoints([]string{"memory.a2", "memory.xx"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if errs[0] != nil || infos[0].Name != "a2" {
+		t.Error("查找标签点失败", errs[0])
+	}
+	if !errors.Is(errs[1], RtePointNotFound) {
+		t.Error("期望标签点不存在", errs[1])
+	}
+
+	count, points, _, err := conn.SearchPoint(0, 10, "a*", "memory", "", "", "", "", "", RtdbTypeAny, RtdbPrecisionAny, RtdbSearchNull, "", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 || len(points) != 2 || points[0].Name != "a1" {
+		t.Error("搜索标签点失败", count, len(points))
+	}
+
+	if err := conn.DeletePoint(infos[0].ID); err != nil {
+		t.Fatal(err)
+	}
+	count, _, _, err = conn.GetRecycledPoints(0, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 1 {
+		t.Error("回收站标签点数量错误", count)
+	}
+	if err := conn.RecoverPoint(table.ID, infos[0].ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := conn.GetPoint(infos[0].ID); err != nil {
+		t.Error("恢复标签点失败", err)
+	}
+}
+
+// 分页参数start、count只影响返回的标签点, 总数为全部符合条件的个数; 自定义类型列表不包含空元素
+func TestMemoryBackend_Paging(t *testing.T) {
+	conn, err := LoginWithBackend(NewMemoryBackend(), "127.0.0.1", Port, Username, Password)
+	if err != nil {
+		t.Fatal("登录用户失败", err)
+	}
+	defer func() { _ = conn.Logout() }()
+
+	table, err := conn.CreateTable("paging", "分页")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ids := make([]PointID, 0)
+	for _, name := range []string{"p1", "p2", "p3", "p4", "p5"} {
+		info, err := conn.AddPoint(NewPointInfo(name, table.ID, ValueTypeInt32, PointBase, RtdbPrecisionMilli, "", ""))
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, info.ID)
+	}
+
+	total, points, _, err := conn.SearchPoint(1, 2, "p*", "paging", "", "", "", "", "", RtdbTypeAny, RtdbPrecisionAny, RtdbSearchNull, "", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total != 5 || len(points) != 2 || points[0].Name != "p2" || points[1].Name != "p3" {
+		t.Error("搜索标签点的分页错误", total, len(points))
+	}
+
+	for _, id := range ids[:3] {
+		if err := conn.DeletePoint(id); err != nil {
+			t.Fatal(err)
+		}
+	}
+	total, points, _, err = conn.GetRecycledPoints(1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total != 3 || len(points) != 1 || points[0].ID != ids[1] {
+		t.Error("回收站标签点的分页错误", total, len(points))
+	}
+
+	for _, name := range []string{"t1", "t2"} {
+		if err := conn.AddNamedType(name, "", RtdbDataTypeField{Name: "v", Type: RtdbTypeInt32}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	types, err := conn.GetNamedTypes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(types) != 2 || types[0].Name == "" || types[1].Name == "" {
+		t.Errorf("自定义类型列表错误 %+v", types)
+	}
+}
+
+// 内存后端读取快照、插值与统计值
+func TestMemoryBackend_SnapshotsSummary(t *testing.T) {
+	conn, err := LoginWithBackend(NewMemoryBackend(), "127.0.0.1", Port, Username, Password)
+	if err != nil {
+		t.Fatal("登录用户失败", err)
+	}
+	defer func() { _ = conn.Logout() }()
+
+	table, err := conn.CreateTable("memory", "内存表")
+	if err != nil {
+		t.Fatal(err)
+	}
+	temp, err := conn.AddPoint(NewPointInfo("temp", table.ID, ValueTypeFloat64, PointBase, RtdbPrecisionMilli, "℃", "温度"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	count, err := conn.AddPoint(NewPointInfo("count", table.ID, ValueTypeInt32, PointBase, RtdbPrecisionMilli, "", "计数"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	name, err := conn.AddPoint(NewPointInfo("name", table.ID, ValueTypeString, PointBase, RtdbPrecisionMilli, "", "名称"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Unix(1700000000, 0)
+	for i, v := range []float64{1, 3, 5, 7} {
+		if err := conn.WriteValue(temp, false, NewTvqFloat64(now.Add(time.Duration(i)*10*time.Second), v, QualityGood)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := conn.WriteValue(count, false, NewTvqInt32(now, 42, QualityGood)); err != nil {
+		t.Fatal(err)
+	}
+
+	tvqs, errs, err := conn.ReadSnapshots([]*PointInfo{temp, count, name})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, e := range errs {
+		if e != nil {
+			t.Fatal(i, e)
+		}
+	}
+	if tvqs[0].Value.FloatValue != 7 || tvqs[1].Value.IntValue != 42 || tvqs[2].Quality != QualityCreated {
+		t.Errorf("快照错误 %+v", tvqs)
+	}
+
+	tvqs, err = conn.ReadInterpoValues(temp, now, now.Add(30*time.Second), 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []float64{1, 2, 3, 4, 5, 6, 7}
+	if len(tvqs) != len(want) {
+		t.Fatal("插值个数错误", len(tvqs))
+	}
+	for i, tvq := range tvqs {
+		if tvq.Value.FloatValue != want[i] || !tvq.Timestamp.Equal(now.Add(time.Duration(i)*5*time.Second)) {
+			t.Errorf("插值%d错误 %+v", i, tvq)
+		}
+	}
+	if _, err := conn.ReadInterpoValues(name, now, now.Add(time.Minute), 2); err == nil {
+		t.Error("字符串类型期望插值失败")
+	}
+
+	summary, err := conn.ReadSummary(temp, now, now.Add(30*time.Second))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if summary.Count != 4 || summary.Total != 120 || summary.CalcAvg != 4 || summary.Power != 120 || summary.PowerAvg != 4 ||
+		summary.Max.Value != 7 || summary.Min.Value != 1 || !summary.First.Timestamp.Equal(now) {
+		t.Errorf("统计值错误 %+v", summary)
+	}
+
+	temp.HighLimit = 200
+	temp.Unit = "K"
+	if err := conn.UpdatePointInfo(temp); err != nil {
+		t.Fatal(err)
+	}
+	updated, err := conn.GetPoint(temp.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.HighLimit != 200 || updated.Unit != "K" {
+		t.Errorf("修改标签点属性失败 %+v", updated)
+	}
+}
@@ -0,0 +1,514 @@
+//go:build cgo
+
+package rtdb_api
+
+// NativeBackend 基于CGO的后端, 直接调用api.go中的同名函数
+type NativeBackend struct{}
+
+// defaultBackend 获取默认后端, 开启CGO时为 NativeBackend, 并使用 SetDefaultInstrumentation 设置的观测配置
+func defaultBackend() (Backend, error) {
+	if err := checkLibraryLoaded(); err != nil {
+		return nil, err
+	}
+	return NewInstrumentedBackend(NativeBackend{}, defaultInstrumentation.Load()), nil
+}
+
+func (NativeBackend) RawRtdbGetApiVersionWarp() (ApiVersion, RtdbError) {
+	return RawRtdbGetApiVersionWarp()
+}
+
+func (NativeBackend) RawRtdbSetOptionWarp(optionType RtdbApiOption, value int32) RtdbError {
+	return RawRtdbSetOptionWarp(optionType, value)
+}
+
+func (NativeBackend) RawRtdbConnectWarp(hostname string, port int32) (ConnectHandle, RtdbError) {
+	return RawRtdbConnectWarp(hostname, port)
+}
+
+func (NativeBackend) RawRtdbLoginWarp(handle ConnectHandle, user string, password string) (PrivGroup, RtdbError) {
+	return RawRtdbLoginWarp(handle, user, password)
+}
+
+func (NativeBackend) RawRtdbDisconnectWarp(handle ConnectHandle) RtdbError {
+	return RawRtdbDisconnectWarp(handle)
+}
+
+func (NativeBackend) RawRtdbGetDbInfo1Warp(handle ConnectHandle, param RtdbParam) (ParamString, RtdbError) {
+	return RawRtdbGetDbInfo1Warp(handle, param)
+}
+
+func (NativeBackend) RawRtdbGetDbInfo2Warp(handle ConnectHandle, param RtdbParam) (ParamInt, RtdbError) {
+	return RawRtdbGetDbInfo2Warp(handle, param)
+}
+
+func (NativeBackend) RawRtdbSetDbInfo1Warp(handle ConnectHandle, param RtdbParam, value ParamString) RtdbError {
+	return RawRtdbSetDbInfo1Warp(handle, param, value)
+}
+
+func (NativeBackend) RawRtdbSetDbInfo2Warp(handle ConnectHandle, param RtdbParam, value ParamInt) RtdbError {
+	return RawRtdbSetDbInfo2Warp(handle, param, value)
+}
+
+func (NativeBackend) RawRtdbConnectionCountWarp(handle ConnectHandle, nodeNumber int32) (int32, RtdbError) {
+	return RawRtdbConnectionCountWarp(handle, nodeNumber)
+}
+
+func (NativeBackend) RawRtdbGetConnectionsWarp(handle ConnectHandle, nodeNumber int32, count int32) ([]SocketHandle, RtdbError) {
+	return RawRtdbGetConnectionsWarp(handle, nodeNumber, count)
+}
+
+func (NativeBackend) RawRtdbGetOwnConnectionWarp(handle ConnectHandle, nodeNumber int32) (SocketHandle, RtdbError) {
+	return RawRtdbGetOwnConnectionWarp(handle, nodeNumber)
+}
+
+func (NativeBackend) RawRtdbGetConnectionInfoIpv6Warp(handle ConnectHandle, nodeNumber int32, socket SocketHandle) (RtdbHostConnectInfoIpv6, RtdbError) {
+	return RawRtdbGetConnectionInfoIpv6Warp(handle, nodeNumber, socket)
+}
+
+func (NativeBackend) RawRtdbOsType(handle ConnectHandle) (RtdbOsType, RtdbError) {
+	return RawRtdbOsType(handle)
+}
+
+func (NativeBackend) RawRtdbChangePasswordWarp(handle ConnectHandle, user string, password string) RtdbError {
+	return RawRtdbChangePasswordWarp(handle, user, password)
+}
+
+func (NativeBackend) RawRtdbChangeMyPasswordWarp(handle ConnectHandle, oldPwd string, newPwd string) RtdbError {
+	return RawRtdbChangeMyPasswordWarp(handle, oldPwd, newPwd)
+}
+
+func (NativeBackend) RawRtdbGetPrivWarp(handle ConnectHandle) (PrivGroup, RtdbError) {
+	return RawRtdbGetPrivWarp(handle)
+}
+
+func (NativeBackend) RawRtdbChangePrivWarp(handle ConnectHandle, user string, priv PrivGroup) RtdbError {
+	return RawRtdbChangePrivWarp(handle, user, priv)
+}
+
+func (NativeBackend) RawRtdbAddUserWarp(handle ConnectHandle, user string, password string, priv PrivGroup) RtdbError {
+	return RawRtdbAddUserWarp(handle, user, password, priv)
+}
+
+func (NativeBackend) RawRtdbRemoveUserWarp(handle ConnectHandle, user string) RtdbError {
+	return RawRtdbRemoveUserWarp(handle, user)
+}
+
+func (NativeBackend) RawRtdbLockUserWarp(handle ConnectHandle, user string, lock Switch) RtdbError {
+	return RawRtdbLockUserWarp(handle, user, lock)
+}
+
+func (NativeBackend) RawRtdbGetUsersWarp(handle ConnectHandle) ([]RtdbUserInfo, RtdbError) {
+	return RawRtdbGetUsersWarp(handle)
+}
+
+func (NativeBackend) RawRtdbAddBlacklistWarp(handle ConnectHandle, addr string, mask string, desc string) RtdbError {
+	return RawRtdbAddBlacklistWarp(handle, addr, mask, desc)
+}
+
+func (NativeBackend) RawRtdbUpdateBlacklistWarp(handle ConnectHandle, oldAddr string, oldMask string, newAddr string, newMask string, newDesc string) RtdbError {
+	return RawRtdbUpdateBlacklistWarp(handle, oldAddr, oldMask, newAddr, newMask, newDesc)
+}
+
+func (NativeBackend) RawRtdbRemoveBlacklistWarp(handle ConnectHandle, addr string, mask string) RtdbError {
+	return RawRtdbRemoveBlacklistWarp(handle, addr, mask)
+}
+
+func (NativeBackend) RawRtdbGetBlacklistWarp(handle ConnectHandle) ([]BlackList, RtdbError) {
+	return RawRtdbGetBlacklistWarp(handle)
+}
+
+func (NativeBackend) RawRtdbAddAuthorizationWarp(handle ConnectHandle, addr string, mask string, desc string, priv PrivGroup) RtdbError {
+	return RawRtdbAddAuthorizationWarp(handle, addr, mask, desc, priv)
+}
+
+func (NativeBackend) RawRtdbUpdateAuthorizationWarp(handle ConnectHandle, oldAddr string, oldMask string, newAddr string, newMask string, newDesc string, priv PrivGroup) RtdbError {
+	return RawRtdbUpdateAuthorizationWarp(handle, oldAddr, oldMask, newAddr, newMask, newDesc, priv)
+}
+
+func (NativeBackend) RawRtdbRemoveAuthorizationWarp(handle ConnectHandle, addr string, mask string) RtdbError {
+	return RawRtdbRemoveAuthorizationWarp(handle, addr, mask)
+}
+
+func (NativeBackend) RawRtdbGetAuthorizationsWarp(handle ConnectHandle) ([]AuthorizationsList, RtdbError) {
+	return RawRtdbGetAuthorizationsWarp(handle)
+}
+
+func (NativeBackend) RawRtdbHostTime64Warp(handle ConnectHandle) (TimestampType, RtdbError) {
+	return RawRtdbHostTime64Warp(handle)
+}
+
+func (NativeBackend) RawRtdbFormatTimespanWarp(timespan int32) (string, RtdbError) {
+	return RawRtdbFormatTimespanWarp(timespan)
+}
+
+func (NativeBackend) RawRtdbParseTimespanWarp(tStr string) (DateTimeType, RtdbError) {
+	return RawRtdbParseTimespanWarp(tStr)
+}
+
+func (NativeBackend) RawRtdbParseTimeWarp(tStr string) (TimestampType, SubtimeType, RtdbError) {
+	return RawRtdbParseTimeWarp(tStr)
+}
+
+func (NativeBackend) RawRtdbSetTimeoutWarp(handle ConnectHandle, socket SocketHandle, timeout DateTimeType) RtdbError {
+	return RawRtdbSetTimeoutWarp(handle, socket, timeout)
+}
+
+func (NativeBackend) RawRtdbGetTimeoutWarp(handle ConnectHandle, socket SocketHandle) (DateTimeType, RtdbError) {
+	return RawRtdbGetTimeoutWarp(handle, socket)
+}
+
+func (NativeBackend) RawRtdbKillConnectionWarp(handle ConnectHandle, socket SocketHandle) RtdbError {
+	return RawRtdbKillConnectionWarp(handle, socket)
+}
+
+func (NativeBackend) RawRtdbGetLogicalDriversWarp(handle ConnectHandle) ([]string, RtdbError) {
+	return RawRtdbGetLogicalDriversWarp(handle)
+}
+
+func (NativeBackend) RawRtdbOpenPathWarp(handle ConnectHandle, dir string) RtdbError {
+	return RawRtdbOpenPathWarp(handle, dir)
+}
+
+func (NativeBackend) RawRtdbReadPath64Warp(handle ConnectHandle) (DirItem, RtdbError) {
+	return RawRtdbReadPath64Warp(handle)
+}
+
+func (NativeBackend) RawRtdbClosePathWarp(handle ConnectHandle) RtdbError {
+	return RawRtdbClosePathWarp(handle)
+}
+
+func (NativeBackend) RawRtdbMkdirWarp(handle ConnectHandle, dirName string) RtdbError {
+	return RawRtdbMkdirWarp(handle, dirName)
+}
+
+func (NativeBackend) RawRtdbGetFileSizeWarp(handle ConnectHandle, filePath string) (int64, RtdbError) {
+	return RawRtdbGetFileSizeWarp(handle, filePath)
+}
+
+func (NativeBackend) RawRtdbReadFileWarp(handle ConnectHandle, filePath string, pos int64, cacheSize int64) ([]byte, RtdbError) {
+	return RawRtdbReadFileWarp(handle, filePath, pos, cacheSize)
+}
+
+func (NativeBackend) RawRtdbGetMaxBlobLenWarp(handle ConnectHandle) (int32, RtdbError) {
+	return RawRtdbGetMaxBlobLenWarp(handle)
+}
+
+func (NativeBackend) RawRtdbFormatQualityWarp(handle ConnectHandle, qualities []Quality) ([]string, RtdbError) {
+	return RawRtdbFormatQualityWarp(handle, qualities)
+}
+
+func (NativeBackend) RawRtdbJudgeConnectStatusWarp(handle ConnectHandle) RtdbError {
+	return RawRtdbJudgeConnectStatusWarp(handle)
+}
+
+func (NativeBackend) RawRtdbbAppendTableWarp(handle ConnectHandle, tableName, tableDesc string) (RtdbTable, RtdbError) {
+	return RawRtdbbAppendTableWarp(handle, tableName, tableDesc)
+}
+
+func (NativeBackend) RawRtdbbRemoveTableByIdWarp(handle ConnectHandle, tableID TableID) RtdbError {
+	return RawRtdbbRemoveTableByIdWarp(handle, tableID)
+}
+
+func (NativeBackend) RawRtdbbTablesCountWarp(handle ConnectHandle) (int32, RtdbError) {
+	return RawRtdbbTablesCountWarp(handle)
+}
+
+func (NativeBackend) RawRtdbbGetTablesWarp(handle ConnectHandle, count int32) ([]TableID, RtdbError) {
+	return RawRtdbbGetTablesWarp(handle, count)
+}
+
+func (NativeBackend) RawRtdbbGetTablePropertyByIdWarp(handle ConnectHandle, tableID TableID) (RtdbTable, RtdbError) {
+	return RawRtdbbGetTablePropertyByIdWarp(handle, tableID)
+}
+
+func (NativeBackend) RawRtdbbInsertMaxPointWarp(handle ConnectHandle, base *RtdbPoint, scan *RtdbScan, calc *RtdbCalc) (*RtdbPoint, *RtdbScan, *RtdbCalc, RtdbError) {
+	return RawRtdbbInsertMaxPointWarp(handle, base, scan, calc)
+}
+
+func (NativeBackend) RawRtdbbRemovePointByIdWarp(handle ConnectHandle, id PointID) RtdbError {
+	return RawRtdbbRemovePointByIdWarp(handle, id)
+}
+
+func (NativeBackend) RawRtdbbInsertNamedTypePointWarp(handle ConnectHandle, base *RtdbPoint, scan *RtdbScan, name string) (*RtdbPoint, *RtdbScan, RtdbError) {
+	return RawRtdbbInsertNamedTypePointWarp(handle, base, scan, name)
+}
+
+func (NativeBackend) RawRtdbbMovePointByIdWarp(handle ConnectHandle, id PointID, tableName string) RtdbError {
+	return RawRtdbbMovePointByIdWarp(handle, id, tableName)
+}
+
+func (NativeBackend) RawRtdbbGetMaxPointsPropertyWarp(handle ConnectHandle, ids []PointID) ([]RtdbPoint, []RtdbScan, []RtdbCalc, []RtdbError, RtdbError) {
+	return RawRtdbbGetMaxPointsPropertyWarp(handle, ids)
+}
+
+func (NativeBackend) RawRtdbbSearchExWarp(handle ConnectHandle, maxCount int32, tagMask, tableMask, source, unit, desc, instrument, typeMask string, classOfMask RtdbType, timeUnitMask RtdbPrecision, otherTypeMask RtdbSearch, otherTypeMaskValue string, model RtdbSortFlag) ([]PointID, RtdbError) {
+	return RawRtdbbSearchExWarp(handle, maxCount, tagMask, tableMask, source, unit, desc, instrument, typeMask, classOfMask, timeUnitMask, otherTypeMask, otherTypeMaskValue, model)
+}
+
+func (NativeBackend) RawRtdbbSearchPointsCountWarp(handle ConnectHandle, tagMask, tableMask, source, unit, desc, instrument, typeMask string, classOfMask RtdbType, timeUnitMask RtdbPrecision, otherTypeMask RtdbSearch, otherTypeMaskValue string) (int32, RtdbError) {
+	return RawRtdbbSearchPointsCountWarp(handle, tagMask, tableMask, source, unit, desc, instrument, typeMask, classOfMask, timeUnitMask, otherTypeMask, otherTypeMaskValue)
+}
+
+func (NativeBackend) RawRtdbbUpdateMaxPointPropertyWarp(handle ConnectHandle, base *RtdbPoint, scan *RtdbScan, calc *RtdbCalc) RtdbError {
+	return RawRtdbbUpdateMaxPointPropertyWarp(handle, base, scan, calc)
+}
+
+func (NativeBackend) RawRtdbbFindPointsExWarp(handle ConnectHandle, tableDotTags []string) ([]PointID, []RtdbType, []RtdbClass, []RtdbPrecision, []RtdbError, RtdbError) {
+	return RawRtdbbFindPointsExWarp(handle, tableDotTags)
+}
+
+func (NativeBackend) RawRtdbbUpdateTableNameWarp(handle ConnectHandle, id TableID, name string) RtdbError {
+	return RawRtdbbUpdateTableNameWarp(handle, id, name)
+}
+
+func (NativeBackend) RawRtdbbUpdateTableDescByIdWarp(handle ConnectHandle, id TableID, desc string) RtdbError {
+	return RawRtdbbUpdateTableDescByIdWarp(handle, id, desc)
+}
+
+func (NativeBackend) RawRtdbbRecoverPointWarp(handle ConnectHandle, tableID TableID, pointID PointID) RtdbError {
+	return RawRtdbbRecoverPointWarp(handle, tableID, pointID)
+}
+
+func (NativeBackend) RawRtdbbPurgePointWarp(handle ConnectHandle, id PointID) RtdbError {
+	return RawRtdbbPurgePointWarp(handle, id)
+}
+
+func (NativeBackend) RawRtdbbGetRecycledPointsCountWarp(handle ConnectHandle) (int32, RtdbError) {
+	return RawRtdbbGetRecycledPointsCountWarp(handle)
+}
+
+func (NativeBackend) RawRtdbbGetRecycledPointsWarp(handle ConnectHandle, count int32) ([]PointID, RtdbError) {
+	return RawRtdbbGetRecycledPointsWarp(handle, count)
+}
+
+func (NativeBackend) RawRtdbbSearchRecycledPointsInBatchesWarp(handle ConnectHandle, start int32, count int32, tagMask, fullMask, source, unit, desc, instrument string, mode RtdbSortFlag) ([]PointID, RtdbError) {
+	return RawRtdbbSearchRecycledPointsInBatchesWarp(handle, start, count, tagMask, fullMask, source, unit, desc, instrument, mode)
+}
+
+func (NativeBackend) RawRtdbbGetRecycledMaxPointPropertyWarp(handle ConnectHandle, id PointID) (*RtdbPoint, *RtdbScan, *RtdbCalc, RtdbError) {
+	return RawRtdbbGetRecycledMaxPointPropertyWarp(handle, id)
+}
+
+func (NativeBackend) RawRtdbbClearRecyclerWarp(handle ConnectHandle) RtdbError {
+	return RawRtdbbClearRecyclerWarp(handle)
+}
+
+func (NativeBackend) RawRtdbbCreateNamedTypeWarp(handle ConnectHandle, name string, desc string, fields ...RtdbDataTypeField) RtdbError {
+	return RawRtdbbCreateNamedTypeWarp(handle, name, desc, fields...)
+}
+
+func (NativeBackend) RawRtdbbGetNamedTypesCountWarp(handle ConnectHandle) (int32, RtdbError) {
+	return RawRtdbbGetNamedTypesCountWarp(handle)
+}
+
+func (NativeBackend) RawRtdbbGetAllNamedTypesWarp(handle ConnectHandle, count int32) ([]string, []int32, RtdbError) {
+	return RawRtdbbGetAllNamedTypesWarp(handle, count)
+}
+
+func (NativeBackend) RawRtdbbGetNamedTypeWarp(handle ConnectHandle, name string, fieldCount int32) ([]RtdbDataTypeField, int32, string, RtdbError) {
+	return RawRtdbbGetNamedTypeWarp(handle, name, fieldCount)
+}
+
+func (NativeBackend) RawRtdbbRemoveNamedTypeWarp(handle ConnectHandle, name string) RtdbError {
+	return RawRtdbbRemoveNamedTypeWarp(handle, name)
+}
+
+func (NativeBackend) RawRtdbbGetNamedTypeNamesPropertyWarp(handle ConnectHandle, ids []PointID) ([]string, []int32, []RtdbError, RtdbError) {
+	return RawRtdbbGetNamedTypeNamesPropertyWarp(handle, ids)
+}
+
+func (NativeBackend) RawRtdbbGetRecycledNamedTypeNamesPropertyWarp(handle ConnectHandle, ids []PointID) ([]string, []int32, []RtdbError, RtdbError) {
+	return RawRtdbbGetRecycledNamedTypeNamesPropertyWarp(handle, ids)
+}
+
+func (NativeBackend) RawRtdbbGetNamedTypePointsCountWarp(handle ConnectHandle, name string) (int32, RtdbError) {
+	return RawRtdbbGetNamedTypePointsCountWarp(handle, name)
+}
+
+func (NativeBackend) RawRtdbbGetBaseTypePointsCountWarp(handle ConnectHandle, rtdbType RtdbType) (int32, RtdbError) {
+	return RawRtdbbGetBaseTypePointsCountWarp(handle, rtdbType)
+}
+
+func (NativeBackend) RawRtdbbModifyNamedTypeWarp(handle ConnectHandle, name string, modifyName *string, modifyDesc *string, fieldNames []string, fieldDescs []string) RtdbError {
+	return RawRtdbbModifyNamedTypeWarp(handle, name, modifyName, modifyDesc, fieldNames, fieldDescs)
+}
+
+func (NativeBackend) RawRtdbWriteNamedTypeFieldByName32Warp(handle ConnectHandle, typeName string, fieldName string, fieldType RtdbType, object []byte, field []byte) RtdbError {
+	return RawRtdbWriteNamedTypeFieldByName32Warp(handle, typeName, fieldName, fieldType, object, field)
+}
+
+func (NativeBackend) RawRtdbWriteNamedTypeFieldByPos32Warp(handle ConnectHandle, typeName string, fieldPos int32, fieldType RtdbType, object []byte, field []byte) RtdbError {
+	return RawRtdbWriteNamedTypeFieldByPos32Warp(handle, typeName, fieldPos, fieldType, object, field)
+}
+
+func (NativeBackend) RawRtdbReadNamedTypeFieldByName32Warp(handle ConnectHandle, typeName string, fieldName string, fieldType RtdbType, object []byte, fieldLen int32) ([]byte, RtdbError) {
+	return RawRtdbReadNamedTypeFieldByName32Warp(handle, typeName, fieldName, fieldType, object, fieldLen)
+}
+
+func (NativeBackend) RawRtdbReadNamedTypeFieldByPos32Warp(handle ConnectHandle, typeName string, fieldPos int32, fieldType RtdbType, object []byte, fieldLen int32) ([]byte, RtdbError) {
+	return RawRtdbReadNamedTypeFieldByPos32Warp(handle, typeName, fieldPos, fieldType, object, fieldLen)
+}
+
+func (NativeBackend) RawRtdbNamedTypeNameFieldCheckWarp(checkName string, flag byte) RtdbError {
+	return RawRtdbNamedTypeNameFieldCheckWarp(checkName, flag)
+}
+
+func (NativeBackend) RawRtdbbGetMetaSyncInfoWarp(handle ConnectHandle, nodeNumber int32) ([]RtdbSyncInfo, []RtdbError, RtdbError) {
+	return RawRtdbbGetMetaSyncInfoWarp(handle, nodeNumber)
+}
+
+func (NativeBackend) RawRtdbsPutSnapshots64Warp(handle ConnectHandle, ids []PointID, datetimes []TimestampType, subtimes []SubtimeType, values []float64, states []int64, qualities []Quality) ([]RtdbError, RtdbError) {
+	return RawRtdbsPutSnapshots64Warp(handle, ids, datetimes, subtimes, values, states, qualities)
+}
+
+func (NativeBackend) RawRtdbsFixSnapshots64Warp(handle ConnectHandle, ids []PointID, datetimes []TimestampType, subtimes []SubtimeType, values []float64, states []int64, qualities []Quality) ([]RtdbError, RtdbError) {
+	return RawRtdbsFixSnapshots64Warp(handle, ids, datetimes, subtimes, values, states, qualities)
+}
+
+func (NativeBackend) RawRtdbsPutCoorSnapshots64Warp(handle ConnectHandle, ids []PointID, datetimes []TimestampType, subtimes []SubtimeType, xs []float32, ys []float32, qualities []Quality) ([]RtdbError, RtdbError) {
+	return RawRtdbsPutCoorSnapshots64Warp(handle, ids, datetimes, subtimes, xs, ys, qualities)
+}
+
+func (NativeBackend) RawRtdbsFixCoorSnapshots64Warp(handle ConnectHandle, ids []PointID, datetimes []TimestampType, subtimes []SubtimeType, xs []float32, ys []float32, qualities []Quality) ([]RtdbError, RtdbError) {
+	return RawRtdbsFixCoorSnapshots64Warp(handle, ids, datetimes, subtimes, xs, ys, qualities)
+}
+
+func (NativeBackend) RawRtdbsPutBlobSnapshots64Warp(handle ConnectHandle, ids []PointID, datetimes []TimestampType, subtimes []SubtimeType, blobs [][]byte, qualities []Quality) ([]RtdbError, RtdbError) {
+	return RawRtdbsPutBlobSnapshots64Warp(handle, ids, datetimes, subtimes, blobs, qualities)
+}
+
+func (NativeBackend) RawRtdbsPutDatetimeSnapshots64Warp(handle ConnectHandle, ids []PointID, datetimes []TimestampType, subtimes []SubtimeType, dtValues []string, qualities []Quality) ([]RtdbError, RtdbError) {
+	return RawRtdbsPutDatetimeSnapshots64Warp(handle, ids, datetimes, subtimes, dtValues, qualities)
+}
+
+func (NativeBackend) RawRtdbsPutNamedTypeSnapshots64Warp(handle ConnectHandle, ids []PointID, datetimes []TimestampType, subtimes []SubtimeType, objects [][]byte, qualities []Quality) ([]RtdbError, RtdbError) {
+	return RawRtdbsPutNamedTypeSnapshots64Warp(handle, ids, datetimes, subtimes, objects, qualities)
+}
+
+func (NativeBackend) RawRtdbsGetSnapshots64Warp(handle ConnectHandle, ids []PointID) ([]TimestampType, []SubtimeType, []float64, []int64, []Quality, []RtdbError, RtdbError) {
+	return RawRtdbsGetSnapshots64Warp(handle, ids)
+}
+
+func (NativeBackend) RawRtdbsGetCoorSnapshots64Warp(handle ConnectHandle, ids []PointID) ([]TimestampType, []SubtimeType, []float32, []float32, []Quality, []RtdbError, RtdbError) {
+	return RawRtdbsGetCoorSnapshots64Warp(handle, ids)
+}
+
+func (NativeBackend) RawRtdbsGetBlobSnapshots64Warp(handle ConnectHandle, ids []PointID, maxLen int32) ([]TimestampType, []SubtimeType, [][]byte, []Quality, []RtdbError, RtdbError) {
+	return RawRtdbsGetBlobSnapshots64Warp(handle, ids, maxLen)
+}
+
+func (NativeBackend) RawRtdbsGetDatetimeSnapshots64Warp(handle ConnectHandle, ids []PointID, typ int16) ([]TimestampType, []SubtimeType, []string, []Quality, []RtdbError, RtdbError) {
+	return RawRtdbsGetDatetimeSnapshots64Warp(handle, ids, typ)
+}
+
+func (NativeBackend) RawRtdbsGetNamedTypeSnapshots64Warp(handle ConnectHandle, ids []PointID, lens []int32) ([]TimestampType, []SubtimeType, [][]byte, []Quality, []RtdbError, RtdbError) {
+	return RawRtdbsGetNamedTypeSnapshots64Warp(handle, ids, lens)
+}
+
+// RawRtdbsSubscribeSnapshotsEx64Warp 订阅快照, 回调函数以连接句柄为键登记, 由C回调 goSnapsEventEx 分发
+func (NativeBackend) RawRtdbsSubscribeSnapshotsEx64Warp(handle ConnectHandle, ids []PointID, options RtdbSubscribeOption, callback SnapshotEventFunc) ([]RtdbError, RtdbError) {
+	snapshotCallbacks.Store(handle, callback)
+	errs, rte := RawRtdbsSubscribeSnapshotsEx64Warp(handle, ids, options, nil)
+	if !RteIsOk(rte) {
+		snapshotCallbacks.Delete(handle)
+	}
+	return errs, rte
+}
+
+// RawRtdbsSubscribeDeltaSnapshots64Warp 按容差订阅快照, 回调函数的登记方式与 RawRtdbsSubscribeSnapshotsEx64Warp 相同
+func (NativeBackend) RawRtdbsSubscribeDeltaSnapshots64Warp(handle ConnectHandle, ids []PointID, deltaValues []float64, deltaStates []int64, options RtdbSubscribeOption, callback SnapshotEventFunc) ([]RtdbError, RtdbError) {
+	snapshotCallbacks.Store(handle, callback)
+	errs, rte := RawRtdbsSubscribeDeltaSnapshots64Warp(handle, ids, deltaValues, deltaStates, options, nil)
+	if !RteIsOk(rte) {
+		snapshotCallbacks.Delete(handle)
+	}
+	return errs, rte
+}
+
+func (NativeBackend) RawRtdbsChangeSubscribeSnapshotsWarp(handle ConnectHandle, ids []PointID, deltaValues []float64, deltaStates []int64, changedTypes []RtdbSubscribeChangeType) ([]RtdbError, RtdbError) {
+	return RawRtdbsChangeSubscribeSnapshotsWarp(handle, ids, deltaValues, deltaStates, changedTypes)
+}
+
+func (NativeBackend) RawRtdbsCancelSubscribeSnapshotsWarp(handle ConnectHandle) RtdbError {
+	rte := RawRtdbsCancelSubscribeSnapshotsWarp(handle)
+	if RteIsOk(rte) {
+		snapshotCallbacks.Delete(handle)
+	}
+	return rte
+}
+
+func (NativeBackend) RawRtdbaGetArchivesCountWarp(handle ConnectHandle) (int32, RtdbError) {
+	return RawRtdbaGetArchivesCountWarp(handle)
+}
+
+func (NativeBackend) RawRtdbaGetArchivesWarp(handle ConnectHandle, maxCount int32) ([]string, []string, []RtdbArchiveState, RtdbError) {
+	return RawRtdbaGetArchivesWarp(handle, maxCount)
+}
+
+func (NativeBackend) RawRtdbhGetSingleValue64Warp(handle ConnectHandle, id PointID, mode RtdbHisMode, datetime TimestampType, subtime SubtimeType) (TimestampType, SubtimeType, float64, int64, Quality, RtdbError) {
+	return RawRtdbhGetSingleValue64Warp(handle, id, mode, datetime, subtime)
+}
+
+func (NativeBackend) RawRtdbhGetSingleCoorValue64Warp(handle ConnectHandle, id PointID, mode RtdbHisMode, datetime TimestampType, subtime SubtimeType) (TimestampType, SubtimeType, float32, float32, Quality, RtdbError) {
+	return RawRtdbhGetSingleCoorValue64Warp(handle, id, mode, datetime, subtime)
+}
+
+func (NativeBackend) RawRtdbhGetSingleBlobValue64Warp(handle ConnectHandle, id PointID, mode RtdbHisMode, datetime TimestampType, subtime SubtimeType, maxLen int32) (TimestampType, SubtimeType, []byte, Quality, RtdbError) {
+	return RawRtdbhGetSingleBlobValue64Warp(handle, id, mode, datetime, subtime, maxLen)
+}
+
+func (NativeBackend) RawRtdbhGetSingleDatetimeValue64Warp(handle ConnectHandle, id PointID, mode RtdbHisMode, datetime TimestampType, subtime SubtimeType, dtType int16) (TimestampType, SubtimeType, []byte, Quality, RtdbError) {
+	return RawRtdbhGetSingleDatetimeValue64Warp(handle, id, mode, datetime, subtime, dtType)
+}
+
+func (NativeBackend) RawRtdbhGetSingleNamedTypeValue64Warp(handle ConnectHandle, id PointID, mode RtdbHisMode, datetime TimestampType, subtime SubtimeType, length int32) (TimestampType, SubtimeType, []byte, Quality, RtdbError) {
+	return RawRtdbhGetSingleNamedTypeValue64Warp(handle, id, mode, datetime, subtime, length)
+}
+
+func (NativeBackend) RawRtdbhGetArchivedValues64Warp(handle ConnectHandle, id PointID, count int32, datetime1 TimestampType, subtime1 SubtimeType, datetime2 TimestampType, subtime2 SubtimeType) ([]TimestampType, []SubtimeType, []float64, []int64, []Quality, RtdbError) {
+	return RawRtdbhGetArchivedValues64Warp(handle, id, count, datetime1, subtime1, datetime2, subtime2)
+}
+
+func (NativeBackend) RawRtdbhGetArchivedCoorValues64Warp(handle ConnectHandle, id PointID, count int32, datetime1 TimestampType, subtime1 SubtimeType, datetime2 TimestampType, subtime2 SubtimeType) ([]TimestampType, []SubtimeType, []float32, []float32, []Quality, RtdbError) {
+	return RawRtdbhGetArchivedCoorValues64Warp(handle, id, count, datetime1, subtime1, datetime2, subtime2)
+}
+
+func (NativeBackend) RawRtdbhGetArchivedBlobValues64Warp(handle ConnectHandle, id PointID, maxLen int32, maxCount int32, datetime1 TimestampType, subtime1 SubtimeType, datetime2 TimestampType, subtime2 SubtimeType) ([]TimestampType, []SubtimeType, [][]byte, []Quality, RtdbError) {
+	return RawRtdbhGetArchivedBlobValues64Warp(handle, id, maxLen, maxCount, datetime1, subtime1, datetime2, subtime2)
+}
+
+func (NativeBackend) RawRtdbhGetArchivedDatetimeValues64Warp(handle ConnectHandle, id PointID, maxCount int32, datetime1 TimestampType, subtime1 SubtimeType, datetime2 TimestampType, subtime2 SubtimeType, dtType int16) ([]TimestampType, []SubtimeType, [][]byte, []Quality, RtdbError) {
+	return RawRtdbhGetArchivedDatetimeValues64Warp(handle, id, maxCount, datetime1, subtime1, datetime2, subtime2, dtType)
+}
+
+func (NativeBackend) RawRtdbhGetArchivedNamedTypeValues64Warp(handle ConnectHandle, id PointID, datetime1 TimestampType, subtime1 SubtimeType, datetime2 TimestampType, subtime2 SubtimeType, length int32, maxCount int32) ([]TimestampType, []SubtimeType, [][]byte, []Quality, RtdbError) {
+	return RawRtdbhGetArchivedNamedTypeValues64Warp(handle, id, datetime1, subtime1, datetime2, subtime2, length, maxCount)
+}
+
+func (NativeBackend) RawRtdbhGetInterpoValues64Warp(handle ConnectHandle, id PointID, count int32, datetime1 TimestampType, subtime1 SubtimeType, datetime2 TimestampType, subtime2 SubtimeType) ([]TimestampType, []SubtimeType, []float64, []int64, []Quality, RtdbError) {
+	return RawRtdbhGetInterpoValues64Warp(handle, id, count, datetime1, subtime1, datetime2, subtime2)
+}
+
+func (NativeBackend) RawRtdbhSummaryDataWarp(handle ConnectHandle, id PointID, datetime1 TimestampType, subtime1 SubtimeType, datetime2 TimestampType, subtime2 SubtimeType) (*RtdbSummaryData, RtdbError) {
+	return RawRtdbhSummaryDataWarp(handle, id, datetime1, subtime1, datetime2, subtime2)
+}
+
+func (NativeBackend) RawRtdbhPutArchivedDatetimeValues64Warp(handle ConnectHandle, ids []PointID, datetimes []TimestampType, subtimes []SubtimeType, dtValues []string, qualities []Quality) ([]RtdbError, RtdbError) {
+	return RawRtdbhPutArchivedDatetimeValues64Warp(handle, ids, datetimes, subtimes, dtValues, qualities)
+}
+
+func (NativeBackend) RawRtdbhPutArchivedValues64Warp(handle ConnectHandle, ids []PointID, datetimes []TimestampType, subtimes []SubtimeType, values []float64, states []int64, qualities []Quality) ([]RtdbError, RtdbError) {
+	return RawRtdbhPutArchivedValues64Warp(handle, ids, datetimes, subtimes, values, states, qualities)
+}
+
+func (NativeBackend) RawRtdbhPutArchivedCoorValues64Warp(handle ConnectHandle, ids []PointID, datetimes []TimestampType, subtimes []SubtimeType, xs []float32, ys []float32, qualities []Quality) ([]RtdbError, RtdbError) {
+	return RawRtdbhPutArchivedCoorValues64Warp(handle, ids, datetimes, subtimes, xs, ys, qualities)
+}
+
+func (NativeBackend) RawRtdbhPutArchivedBlobValues64Warp(handle ConnectHandle, ids []PointID, datetimes []TimestampType, subtimes []SubtimeType, blobs [][]byte, qualities []Quality) ([]RtdbError, RtdbError) {
+	return RawRtdbhPutArchivedBlobValues64Warp(handle, ids, datetimes, subtimes, blobs, qualities)
+}
+
+func (NativeBackend) RawRtdbhPutArchivedNamedTypeValues64Warp(handle ConnectHandle, ids []PointID, datetimes []TimestampType, subtimes []SubtimeType, objects [][]byte, qualities []Quality) ([]RtdbError, RtdbError) {
+	return RawRtdbhPutArchivedNamedTypeValues64Warp(handle, ids, datetimes, subtimes, objects, qualities)
+}
@@ -0,0 +1,18 @@
+//go:build !cgo
+
+package rtdb_api
+
+// defaultBackend 获取默认后端, 未开启CGO时没有默认后端, 需要通过 LoginWithBackend 指定
+func defaultBackend() (Backend, error) {
+	return nil, errNoDefaultBackend
+}
+
+// LoadLibrary 未开启CGO时无法加载动态库
+func LoadLibrary(path string) error {
+	return errNoDefaultBackend
+}
+
+// LoadLibraryWithChecksum 未开启CGO时无法加载动态库
+func LoadLibraryWithChecksum(path string, sha256Hex string) error {
+	return errNoDefaultBackend
+}
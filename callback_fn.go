@@ -1,3 +1,5 @@
+//go:build cgo
+
 package rtdb_api
 
 // #cgo CFLAGS: -DPNG_DEBUG=1 -I./cinclude
@@ -5,7 +7,21 @@ package rtdb_api
 // #include <stdlib.h>
 // #include "gofn.h"
 import "C"
-import "unsafe"
+import (
+	"sync"
+	"unsafe"
+)
+
+// snapshotCallbacks 快照订阅的回调函数, 键为连接句柄, 值为 SnapshotEventFunc
+var snapshotCallbacks sync.Map
+
+// cSlice 复制C数组, 指针为空时返回nil
+func cSlice[T any, C any](ptr *C, count int) []T {
+	if ptr == nil || count <= 0 {
+		return nil
+	}
+	return append([]T(nil), unsafe.Slice((*T)(unsafe.Pointer(ptr)), count)...)
+}
 
 //export goSubscribeTagsEx
 func goSubscribeTagsEx(
@@ -33,5 +49,24 @@ func goSnapsEventEx(
 	qualities *C.rtdb_int16,
 	errors *C.rtdb_error,
 ) C.rtdb_error {
-	return C.rtdb_error(0)
+	value, ok := snapshotCallbacks.Load(ConnectHandle(handle))
+	if !ok {
+		return C.rtdb_error(RteOk)
+	}
+	n := int(count)
+	event := &SnapshotEvent{
+		Type:      RtdbEventType(eventType),
+		IDs:       cSlice[PointID](ids, n),
+		Datetimes: cSlice[TimestampType](datetimes, n),
+		Subtimes:  cSlice[SubtimeType](subtimes, n),
+		Values:    cSlice[float64](values, n),
+		States:    cSlice[int64](status, n),
+		Qualities: cSlice[Quality](qualities, n),
+		Errors:    cSlice[RtdbError](errors, n),
+	}
+	rte := value.(SnapshotEventFunc)(ConnectHandle(handle), event)
+	if !RteIsOk(rte) {
+		snapshotCallbacks.Delete(ConnectHandle(handle))
+	}
+	return C.rtdb_error(rte)
 }
@@ -26,20 +26,49 @@ typedef void* LIBRARY_HANDLE;
 
 LIBRARY_HANDLE LIB;
 
-// 加载动态库宽字符版本
-void load_library_windows(wchar_t *path) {
+// 加载动态库宽字符版本, 成功返回1, 失败返回0且保留原来已加载的动态库
+int load_library_windows(wchar_t *path) {
 #ifdef _WIN32
-    LIB = LOAD_LIBRARY(path);
+    LIBRARY_HANDLE lib = LOAD_LIBRARY(path);
+    if (lib == NULL) {
+        return 0;
+    }
+    LIB = lib;
+    return 1;
+#else
+    return 0;
 #endif
 }
 
-// 加载动态库Ascii版本
-void load_library_linux(char *path) {
+// 加载动态库Ascii版本, 成功返回1, 失败返回0且保留原来已加载的动态库
+int load_library_linux(char *path) {
 #ifndef _WIN32
-    LIB = LOAD_LIBRARY(path);
+    LIBRARY_HANDLE lib = LOAD_LIBRARY(path);
+    if (lib == NULL) {
+        return 0;
+    }
+    LIB = lib;
+    return 1;
+#else
+    return 0;
 #endif
 }
 
+// 获取最近一次加载动态库失败的原因
+const char* load_library_error() {
+#ifdef _WIN32
+    return "LoadLibraryW failed";
+#else
+    const char *err = dlerror();
+    return err == NULL ? "" : err;
+#endif
+}
+
+// 动态库是否已加载
+int library_loaded() {
+    return LIB != NULL;
+}
+
 
 // 释放动态库
 void free_library() {
@@ -1016,6 +1045,187 @@ rtdb_error RTDBAPI_CALLRULE rtdb_format_quality_warp(rtdb_int32 handle, rtdb_int
     return fn(handle, count, qualities, definitions, lens);
 }
 
+/*
+* 命名：rtdb_write_named_type_field_by_name32
+* 功能：按名称填充自定义类型数值中字段的内容
+* 参数：
+*      [handle]       连接句柄
+*      [type_name]    自定义类型的名称，名称长度不能超过RTDB_TYPE_NAME_SIZE的长度，输入参数，
+*      [field_name]   自定义类型中需要填充的字段的名称，名称长度不能超过RTDB_TYPE_NAME_SIZE的长度，输入参数
+*      [field_type]   field_name字段的类型，RTDB_TYPE所支持的基础类型，输入参数
+*      [object]       自定义类型数值的缓冲区,输入/输出参数
+*      [object_len]   object缓冲区的长度,输入参数
+*      [field]        需要填充的字段数值的缓冲区,输入参数
+*      [field_len]    自定义类型中字段数值的缓冲区中数据的长度,输入参数
+*/
+rtdb_error RTDBAPI_CALLRULE rtdb_write_named_type_field_by_name32_warp(rtdb_int32 handle, const char* type_name, const char* field_name, rtdb_int32 field_type, void* object, rtdb_length_type object_len, const void* field, rtdb_length_type field_len)
+{
+    typedef rtdb_error (RTDBAPI_CALLRULE *rtdb_write_named_type_field_by_name32_fn)(rtdb_int32 handle, const char* type_name, const char* field_name, rtdb_int32 field_type, void* object, rtdb_length_type object_len, const void* field, rtdb_length_type field_len);
+    rtdb_write_named_type_field_by_name32_fn fn = (rtdb_write_named_type_field_by_name32_fn)get_function("rtdb_write_named_type_field_by_name32");
+    return fn(handle, type_name, field_name, field_type, object, object_len, field, field_len);
+}
+
+/*
+* 命名：rtdb_write_named_type_field_by_pos32
+* 功能：按位置填充自定义类型数值中字段的内容
+* 参数：
+*      [handle]       连接句柄
+*      [type_name]    自定义类型的名称，名称长度不能超过RTDB_TYPE_NAME_SIZE的长度，输入参数，
+*      [field_pos]    自定义类型中需要填充的字段的位置，指字段在所有字段中的位置，从0开始，输入参数
+*      [field_type]   field_pos位置所在字段的类型，RTDB_TYPE所支持的基础类型，输入参数
+*      [object]       自定义类型数值的缓冲区,输入/输出参数
+*      [object_len]   object缓冲区的长度,输入参数
+*      [field]        需要填充的字段数值的缓冲区,输入参数
+*      [field_len]    自定义类型中字段数值的缓冲区中数据的长度,输入参数
+*/
+rtdb_error RTDBAPI_CALLRULE rtdb_write_named_type_field_by_pos32_warp(rtdb_int32 handle, const char* type_name, rtdb_int32 field_pos, rtdb_int32 field_type, void* object, rtdb_length_type object_len, const void* field, rtdb_length_type field_len)
+{
+    typedef rtdb_error (RTDBAPI_CALLRULE *rtdb_write_named_type_field_by_pos32_fn)(rtdb_int32 handle, const char* type_name, rtdb_int32 field_pos, rtdb_int32 field_type, void* object, rtdb_length_type object_len, const void* field, rtdb_length_type field_len);
+    rtdb_write_named_type_field_by_pos32_fn fn = (rtdb_write_named_type_field_by_pos32_fn)get_function("rtdb_write_named_type_field_by_pos32");
+    return fn(handle, type_name, field_pos, field_type, object, object_len, field, field_len);
+}
+
+/*
+* 命名：rtdb_read_named_type_field_by_name32
+* 功能：按名称提取自定义类型数值中字段的内容
+* 参数：
+*      [handle]       连接句柄
+*      [type_name]    自定义类型的名称，名称长度不能超过RTDB_TYPE_NAME_SIZE的长度，输入参数，
+*      [field_name]   自定义类型中需要提取的字段的名称，名称长度不能超过RTDB_TYPE_NAME_SIZE的长度，输入参数
+*      [field_type]   field_name字段的类型，RTDB_TYPE所支持的基础类型，输入参数
+*      [object]       自定义类型数值的缓冲区,输入参数
+*      [object_len]   object缓冲区的长度,输入参数
+*      [field]        被读取的字段的数值的缓冲区,输入/输出参数
+*      [field_len]    field字段数值缓冲区的长度,输入参数
+*/
+rtdb_error RTDBAPI_CALLRULE rtdb_read_named_type_field_by_name32_warp(rtdb_int32 handle, const char* type_name, const char* field_name, rtdb_int32 field_type, const void* object, rtdb_length_type object_len, void* field, rtdb_length_type field_len)
+{
+    typedef rtdb_error (RTDBAPI_CALLRULE *rtdb_read_named_type_field_by_name32_fn)(rtdb_int32 handle, const char* type_name, const char* field_name, rtdb_int32 field_type, const void* object, rtdb_length_type object_len, void* field, rtdb_length_type field_len);
+    rtdb_read_named_type_field_by_name32_fn fn = (rtdb_read_named_type_field_by_name32_fn)get_function("rtdb_read_named_type_field_by_name32");
+    return fn(handle, type_name, field_name, field_type, object, object_len, field, field_len);
+}
+
+/*
+* 命名：rtdb_read_named_type_field_by_pos32
+* 功能：按位置提取自定义类型数值中字段的内容
+* 参数：
+*      [handle]       连接句柄
+*      [type_name]    自定义类型的名称，名称长度不能超过RTDB_TYPE_NAME_SIZE的长度，输入参数，
+*      [field_pos]    自定义类型中需要提取的字段的位置，指字段在所有字段中的位置，从0开始，输入参数
+*      [field_type]   field_pos位置所在字段的类型，RTDB_TYPE所支持的基础类型，输入参数
+*      [object]       自定义类型数值的缓冲区,输入参数
+*      [object_len]   object缓冲区的长度,输入参数
+*      [field]        被读取的字段的数值的缓冲区,输入/输出参数
+*      [field_len]    field字段数值缓冲区的长度,输入参数
+*/
+rtdb_error RTDBAPI_CALLRULE rtdb_read_named_type_field_by_pos32_warp(rtdb_int32 handle, const char* type_name, rtdb_int32 field_pos, rtdb_int32 field_type, const void* object, rtdb_length_type object_len, void* field, rtdb_length_type field_len)
+{
+    typedef rtdb_error (RTDBAPI_CALLRULE *rtdb_read_named_type_field_by_pos32_fn)(rtdb_int32 handle, const char* type_name, rtdb_int32 field_pos, rtdb_int32 field_type, const void* object, rtdb_length_type object_len, void* field, rtdb_length_type field_len);
+    rtdb_read_named_type_field_by_pos32_fn fn = (rtdb_read_named_type_field_by_pos32_fn)get_function("rtdb_read_named_type_field_by_pos32");
+    return fn(handle, type_name, field_pos, field_type, object, object_len, field, field_len);
+}
+
+/*
+* 命名：rtdb_named_type_name_field_check
+* 功能：检查自定义类型名称及字段命名是否符合规则；
+* 规则：1. 只允许使用26个英文字母,数字0-9，下划线；
+*       2. 必须以字母作为首字母；
+*       3. 大小写不敏感。
+* 参数：
+*      [check_name]   需要检查的名称
+*      [flag]         标志0--类型名称，其他 -- 字段名称，暂不启用
+*/
+rtdb_error RTDBAPI_CALLRULE rtdb_named_type_name_field_check_warp(const char* check_name, rtdb_byte flag)
+{
+    typedef rtdb_error (RTDBAPI_CALLRULE *rtdb_named_type_name_field_check_fn)(const char* check_name, rtdb_byte flag);
+    rtdb_named_type_name_field_check_fn fn = (rtdb_named_type_name_field_check_fn)get_function("rtdb_named_type_name_field_check");
+    return fn(check_name, flag);
+}
+
+/*
+* 命名：rtdb_write_named_type_field_by_name
+* 功能：按名称填充自定义类型数值中字段的内容
+* 备注：已废弃，长度为16位整数，请使用 rtdb_write_named_type_field_by_name32
+* 参数：
+*      [handle]       连接句柄
+*      [type_name]    自定义类型的名称，名称长度不能超过RTDB_TYPE_NAME_SIZE的长度，输入参数，
+*      [field_name]   自定义类型中需要填充的字段的名称，名称长度不能超过RTDB_TYPE_NAME_SIZE的长度，输入参数
+*      [field_type]   field_name字段的类型，RTDB_TYPE所支持的基础类型，输入参数
+*      [object]       自定义类型数值的缓冲区,输入/输出参数
+*      [object_len]   object缓冲区的长度,输入参数
+*      [field]        需要填充的字段数值的缓冲区,输入参数
+*      [field_len]    自定义类型中字段数值的缓冲区中数据的长度,输入参数
+*/
+rtdb_error RTDBAPI_CALLRULE rtdb_write_named_type_field_by_name_warp(rtdb_int32 handle, const char* type_name, const char* field_name, rtdb_int32 field_type, void* object, rtdb_int16 object_len, const void* field, rtdb_int16 field_len)
+{
+    typedef rtdb_error (RTDBAPI_CALLRULE *rtdb_write_named_type_field_by_name_fn)(rtdb_int32 handle, const char* type_name, const char* field_name, rtdb_int32 field_type, void* object, rtdb_int16 object_len, const void* field, rtdb_int16 field_len);
+    rtdb_write_named_type_field_by_name_fn fn = (rtdb_write_named_type_field_by_name_fn)get_function("rtdb_write_named_type_field_by_name");
+    return fn(handle, type_name, field_name, field_type, object, object_len, field, field_len);
+}
+
+/*
+* 命名：rtdb_write_named_type_field_by_pos
+* 功能：按位置填充自定义类型数值中字段的内容
+* 备注：已废弃，长度为16位整数，请使用 rtdb_write_named_type_field_by_pos32
+* 参数：
+*      [handle]       连接句柄
+*      [type_name]    自定义类型的名称，名称长度不能超过RTDB_TYPE_NAME_SIZE的长度，输入参数，
+*      [field_pos]    自定义类型中需要填充的字段的位置，指字段在所有字段中的位置，从0开始，输入参数
+*      [field_type]   field_pos位置所在字段的类型，RTDB_TYPE所支持的基础类型，输入参数
+*      [object]       自定义类型数值的缓冲区,输入/输出参数
+*      [object_len]   object缓冲区的长度,输入参数
+*      [field]        需要填充的字段数值的缓冲区,输入参数
+*      [field_len]    自定义类型中字段数值的缓冲区中数据的长度,输入参数
+*/
+rtdb_error RTDBAPI_CALLRULE rtdb_write_named_type_field_by_pos_warp(rtdb_int32 handle, const char* type_name, rtdb_int32 field_pos, rtdb_int32 field_type, void* object, rtdb_int16 object_len, const void* field, rtdb_int16 field_len)
+{
+    typedef rtdb_error (RTDBAPI_CALLRULE *rtdb_write_named_type_field_by_pos_fn)(rtdb_int32 handle, const char* type_name, rtdb_int32 field_pos, rtdb_int32 field_type, void* object, rtdb_int16 object_len, const void* field, rtdb_int16 field_len);
+    rtdb_write_named_type_field_by_pos_fn fn = (rtdb_write_named_type_field_by_pos_fn)get_function("rtdb_write_named_type_field_by_pos");
+    return fn(handle, type_name, field_pos, field_type, object, object_len, field, field_len);
+}
+
+/*
+* 命名：rtdb_read_named_type_field_by_name
+* 功能：按名称提取自定义类型数值中字段的内容
+* 备注：已废弃，长度为16位整数，请使用 rtdb_read_named_type_field_by_name32
+* 参数：
+*      [handle]       连接句柄
+*      [type_name]    自定义类型的名称，名称长度不能超过RTDB_TYPE_NAME_SIZE的长度，输入参数，
+*      [field_name]   自定义类型中需要提取的字段的名称，名称长度不能超过RTDB_TYPE_NAME_SIZE的长度，输入参数
+*      [field_type]   field_name字段的类型，RTDB_TYPE所支持的基础类型，输入参数
+*      [object]       自定义类型数值的缓冲区,输入参数
+*      [object_len]   object缓冲区的长度,输入参数
+*      [field]        被读取的字段的数值的缓冲区,输入/输出参数
+*      [field_len]    field字段数值缓冲区的长度,输入参数
+*/
+rtdb_error RTDBAPI_CALLRULE rtdb_read_named_type_field_by_name_warp(rtdb_int32 handle, const char* type_name, const char* field_name, rtdb_int32 field_type, const void* object, rtdb_int16 object_len, void* field, rtdb_int16 field_len)
+{
+    typedef rtdb_error (RTDBAPI_CALLRULE *rtdb_read_named_type_field_by_name_fn)(rtdb_int32 handle, const char* type_name, const char* field_name, rtdb_int32 field_type, const void* object, rtdb_int16 object_len, void* field, rtdb_int16 field_len);
+    rtdb_read_named_type_field_by_name_fn fn = (rtdb_read_named_type_field_by_name_fn)get_function("rtdb_read_named_type_field_by_name");
+    return fn(handle, type_name, field_name, field_type, object, object_len, field, field_len);
+}
+
+/*
+* 命名：rtdb_read_named_type_field_by_pos
+* 功能：按位置提取自定义类型数值中字段的内容
+* 备注：已废弃，长度为16位整数，请使用 rtdb_read_named_type_field_by_pos32
+* 参数：
+*      [handle]       连接句柄
+*      [type_name]    自定义类型的名称，名称长度不能超过RTDB_TYPE_NAME_SIZE的长度，输入参数，
+*      [field_pos]    自定义类型中需要提取的字段的位置，指字段在所有字段中的位置，从0开始，输入参数
+*      [field_type]   field_pos位置所在字段的类型，RTDB_TYPE所支持的基础类型，输入参数
+*      [object]       自定义类型数值的缓冲区,输入参数
+*      [object_len]   object缓冲区的长度,输入参数
+*      [field]        被读取的字段的数值的缓冲区,输入/输出参数
+*      [field_len]    field字段数值缓冲区的长度,输入参数
+*/
+rtdb_error RTDBAPI_CALLRULE rtdb_read_named_type_field_by_pos_warp(rtdb_int32 handle, const char* type_name, rtdb_int32 field_pos, rtdb_int32 field_type, const void* object, rtdb_int16 object_len, void* field, rtdb_int16 field_len)
+{
+    typedef rtdb_error (RTDBAPI_CALLRULE *rtdb_read_named_type_field_by_pos_fn)(rtdb_int32 handle, const char* type_name, rtdb_int32 field_pos, rtdb_int32 field_type, const void* object, rtdb_int16 object_len, void* field, rtdb_int16 field_len);
+    rtdb_read_named_type_field_by_pos_fn fn = (rtdb_read_named_type_field_by_pos_fn)get_function("rtdb_read_named_type_field_by_pos");
+    return fn(handle, type_name, field_pos, field_type, object, object_len, field, field_len);
+}
+
 /**
 *
 * \brief 判断连接是否可用
@@ -0,0 +1,65 @@
+// rtdb-gateway 将RTDB的表、标签点、快照、历史数据、写入与自定义类型以REST/JSON接口提供给不能使用CGO的客户端
+//
+// 用法:
+//
+//	rtdb-gateway -listen :8080 -host 127.0.0.1 -port 6327
+//
+// 每个请求通过HTTP Basic认证提供RTDB用户名与密码, 网关按用户名与密码维护连接池;
+// 列表接口以JSON数组或NDJSON(?format=ndjson 或 Accept: application/x-ndjson)流式输出;
+// 接口文档位于 /openapi.json
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	rtdb "github.com/kkbase/rtdb_api"
+)
+
+func main() {
+	listen := flag.String("listen", ":8080", "HTTP监听地址")
+	host := flag.String("host", "127.0.0.1", "RTDB服务器地址")
+	port := flag.Int("port", 6327, "RTDB服务器端口")
+	poolSize := flag.Int("pool-size", 4, "每个用户的最大连接数")
+	idleTimeout := flag.Duration("idle-timeout", 5*time.Minute, "空闲连接的关闭时间")
+	batchSize := flag.Int("batch-size", 1000, "写入数值时每批的个数")
+	tlsCert := flag.String("tls-cert", "", "TLS证书文件, 为空时使用HTTP")
+	tlsKey := flag.String("tls-key", "", "TLS私钥文件")
+	flag.Parse()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	p := newPool(func(user, password string) (*rtdb.RtdbConnect, error) {
+		return rtdb.Login(*host, int32(*port), user, password)
+	}, *poolSize, *idleTimeout)
+	go p.run(ctx)
+
+	srv := &http.Server{Addr: *listen, Handler: newServer(p, *batchSize), ReadHeaderTimeout: 10 * time.Second}
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		_ = srv.Shutdown(shutdownCtx)
+	}()
+
+	slog.Info("rtdb-gateway启动", "listen", *listen, "rtdb", *host, "port", *port)
+	var err error
+	if *tlsCert != "" {
+		err = srv.ListenAndServeTLS(*tlsCert, *tlsKey)
+	} else {
+		err = srv.ListenAndServe()
+	}
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
+		slog.Error("rtdb-gateway退出", "err", err)
+		os.Exit(1)
+	}
+	p.close()
+}
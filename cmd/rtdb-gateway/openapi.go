@@ -0,0 +1,8 @@
+package main
+
+import _ "embed"
+
+// openapiJSON 接口文档(OpenAPI 3.0), 通过 GET /openapi.json 提供
+//
+//go:embed openapi.json
+var openapiJSON []byte
@@ -0,0 +1,163 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"sync"
+	"time"
+
+	rtdb "github.com/kkbase/rtdb_api"
+)
+
+// loginFunc 使用RTDB用户名与密码登录
+type loginFunc func(user, password string) (*rtdb.RtdbConnect, error)
+
+// errLogin 登录失败, 映射为401
+type errLogin struct {
+	err error
+}
+
+func (e *errLogin) Error() string {
+	return "登录RTDB失败: " + e.err.Error()
+}
+
+func (e *errLogin) Unwrap() error {
+	return e.err
+}
+
+// poolKey 连接池的键, 密码只保存摘要, 不同密码的请求不会共用连接
+type poolKey struct {
+	user     string
+	password [sha256.Size]byte
+}
+
+// pooledConn 空闲连接
+type pooledConn struct {
+	conn *rtdb.RtdbConnect
+	used time.Time
+}
+
+// userPool 单个用户的连接池
+type userPool struct {
+	sem  chan struct{}
+	idle []pooledConn
+}
+
+// pool 按用户名与密码划分的连接池
+//   - 每个用户最多 size 个连接, 超出时等待其他请求释放连接
+//   - 空闲超过 idleTimeout 的连接会被关闭
+//   - 请求返回网络与连接错误时关闭该连接, 下一个请求重新登录
+type pool struct {
+	login       loginFunc
+	size        int
+	idleTimeout time.Duration
+
+	mu    sync.Mutex
+	users map[poolKey]*userPool
+}
+
+// newPool 创建连接池
+func newPool(login loginFunc, size int, idleTimeout time.Duration) *pool {
+	if size <= 0 {
+		size = 4
+	}
+	if idleTimeout <= 0 {
+		idleTimeout = 5 * time.Minute
+	}
+	return &pool{login: login, size: size, idleTimeout: idleTimeout, users: make(map[poolKey]*userPool)}
+}
+
+// acquire 获取连接, 使用完毕后必须调用release, 并传入请求的错误以判断连接是否可以复用
+func (p *pool) acquire(ctx context.Context, user, password string) (*rtdb.RtdbConnect, func(err error), error) {
+	key := poolKey{user: user, password: sha256.Sum256([]byte(password))}
+	p.mu.Lock()
+	up, ok := p.users[key]
+	if !ok {
+		up = &userPool{sem: make(chan struct{}, p.size)}
+		p.users[key] = up
+	}
+	p.mu.Unlock()
+
+	select {
+	case up.sem <- struct{}{}:
+	case <-ctx.Done():
+		return nil, nil, ctx.Err()
+	}
+	var conn *rtdb.RtdbConnect
+	p.mu.Lock()
+	if n := len(up.idle); n > 0 {
+		conn = up.idle[n-1].conn
+		up.idle = up.idle[:n-1]
+	}
+	p.mu.Unlock()
+	if conn == nil {
+		var err error
+		if conn, err = p.login(user, password); err != nil {
+			<-up.sem
+			return nil, nil, &errLogin{err: err}
+		}
+	}
+
+	release := func(err error) {
+		if err != nil && rtdb.ErrorCategoryOf(err) == rtdb.ErrorCategoryNetwork {
+			_ = conn.Logout()
+		} else {
+			p.mu.Lock()
+			up.idle = append(up.idle, pooledConn{conn: conn, used: time.Now()})
+			p.mu.Unlock()
+		}
+		<-up.sem
+	}
+	return conn, release, nil
+}
+
+// evict 关闭空闲超时的连接, 并删除没有连接的用户
+func (p *pool) evict(now time.Time) {
+	closing := make([]*rtdb.RtdbConnect, 0)
+	p.mu.Lock()
+	for key, up := range p.users {
+		idle := up.idle[:0]
+		for _, pc := range up.idle {
+			if now.Sub(pc.used) >= p.idleTimeout {
+				closing = append(closing, pc.conn)
+			} else {
+				idle = append(idle, pc)
+			}
+		}
+		up.idle = idle
+		if len(up.idle) == 0 && len(up.sem) == 0 {
+			delete(p.users, key)
+		}
+	}
+	p.mu.Unlock()
+	for _, conn := range closing {
+		_ = conn.Logout()
+	}
+}
+
+// run 定期关闭空闲超时的连接, ctx结束时关闭所有空闲连接
+func (p *pool) run(ctx context.Context) {
+	ticker := time.NewTicker(min(p.idleTimeout, time.Minute))
+	defer ticker.Stop()
+	for {
+		select {
+		case now := <-ticker.C:
+			p.evict(now)
+		case <-ctx.Done():
+			p.close()
+			return
+		}
+	}
+}
+
+// close 关闭所有空闲连接
+func (p *pool) close() {
+	p.evict(time.Now().Add(p.idleTimeout))
+}
+
+// isLoginError 是否为登录失败
+func isLoginError(err error) bool {
+	var e *errLogin
+	return errors.As(err, &e)
+}
@@ -0,0 +1,795 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	rtdb "github.com/kkbase/rtdb_api"
+)
+
+// apiPrefix 接口路径前缀
+const apiPrefix = "/api/v1"
+
+// handlerFunc 使用连接池中的连接处理请求, 返回的错误用于生成错误响应并判断连接是否可以复用
+type handlerFunc func(w http.ResponseWriter, r *http.Request, conn *rtdb.RtdbConnect) error
+
+// route 接口路由, 与 openapi.json 中的路径一一对应
+type route struct {
+	method  string
+	path    string
+	handler handlerFunc
+}
+
+// server REST/JSON网关
+type server struct {
+	pool      *pool
+	batchSize int
+	routes    []route
+	mux       *http.ServeMux
+}
+
+// newServer 创建网关
+//
+// input:
+//   - p 连接池
+//   - batchSize 写入数值时每批的个数
+func newServer(p *pool, batchSize int) *server {
+	if batchSize <= 0 {
+		batchSize = 1000
+	}
+	s := &server{pool: p, batchSize: batchSize, mux: http.NewServeMux()}
+	s.routes = []route{
+		{http.MethodGet, "/tables", s.listTables},
+		{http.MethodPost, "/tables", s.createTable},
+		{http.MethodGet, "/tables/{table}", s.getTable},
+		{http.MethodPatch, "/tables/{table}", s.updateTable},
+		{http.MethodDelete, "/tables/{table}", s.deleteTable},
+		{http.MethodGet, "/points", s.searchPoints},
+		{http.MethodPost, "/points", s.createPoint},
+		{http.MethodGet, "/points/{point}", s.getPoint},
+		{http.MethodPatch, "/points/{point}", s.updatePoint},
+		{http.MethodDelete, "/points/{point}", s.deletePoint},
+		{http.MethodGet, "/points/{point}/history", s.history},
+		{http.MethodGet, "/points/{point}/interpolated", s.interpolated},
+		{http.MethodGet, "/points/{point}/summary", s.summary},
+		{http.MethodGet, "/snapshots", s.snapshots},
+		{http.MethodPost, "/values", s.writeValues},
+		{http.MethodGet, "/named-types", s.listNamedTypes},
+		{http.MethodPost, "/named-types", s.createNamedType},
+		{http.MethodGet, "/named-types/{name}", s.getNamedType},
+		{http.MethodDelete, "/named-types/{name}", s.deleteNamedType},
+	}
+	for _, rt := range s.routes {
+		s.mux.HandleFunc(rt.method+" "+apiPrefix+rt.path, s.wrap(rt.handler))
+	}
+	s.mux.HandleFunc("GET /openapi.json", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", contentTypeJSON)
+		_, _ = w.Write(openapiJSON)
+	})
+	return s
+}
+
+// ServeHTTP 实现 http.Handler
+func (s *server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+// wrap 使用Basic认证中的RTDB用户名与密码从连接池获取连接, 并将错误转换成JSON响应
+func (s *server) wrap(h handlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		user, password, ok := r.BasicAuth()
+		if !ok {
+			writeError(w, &errLogin{err: errors.New("需要通过Basic认证提供RTDB用户名与密码")})
+			return
+		}
+		conn, release, err := s.pool.acquire(r.Context(), user, password)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		err = h(w, r, conn)
+		release(err)
+		var streamed *errStreamed
+		if err != nil && !errors.As(err, &streamed) {
+			writeError(w, err)
+		}
+	}
+}
+
+// readJSON 解码请求体
+func readJSON(r *http.Request, v any) error {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		return badRequest(fmt.Errorf("请求体格式错误: %w", err))
+	}
+	return nil
+}
+
+// queryInt 读取整数查询参数
+func queryInt(r *http.Request, name string, def int64) (int64, error) {
+	s := r.URL.Query().Get(name)
+	if s == "" {
+		return def, nil
+	}
+	v, err := strconv.ParseInt(s, 10, 32)
+	if err != nil {
+		return 0, badRequest(fmt.Errorf("参数%s应为整数: %s", name, s))
+	}
+	return v, nil
+}
+
+// queryBool 读取布尔查询参数
+func queryBool(r *http.Request, name string) (bool, error) {
+	s := r.URL.Query().Get(name)
+	if s == "" {
+		return false, nil
+	}
+	v, err := strconv.ParseBool(s)
+	if err != nil {
+		return false, badRequest(fmt.Errorf("参数%s应为布尔值: %s", name, s))
+	}
+	return v, nil
+}
+
+// parseTime 解析时间参数, 支持RFC3339、毫秒时间戳与now
+func parseTime(s string, def time.Time) (time.Time, error) {
+	switch s {
+	case "":
+		return def, nil
+	case "now":
+		return time.Now(), nil
+	}
+	if ms, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return time.UnixMilli(ms), nil
+	}
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return time.Time{}, badRequest(fmt.Errorf("时间格式错误, 应为RFC3339或毫秒时间戳: %s", s))
+	}
+	return t, nil
+}
+
+// queryRange 读取start与end参数, end默认为当前时间, start默认为end之前1小时
+func queryRange(r *http.Request) (time.Time, time.Time, error) {
+	end, err := parseTime(r.URL.Query().Get("end"), time.Now())
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	start, err := parseTime(r.URL.Query().Get("start"), end.Add(-time.Hour))
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	if start.After(end) {
+		return time.Time{}, time.Time{}, badRequest(errors.New("start不能晚于end"))
+	}
+	return start, end, nil
+}
+
+// tableJSON 表的JSON结构
+type tableJSON struct {
+	ID   rtdb.TableID `json:"id"`
+	Name string       `json:"name"`
+	Desc string       `json:"desc"`
+}
+
+// tablePatchJSON 修改表的JSON结构, 为空的字段不修改
+type tablePatchJSON struct {
+	Name *string `json:"name"`
+	Desc *string `json:"desc"`
+}
+
+// pathTable 读取路径中的表ID
+func pathTable(r *http.Request) (rtdb.TableID, error) {
+	id, err := strconv.ParseInt(r.PathValue("table"), 10, 32)
+	if err != nil {
+		return 0, badRequest(fmt.Errorf("表ID应为整数: %s", r.PathValue("table")))
+	}
+	return rtdb.TableID(id), nil
+}
+
+func (s *server) listTables(w http.ResponseWriter, r *http.Request, conn *rtdb.RtdbConnect) error {
+	tables, err := conn.GetTables()
+	if err != nil {
+		return err
+	}
+	st := newStream(w, r)
+	for _, t := range tables {
+		if err := st.write(tableJSON{ID: t.ID, Name: t.Name, Desc: t.Desc}); err != nil {
+			return st.close(err)
+		}
+	}
+	return st.close(nil)
+}
+
+func (s *server) createTable(w http.ResponseWriter, r *http.Request, conn *rtdb.RtdbConnect) error {
+	body := tableJSON{}
+	if err := readJSON(r, &body); err != nil {
+		return err
+	}
+	if body.Name == "" {
+		return badRequest(errors.New("表名称不能为空"))
+	}
+	table, err := conn.CreateTable(body.Name, body.Desc)
+	if err != nil {
+		return err
+	}
+	writeJSON(w, http.StatusCreated, tableJSON{ID: table.ID, Name: table.Name, Desc: table.Desc})
+	return nil
+}
+
+func (s *server) getTable(w http.ResponseWriter, r *http.Request, conn *rtdb.RtdbConnect) error {
+	id, err := pathTable(r)
+	if err != nil {
+		return err
+	}
+	table, err := conn.GetTable(id)
+	if err != nil {
+		return err
+	}
+	writeJSON(w, http.StatusOK, tableJSON{ID: table.ID, Name: table.Name, Desc: table.Desc})
+	return nil
+}
+
+func (s *server) updateTable(w http.ResponseWriter, r *http.Request, conn *rtdb.RtdbConnect) error {
+	id, err := pathTable(r)
+	if err != nil {
+		return err
+	}
+	patch := tablePatchJSON{}
+	if err := readJSON(r, &patch); err != nil {
+		return err
+	}
+	if patch.Name != nil {
+		if err := conn.UpdateTableName(id, *patch.Name); err != nil {
+			return err
+		}
+	}
+	if patch.Desc != nil {
+		if err := conn.UpdateTableDesc(id, *patch.Desc); err != nil {
+			return err
+		}
+	}
+	return s.getTable(w, r, conn)
+}
+
+func (s *server) deleteTable(w http.ResponseWriter, r *http.Request, conn *rtdb.RtdbConnect) error {
+	id, err := pathTable(r)
+	if err != nil {
+		return err
+	}
+	if err := conn.DeleteTable(id); err != nil {
+		return err
+	}
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+// searchPageSize 每次搜索的标签点个数
+const searchPageSize = 1000
+
+func (s *server) searchPoints(w http.ResponseWriter, r *http.Request, conn *rtdb.RtdbConnect) error {
+	q := r.URL.Query()
+	offset, err := queryInt(r, "offset", 0)
+	if err != nil {
+		return err
+	}
+	limit, err := queryInt(r, "limit", 0)
+	if err != nil {
+		return err
+	}
+	st := newStream(w, r)
+	for start := int32(offset); limit <= 0 || int64(st.count) < limit; {
+		count := int32(searchPageSize)
+		if limit > 0 {
+			count = int32(min(limit-int64(st.count), searchPageSize))
+		}
+		total, infos, errs, err := conn.SearchPoint(start, count, q.Get("tag"), q.Get("table"), q.Get("source"), q.Get("unit"), q.Get("desc"), q.Get("instrument"), "", rtdb.RtdbTypeAny, rtdb.RtdbPrecisionAny, rtdb.RtdbSearchNull, "", 0)
+		if err != nil {
+			return st.close(err)
+		}
+		for i, info := range infos {
+			if errs[i] != nil {
+				return st.close(errs[i])
+			}
+			if err := st.write(info); err != nil {
+				return st.close(err)
+			}
+		}
+		start += int32(len(infos))
+		if len(infos) == 0 || start >= total {
+			break
+		}
+	}
+	return st.close(nil)
+}
+
+// findPoint 根据标签点ID或全名(表名.点名)查找标签点
+func findPoint(conn *rtdb.RtdbConnect, point string) (*rtdb.PointInfo, error) {
+	if id, err := strconv.ParseInt(point, 10, 32); err == nil {
+		return conn.GetPoint(rtdb.PointID(id))
+	}
+	infos, errs, err := conn.FindPoints([]string{point})
+	if err != nil {
+		return nil, err
+	}
+	if errs[0] != nil {
+		return nil, fmt.Errorf("%s: %w", point, errs[0])
+	}
+	return infos[0], nil
+}
+
+func (s *server) createPoint(w http.ResponseWriter, r *http.Request, conn *rtdb.RtdbConnect) error {
+	info := &rtdb.PointInfo{}
+	if err := readJSON(r, info); err != nil {
+		return err
+	}
+	info, err := conn.AddPoint(info)
+	if err != nil {
+		return err
+	}
+	writeJSON(w, http.StatusCreated, info)
+	return nil
+}
+
+func (s *server) getPoint(w http.ResponseWriter, r *http.Request, conn *rtdb.RtdbConnect) error {
+	info, err := findPoint(conn, r.PathValue("point"))
+	if err != nil {
+		return err
+	}
+	writeJSON(w, http.StatusOK, info)
+	return nil
+}
+
+// updatePoint 修改标签点属性, 请求体为需要修改的字段(JSON merge patch), 不能修改ID、数值类型与所属的表
+func (s *server) updatePoint(w http.ResponseWriter, r *http.Request, conn *rtdb.RtdbConnect) error {
+	info, err := findPoint(conn, r.PathValue("point"))
+	if err != nil {
+		return err
+	}
+	patch := map[string]json.RawMessage{}
+	if err := readJSON(r, &patch); err != nil {
+		return err
+	}
+	for _, key := range []string{"id", "table_id", "value_type"} {
+		if _, ok := patch[key]; ok {
+			return badRequest(fmt.Errorf("不能修改标签点的%s", key))
+		}
+	}
+	data, err := json.Marshal(info)
+	if err != nil {
+		return err
+	}
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	for k, v := range patch {
+		fields[k] = v
+	}
+	if data, err = json.Marshal(fields); err != nil {
+		return err
+	}
+	updated := &rtdb.PointInfo{}
+	if err := json.Unmarshal(data, updated); err != nil {
+		return badRequest(fmt.Errorf("标签点属性错误: %w", err))
+	}
+	if err := conn.UpdatePointInfo(updated); err != nil {
+		return err
+	}
+	if info, err = conn.GetPoint(info.ID); err != nil {
+		return err
+	}
+	writeJSON(w, http.StatusOK, info)
+	return nil
+}
+
+func (s *server) deletePoint(w http.ResponseWriter, r *http.Request, conn *rtdb.RtdbConnect) error {
+	info, err := findPoint(conn, r.PathValue("point"))
+	if err != nil {
+		return err
+	}
+	if err := conn.DeletePoint(info.ID); err != nil {
+		return err
+	}
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+func (s *server) history(w http.ResponseWriter, r *http.Request, conn *rtdb.RtdbConnect) error {
+	info, err := findPoint(conn, r.PathValue("point"))
+	if err != nil {
+		return err
+	}
+	start, end, err := queryRange(r)
+	if err != nil {
+		return err
+	}
+	limit, err := queryInt(r, "limit", 0)
+	if err != nil {
+		return err
+	}
+	st := newStream(w, r)
+	for tvq, err := range conn.ArchivedValues(info, start, end) {
+		if err != nil {
+			return st.close(err)
+		}
+		if err := st.write(tvq); err != nil {
+			return st.close(err)
+		}
+		if limit > 0 && int64(st.count) >= limit {
+			break
+		}
+	}
+	return st.close(nil)
+}
+
+func (s *server) interpolated(w http.ResponseWriter, r *http.Request, conn *rtdb.RtdbConnect) error {
+	info, err := findPoint(conn, r.PathValue("point"))
+	if err != nil {
+		return err
+	}
+	start, end, err := queryRange(r)
+	if err != nil {
+		return err
+	}
+	count, err := queryInt(r, "count", 100)
+	if err != nil {
+		return err
+	}
+	if count <= 0 {
+		return badRequest(errors.New("count应大于0"))
+	}
+	tvqs, err := conn.ReadInterpoValues(info, start, end, int32(count))
+	if err != nil {
+		return err
+	}
+	st := newStream(w, r)
+	for _, tvq := range tvqs {
+		if err := st.write(tvq); err != nil {
+			return st.close(err)
+		}
+	}
+	return st.close(nil)
+}
+
+func (s *server) summary(w http.ResponseWriter, r *http.Request, conn *rtdb.RtdbConnect) error {
+	info, err := findPoint(conn, r.PathValue("point"))
+	if err != nil {
+		return err
+	}
+	start, end, err := queryRange(r)
+	if err != nil {
+		return err
+	}
+	summary, err := conn.ReadSummary(info, start, end)
+	if err != nil {
+		return err
+	}
+	writeJSON(w, http.StatusOK, summary)
+	return nil
+}
+
+// snapshotErrorJSON 读取快照失败的标签点
+type snapshotErrorJSON struct {
+	Point string `json:"point"`
+	errorJSON
+}
+
+// snapshots 读取快照, 查询参数point可以重复, 为标签点ID或全名
+func (s *server) snapshots(w http.ResponseWriter, r *http.Request, conn *rtdb.RtdbConnect) error {
+	points := r.URL.Query()["point"]
+	if len(points) == 0 {
+		return badRequest(errors.New("缺少参数point"))
+	}
+	infos := make([]*rtdb.PointInfo, 0, len(points))
+	failed := make([]snapshotErrorJSON, 0)
+	for _, point := range points {
+		info, err := findPoint(conn, point)
+		if err != nil {
+			if rtdb.ErrorCategoryOf(err) != rtdb.ErrorCategoryNotFound {
+				return err
+			}
+			failed = append(failed, snapshotErrorJSON{Point: point, errorJSON: errorBody(err)})
+			continue
+		}
+		infos = append(infos, info)
+	}
+	tvqs, errs, err := conn.ReadSnapshots(infos)
+	if err != nil {
+		return err
+	}
+	st := newStream(w, r)
+	for i, info := range infos {
+		var v any = rtdb.NewPTVQ(info, tvqs[i])
+		if errs[i] != nil {
+			v = snapshotErrorJSON{Point: info.TableDotTag, errorJSON: errorBody(errs[i])}
+		}
+		if err := st.write(v); err != nil {
+			return st.close(err)
+		}
+	}
+	for _, f := range failed {
+		if err := st.write(f); err != nil {
+			return st.close(err)
+		}
+	}
+	return st.close(nil)
+}
+
+// writeResultJSON 写入数值的结果
+type writeResultJSON struct {
+	Written int              `json:"written"`
+	Errors  []writeErrorJSON `json:"errors"`
+}
+
+// writeErrorJSON 写入失败的数值, Index为数值在请求中的序号(从0开始)
+type writeErrorJSON struct {
+	Index int `json:"index"`
+	errorJSON
+}
+
+// valueDecoder 逐个解码请求中的数值, 请求体可以是JSON数组, 也可以是NDJSON或连续的JSON对象
+type valueDecoder struct {
+	dec   *json.Decoder
+	array bool
+}
+
+// newValueDecoder 根据请求体的第一个非空白字符判断格式
+func newValueDecoder(body io.Reader) (*valueDecoder, error) {
+	br := bufio.NewReader(body)
+	for {
+		b, err := br.ReadByte()
+		if err == io.EOF {
+			return &valueDecoder{dec: json.NewDecoder(br)}, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if b == ' ' || b == '\t' || b == '\r' || b == '\n' {
+			continue
+		}
+		_ = br.UnreadByte()
+		d := &valueDecoder{dec: json.NewDecoder(br), array: b == '['}
+		if d.array {
+			if _, err := d.dec.Token(); err != nil {
+				return nil, err
+			}
+		}
+		return d, nil
+	}
+}
+
+// next 解码下一个数值, 结束时返回 io.EOF
+func (d *valueDecoder) next() (map[string]json.RawMessage, error) {
+	if d.array && !d.dec.More() {
+		if _, err := d.dec.Token(); err != nil {
+			return nil, err
+		}
+		return nil, io.EOF
+	}
+	v := map[string]json.RawMessage{}
+	if err := d.dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// writeValues 写入数值, 每个数值为 {"id":1,"t":"...","v":1.5,"q":"good"} 或使用 "tag":"表名.点名" 指定标签点
+//   - t 省略时为当前时间, q 省略时为good, type 由标签点决定, 不需要提供
+//   - 查询参数archive为true时直接写入历史存档, fix为true时允许覆盖写入
+//   - 全部成功返回200, 部分失败返回207以及失败的数值
+//   - 客户端断开时取消进行中的写入
+func (s *server) writeValues(w http.ResponseWriter, r *http.Request, conn *rtdb.RtdbConnect) error {
+	archive, err := queryBool(r, "archive")
+	if err != nil {
+		return err
+	}
+	fix, err := queryBool(r, "fix")
+	if err != nil {
+		return err
+	}
+	dec, err := newValueDecoder(r.Body)
+	if err != nil {
+		return badRequest(err)
+	}
+
+	result := writeResultJSON{Errors: make([]writeErrorJSON, 0)}
+	fail := func(index int, err error) {
+		result.Errors = append(result.Errors, writeErrorJSON{Index: index, errorJSON: errorBody(err)})
+	}
+	points := make(map[string]*rtdb.PointInfo)
+	batch, index := make([]rtdb.PTVQ, 0, s.batchSize), make([]int, 0, s.batchSize)
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		var errs []error
+		var err error
+		if archive {
+			errs, err = conn.WriteArchivedValuesContext(r.Context(), batch)
+		} else {
+			errs, err = conn.WriteSectionContext(r.Context(), fix, batch)
+		}
+		if err != nil {
+			return err
+		}
+		for i, e := range errs {
+			if e != nil {
+				fail(index[i], e)
+			} else {
+				result.Written++
+			}
+		}
+		batch, index = batch[:0], index[:0]
+		return nil
+	}
+
+	for i := 0; ; i++ {
+		v, err := dec.next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return badRequest(fmt.Errorf("第%d个数值格式错误: %w", i, err))
+		}
+		ptvq, err := s.decodeValue(conn, points, v)
+		if err != nil {
+			if rtdb.ErrorCategoryOf(err) == rtdb.ErrorCategoryNetwork {
+				return err
+			}
+			fail(i, err)
+			continue
+		}
+		batch, index = append(batch, ptvq), append(index, i)
+		if len(batch) >= s.batchSize {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+	if err := flush(); err != nil {
+		return err
+	}
+	code := http.StatusOK
+	if len(result.Errors) != 0 {
+		code = http.StatusMultiStatus
+	}
+	writeJSON(w, code, result)
+	return nil
+}
+
+// decodeValue 解码单个数值, 标签点信息在一次请求内缓存
+func (s *server) decodeValue(conn *rtdb.RtdbConnect, points map[string]*rtdb.PointInfo, v map[string]json.RawMessage) (rtdb.PTVQ, error) {
+	point := ""
+	if raw, ok := v["id"]; ok {
+		point = string(raw)
+	} else if raw, ok := v["tag"]; ok {
+		if err := json.Unmarshal(raw, &point); err != nil {
+			return rtdb.PTVQ{}, badRequest(fmt.Errorf("tag应为字符串: %w", err))
+		}
+	}
+	if point == "" {
+		return rtdb.PTVQ{}, badRequest(errors.New("缺少id或tag"))
+	}
+	info, ok := points[point]
+	if !ok {
+		var err error
+		if info, err = findPoint(conn, point); err != nil {
+			return rtdb.PTVQ{}, err
+		}
+		points[point] = info
+	}
+
+	vt, err := json.Marshal(info.ValueType)
+	if err != nil {
+		return rtdb.PTVQ{}, err
+	}
+	v["type"] = vt
+	if _, ok := v["t"]; !ok {
+		v["t"], _ = json.Marshal(time.Now())
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return rtdb.PTVQ{}, err
+	}
+	tvq := rtdb.TVQ{}
+	if err := tvq.UnmarshalJSON(data); err != nil {
+		return rtdb.PTVQ{}, badRequest(fmt.Errorf("%s: %w", info.TableDotTag, err))
+	}
+	return rtdb.NewPTVQ(info, tvq), nil
+}
+
+// namedTypeJSON 自定义类型的JSON结构
+type namedTypeJSON struct {
+	Name   string           `json:"name"`
+	Desc   string           `json:"desc,omitempty"`
+	Length int32            `json:"length"`
+	Fields []namedFieldJSON `json:"fields"`
+}
+
+// namedFieldJSON 自定义类型字段的JSON结构, Type为基本数值类型名称, 例如 float32
+type namedFieldJSON struct {
+	Name   string         `json:"name"`
+	Type   rtdb.ValueType `json:"type"`
+	Length int32          `json:"length,omitempty"`
+	Desc   string         `json:"desc,omitempty"`
+}
+
+// toNamedTypeJSON NamedType转换成JSON结构
+func toNamedTypeJSON(t *rtdb.NamedType) namedTypeJSON {
+	rtn := namedTypeJSON{Name: t.Name, Desc: t.Desc, Length: t.Length, Fields: make([]namedFieldJSON, len(t.Fields))}
+	for i, f := range t.Fields {
+		rtn.Fields[i] = namedFieldJSON{Name: f.Name, Type: rtdb.FromRawType(f.Type, ""), Length: f.Length, Desc: f.Desc}
+	}
+	return rtn
+}
+
+func (s *server) listNamedTypes(w http.ResponseWriter, r *http.Request, conn *rtdb.RtdbConnect) error {
+	types, err := conn.GetNamedTypes()
+	if err != nil {
+		return err
+	}
+	st := newStream(w, r)
+	for i := range types {
+		if err := st.write(toNamedTypeJSON(&types[i])); err != nil {
+			return st.close(err)
+		}
+	}
+	return st.close(nil)
+}
+
+func (s *server) createNamedType(w http.ResponseWriter, r *http.Request, conn *rtdb.RtdbConnect) error {
+	body := namedTypeJSON{}
+	if err := readJSON(r, &body); err != nil {
+		return err
+	}
+	if body.Name == "" || len(body.Fields) == 0 {
+		return badRequest(errors.New("自定义类型的名称与字段不能为空"))
+	}
+	fields := make([]rtdb.RtdbDataTypeField, len(body.Fields))
+	for i, f := range body.Fields {
+		typ, _ := f.Type.ToRawType()
+		if typ == rtdb.RtdbTypeNamedT || typ == rtdb.RtdbTypeBlob || typ == rtdb.RtdbTypeDatetime {
+			return badRequest(fmt.Errorf("字段%s的类型%s不支持", f.Name, f.Type))
+		}
+		length := f.Length
+		if length == 0 {
+			length = rtdb.RtdbTypeSize(typ)
+		}
+		if length <= 0 {
+			return badRequest(fmt.Errorf("字段%s需要指定长度", f.Name))
+		}
+		fields[i] = rtdb.RtdbDataTypeField{Name: f.Name, Type: typ, Length: length, Desc: f.Desc}
+	}
+	if err := conn.AddNamedType(body.Name, body.Desc, fields...); err != nil {
+		return err
+	}
+	t, err := conn.GetNamedType(body.Name)
+	if err != nil {
+		return err
+	}
+	writeJSON(w, http.StatusCreated, toNamedTypeJSON(t))
+	return nil
+}
+
+func (s *server) getNamedType(w http.ResponseWriter, r *http.Request, conn *rtdb.RtdbConnect) error {
+	t, err := conn.GetNamedType(r.PathValue("name"))
+	if err != nil {
+		return err
+	}
+	writeJSON(w, http.StatusOK, toNamedTypeJSON(t))
+	return nil
+}
+
+func (s *server) deleteNamedType(w http.ResponseWriter, r *http.Request, conn *rtdb.RtdbConnect) error {
+	if err := conn.DeleteNamedType(r.PathValue("name")); err != nil {
+		return err
+	}
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
@@ -0,0 +1,264 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	rtdb "github.com/kkbase/rtdb_api"
+)
+
+// newTestServer 使用内存后端创建网关, 密码不是golden时登录失败
+func newTestServer(t *testing.T) *httptest.Server {
+	backend := rtdb.NewMemoryBackend()
+	p := newPool(func(user, password string) (*rtdb.RtdbConnect, error) {
+		if password != "golden" {
+			return nil, errors.New("密码错误")
+		}
+		return rtdb.LoginWithBackend(backend, "127.0.0.1", 6327, user, password)
+	}, 2, time.Minute)
+	server := httptest.NewServer(newServer(p, 2))
+	t.Cleanup(func() {
+		server.Close()
+		p.close()
+	})
+	return server
+}
+
+// call 发送请求, body为nil时不发送请求体, 为string时原样发送, 否则编码为JSON
+func call(t *testing.T, server *httptest.Server, method, path string, body any, accept string) (int, []byte) {
+	t.Helper()
+	var reader io.Reader
+	switch b := body.(type) {
+	case nil:
+	case string:
+		reader = strings.NewReader(b)
+	default:
+		data, err := json.Marshal(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		reader = bytes.NewReader(data)
+	}
+	req, err := http.NewRequest(method, server.URL+path, reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.SetBasicAuth("sa", "golden")
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resp.StatusCode, data
+}
+
+// decode 解码JSON响应
+func decode[T any](t *testing.T, data []byte) T {
+	t.Helper()
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		t.Fatalf("解码响应失败: %v\n%s", err, data)
+	}
+	return v
+}
+
+// 登录失败与缺少认证信息时返回401
+func TestServer_Auth(t *testing.T) {
+	server := newTestServer(t)
+	resp, err := http.Get(server.URL + "/api/v1/tables")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusUnauthorized || resp.Header.Get("WWW-Authenticate") == "" {
+		t.Error("缺少认证信息时期望返回401", resp.StatusCode)
+	}
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL+"/api/v1/tables", nil)
+	req.SetBasicAuth("sa", "wrong")
+	if resp, err = http.DefaultClient.Do(req); err != nil {
+		t.Fatal(err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Error("密码错误时期望返回401", resp.StatusCode)
+	}
+}
+
+// 表、标签点与自定义类型的增删改查
+func TestServer_CRUD(t *testing.T) {
+	server := newTestServer(t)
+
+	code, data := call(t, server, http.MethodPost, "/api/v1/tables", map[string]string{"name": "plant", "desc": "工厂"}, "")
+	if code != http.StatusCreated {
+		t.Fatalf("创建表失败 %d %s", code, data)
+	}
+	table := decode[tableJSON](t, data)
+	if code, _ = call(t, server, http.MethodPost, "/api/v1/tables", map[string]string{"name": "plant"}, ""); code != http.StatusConflict {
+		t.Error("重复创建表期望返回409", code)
+	}
+	tablePath := fmt.Sprintf("/api/v1/tables/%d", table.ID)
+	code, data = call(t, server, http.MethodPatch, tablePath, map[string]string{"desc": "一号工厂"}, "")
+	if code != http.StatusOK || decode[tableJSON](t, data).Desc != "一号工厂" {
+		t.Errorf("修改表失败 %d %s", code, data)
+	}
+
+	for _, name := range []string{"temp", "press", "flow"} {
+		info := rtdb.NewPointInfo(name, table.ID, rtdb.ValueTypeFloat64, rtdb.PointBase, rtdb.RtdbPrecisionMilli, "", "")
+		if code, data = call(t, server, http.MethodPost, "/api/v1/points", info, ""); code != http.StatusCreated {
+			t.Fatalf("创建标签点失败 %d %s", code, data)
+		}
+	}
+	code, data = call(t, server, http.MethodGet, "/api/v1/points?table=plant&tag=*e*&format=ndjson", nil, "")
+	if code != http.StatusOK || strings.Count(string(data), "\n") != 2 {
+		t.Errorf("搜索标签点结果错误 %d %s", code, data)
+	}
+	code, data = call(t, server, http.MethodGet, "/api/v1/points?table=plant&offset=1&limit=1", nil, "")
+	if infos := decode[[]rtdb.PointInfo](t, data); code != http.StatusOK || len(infos) != 1 {
+		t.Errorf("分页搜索标签点结果错误 %d %s", code, data)
+	}
+
+	code, data = call(t, server, http.MethodPatch, "/api/v1/points/plant.temp", map[string]any{"unit": "℃", "high_limit": 200}, "")
+	if info := decode[rtdb.PointInfo](t, data); code != http.StatusOK || info.Unit != "℃" || info.HighLimit != 200 {
+		t.Errorf("修改标签点失败 %d %s", code, data)
+	}
+	if code, _ = call(t, server, http.MethodPatch, "/api/v1/points/plant.temp", map[string]any{"value_type": "int32"}, ""); code != http.StatusBadRequest {
+		t.Error("修改数值类型期望返回400", code)
+	}
+	info := decode[rtdb.PointInfo](t, func() []byte { _, d := call(t, server, http.MethodGet, "/api/v1/points/plant.flow", nil, ""); return d }())
+	if code, _ = call(t, server, http.MethodDelete, fmt.Sprintf("/api/v1/points/%d", info.ID), nil, ""); code != http.StatusNoContent {
+		t.Error("删除标签点失败", code)
+	}
+	if code, _ = call(t, server, http.MethodGet, "/api/v1/points/plant.flow", nil, ""); code != http.StatusNotFound {
+		t.Error("删除后期望返回404", code)
+	}
+
+	named := map[string]any{"name": "vec", "fields": []map[string]any{{"name": "x", "type": "float32"}, {"name": "y", "type": "float32"}}}
+	if code, data = call(t, server, http.MethodPost, "/api/v1/named-types", named, ""); code != http.StatusCreated {
+		t.Fatalf("创建自定义类型失败 %d %s", code, data)
+	}
+	code, data = call(t, server, http.MethodGet, "/api/v1/named-types/vec", nil, "")
+	if nt := decode[namedTypeJSON](t, data); code != http.StatusOK || nt.Length != 8 || len(nt.Fields) != 2 || nt.Fields[1].Type != rtdb.ValueTypeFloat32 {
+		t.Errorf("获取自定义类型错误 %d %s", code, data)
+	}
+	code, data = call(t, server, http.MethodGet, "/api/v1/named-types", nil, "")
+	if code != http.StatusOK || len(decode[[]namedTypeJSON](t, data)) != 1 {
+		t.Errorf("列出自定义类型错误 %d %s", code, data)
+	}
+	if code, _ = call(t, server, http.MethodDelete, "/api/v1/named-types/vec", nil, ""); code != http.StatusNoContent {
+		t.Error("删除自定义类型失败", code)
+	}
+
+	if code, _ = call(t, server, http.MethodDelete, tablePath, nil, ""); code != http.StatusNoContent {
+		t.Error("删除表失败", code)
+	}
+	if code, _ = call(t, server, http.MethodGet, tablePath, nil, ""); code != http.StatusNotFound {
+		t.Error("删除后期望返回404", code)
+	}
+}
+
+// 写入数值并读取快照、历史、插值与统计值
+func TestServer_Values(t *testing.T) {
+	server := newTestServer(t)
+	code, data := call(t, server, http.MethodPost, "/api/v1/tables", map[string]string{"name": "plant"}, "")
+	if code != http.StatusCreated {
+		t.Fatalf("创建表失败 %d %s", code, data)
+	}
+	table := decode[tableJSON](t, data)
+	info := rtdb.NewPointInfo("temp", table.ID, rtdb.ValueTypeFloat64, rtdb.PointBase, rtdb.RtdbPrecisionMilli, "", "")
+	code, data = call(t, server, http.MethodPost, "/api/v1/points", info, "")
+	if code != http.StatusCreated {
+		t.Fatalf("创建标签点失败 %d %s", code, data)
+	}
+	info = decode[*rtdb.PointInfo](t, data)
+
+	// 批大小为2, 第3个数值位于第二批, 第2个数值的标签点不存在
+	body := fmt.Sprintf(`[
+		{"id":%d,"t":"2024-01-01T00:00:00Z","v":1},
+		{"tag":"plant.missing","t":"2024-01-01T00:00:00Z","v":1},
+		{"tag":"plant.temp","t":"2024-01-01T00:00:10Z","v":3,"q":"good"},
+		{"tag":"plant.temp","t":"2024-01-01T00:00:20Z","v":"abc"}
+	]`, info.ID)
+	code, data = call(t, server, http.MethodPost, "/api/v1/values?archive=true", body, "")
+	result := decode[writeResultJSON](t, data)
+	if code != http.StatusMultiStatus || result.Written != 2 || len(result.Errors) != 2 ||
+		result.Errors[0].Index != 1 || result.Errors[0].Category != "not-found" || result.Errors[1].Index != 3 {
+		t.Errorf("部分写入结果错误 %d %s", code, data)
+	}
+	code, data = call(t, server, http.MethodPost, "/api/v1/values", `{"tag":"plant.temp","t":"2024-01-01T00:00:30Z","v":5}`+"\n"+`{"tag":"plant.temp","t":"2024-01-01T00:00:40Z","v":7}`, "")
+	if code != http.StatusOK || decode[writeResultJSON](t, data).Written != 2 {
+		t.Errorf("NDJSON写入结果错误 %d %s", code, data)
+	}
+
+	code, data = call(t, server, http.MethodGet, "/api/v1/snapshots?point=plant.temp&point=plant.missing", nil, "")
+	snapshots := decode[[]map[string]any](t, data)
+	if code != http.StatusOK || len(snapshots) != 2 || snapshots[0]["v"] != 7.0 || snapshots[1]["category"] != "not-found" {
+		t.Errorf("读取快照结果错误 %d %s", code, data)
+	}
+
+	rng := "start=2024-01-01T00:00:00Z&end=2024-01-01T00:00:40Z"
+	code, data = call(t, server, http.MethodGet, "/api/v1/points/plant.temp/history?"+rng, nil, "")
+	if tvqs := decode[[]rtdb.TVQ](t, data); code != http.StatusOK || len(tvqs) != 4 || tvqs[1].Value.FloatValue != 3 {
+		t.Errorf("读取历史结果错误 %d %s", code, data)
+	}
+	code, data = call(t, server, http.MethodGet, "/api/v1/points/plant.temp/history?limit=3&"+rng, nil, contentTypeNDJSON)
+	if code != http.StatusOK || strings.Count(string(data), "\n") != 3 {
+		t.Errorf("读取NDJSON历史结果错误 %d %s", code, data)
+	}
+	code, data = call(t, server, http.MethodGet, "/api/v1/points/plant.temp/interpolated?count=5&"+rng, nil, "")
+	if tvqs := decode[[]rtdb.TVQ](t, data); code != http.StatusOK || len(tvqs) != 5 || tvqs[2].Value.FloatValue != 4 {
+		t.Errorf("读取插值结果错误 %d %s", code, data)
+	}
+	code, data = call(t, server, http.MethodGet, "/api/v1/points/plant.temp/summary?"+rng, nil, "")
+	if s := decode[rtdb.Summary](t, data); code != http.StatusOK || s.Count != 4 || s.Max.Value != 7 || s.CalcAvg != 4 || s.Total != s.Power {
+		t.Errorf("读取统计值结果错误 %d %s", code, data)
+	}
+	if code, _ = call(t, server, http.MethodGet, "/api/v1/points/plant.temp/history?start=yesterday", nil, ""); code != http.StatusBadRequest {
+		t.Error("时间格式错误期望返回400", code)
+	}
+}
+
+// openapi.json 包含所有接口
+func TestServer_OpenAPI(t *testing.T) {
+	server := newTestServer(t)
+	resp, err := http.Get(server.URL + "/openapi.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	doc := struct {
+		Paths map[string]map[string]json.RawMessage `json:"paths"`
+	}{}
+	if err := json.NewDecoder(resp.Body).Decode(&doc); err != nil {
+		t.Fatal(err)
+	}
+	s := newServer(nil, 0)
+	routes := make(map[string]bool)
+	for _, rt := range s.routes {
+		path := apiPrefix + rt.path
+		routes[path] = true
+		if _, ok := doc.Paths[path][strings.ToLower(rt.method)]; !ok {
+			t.Errorf("openapi.json 缺少 %s %s", rt.method, path)
+		}
+	}
+	for path := range doc.Paths {
+		if !routes[path] {
+			t.Errorf("openapi.json 中的 %s 没有对应的接口", path)
+		}
+	}
+}
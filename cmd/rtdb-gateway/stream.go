@@ -0,0 +1,204 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	rtdb "github.com/kkbase/rtdb_api"
+)
+
+// 响应的媒体类型
+const (
+	contentTypeJSON   = "application/json"
+	contentTypeNDJSON = "application/x-ndjson"
+)
+
+// flushEvery 每写入多少个元素刷新一次响应
+const flushEvery = 100
+
+// wantNDJSON 客户端是否需要NDJSON, 查询参数format优先于Accept
+func wantNDJSON(r *http.Request) bool {
+	switch r.URL.Query().Get("format") {
+	case "ndjson":
+		return true
+	case "json":
+		return false
+	}
+	return strings.Contains(r.Header.Get("Accept"), contentTypeNDJSON)
+}
+
+// stream 以JSON数组或NDJSON流式输出列表, 不需要在内存中保存完整的结果
+//   - JSON: [元素,元素,...]
+//   - NDJSON: 每行一个元素
+//   - 已经开始输出后发生的错误作为最后一个元素 {"error":"..."} 输出
+type stream struct {
+	w       http.ResponseWriter
+	ndjson  bool
+	started bool
+	count   int
+}
+
+// newStream 创建流式输出
+func newStream(w http.ResponseWriter, r *http.Request) *stream {
+	return &stream{w: w, ndjson: wantNDJSON(r)}
+}
+
+// start 写入响应头
+func (s *stream) start() {
+	if s.started {
+		return
+	}
+	s.started = true
+	if s.ndjson {
+		s.w.Header().Set("Content-Type", contentTypeNDJSON)
+	} else {
+		s.w.Header().Set("Content-Type", contentTypeJSON)
+	}
+	s.w.WriteHeader(http.StatusOK)
+	if !s.ndjson {
+		_, _ = s.w.Write([]byte("["))
+	}
+}
+
+// write 写入一个元素
+func (s *stream) write(v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	s.start()
+	if s.ndjson {
+		data = append(data, '\n')
+	} else if s.count > 0 {
+		data = append([]byte(",\n"), data...)
+	}
+	if _, err := s.w.Write(data); err != nil {
+		return err
+	}
+	s.count++
+	if s.count%flushEvery == 0 {
+		if f, ok := s.w.(http.Flusher); ok {
+			f.Flush()
+		}
+	}
+	return nil
+}
+
+// close 结束输出
+//   - 尚未开始输出时返回err, 由调用方返回错误状态码
+//   - 已经开始输出时将err作为最后一个元素输出, 返回 errStreamed 包装的err
+func (s *stream) close(err error) error {
+	if err != nil && !s.started {
+		return err
+	}
+	s.start()
+	if err != nil {
+		body := errorBody(err)
+		if s.count > 0 && !s.ndjson {
+			_, _ = s.w.Write([]byte(",\n"))
+		}
+		data, _ := json.Marshal(body)
+		_, _ = s.w.Write(data)
+		if s.ndjson {
+			_, _ = s.w.Write([]byte("\n"))
+		}
+	}
+	if !s.ndjson {
+		_, _ = s.w.Write([]byte("]\n"))
+	}
+	if err != nil {
+		return &errStreamed{err: err}
+	}
+	return nil
+}
+
+// errStreamed 已经在响应中输出的错误
+type errStreamed struct {
+	err error
+}
+
+func (e *errStreamed) Error() string {
+	return e.err.Error()
+}
+
+func (e *errStreamed) Unwrap() error {
+	return e.err
+}
+
+// httpError 带有状态码的错误
+type httpError struct {
+	code int
+	err  error
+}
+
+func (e *httpError) Error() string {
+	return e.err.Error()
+}
+
+func (e *httpError) Unwrap() error {
+	return e.err
+}
+
+// badRequest 请求参数错误, 映射为400
+func badRequest(err error) error {
+	return &httpError{code: http.StatusBadRequest, err: err}
+}
+
+// statusOf 错误对应的HTTP状态码
+func statusOf(err error) int {
+	var he *httpError
+	if errors.As(err, &he) {
+		return he.code
+	}
+	if isLoginError(err) {
+		return http.StatusUnauthorized
+	}
+	switch rtdb.ErrorCategoryOf(err) {
+	case rtdb.ErrorCategoryNotFound:
+		return http.StatusNotFound
+	case rtdb.ErrorCategoryConflict:
+		return http.StatusConflict
+	case rtdb.ErrorCategoryData:
+		return http.StatusBadRequest
+	case rtdb.ErrorCategoryPermission:
+		return http.StatusForbidden
+	case rtdb.ErrorCategoryUnsupported:
+		return http.StatusNotImplemented
+	case rtdb.ErrorCategoryNetwork:
+		return http.StatusBadGateway
+	}
+	return http.StatusInternalServerError
+}
+
+// errorJSON 错误的JSON结构
+type errorJSON struct {
+	Error    string `json:"error"`
+	Category string `json:"category,omitempty"`
+}
+
+// errorBody 生成错误的JSON结构, 数据库错误附带错误分类
+func errorBody(err error) errorJSON {
+	body := errorJSON{Error: err.Error()}
+	if category := rtdb.ErrorCategoryOf(err); category != rtdb.ErrorCategoryUnknown {
+		body.Category = category.String()
+	}
+	return body
+}
+
+// writeJSON 输出单个JSON对象
+func writeJSON(w http.ResponseWriter, code int, v any) {
+	w.Header().Set("Content-Type", contentTypeJSON)
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writeError 输出错误
+func writeError(w http.ResponseWriter, err error) {
+	code := statusOf(err)
+	if code == http.StatusUnauthorized {
+		w.Header().Set("WWW-Authenticate", `Basic realm="rtdb"`)
+	}
+	writeJSON(w, code, errorBody(err))
+}
@@ -0,0 +1,242 @@
+package rtdb_api
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Compressor 客户端例外与旋转门压缩, 在发送之前按本包实现的例外与旋转门算法丢弃数值
+//   - 算法没有与服务端的存档结果校验过, 发送的数值不保证与服务端存档的数值一致
+//   - 按照标签点自身的例外(ExcDev、ExcDevPercent、ExcTimeMax、ExcTimeMin)与压缩(Compress、CompDev、CompDevPercent、CompTimeMax、CompTimeMin)配置过滤
+//   - 偏差百分比不为0时按量程(HighLimit-LowLimit)换算, 优先于偏差值; 时间间隔的单位为秒, 为0时不限制
+//   - 例外偏差内的数值不会立即丢弃, 而是作为前一个数值保留; 下一个数值超过例外偏差或质量码改变时, 先输出前一个数值再输出新的数值, 保留变化的起点
+//   - 每个标签点单独保存状态, 同一个标签点的数值需要按时间顺序输入, 早于上一个数值的数值原样输出且不影响状态
+//   - 旋转门压缩会保留最后一个通过例外的数值, 直到下一个数值打开旋转门或调用 Flush
+//   - 字符串、坐标、BLOB等非数值类型原样输出
+type Compressor struct {
+	mu     sync.Mutex
+	points map[PointID]*compressState
+}
+
+// compressState 单个标签点的例外与压缩状态
+type compressState struct {
+	info         *PointInfo
+	exception    *TVQ    // 最后一个通过例外的数值
+	previous     *TVQ    // 最后一个在例外偏差内的数值, 下一个数值超过例外偏差时一起通过
+	archived     *TVQ    // 最后一个存档的数值
+	held         *TVQ    // 通过例外但是还没有存档的数值
+	upper, lower float64 // 旋转门的上下斜率
+}
+
+// NewCompressor 新建客户端压缩
+func NewCompressor() *Compressor {
+	return &Compressor{points: make(map[PointID]*compressState)}
+}
+
+// Filter 对数值进行例外与压缩
+//
+// input:
+//   - ptvqs 数值列表, 同一个标签点的数值按时间升序排列
+//
+// output:
+//   - []PTVQ 需要发送的数值
+func (c *Compressor) Filter(ptvqs []PTVQ) []PTVQ {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	rtn := make([]PTVQ, 0, len(ptvqs))
+	for _, ptvq := range ptvqs {
+		s, ok := c.points[ptvq.PointInfo.ID]
+		if !ok {
+			s = &compressState{}
+			s.reset()
+			c.points[ptvq.PointInfo.ID] = s
+		}
+		s.info = ptvq.PointInfo
+		for _, tvq := range s.push(ptvq.TVQ) {
+			rtn = append(rtn, NewPTVQ(ptvq.PointInfo, tvq))
+		}
+	}
+	return rtn
+}
+
+// Flush 输出所有标签点保留的数值, 用于停止采集或者需要立即发送快照时
+//
+// output:
+//   - []PTVQ 需要发送的数值
+func (c *Compressor) Flush() []PTVQ {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	rtn := make([]PTVQ, 0)
+	for _, s := range c.points {
+		if s.held != nil {
+			rtn = append(rtn, NewPTVQ(s.info, *s.held))
+			s.archive()
+		}
+	}
+	sort.Slice(rtn, func(i, j int) bool { return rtn[i].PointInfo.ID < rtn[j].PointInfo.ID })
+	return rtn
+}
+
+// Reset 丢弃标签点的状态, 修改标签点的例外或压缩配置后调用
+//
+// input:
+//   - id 标签点ID
+func (c *Compressor) Reset(id PointID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.points, id)
+}
+
+// 例外判断的结果
+const (
+	exceptionDrop  = iota // 最短例外间隔内, 丢弃
+	exceptionHold         // 例外偏差内, 作为前一个数值保留
+	exceptionPass         // 超过例外偏差或者质量码改变, 连同前一个数值一起通过
+	exceptionForce        // 第一个数值或者超过最大例外间隔, 单独通过
+)
+
+// push 输入一个数值, 返回需要存档的数值
+func (s *compressState) push(tvq TVQ) []TVQ {
+	rtdbType, _ := s.info.ValueType.ToRawType()
+	if !isNumberType(rtdbType) {
+		return []TVQ{tvq}
+	}
+	if s.exception != nil && !tvq.Timestamp.After(s.exception.Timestamp) {
+		return []TVQ{tvq}
+	}
+	if s.previous != nil && !tvq.Timestamp.After(s.previous.Timestamp) {
+		return []TVQ{tvq}
+	}
+	var rtn []TVQ
+	switch s.exceptionTest(tvq) {
+	case exceptionDrop:
+		s.previous = nil
+		return nil
+	case exceptionHold:
+		s.previous = &tvq
+		return nil
+	case exceptionPass:
+		if s.previous != nil {
+			rtn = s.compress(*s.previous)
+		}
+	}
+	s.previous = nil
+	s.exception = &tvq
+	return append(rtn, s.compress(tvq)...)
+}
+
+// exceptionTest 例外判断: 最短例外间隔内丢弃, 变化超过例外偏差或质量码改变时与前一个数值一起通过, 超过最大例外间隔时单独通过
+func (s *compressState) exceptionTest(tvq TVQ) int {
+	if s.exception == nil {
+		return exceptionForce
+	}
+	dt := tvq.Timestamp.Sub(s.exception.Timestamp)
+	if s.info.ExcTimeMin > 0 && dt < seconds(s.info.ExcTimeMin) {
+		return exceptionDrop
+	}
+	dev := deviation(s.info, s.info.ExcDev, s.info.ExcDevPercent)
+	switch {
+	case tvq.Quality != s.exception.Quality, math.Abs(tvqNumber(tvq)-tvqNumber(*s.exception)) > dev:
+		return exceptionPass
+	case s.info.ExcTimeMax > 0 && dt >= seconds(s.info.ExcTimeMax):
+		return exceptionForce
+	}
+	return exceptionHold
+}
+
+// compress 旋转门压缩, 新的数值超出旋转门、质量码改变或者超过最大压缩间隔时存档保留的数值
+func (s *compressState) compress(tvq TVQ) []TVQ {
+	if s.info.Compress != ON {
+		return []TVQ{tvq}
+	}
+	if s.archived == nil {
+		s.archived = &tvq
+		return []TVQ{tvq}
+	}
+	var rtn []TVQ
+	dt := tvq.Timestamp.Sub(s.archived.Timestamp)
+	switch {
+	case s.held == nil:
+	case s.info.CompTimeMin > 0 && dt < seconds(s.info.CompTimeMin):
+		// 最短压缩间隔内不存档, 新的数值直接替换保留的数值
+	case tvq.Quality != s.held.Quality, s.info.CompTimeMax > 0 && dt >= seconds(s.info.CompTimeMax), !s.inDoor(tvq):
+		rtn = append(rtn, *s.held)
+		s.archive()
+	}
+	s.held = &tvq
+	s.narrow(tvq)
+	return rtn
+}
+
+// archive 存档保留的数值, 并以其为起点重新打开旋转门
+func (s *compressState) archive() {
+	s.archived = s.held
+	s.held = nil
+	s.reset()
+}
+
+// reset 打开旋转门
+func (s *compressState) reset() {
+	s.upper = math.Inf(1)
+	s.lower = math.Inf(-1)
+}
+
+// inDoor 从存档的数值到tvq的直线是否在旋转门内
+func (s *compressState) inDoor(tvq TVQ) bool {
+	slope := (tvqNumber(tvq) - tvqNumber(*s.archived)) / tvq.Timestamp.Sub(s.archived.Timestamp).Seconds()
+	return slope >= s.lower && slope <= s.upper
+}
+
+// narrow 按保留的数值收窄旋转门
+func (s *compressState) narrow(tvq TVQ) {
+	dt := tvq.Timestamp.Sub(s.archived.Timestamp).Seconds()
+	if dt <= 0 {
+		return
+	}
+	dev := deviation(s.info, s.info.CompDev, s.info.CompDevPercent)
+	value := tvqNumber(tvq) - tvqNumber(*s.archived)
+	s.upper = math.Min(s.upper, (value+dev)/dt)
+	s.lower = math.Max(s.lower, (value-dev)/dt)
+}
+
+// deviation 偏差, 百分比不为0时按量程换算
+func deviation(info *PointInfo, dev float32, percent float32) float64 {
+	if percent != 0 {
+		return (float64(info.HighLimit) - float64(info.LowLimit)) * float64(percent) / 100
+	}
+	return float64(dev)
+}
+
+// tvqNumber 数值类型的值
+func tvqNumber(tvq TVQ) float64 {
+	rtdbType, _ := tvq.Type.ToRawType()
+	if isFloatType(rtdbType) {
+		return tvq.Value.FloatValue
+	}
+	return float64(tvq.Value.IntValue)
+}
+
+// seconds 秒数转换成 time.Duration
+func seconds(s int32) time.Duration {
+	return time.Duration(s) * time.Second
+}
+
+// WriteCompressed 经过客户端例外与压缩后写入快照, 只写入 Compressor 保留的数值
+//
+// input:
+//   - comp 客户端压缩, 同一个数据源应当使用同一个 Compressor
+//   - ptvqs 数值列表, 同一个标签点的数值按时间升序排列
+//
+// output:
+//   - []PTVQ 写入的数值
+//   - []error 与写入的数值一一对应的错误
+func (c *RtdbConnect) WriteCompressed(comp *Compressor, ptvqs []PTVQ) ([]PTVQ, []error, error) {
+	sends := comp.Filter(ptvqs)
+	if len(sends) == 0 {
+		return sends, make([]error, 0), nil
+	}
+	errs, err := c.WriteSection(false, sends)
+	return sends, errs, err
+}
@@ -0,0 +1,265 @@
+package rtdb_api
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"slices"
+	"testing"
+	"time"
+)
+
+// updateGolden 使用服务端的存档结果更新 testdata/compress_golden.json
+var updateGolden = flag.Bool("update", false, "使用服务端的存档结果更新 testdata/compress_golden.json")
+
+// compressGolden 例外与压缩的期望结果, 由 TestRtdbConnect_CompressorGolden 在服务端录制
+type compressGolden struct {
+	Server string         `json:"server"` // 录制结果的服务端, 为空时尚未录制
+	Cases  []compressCase `json:"cases"`
+}
+
+// compressCase 单个标签点的例外与压缩配置、输入的数值(间隔1秒)与存档的数值序号
+type compressCase struct {
+	Name           string    `json:"name"`
+	Compress       bool      `json:"compress,omitempty"`
+	CompDev        float32   `json:"comp_dev,omitempty"`
+	CompDevPercent float32   `json:"comp_dev_percent,omitempty"`
+	CompTimeMax    int32     `json:"comp_time_max,omitempty"`
+	CompTimeMin    int32     `json:"comp_time_min,omitempty"`
+	ExcDev         float32   `json:"exc_dev,omitempty"`
+	ExcDevPercent  float32   `json:"exc_dev_percent,omitempty"`
+	ExcTimeMax     int32     `json:"exc_time_max,omitempty"`
+	ExcTimeMin     int32     `json:"exc_time_min,omitempty"`
+	HighLimit      float32   `json:"high_limit,omitempty"`
+	Values         []float64 `json:"values"`
+	Bad            []int     `json:"bad,omitempty"` // 质量码为 QualityBad 的数值序号
+	Want           []int     `json:"want"`          // 存档的数值序号, 包括快照(Flush 输出的数值)
+}
+
+// readCompressGolden 读取例外与压缩的期望结果
+func readCompressGolden(t *testing.T) *compressGolden {
+	data, err := os.ReadFile("testdata/compress_golden.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	golden := &compressGolden{}
+	if err := json.Unmarshal(data, golden); err != nil {
+		t.Fatal(err)
+	}
+	return golden
+}
+
+// pointInfo 按配置新建标签点
+func (c *compressCase) pointInfo(name string, table TableID) *PointInfo {
+	info := NewPointInfo(name, table, ValueTypeFloat64, PointBase, RtdbPrecisionMilli, "", "")
+	compress := OFF
+	if c.Compress {
+		compress = ON
+	}
+	high := c.HighLimit
+	if high == 0 {
+		high = 100
+	}
+	info.SetLimit(0, high, 0)
+	info.SetCompress(compress, c.CompDev, c.CompDevPercent, c.CompTimeMax, c.CompTimeMin)
+	info.SetException(c.ExcDev, c.ExcDevPercent, c.ExcTimeMax, c.ExcTimeMin)
+	return info
+}
+
+// tvqs 输入的数值
+func (c *compressCase) tvqs(base time.Time) []TVQ {
+	tvqs := make([]TVQ, len(c.Values))
+	for i, v := range c.Values {
+		quality := QualityGood
+		if slices.Contains(c.Bad, i) {
+			quality = QualityBad
+		}
+		tvqs[i] = NewTvqFloat64(base.Add(time.Duration(i)*time.Second), v, quality)
+	}
+	return tvqs
+}
+
+// 例外与旋转门压缩的保留结果与服务端的存档结果一致
+func TestCompressorGolden(t *testing.T) {
+	golden := readCompressGolden(t)
+	if golden.Server == "" {
+		t.Skip("期望结果尚未在服务端录制, 使用 go test -run TestRtdbConnect_CompressorGolden -update 录制")
+	}
+	base := time.Unix(1700000000, 0)
+	for _, c := range golden.Cases {
+		t.Run(c.Name, func(t *testing.T) {
+			info := c.pointInfo("temp", 1)
+			info.ID = 1
+			comp := NewCompressor()
+			index := make(map[time.Time]int)
+			got := make([]int, 0)
+			for i, tvq := range c.tvqs(base) {
+				index[tvq.Timestamp] = i
+				for _, ptvq := range comp.Filter([]PTVQ{NewPTVQ(info, tvq)}) {
+					got = append(got, index[ptvq.TVQ.Timestamp])
+				}
+			}
+			for _, ptvq := range comp.Flush() {
+				got = append(got, index[ptvq.TVQ.Timestamp])
+			}
+			if !slices.Equal(got, c.Want) {
+				t.Errorf("保留的数值错误, 期望%v实际%v", c.Want, got)
+			}
+		})
+	}
+}
+
+// 逐个写入快照, 由服务端进行例外与压缩, 比较存档与快照的结果; 指定 -update 时录制为期望结果
+func TestRtdbConnect_CompressorGolden(t *testing.T) {
+	conn, err := Login(Hostname, Port, Username, Password)
+	if err != nil {
+		t.Fatal("登录用户失败", err)
+	}
+	defer func() { _ = conn.Logout() }()
+	table, err := conn.CreateTable(fmt.Sprintf("compress_golden_%d", time.Now().Unix()), "压缩录制")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = conn.DeleteTable(table.ID) }()
+
+	golden := readCompressGolden(t)
+	base := time.Now().Add(-time.Hour).Truncate(time.Second)
+	for i := range golden.Cases {
+		c := &golden.Cases[i]
+		info, err := conn.AddPoint(c.pointInfo(fmt.Sprintf("case%d", i), table.ID))
+		if err != nil {
+			t.Fatal(err)
+		}
+		tvqs := c.tvqs(base)
+		index := make(map[int64]int)
+		for j, tvq := range tvqs {
+			index[tvq.Timestamp.UnixMilli()] = j
+			if err := conn.WriteValue(info, false, tvq); err != nil {
+				t.Fatal(err)
+			}
+		}
+		archived, err := conn.ReadArchivedValues(info, base, tvqs[len(tvqs)-1].Timestamp, int32(len(tvqs)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		snapshots, errs, err := conn.ReadSnapshots([]*PointInfo{info})
+		if err != nil || errs[0] != nil {
+			t.Fatal(err, errs)
+		}
+		got := make([]int, 0)
+		for _, tvq := range append(archived, snapshots[0]) {
+			j, ok := index[tvq.Timestamp.UnixMilli()]
+			if ok && !slices.Contains(got, j) {
+				got = append(got, j)
+			}
+		}
+		if *updateGolden {
+			c.Want = got
+		} else if !slices.Equal(got, c.Want) {
+			t.Errorf("%s: 服务端存档的数值错误, 期望%v实际%v", c.Name, c.Want, got)
+		}
+	}
+	if *updateGolden {
+		golden.Server = fmt.Sprintf("%s:%d", Hostname, Port)
+		data, err := json.MarshalIndent(golden, "", "  ")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile("testdata/compress_golden.json", append(data, '\n'), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// 超过例外偏差时先输出前一个数值, 只超过最大例外间隔时单独输出
+func TestCompressorException(t *testing.T) {
+	base := time.Unix(1700000000, 0)
+	info := NewPointInfo("temp", 1, ValueTypeFloat64, PointBase, RtdbPrecisionMilli, "", "")
+	info.ID = 1
+	info.SetCompress(OFF, 0, 0, 0, 0)
+	info.SetException(1, 0, 10, 0)
+	comp := NewCompressor()
+	got := make([]float64, 0)
+	for i, v := range []float64{0, 0.2, 0.4, 3, 3.5, 3.5, 3.5} {
+		ts := base.Add(time.Duration(i) * time.Second)
+		if i == 6 {
+			ts = base.Add(13 * time.Second)
+		}
+		for _, ptvq := range comp.Filter([]PTVQ{NewPTVQ(info, NewTvqFloat64(ts, v, QualityGood))}) {
+			got = append(got, ptvq.TVQ.Value.FloatValue)
+		}
+	}
+	if want := []float64{0, 0.4, 3, 3.5}; !slices.Equal(got, want) {
+		t.Errorf("例外结果错误, 期望%v实际%v", want, got)
+	}
+}
+
+// 整数、非数值类型与乱序的数值
+func TestCompressorTypes(t *testing.T) {
+	base := time.Unix(1700000000, 0)
+	count := NewPointInfo("count", 1, ValueTypeInt32, PointBase, RtdbPrecisionMilli, "", "")
+	count.ID = 1
+	count.SetException(1, 0, 0, 0)
+	name := NewPointInfo("name", 1, ValueTypeString, PointBase, RtdbPrecisionMilli, "", "")
+	name.ID = 2
+
+	comp := NewCompressor()
+	got := comp.Filter([]PTVQ{
+		NewPTVQ(count, NewTvqInt32(base, 0, QualityGood)),
+		NewPTVQ(name, NewTvqString(base, "a", QualityGood)),
+		NewPTVQ(count, NewTvqInt32(base.Add(time.Second), 1, QualityGood)),
+		NewPTVQ(name, NewTvqString(base.Add(time.Second), "a", QualityGood)),
+		NewPTVQ(count, NewTvqInt32(base.Add(2*time.Second), 3, QualityGood)),
+		NewPTVQ(count, NewTvqInt32(base.Add(-time.Second), 100, QualityGood)),
+	})
+	if len(got) != 4 || got[0].TVQ.Value.IntValue != 0 || got[1].PointInfo != name || got[2].PointInfo != name || got[3].TVQ.Value.IntValue != 100 {
+		t.Fatalf("过滤结果错误 %+v", got)
+	}
+	if flushed := comp.Flush(); len(flushed) != 1 || flushed[0].TVQ.Value.IntValue != 3 {
+		t.Fatalf("Flush结果错误 %+v", flushed)
+	}
+	if flushed := comp.Flush(); len(flushed) != 0 {
+		t.Fatalf("重复Flush期望为空 %+v", flushed)
+	}
+}
+
+// 通过内存后端写入压缩后的数值
+func TestWriteCompressed(t *testing.T) {
+	conn, err := LoginWithBackend(NewMemoryBackend(), "127.0.0.1", Port, Username, Password)
+	if err != nil {
+		t.Fatal("登录用户失败", err)
+	}
+	defer func() { _ = conn.Logout() }()
+	table, err := conn.CreateTable("compress", "压缩")
+	if err != nil {
+		t.Fatal(err)
+	}
+	info := NewPointInfo("temp", table.ID, ValueTypeFloat64, PointBase, RtdbPrecisionMilli, "", "")
+	info.SetException(0, 0, 0, 0)
+	info.SetCompress(ON, 0.5, 0, 0, 0)
+	if info, err = conn.AddPoint(info); err != nil {
+		t.Fatal(err)
+	}
+
+	base := time.Unix(1700000000, 0)
+	comp := NewCompressor()
+	ptvqs := make([]PTVQ, 0)
+	for i, v := range []float64{0, 1, 2, 3, 2, 1, 0} {
+		ptvqs = append(ptvqs, NewPTVQ(info, NewTvqFloat64(base.Add(time.Duration(i)*time.Second), v, QualityGood)))
+	}
+	sends, errs, err := conn.WriteCompressed(comp, ptvqs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sends) != 2 || len(errs) != 2 || errs[0] != nil || errs[1] != nil {
+		t.Fatal("写入结果错误", sends, errs)
+	}
+	tvqs, err := conn.ReadArchivedValues(info, base, base.Add(time.Minute), 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tvqs) != 2 || tvqs[1].Value.FloatValue != 3 {
+		t.Fatalf("存档的数值错误 %+v", tvqs)
+	}
+}
@@ -0,0 +1,423 @@
+package rtdb_api
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// maxIdleCallConns 每个连接最多保留的空闲调用连接个数
+const maxIdleCallConns = 4
+
+// callContext 在ctx的控制下执行一次阻塞调用
+//   - 不可取消的ctx直接在c上调用
+//   - 可取消的ctx在单独的调用连接上调用, 调用连接同一时间只执行一个调用, 结束后放回c的空闲调用连接, 没有空闲的调用连接时重新登录
+//   - ctx带有截止时间时，调用期间会通过 RawRtdbSetTimeoutWarp 将调用连接的超时时间设置为剩余时间，调用结束后恢复
+//   - ctx被取消或超时时，放弃调用结果并通过独立的连接调用 RawRtdbKillConnectionWarp 断开调用连接的Socket，避免调用方无限阻塞，
+//     c以及其他调用不受影响, 被断开的调用连接在调用结束后登出
+func callContext[T any](ctx context.Context, c *RtdbConnect, fn func(conn *RtdbConnect) (T, error)) (T, error) {
+	var zero T
+	if err := ctx.Err(); err != nil {
+		return zero, err
+	}
+	if ctx.Done() == nil { /* 不可取消的ctx，直接调用 */
+		return fn(c)
+	}
+
+	conn, err := c.takeCallConn()
+	if err != nil {
+		return zero, err
+	}
+	if conn == nil { /* c已经登出，调用会直接失败 */
+		return fn(c)
+	}
+	release := conn.applyDeadline(ctx)
+
+	type result struct {
+		value T
+		err   error
+	}
+	ch := make(chan result, 1)
+	var mu sync.Mutex
+	finished, killed := false, false
+	go func() {
+		value, err := fn(conn)
+		mu.Lock()
+		finished = true
+		broken := killed || ErrorCategoryOf(err) == ErrorCategoryNetwork
+		mu.Unlock()
+		// 调用真正结束后才恢复超时时间并放回调用连接，ctx取消时同样会恢复
+		release()
+		c.putCallConn(conn, broken)
+		ch <- result{value: value, err: err}
+	}()
+
+	select {
+	case r := <-ch:
+		return r.value, r.err
+	case <-ctx.Done():
+		mu.Lock()
+		if finished {
+			mu.Unlock()
+			r := <-ch
+			return r.value, r.err
+		}
+		killed = true
+		mu.Unlock()
+		c.killSockets(conn)
+		return zero, ctx.Err()
+	}
+}
+
+// callContextErr 同 callContext, 用于只返回error的调用
+func callContextErr(ctx context.Context, c *RtdbConnect, fn func(conn *RtdbConnect) error) error {
+	_, err := callContext(ctx, c, func(conn *RtdbConnect) (struct{}, error) {
+		return struct{}{}, fn(conn)
+	})
+	return err
+}
+
+// callState 带有ctx的调用使用的连接
+type callState struct {
+	mu       sync.Mutex
+	idle     []*RtdbConnect // 空闲的调用连接
+	canceler *RtdbConnect   // 用于断开阻塞调用的独立连接，第一次取消时登录
+	closed   bool           // 已经调用 Logout
+}
+
+// takeCallConn 取出一个空闲的调用连接, 没有时登录新的调用连接
+//   - 主备切换之前登录的空闲调用连接会被登出
+//
+// output:
+//   - *RtdbConnect 调用连接, c已经登出时为nil
+func (c *RtdbConnect) takeCallConn() (*RtdbConnect, error) {
+	endpoint := c.Endpoint()
+	s := &c.calls
+	stale := make([]*RtdbConnect, 0)
+	defer func() {
+		for _, conn := range stale {
+			_ = conn.Logout()
+		}
+	}()
+
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return nil, nil
+	}
+	for len(s.idle) != 0 {
+		conn := s.idle[len(s.idle)-1]
+		s.idle = s.idle[:len(s.idle)-1]
+		if conn.Endpoint() == endpoint {
+			s.mu.Unlock()
+			return conn, nil
+		}
+		stale = append(stale, conn)
+	}
+	s.mu.Unlock()
+
+	conn, err := loginEndpoint(c.backend, endpoint, c.UserName, c.Password)
+	if err != nil {
+		return nil, err
+	}
+	conn.metrics = c.getMetrics()
+	return conn, nil
+}
+
+// putCallConn 放回调用连接, 被断开、主备切换之前登录或者超过 maxIdleCallConns 的调用连接会被登出
+func (c *RtdbConnect) putCallConn(conn *RtdbConnect, broken bool) {
+	s := &c.calls
+	s.mu.Lock()
+	if !broken && !s.closed && len(s.idle) < maxIdleCallConns && conn.Endpoint() == c.Endpoint() {
+		s.idle = append(s.idle, conn)
+		s.mu.Unlock()
+		return
+	}
+	s.mu.Unlock()
+	_ = conn.Logout()
+}
+
+// applyDeadline 根据ctx的截止时间设置调用连接的超时时间, 原超时时间更短时保持不变
+//
+// output:
+//   - func() 调用结束后恢复原超时时间
+func (c *RtdbConnect) applyDeadline(ctx context.Context) func() {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return func() {}
+	}
+
+	timeout := DateTimeType(math.Ceil(time.Until(deadline).Seconds()))
+	if timeout < 1 {
+		timeout = 1
+	}
+	handle := c.handle()
+	saved := make(map[SocketHandle]DateTimeType)
+	for _, socket := range c.SocketHandles {
+		old, rte := c.backend.RawRtdbGetTimeoutWarp(handle, socket)
+		if !RteIsOk(rte) {
+			continue
+		}
+		saved[socket] = old
+		if old == 0 || old > timeout {
+			_ = c.backend.RawRtdbSetTimeoutWarp(handle, socket, timeout)
+		}
+	}
+	return func() {
+		for socket, old := range saved {
+			_ = c.backend.RawRtdbSetTimeoutWarp(handle, socket, old)
+		}
+	}
+}
+
+// killSockets 通过独立的连接断开调用连接的所有Socket，阻塞中的句柄不能用于取消自身的调用
+func (c *RtdbConnect) killSockets(conn *RtdbConnect) {
+	sockets := conn.SocketHandles
+	if len(sockets) == 0 {
+		return
+	}
+
+	s := &c.calls
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.canceler != nil && s.canceler.Endpoint() != conn.Endpoint() { /* 主备切换后重新登录 */
+		_ = s.canceler.Logout()
+		s.canceler = nil
+	}
+	if s.canceler == nil {
+		canceler, err := loginEndpoint(c.backend, conn.Endpoint(), c.UserName, c.Password)
+		if err != nil {
+			return
+		}
+		s.canceler = canceler
+	}
+	for _, socket := range sockets {
+		if rte := c.backend.RawRtdbKillConnectionWarp(s.canceler.handle(), socket); rte.Category() == ErrorCategoryNetwork {
+			// 独立连接已经断开，下次取消时重新登录
+			_ = s.canceler.Logout()
+			s.canceler = nil
+			return
+		}
+	}
+}
+
+// closeCalls 登出空闲的调用连接与用于取消调用的独立连接, 之后结束的调用连接直接登出
+func (c *RtdbConnect) closeCalls() {
+	s := &c.calls
+	s.mu.Lock()
+	conns := s.idle
+	if s.canceler != nil {
+		conns = append(conns, s.canceler)
+	}
+	s.idle, s.canceler, s.closed = nil, nil, true
+	s.mu.Unlock()
+	for _, conn := range conns {
+		_ = conn.Logout()
+	}
+}
+
+// LoginContext 同 Login, 支持通过ctx取消或设置超时, ctx结束后才完成的登录会被自动登出
+func LoginContext(ctx context.Context, hostIp string, port int32, userName string, password string) (*RtdbConnect, error) {
+	return LoginEndpointsContext(ctx, []Endpoint{{HostIp: hostIp, Port: port}}, userName, password)
+}
+
+// LoginEndpointsContext 同 LoginEndpoints, 支持通过ctx取消或设置超时, ctx结束后才完成的登录会被自动登出
+func LoginEndpointsContext(ctx context.Context, endpoints []Endpoint, userName string, password string) (*RtdbConnect, error) {
+	backend, err := defaultBackend()
+	if err != nil {
+		return nil, err
+	}
+	return LoginEndpointsContextWithBackend(ctx, backend, endpoints, userName, password)
+}
+
+// LoginEndpointsContextWithBackend 同 LoginEndpointsWithBackend, 支持通过ctx取消或设置超时, ctx结束后才完成的登录会被自动登出
+func LoginEndpointsContextWithBackend(ctx context.Context, backend Backend, endpoints []Endpoint, userName string, password string) (*RtdbConnect, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	type result struct {
+		conn *RtdbConnect
+		err  error
+	}
+	ch := make(chan result, 1)
+	go func() {
+		conn, err := LoginEndpointsWithBackend(backend, endpoints, userName, password)
+		ch <- result{conn: conn, err: err}
+	}()
+
+	select {
+	case r := <-ch:
+		return r.conn, r.err
+	case <-ctx.Done():
+		go func() {
+			if r := <-ch; r.conn != nil {
+				_ = r.conn.Logout()
+			}
+		}()
+		return nil, ctx.Err()
+	}
+}
+
+// GetTablesContext 同 GetTables, 支持通过ctx取消或设置超时
+func (c *RtdbConnect) GetTablesContext(ctx context.Context) ([]RtdbTable, error) {
+	return callContext(ctx, c, func(conn *RtdbConnect) ([]RtdbTable, error) {
+		return conn.withContext(ctx).GetTables()
+	})
+}
+
+// AddPointContext 同 AddPoint, 支持通过ctx取消或设置超时
+func (c *RtdbConnect) AddPointContext(ctx context.Context, info *PointInfo) (*PointInfo, error) {
+	return callContext(ctx, c, func(conn *RtdbConnect) (*PointInfo, error) {
+		return conn.withContext(ctx).AddPoint(info)
+	})
+}
+
+// UpdatePointContext 同 UpdatePoint, 支持通过ctx取消或设置超时
+func (c *RtdbConnect) UpdatePointContext(ctx context.Context, id PointID, fields map[PointInfoField]any) error {
+	return callContextErr(ctx, c, func(conn *RtdbConnect) error {
+		return conn.withContext(ctx).UpdatePoint(id, fields)
+	})
+}
+
+// DeletePointContext 同 DeletePoint, 支持通过ctx取消或设置超时
+func (c *RtdbConnect) DeletePointContext(ctx context.Context, id PointID) error {
+	return callContextErr(ctx, c, func(conn *RtdbConnect) error {
+		return conn.withContext(ctx).DeletePoint(id)
+	})
+}
+
+// GetPointContext 同 GetPoint, 支持通过ctx取消或设置超时
+func (c *RtdbConnect) GetPointContext(ctx context.Context, id PointID) (*PointInfo, error) {
+	return callContext(ctx, c, func(conn *RtdbConnect) (*PointInfo, error) {
+		return conn.withContext(ctx).GetPoint(id)
+	})
+}
+
+// GetPointsContext 同 GetPoints, 支持通过ctx取消或设置超时
+func (c *RtdbConnect) GetPointsContext(ctx context.Context, ids []PointID) ([]*PointInfo, []error, error) {
+	var errs []error
+	infos, err := callContext(ctx, c, func(conn *RtdbConnect) ([]*PointInfo, error) {
+		infos, es, err := conn.withContext(ctx).GetPoints(ids)
+		errs = es
+		return infos, err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return infos, errs, nil
+}
+
+// FindPointsContext 同 FindPoints, 支持通过ctx取消或设置超时
+func (c *RtdbConnect) FindPointsContext(ctx context.Context, tableDotPoints []string) ([]*PointInfo, []error, error) {
+	var errs []error
+	infos, err := callContext(ctx, c, func(conn *RtdbConnect) ([]*PointInfo, error) {
+		infos, es, err := conn.withContext(ctx).FindPoints(tableDotPoints)
+		errs = es
+		return infos, err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return infos, errs, nil
+}
+
+// SearchPointContext 同 SearchPoint, 支持通过ctx取消或设置超时
+func (c *RtdbConnect) SearchPointContext(ctx context.Context, start int32, count int32, tagMask, tableMask, source, unit, desc, instrument, typeMask string, classOfMask RtdbType, timeUnitMask RtdbPrecision, otherTypeMask RtdbSearch, otherTypeMaskValue string, model RtdbSortFlag) (int32, []*PointInfo, []error, error) {
+	var total int32
+	var errs []error
+	infos, err := callContext(ctx, c, func(conn *RtdbConnect) ([]*PointInfo, error) {
+		n, infos, es, err := conn.withContext(ctx).SearchPoint(start, count, tagMask, tableMask, source, unit, desc, instrument, typeMask, classOfMask, timeUnitMask, otherTypeMask, otherTypeMaskValue, model)
+		total, errs = n, es
+		return infos, err
+	})
+	if err != nil {
+		return 0, nil, nil, err
+	}
+	return total, infos, errs, nil
+}
+
+// GetDirItemListContext 同 GetDirItemList, 支持通过ctx取消或设置超时
+func (c *RtdbConnect) GetDirItemListContext(ctx context.Context, dir string) ([]DirItem, error) {
+	return callContext(ctx, c, func(conn *RtdbConnect) ([]DirItem, error) {
+		return conn.withContext(ctx).GetDirItemList(dir)
+	})
+}
+
+// ReadFileContext 同 ReadFile, 支持通过ctx取消或设置超时
+func (c *RtdbConnect) ReadFileContext(ctx context.Context, path string) ([]byte, error) {
+	return callContext(ctx, c, func(conn *RtdbConnect) ([]byte, error) {
+		return conn.withContext(ctx).ReadFile(path)
+	})
+}
+
+// WriteValueContext 同 WriteValue, 支持通过ctx取消或设置超时
+func (c *RtdbConnect) WriteValueContext(ctx context.Context, info *PointInfo, fix bool, tvq TVQ) error {
+	return callContextErr(ctx, c, func(conn *RtdbConnect) error {
+		return conn.withContext(ctx).WriteValue(info, fix, tvq)
+	})
+}
+
+// WriteValuesContext 同 WriteValues, 支持通过ctx取消或设置超时
+func (c *RtdbConnect) WriteValuesContext(ctx context.Context, info *PointInfo, fix bool, tvqs []TVQ) ([]error, error) {
+	return callContext(ctx, c, func(conn *RtdbConnect) ([]error, error) {
+		return conn.withContext(ctx).WriteValues(info, fix, tvqs)
+	})
+}
+
+// WriteSectionContext 同 WriteSection, 支持通过ctx取消或设置超时
+func (c *RtdbConnect) WriteSectionContext(ctx context.Context, fix bool, ptvqs []PTVQ) ([]error, error) {
+	return callContext(ctx, c, func(conn *RtdbConnect) ([]error, error) {
+		return conn.withContext(ctx).WriteSection(fix, ptvqs)
+	})
+}
+
+// ReadValueContext 同 ReadValue, 支持通过ctx取消或设置超时
+func (c *RtdbConnect) ReadValueContext(ctx context.Context, info *PointInfo, mode RtdbHisMode, timestamp time.Time) (TVQ, error) {
+	return callContext(ctx, c, func(conn *RtdbConnect) (TVQ, error) {
+		return conn.withContext(ctx).ReadValue(info, mode, timestamp)
+	})
+}
+
+// ReadArchivedValuesContext 同 ReadArchivedValues, 支持通过ctx取消或设置超时
+func (c *RtdbConnect) ReadArchivedValuesContext(ctx context.Context, info *PointInfo, start, end time.Time, maxCount int32) ([]TVQ, error) {
+	return callContext(ctx, c, func(conn *RtdbConnect) ([]TVQ, error) {
+		return conn.withContext(ctx).ReadArchivedValues(info, start, end, maxCount)
+	})
+}
+
+// WriteArchivedValuesContext 同 WriteArchivedValues, 支持通过ctx取消或设置超时
+func (c *RtdbConnect) WriteArchivedValuesContext(ctx context.Context, ptvqs []PTVQ) ([]error, error) {
+	return callContext(ctx, c, func(conn *RtdbConnect) ([]error, error) {
+		return conn.withContext(ctx).WriteArchivedValues(ptvqs)
+	})
+}
+
+// ReadSnapshotsContext 同 ReadSnapshots, 支持通过ctx取消或设置超时
+func (c *RtdbConnect) ReadSnapshotsContext(ctx context.Context, infos []*PointInfo) ([]TVQ, []error, error) {
+	var errs []error
+	tvqs, err := callContext(ctx, c, func(conn *RtdbConnect) ([]TVQ, error) {
+		tvqs, es, err := conn.withContext(ctx).ReadSnapshots(infos)
+		errs = es
+		return tvqs, err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return tvqs, errs, nil
+}
+
+// ReadInterpoValuesContext 同 ReadInterpoValues, 支持通过ctx取消或设置超时
+func (c *RtdbConnect) ReadInterpoValuesContext(ctx context.Context, info *PointInfo, start, end time.Time, count int32) ([]TVQ, error) {
+	return callContext(ctx, c, func(conn *RtdbConnect) ([]TVQ, error) {
+		return conn.withContext(ctx).ReadInterpoValues(info, start, end, count)
+	})
+}
+
+// ReadSummaryContext 同 ReadSummary, 支持通过ctx取消或设置超时
+func (c *RtdbConnect) ReadSummaryContext(ctx context.Context, info *PointInfo, start, end time.Time) (*Summary, error) {
+	return callContext(ctx, c, func(conn *RtdbConnect) (*Summary, error) {
+		return conn.withContext(ctx).ReadSummary(info, start, end)
+	})
+}
@@ -0,0 +1,230 @@
+package rtdb_api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+// 带超时的登录、读取表
+func TestRtdbConnect_Context(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	conn, err := LoginContext(ctx, Hostname, Port, Username, Password)
+	if err != nil {
+		t.Fatal("登录用户失败", err)
+	}
+	defer func() { _ = conn.Logout() }()
+
+	tables, err := conn.GetTablesContext(ctx)
+	if err != nil {
+		t.Error("获取表列表失败：", err)
+		return
+	}
+	fmt.Println(tables)
+}
+
+// 使用指定的后端带超时登录
+func TestLoginEndpointsContextWithBackend(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	conn, err := LoginEndpointsContextWithBackend(ctx, NewMemoryBackend(), []Endpoint{{HostIp: "127.0.0.1", Port: Port}}, Username, Password)
+	if err != nil {
+		t.Fatal("登录用户失败", err)
+	}
+	defer func() { _ = conn.Logout() }()
+	if _, err := conn.GetTablesContext(ctx); err != nil {
+		t.Error("获取表列表失败", err)
+	}
+
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := LoginEndpointsContextWithBackend(canceled, NewMemoryBackend(), []Endpoint{{HostIp: "127.0.0.1", Port: Port}}, Username, Password); !errors.Is(err, context.Canceled) {
+		t.Error("已取消的ctx不应该登录", err)
+	}
+}
+
+// killRecorder 记录 RawRtdbKillConnectionWarp 使用的句柄
+type killRecorder struct {
+	Backend
+	mu    sync.Mutex
+	kills []ConnectHandle
+}
+
+func (b *killRecorder) RawRtdbKillConnectionWarp(handle ConnectHandle, socket SocketHandle) RtdbError {
+	b.mu.Lock()
+	b.kills = append(b.kills, handle)
+	b.mu.Unlock()
+	return b.Backend.RawRtdbKillConnectionWarp(handle, socket)
+}
+
+// ctx取消后立即返回，不等待阻塞调用; 只断开该调用使用的调用连接, 原连接与其他调用不受影响
+func TestCallContext_Cancel(t *testing.T) {
+	backend := &killRecorder{Backend: NewMemoryBackend()}
+	conn, err := LoginWithBackend(backend, "127.0.0.1", Port, Username, Password)
+	if err != nil {
+		t.Fatal("登录用户失败", err)
+	}
+	defer func() { _ = conn.Logout() }()
+
+	// 已经取消的ctx不会执行调用
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	called := false
+	_, err = callContext(ctx, conn, func(*RtdbConnect) (int, error) {
+		called = true
+		return 1, nil
+	})
+	if !errors.Is(err, context.Canceled) || called {
+		t.Error("已取消的ctx不应该执行调用", err, called)
+	}
+
+	// 不可取消的ctx直接在原连接上调用
+	v, err := callContext(context.Background(), conn, func(call *RtdbConnect) (int, error) {
+		if call != conn {
+			t.Error("不可取消的ctx应该使用原连接")
+		}
+		return 2, nil
+	})
+	if err != nil || v != 2 {
+		t.Error("调用结果错误", v, err)
+	}
+
+	// 阻塞调用期间取消, 同时进行的另一个调用正常结束
+	blocked := make(chan *RtdbConnect, 1)
+	other := make(chan error, 1)
+	block, proceed := make(chan struct{}), make(chan struct{})
+	released := make(chan struct{})
+	ctx, cancel = context.WithCancel(context.Background())
+	go func() {
+		_, err := callContext(context.WithoutCancel(ctx), conn, func(call *RtdbConnect) (int, error) {
+			<-proceed
+			_, err := call.GetTables()
+			return 0, err
+		})
+		other <- err
+	}()
+	go func() {
+		call := <-blocked
+		if call == conn {
+			t.Error("可取消的ctx应该使用单独的调用连接")
+		}
+		cancel()
+	}()
+	_, err = callContext(ctx, conn, func(call *RtdbConnect) (int, error) {
+		defer close(released)
+		blocked <- call
+		<-block
+		return 1, nil
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatal("阻塞调用应该因ctx取消而返回", err)
+	}
+	close(proceed)
+	if err := <-other; err != nil {
+		t.Error("其他调用不应该受到影响", err)
+	}
+	if _, err := conn.GetTables(); err != nil {
+		t.Error("原连接不应该被断开", err)
+	}
+	backend.mu.Lock()
+	kills := append([]ConnectHandle(nil), backend.kills...)
+	backend.mu.Unlock()
+	if len(kills) != 1 || kills[0] == conn.handle() {
+		t.Error("应该通过独立的连接断开Socket", kills, conn.handle())
+	}
+	close(block)
+	<-released
+}
+
+// 带截止时间的调用只修改调用连接的超时时间, 结束后恢复; 调用连接在调用结束后复用, 被断开的调用连接不会复用
+func TestCallContext_Deadlines(t *testing.T) {
+	conn, err := LoginWithBackend(NewMemoryBackend(), "127.0.0.1", Port, Username, Password)
+	if err != nil {
+		t.Fatal("登录用户失败", err)
+	}
+	timeout := func(c *RtdbConnect) DateTimeType {
+		v, _ := c.backend.RawRtdbGetTimeoutWarp(c.handle(), c.SocketHandles[0])
+		return v
+	}
+	if rte := conn.backend.RawRtdbSetTimeoutWarp(conn.handle(), conn.SocketHandles[0], 300); !RteIsOk(rte) {
+		t.Fatal(rte)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+	var first *RtdbConnect
+	_, err = callContext(ctx, conn, func(call *RtdbConnect) (int, error) {
+		first = call
+		if v := timeout(call); v < 55 || v > 60 {
+			t.Error("调用连接的超时时间错误", v)
+		}
+		if v := timeout(conn); v != 300 {
+			t.Error("不应该修改原连接的超时时间", v)
+		}
+		return 0, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := timeout(first); v != 0 {
+		t.Error("没有恢复调用连接的超时时间", v)
+	}
+	_, _ = callContext(ctx, conn, func(call *RtdbConnect) (int, error) {
+		if call != first {
+			t.Error("应该复用空闲的调用连接")
+		}
+		return 0, nil
+	})
+
+	// 被断开的调用连接登出, 不会放回
+	cancelCtx, cancelCall := context.WithCancel(context.Background())
+	block, released := make(chan struct{}), make(chan struct{})
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		cancelCall()
+	}()
+	var killed *RtdbConnect
+	_, err = callContext(cancelCtx, conn, func(call *RtdbConnect) (int, error) {
+		defer close(released)
+		killed = call
+		<-block
+		return 0, nil
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatal("阻塞调用应该因ctx取消而返回", err)
+	}
+	close(block)
+	<-released
+	for i := 0; i < 100; i++ {
+		if _, rte := killed.backend.RawRtdbHostTime64Warp(killed.handle()); !RteIsOk(rte) {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	conn.calls.mu.Lock()
+	idle := len(conn.calls.idle)
+	conn.calls.mu.Unlock()
+	if killed != first || idle != 0 {
+		t.Error("被断开的调用连接不应该放回", idle)
+	}
+	if _, rte := killed.backend.RawRtdbHostTime64Warp(killed.handle()); RteIsOk(rte) {
+		t.Error("被断开的调用连接应该已经登出")
+	}
+	_, _ = callContext(ctx, conn, func(call *RtdbConnect) (int, error) {
+		first = call
+		return 0, nil
+	})
+
+	// 登出时登出空闲的调用连接
+	if err := conn.Logout(); err != nil {
+		t.Fatal(err)
+	}
+	if _, rte := first.backend.RawRtdbHostTime64Warp(first.handle()); RteIsOk(rte) {
+		t.Error("登出后调用连接应该已经断开")
+	}
+}
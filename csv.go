@@ -0,0 +1,143 @@
+package rtdb_api
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding/simplifiedchinese"
+	"golang.org/x/text/transform"
+)
+
+// CsvEncoding CSV文件的字符编码
+type CsvEncoding int
+
+const (
+	// CsvEncodingAuto 自动识别, 带有BOM或者是合法的UTF-8时按UTF-8读取, 否则按GBK读取
+	CsvEncodingAuto = CsvEncoding(0)
+
+	// CsvEncodingUTF8 UTF-8编码, 可以带有BOM
+	CsvEncodingUTF8 = CsvEncoding(1)
+
+	// CsvEncodingGBK GBK编码, Windows中文版Excel默认保存的格式
+	CsvEncodingGBK = CsvEncoding(2)
+)
+
+// utf8BOM UTF-8的BOM, 写入后Windows中的Excel能够正确识别UTF-8编码
+const utf8BOM = "\xEF\xBB\xBF"
+
+// csvDetectSize 自动识别字符编码时检查的字节数
+const csvDetectSize = 64 * 1024
+
+// newCsvReader 创建CSV读取器, 根据字符编码将内容转换成UTF-8
+//
+// input:
+//   - r 输入
+//   - encoding 字符编码
+//   - comma 分隔符
+func newCsvReader(r io.Reader, encoding CsvEncoding, comma rune) (*csv.Reader, error) {
+	br := bufio.NewReaderSize(r, csvDetectSize)
+	head, err := br.Peek(csvDetectSize)
+	if err != nil && err != io.EOF && err != bufio.ErrBufferFull {
+		return nil, err
+	}
+	if bytes.HasPrefix(head, []byte(utf8BOM)) {
+		_, _ = br.Discard(len(utf8BOM))
+		if encoding == CsvEncodingAuto {
+			encoding = CsvEncodingUTF8
+		}
+	}
+	if encoding == CsvEncodingAuto {
+		encoding = CsvEncodingGBK
+		if validUTF8Prefix(head, len(head) == csvDetectSize) {
+			encoding = CsvEncodingUTF8
+		}
+	}
+
+	var input io.Reader = br
+	switch encoding {
+	case CsvEncodingUTF8:
+	case CsvEncodingGBK:
+		input = transform.NewReader(br, simplifiedchinese.GBK.NewDecoder())
+	default:
+		return nil, fmt.Errorf("未知的CSV字符编码%d", encoding)
+	}
+	reader := csv.NewReader(input)
+	reader.Comma = comma
+	// 分隔符为空白字符(如TSV)时去除前导空白会吞掉空的单元格
+	reader.TrimLeadingSpace = !unicode.IsSpace(comma)
+	return reader, nil
+}
+
+// validUTF8Prefix 判断数据是否为合法的UTF-8, 数据被截断时忽略末尾不完整的字符
+func validUTF8Prefix(data []byte, truncated bool) bool {
+	if utf8.Valid(data) {
+		return true
+	}
+	if !truncated {
+		return false
+	}
+	for i := 1; i < utf8.UTFMax && i < len(data); i++ {
+		if utf8.Valid(data[:len(data)-i]) {
+			return true
+		}
+	}
+	return false
+}
+
+// newCsvWriter 创建CSV写入器, 先写入UTF-8的BOM
+//
+// input:
+//   - w 输出
+//   - comma 分隔符
+func newCsvWriter(w io.Writer, comma rune) (*csv.Writer, error) {
+	if _, err := io.WriteString(w, utf8BOM); err != nil {
+		return nil, err
+	}
+	writer := csv.NewWriter(w)
+	writer.Comma = comma
+	return writer, nil
+}
+
+// csvHeader 解析表头, 返回列名到列序号的映射, 列名不区分大小写
+//
+// input:
+//   - header 表头
+//   - known 判断列名是否合法
+func csvHeader(header []string, known func(name string) bool) (map[string]int, error) {
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if !known(name) {
+			return nil, fmt.Errorf("未知的列%q", header[i])
+		}
+		if _, ok := columns[name]; ok {
+			return nil, fmt.Errorf("重复的列%q", header[i])
+		}
+		columns[name] = i
+	}
+	return columns, nil
+}
+
+// formatFloat32 float32转换成字符串, 能够无损的还原
+func formatFloat32(v float32) string {
+	return strconv.FormatFloat(float64(v), 'g', -1, 32)
+}
+
+// parseSwitch 字符串转换成Switch, 支持 1/0、on/off、true/false、是/否
+func parseSwitch(s string) (Switch, error) {
+	switch strings.ToLower(s) {
+	case "1", "on", "true", "是":
+		return ON, nil
+	case "0", "off", "false", "否":
+		return OFF, nil
+	default:
+		return OFF, fmt.Errorf("%q不是合法的开关值", s)
+	}
+}
@@ -0,0 +1,483 @@
+package rtdb_api
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// ErrPointExists 导入标签点时标签点已经存在
+var ErrPointExists = errors.New("标签点已存在")
+
+// PointFilter 导出标签点的过滤条件, 参见 SearchPoint
+type PointFilter struct {
+	TableMask  string // 表名称掩码, 支持"*"和"?"通配符, 为空时表示所有表
+	TagMask    string // 标签点名称掩码, 支持"*"和"?"通配符, 为空时表示所有标签点
+	Source     string // 数据源, 为空时不作为搜索条件
+	Unit       string // 工程单位, 为空时不作为搜索条件
+	Desc       string // 描述, 为空时不作为搜索条件
+	Instrument string // 设备标签, 为空时不作为搜索条件
+	TypeMask   string // 数值类型名称, 为空时不作为搜索条件
+}
+
+// ImportMode 导入标签点时对已存在标签点的处理方式
+type ImportMode int
+
+const (
+	// ImportModeCreate 只创建标签点, 已存在的标签点记为失败
+	ImportModeCreate = ImportMode(0)
+
+	// ImportModeUpdate 创建不存在的标签点, 更新已存在的标签点
+	ImportModeUpdate = ImportMode(1)
+
+	// ImportModeSkipExisting 创建不存在的标签点, 跳过已存在的标签点
+	ImportModeSkipExisting = ImportMode(2)
+)
+
+// ImportPointsOptions 导入标签点的选项
+type ImportPointsOptions struct {
+	Mode         ImportMode  // 对已存在标签点的处理方式
+	CreateTables bool        // 表不存在时是否自动创建
+	Encoding     CsvEncoding // 字符编码, 默认自动识别UTF-8与GBK
+}
+
+// ImportAction 单行的导入结果
+type ImportAction string
+
+const (
+	// ImportActionCreated 创建了标签点
+	ImportActionCreated = ImportAction("created")
+
+	// ImportActionUpdated 更新了标签点
+	ImportActionUpdated = ImportAction("updated")
+
+	// ImportActionSkipped 标签点已存在, 跳过
+	ImportActionSkipped = ImportAction("skipped")
+
+	// ImportActionFailed 导入失败, 原因参见 ImportPointResult.Err
+	ImportActionFailed = ImportAction("failed")
+)
+
+// ImportPointResult 单行的导入报告
+type ImportPointResult struct {
+	Line        int          // 行号, 从1开始, 表头为第1行
+	TableDotTag string       // 标签点全名
+	ID          PointID      // 标签点ID, 失败时可能为0
+	Action      ImportAction // 导入结果
+	Err         error        // 失败原因
+}
+
+const (
+	// pointColumnTable 表名称列
+	pointColumnTable = "table"
+
+	// pointColumnName 标签点名称列
+	pointColumnName = "name"
+
+	// pointExportPageSize 导出时每次搜索的标签点个数
+	pointExportPageSize = 1000
+)
+
+// pointColumn 标签点CSV文件中的列
+type pointColumn struct {
+	name  string                             // 列名
+	field PointInfoField                     // UpdatePoint 使用的字段, 为空时表示不能修改
+	get   func(p *PointInfo) string          // 导出
+	set   func(p *PointInfo, s string) error // 导入
+	value func(p *PointInfo) any             // UpdatePoint 使用的数值
+}
+
+// newPointColumn 创建列
+//
+// input:
+//   - name 列名
+//   - field UpdatePoint 使用的字段
+//   - ptr 获取属性的指针
+//   - format 属性转换成字符串
+//   - parse 字符串转换成属性
+func newPointColumn[T any](name string, field PointInfoField, ptr func(p *PointInfo) *T, format func(T) string, parse func(string) (T, error)) pointColumn {
+	return pointColumn{
+		name:  name,
+		field: field,
+		get:   func(p *PointInfo) string { return format(*ptr(p)) },
+		set: func(p *PointInfo, s string) error {
+			v, err := parse(s)
+			if err != nil {
+				return err
+			}
+			*ptr(p) = v
+			return nil
+		},
+		value: func(p *PointInfo) any { return *ptr(p) },
+	}
+}
+
+// formatInt 整数转换成字符串
+func formatInt[T ~int8 | ~int16 | ~int32 | ~uint8](v T) string {
+	return strconv.FormatInt(int64(v), 10)
+}
+
+// intParser 返回指定位数的整数解析函数
+func intParser[T ~int8 | ~int16 | ~int32](bits int) func(string) (T, error) {
+	return func(s string) (T, error) {
+		v, err := strconv.ParseInt(s, 10, bits)
+		return T(v), err
+	}
+}
+
+// uintParser 返回指定位数的无符号整数解析函数
+func uintParser[T ~uint8](bits int) func(string) (T, error) {
+	return func(s string) (T, error) {
+		v, err := strconv.ParseUint(s, 10, bits)
+		return T(v), err
+	}
+}
+
+// parseFloat32 字符串转换成float32
+func parseFloat32(s string) (float32, error) {
+	v, err := strconv.ParseFloat(s, 32)
+	return float32(v), err
+}
+
+// parseString 字符串原样返回
+func parseString(s string) (string, error) {
+	return s, nil
+}
+
+// formatString 字符串原样返回
+func formatString(s string) string {
+	return s
+}
+
+// formatSwitch Switch转换成字符串
+func formatSwitch(s Switch) string {
+	return strconv.Itoa(int(s))
+}
+
+// parseValueType 字符串转换成数值类型
+func parseValueType(s string) (ValueType, error) {
+	vt := ValueType("")
+	err := vt.UnmarshalText([]byte(s))
+	return vt, err
+}
+
+// parsePointClass 字符串转换成标签点类别, 支持名称与数字
+func parsePointClass(s string) (PointClass, error) {
+	class := PointClass(0)
+	err := class.UnmarshalText([]byte(s))
+	return class, err
+}
+
+// pointColumns 除表名称与标签点名称外的所有列, 顺序即为导出的顺序
+var pointColumns = func() []pointColumn {
+	columns := []pointColumn{
+		newPointColumn("value_type", "", func(p *PointInfo) *ValueType { return &p.ValueType }, func(v ValueType) string { return string(v) }, parseValueType),
+		newPointColumn("class", PointInfoFieldClass, func(p *PointInfo) *PointClass { return &p.Class }, PointClass.String, parsePointClass),
+		newPointColumn("precision", "", func(p *PointInfo) *RtdbPrecision { return &p.Precision }, formatInt[RtdbPrecision], intParser[RtdbPrecision](8)),
+		newPointColumn(string(PointInfoFieldDesc), PointInfoFieldDesc, func(p *PointInfo) *string { return &p.Desc }, formatString, parseString),
+		newPointColumn(string(PointInfoFieldUnit), PointInfoFieldUnit, func(p *PointInfo) *string { return &p.Unit }, formatString, parseString),
+		newPointColumn(string(PointInfoFieldArchive), PointInfoFieldArchive, func(p *PointInfo) *Switch { return &p.Archive }, formatSwitch, parseSwitch),
+		newPointColumn(string(PointInfoFieldDigits), PointInfoFieldDigits, func(p *PointInfo) *int16 { return &p.Digits }, formatInt[int16], intParser[int16](16)),
+		newPointColumn(string(PointInfoFieldShutdown), PointInfoFieldShutdown, func(p *PointInfo) *Switch { return &p.Shutdown }, formatSwitch, parseSwitch),
+		newPointColumn(string(PointInfoFieldLowLimit), PointInfoFieldLowLimit, func(p *PointInfo) *float32 { return &p.LowLimit }, formatFloat32, parseFloat32),
+		newPointColumn(string(PointInfoFieldHighLimit), PointInfoFieldHighLimit, func(p *PointInfo) *float32 { return &p.HighLimit }, formatFloat32, parseFloat32),
+		newPointColumn(string(PointInfoFieldStep), PointInfoFieldStep, func(p *PointInfo) *Switch { return &p.Step }, formatSwitch, parseSwitch),
+		newPointColumn(string(PointInfoFieldTypical), PointInfoFieldTypical, func(p *PointInfo) *float32 { return &p.Typical }, formatFloat32, parseFloat32),
+		newPointColumn(string(PointInfoFieldCompress), PointInfoFieldCompress, func(p *PointInfo) *Switch { return &p.Compress }, formatSwitch, parseSwitch),
+		newPointColumn(string(PointInfoFieldCompDev), PointInfoFieldCompDev, func(p *PointInfo) *float32 { return &p.CompDev }, formatFloat32, parseFloat32),
+		newPointColumn(string(PointInfoFieldCompDevPercent), PointInfoFieldCompDevPercent, func(p *PointInfo) *float32 { return &p.CompDevPercent }, formatFloat32, parseFloat32),
+		newPointColumn(string(PointInfoFieldCompTimeMax), PointInfoFieldCompTimeMax, func(p *PointInfo) *int32 { return &p.CompTimeMax }, formatInt[int32], intParser[int32](32)),
+		newPointColumn(string(PointInfoFieldCompTimeMin), PointInfoFieldCompTimeMin, func(p *PointInfo) *int32 { return &p.CompTimeMin }, formatInt[int32], intParser[int32](32)),
+		newPointColumn(string(PointInfoFieldExcDev), PointInfoFieldExcDev, func(p *PointInfo) *float32 { return &p.ExcDev }, formatFloat32, parseFloat32),
+		newPointColumn(string(PointInfoFieldExcDevPercent), PointInfoFieldExcDevPercent, func(p *PointInfo) *float32 { return &p.ExcDevPercent }, formatFloat32, parseFloat32),
+		newPointColumn(string(PointInfoFieldExcTimeMax), PointInfoFieldExcTimeMax, func(p *PointInfo) *int32 { return &p.ExcTimeMax }, formatInt[int32], intParser[int32](32)),
+		newPointColumn(string(PointInfoFieldExcTimeMin), PointInfoFieldExcTimeMin, func(p *PointInfo) *int32 { return &p.ExcTimeMin }, formatInt[int32], intParser[int32](32)),
+		newPointColumn(string(PointInfoFieldMirror), PointInfoFieldMirror, func(p *PointInfo) *RtdbMirror { return &p.Mirror }, formatInt[RtdbMirror], intParser[RtdbMirror](8)),
+		newPointColumn(string(PointInfoFieldSummary), PointInfoFieldSummary, func(p *PointInfo) *Switch { return &p.Summary }, formatSwitch, parseSwitch),
+		newPointColumn(string(PointInfoFieldSource), PointInfoFieldSource, func(p *PointInfo) *string { return &p.Source }, formatString, parseString),
+		newPointColumn(string(PointInfoFieldScan), PointInfoFieldScan, func(p *PointInfo) *Switch { return &p.Scan }, formatSwitch, parseSwitch),
+		newPointColumn(string(PointInfoFieldInstrument), PointInfoFieldInstrument, func(p *PointInfo) *string { return &p.Instrument }, formatString, parseString),
+	}
+	// 数组属性每个元素一列, 修改时整体更新
+	for i := range int(RtdbConstLocationsSize) {
+		column := newPointColumn(fmt.Sprintf("location%d", i+1), PointInfoFieldLocations, func(p *PointInfo) *int32 { return &p.Locations[i] }, formatInt[int32], intParser[int32](32))
+		column.value = func(p *PointInfo) any { return p.Locations }
+		columns = append(columns, column)
+	}
+	for i := range int(RtdbConstUserintSize) {
+		column := newPointColumn(fmt.Sprintf("user_int%d", i+1), PointInfoFieldUserInts, func(p *PointInfo) *int32 { return &p.UserInts[i] }, formatInt[int32], intParser[int32](32))
+		column.value = func(p *PointInfo) any { return p.UserInts }
+		columns = append(columns, column)
+	}
+	for i := range int(RtdbConstUserrealSize) {
+		column := newPointColumn(fmt.Sprintf("user_real%d", i+1), PointInfoFieldUserReals, func(p *PointInfo) *float32 { return &p.UserReals[i] }, formatFloat32, parseFloat32)
+		column.value = func(p *PointInfo) any { return p.UserReals }
+		columns = append(columns, column)
+	}
+	return append(columns,
+		newPointColumn(string(PointInfoFieldEquation), PointInfoFieldEquation, func(p *PointInfo) *string { return &p.Equation }, formatString, parseString),
+		newPointColumn(string(PointInfoFieldTrigger), PointInfoFieldTrigger, func(p *PointInfo) *RtdbTrigger { return &p.Trigger }, formatInt[RtdbTrigger], uintParser[RtdbTrigger](8)),
+		newPointColumn(string(PointInfoFieldTimeCopy), PointInfoFieldTimeCopy, func(p *PointInfo) *RtdbTimeCopy { return &p.TimeCopy }, formatInt[RtdbTimeCopy], uintParser[RtdbTimeCopy](8)),
+		newPointColumn(string(PointInfoFieldPeriod), PointInfoFieldPeriod, func(p *PointInfo) *int32 { return &p.Period }, formatInt[int32], intParser[int32](32)),
+	)
+}()
+
+// pointColumnKnown 判断列名是否合法
+func pointColumnKnown(name string) bool {
+	if name == pointColumnTable || name == pointColumnName {
+		return true
+	}
+	for _, column := range pointColumns {
+		if column.name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// ExportPoints 将标签点配置导出为CSV, 编码为带有BOM的UTF-8, 可以直接用Excel打开
+//   - 第一行为表头, 依次为table、name、value_type、class、precision以及其他可配置属性
+//   - 导出的文件可以通过 ImportPoints 导入
+//
+// input:
+//   - w 输出
+//   - filter 过滤条件
+//
+// output:
+//   - int(count) 导出的标签点个数
+func (c *RtdbConnect) ExportPoints(w io.Writer, filter PointFilter) (int, error) {
+	writer, err := newCsvWriter(w, ',')
+	if err != nil {
+		return 0, err
+	}
+	header := []string{pointColumnTable, pointColumnName}
+	for _, column := range pointColumns {
+		header = append(header, column.name)
+	}
+	if err := writer.Write(header); err != nil {
+		return 0, err
+	}
+
+	tableMask, tagMask := filter.TableMask, filter.TagMask
+	if tableMask == "" {
+		tableMask = "*"
+	}
+	if tagMask == "" {
+		tagMask = "*"
+	}
+	count := 0
+	for start := int32(0); ; {
+		total, infos, errs, err := c.SearchPoint(start, pointExportPageSize, tagMask, tableMask, filter.Source, filter.Unit, filter.Desc, filter.Instrument, filter.TypeMask, RtdbTypeAny, RtdbPrecisionAny, RtdbSearchNull, "", 0)
+		if err != nil {
+			return count, err
+		}
+		for i, info := range infos {
+			if errs[i] != nil {
+				return count, errs[i]
+			}
+			table, _, _ := strings.Cut(info.TableDotTag, ".")
+			record := []string{table, info.Name}
+			for _, column := range pointColumns {
+				record = append(record, column.get(info))
+			}
+			if err := writer.Write(record); err != nil {
+				return count, err
+			}
+			count++
+		}
+		start += int32(len(infos))
+		if len(infos) == 0 || start >= total {
+			break
+		}
+	}
+	writer.Flush()
+	return count, writer.Error()
+}
+
+// ImportPoints 从CSV导入标签点配置, 格式参见 ExportPoints
+//   - 表头决定列的顺序, 只有table与name是必须的, 列名不区分大小写
+//   - 创建标签点时value_type是必须的, 空白单元格使用 NewPointInfo 的默认值, class默认为base, precision默认为毫秒
+//   - 更新标签点时空白单元格保持原值, value_type与precision不能修改
+//   - 单行失败不会中断导入, 失败原因记录在报告中
+//
+// input:
+//   - r 输入
+//   - opts 导入选项
+//
+// output:
+//   - []ImportPointResult(results) 每一行数据的导入报告
+//   - error 表头错误或读取失败
+func (c *RtdbConnect) ImportPoints(r io.Reader, opts ImportPointsOptions) ([]ImportPointResult, error) {
+	reader, err := newCsvReader(r, opts.Encoding, ',')
+	if err != nil {
+		return nil, err
+	}
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	columns, err := csvHeader(header, pointColumnKnown)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := columns[pointColumnTable]; !ok {
+		return nil, errors.New("缺少table列")
+	}
+	if _, ok := columns[pointColumnName]; !ok {
+		return nil, errors.New("缺少name列")
+	}
+
+	tables, err := c.GetTables()
+	if err != nil {
+		return nil, err
+	}
+	tableIDs := make(map[string]TableID, len(tables))
+	for _, table := range tables {
+		tableIDs[table.Name] = table.ID
+	}
+
+	results := make([]ImportPointResult, 0)
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			results = append(results, ImportPointResult{Line: parseErr.Line, Action: ImportActionFailed, Err: err})
+			continue
+		}
+		if err != nil {
+			return results, err
+		}
+		line, _ := reader.FieldPos(0)
+		result := c.importPoint(record, columns, tableIDs, opts)
+		result.Line = line
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// importPoint 导入一行数据
+func (c *RtdbConnect) importPoint(record []string, columns map[string]int, tableIDs map[string]TableID, opts ImportPointsOptions) ImportPointResult {
+	cell := func(name string) string {
+		if i, ok := columns[name]; ok {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+	table, name := cell(pointColumnTable), cell(pointColumnName)
+	result := ImportPointResult{TableDotTag: table + "." + name, Action: ImportActionFailed}
+	if table == "" || name == "" {
+		result.Err = errors.New("表名称与标签点名称不能为空")
+		return result
+	}
+
+	existing, err := c.findPoint(result.TableDotTag)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+
+	if existing != nil {
+		result.ID = existing.ID
+		switch opts.Mode {
+		case ImportModeSkipExisting:
+			result.Action = ImportActionSkipped
+			return result
+		case ImportModeUpdate:
+		default:
+			result.Err = fmt.Errorf("%w: %s", ErrPointExists, result.TableDotTag)
+			return result
+		}
+
+		info := *existing
+		fields := make(map[PointInfoField]any)
+		for _, column := range pointColumns {
+			s := cell(column.name)
+			if s == "" {
+				continue
+			}
+			if err := column.set(&info, s); err != nil {
+				result.Err = fmt.Errorf("%s列: %w", column.name, err)
+				return result
+			}
+			if column.field == "" {
+				if column.get(&info) != column.get(existing) {
+					result.Err = fmt.Errorf("%s列: 已存在的标签点不能修改", column.name)
+					return result
+				}
+				continue
+			}
+			fields[column.field] = column.value(&info)
+		}
+		if len(fields) != 0 {
+			if err := c.UpdatePoint(existing.ID, fields); err != nil {
+				result.Err = err
+				return result
+			}
+		}
+		result.Action = ImportActionUpdated
+		return result
+	}
+
+	if cell("value_type") == "" {
+		result.Err = errors.New("创建标签点时value_type不能为空")
+		return result
+	}
+	tableID, ok := tableIDs[table]
+	if !ok {
+		if !opts.CreateTables {
+			result.Err = c.opError("ImportPoints", RteTableNotFound, 0)
+			return result
+		}
+		created, err := c.CreateTable(table, "")
+		if err != nil {
+			result.Err = err
+			return result
+		}
+		tableID = created.ID
+		tableIDs[table] = tableID
+	}
+	info := NewPointInfo(name, tableID, "", PointBase, RtdbPrecisionMilli, "", "")
+	for _, column := range pointColumns {
+		s := cell(column.name)
+		if s == "" {
+			continue
+		}
+		if err := column.set(info, s); err != nil {
+			result.Err = fmt.Errorf("%s列: %w", column.name, err)
+			return result
+		}
+	}
+	created, err := c.AddPoint(info)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+	result.ID, result.Action = created.ID, ImportActionCreated
+	return result
+}
+
+// findPoint 根据全名查找标签点, 标签点或表不存在时返回nil
+func (c *RtdbConnect) findPoint(tableDotTag string) (*PointInfo, error) {
+	handle, release := c.acquire()
+	defer release()
+	ids, _, _, _, rtes, rte := c.backend.RawRtdbbFindPointsExWarp(handle, []string{tableDotTag})
+	if !RteIsOk(rte) {
+		return nil, c.opError("FindPoints", rte, 0)
+	}
+	if rtes[0] == RtePointNotFound || rtes[0] == RteTableNotFound {
+		return nil, nil
+	}
+	if !RteIsOk(rtes[0]) {
+		return nil, c.opError("FindPoints", rtes[0], 0)
+	}
+	return c.GetPoint(ids[0])
+}
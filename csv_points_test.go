@@ -0,0 +1,110 @@
+package rtdb_api
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"golang.org/x/text/encoding/simplifiedchinese"
+)
+
+// 通过CSV导入、导出标签点配置
+func TestRtdbConnect_ImportExportPoints(t *testing.T) {
+	conn, err := LoginWithBackend(NewMemoryBackend(), "127.0.0.1", Port, Username, Password)
+	if err != nil {
+		t.Fatal("登录用户失败", err)
+	}
+	defer func() { _ = conn.Logout() }()
+	if _, err := conn.CreateTable("plant", "工厂"); err != nil {
+		t.Fatal(err)
+	}
+
+	src := "table,name,value_type,class,precision,desc,unit,high_limit,location2,user_real1,equation\n" +
+		"plant,temp,float32,scan,1,温度,℃,150,7,0.5,\n" +
+		"plant,flow,int32,calc,0,流量,,,,,'plant.temp' * 2\n" +
+		"plant,bad,float32,,,,,abc,,,\n" +
+		"plant,untyped,,,,,,,,,\n" +
+		"other,temp,float32,,,,,,,,\n"
+	results, err := conn.ImportPoints(strings.NewReader(src), ImportPointsOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 5 {
+		t.Fatal("导入报告错误", results)
+	}
+	wants := []ImportAction{ImportActionCreated, ImportActionCreated, ImportActionFailed, ImportActionFailed, ImportActionFailed}
+	for i, want := range wants {
+		if results[i].Action != want || results[i].Line != i+2 {
+			t.Errorf("第%d行: %+v", i+2, results[i])
+		}
+	}
+	if !errors.Is(results[4].Err, RteTableNotFound) {
+		t.Error("期望表不存在", results[4].Err)
+	}
+
+	temp, err := conn.GetPoint(results[0].ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if temp.Desc != "温度" || temp.Unit != "℃" || temp.HighLimit != 150 || temp.Locations[1] != 7 || temp.UserReals[0] != 0.5 || temp.Precision != RtdbPrecisionMilli {
+		t.Errorf("标签点属性错误: %+v", temp)
+	}
+
+	if flow, err := conn.GetPoint(results[1].ID); err != nil || flow.Equation != "'plant.temp' * 2" || !flow.Class.IsCalc() {
+		t.Error("计算点属性错误", flow, err)
+	}
+
+	// 导出后修改描述, 再以更新模式导入
+	buf := bytes.Buffer{}
+	count, err := conn.ExportPoints(&buf, PointFilter{TableMask: "plant"})
+	if err != nil || count != 2 {
+		t.Fatal("导出失败", count, err)
+	}
+	if !strings.HasPrefix(buf.String(), utf8BOM+"table,name,value_type,class,precision,desc") {
+		t.Errorf("表头错误: %q", buf.String()[:64])
+	}
+	exported := strings.Replace(buf.String(), "温度", "新温度", 1)
+	results, err = conn.ImportPoints(strings.NewReader(exported), ImportPointsOptions{Mode: ImportModeUpdate})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, result := range results {
+		if result.Action != ImportActionUpdated {
+			t.Errorf("期望更新: %+v", result)
+		}
+	}
+	if temp, _ = conn.GetPoint(temp.ID); temp.Desc != "新温度" || temp.Locations[1] != 7 {
+		t.Errorf("更新失败: %+v", temp)
+	}
+
+	results, err = conn.ImportPoints(strings.NewReader(exported), ImportPointsOptions{Mode: ImportModeSkipExisting})
+	if err != nil || results[0].Action != ImportActionSkipped {
+		t.Error("期望跳过", results, err)
+	}
+	results, err = conn.ImportPoints(strings.NewReader(exported), ImportPointsOptions{})
+	if err != nil || !errors.Is(results[0].Err, ErrPointExists) {
+		t.Error("期望标签点已存在", results, err)
+	}
+	results, err = conn.ImportPoints(strings.NewReader("table,name,precision\nplant,temp,3\n"), ImportPointsOptions{Mode: ImportModeUpdate})
+	if err != nil || results[0].Action != ImportActionFailed {
+		t.Error("期望不能修改时间戳精度", results, err)
+	}
+
+	// Windows中Excel保存的GBK编码文件, 自动创建表
+	gbk, err := simplifiedchinese.GBK.NewEncoder().String("table,name,value_type,desc\n车间,压力,float64,出口压力\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	results, err = conn.ImportPoints(strings.NewReader(gbk), ImportPointsOptions{CreateTables: true})
+	if err != nil || results[0].Action != ImportActionCreated {
+		t.Fatal("导入GBK文件失败", results, err)
+	}
+	if info, err := conn.GetPoint(results[0].ID); err != nil || info.TableDotTag != "车间.压力" || info.Desc != "出口压力" {
+		t.Error("GBK解码错误", info, err)
+	}
+
+	if _, err := conn.ImportPoints(strings.NewReader("table,name,unknown\n"), ImportPointsOptions{}); err == nil {
+		t.Error("期望未知的列")
+	}
+}
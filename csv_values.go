@@ -0,0 +1,499 @@
+package rtdb_api
+
+import (
+	"encoding/base64"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ValuesLayout 历史数据CSV文件的布局
+type ValuesLayout int
+
+const (
+	// ValuesLayoutLong 长表, 每行一个数值, 列依次为tag、time、value、quality
+	ValuesLayoutLong = ValuesLayout(0)
+
+	// ValuesLayoutWide 宽表, 每行一个时刻, 第一列为time, 其余每个标签点依次为数值列和质量码列
+	ValuesLayoutWide = ValuesLayout(1)
+)
+
+// ValuesFormat 历史数据CSV文件的格式
+type ValuesFormat struct {
+	// Layout 布局
+	Layout ValuesLayout
+
+	// Comma 分隔符, 为0时使用逗号, 使用'\t'即为TSV
+	Comma rune
+
+	// Encoding 导入时的字符编码, 导出时总是使用带有BOM的UTF-8
+	Encoding CsvEncoding
+}
+
+// comma 返回分隔符
+func (f ValuesFormat) comma() rune {
+	if f.Comma == 0 {
+		return ','
+	}
+	return f.Comma
+}
+
+const (
+	valueColumnTag     = "tag"
+	valueColumnTime    = "time"
+	valueColumnValue   = "value"
+	valueColumnQuality = "quality"
+
+	// valueQualitySuffix 宽表中质量码列的后缀, 列名为"表名.标签点名:quality"
+	valueQualitySuffix = ":" + valueColumnQuality
+)
+
+// valuesImportBatchSize ImportValues 每次写入的数值个数
+const valuesImportBatchSize = 1000
+
+// ImportValueError 导入历史数据时单个数值的错误
+type ImportValueError struct {
+	// Line 行号, 从1开始
+	Line int
+
+	// TableDotTag 标签点全名, 无法解析时为空
+	TableDotTag string
+
+	// Err 错误原因
+	Err error
+}
+
+func (e *ImportValueError) Error() string {
+	if e.TableDotTag == "" {
+		return fmt.Sprintf("第%d行: %v", e.Line, e.Err)
+	}
+	return fmt.Sprintf("第%d行 %s: %v", e.Line, e.TableDotTag, e.Err)
+}
+
+func (e *ImportValueError) Unwrap() error {
+	return e.Err
+}
+
+// formatCsvTime 时间转换成字符串
+func formatCsvTime(t time.Time) string {
+	return t.Format(time.RFC3339Nano)
+}
+
+// parseCsvTime 字符串转换成时间, 支持RFC3339以及本地时区的"2006-01-02 15:04:05.999999999"
+func parseCsvTime(s string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339Nano, s); err == nil {
+		return t, nil
+	}
+	t, err := time.ParseInLocation("2006-01-02 15:04:05.999999999", s, time.Local)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%q不是合法的时间", s)
+	}
+	return t, nil
+}
+
+// formatCsvValue 数值转换成字符串
+//   - bool: true/false
+//   - 整数与浮点数: 十进制数字
+//   - coor: x,y
+//   - string与datetime: 原样输出
+//   - blob与自定义类型: base64
+func formatCsvValue(tvq *TVQ) string {
+	rtdbType, _ := tvq.Type.ToRawType()
+	switch rtdbType {
+	case RtdbTypeBool:
+		return strconv.FormatBool(Int64ToBool(tvq.Value.IntValue))
+	case RtdbTypeUint8, RtdbTypeInt8, RtdbTypeChar, RtdbTypeUint16, RtdbTypeInt16, RtdbTypeUint32, RtdbTypeInt32, RtdbTypeInt64:
+		return strconv.FormatInt(tvq.Value.IntValue, 10)
+	case RtdbTypeReal16, RtdbTypeReal32, RtdbTypeFp16, RtdbTypeFp32:
+		return strconv.FormatFloat(tvq.Value.FloatValue, 'g', -1, 32)
+	case RtdbTypeReal64, RtdbTypeFp64:
+		return strconv.FormatFloat(tvq.Value.FloatValue, 'g', -1, 64)
+	case RtdbTypeCoor:
+		xy := tvq.Value.CoordinatesValue
+		return formatFloat32(xy.X) + "," + formatFloat32(xy.Y)
+	case RtdbTypeString, RtdbTypeDatetime:
+		return tvq.Value.StringValue
+	default:
+		return base64.StdEncoding.EncodeToString(tvq.Value.BytesValue)
+	}
+}
+
+// parseCsvValue 字符串转换成数值, 格式参见 formatCsvValue
+func parseCsvValue(vt ValueType, ts time.Time, s string, quality Quality) (TVQ, error) {
+	tvq := TVQ{Timestamp: ts, Type: vt, Quality: quality}
+	rtdbType, _ := vt.ToRawType()
+	var err error
+	switch rtdbType {
+	case RtdbTypeBool:
+		var b bool
+		b, err = strconv.ParseBool(s)
+		tvq.Value.IntValue = BoolToInt64(b)
+	case RtdbTypeUint8, RtdbTypeInt8, RtdbTypeChar, RtdbTypeUint16, RtdbTypeInt16, RtdbTypeUint32, RtdbTypeInt32, RtdbTypeInt64:
+		tvq.Value.IntValue, err = strconv.ParseInt(s, 10, 64)
+	case RtdbTypeReal16, RtdbTypeReal32, RtdbTypeReal64, RtdbTypeFp16, RtdbTypeFp32, RtdbTypeFp64:
+		tvq.Value.FloatValue, err = strconv.ParseFloat(s, 64)
+	case RtdbTypeCoor:
+		x, y, ok := strings.Cut(s, ",")
+		if !ok {
+			return TVQ{}, fmt.Errorf("%q不是合法的坐标", s)
+		}
+		xy := Coordinates{}
+		if xy.X, err = parseFloat32(strings.TrimSpace(x)); err == nil {
+			xy.Y, err = parseFloat32(strings.TrimSpace(y))
+		}
+		tvq.Value.CoordinatesValue = xy
+	case RtdbTypeString, RtdbTypeDatetime:
+		tvq.Value.StringValue = s
+	default:
+		tvq.Value.BytesValue, err = base64.StdEncoding.DecodeString(s)
+	}
+	if err != nil {
+		return TVQ{}, fmt.Errorf("%s类型的数值格式错误: %w", vt, err)
+	}
+	return tvq, nil
+}
+
+// ExportValues 将多个标签点一段时间内的历史数据导出为CSV, 编码为带有BOM的UTF-8
+//   - 长表: 表头为tag、time、value、quality, 按标签点依次输出, 同一标签点按时间升序
+//   - 宽表: 表头为time以及每个标签点的全名和"全名:quality", 按时间升序每行一个时刻, 没有数值的单元格为空
+//   - 分批读取历史数据并逐行写出, 不会将全部数据读入内存
+//   - 导出的文件可以通过 ImportValues 导入
+//
+// input:
+//   - w 输出
+//   - infos 标签点信息
+//   - start 开始时间(包含)
+//   - end 结束时间(包含)
+//   - format 文件格式
+//
+// output:
+//   - int(count) 导出的数值个数
+func (c *RtdbConnect) ExportValues(w io.Writer, infos []*PointInfo, start, end time.Time, format ValuesFormat) (int, error) {
+	writer, err := newCsvWriter(w, format.comma())
+	if err != nil {
+		return 0, err
+	}
+	count := 0
+	switch format.Layout {
+	case ValuesLayoutLong:
+		count, err = c.exportValuesLong(writer, infos, start, end)
+	case ValuesLayoutWide:
+		count, err = c.exportValuesWide(writer, infos, start, end)
+	default:
+		err = fmt.Errorf("未知的CSV布局%d", format.Layout)
+	}
+	if err != nil {
+		return count, err
+	}
+	writer.Flush()
+	return count, writer.Error()
+}
+
+// exportValuesLong 按长表导出
+func (c *RtdbConnect) exportValuesLong(writer *csv.Writer, infos []*PointInfo, start, end time.Time) (int, error) {
+	if err := writer.Write([]string{valueColumnTag, valueColumnTime, valueColumnValue, valueColumnQuality}); err != nil {
+		return 0, err
+	}
+	count := 0
+	for _, info := range infos {
+		for tvq, err := range c.ArchivedValues(info, start, end) {
+			if err != nil {
+				return count, err
+			}
+			if err := writer.Write([]string{info.TableDotTag, formatCsvTime(tvq.Timestamp), formatCsvValue(&tvq), tvq.Quality.String()}); err != nil {
+				return count, err
+			}
+			count++
+		}
+	}
+	return count, nil
+}
+
+// exportValuesWide 按宽表导出, 同时遍历所有标签点, 每次输出时间最早的一行
+func (c *RtdbConnect) exportValuesWide(writer *csv.Writer, infos []*PointInfo, start, end time.Time) (int, error) {
+	header := []string{valueColumnTime}
+	for _, info := range infos {
+		header = append(header, info.TableDotTag, info.TableDotTag+valueQualitySuffix)
+	}
+	if err := writer.Write(header); err != nil {
+		return 0, err
+	}
+
+	type cursor struct {
+		next func() (TVQ, error, bool)
+		tvq  TVQ
+		ok   bool
+	}
+	cursors := make([]cursor, len(infos))
+	advance := func(cur *cursor) error {
+		tvq, err, ok := cur.next()
+		if err != nil {
+			return err
+		}
+		cur.tvq, cur.ok = tvq, ok
+		return nil
+	}
+	for i, info := range infos {
+		next, stop := iter.Pull2(c.ArchivedValues(info, start, end))
+		defer stop()
+		cursors[i].next = next
+		if err := advance(&cursors[i]); err != nil {
+			return 0, err
+		}
+	}
+
+	count := 0
+	record := make([]string, 2*len(infos)+1)
+	for {
+		var ts time.Time
+		found := false
+		for i := range cursors {
+			if cursors[i].ok && (!found || cursors[i].tvq.Timestamp.Before(ts)) {
+				ts, found = cursors[i].tvq.Timestamp, true
+			}
+		}
+		if !found {
+			return count, nil
+		}
+		record[0] = formatCsvTime(ts)
+		for i := range cursors {
+			record[2*i+1], record[2*i+2] = "", ""
+			if cursors[i].ok && cursors[i].tvq.Timestamp.Equal(ts) {
+				record[2*i+1] = formatCsvValue(&cursors[i].tvq)
+				record[2*i+2] = cursors[i].tvq.Quality.String()
+				count++
+				if err := advance(&cursors[i]); err != nil {
+					return count, err
+				}
+			}
+		}
+		if err := writer.Write(record); err != nil {
+			return count, err
+		}
+	}
+}
+
+// ImportValues 从CSV导入历史数据, 格式参见 ExportValues
+//   - 根据标签点的数值类型解析数值, 质量码为空或者宽表中没有对应的质量码列时为QualityGood
+//   - 空的单元格会被跳过
+//   - 数值通过 WriteArchivedValues 分批写入历史存档, 不经过快照
+//   - 无法解析或写入失败的数值记录在错误列表中, 不影响其他数值
+//
+// input:
+//   - r 输入
+//   - infos 标签点信息, 文件中不在其中的标签点会根据全名查询
+//   - format 文件格式
+//
+// output:
+//   - int(count) 成功写入的数值个数
+//   - []*ImportValueError(errs) 单个数值的错误列表
+func (c *RtdbConnect) ImportValues(r io.Reader, infos []*PointInfo, format ValuesFormat) (int, []*ImportValueError, error) {
+	reader, err := newCsvReader(r, format.Encoding, format.comma())
+	if err != nil {
+		return 0, nil, err
+	}
+	header, err := reader.Read()
+	if err == io.EOF {
+		return 0, nil, nil
+	}
+	if err != nil {
+		return 0, nil, err
+	}
+
+	points := make(map[string]*PointInfo, len(infos))
+	for _, info := range infos {
+		points[info.TableDotTag] = info
+	}
+	lookup := func(tableDotTag string) (*PointInfo, error) {
+		if info, ok := points[tableDotTag]; ok {
+			if info == nil {
+				return nil, RtePointNotFound
+			}
+			return info, nil
+		}
+		info, err := c.findPoint(tableDotTag)
+		if err != nil {
+			return nil, err
+		}
+		points[tableDotTag] = info
+		if info == nil {
+			return nil, RtePointNotFound
+		}
+		return info, nil
+	}
+
+	im := valuesImporter{c: c}
+	var parse func(line int, record []string)
+	switch format.Layout {
+	case ValuesLayoutLong:
+		columns, err := csvHeader(header, func(name string) bool {
+			return name == valueColumnTag || name == valueColumnTime || name == valueColumnValue || name == valueColumnQuality
+		})
+		if err != nil {
+			return 0, nil, err
+		}
+		for _, name := range []string{valueColumnTag, valueColumnTime, valueColumnValue} {
+			if _, ok := columns[name]; !ok {
+				return 0, nil, fmt.Errorf("缺少%s列", name)
+			}
+		}
+		parse = func(line int, record []string) {
+			tag := record[columns[valueColumnTag]]
+			value := record[columns[valueColumnValue]]
+			if value == "" {
+				return
+			}
+			info, err := lookup(tag)
+			if err != nil {
+				im.fail(line, tag, err)
+				return
+			}
+			ts, err := parseCsvTime(record[columns[valueColumnTime]])
+			if err != nil {
+				im.fail(line, tag, err)
+				return
+			}
+			quality := QualityGood
+			if i, ok := columns[valueColumnQuality]; ok && record[i] != "" {
+				if err := quality.UnmarshalText([]byte(record[i])); err != nil {
+					im.fail(line, tag, err)
+					return
+				}
+			}
+			tvq, err := parseCsvValue(info.ValueType, ts, value, quality)
+			if err != nil {
+				im.fail(line, tag, err)
+				return
+			}
+			im.add(line, PTVQ{PointInfo: info, TVQ: tvq})
+		}
+	case ValuesLayoutWide:
+		if len(header) == 0 || strings.ToLower(strings.TrimSpace(header[0])) != valueColumnTime {
+			return 0, nil, fmt.Errorf("第一列必须为%s列", valueColumnTime)
+		}
+		type column struct {
+			tag     string
+			value   int
+			quality int // 质量码列的位置, -1表示没有质量码列
+		}
+		columns := make([]column, 0, len(header)-1)
+		qualities := make(map[string]int)
+		for i, name := range header[1:] {
+			name = strings.TrimSpace(name)
+			if tag, ok := strings.CutSuffix(name, valueQualitySuffix); ok {
+				qualities[tag] = i + 1
+			} else {
+				columns = append(columns, column{tag: name, value: i + 1, quality: -1})
+			}
+		}
+		for i := range columns {
+			if q, ok := qualities[columns[i].tag]; ok {
+				columns[i].quality = q
+			}
+		}
+		parse = func(line int, record []string) {
+			ts, err := parseCsvTime(record[0])
+			if err != nil {
+				im.fail(line, "", err)
+				return
+			}
+			for _, col := range columns {
+				tag, value := col.tag, record[col.value]
+				if value == "" {
+					continue
+				}
+				info, err := lookup(tag)
+				if err != nil {
+					im.fail(line, tag, err)
+					continue
+				}
+				quality := QualityGood
+				if col.quality >= 0 && record[col.quality] != "" {
+					if err := quality.UnmarshalText([]byte(record[col.quality])); err != nil {
+						im.fail(line, tag, err)
+						continue
+					}
+				}
+				tvq, err := parseCsvValue(info.ValueType, ts, value, quality)
+				if err != nil {
+					im.fail(line, tag, err)
+					continue
+				}
+				im.add(line, PTVQ{PointInfo: info, TVQ: tvq})
+			}
+		}
+	default:
+		return 0, nil, fmt.Errorf("未知的CSV布局%d", format.Layout)
+	}
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			im.fail(parseErr.Line, "", err)
+			continue
+		}
+		if err != nil {
+			return im.count, im.errs, err
+		}
+		line, _ := reader.FieldPos(0)
+		parse(line, record)
+		if len(im.ptvqs) >= valuesImportBatchSize {
+			if err := im.flush(); err != nil {
+				return im.count, im.errs, err
+			}
+		}
+	}
+	if err := im.flush(); err != nil {
+		return im.count, im.errs, err
+	}
+	return im.count, im.errs, nil
+}
+
+// valuesImporter 缓存待写入的数值并记录导入结果
+type valuesImporter struct {
+	c     *RtdbConnect
+	ptvqs []PTVQ
+	lines []int
+	count int
+	errs  []*ImportValueError
+}
+
+// fail 记录错误
+func (im *valuesImporter) fail(line int, tableDotTag string, err error) {
+	im.errs = append(im.errs, &ImportValueError{Line: line, TableDotTag: tableDotTag, Err: err})
+}
+
+// add 缓存待写入的数值
+func (im *valuesImporter) add(line int, ptvq PTVQ) {
+	im.ptvqs = append(im.ptvqs, ptvq)
+	im.lines = append(im.lines, line)
+}
+
+// flush 写入缓存的数值
+func (im *valuesImporter) flush() error {
+	if len(im.ptvqs) == 0 {
+		return nil
+	}
+	errs, err := im.c.WriteArchivedValues(im.ptvqs)
+	if err != nil {
+		return err
+	}
+	for i, e := range errs {
+		if e != nil {
+			im.fail(im.lines[i], im.ptvqs[i].PointInfo.TableDotTag, e)
+		} else {
+			im.count++
+		}
+	}
+	im.ptvqs, im.lines = im.ptvqs[:0], im.lines[:0]
+	return nil
+}
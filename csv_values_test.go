@@ -0,0 +1,120 @@
+package rtdb_api
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+// 通过CSV导入、导出历史数据
+func TestRtdbConnect_ImportExportValues(t *testing.T) {
+	newConn := func() (*RtdbConnect, []*PointInfo) {
+		conn, err := LoginWithBackend(NewMemoryBackend(), "127.0.0.1", Port, Username, Password)
+		if err != nil {
+			t.Fatal("登录用户失败", err)
+		}
+		table, err := conn.CreateTable("his", "历史数据")
+		if err != nil {
+			t.Fatal(err)
+		}
+		infos := make([]*PointInfo, 0)
+		for _, vt := range []ValueType{ValueTypeFloat64, ValueTypeCoor, ValueTypeBlob, ValueTypeDatetime} {
+			info, err := conn.AddPoint(NewPointInfo(string(vt), table.ID, vt, PointBase, RtdbPrecisionMilli, "", ""))
+			if err != nil {
+				t.Fatal(err)
+			}
+			infos = append(infos, info)
+		}
+		return conn, infos
+	}
+	src, infos := newConn()
+	defer func() { _ = src.Logout() }()
+
+	// 数值个数超过一批, 检查分批读取
+	start := time.UnixMilli(1700000000000)
+	ptvqs := make([]PTVQ, 0)
+	for i := 0; i < ArchivedValuesPageSize+200; i++ {
+		ptvqs = append(ptvqs, PTVQ{PointInfo: infos[0], TVQ: NewTvqFloat64(start.Add(time.Duration(i)*time.Second), float64(i)/4, QualityGood)})
+	}
+	ptvqs = append(ptvqs,
+		PTVQ{PointInfo: infos[1], TVQ: NewTvqCoordinates(start, 1.5, -2, QualityBad)},
+		PTVQ{PointInfo: infos[2], TVQ: NewTvqBlob(start.Add(time.Second), []byte{0, 1, 2, 255}, QualityGood)},
+		PTVQ{PointInfo: infos[3], TVQ: NewTvqDatetime(start.Add(2*time.Second), "2023-11-14 22:13:20.000", QualityGood)},
+	)
+	errs, err := src.WriteArchivedValues(ptvqs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, e := range errs {
+		if e != nil {
+			t.Fatal(i, e)
+		}
+	}
+	end := start.Add(time.Hour)
+	tvqs, err := src.ReadArchivedValues(infos[0], start, end, 10)
+	if err != nil || len(tvqs) != 10 || tvqs[9].Value.FloatValue != 2.25 {
+		t.Fatal("读取历史数据错误", tvqs, err)
+	}
+
+	for _, format := range []ValuesFormat{{Layout: ValuesLayoutLong}, {Layout: ValuesLayoutWide, Comma: '\t'}} {
+		buf := bytes.Buffer{}
+		count, err := src.ExportValues(&buf, infos, start, end, format)
+		if err != nil || count != len(ptvqs) {
+			t.Fatal("导出历史数据错误", count, err)
+		}
+		exported := buf.String()
+
+		dst, dstInfos := newConn()
+		count, importErrs, err := dst.ImportValues(strings.NewReader(exported), nil, format)
+		if err != nil || count != len(ptvqs) || len(importErrs) != 0 {
+			t.Fatal("导入历史数据错误", count, importErrs, err)
+		}
+		buf.Reset()
+		if _, err := dst.ExportValues(&buf, dstInfos, start, end, format); err != nil {
+			t.Fatal(err)
+		}
+		if buf.String() != exported {
+			t.Errorf("布局%d导入后再导出的结果不一致:\n%s", format.Layout, buf.String()[:200])
+		}
+		if tvq, err := dst.ReadValue(dstInfos[1], RtdbHisModeExact, start); err != nil || tvq.Quality != QualityBad {
+			t.Errorf("布局%d导入后质量码错误 %v %v", format.Layout, tvq, err)
+		}
+		_ = dst.Logout()
+	}
+
+	// 长表中的错误行不影响其他行
+	bad := "tag,time,value,quality\n" +
+		"his.float64,2023-11-14 22:13:20.5,1.5,\n" +
+		"his.float64,2023-11-14T22:13:21Z,abc,good\n" +
+		"his.unknown,2023-11-14T22:13:21Z,1,good\n" +
+		"his.coor,2023-11-14T22:13:21Z,\"3,4\",bad\n"
+	count, importErrs, err := src.ImportValues(strings.NewReader(bad), infos, ValuesFormat{})
+	if err != nil || count != 2 || len(importErrs) != 2 {
+		t.Fatal("导入报告错误", count, importErrs, err)
+	}
+	if importErrs[0].Line != 3 || importErrs[1].Line != 4 || !errors.Is(importErrs[1], RtePointNotFound) {
+		t.Error("错误行号或原因错误", importErrs[0], importErrs[1])
+	}
+	ts := time.Date(2023, 11, 14, 22, 13, 20, 500000000, time.Local)
+	if tvq, err := src.ReadValue(infos[0], RtdbHisModeExact, ts); err != nil || tvq.Value.FloatValue != 1.5 {
+		t.Error("读取导入的数值错误", tvq, err)
+	}
+
+	// 宽表中的质量码列, 没有质量码列的标签点为QualityGood
+	wide := "time,his.float64,his.coor,his.coor:quality\n" +
+		"2023-11-14T22:13:30Z,2.5,\"5,6\",bad\n" +
+		"2023-11-14T22:13:31Z,,\"7,8\",unknown\n"
+	count, importErrs, err = src.ImportValues(strings.NewReader(wide), infos, ValuesFormat{Layout: ValuesLayoutWide})
+	if err != nil || count != 2 || len(importErrs) != 1 || importErrs[0].Line != 3 {
+		t.Fatal("宽表导入报告错误", count, importErrs, err)
+	}
+	ts = time.Date(2023, 11, 14, 22, 13, 30, 0, time.UTC)
+	if tvq, err := src.ReadValue(infos[0], RtdbHisModeExact, ts); err != nil || tvq.Quality != QualityGood {
+		t.Error("没有质量码列时应为QualityGood", tvq, err)
+	}
+	if tvq, err := src.ReadValue(infos[1], RtdbHisModeExact, ts); err != nil || tvq.Quality != QualityBad {
+		t.Error("宽表导入的质量码错误", tvq, err)
+	}
+}
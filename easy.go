@@ -1,14 +1,15 @@
 package rtdb_api
 
-import "C"
 import (
 	"bytes"
 	"errors"
 	"fmt"
 	"golang.org/x/text/encoding/simplifiedchinese"
 	"golang.org/x/text/transform"
+	"iter"
 	"sort"
 	"strconv"
+	"sync"
 	"time"
 )
 
@@ -88,18 +89,18 @@ type SocketInfo struct {
 	User         string       // 登录的用户
 }
 
-func getSocketInfo(handle ConnectHandle, nodeNumber int32, socket SocketHandle) (*SocketInfo, error) {
-	connInfo, rte := RawRtdbGetConnectionInfoIpv6Warp(handle, nodeNumber, socket)
+func getSocketInfo(b Backend, handle ConnectHandle, nodeNumber int32, socket SocketHandle) (*SocketInfo, error) {
+	connInfo, rte := b.RawRtdbGetConnectionInfoIpv6Warp(handle, nodeNumber, socket)
 	if !RteIsOk(rte) {
-		return nil, rte.GoError()
+		return nil, newOpError("GetSocketInfo", rte, 0)
 	}
 	ipAddr := connInfo.IpAddr6
 	if ipAddr == "" {
 		ipAddr = fmt.Sprintf("%d.%d.%d.%d", byte(connInfo.IpAddr>>24), byte(connInfo.IpAddr>>16), byte(connInfo.IpAddr>>8), byte(connInfo.IpAddr))
 	}
-	timeout, rte := RawRtdbGetTimeoutWarp(handle, socket)
+	timeout, rte := b.RawRtdbGetTimeoutWarp(handle, socket)
 	if !RteIsOk(rte) {
-		return nil, rte.GoError()
+		return nil, newOpError("GetSocketInfo", rte, 0)
 	}
 	info := SocketInfo{
 		SocketHandle: socket,
@@ -654,29 +655,38 @@ func PointInfoToRaw(info *PointInfo) (*RtdbPoint, *RtdbScan, *RtdbCalc, string)
 	return base, scan, calc, namedTypeName
 }
 
-// PointInfoFromRaw 点属性表转换为点信息
+// PointInfoFromRaw 点属性表转换为点信息, 使用默认后端查询自定义类型
 func PointInfoFromRaw(handle ConnectHandle, base *RtdbPoint, scan *RtdbScan, calc *RtdbCalc, isRecycled bool) (*PointInfo, error) {
+	b, err := defaultBackend()
+	if err != nil {
+		return nil, err
+	}
+	return pointInfoFromRaw(b, handle, base, scan, calc, isRecycled)
+}
+
+// pointInfoFromRaw 点属性表转换为点信息
+func pointInfoFromRaw(b Backend, handle ConnectHandle, base *RtdbPoint, scan *RtdbScan, calc *RtdbCalc, isRecycled bool) (*PointInfo, error) {
 	typ := (*NamedType)(nil)
 	if base.Type == RtdbTypeNamedT {
 		if !isRecycled {
-			names, counts, rtes, rte := RawRtdbbGetNamedTypeNamesPropertyWarp(handle, []PointID{base.ID})
+			names, counts, rtes, rte := b.RawRtdbbGetNamedTypeNamesPropertyWarp(handle, []PointID{base.ID})
 			if !RteIsOk(rte) {
-				return nil, rte.GoError()
+				return nil, newOpError("PointInfoFromRaw", rte, base.ID)
 			}
 			if !RteIsOk(rtes[0]) {
-				return nil, rte.GoError()
+				return nil, newOpError("PointInfoFromRaw", rte, base.ID)
 			}
-			fields, tLen, desc, rte := RawRtdbbGetNamedTypeWarp(handle, names[0], counts[0])
+			fields, tLen, desc, rte := b.RawRtdbbGetNamedTypeWarp(handle, names[0], counts[0])
 			typ = &NamedType{Name: names[0], Fields: fields, Desc: desc, Length: tLen}
 		} else {
-			names, counts, rtes, rte := RawRtdbbGetRecycledNamedTypeNamesPropertyWarp(handle, []PointID{base.ID})
+			names, counts, rtes, rte := b.RawRtdbbGetRecycledNamedTypeNamesPropertyWarp(handle, []PointID{base.ID})
 			if !RteIsOk(rte) {
-				return nil, rte.GoError()
+				return nil, newOpError("PointInfoFromRaw", rte, base.ID)
 			}
 			if !RteIsOk(rtes[0]) {
-				return nil, rte.GoError()
+				return nil, newOpError("PointInfoFromRaw", rte, base.ID)
 			}
-			fields, tLen, desc, rte := RawRtdbbGetNamedTypeWarp(handle, names[0], counts[0])
+			fields, tLen, desc, rte := b.RawRtdbbGetNamedTypeWarp(handle, names[0], counts[0])
 			typ = &NamedType{Name: names[0], Fields: fields, Desc: desc, Length: tLen}
 		}
 	}
@@ -1078,7 +1088,6 @@ func GoTimeToRtdbTimestamp(timestamp time.Time, precision RtdbPrecision) (Timest
 	// case RtdbPrecisionMicro:
 	// 	subtime = subtime / 1000
 	// }
-	fmt.Println(timestamp, datetime, subtime)
 	return datetime, subtime
 }
 
@@ -1151,6 +1160,26 @@ func (v *TVQ) GetRtdbStringBlob(osType RtdbOsType) ([]byte, error) {
 	return data, nil
 }
 
+// RtdbStringFromBlob 将读取到的string类型数值转换成Go字符串, 与 GetRtdbStringBlob 相反
+//
+// input:
+//   - osType 服务端操作系统类型, Windows服务端的字符串为GBK编码
+//   - data 读取到的数据
+//
+// output:
+//   - string(str) UTF-8字符串
+func RtdbStringFromBlob(osType RtdbOsType, data []byte) (string, error) {
+	if osType != RtdbOsWindows {
+		return string(data), nil
+	}
+	decoder := simplifiedchinese.GBK.NewDecoder()
+	buf, n, err := transform.Bytes(decoder, data)
+	if err != nil {
+		return "", errors.New("GBK格式[]byte转换成str报错：" + err.Error())
+	}
+	return string(buf[:n]), nil
+}
+
 // GetRtdbDatetime 获取日期类型数值
 func (v *TVQ) GetRtdbDatetime() string {
 	return v.Value.StringValue
@@ -1201,6 +1230,14 @@ type RtdbConnect struct {
 	SocketHandles    []SocketHandle // 套接字句柄
 	ServerOsType     RtdbOsType     // 服务端操作系统类型
 	StringBlobMaxLen int32          // 最大支持String/Blob长度
+	Endpoints        []Endpoint     // 候选服务端地址列表, 用于主备切换
+
+	backend Backend      // 数据库后端
+	metrics Metrics      // 客户端指标, 为nil时不统计
+	calls   callState    // 带有ctx的调用使用的调用连接
+	parent  *RtdbConnect // withContext 创建的连接视图所属的连接, 连接句柄从parent读取
+
+	mu sync.RWMutex // 主备切换时保护连接信息
 }
 
 // Login 登录数据库
@@ -1214,69 +1251,144 @@ type RtdbConnect struct {
 // output:
 //   - RtdbConnect(conn) 返回数据库连接
 func Login(hostIp string, port int32, userName string, password string) (*RtdbConnect, error) {
+	return LoginEndpoints([]Endpoint{{HostIp: hostIp, Port: port}}, userName, password)
+}
+
+// LoginWithBackend 使用指定的后端登录数据库
+//
+// input:
+//   - backend 数据库后端，例如 NewMemoryBackend()
+//   - hostIp 主机IP
+//   - port 端口
+//   - userName 用户名
+//   - password 密码
+//
+// output:
+//   - RtdbConnect(conn) 返回数据库连接
+func LoginWithBackend(backend Backend, hostIp string, port int32, userName string, password string) (*RtdbConnect, error) {
+	return LoginEndpointsWithBackend(backend, []Endpoint{{HostIp: hostIp, Port: port}}, userName, password)
+}
+
+// loginEndpoint 登录单个服务端地址
+func loginEndpoint(b Backend, endpoint Endpoint, userName string, password string) (*RtdbConnect, error) {
 	rtn := RtdbConnect{
-		HostIp:   hostIp,
-		Port:     port,
+		HostIp:   endpoint.HostIp,
+		Port:     endpoint.Port,
 		UserName: userName,
 		Password: password,
+		backend:  b,
 	}
 
 	// 连接数据库
-	cHandle, rte := RawRtdbConnectWarp(rtn.HostIp, rtn.Port)
+	cHandle, rte := b.RawRtdbConnectWarp(rtn.HostIp, rtn.Port)
 	if !RteIsOk(rte) {
-		return nil, rte.GoError()
+		return nil, newOpError("Login", rte, 0)
 	}
 	rtn.ConnectHandle = cHandle
 
+	// 后续步骤失败时断开连接，避免句柄泄露
+	ok := false
+	defer func() {
+		if !ok {
+			_ = b.RawRtdbDisconnectWarp(cHandle)
+		}
+	}()
+
 	// 登录数据库
-	priv, rte := RawRtdbLoginWarp(rtn.ConnectHandle, rtn.UserName, rtn.Password)
+	priv, rte := b.RawRtdbLoginWarp(rtn.ConnectHandle, rtn.UserName, rtn.Password)
 	if !RteIsOk(rte) {
-		return nil, rte.GoError()
+		return nil, newOpError("Login", rte, 0)
 	}
 	rtn.Priv = priv
 
 	// 获取元信息
-	infos, errs, rte := RawRtdbbGetMetaSyncInfoWarp(rtn.ConnectHandle, 0)
+	infos, errs, rte := b.RawRtdbbGetMetaSyncInfoWarp(rtn.ConnectHandle, 0)
 	if !RteIsOk(rte) {
-		return nil, rte.GoError()
+		return nil, newOpError("Login", rte, 0)
 	}
 	for _, rte := range errs {
 		if !RteIsOk(rte) {
-			return nil, rte.GoError()
+			return nil, newOpError("Login", rte, 0)
 		}
 	}
 	rtn.SyncInfos = infos
 
 	// 获取套接字句柄
 	for i := range infos {
-		sHandle, rte := RawRtdbGetOwnConnectionWarp(rtn.ConnectHandle, int32(i+1))
+		sHandle, rte := b.RawRtdbGetOwnConnectionWarp(rtn.ConnectHandle, int32(i+1))
 		if !RteIsOk(rte) {
-			return nil, rte.GoError()
+			return nil, newOpError("Login", rte, 0)
 		}
 		rtn.SocketHandles = append(rtn.SocketHandles, sHandle)
 	}
 
 	// 获取服务器操作系统类型
-	osType, rte := RawRtdbOsType(rtn.ConnectHandle)
+	osType, rte := b.RawRtdbOsType(rtn.ConnectHandle)
 	if !RteIsOk(rte) {
-		return nil, rte.GoError()
+		return nil, newOpError("Login", rte, 0)
 	}
 	rtn.ServerOsType = osType
 
 	// 获取String/Blob最大长度
-	maxLen, rte := RawRtdbGetMaxBlobLenWarp(rtn.ConnectHandle)
+	maxLen, rte := b.RawRtdbGetMaxBlobLenWarp(rtn.ConnectHandle)
 	if !RteIsOk(rte) {
-		return nil, rte.GoError()
+		return nil, newOpError("Login", rte, 0)
 	}
 	rtn.StringBlobMaxLen = maxLen
 
+	ok = true
 	return &rtn, nil
 }
 
+// handle 获取当前连接句柄，主备切换期间保证读取到完整的句柄; 用于Raw调用时使用 acquire
+func (c *RtdbConnect) handle() ConnectHandle {
+	if c.parent != nil {
+		return c.parent.handle()
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.ConnectHandle
+}
+
+// acquire 获取当前连接句柄并登记一次进行中的调用, 调用结束后执行返回的函数
+//   - 读取句柄与登记在同一个读锁内完成, 主备切换替换句柄后断开旧句柄时会等待登记过的调用结束(参见 guardedBackend)
+//   - 同一个方法中的多次Raw调用使用同一个句柄
+func (c *RtdbConnect) acquire() (ConnectHandle, func()) {
+	if c.parent != nil {
+		return c.parent.acquire()
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if b, ok := c.backend.(*guardedBackend); ok {
+		return c.ConnectHandle, b.enter(c.ConnectHandle)
+	}
+	return c.ConnectHandle, func() {}
+}
+
+// isSingleNode 是否为单机服务端
+func (c *RtdbConnect) isSingleNode() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return len(c.SyncInfos) == 1
+}
+
+// Backend 获取当前连接使用的数据库后端
+func (c *RtdbConnect) Backend() Backend {
+	return c.backend
+}
+
+// serverOsType 获取服务端操作系统类型
+func (c *RtdbConnect) serverOsType() RtdbOsType {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.ServerOsType
+}
+
 // Logout 登出数据库
 func (c *RtdbConnect) Logout() error {
-	rte := RawRtdbDisconnectWarp(c.ConnectHandle)
-	return rte.GoError()
+	c.closeCalls()
+	rte := c.backend.RawRtdbDisconnectWarp(c.handle())
+	return c.opError("Logout", rte, 0)
 }
 
 // GetClientVersion 获取客户端版本
@@ -1284,11 +1396,11 @@ func (c *RtdbConnect) Logout() error {
 // output:
 //   - ApiVersion(version) 客户端版本
 func (c *RtdbConnect) GetClientVersion() (*ApiVersion, error) {
-	version, rte := RawRtdbGetApiVersionWarp()
+	version, rte := c.backend.RawRtdbGetApiVersionWarp()
 	if !RteIsOk(rte) {
-		return nil, rte.GoError()
+		return nil, c.opError("GetClientVersion", rte, 0)
 	}
-	return &version, rte.GoError()
+	return &version, c.opError("GetClientVersion", rte, 0)
 }
 
 // SetClientOption 设置客户端参数
@@ -1297,8 +1409,8 @@ func (c *RtdbConnect) GetClientVersion() (*ApiVersion, error) {
 //   - option: 客户端参数选项
 //   - value: 客户端参数值
 func (c *RtdbConnect) SetClientOption(option RtdbApiOption, value int32) error {
-	rte := RawRtdbSetOptionWarp(option, value)
-	return rte.GoError()
+	rte := c.backend.RawRtdbSetOptionWarp(option, value)
+	return c.opError("SetClientOption", rte, 0)
 }
 
 // GetServerOption 获取服务端参数
@@ -1309,16 +1421,18 @@ func (c *RtdbConnect) SetClientOption(option RtdbApiOption, value int32) error {
 // output:
 //   - ServerOption(option) 服务端参数值
 func (c *RtdbConnect) GetServerOption(param RtdbParam) (*ServerOption, error) {
+	handle, release := c.acquire()
+	defer release()
 	if param.IsStringParam() {
-		opt, rte := RawRtdbGetDbInfo1Warp(c.ConnectHandle, param)
+		opt, rte := c.backend.RawRtdbGetDbInfo1Warp(handle, param)
 		if !RteIsOk(rte) {
-			return nil, rte.GoError()
+			return nil, c.opError("GetServerOption", rte, 0)
 		}
 		return &ServerOption{StringOption: opt, IsString: true}, nil
 	} else {
-		opt, rte := RawRtdbGetDbInfo2Warp(c.ConnectHandle, param)
+		opt, rte := c.backend.RawRtdbGetDbInfo2Warp(handle, param)
 		if !RteIsOk(rte) {
-			return nil, rte.GoError()
+			return nil, c.opError("GetServerOption", rte, 0)
 		}
 		return &ServerOption{IntOption: opt, IsString: false}, nil
 	}
@@ -1330,20 +1444,22 @@ func (c *RtdbConnect) GetServerOption(param RtdbParam) (*ServerOption, error) {
 //   - param 服务端参数选项
 //   - option 服务端参数值
 func (c *RtdbConnect) SetServerOption(param RtdbParam, option ServerOption) error {
+	handle, release := c.acquire()
+	defer release()
 	if param.IsStringParam() {
 		strOpt, err := option.GetString()
 		if err != nil {
 			return err
 		}
-		rte := RawRtdbSetDbInfo1Warp(c.ConnectHandle, param, strOpt)
-		return rte.GoError()
+		rte := c.backend.RawRtdbSetDbInfo1Warp(handle, param, strOpt)
+		return c.opError("SetServerOption", rte, 0)
 	} else {
 		intOpt, err := option.GetInt()
 		if err != nil {
 			return err
 		}
-		rte := RawRtdbSetDbInfo2Warp(c.ConnectHandle, param, intOpt)
-		return rte.GoError()
+		rte := c.backend.RawRtdbSetDbInfo2Warp(handle, param, intOpt)
+		return c.opError("SetServerOption", rte, 0)
 	}
 }
 
@@ -1352,19 +1468,21 @@ func (c *RtdbConnect) SetServerOption(param RtdbParam, option ServerOption) erro
 // output:
 //   - [][]SocketInfo(infos) Socket信息列表
 func (c *RtdbConnect) GetSocketInfos() ([][]SocketInfo, error) {
-	if len(c.SyncInfos) == 1 { /* 单机,返回一个Socket列表 */
-		count, rte := RawRtdbConnectionCountWarp(c.ConnectHandle, 0)
+	handle, release := c.acquire()
+	defer release()
+	if c.isSingleNode() { /* 单机,返回一个Socket列表 */
+		count, rte := c.backend.RawRtdbConnectionCountWarp(handle, 0)
 		if !RteIsOk(rte) {
-			return nil, rte.GoError()
+			return nil, c.opError("GetSocketInfos", rte, 0)
 		}
-		sockets, rte := RawRtdbGetConnectionsWarp(c.ConnectHandle, 0, count)
+		sockets, rte := c.backend.RawRtdbGetConnectionsWarp(handle, 0, count)
 		if !RteIsOk(rte) {
-			return nil, rte.GoError()
+			return nil, c.opError("GetSocketInfos", rte, 0)
 		}
 
 		infos := make([]SocketInfo, 0)
 		for _, socket := range sockets {
-			info, err := getSocketInfo(c.ConnectHandle, 0, socket)
+			info, err := getSocketInfo(c.backend, handle, 0, socket)
 			if err != nil {
 				return nil, err
 			}
@@ -1372,34 +1490,34 @@ func (c *RtdbConnect) GetSocketInfos() ([][]SocketInfo, error) {
 		}
 		return [][]SocketInfo{infos}, nil
 	} else { /* 双活,返回两个Socket列表 */
-		count1, rte := RawRtdbConnectionCountWarp(c.ConnectHandle, 1)
+		count1, rte := c.backend.RawRtdbConnectionCountWarp(handle, 1)
 		if !RteIsOk(rte) {
-			return nil, rte.GoError()
+			return nil, c.opError("GetSocketInfos", rte, 0)
 		}
-		sockets1, rte := RawRtdbGetConnectionsWarp(c.ConnectHandle, 1, count1)
+		sockets1, rte := c.backend.RawRtdbGetConnectionsWarp(handle, 1, count1)
 		if !RteIsOk(rte) {
-			return nil, rte.GoError()
+			return nil, c.opError("GetSocketInfos", rte, 0)
 		}
 		infos1 := make([]SocketInfo, 0)
 		for _, socket := range sockets1 {
-			info, err := getSocketInfo(c.ConnectHandle, 1, socket)
+			info, err := getSocketInfo(c.backend, handle, 1, socket)
 			if err != nil {
 				return nil, err
 			}
 			infos1 = append(infos1, *info)
 		}
 
-		count2, rte := RawRtdbConnectionCountWarp(c.ConnectHandle, 2)
+		count2, rte := c.backend.RawRtdbConnectionCountWarp(handle, 2)
 		if !RteIsOk(rte) {
-			return nil, rte.GoError()
+			return nil, c.opError("GetSocketInfos", rte, 0)
 		}
-		sockets2, rte := RawRtdbGetConnectionsWarp(c.ConnectHandle, 2, count2)
+		sockets2, rte := c.backend.RawRtdbGetConnectionsWarp(handle, 2, count2)
 		if !RteIsOk(rte) {
-			return nil, rte.GoError()
+			return nil, c.opError("GetSocketInfos", rte, 0)
 		}
 		infos2 := make([]SocketInfo, 0)
 		for _, socket := range sockets2 {
-			info, err := getSocketInfo(c.ConnectHandle, 2, socket)
+			info, err := getSocketInfo(c.backend, handle, 2, socket)
 			if err != nil {
 				return nil, err
 			}
@@ -1415,30 +1533,32 @@ func (c *RtdbConnect) GetSocketInfos() ([][]SocketInfo, error) {
 // output:
 //   - []Socket Socket信息
 func (c *RtdbConnect) GetOwnSocketInfo() ([]SocketInfo, error) {
-	if len(c.SyncInfos) == 1 { /* 单机,返回一个Socket句柄 */
-		socket, rte := RawRtdbGetOwnConnectionWarp(c.ConnectHandle, 0)
+	handle, release := c.acquire()
+	defer release()
+	if c.isSingleNode() { /* 单机,返回一个Socket句柄 */
+		socket, rte := c.backend.RawRtdbGetOwnConnectionWarp(handle, 0)
 		if !RteIsOk(rte) {
-			return nil, rte.GoError()
+			return nil, c.opError("GetOwnSocketInfo", rte, 0)
 		}
-		info, err := getSocketInfo(c.ConnectHandle, 0, socket)
+		info, err := getSocketInfo(c.backend, handle, 0, socket)
 		if err != nil {
 			return nil, err
 		}
 		return []SocketInfo{*info}, nil
 	} else { /* 双活,返回两个Socket句柄 */
-		socket1, rte := RawRtdbGetOwnConnectionWarp(c.ConnectHandle, 1)
+		socket1, rte := c.backend.RawRtdbGetOwnConnectionWarp(handle, 1)
 		if !RteIsOk(rte) {
-			return nil, rte.GoError()
+			return nil, c.opError("GetOwnSocketInfo", rte, 0)
 		}
-		info1, err := getSocketInfo(c.ConnectHandle, 1, socket1)
+		info1, err := getSocketInfo(c.backend, handle, 1, socket1)
 		if err != nil {
 			return nil, err
 		}
-		socket2, rte := RawRtdbGetOwnConnectionWarp(c.ConnectHandle, 2)
+		socket2, rte := c.backend.RawRtdbGetOwnConnectionWarp(handle, 2)
 		if !RteIsOk(rte) {
-			return nil, rte.GoError()
+			return nil, c.opError("GetOwnSocketInfo", rte, 0)
 		}
-		info2, err := getSocketInfo(c.ConnectHandle, 2, socket2)
+		info2, err := getSocketInfo(c.backend, handle, 2, socket2)
 		if err != nil {
 			return nil, err
 		}
@@ -1452,8 +1572,10 @@ func (c *RtdbConnect) GetOwnSocketInfo() ([]SocketInfo, error) {
 //   - info Socket信息结构
 //   - timeout 超时时间
 func (c *RtdbConnect) SetSocketTimeout(info SocketInfo, timeout DateTimeType) error {
-	rte := RawRtdbSetTimeoutWarp(c.ConnectHandle, info.SocketHandle, timeout)
-	return rte.GoError()
+	handle, release := c.acquire()
+	defer release()
+	rte := c.backend.RawRtdbSetTimeoutWarp(handle, info.SocketHandle, timeout)
+	return c.opError("SetSocketTimeout", rte, 0)
 }
 
 // KillSocket 断开Socket
@@ -1461,8 +1583,10 @@ func (c *RtdbConnect) SetSocketTimeout(info SocketInfo, timeout DateTimeType) er
 // input:
 //   - info Socket信息结构
 func (c *RtdbConnect) KillSocket(info SocketInfo) error {
-	rte := RawRtdbKillConnectionWarp(c.ConnectHandle, info.SocketHandle)
-	return rte.GoError()
+	handle, release := c.acquire()
+	defer release()
+	rte := c.backend.RawRtdbKillConnectionWarp(handle, info.SocketHandle)
+	return c.opError("KillSocket", rte, 0)
 }
 
 // AddIpBlackList 添加IP黑名单项
@@ -1472,8 +1596,10 @@ func (c *RtdbConnect) KillSocket(info SocketInfo) error {
 //   - mask 阻止连接段子网掩码
 //   - desc 阻止连接段的说明
 func (c *RtdbConnect) AddIpBlackList(address string, mask string, desc string) error {
-	rte := RawRtdbAddBlacklistWarp(c.ConnectHandle, address, mask, desc)
-	return rte.GoError()
+	handle, release := c.acquire()
+	defer release()
+	rte := c.backend.RawRtdbAddBlacklistWarp(handle, address, mask, desc)
+	return c.opError("AddIpBlackList", rte, 0)
 }
 
 // UpdateIpBlackList 更新连接黑名单项
@@ -1485,8 +1611,10 @@ func (c *RtdbConnect) AddIpBlackList(address string, mask string, desc string) e
 //   - newMask 新黑名单掩码
 //   - newDesc 新黑名单描述
 func (c *RtdbConnect) UpdateIpBlackList(oldAddr string, oldMask string, newAddr string, newMask string, newDesc string) error {
-	rte := RawRtdbUpdateBlacklistWarp(c.ConnectHandle, oldAddr, oldMask, newAddr, newMask, newDesc)
-	return rte.GoError()
+	handle, release := c.acquire()
+	defer release()
+	rte := c.backend.RawRtdbUpdateBlacklistWarp(handle, oldAddr, oldMask, newAddr, newMask, newDesc)
+	return c.opError("UpdateIpBlackList", rte, 0)
 }
 
 // DeleteIpBlackList 删除连接黑名单项
@@ -1495,8 +1623,10 @@ func (c *RtdbConnect) UpdateIpBlackList(oldAddr string, oldMask string, newAddr
 //   - addr 黑名单地址
 //   - mask 黑名单掩码
 func (c *RtdbConnect) DeleteIpBlackList(addr string, mask string) error {
-	rte := RawRtdbRemoveBlacklistWarp(c.ConnectHandle, addr, mask)
-	return rte.GoError()
+	handle, release := c.acquire()
+	defer release()
+	rte := c.backend.RawRtdbRemoveBlacklistWarp(handle, addr, mask)
+	return c.opError("DeleteIpBlackList", rte, 0)
 }
 
 // GetIpBlackLists 获得连接黑名单列表
@@ -1504,9 +1634,11 @@ func (c *RtdbConnect) DeleteIpBlackList(addr string, mask string) error {
 // output:
 //   - []BlackList(lists) 连接黑名单列表
 func (c *RtdbConnect) GetIpBlackLists() ([]BlackList, error) {
-	lists, rte := RawRtdbGetBlacklistWarp(c.ConnectHandle)
+	handle, release := c.acquire()
+	defer release()
+	lists, rte := c.backend.RawRtdbGetBlacklistWarp(handle)
 	if !RteIsOk(rte) {
-		return nil, rte.GoError()
+		return nil, c.opError("GetIpBlackLists", rte, 0)
 	}
 	return lists, nil
 }
@@ -1519,8 +1651,10 @@ func (c *RtdbConnect) GetIpBlackLists() ([]BlackList, error) {
 //   - desc 连接白名单描述
 //   - priv 连接白名单权限
 func (c *RtdbConnect) AddIpWhiteList(addr string, mask string, desc string, priv PrivGroup) error {
-	rte := RawRtdbAddAuthorizationWarp(c.ConnectHandle, addr, mask, desc, priv)
-	return rte.GoError()
+	handle, release := c.acquire()
+	defer release()
+	rte := c.backend.RawRtdbAddAuthorizationWarp(handle, addr, mask, desc, priv)
+	return c.opError("AddIpWhiteList", rte, 0)
 }
 
 // UpdateIpWhiteList 更新连接白名单
@@ -1533,8 +1667,10 @@ func (c *RtdbConnect) AddIpWhiteList(addr string, mask string, desc string, priv
 //   - newDesc 新连接白名单描述
 //   - newPriv 新连接白名单权限
 func (c *RtdbConnect) UpdateIpWhiteList(oldAddr string, oldMask string, newAddr string, newMask string, newDesc string, newPriv PrivGroup) error {
-	rte := RawRtdbUpdateAuthorizationWarp(c.ConnectHandle, oldAddr, oldMask, newAddr, newMask, newDesc, newPriv)
-	return rte.GoError()
+	handle, release := c.acquire()
+	defer release()
+	rte := c.backend.RawRtdbUpdateAuthorizationWarp(handle, oldAddr, oldMask, newAddr, newMask, newDesc, newPriv)
+	return c.opError("UpdateIpWhiteList", rte, 0)
 }
 
 // DeleteIpWhiteList 删除白名单
@@ -1543,8 +1679,10 @@ func (c *RtdbConnect) UpdateIpWhiteList(oldAddr string, oldMask string, newAddr
 //   - addr 连接白名单地址
 //   - mask 连接白名单掩码
 func (c *RtdbConnect) DeleteIpWhiteList(addr string, mask string) error {
-	rte := RawRtdbRemoveAuthorizationWarp(c.ConnectHandle, addr, mask)
-	return rte.GoError()
+	handle, release := c.acquire()
+	defer release()
+	rte := c.backend.RawRtdbRemoveAuthorizationWarp(handle, addr, mask)
+	return c.opError("DeleteIpWhiteList", rte, 0)
 }
 
 // GetIpWhiteLists 获取连接白名单列表
@@ -1552,9 +1690,11 @@ func (c *RtdbConnect) DeleteIpWhiteList(addr string, mask string) error {
 // output:
 //   - []AuthorizationsList(lists)
 func (c *RtdbConnect) GetIpWhiteLists() ([]AuthorizationsList, error) {
-	lists, rte := RawRtdbGetAuthorizationsWarp(c.ConnectHandle)
+	handle, release := c.acquire()
+	defer release()
+	lists, rte := c.backend.RawRtdbGetAuthorizationsWarp(handle)
 	if !RteIsOk(rte) {
-		return nil, rte.GoError()
+		return nil, c.opError("GetIpWhiteLists", rte, 0)
 	}
 	return lists, nil
 }
@@ -1565,8 +1705,10 @@ func (c *RtdbConnect) GetIpWhiteLists() ([]AuthorizationsList, error) {
 //   - user 用户名
 //   - password 用户密码
 func (c *RtdbConnect) UpdatePassword(user string, password string) error {
-	rte := RawRtdbChangePasswordWarp(c.ConnectHandle, user, password)
-	return rte.GoError()
+	handle, release := c.acquire()
+	defer release()
+	rte := c.backend.RawRtdbChangePasswordWarp(handle, user, password)
+	return c.opError("UpdatePassword", rte, 0)
 }
 
 // UpdateOwnPassword 修改自己的密码
@@ -1575,8 +1717,10 @@ func (c *RtdbConnect) UpdatePassword(user string, password string) error {
 //   - oldPwd 旧密码
 //   - newPwd 新密码
 func (c *RtdbConnect) UpdateOwnPassword(oldPwd string, newPwd string) error {
-	rte := RawRtdbChangeMyPasswordWarp(c.ConnectHandle, oldPwd, newPwd)
-	return rte.GoError()
+	handle, release := c.acquire()
+	defer release()
+	rte := c.backend.RawRtdbChangeMyPasswordWarp(handle, oldPwd, newPwd)
+	return c.opError("UpdateOwnPassword", rte, 0)
 }
 
 // GetPriv 获取连接权限
@@ -1584,9 +1728,11 @@ func (c *RtdbConnect) UpdateOwnPassword(oldPwd string, newPwd string) error {
 // output:
 //   - PrivGroup(priv) 用户权限
 func (c *RtdbConnect) GetPriv() (*PrivGroup, error) {
-	priv, rte := RawRtdbGetPrivWarp(c.ConnectHandle)
+	handle, release := c.acquire()
+	defer release()
+	priv, rte := c.backend.RawRtdbGetPrivWarp(handle)
 	if !RteIsOk(rte) {
-		return nil, rte.GoError()
+		return nil, c.opError("GetPriv", rte, 0)
 	}
 	return &priv, nil
 }
@@ -1597,11 +1743,15 @@ func (c *RtdbConnect) GetPriv() (*PrivGroup, error) {
 //   - user 用户名
 //   - priv 用户权限
 func (c *RtdbConnect) SetPriv(user string, priv PrivGroup) error {
-	rte := RawRtdbChangePrivWarp(c.ConnectHandle, user, priv)
+	handle, release := c.acquire()
+	defer release()
+	rte := c.backend.RawRtdbChangePrivWarp(handle, user, priv)
 	if RteIsOk(rte) && c.UserName == user {
+		c.mu.Lock()
 		c.Priv = priv
+		c.mu.Unlock()
 	}
-	return rte.GoError()
+	return c.opError("SetPriv", rte, 0)
 }
 
 // AddUser 添加用户
@@ -1611,8 +1761,10 @@ func (c *RtdbConnect) SetPriv(user string, priv PrivGroup) error {
 //   - password 用户密码
 //   - priv 用户权限
 func (c *RtdbConnect) AddUser(user string, password string, priv PrivGroup) error {
-	rte := RawRtdbAddUserWarp(c.ConnectHandle, user, password, priv)
-	return rte.GoError()
+	handle, release := c.acquire()
+	defer release()
+	rte := c.backend.RawRtdbAddUserWarp(handle, user, password, priv)
+	return c.opError("AddUser", rte, 0)
 }
 
 // DeleteUser 删除用户
@@ -1620,8 +1772,10 @@ func (c *RtdbConnect) AddUser(user string, password string, priv PrivGroup) erro
 // input:
 //   - user 用户名
 func (c *RtdbConnect) DeleteUser(user string) error {
-	rte := RawRtdbRemoveUserWarp(c.ConnectHandle, user)
-	return rte.GoError()
+	handle, release := c.acquire()
+	defer release()
+	rte := c.backend.RawRtdbRemoveUserWarp(handle, user)
+	return c.opError("DeleteUser", rte, 0)
 }
 
 // LockUser 锁定用户
@@ -1630,8 +1784,10 @@ func (c *RtdbConnect) DeleteUser(user string) error {
 //   - user 用户名
 //   - lock 是否锁定
 func (c *RtdbConnect) LockUser(user string, lock Switch) error {
-	rte := RawRtdbLockUserWarp(c.ConnectHandle, user, lock)
-	return rte.GoError()
+	handle, release := c.acquire()
+	defer release()
+	rte := c.backend.RawRtdbLockUserWarp(handle, user, lock)
+	return c.opError("LockUser", rte, 0)
 }
 
 // GetUsers 获取用户列表
@@ -1639,9 +1795,11 @@ func (c *RtdbConnect) LockUser(user string, lock Switch) error {
 // output:
 //   - []RtdbUserInfo(users) 用户列表
 func (c *RtdbConnect) GetUsers() ([]RtdbUserInfo, error) {
-	users, rte := RawRtdbGetUsersWarp(c.ConnectHandle)
+	handle, release := c.acquire()
+	defer release()
+	users, rte := c.backend.RawRtdbGetUsersWarp(handle)
 	if !RteIsOk(rte) {
-		return nil, rte.GoError()
+		return nil, c.opError("GetUsers", rte, 0)
 	}
 	return users, nil
 }
@@ -1653,8 +1811,10 @@ func (c *RtdbConnect) GetUsers() ([]RtdbUserInfo, error) {
 //   - fields 自定义类型字段列表
 //   - desc 自定义类型描述
 func (c *RtdbConnect) AddNamedType(name string, desc string, fields ...RtdbDataTypeField) error {
-	rte := RawRtdbbCreateNamedTypeWarp(c.ConnectHandle, name, desc, fields...)
-	return rte.GoError()
+	handle, release := c.acquire()
+	defer release()
+	rte := c.backend.RawRtdbbCreateNamedTypeWarp(handle, name, desc, fields...)
+	return c.opError("AddNamedType", rte, 0)
 }
 
 // DeleteNamedType 删除自定义类型
@@ -1662,8 +1822,10 @@ func (c *RtdbConnect) AddNamedType(name string, desc string, fields ...RtdbDataT
 // input:
 //   - name 自定义类型的名称
 func (c *RtdbConnect) DeleteNamedType(name string) error {
-	rte := RawRtdbbRemoveNamedTypeWarp(c.ConnectHandle, name)
-	return rte.GoError()
+	handle, release := c.acquire()
+	defer release()
+	rte := c.backend.RawRtdbbRemoveNamedTypeWarp(handle, name)
+	return c.opError("DeleteNamedType", rte, 0)
 }
 
 // GetNamedType 获取自定义类型
@@ -1690,20 +1852,22 @@ func (c *RtdbConnect) GetNamedType(name string) (*NamedType, error) {
 // output:
 //   - []NamedType(types) 自定义类型列表
 func (c *RtdbConnect) GetNamedTypes() ([]NamedType, error) {
-	count, rte := RawRtdbbGetNamedTypesCountWarp(c.ConnectHandle)
+	handle, release := c.acquire()
+	defer release()
+	count, rte := c.backend.RawRtdbbGetNamedTypesCountWarp(handle)
 	if !RteIsOk(rte) {
-		return nil, rte.GoError()
+		return nil, c.opError("GetNamedTypes", rte, 0)
 	}
-	names, fieldCounts, rte := RawRtdbbGetAllNamedTypesWarp(c.ConnectHandle, count)
+	names, fieldCounts, rte := c.backend.RawRtdbbGetAllNamedTypesWarp(handle, count)
 	if !RteIsOk(rte) {
-		return nil, rte.GoError()
+		return nil, c.opError("GetNamedTypes", rte, 0)
 	}
 
-	types := make([]NamedType, count)
+	types := make([]NamedType, 0, count)
 	for i := 0; i < len(names); i++ {
-		fields, length, desc, rte := RawRtdbbGetNamedTypeWarp(c.ConnectHandle, names[i], fieldCounts[i])
+		fields, length, desc, rte := c.backend.RawRtdbbGetNamedTypeWarp(handle, names[i], fieldCounts[i])
 		if !RteIsOk(rte) {
-			return nil, rte.GoError()
+			return nil, c.opError("GetNamedTypes", rte, 0)
 		}
 		types = append(types, NamedType{
 			Name:   names[i],
@@ -1723,21 +1887,25 @@ func (c *RtdbConnect) GetNamedTypes() ([]NamedType, error) {
 //   - modifyDesc 要修改的 自定义类型的描述
 //   - modifyFields 要修改的 字段名称<->字段描述
 func (c *RtdbConnect) UpdateNamedType(name string, modifyName *string, modifyDesc *string, modifyFields map[string]string) error {
+	handle, release := c.acquire()
+	defer release()
 	fieldNames := make([]string, 0)
 	fieldDescs := make([]string, 0)
 	for name, desc := range modifyFields {
 		fieldNames = append(fieldNames, name)
 		fieldDescs = append(fieldDescs, desc)
 	}
-	rte := RawRtdbbModifyNamedTypeWarp(c.ConnectHandle, name, modifyName, modifyDesc, fieldNames, fieldDescs)
-	return rte.GoError()
+	rte := c.backend.RawRtdbbModifyNamedTypeWarp(handle, name, modifyName, modifyDesc, fieldNames, fieldDescs)
+	return c.opError("UpdateNamedType", rte, 0)
 }
 
 // ServerHostTime 服务端主机时间
 func (c *RtdbConnect) ServerHostTime() (*time.Time, error) {
-	datetime, rte := RawRtdbHostTime64Warp(c.ConnectHandle)
+	handle, release := c.acquire()
+	defer release()
+	datetime, rte := c.backend.RawRtdbHostTime64Warp(handle)
 	if !RteIsOk(rte) {
-		return nil, rte.GoError()
+		return nil, c.opError("ServerHostTime", rte, 0)
 	}
 	hostTime := time.Unix(int64(datetime), 0)
 	return &hostTime, nil
@@ -1758,9 +1926,9 @@ func (c *RtdbConnect) ServerHostTime() (*time.Time, error) {
 // output:
 //   - string(字符串格式时间段)
 func (c *RtdbConnect) DurationToString(duration time.Duration) (string, error) {
-	durationStr, rte := RawRtdbFormatTimespanWarp(int32(duration.Seconds()))
+	durationStr, rte := c.backend.RawRtdbFormatTimespanWarp(int32(duration.Seconds()))
 	if !RteIsOk(rte) {
-		return "", rte.GoError()
+		return "", c.opError("DurationToString", rte, 0)
 	}
 	return durationStr, nil
 }
@@ -1780,9 +1948,9 @@ func (c *RtdbConnect) DurationToString(duration time.Duration) (string, error) {
 // output:
 //   - time.Duration(duration) 时间段
 func (c *RtdbConnect) StringToDuration(strDuration string) (time.Duration, error) {
-	duration, rte := RawRtdbParseTimespanWarp(strDuration)
+	duration, rte := c.backend.RawRtdbParseTimespanWarp(strDuration)
 	if !RteIsOk(rte) {
-		return 0, rte.GoError()
+		return 0, c.opError("StringToDuration", rte, 0)
 	}
 	return time.Second * time.Duration(duration), nil
 }
@@ -1821,9 +1989,9 @@ func (c *RtdbConnect) StringToDuration(strDuration string) (time.Duration, error
 // output:
 //   - time.Time(timestamp) 时间戳
 func (c *RtdbConnect) StringToTime(strTime string) (*time.Time, error) {
-	datetime, subtime, rte := RawRtdbParseTimeWarp(strTime)
+	datetime, subtime, rte := c.backend.RawRtdbParseTimeWarp(strTime)
 	if !RteIsOk(rte) {
-		return nil, rte.GoError()
+		return nil, c.opError("StringToTime", rte, 0)
 	}
 	goTime := time.Unix(int64(datetime), int64(subtime))
 	return &goTime, nil
@@ -1831,9 +1999,11 @@ func (c *RtdbConnect) StringToTime(strTime string) (*time.Time, error) {
 
 // GetQualityDesc 获取质量码说明
 func (c *RtdbConnect) GetQualityDesc(qualities []Quality) ([]string, error) {
-	descs, rte := RawRtdbFormatQualityWarp(c.ConnectHandle, qualities)
+	handle, release := c.acquire()
+	defer release()
+	descs, rte := c.backend.RawRtdbFormatQualityWarp(handle, qualities)
 	if !RteIsOk(rte) {
-		return nil, rte.GoError()
+		return nil, c.opError("GetQualityDesc", rte, 0)
 	}
 	return descs, nil
 }
@@ -1843,9 +2013,11 @@ func (c *RtdbConnect) GetQualityDesc(qualities []Quality) ([]string, error) {
 // output:
 //   - []string(litters) 盘符列表
 func (c *RtdbConnect) GetDriveLetterList() ([]string, error) {
-	letters, rte := RawRtdbGetLogicalDriversWarp(c.ConnectHandle)
+	handle, release := c.acquire()
+	defer release()
+	letters, rte := c.backend.RawRtdbGetLogicalDriversWarp(handle)
 	if !RteIsOk(rte) {
-		return nil, rte.GoError()
+		return nil, c.opError("GetDriveLetterList", rte, 0)
 	}
 	return letters, nil
 }
@@ -1858,22 +2030,24 @@ func (c *RtdbConnect) GetDriveLetterList() ([]string, error) {
 // output:
 //   - []DirItem(items) 目录项列表
 func (c *RtdbConnect) GetDirItemList(dir string) ([]DirItem, error) {
-	rte := RawRtdbOpenPathWarp(c.ConnectHandle, dir)
+	handle, release := c.acquire()
+	defer release()
+	rte := c.backend.RawRtdbOpenPathWarp(handle, dir)
 	if !RteIsOk(rte) {
-		return nil, rte.GoError()
+		return nil, c.opError("GetDirItemList", rte, 0)
 	}
 	defer func() {
-		_ = RawRtdbClosePathWarp(c.ConnectHandle)
+		_ = c.backend.RawRtdbClosePathWarp(handle)
 	}()
 
 	items := make([]DirItem, 0)
 	for {
-		item, rte := RawRtdbReadPath64Warp(c.ConnectHandle)
+		item, rte := c.backend.RawRtdbReadPath64Warp(handle)
 		if !RteIsOk(rte) {
 			if errors.Is(rte, RteBatchEnd) {
 				break
 			} else {
-				return nil, rte.GoError()
+				return nil, c.opError("GetDirItemList", rte, 0)
 			}
 		}
 		items = append(items, item)
@@ -1886,8 +2060,10 @@ func (c *RtdbConnect) GetDirItemList(dir string) ([]DirItem, error) {
 // input:
 //   - path 目录路径
 func (c *RtdbConnect) CreateDir(path string) error {
-	rte := RawRtdbMkdirWarp(c.ConnectHandle, path)
-	return rte.GoError()
+	handle, release := c.acquire()
+	defer release()
+	rte := c.backend.RawRtdbMkdirWarp(handle, path)
+	return c.opError("CreateDir", rte, 0)
 }
 
 // ReadFile 读取文件
@@ -1898,9 +2074,11 @@ func (c *RtdbConnect) CreateDir(path string) error {
 // output:
 //   - []byte(data) 文件内容
 func (c *RtdbConnect) ReadFile(path string) ([]byte, error) {
-	size, rte := RawRtdbGetFileSizeWarp(c.ConnectHandle, path)
+	handle, release := c.acquire()
+	defer release()
+	size, rte := c.backend.RawRtdbGetFileSizeWarp(handle, path)
 	if !RteIsOk(rte) {
-		return nil, rte.GoError()
+		return nil, c.opError("ReadFile", rte, 0)
 	}
 	if size > MaxFileSize {
 		return nil, errors.New("当前文件大小超出允许读取长度")
@@ -1908,9 +2086,9 @@ func (c *RtdbConnect) ReadFile(path string) ([]byte, error) {
 
 	buf := bytes.NewBuffer(nil)
 	for i := 0; i < int(size); i += MaxBlockSize {
-		data, rte := RawRtdbReadFileWarp(c.ConnectHandle, path, int64(i*MaxBlockSize), MaxBlockSize)
+		data, rte := c.backend.RawRtdbReadFileWarp(handle, path, int64(i*MaxBlockSize), MaxBlockSize)
 		if !RteIsOk(rte) {
-			return nil, rte.GoError()
+			return nil, c.opError("ReadFile", rte, 0)
 		}
 		_, err := buf.Write(data)
 		if err != nil {
@@ -1929,9 +2107,11 @@ func (c *RtdbConnect) ReadFile(path string) ([]byte, error) {
 // output:
 //   - RtdbTable(table) 返回表
 func (c *RtdbConnect) CreateTable(name string, desc string) (*RtdbTable, error) {
-	table, rte := RawRtdbbAppendTableWarp(c.ConnectHandle, name, desc)
+	handle, release := c.acquire()
+	defer release()
+	table, rte := c.backend.RawRtdbbAppendTableWarp(handle, name, desc)
 	if !RteIsOk(rte) {
-		return nil, rte.GoError()
+		return nil, c.opError("CreateTable", rte, 0)
 	}
 	return &table, nil
 }
@@ -1941,8 +2121,10 @@ func (c *RtdbConnect) CreateTable(name string, desc string) (*RtdbTable, error)
 // input:
 //   - id 表ID
 func (c *RtdbConnect) DeleteTable(id TableID) error {
-	rte := RawRtdbbRemoveTableByIdWarp(c.ConnectHandle, id)
-	return rte.GoError()
+	handle, release := c.acquire()
+	defer release()
+	rte := c.backend.RawRtdbbRemoveTableByIdWarp(handle, id)
+	return c.opError("DeleteTable", rte, 0)
 }
 
 // GetTable
@@ -1950,9 +2132,11 @@ func (c *RtdbConnect) DeleteTable(id TableID) error {
 // input:
 //   - id 获取表
 func (c *RtdbConnect) GetTable(id TableID) (*RtdbTable, error) {
-	table, rte := RawRtdbbGetTablePropertyByIdWarp(c.ConnectHandle, id)
+	handle, release := c.acquire()
+	defer release()
+	table, rte := c.backend.RawRtdbbGetTablePropertyByIdWarp(handle, id)
 	if !RteIsOk(rte) {
-		return nil, rte.GoError()
+		return nil, c.opError("GetTable", rte, 0)
 	}
 	return &table, nil
 }
@@ -1962,19 +2146,21 @@ func (c *RtdbConnect) GetTable(id TableID) (*RtdbTable, error) {
 // output:
 //   - []RtdbTable(tables) 表列表
 func (c *RtdbConnect) GetTables() ([]RtdbTable, error) {
-	count, rte := RawRtdbbTablesCountWarp(c.ConnectHandle)
+	handle, release := c.acquire()
+	defer release()
+	count, rte := c.backend.RawRtdbbTablesCountWarp(handle)
 	if !RteIsOk(rte) {
-		return nil, rte.GoError()
+		return nil, c.opError("GetTables", rte, 0)
 	}
-	ids, rte := RawRtdbbGetTablesWarp(c.ConnectHandle, count)
+	ids, rte := c.backend.RawRtdbbGetTablesWarp(handle, count)
 	if !RteIsOk(rte) {
-		return nil, rte.GoError()
+		return nil, c.opError("GetTables", rte, 0)
 	}
 	tables := make([]RtdbTable, 0)
 	for _, id := range ids {
-		table, rte := RawRtdbbGetTablePropertyByIdWarp(c.ConnectHandle, id)
+		table, rte := c.backend.RawRtdbbGetTablePropertyByIdWarp(handle, id)
 		if !RteIsOk(rte) {
-			return nil, rte.GoError()
+			return nil, c.opError("GetTables", rte, 0)
 		}
 		tables = append(tables, table)
 	}
@@ -1986,8 +2172,10 @@ func (c *RtdbConnect) GetTables() ([]RtdbTable, error) {
 //   - id 表ID
 //   - name 表名
 func (c *RtdbConnect) UpdateTableName(id TableID, name string) error {
-	rte := RawRtdbbUpdateTableNameWarp(c.ConnectHandle, id, name)
-	return rte.GoError()
+	handle, release := c.acquire()
+	defer release()
+	rte := c.backend.RawRtdbbUpdateTableNameWarp(handle, id, name)
+	return c.opError("UpdateTableName", rte, 0)
 }
 
 // UpdateTableDesc 更新表描述
@@ -1996,8 +2184,10 @@ func (c *RtdbConnect) UpdateTableName(id TableID, name string) error {
 //   - id 表ID
 //   - desc 表描述
 func (c *RtdbConnect) UpdateTableDesc(id TableID, desc string) error {
-	rte := RawRtdbbUpdateTableDescByIdWarp(c.ConnectHandle, id, desc)
-	return rte.GoError()
+	handle, release := c.acquire()
+	defer release()
+	rte := c.backend.RawRtdbbUpdateTableDescByIdWarp(handle, id, desc)
+	return c.opError("UpdateTableDesc", rte, 0)
 }
 
 // AddPoint 创建点
@@ -2008,6 +2198,8 @@ func (c *RtdbConnect) UpdateTableDesc(id TableID, desc string) error {
 // output:
 //   - PointInfo(info) 输出点信息
 func (c *RtdbConnect) AddPoint(info *PointInfo) (*PointInfo, error) {
+	handle, release := c.acquire()
+	defer release()
 	base, scan, calc, tName := PointInfoToRaw(info)
 	if base.Type == RtdbTypeNamedT {
 		if tName == "" {
@@ -2017,17 +2209,17 @@ func (c *RtdbConnect) AddPoint(info *PointInfo) (*PointInfo, error) {
 		if err != nil {
 			return nil, err
 		}
-		base, scan, rte := RawRtdbbInsertNamedTypePointWarp(c.ConnectHandle, base, scan, tName)
+		base, scan, rte := c.backend.RawRtdbbInsertNamedTypePointWarp(handle, base, scan, tName)
 		if !RteIsOk(rte) {
-			return nil, rte.GoError()
+			return nil, c.opError("AddPoint", rte, info.ID)
 		}
-		return PointInfoFromRaw(c.ConnectHandle, base, scan, nil, false)
+		return pointInfoFromRaw(c.backend, handle, base, scan, nil, false)
 	} else {
-		base, scan, calc, rte := RawRtdbbInsertMaxPointWarp(c.ConnectHandle, base, scan, calc)
+		base, scan, calc, rte := c.backend.RawRtdbbInsertMaxPointWarp(handle, base, scan, calc)
 		if !RteIsOk(rte) {
-			return nil, rte.GoError()
+			return nil, c.opError("AddPoint", rte, info.ID)
 		}
-		return PointInfoFromRaw(c.ConnectHandle, base, scan, calc, false)
+		return pointInfoFromRaw(c.backend, handle, base, scan, calc, false)
 	}
 }
 
@@ -2036,8 +2228,10 @@ func (c *RtdbConnect) AddPoint(info *PointInfo) (*PointInfo, error) {
 // input:
 //   - id 点ID
 func (c *RtdbConnect) DeletePoint(id PointID) error {
-	rte := RawRtdbbRemovePointByIdWarp(c.ConnectHandle, id)
-	return rte.GoError()
+	handle, release := c.acquire()
+	defer release()
+	rte := c.backend.RawRtdbbRemovePointByIdWarp(handle, id)
+	return c.opError("DeletePoint", rte, id)
 }
 
 // UpdatePoint 更新点
@@ -2046,6 +2240,8 @@ func (c *RtdbConnect) DeletePoint(id PointID) error {
 //   - id 点ID
 //   - fields 需要更新的字段
 func (c *RtdbConnect) UpdatePoint(id PointID, fields map[PointInfoField]any) error {
+	handle, release := c.acquire()
+	defer release()
 	pointInfo, err := c.GetPoint(id)
 	if err != nil {
 		return err
@@ -2249,8 +2445,21 @@ func (c *RtdbConnect) UpdatePoint(id PointID, fields map[PointInfoField]any) err
 		}
 	}
 	base, scan, calc, _ := PointInfoToRaw(pointInfo)
-	rte := RawRtdbbUpdateMaxPointPropertyWarp(c.ConnectHandle, base, scan, calc)
-	return rte.GoError()
+	rte := c.backend.RawRtdbbUpdateMaxPointPropertyWarp(handle, base, scan, calc)
+	return c.opError("UpdatePoint", rte, id)
+}
+
+// UpdatePointInfo 使用完整的标签点信息更新标签点, 适用于先 GetPoint 再修改多个属性的场景
+//   - 根据 info.ID 确定标签点, 不能修改数值类型与所属的表, 修改所属的表请使用 MovePoint
+//
+// input:
+//   - info 标签点信息
+func (c *RtdbConnect) UpdatePointInfo(info *PointInfo) error {
+	handle, release := c.acquire()
+	defer release()
+	base, scan, calc, _ := PointInfoToRaw(info)
+	rte := c.backend.RawRtdbbUpdateMaxPointPropertyWarp(handle, base, scan, calc)
+	return c.opError("UpdatePointInfo", rte, info.ID)
 }
 
 // GetPoints 批量获取标签点
@@ -2261,14 +2470,16 @@ func (c *RtdbConnect) UpdatePoint(id PointID, fields map[PointInfoField]any) err
 // output:
 //   - []PointInfo(infos) 标签点属性列表
 func (c *RtdbConnect) GetPoints(ids []PointID) ([]*PointInfo, []error, error) {
-	bases, scans, calcs, rtes, rte := RawRtdbbGetMaxPointsPropertyWarp(c.ConnectHandle, ids)
+	handle, release := c.acquire()
+	defer release()
+	bases, scans, calcs, rtes, rte := c.backend.RawRtdbbGetMaxPointsPropertyWarp(handle, ids)
 	if !RteIsOk(rte) {
-		return nil, nil, rte.GoError()
+		return nil, nil, c.opError("GetPoints", rte, 0)
 	}
-	errs := RtdbErrorListToErrorList(rtes)
+	errs := c.opErrors("GetPoints", ids, rtes)
 	infos := make([]*PointInfo, 0)
 	for i := 0; i < len(ids); i++ {
-		info, err := PointInfoFromRaw(c.ConnectHandle, &bases[i], &scans[i], &calcs[i], false)
+		info, err := pointInfoFromRaw(c.backend, handle, &bases[i], &scans[i], &calcs[i], false)
 		if err != nil {
 			errs[i] = err
 		}
@@ -2285,16 +2496,18 @@ func (c *RtdbConnect) GetPoints(ids []PointID) ([]*PointInfo, []error, error) {
 // output:
 //   - PointInfo(info) 返回点信息
 func (c *RtdbConnect) GetPoint(id PointID) (*PointInfo, error) {
-	bases, scans, calcs, rtes, rte := RawRtdbbGetMaxPointsPropertyWarp(c.ConnectHandle, []PointID{id})
+	handle, release := c.acquire()
+	defer release()
+	bases, scans, calcs, rtes, rte := c.backend.RawRtdbbGetMaxPointsPropertyWarp(handle, []PointID{id})
 	if !RteIsOk(rte) {
-		return nil, rte.GoError()
+		return nil, c.opError("GetPoint", rte, id)
 	}
 	for _, rte := range rtes {
 		if !RteIsOk(rte) {
-			return nil, rte.GoError()
+			return nil, c.opError("GetPoint", rte, id)
 		}
 	}
-	return PointInfoFromRaw(c.ConnectHandle, &bases[0], &scans[0], &calcs[0], false)
+	return pointInfoFromRaw(c.backend, handle, &bases[0], &scans[0], &calcs[0], false)
 }
 
 // FindPoints 根据 表名.点名 搜索标签点
@@ -2306,9 +2519,11 @@ func (c *RtdbConnect) GetPoint(id PointID) (*PointInfo, error) {
 //   - []*PointInfo(infos) 点信息列表
 //   - []error 报错信息
 func (c *RtdbConnect) FindPoints(tableDotPoints []string) ([]*PointInfo, []error, error) {
-	ids, _, _, _, _, rte := RawRtdbbFindPointsExWarp(c.ConnectHandle, tableDotPoints)
+	handle, release := c.acquire()
+	defer release()
+	ids, _, _, _, _, rte := c.backend.RawRtdbbFindPointsExWarp(handle, tableDotPoints)
 	if !RteIsOk(rte) {
-		return nil, nil, rte.GoError()
+		return nil, nil, c.opError("FindPoints", rte, 0)
 	}
 	return c.GetPoints(ids)
 }
@@ -2319,8 +2534,10 @@ func (c *RtdbConnect) FindPoints(tableDotPoints []string) ([]*PointInfo, []error
 //   - id 点ID
 //   - tableName 表名称
 func (c *RtdbConnect) MovePoint(id PointID, tableName string) error {
-	rte := RawRtdbbMovePointByIdWarp(c.ConnectHandle, id, tableName)
-	return rte.GoError()
+	handle, release := c.acquire()
+	defer release()
+	rte := c.backend.RawRtdbbMovePointByIdWarp(handle, id, tableName)
+	return c.opError("MovePoint", rte, id)
 }
 
 // SearchPoint 分页搜索点
@@ -2346,26 +2563,30 @@ func (c *RtdbConnect) MovePoint(id PointID, tableName string) error {
 //   - int32(count) 点总数
 //   - []*PointInfo(infos) 点信息列表
 func (c *RtdbConnect) SearchPoint(start int32, count int32, tagMask, tableMask, source, unit, desc, instrument, typeMask string, classOfMask RtdbType, timeUnitMask RtdbPrecision, otherTypeMask RtdbSearch, otherTypeMaskValue string, model RtdbSortFlag) (int32, []*PointInfo, []error, error) {
-	count, rte := RawRtdbbSearchPointsCountWarp(c.ConnectHandle, tagMask, tableMask, source, unit, desc, instrument, typeMask, classOfMask, timeUnitMask, otherTypeMask, otherTypeMaskValue)
+	handle, release := c.acquire()
+	defer release()
+	total, rte := c.backend.RawRtdbbSearchPointsCountWarp(handle, tagMask, tableMask, source, unit, desc, instrument, typeMask, classOfMask, timeUnitMask, otherTypeMask, otherTypeMaskValue)
 	if !RteIsOk(rte) {
-		return 0, nil, nil, rte.GoError()
+		return 0, nil, nil, c.opError("SearchPoint", rte, 0)
 	}
-	ids, rte := RawRtdbbSearchExWarp(c.ConnectHandle, count, tagMask, tableMask, source, unit, desc, instrument, typeMask, classOfMask, timeUnitMask, otherTypeMask, otherTypeMaskValue, model)
+	ids, rte := c.backend.RawRtdbbSearchExWarp(handle, total, tagMask, tableMask, source, unit, desc, instrument, typeMask, classOfMask, timeUnitMask, otherTypeMask, otherTypeMaskValue, model)
 	if !RteIsOk(rte) {
-		return 0, nil, nil, rte.GoError()
+		return 0, nil, nil, c.opError("SearchPoint", rte, 0)
 	}
 	ids = SafeSlice(ids, start, count)
 	infos, errs, err := c.GetPoints(ids)
 	if err != nil {
 		return 0, nil, nil, err
 	}
-	return count, infos, errs, nil
+	return total, infos, errs, nil
 }
 
 // ClearRecycler 清空回收站
 func (c *RtdbConnect) ClearRecycler() error {
-	rte := RawRtdbbClearRecyclerWarp(c.ConnectHandle)
-	return rte.GoError()
+	handle, release := c.acquire()
+	defer release()
+	rte := c.backend.RawRtdbbClearRecyclerWarp(handle)
+	return c.opError("ClearRecycler", rte, 0)
 }
 
 // GetRecycledPoints 分段获取回收站中的点
@@ -2379,28 +2600,30 @@ func (c *RtdbConnect) ClearRecycler() error {
 //   - []*PointInfo(infos) 点信息列表
 //   - []error(errs) 获取点信息时的错误列表
 func (c *RtdbConnect) GetRecycledPoints(start int32, count int32) (int32, []*PointInfo, []error, error) {
-	count, rte := RawRtdbbGetRecycledPointsCountWarp(c.ConnectHandle)
+	handle, release := c.acquire()
+	defer release()
+	total, rte := c.backend.RawRtdbbGetRecycledPointsCountWarp(handle)
 	if !RteIsOk(rte) {
-		return 0, nil, nil, rte.GoError()
+		return 0, nil, nil, c.opError("GetRecycledPoints", rte, 0)
 	}
-	ids, rte := RawRtdbbGetRecycledPointsWarp(c.ConnectHandle, count)
+	ids, rte := c.backend.RawRtdbbGetRecycledPointsWarp(handle, total)
 	if !RteIsOk(rte) {
-		return 0, nil, nil, rte.GoError()
+		return 0, nil, nil, c.opError("GetRecycledPoints", rte, 0)
 	}
 	ids = SafeSlice(ids, start, count)
 	infos := make([]*PointInfo, 0)
 	errs := make([]error, 0)
 	for _, id := range ids {
-		base, scan, calc, rte := RawRtdbbGetRecycledMaxPointPropertyWarp(c.ConnectHandle, id)
-		info, _ := PointInfoFromRaw(c.ConnectHandle, base, scan, calc, true)
+		base, scan, calc, rte := c.backend.RawRtdbbGetRecycledMaxPointPropertyWarp(handle, id)
+		info, _ := pointInfoFromRaw(c.backend, handle, base, scan, calc, true)
 		infos = append(infos, info)
 		if !RteIsOk(rte) {
-			errs = append(errs, rte.GoError())
+			errs = append(errs, c.opError("GetRecycledPoints", rte, id))
 		} else {
 			errs = append(errs, nil)
 		}
 	}
-	return count, infos, errs, nil
+	return total, infos, errs, nil
 }
 
 // RecoverPoint 从回收站中恢复点到某个表
@@ -2409,8 +2632,10 @@ func (c *RtdbConnect) GetRecycledPoints(start int32, count int32) (int32, []*Poi
 //   - tableID 点恢复到这个表
 //   - pointID 需要恢复的点
 func (c *RtdbConnect) RecoverPoint(tableId TableID, pointId PointID) error {
-	rte := RawRtdbbRecoverPointWarp(c.ConnectHandle, tableId, pointId)
-	return rte.GoError()
+	handle, release := c.acquire()
+	defer release()
+	rte := c.backend.RawRtdbbRecoverPointWarp(handle, tableId, pointId)
+	return c.opError("RecoverPoint", rte, pointId)
 }
 
 // PurgePoint 从回收站中清除点
@@ -2418,8 +2643,10 @@ func (c *RtdbConnect) RecoverPoint(tableId TableID, pointId PointID) error {
 // input:
 //   - id 点ID
 func (c *RtdbConnect) PurgePoint(id PointID) error {
-	rte := RawRtdbbPurgePointWarp(c.ConnectHandle, id)
-	return rte.GoError()
+	handle, release := c.acquire()
+	defer release()
+	rte := c.backend.RawRtdbbPurgePointWarp(handle, id)
+	return c.opError("PurgePoint", rte, id)
 }
 
 // SearchRecycledPoint 从回收站中搜索点
@@ -2440,13 +2667,15 @@ func (c *RtdbConnect) PurgePoint(id PointID) error {
 //   - []*PointInfo(infos) 点信息列表
 //   - []error(errs) 获取点信息时的错误列表
 func (c *RtdbConnect) SearchRecycledPoint(start int32, count int32, tagMask, tableMask, source, unit, desc, instrument string, mode RtdbSortFlag) (int32, []*PointInfo, []error, error) {
-	maxCount, rte := RawRtdbbGetRecycledPointsCountWarp(c.ConnectHandle)
+	handle, release := c.acquire()
+	defer release()
+	maxCount, rte := c.backend.RawRtdbbGetRecycledPointsCountWarp(handle)
 	if !RteIsOk(rte) {
-		return 0, nil, nil, rte.GoError()
+		return 0, nil, nil, c.opError("SearchRecycledPoint", rte, 0)
 	}
-	ids, rte := RawRtdbbSearchRecycledPointsInBatchesWarp(c.ConnectHandle, start, maxCount, tagMask, tableMask, source, unit, desc, instrument, mode)
+	ids, rte := c.backend.RawRtdbbSearchRecycledPointsInBatchesWarp(handle, start, maxCount, tagMask, tableMask, source, unit, desc, instrument, mode)
 	if !RteIsOk(rte) {
-		return 0, nil, nil, rte.GoError()
+		return 0, nil, nil, c.opError("SearchRecycledPoint", rte, 0)
 	}
 	maxCount = int32(len(ids))
 	rtnIds := SafeSlice(ids, start, count)
@@ -2454,11 +2683,11 @@ func (c *RtdbConnect) SearchRecycledPoint(start int32, count int32, tagMask, tab
 	infos := make([]*PointInfo, 0)
 	errs := make([]error, 0)
 	for _, id := range rtnIds {
-		base, scan, calc, rte := RawRtdbbGetRecycledMaxPointPropertyWarp(c.ConnectHandle, id)
-		info, _ := PointInfoFromRaw(c.ConnectHandle, base, scan, calc, true)
+		base, scan, calc, rte := c.backend.RawRtdbbGetRecycledMaxPointPropertyWarp(handle, id)
+		info, _ := pointInfoFromRaw(c.backend, handle, base, scan, calc, true)
 		infos = append(infos, info)
 		if !RteIsOk(rte) {
-			errs = append(errs, rte.GoError())
+			errs = append(errs, c.opError("SearchRecycledPoint", rte, id))
 		} else {
 			errs = append(errs, nil)
 		}
@@ -2475,17 +2704,19 @@ func (c *RtdbConnect) SearchRecycledPoint(start int32, count int32, tagMask, tab
 // output:
 //   - int32(count) 该数值类型对应的点数量
 func (c *RtdbConnect) GetPointCountFromValueType(valueType ValueType) (int32, error) {
+	handle, release := c.acquire()
+	defer release()
 	rtdbType, name := valueType.ToRawType()
 	if rtdbType == RtdbTypeNamedT {
-		count, rte := RawRtdbbGetNamedTypePointsCountWarp(c.ConnectHandle, name)
+		count, rte := c.backend.RawRtdbbGetNamedTypePointsCountWarp(handle, name)
 		if !RteIsOk(rte) {
-			return 0, rte.GoError()
+			return 0, c.opError("GetPointCountFromValueType", rte, 0)
 		}
 		return count, nil
 	} else {
-		count, rte := RawRtdbbGetBaseTypePointsCountWarp(c.ConnectHandle, rtdbType)
+		count, rte := c.backend.RawRtdbbGetBaseTypePointsCountWarp(handle, rtdbType)
 		if !RteIsOk(rte) {
-			return 0, rte.GoError()
+			return 0, c.opError("GetPointCountFromValueType", rte, 0)
 		}
 		return count, nil
 	}
@@ -2494,14 +2725,16 @@ func (c *RtdbConnect) GetPointCountFromValueType(valueType ValueType) (int32, er
 /*
 // TODO
 func (c *RtdbConnect) GetArchiveFileList() error {
-	count, rte := RawRtdbaGetArchivesCountWarp(c.ConnectHandle)
+	handle, release := c.acquire()
+	defer release()
+	count, rte := c.backend.RawRtdbaGetArchivesCountWarp(handle)
 	if !RteIsOk(rte) {
-		return rte.GoError()
+		return c.opError("GetArchiveFileList", rte, 0)
 	}
 
-	paths, files, states, rte := RawRtdbaGetArchivesWarp(c.ConnectHandle, count)
+	paths, files, states, rte := c.backend.RawRtdbaGetArchivesWarp(handle, count)
 	if !RteIsOk(rte) {
-		return rte.GoError()
+		return c.opError("GetArchiveFileList", rte, 0)
 	}
 
 	return nil
@@ -2516,10 +2749,10 @@ func (c *RtdbConnect) GetArchiveFileList() error {
 //   - tvq 时间戳+数值+质量码
 func (c *RtdbConnect) WriteValue(info *PointInfo, fix bool, tvq TVQ) error {
 	errs, err := c.WriteValues(info, fix, []TVQ{tvq})
-	if errs[0] != nil {
-		return errs[0]
+	if err != nil {
+		return err
 	}
-	return err
+	return errs[0]
 }
 
 // WriteValues 批量写入值
@@ -2544,10 +2777,19 @@ func (c *RtdbConnect) WriteValues(info *PointInfo, fix bool, tvqs []TVQ) ([]erro
 // input:
 //   - fix 是否可以覆盖写入，只对 整数、浮点数、坐标 生效
 //   - ptvqs PTVQ值数组, 备注：p是可以重复的，表示一个point中写入多条数值
+//
+// output:
+//   - []error(errs) 错误列表, 与ptvqs一一对应, 按时间顺序写入但不会修改ptvqs的顺序
 func (c *RtdbConnect) WriteSection(fix bool, ptvqs []PTVQ) ([]error, error) {
+	handle, release := c.acquire()
+	defer release()
 	rtnRtes := make([]RtdbError, len(ptvqs))
-	sort.Slice(ptvqs, func(i, j int) bool {
-		return ptvqs[i].TVQ.Timestamp.UnixNano() < ptvqs[i].TVQ.Timestamp.UnixNano()
+	order := make([]int, len(ptvqs))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return ptvqs[order[i]].TVQ.Timestamp.Before(ptvqs[order[j]].TVQ.Timestamp)
 	})
 
 	// 数值 int&float
@@ -2592,7 +2834,8 @@ func (c *RtdbConnect) WriteSection(fix bool, ptvqs []PTVQ) ([]error, error) {
 	dtQualities := make([]Quality, 0)
 	dtIdx := make([]int, 0)
 
-	for i, ptvq := range ptvqs {
+	for _, i := range order {
+		ptvq := ptvqs[i]
 		rtdbType, _ := ptvq.PointInfo.ValueType.ToRawType()
 		switch rtdbType {
 		case RtdbTypeBool, RtdbTypeUint8, RtdbTypeInt8, RtdbTypeChar, RtdbTypeUint16, RtdbTypeInt16, RtdbTypeUint32, RtdbTypeInt32, RtdbTypeInt64, RtdbTypeReal16, RtdbTypeReal32, RtdbTypeReal64, RtdbTypeFp16, RtdbTypeFp32, RtdbTypeFp64:
@@ -2626,7 +2869,7 @@ func (c *RtdbConnect) WriteSection(fix bool, ptvqs []PTVQ) ([]error, error) {
 			bDatetimes = append(bDatetimes, datetime)
 			bSubtimes = append(bSubtimes, subtime)
 			bQualities = append(bQualities, ptvq.TVQ.GetRtdbQuality())
-			data, err := ptvq.TVQ.GetRtdbStringBlob(c.ServerOsType)
+			data, err := ptvq.TVQ.GetRtdbStringBlob(c.serverOsType())
 			if err != nil {
 				return nil, err
 			}
@@ -2655,12 +2898,12 @@ func (c *RtdbConnect) WriteSection(fix bool, ptvqs []PTVQ) ([]error, error) {
 		rtes := make([]RtdbError, 0)
 		rte := RtdbError(0)
 		if fix {
-			rtes, rte = RawRtdbsFixSnapshots64Warp(c.ConnectHandle, numberIds, numberDatetimes, numberSubtimes, numberValues, numberStates, numberQualities)
+			rtes, rte = c.backend.RawRtdbsFixSnapshots64Warp(handle, numberIds, numberDatetimes, numberSubtimes, numberValues, numberStates, numberQualities)
 		} else {
-			rtes, rte = RawRtdbsPutSnapshots64Warp(c.ConnectHandle, numberIds, numberDatetimes, numberSubtimes, numberValues, numberStates, numberQualities)
+			rtes, rte = c.backend.RawRtdbsPutSnapshots64Warp(handle, numberIds, numberDatetimes, numberSubtimes, numberValues, numberStates, numberQualities)
 		}
 		if !RteIsOk(rte) {
-			return nil, rte.GoError()
+			return nil, c.opError("WriteSection", rte, 0)
 		}
 		aIndex := make([]int, 0)
 		aIds := make([]PointID, 0)
@@ -2681,30 +2924,30 @@ func (c *RtdbConnect) WriteSection(fix bool, ptvqs []PTVQ) ([]error, error) {
 			}
 		}
 		if len(aIds) != 0 {
-			fmt.Println("??????????!!!!")
-			aRtes, aRte := RawRtdbhPutArchivedValues64Warp(c.ConnectHandle, aIds, aDatetimes, aSubtimes, aValues, aStates, aQualities)
+			aRtes, aRte := c.backend.RawRtdbhPutArchivedValues64Warp(handle, aIds, aDatetimes, aSubtimes, aValues, aStates, aQualities)
 			if !RteIsOk(aRte) {
-				return nil, aRte.GoError()
+				return nil, c.opError("WriteSection", aRte, 0)
 			}
 			for i, e := range aRtes {
 				rtes[aIndex[i]] = e
 			}
-			for i, e := range rtes {
-				rtnRtes[numberIdx[i]] = e
-			}
 		}
+		for i, e := range rtes {
+			rtnRtes[numberIdx[i]] = e
+		}
+		c.observeWrite(WriteBucketNumber, rtes, len(aIds))
 	}
 
 	if len(coorIds) != 0 {
 		rtes := make([]RtdbError, 0)
 		rte := RtdbError(0)
 		if fix {
-			rtes, rte = RawRtdbsFixCoorSnapshots64Warp(c.ConnectHandle, coorIds, coorDatetimes, coorSubtimes, coorXs, coorYs, coorQualities)
+			rtes, rte = c.backend.RawRtdbsFixCoorSnapshots64Warp(handle, coorIds, coorDatetimes, coorSubtimes, coorXs, coorYs, coorQualities)
 		} else {
-			rtes, rte = RawRtdbsPutCoorSnapshots64Warp(c.ConnectHandle, coorIds, coorDatetimes, coorSubtimes, coorXs, coorYs, coorQualities)
+			rtes, rte = c.backend.RawRtdbsPutCoorSnapshots64Warp(handle, coorIds, coorDatetimes, coorSubtimes, coorXs, coorYs, coorQualities)
 		}
 		if !RteIsOk(rte) {
-			return nil, rte.GoError()
+			return nil, c.opError("WriteSection", rte, 0)
 		}
 		aIndex := make([]int, 0)
 		aIds := make([]PointID, 0)
@@ -2725,23 +2968,24 @@ func (c *RtdbConnect) WriteSection(fix bool, ptvqs []PTVQ) ([]error, error) {
 			}
 		}
 		if len(aIds) != 0 {
-			aRtes, aRte := RawRtdbhPutArchivedCoorValues64Warp(c.ConnectHandle, aIds, aDatetimes, aSubtimes, aXs, aYs, aQualities)
+			aRtes, aRte := c.backend.RawRtdbhPutArchivedCoorValues64Warp(handle, aIds, aDatetimes, aSubtimes, aXs, aYs, aQualities)
 			if !RteIsOk(aRte) {
-				return nil, aRte.GoError()
+				return nil, c.opError("WriteSection", aRte, 0)
 			}
 			for i, e := range aRtes {
 				rtes[aIndex[i]] = e
 			}
-			for i, e := range rtes {
-				rtnRtes[coorIdx[i]] = e
-			}
 		}
+		for i, e := range rtes {
+			rtnRtes[coorIdx[i]] = e
+		}
+		c.observeWrite(WriteBucketCoor, rtes, len(aIds))
 	}
 
 	if len(bIds) != 0 {
-		rtes, rte := RawRtdbsPutBlobSnapshots64Warp(c.ConnectHandle, bIds, bDatetimes, bSubtimes, bDatas, bQualities)
+		rtes, rte := c.backend.RawRtdbsPutBlobSnapshots64Warp(handle, bIds, bDatetimes, bSubtimes, bDatas, bQualities)
 		if !RteIsOk(rte) {
-			return nil, rte.GoError()
+			return nil, c.opError("WriteSection", rte, 0)
 		}
 		aIndex := make([]int, 0)
 		aIds := make([]PointID, 0)
@@ -2760,23 +3004,24 @@ func (c *RtdbConnect) WriteSection(fix bool, ptvqs []PTVQ) ([]error, error) {
 			}
 		}
 		if len(aIds) != 0 {
-			aRtes, aRte := RawRtdbhPutArchivedBlobValues64Warp(c.ConnectHandle, aIds, aDatetimes, aSubtimes, aDatas, aQualities)
+			aRtes, aRte := c.backend.RawRtdbhPutArchivedBlobValues64Warp(handle, aIds, aDatetimes, aSubtimes, aDatas, aQualities)
 			if !RteIsOk(aRte) {
-				return nil, aRte.GoError()
+				return nil, c.opError("WriteSection", aRte, 0)
 			}
 			for i, e := range aRtes {
 				rtes[aIndex[i]] = e
 			}
-			for i, e := range rtes {
-				rtnRtes[bIdx[i]] = e
-			}
 		}
+		for i, e := range rtes {
+			rtnRtes[bIdx[i]] = e
+		}
+		c.observeWrite(WriteBucketBlob, rtes, len(aIds))
 	}
 
 	if len(namedIds) != 0 {
-		rtes, rte := RawRtdbsPutNamedTypeSnapshots64Warp(c.ConnectHandle, namedIds, namedDatetimes, namedSubtimes, namedDatas, namedQualities)
+		rtes, rte := c.backend.RawRtdbsPutNamedTypeSnapshots64Warp(handle, namedIds, namedDatetimes, namedSubtimes, namedDatas, namedQualities)
 		if !RteIsOk(rte) {
-			return nil, rte.GoError()
+			return nil, c.opError("WriteSection", rte, 0)
 		}
 		aIndex := make([]int, 0)
 		aIds := make([]PointID, 0)
@@ -2795,23 +3040,24 @@ func (c *RtdbConnect) WriteSection(fix bool, ptvqs []PTVQ) ([]error, error) {
 			}
 		}
 		if len(aIds) != 0 {
-			aRtes, aRte := RawRtdbhPutArchivedNamedTypeValues64Warp(c.ConnectHandle, aIds, aDatetimes, aSubtimes, aDatas, aQualities)
+			aRtes, aRte := c.backend.RawRtdbhPutArchivedNamedTypeValues64Warp(handle, aIds, aDatetimes, aSubtimes, aDatas, aQualities)
 			if !RteIsOk(aRte) {
-				return nil, aRte.GoError()
+				return nil, c.opError("WriteSection", aRte, 0)
 			}
 			for i, e := range aRtes {
 				rtes[aIndex[i]] = e
 			}
-			for i, e := range rtes {
-				rtnRtes[namedIdx[i]] = e
-			}
 		}
+		for i, e := range rtes {
+			rtnRtes[namedIdx[i]] = e
+		}
+		c.observeWrite(WriteBucketNamed, rtes, len(aIds))
 	}
 
 	if len(dtIds) != 0 {
-		rtes, rte := RawRtdbsPutDatetimeSnapshots64Warp(c.ConnectHandle, dtIds, dtDatetimes, dtSubtimes, dtDates, dtQualities)
+		rtes, rte := c.backend.RawRtdbsPutDatetimeSnapshots64Warp(handle, dtIds, dtDatetimes, dtSubtimes, dtDates, dtQualities)
 		if !RteIsOk(rte) {
-			return nil, rte.GoError()
+			return nil, c.opError("WriteSection", rte, 0)
 		}
 		aIndex := make([]int, 0)
 		aIds := make([]PointID, 0)
@@ -2830,64 +3076,620 @@ func (c *RtdbConnect) WriteSection(fix bool, ptvqs []PTVQ) ([]error, error) {
 			}
 		}
 		if len(aIds) != 0 {
-			aRtes, aRte := RawRtdbhPutArchivedDatetimeValues64Warp(c.ConnectHandle, aIds, aDatetimes, aSubtimes, aDates, aQualities)
+			aRtes, aRte := c.backend.RawRtdbhPutArchivedDatetimeValues64Warp(handle, aIds, aDatetimes, aSubtimes, aDates, aQualities)
 			if !RteIsOk(aRte) {
-				return nil, aRte.GoError()
+				return nil, c.opError("WriteSection", aRte, 0)
 			}
 			for i, e := range aRtes {
 				rtes[aIndex[i]] = e
 			}
-			for i, e := range rtes {
-				rtnRtes[dtIdx[i]] = e
-			}
 		}
+		for i, e := range rtes {
+			rtnRtes[dtIdx[i]] = e
+		}
+		c.observeWrite(WriteBucketDatetime, rtes, len(aIds))
 	}
 
-	return RtdbErrorListToErrorList(rtnRtes), nil
+	ids := make([]PointID, len(ptvqs))
+	for i, ptvq := range ptvqs {
+		ids[i] = ptvq.PointInfo.ID
+	}
+	return c.opErrors("WriteSection", ids, rtnRtes), nil
 }
 
+// newNumberTvq 根据读取到的整数或浮点数创建TVQ
+func newNumberTvq(rtdbType RtdbType, ts time.Time, value float64, state int64, quality Quality) TVQ {
+	switch rtdbType {
+	case RtdbTypeBool:
+		return NewTvqBool(ts, Int64ToBool(state), quality)
+	case RtdbTypeUint8:
+		return NewTvqUint8(ts, uint8(state), quality)
+	case RtdbTypeInt8:
+		return NewTvqInt8(ts, int8(state), quality)
+	case RtdbTypeChar:
+		return NewTvqChar(ts, byte(state), quality)
+	case RtdbTypeUint16:
+		return NewTvqUint16(ts, uint16(state), quality)
+	case RtdbTypeInt16:
+		return NewTvqInt16(ts, int16(state), quality)
+	case RtdbTypeUint32:
+		return NewTvqUint32(ts, uint32(state), quality)
+	case RtdbTypeInt32:
+		return NewTvqInt32(ts, int32(state), quality)
+	case RtdbTypeInt64:
+		return NewTvqInt64(ts, state, quality)
+	case RtdbTypeReal16:
+		return NewTvqFloat16(ts, float32(value), quality)
+	case RtdbTypeReal32:
+		return NewTvqFloat32(ts, float32(value), quality)
+	case RtdbTypeReal64:
+		return NewTvqFloat64(ts, value, quality)
+	case RtdbTypeFp16:
+		return NewTvqFp16(ts, float32(value), quality)
+	case RtdbTypeFp32:
+		return NewTvqFp32(ts, float32(value), quality)
+	case RtdbTypeFp64:
+		return NewTvqFp64(ts, value, quality)
+	}
+	return TVQ{}
+}
+
+// ReadValue 读取单个标签点的历史数据, 支持所有数值类型
+//
+// input:
+//   - info 标签点信息
+//   - mode 读取模式, 参见 RtdbHisMode
+//   - timestamp 时间戳
+//
+// output:
+//   - TVQ(tvq) 读取到的数值
 func (c *RtdbConnect) ReadValue(info *PointInfo, mode RtdbHisMode, timestamp time.Time) (TVQ, error) {
+	handle, release := c.acquire()
+	defer release()
+	defer c.observeRead(mode, time.Now())
 	rtdbType, _ := info.ValueType.ToRawType()
 	datetime, subtime := GoTimeToRtdbTimestamp(timestamp, info.Precision)
 	switch rtdbType {
 	case RtdbTypeBool, RtdbTypeUint8, RtdbTypeInt8, RtdbTypeChar, RtdbTypeUint16, RtdbTypeInt16, RtdbTypeUint32, RtdbTypeInt32, RtdbTypeInt64, RtdbTypeReal16, RtdbTypeReal32, RtdbTypeReal64, RtdbTypeFp16, RtdbTypeFp32, RtdbTypeFp64:
-		dt, ms, value, state, quality, rte := RawRtdbhGetSingleValue64Warp(c.ConnectHandle, info.ID, mode, datetime, subtime)
+		dt, ms, value, state, quality, rte := c.backend.RawRtdbhGetSingleValue64Warp(handle, info.ID, mode, datetime, subtime)
+		if !RteIsOk(rte) {
+			return TVQ{}, c.opError("ReadValue", rte, info.ID)
+		}
+		return newNumberTvq(rtdbType, RtdbTimestampToGoTime(dt, ms, info.Precision), value, state, quality), nil
+	case RtdbTypeCoor:
+		dt, ms, x, y, quality, rte := c.backend.RawRtdbhGetSingleCoorValue64Warp(handle, info.ID, mode, datetime, subtime)
 		if !RteIsOk(rte) {
-			return TVQ{}, rte.GoError()
+			return TVQ{}, c.opError("ReadValue", rte, info.ID)
+		}
+		return NewTvqCoordinates(RtdbTimestampToGoTime(dt, ms, info.Precision), x, y, quality), nil
+	case RtdbTypeString, RtdbTypeBlob:
+		dt, ms, data, quality, rte := c.backend.RawRtdbhGetSingleBlobValue64Warp(handle, info.ID, mode, datetime, subtime, c.StringBlobMaxLen)
+		if !RteIsOk(rte) {
+			return TVQ{}, c.opError("ReadValue", rte, info.ID)
 		}
 		ts := RtdbTimestampToGoTime(dt, ms, info.Precision)
-		switch rtdbType {
-		case RtdbTypeBool:
-			return NewTvqBool(ts, Int64ToBool(state), quality), nil
-		case RtdbTypeUint8:
-			return NewTvqUint8(ts, uint8(state), quality), nil
-		case RtdbTypeInt8:
-			return NewTvqInt8(ts, int8(state), quality), nil
-		case RtdbTypeChar:
-			return NewTvqChar(ts, byte(state), quality), nil
-		case RtdbTypeUint16:
-			return NewTvqUint16(ts, uint16(state), quality), nil
-		case RtdbTypeInt16:
-			return NewTvqInt16(ts, int16(state), quality), nil
-		case RtdbTypeUint32:
-			return NewTvqUint32(ts, uint32(state), quality), nil
-		case RtdbTypeInt32:
-			return NewTvqInt32(ts, int32(state), quality), nil
-		case RtdbTypeInt64:
-			return NewTvqInt64(ts, state, quality), nil
-		case RtdbTypeReal16:
-			return NewTvqFloat16(ts, float32(value), quality), nil
-		case RtdbTypeReal32:
-			return NewTvqFloat32(ts, float32(value), quality), nil
-		case RtdbTypeReal64:
-			return NewTvqFloat64(ts, value, quality), nil
-		case RtdbTypeFp16:
-			return NewTvqFp16(ts, float32(value), quality), nil
-		case RtdbTypeFp32:
-			return NewTvqFp32(ts, float32(value), quality), nil
-		case RtdbTypeFp64:
-			return NewTvqFp64(ts, value, quality), nil
+		if rtdbType == RtdbTypeBlob {
+			return NewTvqBlob(ts, data, quality), nil
+		}
+		str, err := RtdbStringFromBlob(c.serverOsType(), data)
+		if err != nil {
+			return TVQ{}, err
+		}
+		return NewTvqString(ts, str, quality), nil
+	case RtdbTypeDatetime:
+		dt, ms, data, quality, rte := c.backend.RawRtdbhGetSingleDatetimeValue64Warp(handle, info.ID, mode, datetime, subtime, -1)
+		if !RteIsOk(rte) {
+			return TVQ{}, c.opError("ReadValue", rte, info.ID)
+		}
+		return NewTvqDatetime(RtdbTimestampToGoTime(dt, ms, info.Precision), string(data), quality), nil
+	case RtdbTypeNamedT:
+		_, name := info.ValueType.ToRawType()
+		namedType, err := c.GetNamedType(name)
+		if err != nil {
+			return TVQ{}, err
+		}
+		dt, ms, data, quality, rte := c.backend.RawRtdbhGetSingleNamedTypeValue64Warp(handle, info.ID, mode, datetime, subtime, namedType.Length)
+		if !RteIsOk(rte) {
+			return TVQ{}, c.opError("ReadValue", rte, info.ID)
 		}
+		return NewTvqNamed(RtdbTimestampToGoTime(dt, ms, info.Precision), info.ValueType, data, quality), nil
 	}
 	return TVQ{}, nil
 }
+
+// ReadArchivedValues 读取单个标签点一段时间内的历史数据, 支持所有数值类型
+//
+// input:
+//   - info 标签点信息
+//   - start 开始时间(包含)
+//   - end 结束时间(包含)
+//   - maxCount 最多返回的数值个数
+//
+// output:
+//   - []TVQ(tvqs) 按时间升序排列的数值
+func (c *RtdbConnect) ReadArchivedValues(info *PointInfo, start, end time.Time, maxCount int32) ([]TVQ, error) {
+	handle, release := c.acquire()
+	defer release()
+	tvqs := make([]TVQ, 0)
+	if maxCount <= 0 {
+		return tvqs, nil
+	}
+	rtdbType, _ := info.ValueType.ToRawType()
+	datetime1, subtime1 := GoTimeToRtdbTimestamp(start, info.Precision)
+	datetime2, subtime2 := GoTimeToRtdbTimestamp(end, info.Precision)
+	switch rtdbType {
+	case RtdbTypeBool, RtdbTypeUint8, RtdbTypeInt8, RtdbTypeChar, RtdbTypeUint16, RtdbTypeInt16, RtdbTypeUint32, RtdbTypeInt32, RtdbTypeInt64, RtdbTypeReal16, RtdbTypeReal32, RtdbTypeReal64, RtdbTypeFp16, RtdbTypeFp32, RtdbTypeFp64:
+		dts, mss, values, states, qualities, rte := c.backend.RawRtdbhGetArchivedValues64Warp(handle, info.ID, maxCount, datetime1, subtime1, datetime2, subtime2)
+		if !RteIsOk(rte) {
+			return nil, c.opError("ReadArchivedValues", rte, info.ID)
+		}
+		for i := range dts {
+			tvqs = append(tvqs, newNumberTvq(rtdbType, RtdbTimestampToGoTime(dts[i], mss[i], info.Precision), values[i], states[i], qualities[i]))
+		}
+	case RtdbTypeCoor:
+		dts, mss, xs, ys, qualities, rte := c.backend.RawRtdbhGetArchivedCoorValues64Warp(handle, info.ID, maxCount, datetime1, subtime1, datetime2, subtime2)
+		if !RteIsOk(rte) {
+			return nil, c.opError("ReadArchivedValues", rte, info.ID)
+		}
+		for i := range dts {
+			tvqs = append(tvqs, NewTvqCoordinates(RtdbTimestampToGoTime(dts[i], mss[i], info.Precision), xs[i], ys[i], qualities[i]))
+		}
+	case RtdbTypeString, RtdbTypeBlob:
+		dts, mss, datas, qualities, rte := c.backend.RawRtdbhGetArchivedBlobValues64Warp(handle, info.ID, c.StringBlobMaxLen, maxCount, datetime1, subtime1, datetime2, subtime2)
+		if !RteIsOk(rte) {
+			return nil, c.opError("ReadArchivedValues", rte, info.ID)
+		}
+		for i := range dts {
+			ts := RtdbTimestampToGoTime(dts[i], mss[i], info.Precision)
+			if rtdbType == RtdbTypeBlob {
+				tvqs = append(tvqs, NewTvqBlob(ts, datas[i], qualities[i]))
+				continue
+			}
+			str, err := RtdbStringFromBlob(c.serverOsType(), datas[i])
+			if err != nil {
+				return nil, err
+			}
+			tvqs = append(tvqs, NewTvqString(ts, str, qualities[i]))
+		}
+	case RtdbTypeDatetime:
+		dts, mss, datas, qualities, rte := c.backend.RawRtdbhGetArchivedDatetimeValues64Warp(handle, info.ID, maxCount, datetime1, subtime1, datetime2, subtime2, -1)
+		if !RteIsOk(rte) {
+			return nil, c.opError("ReadArchivedValues", rte, info.ID)
+		}
+		for i := range dts {
+			tvqs = append(tvqs, NewTvqDatetime(RtdbTimestampToGoTime(dts[i], mss[i], info.Precision), string(datas[i]), qualities[i]))
+		}
+	case RtdbTypeNamedT:
+		_, name := info.ValueType.ToRawType()
+		namedType, err := c.GetNamedType(name)
+		if err != nil {
+			return nil, err
+		}
+		dts, mss, datas, qualities, rte := c.backend.RawRtdbhGetArchivedNamedTypeValues64Warp(handle, info.ID, datetime1, subtime1, datetime2, subtime2, namedType.Length, maxCount)
+		if !RteIsOk(rte) {
+			return nil, c.opError("ReadArchivedValues", rte, info.ID)
+		}
+		for i := range dts {
+			tvqs = append(tvqs, NewTvqNamed(RtdbTimestampToGoTime(dts[i], mss[i], info.Precision), info.ValueType, datas[i], qualities[i]))
+		}
+	}
+	return tvqs, nil
+}
+
+// ArchivedValuesPageSize ArchivedValues 每次读取的数值个数
+const ArchivedValuesPageSize = 1000
+
+// archivedValuesPageSize 测试时可以调小, 方便让分页边界落在相同的时间戳上
+var archivedValuesPageSize = ArchivedValuesPageSize
+
+// ceilPrecision 把时间向上取整到标签点的时间精度, 避免服务端截断后读到开始时间之前的数值
+func ceilPrecision(t time.Time, precision RtdbPrecision) time.Time {
+	unit := time.Nanosecond
+	switch precision {
+	case RtdbPrecisionSecond:
+		unit = time.Second
+	case RtdbPrecisionMilli:
+		unit = time.Millisecond
+	case RtdbPrecisionMicro:
+		unit = time.Microsecond
+	}
+	if truncated := t.Truncate(unit); truncated.Before(t) {
+		return truncated.Add(unit)
+	}
+	return t
+}
+
+// ArchivedValues 分批读取单个标签点一段时间内的历史数据, 用于遍历大量数据
+//   - 开始时间向上取整到标签点的时间精度
+//   - 每次读取 ArchivedValuesPageSize 个数值, 下一批从上一批最后一个时间戳开始, 跳过这个时间戳上已经返回的数值
+//   - 多个数值的时间戳相同时不会丢失, 也不会重复返回
+//   - 读取出错时返回错误并结束遍历
+//
+// input:
+//   - info 标签点信息
+//   - start 开始时间(包含)
+//   - end 结束时间(包含)
+//
+// output:
+//   - iter.Seq2[TVQ, error] 按时间升序排列的数值
+func (c *RtdbConnect) ArchivedValues(info *PointInfo, start, end time.Time) iter.Seq2[TVQ, error] {
+	return func(yield func(TVQ, error) bool) {
+		start := ceilPrecision(start, info.Precision)
+		// skip 是 start 这个时间戳上已经返回的数值个数
+		skip := 0
+		for !start.After(end) {
+			count := archivedValuesPageSize + skip
+			tvqs, err := c.ReadArchivedValues(info, start, end, int32(count))
+			if err != nil {
+				yield(TVQ{}, err)
+				return
+			}
+			for _, tvq := range tvqs[min(skip, len(tvqs)):] {
+				if !yield(tvq, nil) {
+					return
+				}
+			}
+			if len(tvqs) < count {
+				return
+			}
+			last := tvqs[len(tvqs)-1].Timestamp
+			if last.Equal(start) {
+				// 整批都是同一个时间戳, 下一批多读一些
+				skip = len(tvqs)
+				continue
+			}
+			start, skip = last, 0
+			for i := len(tvqs) - 1; i >= 0 && tvqs[i].Timestamp.Equal(last); i-- {
+				skip++
+			}
+		}
+	}
+}
+
+// WriteArchivedValues 批量写入历史存档, 不经过快照, 适用于补录或迁移历史数据
+//
+// input:
+//   - ptvqs PTVQ值数组, 同一个标签点可以写入多条数值
+//
+// output:
+//   - []error(errs) 错误列表
+func (c *RtdbConnect) WriteArchivedValues(ptvqs []PTVQ) ([]error, error) {
+	handle, release := c.acquire()
+	defer release()
+	rtnRtes := make([]RtdbError, len(ptvqs))
+	type group struct {
+		idx       []int
+		ids       []PointID
+		datetimes []TimestampType
+		subtimes  []SubtimeType
+		qualities []Quality
+	}
+	add := func(g *group, i int, ptvq PTVQ) {
+		datetime, subtime := ptvq.TVQ.GetRtdbTimestamp(ptvq.PointInfo.Precision)
+		g.idx = append(g.idx, i)
+		g.ids = append(g.ids, ptvq.PointInfo.ID)
+		g.datetimes = append(g.datetimes, datetime)
+		g.subtimes = append(g.subtimes, subtime)
+		g.qualities = append(g.qualities, ptvq.TVQ.GetRtdbQuality())
+	}
+	number, coor, blob, named, dt := group{}, group{}, group{}, group{}, group{}
+	values, states := make([]float64, 0), make([]int64, 0)
+	xs, ys := make([]float32, 0), make([]float32, 0)
+	blobs, objects, dates := make([][]byte, 0), make([][]byte, 0), make([]string, 0)
+	for i, ptvq := range ptvqs {
+		rtdbType, _ := ptvq.PointInfo.ValueType.ToRawType()
+		switch rtdbType {
+		case RtdbTypeBool, RtdbTypeUint8, RtdbTypeInt8, RtdbTypeChar, RtdbTypeUint16, RtdbTypeInt16, RtdbTypeUint32, RtdbTypeInt32, RtdbTypeInt64:
+			add(&number, i, ptvq)
+			values = append(values, 0)
+			states = append(states, ptvq.TVQ.GetRtdbInt())
+		case RtdbTypeReal16, RtdbTypeReal32, RtdbTypeReal64, RtdbTypeFp16, RtdbTypeFp32, RtdbTypeFp64:
+			add(&number, i, ptvq)
+			values = append(values, ptvq.TVQ.GetRtdbFloat())
+			states = append(states, 0)
+		case RtdbTypeCoor:
+			add(&coor, i, ptvq)
+			xy := ptvq.TVQ.GetRtdbCoordinates()
+			xs = append(xs, xy.X)
+			ys = append(ys, xy.Y)
+		case RtdbTypeString, RtdbTypeBlob:
+			data, err := ptvq.TVQ.GetRtdbStringBlob(c.serverOsType())
+			if err != nil {
+				return nil, err
+			}
+			add(&blob, i, ptvq)
+			blobs = append(blobs, data)
+		case RtdbTypeNamedT:
+			add(&named, i, ptvq)
+			objects = append(objects, ptvq.TVQ.GetRtdbNamedObj())
+		case RtdbTypeDatetime:
+			add(&dt, i, ptvq)
+			dates = append(dates, ptvq.TVQ.GetRtdbDatetime())
+		}
+	}
+
+	write := func(g *group, bucket string, put func() ([]RtdbError, RtdbError)) error {
+		if len(g.ids) == 0 {
+			return nil
+		}
+		rtes, rte := put()
+		if !RteIsOk(rte) {
+			return c.opError("WriteArchivedValues", rte, 0)
+		}
+		for i, e := range rtes {
+			rtnRtes[g.idx[i]] = e
+		}
+		c.observeWrite(bucket, rtes, 0)
+		return nil
+	}
+	if err := write(&number, WriteBucketNumber, func() ([]RtdbError, RtdbError) {
+		return c.backend.RawRtdbhPutArchivedValues64Warp(handle, number.ids, number.datetimes, number.subtimes, values, states, number.qualities)
+	}); err != nil {
+		return nil, err
+	}
+	if err := write(&coor, WriteBucketCoor, func() ([]RtdbError, RtdbError) {
+		return c.backend.RawRtdbhPutArchivedCoorValues64Warp(handle, coor.ids, coor.datetimes, coor.subtimes, xs, ys, coor.qualities)
+	}); err != nil {
+		return nil, err
+	}
+	if err := write(&blob, WriteBucketBlob, func() ([]RtdbError, RtdbError) {
+		return c.backend.RawRtdbhPutArchivedBlobValues64Warp(handle, blob.ids, blob.datetimes, blob.subtimes, blobs, blob.qualities)
+	}); err != nil {
+		return nil, err
+	}
+	if err := write(&named, WriteBucketNamed, func() ([]RtdbError, RtdbError) {
+		return c.backend.RawRtdbhPutArchivedNamedTypeValues64Warp(handle, named.ids, named.datetimes, named.subtimes, objects, named.qualities)
+	}); err != nil {
+		return nil, err
+	}
+	if err := write(&dt, WriteBucketDatetime, func() ([]RtdbError, RtdbError) {
+		return c.backend.RawRtdbhPutArchivedDatetimeValues64Warp(handle, dt.ids, dt.datetimes, dt.subtimes, dates, dt.qualities)
+	}); err != nil {
+		return nil, err
+	}
+
+	ids := make([]PointID, len(ptvqs))
+	for i, ptvq := range ptvqs {
+		ids[i] = ptvq.PointInfo.ID
+	}
+	return c.opErrors("WriteArchivedValues", ids, rtnRtes), nil
+}
+
+// ReadSnapshots 批量读取快照(标签点的当前值), 支持所有数值类型
+//
+// input:
+//   - infos 标签点信息
+//
+// output:
+//   - []TVQ(tvqs) 快照, 与infos一一对应
+//   - []error(errs) 错误列表
+func (c *RtdbConnect) ReadSnapshots(infos []*PointInfo) ([]TVQ, []error, error) {
+	handle, release := c.acquire()
+	defer release()
+	tvqs := make([]TVQ, len(infos))
+	rtnRtes := make([]RtdbError, len(infos))
+	type group struct {
+		idx []int
+		ids []PointID
+	}
+	number, coor, blob, named, dt := group{}, group{}, group{}, group{}, group{}
+	lens := make([]int32, 0)
+	for i, info := range infos {
+		rtdbType, name := info.ValueType.ToRawType()
+		g := &number
+		switch rtdbType {
+		case RtdbTypeCoor:
+			g = &coor
+		case RtdbTypeString, RtdbTypeBlob:
+			g = &blob
+		case RtdbTypeDatetime:
+			g = &dt
+		case RtdbTypeNamedT:
+			namedType, err := c.GetNamedType(name)
+			if err != nil {
+				return nil, nil, err
+			}
+			g = &named
+			lens = append(lens, namedType.Length)
+		}
+		g.idx = append(g.idx, i)
+		g.ids = append(g.ids, info.ID)
+	}
+
+	read := func(g *group, get func() ([]TimestampType, []SubtimeType, []RtdbError, RtdbError), tvq func(i int, ts time.Time, info *PointInfo) (TVQ, error)) error {
+		if len(g.ids) == 0 {
+			return nil
+		}
+		datetimes, subtimes, rtes, rte := get()
+		if !RteIsOk(rte) {
+			return c.opError("ReadSnapshots", rte, 0)
+		}
+		for i, idx := range g.idx {
+			if rtnRtes[idx] = rtes[i]; !RteIsOk(rtes[i]) {
+				continue
+			}
+			info := infos[idx]
+			v, err := tvq(i, RtdbTimestampToGoTime(datetimes[i], subtimes[i], info.Precision), info)
+			if err != nil {
+				return err
+			}
+			tvqs[idx] = v
+		}
+		return nil
+	}
+	var values []float64
+	var states []int64
+	var xs, ys []float32
+	var datas [][]byte
+	var dates []string
+	var qualities []Quality
+	if err := read(&number, func() ([]TimestampType, []SubtimeType, []RtdbError, RtdbError) {
+		datetimes, subtimes, vs, ss, qs, rtes, rte := c.backend.RawRtdbsGetSnapshots64Warp(handle, number.ids)
+		values, states, qualities = vs, ss, qs
+		return datetimes, subtimes, rtes, rte
+	}, func(i int, ts time.Time, info *PointInfo) (TVQ, error) {
+		rtdbType, _ := info.ValueType.ToRawType()
+		return newNumberTvq(rtdbType, ts, values[i], states[i], qualities[i]), nil
+	}); err != nil {
+		return nil, nil, err
+	}
+	if err := read(&coor, func() ([]TimestampType, []SubtimeType, []RtdbError, RtdbError) {
+		datetimes, subtimes, x, y, qs, rtes, rte := c.backend.RawRtdbsGetCoorSnapshots64Warp(handle, coor.ids)
+		xs, ys, qualities = x, y, qs
+		return datetimes, subtimes, rtes, rte
+	}, func(i int, ts time.Time, info *PointInfo) (TVQ, error) {
+		return NewTvqCoordinates(ts, xs[i], ys[i], qualities[i]), nil
+	}); err != nil {
+		return nil, nil, err
+	}
+	if err := read(&blob, func() ([]TimestampType, []SubtimeType, []RtdbError, RtdbError) {
+		datetimes, subtimes, ds, qs, rtes, rte := c.backend.RawRtdbsGetBlobSnapshots64Warp(handle, blob.ids, c.StringBlobMaxLen)
+		datas, qualities = ds, qs
+		return datetimes, subtimes, rtes, rte
+	}, func(i int, ts time.Time, info *PointInfo) (TVQ, error) {
+		if info.ValueType == ValueTypeBlob {
+			return NewTvqBlob(ts, datas[i], qualities[i]), nil
+		}
+		str, err := RtdbStringFromBlob(c.serverOsType(), datas[i])
+		if err != nil {
+			return TVQ{}, err
+		}
+		return NewTvqString(ts, str, qualities[i]), nil
+	}); err != nil {
+		return nil, nil, err
+	}
+	if err := read(&dt, func() ([]TimestampType, []SubtimeType, []RtdbError, RtdbError) {
+		datetimes, subtimes, ds, qs, rtes, rte := c.backend.RawRtdbsGetDatetimeSnapshots64Warp(handle, dt.ids, -1)
+		dates, qualities = ds, qs
+		return datetimes, subtimes, rtes, rte
+	}, func(i int, ts time.Time, info *PointInfo) (TVQ, error) {
+		return NewTvqDatetime(ts, dates[i], qualities[i]), nil
+	}); err != nil {
+		return nil, nil, err
+	}
+	if err := read(&named, func() ([]TimestampType, []SubtimeType, []RtdbError, RtdbError) {
+		datetimes, subtimes, ds, qs, rtes, rte := c.backend.RawRtdbsGetNamedTypeSnapshots64Warp(handle, named.ids, lens)
+		datas, qualities = ds, qs
+		return datetimes, subtimes, rtes, rte
+	}, func(i int, ts time.Time, info *PointInfo) (TVQ, error) {
+		return NewTvqNamed(ts, info.ValueType, datas[i], qualities[i]), nil
+	}); err != nil {
+		return nil, nil, err
+	}
+
+	ids := make([]PointID, len(infos))
+	for i, info := range infos {
+		ids[i] = info.ID
+	}
+	return tvqs, c.opErrors("ReadSnapshots", ids, rtnRtes), nil
+}
+
+// ReadInterpoValues 读取单个标签点一段时间内等间隔的插值, 只支持整数与浮点数类型
+//
+// input:
+//   - info 标签点信息
+//   - start 开始时间
+//   - end 结束时间
+//   - count 插值个数, 包含开始时间与结束时间
+//
+// output:
+//   - []TVQ(tvqs) 按时间升序排列的插值
+func (c *RtdbConnect) ReadInterpoValues(info *PointInfo, start, end time.Time, count int32) ([]TVQ, error) {
+	handle, release := c.acquire()
+	defer release()
+	defer c.observeRead(RtdbHisModeInter, time.Now())
+	rtdbType, _ := info.ValueType.ToRawType()
+	switch rtdbType {
+	case RtdbTypeBool, RtdbTypeUint8, RtdbTypeInt8, RtdbTypeChar, RtdbTypeUint16, RtdbTypeInt16, RtdbTypeUint32, RtdbTypeInt32, RtdbTypeInt64, RtdbTypeReal16, RtdbTypeReal32, RtdbTypeReal64, RtdbTypeFp16, RtdbTypeFp32, RtdbTypeFp64:
+	default:
+		return nil, c.opError("ReadInterpoValues", RteInvalidDataType, info.ID)
+	}
+	if count <= 0 {
+		return make([]TVQ, 0), nil
+	}
+	datetime1, subtime1 := GoTimeToRtdbTimestamp(start, info.Precision)
+	datetime2, subtime2 := GoTimeToRtdbTimestamp(end, info.Precision)
+	datetimes, subtimes, values, states, qualities, rte := c.backend.RawRtdbhGetInterpoValues64Warp(handle, info.ID, count, datetime1, subtime1, datetime2, subtime2)
+	if !RteIsOk(rte) {
+		return nil, c.opError("ReadInterpoValues", rte, info.ID)
+	}
+	tvqs := make([]TVQ, len(datetimes))
+	for i := range datetimes {
+		tvqs[i] = newNumberTvq(rtdbType, RtdbTimestampToGoTime(datetimes[i], subtimes[i], info.Precision), values[i], states[i], qualities[i])
+	}
+	return tvqs, nil
+}
+
+// SummaryValue 统计值中的单个数值
+type SummaryValue struct {
+	Timestamp time.Time `json:"t"`
+	Value     float64   `json:"v"`
+	Quality   Quality   `json:"q"`
+}
+
+// Summary 单个标签点一段时间内的统计值, 参见 RtdbSummaryData
+type Summary struct {
+	First SummaryValue `json:"first"`
+	Last  SummaryValue `json:"last"`
+	Max   SummaryValue `json:"max"`
+	Min   SummaryValue `json:"min"`
+
+	// Power 加权值
+	Power float64 `json:"power"`
+
+	// PowerAvg 加权平均值
+	PowerAvg float64 `json:"power_avg"`
+
+	// Total 累计值
+	Total float64 `json:"total"`
+
+	// CalcAvg 算术平均值
+	CalcAvg float64 `json:"calc_avg"`
+
+	// Count 个数
+	Count int32 `json:"count"`
+
+	// ValidCount 有效个数
+	ValidCount int32 `json:"valid_count"`
+}
+
+// ReadSummary 读取单个标签点一段时间内的统计值, 只支持整数与浮点数类型
+//
+// input:
+//   - info 标签点信息
+//   - start 开始时间, 为零值时从最早的数据开始
+//   - end 结束时间, 为零值时统计到最近的数据
+//
+// output:
+//   - *Summary(summary) 统计值
+func (c *RtdbConnect) ReadSummary(info *PointInfo, start, end time.Time) (*Summary, error) {
+	handle, release := c.acquire()
+	defer release()
+	var datetime1, datetime2 TimestampType
+	var subtime1, subtime2 SubtimeType
+	if !start.IsZero() {
+		datetime1, subtime1 = GoTimeToRtdbTimestamp(start, info.Precision)
+	}
+	if !end.IsZero() {
+		datetime2, subtime2 = GoTimeToRtdbTimestamp(end, info.Precision)
+	}
+	data, rte := c.backend.RawRtdbhSummaryDataWarp(handle, info.ID, datetime1, subtime1, datetime2, subtime2)
+	if !RteIsOk(rte) {
+		return nil, c.opError("ReadSummary", rte, info.ID)
+	}
+	value := func(datetime TimestampType, subtime SubtimeType, v float64, q int16) SummaryValue {
+		return SummaryValue{Timestamp: RtdbTimestampToGoTime(datetime, subtime, info.Precision), Value: v, Quality: Quality(q)}
+	}
+	return &Summary{
+		First:      value(data.FirstTime, data.FirstSubtime, data.FirstValue, data.FirstQuality),
+		Last:       value(data.LastTime, data.LastSubtime, data.LastValue, data.LastQuality),
+		Max:        value(data.MaxTime, data.MaxSubtime, data.MaxValue, data.MaxQuality),
+		Min:        value(data.MinTime, data.MinSubtime, data.MinValue, data.MinQuality),
+		Power:      data.Power,
+		PowerAvg:   data.PowerAvg,
+		Total:      data.Total,
+		CalcAvg:    data.CalcAvg,
+		Count:      data.Count,
+		ValidCount: data.ValidCount,
+	}, nil
+}
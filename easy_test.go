@@ -7,6 +7,11 @@ import (
 	"time"
 )
 
+const Hostname = "159.75.187.68"
+const Port = 6327
+const Username = "sa"
+const Password = "golden"
+
 // 用户登录/登出
 func TestLoginLogout(t *testing.T) {
 	// 登录
@@ -0,0 +1,45 @@
+//go:build !rtdb_noembed && cgo
+
+// 内置的动态库, 使用 rtdb_noembed 编译标签可以去掉内置动态库以减小二进制体积
+
+package rtdb_api
+
+//go:generate go run gen_sha256.go
+
+import (
+	_ "embed"
+	"runtime"
+)
+
+//go:embed clibrary/linux_amd64/librtdbapi.so
+var LinuxAmd64RtdbSo []byte
+
+//go:embed clibrary/linux_arm64/librtdbapi.so
+var LinuxArm64RtdbSo []byte
+
+//go:embed clibrary/windows_amd32/rtdbapi.dll
+var WindowsAmd32RtdbSo []byte
+
+//go:embed clibrary/windows_amd64/rtdbapi.dll
+var WindowsAmd64RtdbSo []byte
+
+// embeddedLibrary 获取当前平台对应的内置动态库
+//
+// output:
+//   - []byte(data) 动态库内容
+//   - string(name) 动态库文件名
+//   - string(sum) 生成 embed_sha256.go 时记录的SHA256(十六进制)
+func embeddedLibrary() ([]byte, string, string, error) {
+	switch runtime.GOOS + "/" + runtime.GOARCH {
+	case "linux/amd64":
+		return LinuxAmd64RtdbSo, "librtdbapi.so", linuxAmd64RtdbSoSha256, nil
+	case "linux/arm64":
+		return LinuxArm64RtdbSo, "librtdbapi.so", linuxArm64RtdbSoSha256, nil
+	case "windows/amd64":
+		return WindowsAmd64RtdbSo, "rtdbapi.dll", windowsAmd64RtdbSoSha256, nil
+	case "windows/386":
+		return WindowsAmd32RtdbSo, "rtdbapi.dll", windowsAmd32RtdbSoSha256, nil
+	default:
+		return nil, "", "", errUnsupportedPlatform
+	}
+}
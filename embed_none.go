@@ -0,0 +1,12 @@
+//go:build rtdb_noembed && cgo
+
+// 使用 rtdb_noembed 编译标签时不内置动态库, 需要通过环境变量 RTDB_API_LIBRARY 或 LoadLibrary 指定动态库
+
+package rtdb_api
+
+import "errors"
+
+// embeddedLibrary 未内置动态库
+func embeddedLibrary() ([]byte, string, string, error) {
+	return nil, "", "", errors.New("编译时使用了rtdb_noembed标签，没有内置动态库，请通过环境变量" + EnvLibraryPath + "或LoadLibrary指定动态库")
+}
@@ -0,0 +1,13 @@
+// Code generated by gen_sha256.go; DO NOT EDIT.
+
+//go:build !rtdb_noembed && cgo
+
+package rtdb_api
+
+// 内置动态库的SHA256, 更新 clibrary 中的动态库后需要执行 go generate 重新生成, 空文件的校验值为空字符串
+const (
+	linuxAmd64RtdbSoSha256   = ""                                                                 // clibrary/linux_amd64/librtdbapi.so, 空文件
+	linuxArm64RtdbSoSha256   = ""                                                                 // clibrary/linux_arm64/librtdbapi.so, 空文件
+	windowsAmd32RtdbSoSha256 = "140cee5100adbdeb6a7f7b3ecd59d9236e263c5e9ba902c630bb710264f05659" // clibrary/windows_amd32/rtdbapi.dll
+	windowsAmd64RtdbSoSha256 = "de80235ac0439dc6255c9e615359bc8c1aededd42f471ca8107161e0e6de1d07" // clibrary/windows_amd64/rtdbapi.dll
+)
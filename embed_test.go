@@ -0,0 +1,54 @@
+//go:build !rtdb_noembed && cgo
+
+package rtdb_api
+
+import (
+	"strings"
+	"testing"
+)
+
+// embed_sha256.go 需要与 clibrary 中的动态库一致, 更新动态库后执行 go generate
+func TestEmbeddedLibraryChecksum(t *testing.T) {
+	libraries := []struct {
+		data []byte
+		sum  string
+	}{
+		{LinuxAmd64RtdbSo, linuxAmd64RtdbSoSha256},
+		{LinuxArm64RtdbSo, linuxArm64RtdbSoSha256},
+		{WindowsAmd32RtdbSo, windowsAmd32RtdbSoSha256},
+		{WindowsAmd64RtdbSo, windowsAmd64RtdbSoSha256},
+	}
+	for i, lib := range libraries {
+		if lib.sum == sha256Hex(nil) {
+			t.Errorf("第%d个动态库的校验值是空数据的SHA256, 只能说明动态库为空", i)
+		}
+		if lib.sum == "" {
+			// 占位的空文件, 加载时报告没有记录SHA256
+			if len(lib.data) != 0 {
+				t.Errorf("第%d个动态库不为空但是没有记录SHA256, 请执行 go generate", i)
+			}
+			continue
+		}
+		if got := sha256Hex(lib.data); got != lib.sum {
+			t.Errorf("第%d个动态库的SHA256为%s, 生成的常量为%s, 请执行 go generate", i, got, lib.sum)
+		}
+	}
+}
+
+// 没有记录校验值的内置动态库不加载
+func TestLoadEmbeddedLibraryWithoutChecksum(t *testing.T) {
+	t.Setenv(EnvLibraryDir, t.TempDir())
+	err := loadEmbeddedLibrary([]byte("rtdb library"), "librtdbapi.so", "")
+	if err == nil || !strings.Contains(err.Error(), "go generate") {
+		t.Fatal("没有校验值时应当提示重新生成", err)
+	}
+}
+
+// 内置数据与生成的校验值不一致时不加载
+func TestLoadEmbeddedLibraryChecksum(t *testing.T) {
+	t.Setenv(EnvLibraryDir, t.TempDir())
+	err := loadEmbeddedLibrary([]byte("rtdb library"), "librtdbapi.so", sha256Hex([]byte("release library")))
+	if err == nil || !strings.Contains(err.Error(), "校验失败") {
+		t.Fatal("校验值不匹配时应当返回校验失败", err)
+	}
+}
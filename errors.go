@@ -0,0 +1,235 @@
+package rtdb_api
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync/atomic"
+)
+
+// ErrorCategory 错误分类
+type ErrorCategory int
+
+const (
+	// ErrorCategoryNone 没有错误
+	ErrorCategoryNone = ErrorCategory(0)
+
+	// ErrorCategoryUnknown 未知错误
+	ErrorCategoryUnknown = ErrorCategory(1)
+
+	// ErrorCategoryNetwork 网络与连接错误
+	ErrorCategoryNetwork = ErrorCategory(2)
+
+	// ErrorCategoryPermission 登录、权限与授权错误
+	ErrorCategoryPermission = ErrorCategory(3)
+
+	// ErrorCategoryNotFound 标签点、表、数据等不存在
+	ErrorCategoryNotFound = ErrorCategory(4)
+
+	// ErrorCategoryConflict 标签点、表、用户等已存在
+	ErrorCategoryConflict = ErrorCategory(5)
+
+	// ErrorCategoryData 参数、数据类型与数值错误
+	ErrorCategoryData = ErrorCategory(6)
+
+	// ErrorCategoryFile 数据文件、存档与索引错误
+	ErrorCategoryFile = ErrorCategory(7)
+
+	// ErrorCategoryServer 服务端资源、任务与同步错误
+	ErrorCategoryServer = ErrorCategory(8)
+
+	// ErrorCategorySystem 操作系统错误(errno、Windows错误、进程间通信)
+	ErrorCategorySystem = ErrorCategory(9)
+
+	// ErrorCategoryUnsupported 不支持的功能
+	ErrorCategoryUnsupported = ErrorCategory(10)
+)
+
+// String 错误分类名称
+func (c ErrorCategory) String() string {
+	switch c {
+	case ErrorCategoryNone:
+		return "none"
+	case ErrorCategoryNetwork:
+		return "network"
+	case ErrorCategoryPermission:
+		return "permission"
+	case ErrorCategoryNotFound:
+		return "not-found"
+	case ErrorCategoryConflict:
+		return "conflict"
+	case ErrorCategoryData:
+		return "data"
+	case ErrorCategoryFile:
+		return "file"
+	case ErrorCategoryServer:
+		return "server"
+	case ErrorCategorySystem:
+		return "system"
+	case ErrorCategoryUnsupported:
+		return "unsupported"
+	default:
+		return "unknown"
+	}
+}
+
+// ErrorLocale 错误信息的语言
+type ErrorLocale int32
+
+const (
+	// ErrorLocaleChinese 中文
+	ErrorLocaleChinese = ErrorLocale(0)
+
+	// ErrorLocaleEnglish 英文
+	ErrorLocaleEnglish = ErrorLocale(1)
+)
+
+// errorLocale 当前使用的错误信息语言
+var errorLocale atomic.Int32
+
+func init() {
+	errorLocale.Store(int32(localeFromEnv()))
+}
+
+// EnvErrorLocale 错误信息的语言, 为 en 时使用英文, 其他值或未设置时使用中文
+const EnvErrorLocale = "RTDB_API_ERROR_LOCALE"
+
+// localeFromEnv 根据 RTDB_API_ERROR_LOCALE 环境变量选择错误信息语言
+//   - 默认使用中文, 不受 LANG 等系统语言设置影响, 避免改变已有程序的错误信息
+func localeFromEnv() ErrorLocale {
+	if strings.HasPrefix(strings.ToLower(os.Getenv(EnvErrorLocale)), "en") {
+		return ErrorLocaleEnglish
+	}
+	return ErrorLocaleChinese
+}
+
+// SetErrorLocale 设置错误信息的语言, 默认为中文或者 RTDB_API_ERROR_LOCALE 指定的语言
+func SetErrorLocale(locale ErrorLocale) {
+	errorLocale.Store(int32(locale))
+}
+
+// GetErrorLocale 获取错误信息的语言
+func GetErrorLocale() ErrorLocale {
+	return ErrorLocale(errorLocale.Load())
+}
+
+// Error 实现 error 接口, 根据 GetErrorLocale 返回中文或英文描述
+func (re RtdbError) Error() string {
+	return re.Message(GetErrorLocale())
+}
+
+// Message 获取指定语言的错误描述
+func (re RtdbError) Message(locale ErrorLocale) string {
+	if locale != ErrorLocaleEnglish {
+		return re.chineseMessage()
+	}
+	if info, ok := rtdbErrorInfos[re]; ok {
+		return info.english
+	}
+	if re > RteWindowsError && re < RteWindowsErrorMax {
+		return fmt.Sprintf("windows error %d", uint32(re-RteWindowsError))
+	}
+	return rtdbErrorInfos[RteUnknownError].english
+}
+
+// Category 错误分类
+func (re RtdbError) Category() ErrorCategory {
+	if info, ok := rtdbErrorInfos[re]; ok {
+		return info.category
+	}
+	switch {
+	case re > RteWindowsError && re < RteWindowsErrorMax:
+		return ErrorCategorySystem
+	case re > RteNetError && re < RteCErrnoError:
+		return ErrorCategoryNetwork
+	case re > RteCErrnoError && re < RteIpcError:
+		return ErrorCategorySystem
+	case re >= RteIpcError && re <= RteIpcErrorEnd:
+		return ErrorCategorySystem
+	default:
+		return ErrorCategoryUnknown
+	}
+}
+
+// Retryable 是否可以重试, 例如网络中断、连接超时、服务端繁忙等
+func (re RtdbError) Retryable() bool {
+	return rtdbErrorInfos[re].retryable
+}
+
+// OpError 带有操作名称和标签点ID的数据库错误
+//   - errors.Is(err, RtePointNotFound) 判断错误码
+//   - errors.As(err, &opErr) 获取错误详情
+type OpError struct {
+	Code    RtdbError // 错误码
+	Op      string    // 操作名称, 例如 WriteSection
+	PointID PointID   // 相关的标签点ID, 0表示与标签点无关
+}
+
+// Error 实现 error 接口
+func (e *OpError) Error() string {
+	locale := GetErrorLocale()
+	msg := e.Code.Message(locale)
+	switch {
+	case e.PointID == 0:
+		return fmt.Sprintf("%s: %s (0x%08X)", e.Op, msg, uint32(e.Code))
+	case locale == ErrorLocaleEnglish:
+		return fmt.Sprintf("%s: point %d: %s (0x%08X)", e.Op, e.PointID, msg, uint32(e.Code))
+	default:
+		return fmt.Sprintf("%s: 标签点%d: %s (0x%08X)", e.Op, e.PointID, msg, uint32(e.Code))
+	}
+}
+
+// Unwrap 返回错误码, 用于 errors.Is 和 errors.As
+func (e *OpError) Unwrap() error {
+	return e.Code
+}
+
+// Category 错误分类
+func (e *OpError) Category() ErrorCategory {
+	return e.Code.Category()
+}
+
+// Retryable 是否可以重试
+func (e *OpError) Retryable() bool {
+	return e.Code.Retryable()
+}
+
+// newOpError 将RtdbError转换成带有操作信息的 error, 成功时返回nil
+func newOpError(op string, rte RtdbError, id PointID) error {
+	if RteIsOk(rte) {
+		return nil
+	}
+	return &OpError{Code: rte, Op: op, PointID: id}
+}
+
+// newOpErrors 批量转换RtdbError, rtes与ids一一对应, 成功的位置为nil
+func newOpErrors(op string, ids []PointID, rtes []RtdbError) []error {
+	errs := make([]error, len(rtes))
+	for i, rte := range rtes {
+		id := PointID(0)
+		if i < len(ids) {
+			id = ids[i]
+		}
+		errs[i] = newOpError(op, rte, id)
+	}
+	return errs
+}
+
+// ErrorCategoryOf 获取错误分类, 非数据库错误返回 ErrorCategoryUnknown
+func ErrorCategoryOf(err error) ErrorCategory {
+	if err == nil {
+		return ErrorCategoryNone
+	}
+	var rte RtdbError
+	if errors.As(err, &rte) {
+		return rte.Category()
+	}
+	return ErrorCategoryUnknown
+}
+
+// IsRetryable 判断错误是否可以重试
+func IsRetryable(err error) bool {
+	var rte RtdbError
+	return errors.As(err, &rte) && rte.Retryable()
+}
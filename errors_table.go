@@ -0,0 +1,611 @@
+// 错误码的英文描述与分类, 与 cinclude/rtdb_error.h 保持一致, 不依赖CGO
+
+package rtdb_api
+
+// rtdbErrorInfo 错误码的附加信息
+type rtdbErrorInfo struct {
+	english   string        // 英文描述
+	category  ErrorCategory // 错误分类
+	retryable bool          // 是否可以重试
+}
+
+// rtdbErrorInfos 错误码与附加信息的对应关系
+var rtdbErrorInfos = map[RtdbError]rtdbErrorInfo{
+	RteUnknownError:                         {"unknown error", ErrorCategoryUnknown, false},
+	RteOk:                                   {"the operation completed successfully", ErrorCategoryNone, false},
+	RteWindowsError:                         {"start of the Windows system error range", ErrorCategorySystem, false},
+	RteWindowsErrorMax:                      {"end of the Windows system error range", ErrorCategorySystem, false},
+	RteInvalidOpenmode:                      {"invalid file open mode", ErrorCategoryData, false},
+	RteOpenfileFailed:                       {"failed to open the file", ErrorCategoryFile, false},
+	RteMovetoendFailed:                      {"failed to move the file pointer to the end of the file", ErrorCategoryFile, false},
+	RteDifferReadbytes:                      {"the number of bytes read does not match the requested length", ErrorCategoryFile, false},
+	RteGetfileposFailed:                     {"failed to get the current file position", ErrorCategoryFile, false},
+	RteFlushfileFailed:                      {"failed to flush the file buffer", ErrorCategoryFile, false},
+	RteSetsizeFailed:                        {"failed to set the file size", ErrorCategoryFile, false},
+	RteFileNotClosed:                        {"cannot create or open a file with a file object that is still open", ErrorCategoryFile, false},
+	RteFileUnknown:                          {"a file name is required to create or open a file", ErrorCategoryFile, false},
+	RteInvalidHeader:                        {"invalid data file header", ErrorCategoryData, false},
+	RteDisabledFile:                         {"the data file is invalid and cannot be accessed", ErrorCategoryFile, false},
+	RteFileNotOpened:                        {"the data file has not been opened", ErrorCategoryFile, false},
+	RtePointNotFound:                        {"the requested point does not exist or is invalid", ErrorCategoryNotFound, false},
+	RteReadyblockNotFound:                   {"no free block was found in the data file after the given block", ErrorCategoryNotFound, false},
+	RteFileIsIncult:                         {"the file has never been used", ErrorCategoryFile, false},
+	RteFileIsFull:                           {"the data file is full", ErrorCategoryFile, false},
+	RteFileexIsFull:                         {"the data file extension area is full and cannot hold more data", ErrorCategoryFile, false},
+	RteInvalidDataType:                      {"invalid data type", ErrorCategoryData, false},
+	RteDatablockNotFound:                    {"no data block matches the requested time", ErrorCategoryNotFound, false},
+	RteDataBetweenBlock:                     {"the data time lies between the found block and the next block", ErrorCategoryFile, false},
+	RteCantModifyExistValue:                 {"existing data cannot be modified", ErrorCategoryData, false},
+	RteWrongdataInBlock:                     {"the block contains invalid data that does not match its header", ErrorCategoryFile, false},
+	RteDatatimeNotIn:                        {"the data file has no data for the point at the given time", ErrorCategoryData, false},
+	RteNullArchivePath:                      {"the archive file path is empty", ErrorCategoryFile, false},
+	RteRegArchivePath:                       {"the archive file is already registered", ErrorCategoryFile, false},
+	RteUnregArchivePath:                     {"the archive file is not registered", ErrorCategoryFile, false},
+	RteFileInexistence:                      {"the specified file does not exist", ErrorCategoryFile, false},
+	RteDataTypeNotMatch:                     {"data type mismatch", ErrorCategoryData, false},
+	RteFileIsReadonly:                       {"data in a read-only archive file cannot be modified", ErrorCategoryFile, false},
+	RteTomanyArchiveFile:                    {"too many archive files", ErrorCategoryFile, false},
+	RteNoPointsList:                         {"the point list is missing", ErrorCategoryNotFound, false},
+	RteNoActivedArchive:                     {"there is no active archive", ErrorCategoryFile, false},
+	RteNoArchiveFile:                        {"there is no archive file", ErrorCategoryFile, false},
+	RteNeedActivedArchive:                   {"this operation can only be performed on the active archive", ErrorCategoryFile, false},
+	RteInvalidTimestamp:                     {"invalid timestamp", ErrorCategoryData, false},
+	RteNeedMoreWritable:                     {"too few writable archives", ErrorCategoryData, false},
+	RteNoArchiveForPut:                      {"no suitable archive was found for appending historical data", ErrorCategoryFile, false},
+	RteInvalidValueMode:                     {"invalid value retrieval mode", ErrorCategoryData, false},
+	RteDataNotFound:                         {"the requested data was not found", ErrorCategoryNotFound, false},
+	RteInvalidParameter:                     {"invalid parameter", ErrorCategoryData, false},
+	RteReduplicateTag:                       {"duplicate point name", ErrorCategoryConflict, false},
+	RteReduplicateTabname:                   {"duplicate table name", ErrorCategoryConflict, false},
+	RteReduplicateTabid:                     {"duplicate table ID", ErrorCategoryConflict, false},
+	RteTableNotFound:                        {"the specified table does not exist", ErrorCategoryNotFound, false},
+	RteUnsupportedClassof:                   {"unsupported point class", ErrorCategoryUnsupported, false},
+	RteWrongOrDuplicTag:                     {"invalid or duplicate point name", ErrorCategoryData, false},
+	RteReduplicatePt:                        {"duplicate point identifier", ErrorCategoryConflict, false},
+	RtePointLicenseFull:                     {"the number of points exceeds the license limit", ErrorCategoryPermission, false},
+	RteTableLicenseFull:                     {"the number of tables exceeds the license limit", ErrorCategoryPermission, false},
+	RteWrongOrDuplicTabname:                 {"invalid or duplicate table name", ErrorCategoryData, false},
+	RteInvalidFileFormat:                    {"invalid data file format", ErrorCategoryData, false},
+	RteWrongTabname:                         {"invalid table name", ErrorCategoryData, false},
+	RteWrongTag:                             {"invalid point name", ErrorCategoryData, false},
+	RteNotInScope:                           {"the value is out of the allowed range", ErrorCategoryData, false},
+	RteCantLoadBase:                         {"cannot contact the point information service", ErrorCategoryData, false},
+	RteCantLoadSnapshot:                     {"cannot contact the snapshot service", ErrorCategoryData, false},
+	RteCantLoadHistory:                      {"cannot contact the historian service", ErrorCategoryData, false},
+	RteCantLoadEquation:                     {"cannot contact the real-time equation service", ErrorCategoryData, false},
+	RteArraySizeNotMatch:                    {"array sizes do not match", ErrorCategoryData, false},
+	RteInvalidHostAddress:                   {"invalid host address", ErrorCategoryData, false},
+	RteConnectFalse:                         {"the connection has been closed", ErrorCategoryNetwork, true},
+	RteToomanyBytesRecved:                   {"received more bytes than expected", ErrorCategoryData, false},
+	RteReqidRespidNotMatch:                  {"the response ID does not match the request ID", ErrorCategoryData, false},
+	RteLessBytesRecved:                      {"received fewer bytes than expected", ErrorCategoryData, false},
+	RteUnsupportedCalcMode:                  {"unsupported calculation mode", ErrorCategoryUnsupported, false},
+	RteUnsupportedDataType:                  {"unsupported point type", ErrorCategoryUnsupported, false},
+	RteInvalidExpression:                    {"invalid expression", ErrorCategoryData, false},
+	RteIncondDataNotFound:                   {"no data matches the condition", ErrorCategoryNotFound, false},
+	RteValidDataNotFound:                    {"no valid data was found", ErrorCategoryNotFound, false},
+	RteValueOrStateIsNan:                    {"the value or state is abnormal (NaN)", ErrorCategoryData, false},
+	RteCreateMutexFailed:                    {"failed to create a mutex", ErrorCategoryServer, false},
+	RteTlsallocfail:                         {"LocalAlloc() failed while handling TLS, possibly because of insufficient memory", ErrorCategoryData, false},
+	RteToManyPoints:                         {"too many points for this API function; see the function declaration and the developer manual", ErrorCategoryData, false},
+	RteLicInfoError:                         {"failed to get the license information", ErrorCategoryPermission, false},
+	RteArchiveBufferFull:                    {"the point's historical backfill buffer is full; try again later", ErrorCategoryFile, false},
+	RteUserNotExist:                         {"the user does not exist", ErrorCategoryNotFound, false},
+	RteUserIsLocked:                         {"the account is locked and must be unlocked by an administrator", ErrorCategoryPermission, false},
+	RteWrongPassword:                        {"wrong password", ErrorCategoryPermission, false},
+	RteAccessIsDenied:                       {"access denied; check that you have sufficient privileges", ErrorCategoryData, false},
+	RteHaveNotLogin:                         {"not logged in; please log in first", ErrorCategoryPermission, false},
+	RteUserIsDeleted:                        {"the account has been deleted", ErrorCategoryPermission, false},
+	RteUserAlreadyExist:                     {"the account already exists", ErrorCategoryConflict, false},
+	RteWrongCreateTabname:                   {"failed to create or delete the table", ErrorCategoryData, false},
+	RteWrongFieldValue:                      {"invalid point attribute value", ErrorCategoryData, false},
+	RteInvalidTagId:                         {"invalid point ID", ErrorCategoryData, false},
+	RteCheckNamedTypeNameError:              {"invalid named type name or field name", ErrorCategoryData, false},
+	RteCantLoadDispatch:                     {"cannot contact the dispatch server", ErrorCategoryServer, false},
+	RteConnectTimeOut:                       {"the connection timed out; please log in again", ErrorCategoryNetwork, true},
+	RteWrongLogin4:                          {"account verification failed; 4 attempts remaining", ErrorCategoryPermission, false},
+	RteWrongLogin3:                          {"account verification failed; 3 attempts remaining", ErrorCategoryPermission, false},
+	RteWrongLogin2:                          {"account verification failed; 2 attempts remaining", ErrorCategoryPermission, false},
+	RteWrongLogin1:                          {"account verification failed; 1 attempt remaining", ErrorCategoryPermission, false},
+	RteWrongDesc:                            {"invalid table description", ErrorCategoryData, false},
+	RteWrongUnit:                            {"invalid engineering unit", ErrorCategoryData, false},
+	RteWrongChanger:                         {"invalid name of the user who last modified the point", ErrorCategoryData, false},
+	RteWrongCreator:                         {"invalid name of the user who created the point", ErrorCategoryData, false},
+	RteWrongFull:                            {"invalid full point name", ErrorCategoryData, false},
+	RteWrongSource:                          {"invalid data source", ErrorCategoryData, false},
+	RteWrongInstrument:                      {"invalid instrument tag", ErrorCategoryData, false},
+	RteWrongUser:                            {"invalid creator", ErrorCategoryPermission, false},
+	RteWrongEquation:                        {"invalid real-time equation", ErrorCategoryData, false},
+	RteWrongTypeName:                        {"invalid named type name", ErrorCategoryData, false},
+	RteWrongEncode:                          {"character encoding conversion failed", ErrorCategoryData, false},
+	RteWrongOthermask:                       {"invalid search type mask value", ErrorCategoryData, false},
+	RteWrongType:                            {"invalid search type", ErrorCategoryData, false},
+	RtePointHardwareLimited:                 {"failed to create or restore the point because of hardware resource limits", ErrorCategoryData, false},
+	RteWaitingRecoverData:                   {"waiting for data recovery to finish; try connecting later", ErrorCategoryData, false},
+	RteReplicationLicMismatch:               {"the licenses of the active-active databases do not match", ErrorCategoryPermission, false},
+	RteReadConfigFailed:                     {"failed to read the configuration file", ErrorCategoryServer, false},
+	RteUpdateConfigFailed:                   {"failed to update the configuration file", ErrorCategoryServer, false},
+	RteFilterTooLong:                        {"the filter exceeds the maximum length", ErrorCategoryData, false},
+	RteGetArchiveNameFail:                   {"failed to get the archive file name", ErrorCategoryFile, false},
+	RteAutoMoveFailed:                       {"failed to move the archive file automatically", ErrorCategoryServer, false},
+	RteTimeGreaterThanHotTailArc:            {"the time of the non-flash archive being created or queued is later than the earliest flash archive", ErrorCategoryFile, false},
+	RteTimeLessThanColdBeginArc:             {"the time of the flash archive being created or queued is earlier than the latest non-flash archive", ErrorCategoryFile, false},
+	RteRemoveEarliestArcFailed:              {"failed to delete the earliest archive file (the archive list is empty)", ErrorCategoryFile, false},
+	RteNoFreeTableId:                        {"no free table ID is available", ErrorCategoryServer, false},
+	RteNoFreeTagPosition:                    {"no free point slot is available", ErrorCategoryServer, false},
+	RteNoFreeScanTagPosition:                {"no free scan point slot is available", ErrorCategoryServer, false},
+	RteNoFreeCalcTagPosition:                {"no free calculation point slot is available", ErrorCategoryServer, false},
+	RteInvalidIpcPosition:                   {"an invalid slot was used for inter-process shared memory", ErrorCategoryServer, false},
+	RteWrongIpcPosition:                     {"a wrong slot was used for inter-process shared memory", ErrorCategoryServer, false},
+	RteIpcAccessException:                   {"shared memory access exception", ErrorCategoryServer, false},
+	RteArvPageNotReady:                      {"no free historical data cache page", ErrorCategoryFile, true},
+	RteArvexPageNotReady:                    {"no free backfill cache page", ErrorCategoryFile, true},
+	RteInvalidPositionFromId:                {"the slot obtained from the point ID is invalid", ErrorCategoryData, false},
+	RteNoActivePageAllocator:                {"the new active archive cannot load a page allocator", ErrorCategoryServer, false},
+	RteMapIsNotReady:                        {"the memory mapping is not ready", ErrorCategoryData, true},
+	RteFileMapFailed:                        {"failed to map the file into memory", ErrorCategoryFile, false},
+	RteTimeRangeNotAllowed:                  {"the time range is not allowed", ErrorCategoryData, false},
+	RteNoDataForSummary:                     {"no source data for the summary", ErrorCategoryNotFound, false},
+	RteCantOperateOnActived:                 {"the active archive file cannot be operated on", ErrorCategoryData, false},
+	RteScanPointLicenseFull:                 {"the number of scan points exceeds the license limit", ErrorCategoryPermission, false},
+	RteCalcPointLicenseFull:                 {"the number of calculation points exceeds the license limit", ErrorCategoryPermission, false},
+	RteHistorianIsShuttingdown:              {"the historian service is shutting down", ErrorCategoryData, false},
+	RteSnapshotIsShuttingdown:               {"the snapshot service is shutting down", ErrorCategoryData, false},
+	RteEquationIsShuttingdown:               {"the real-time equation service is shutting down", ErrorCategoryServer, false},
+	RteBaseIsShuttingdown:                   {"the point information service is shutting down", ErrorCategoryData, false},
+	RteServerIsShuttingdown:                 {"the network service is shutting down", ErrorCategoryServer, true},
+	RteOutOfMemory:                          {"out of memory", ErrorCategoryServer, false},
+	RteInvalidPage:                          {"invalid data page, possibly not loaded", ErrorCategoryData, false},
+	RtePageIsEmpty:                          {"encountered an empty data page", ErrorCategoryFile, false},
+	RteStrOrBlobTooLong:                     {"the string or BLOB exceeds the length limit", ErrorCategoryData, false},
+	RteCreatedOrOverdue:                     {"no snapshot has been produced yet, or the snapshot has expired", ErrorCategoryData, false},
+	RteArchiveInfoNotMatching:               {"the archive file header does not match the actual contents", ErrorCategoryFile, false},
+	RteTimeRangeOverlapping:                 {"the time range overlaps an existing archive file", ErrorCategoryData, false},
+	RteCannotShiftToActived:                 {"no suitable archive file was found to switch to the active archive", ErrorCategoryData, false},
+	RteIndexNotReady:                        {"the index of the archive file is not ready", ErrorCategoryFile, true},
+	RteIndexNodeNotMatch:                    {"the index node does not match the content it points to", ErrorCategoryFile, false},
+	RteCanNotCreateIndex:                    {"cannot create the index node", ErrorCategoryFile, false},
+	RteCanNotRemoveIndex:                    {"cannot delete the index node", ErrorCategoryFile, false},
+	RteInvalidFilterExpress:                 {"invalid filter expression", ErrorCategoryData, false},
+	RteMoreVarInFilterExp:                   {"the filter expression contains too many variables", ErrorCategoryData, false},
+	RteInvalidArvPageAllocate:               {"the ID of the newly allocated historical cache page does not match the point event object ID", ErrorCategoryData, false},
+	RteInvalidArvexPageAllocate:             {"the ID of the newly allocated backfill cache page does not match the point event object ID", ErrorCategoryData, false},
+	RteBigJobIsNotDone:                      {"an important task is running; try again later", ErrorCategoryServer, true},
+	RteDatabaseNeedRestart:                  {"the database must be restarted to apply the new parameters", ErrorCategoryData, false},
+	RteInvalidTimeFormat:                    {"invalid time format string", ErrorCategoryData, false},
+	RteDataPlaybackDone:                     {"historical data playback has finished", ErrorCategoryData, false},
+	RteBadEquation:                          {"invalid equation", ErrorCategoryData, false},
+	RteNotEnoughSapce:                       {"not enough free disk space", ErrorCategoryData, false},
+	RteActivedArchiveExist:                  {"an active archive already exists", ErrorCategoryConflict, false},
+	RteArchiveHaveExFiles:                   {"the specified archive file has extension files", ErrorCategoryFile, false},
+	RteArchiveIsNotLatest:                   {"the specified archive file is not the latest one", ErrorCategoryFile, false},
+	RteDbSystemNotRunning:                   {"the database system has not fully started", ErrorCategoryServer, true},
+	RteArchiveIsAltered:                     {"the archive file contents have changed", ErrorCategoryFile, false},
+	RteArchiveIsTooSmall:                    {"archive files and extension files that small cannot be created", ErrorCategoryFile, false},
+	RteInvalidIndexNode:                     {"encountered an invalid index node", ErrorCategoryData, false},
+	RteModifySnapshotNotAllowed:             {"snapshot events cannot be deleted or modified", ErrorCategoryData, false},
+	RteSearchInterrupted:                    {"the search was interrupted because the target is being created, deleted or restored; try again later", ErrorCategoryData, false},
+	RteRecycleShutdown:                      {"the recycle bin is unavailable, so the operation cannot be completed", ErrorCategoryServer, false},
+	RteNeedToReindex:                        {"the index file is missing or some index nodes are damaged; the index must be rebuilt", ErrorCategoryFile, false},
+	RteInvalidQuality:                       {"invalid quality code", ErrorCategoryData, false},
+	RteEquationNotReady:                     {"the real-time equation service is parsing equations; try again later", ErrorCategoryData, true},
+	RteArchivesLicenseFull:                  {"the number of archive files has reached the license limit", ErrorCategoryPermission, false},
+	RteRecycledLicenseFull:                  {"the point recycle bin exceeds the license limit", ErrorCategoryPermission, false},
+	RteStrBlobLicenseFull:                   {"the number of string or BLOB points exceeds the license limit", ErrorCategoryPermission, false},
+	RteNotSupportWhenDebug:                  {"this feature is disabled by a debug option", ErrorCategoryUnsupported, false},
+	RteMappingAlreadyLoaded:                 {"the mapping is already loaded and cannot be loaded again", ErrorCategoryConflict, false},
+	RteArchiveIsModified:                    {"the archive file was modified and the operation was interrupted", ErrorCategoryFile, false},
+	RteActiveArchiveFull:                    {"the active archive is full", ErrorCategoryFile, false},
+	RteSplitNoData:                          {"no data in the given time range after splitting the data page", ErrorCategoryNotFound, false},
+	RteInvalidDirectory:                     {"the specified path does not exist or is invalid", ErrorCategoryData, false},
+	RteArchiveLackExFiles:                   {"some extension files of the specified archive are missing", ErrorCategoryFile, false},
+	RteBigJobIsCanceled:                     {"the background task was canceled", ErrorCategoryServer, false},
+	RteArvexBlobPageNotReady:                {"no free BLOB backfill cache page", ErrorCategoryFile, true},
+	RteInvalidArvexBlobPageAllocate:         {"the ID of the newly allocated BLOB backfill cache page does not match the point event object ID", ErrorCategoryData, false},
+	RteTimestampEqualtoSnapshot:             {"the written time is the same as the snapshot time", ErrorCategoryData, false},
+	RteTimestampEarlierThanSnapshot:         {"the written time is earlier than the current snapshot time", ErrorCategoryData, false},
+	RteTimestampGreaterThanAllow:            {"the written time is later than allowed", ErrorCategoryData, false},
+	RteTimestampBegintimeGreagerThanEndtime: {"the start time is later than the end time", ErrorCategoryData, false},
+	RteTimestampBegintimeEqualtoEndtime:     {"the start time equals the end time", ErrorCategoryData, false},
+	RteInvalidCount:                         {"invalid count", ErrorCategoryData, false},
+	RteInvalidCapacity:                      {"invalid capacity", ErrorCategoryData, false},
+	RteInvalidPath:                          {"invalid path", ErrorCategoryData, false},
+	RteInvalidPosition:                      {"invalid position", ErrorCategoryData, false},
+	RteInvalidArvPage:                       {"invalid archive page: not loaded, or its size is not greater than 0", ErrorCategoryData, false},
+	RteInvalidHisinfoItemState:              {"invalid history information entry", ErrorCategoryData, false},
+	RteInvalidInterval:                      {"invalid interval", ErrorCategoryData, false},
+	RteInvalidLength:                        {"invalid string length", ErrorCategoryData, false},
+	RteInvalidSerachMode:                    {"invalid search mode", ErrorCategoryData, false},
+	RteInvalidFileId:                        {"invalid archive file ID", ErrorCategoryData, false},
+	RteInvalidMillisecond:                   {"invalid millisecond or nanosecond value", ErrorCategoryData, false},
+	RteInvalidDeadline:                      {"invalid deadline", ErrorCategoryData, false},
+	RteInvalidJobname:                       {"invalid job name", ErrorCategoryData, false},
+	RteInvalidJobstate:                      {"invalid job state", ErrorCategoryData, false},
+	RteInvalidProcessRate:                   {"invalid process rate", ErrorCategoryData, false},
+	RteInvalidTableId:                       {"invalid table ID", ErrorCategoryData, false},
+	RteInvalidDataSource:                    {"invalid data source format", ErrorCategoryData, false},
+	RteInvalidTriggerMethod:                 {"invalid trigger method", ErrorCategoryData, false},
+	RteInvalidCalcTimeRes:                   {"invalid timestamp reference for calculation results", ErrorCategoryData, false},
+	RteInvalidTriggerTimer:                  {"invalid timer trigger period; it cannot be less than 1 second", ErrorCategoryData, false},
+	RteInvalidLimit:                         {"the high limit cannot be lower than the low limit", ErrorCategoryData, false},
+	RteInvalidCompTime:                      {"invalid compression interval; the maximum must not be less than the minimum", ErrorCategoryData, false},
+	RteInvalidExtTime:                       {"invalid exception interval; the maximum must not be less than the minimum", ErrorCategoryData, false},
+	RteInvalidDigits:                        {"invalid number of digits; it must be between -20 and 10", ErrorCategoryData, false},
+	RteInvalidFullTagName:                   {"invalid full point name; the \".\" separator between table name and point name was not found", ErrorCategoryData, false},
+	RteInvalidTableDesc:                     {"invalid table description", ErrorCategoryData, false},
+	RteInvalidUserCount:                     {"invalid user count (less than 0)", ErrorCategoryPermission, false},
+	RteInvalidBlacklistCount:                {"invalid blacklist count (less than 0)", ErrorCategoryData, false},
+	RteInvalidAuthorizationCount:            {"invalid trusted connection count (less than 0)", ErrorCategoryData, false},
+	RteInvalidBigJobType:                    {"invalid background task type", ErrorCategoryData, false},
+	RteInvalidSysParam:                      {"invalid system parameter passed to db_set_db_info2", ErrorCategoryData, false},
+	RteInvalidFileParam:                     {"invalid file path parameter passed to db_set_db_info1", ErrorCategoryData, false},
+	RteInvalidFileSize:                      {"invalid recycle file size (baserecycle.dat, scanrecycle.dat, calcrecycle.dat or snaprecycle.dat is smaller than 1)", ErrorCategoryData, false},
+	RteInvalidTagType:                       {"the point type is valid (rtdb_bool to rtdb_blob) but is not supported by this function", ErrorCategoryData, false},
+	RteInvalidRecyStructPos:                 {"invalid position of the last structure in the recycle bin", ErrorCategoryData, false},
+	RteInvalidRecycleFile:                   {"scanrecycle.dat, baserecycle.dat or snaprecycle.dat does not exist or is invalid", ErrorCategoryData, false},
+	RteInvalidSuffixName:                    {"invalid file extension", ErrorCategoryData, false},
+	RteInsertStringFalse:                    {"failed to insert string data into the data page", ErrorCategoryData, false},
+	RteBlobPageFull:                         {"the BLOB data page is full", ErrorCategoryFile, false},
+	RteInvalidStringIteratorPointer:         {"invalid string/BLOB iterator pointer", ErrorCategoryData, false},
+	RteNotEqualTagid:                        {"the point ID of the target page does not match the current ID", ErrorCategoryData, false},
+	RtePathsOfArchiveAndAutobackAreSame:     {"the archive path is the same as the automatic backup path", ErrorCategoryConflict, false},
+	RteXmlParseFail:                         {"failed to parse the XML file", ErrorCategoryData, false},
+	RteXmlElementsAbsent:                    {"the XML manifest is missing content", ErrorCategoryData, false},
+	RteXmlMismatchOnName:                    {"the XML manifest does not match this product", ErrorCategoryData, false},
+	RteXmlMismatchOnVersion:                 {"the XML manifest version does not match", ErrorCategoryData, false},
+	RteXmlMismatchOnDatasize:                {"the XML manifest data size does not match", ErrorCategoryData, false},
+	RteXmlMismatchOnFileinfo:                {"the data file information in the XML manifest does not match", ErrorCategoryFile, false},
+	RteXmlMismatchOnWindow:                  {"all data files in the XML manifest must have the same window size", ErrorCategoryData, false},
+	RteXmlMismatchOnTypecount:               {"the number of named types in the XML manifest does not match", ErrorCategoryData, false},
+	RteXmlMismatchOnFieldcount:              {"the named type fields in the XML manifest do not match", ErrorCategoryData, false},
+	RteXmlFieldMustInType:                   {"field elements in the XML manifest must be nested in a type element", ErrorCategoryData, false},
+	RteInvalidNamedTypeFieldCount:           {"invalid number of fields", ErrorCategoryData, false},
+	RteReduplicateFieldName:                 {"duplicate field name", ErrorCategoryConflict, false},
+	RteInvalidNamedTypeName:                 {"invalid named type name", ErrorCategoryData, false},
+	RteReduplicateNamedType:                 {"the named type already exists", ErrorCategoryConflict, false},
+	RteNotExistNamedType:                    {"the named type does not exist", ErrorCategoryNotFound, false},
+	RteUpdateXmlFailed:                      {"failed to update the XML manifest", ErrorCategoryServer, false},
+	RteNamedTypeUsedWithPoint:               {"the named type is used by some points and cannot be deleted", ErrorCategoryData, false},
+	RteNamedTypeUnsupportCalcPoint:          {"named types are not supported for calculation points", ErrorCategoryUnsupported, false},
+	RteXmlMismatchOnMaxId:                   {"the maximum named type ID does not match the actual number of named types", ErrorCategoryData, false},
+	RteNamedTypeLicenseFull:                 {"the number of named types exceeds the license limit", ErrorCategoryPermission, false},
+	RteNoFreeNamedTypeId:                    {"no free named type ID is available", ErrorCategoryServer, false},
+	RteInvalidNamedTypeId:                   {"invalid named type ID", ErrorCategoryData, false},
+	RteInvalidNamedTypeFieldName:            {"invalid named type field name", ErrorCategoryData, false},
+	RteNamedTypeUsedWithRecyclePoint:        {"the named type is used by some points in the recycle bin and cannot be deleted", ErrorCategoryData, false},
+	RteNamedTypeNameTooLong:                 {"the named type name exceeds the maximum length", ErrorCategoryData, false},
+	RteNamedTypeFieldNameTooLong:            {"the named type field name exceeds the maximum length", ErrorCategoryData, false},
+	RteInvalidNamedTypeFieldLength:          {"invalid named type field length", ErrorCategoryData, false},
+	RteInvalidSearchMask:                    {"invalid point attribute mask for advanced search", ErrorCategoryData, false},
+	RteRecycledSpaceNotEnough:               {"not enough free space in the point recycle bin", ErrorCategoryData, false},
+	RteDynamicLoadedMemoryNotInit:           {"the dynamically loaded memory is not initialized", ErrorCategoryServer, false},
+	RteForbidDynamicAllocType:               {"the memory database does not allow dynamically allocated types", ErrorCategoryPermission, false},
+	RteMemorydbIndexCreateFailed:            {"failed to create the memory database index", ErrorCategoryFile, false},
+	RteWgMakeQueryReturnNull:                {"whitedb make_query_rc returned null", ErrorCategoryData, false},
+	RteThtreadPoolCreatedFailed:             {"the memory database failed to create its thread pool", ErrorCategoryServer, false},
+	RteMemorydbRemoveRecordFailed:           {"the memory database failed to delete the record", ErrorCategoryServer, false},
+	RteMemorydbConfigLoadFailed:             {"failed to load the memory database configuration file", ErrorCategoryServer, false},
+	RteMemorydbProhibitDynamicAlloType:      {"the memory database does not allow dynamically allocated types", ErrorCategoryServer, false},
+	RteMemorydbDynamicAllocTypeFailed:       {"the memory database failed to allocate the type dynamically", ErrorCategoryServer, false},
+	RteMemorydbStorageFileNameParseFailed:   {"failed to parse the memory database priority file name", ErrorCategoryFile, false},
+	RteMemorydbTtreeIndexDamage:             {"the memory database T-tree index is damaged", ErrorCategoryFile, false},
+	RteMemorydbConfigFailed:                 {"invalid memory database configuration file", ErrorCategoryServer, false},
+	RteMemorydbValueCountNotMatch:           {"the number of values in the memory database record does not match", ErrorCategoryServer, false},
+	RteMemorydbFieldTypeNotMatch:            {"the memory database field type does not match", ErrorCategoryServer, false},
+	RteMemorydbMemoryAllocFailed:            {"memory allocation failed in the memory database", ErrorCategoryServer, false},
+	RteMemorydbMethodParamErr:               {"invalid memory database method parameter", ErrorCategoryServer, false},
+	RteMemorydbQueryResultAllocFailed:       {"failed to allocate the memory database query result buffer", ErrorCategoryServer, false},
+	RteFilePathLength:                       {"invalid length of the specified file path", ErrorCategoryFile, false},
+	RteMemorydbFileVersionMatch:             {"the memory database file version does not match", ErrorCategoryFile, false},
+	RteMemorydbFileCrcError:                 {"memory database file CRC error", ErrorCategoryFile, false},
+	RteMemorydbFileFlagMatch:                {"invalid memory database file flag", ErrorCategoryFile, false},
+	RteMemorydbInexistence:                  {"the storage database does not exist", ErrorCategoryServer, false},
+	RteMemorydbLoadFailed:                   {"failed to load the storage database", ErrorCategoryServer, false},
+	RteNoDataInInterval:                     {"no data in the specified query range", ErrorCategoryNotFound, false},
+	RteCantLoadMemorydb:                     {"cannot contact the memory service", ErrorCategoryServer, false},
+	RteQueryInWhitedb:                       {"an internal whitedb error occurred while querying the memory database", ErrorCategoryData, false},
+	RteNoDatabaseMemorydb:                   {"no sub-database was found for the specified data type", ErrorCategoryServer, false},
+	RteRecordNotGet:                         {"failed to get the record from whitedb", ErrorCategoryData, false},
+	RteMemoryAllocErr:                       {"failed to allocate the memory database snapshot receive buffer", ErrorCategoryServer, false},
+	RteEventCreateFailed:                    {"failed to create the event for the memory database receive buffer", ErrorCategoryServer, false},
+	RteGetPointFailed:                       {"failed to get the point", ErrorCategoryServer, false},
+	RteMemoryInitFailed:                     {"failed to initialize the memory database", ErrorCategoryServer, false},
+	RteDatatypeNotMatch:                     {"data type mismatch", ErrorCategoryData, false},
+	RteGetFieldErr:                          {"failed to get a record field from whitedb", ErrorCategoryData, false},
+	RteMemorydbInternalErr:                  {"unknown internal whitedb error", ErrorCategoryServer, false},
+	RteMemorydbRecordCreatedFailed:          {"the memory database failed to create the record", ErrorCategoryServer, false},
+	RteParseNormalTypeSnapshotErr:           {"failed to parse the snapshot of a built-in data type", ErrorCategoryData, false},
+	RteParseNamedTypeSnapshotErr:            {"failed to parse the snapshot of a named type", ErrorCategoryData, false},
+	RteStringBlobTypeUnsupportCalcPoint:     {"string and BLOB types are not supported for calculation points", ErrorCategoryUnsupported, false},
+	RteCoorTypeUnsupportCalcPoint:           {"coordinate types are not supported for calculation points", ErrorCategoryUnsupported, false},
+	RteIncludeHisData:                       {"the record is historical data and may be stale", ErrorCategoryData, false},
+	RteThreadCreateErr:                      {"failed to create the thread", ErrorCategoryServer, false},
+	RteXmlCrcError:                          {"XML file CRC check failed", ErrorCategoryData, false},
+	RteOversizeIntervals:                    {"the number of intervals is not less than the size configured in system.ini", ErrorCategoryData, false},
+	RteDatetimesMustAscendingOrder:          {"times must be in ascending order", ErrorCategoryData, false},
+	RteCantLoadPerf:                         {"cannot contact the performance counter service", ErrorCategoryData, false},
+	RtePerfTagNotFound:                      {"the performance counter point does not exist", ErrorCategoryNotFound, false},
+	RteWaitDataEmpty:                        {"the data is empty", ErrorCategoryData, false},
+	RteWaitDataFull:                         {"the data is full", ErrorCategoryServer, true},
+	RteDataTypeCountLess:                    {"the number of data types is below the minimum", ErrorCategoryData, false},
+	RteMemorydbCreateFailed:                 {"failed to create the memory database", ErrorCategoryServer, false},
+	RteMemorydbFieldEncodeFailed:            {"failed to encode the memory database field", ErrorCategoryServer, false},
+	RteRecordCreateFailed:                   {"failed to create the memory database record", ErrorCategoryServer, false},
+	RteRemoveRecordErr:                      {"failed to delete the memory database record", ErrorCategoryData, false},
+	RteMemorydbFileOpenField:                {"the memory database failed to open the file", ErrorCategoryFile, false},
+	RteMemorydbFileWriteFailed:              {"the memory database failed to write the file", ErrorCategoryFile, false},
+	RteFilterWtihFloatAndEqual:              {"an inequality with floating-point numbers cannot contain \"=\"", ErrorCategoryData, false},
+	RteDispatchPluginNotExsit:               {"the dispatch server plugin does not exist", ErrorCategoryServer, false},
+	RteDispatchPluginFileNotExsit:           {"the dispatch server plugin DLL does not exist", ErrorCategoryFile, false},
+	RteDispatchPluginAlreadyExsit:           {"the dispatch server plugin already exists", ErrorCategoryConflict, false},
+	RteDispatchRegisterPluginFailure:        {"failed to register the plugin", ErrorCategoryServer, false},
+	RteDispatchStartPluginFailure:           {"failed to start the plugin", ErrorCategoryServer, false},
+	RteDispatchStopPluginFailure:            {"failed to stop the plugin", ErrorCategoryServer, false},
+	RteDispatchSetPluginEnableStatusFailure: {"failed to set the plugin status", ErrorCategoryServer, false},
+	RteDispatchGetPluginCountFailure:        {"failed to get the number of plugins", ErrorCategoryServer, false},
+	RteDispatchConfigfileNotExist:           {"the dispatch service configuration file does not exist", ErrorCategoryNotFound, false},
+	RteDispatchConfigDataParseErr:           {"failed to parse the dispatch service configuration", ErrorCategoryServer, false},
+	RteDispatchPluginAlreadyRunning:         {"the dispatch server plugin is already running", ErrorCategoryConflict, false},
+	RteDispatchPluginCannotRun:              {"the dispatch server plugin is not allowed to run", ErrorCategoryServer, false},
+	RteDispatchPluginContainerUnrun:         {"the dispatch server plugin container is not running", ErrorCategoryServer, false},
+	RteDispatchPluginInterfaceErr:           {"the dispatch server plugin interface is not implemented", ErrorCategoryServer, false},
+	RteDispatchPluginSaveConfigErr:          {"the dispatch server failed to save the configuration file", ErrorCategoryServer, false},
+	RteDispatchPluginStartErr:               {"the dispatch server plugin failed to start", ErrorCategoryServer, false},
+	RteDispatchPluginStopErr:                {"the dispatch server plugin failed to stop", ErrorCategoryServer, false},
+	RteDispatchParseDataPageErr:             {"unsupported data page type", ErrorCategoryFile, false},
+	RteDispatchNotRun:                       {"the dispatch service is not enabled", ErrorCategoryServer, false},
+	RteBigJobIsCanceledBecauseArcRoll:       {"the background task was canceled because the archive file rolled over", ErrorCategoryFile, true},
+	RtePerfForbiddenOperation:               {"operations on the performance table are not allowed", ErrorCategoryPermission, false},
+	RteReduplicateTagInDestTable:            {"the destination table already has a point with the same name (when moving points)", ErrorCategoryConflict, false},
+	RteProtocolnotimpl:                      {"the requested message is not implemented", ErrorCategoryData, false},
+	RteCrcerror:                             {"message CRC check failed", ErrorCategoryData, false},
+	RteWrongUserpw:                          {"failed to verify the user name and password", ErrorCategoryData, false},
+	RteChangeUserpw:                         {"failed to change the user name and password", ErrorCategoryData, false},
+	RteInvalidHandle:                        {"invalid handle", ErrorCategoryData, false},
+	RteInvalidSocketHandle:                  {"invalid socket handle", ErrorCategoryNetwork, false},
+	RteFalse:                                {"the operation did not complete successfully; see the minor error code for details", ErrorCategoryData, false},
+	RteScanPointNotFound:                    {"the requested scan point does not exist or is invalid", ErrorCategoryNotFound, false},
+	RteCalcPointNotFound:                    {"the requested calculation point does not exist or is invalid", ErrorCategoryNotFound, false},
+	RteReduplicateId:                        {"duplicate point identifier", ErrorCategoryConflict, false},
+	RteHandleSubscribed:                     {"the handle is already subscribed", ErrorCategoryData, false},
+	RteOtherSdkDoing:                        {"another API call is in progress", ErrorCategoryServer, true},
+	RteBatchEnd:                             {"end of the batched data", ErrorCategoryData, false},
+	RteAuthNotFound:                         {"the trusted connection range does not exist", ErrorCategoryNotFound, false},
+	RteAuthExist:                            {"the address range is already in the trusted list", ErrorCategoryConflict, false},
+	RteAuthFull:                             {"the trusted connection list is full", ErrorCategoryServer, false},
+	RteUserFull:                             {"the user list is full", ErrorCategoryServer, false},
+	RteVersionUnmatch:                       {"message or data version mismatch", ErrorCategoryData, false},
+	RteInvalidPriv:                          {"invalid privilege", ErrorCategoryPermission, false},
+	RteInvalidMask:                          {"invalid subnet mask", ErrorCategoryData, false},
+	RteInvalidUsername:                      {"invalid user name", ErrorCategoryData, false},
+	RteInvalidMark:                          {"unrecognized message header mark", ErrorCategoryData, false},
+	RteUnexpectedMethod:                     {"unexpected message ID", ErrorCategoryData, false},
+	RteInvalidParamIndex:                    {"invalid system parameter index", ErrorCategoryData, false},
+	RteDecodePacketError:                    {"failed to decode the packet", ErrorCategoryData, false},
+	RteEncodePacketError:                    {"failed to encode the packet", ErrorCategoryData, false},
+	RteBlacklistFull:                        {"the blocked connection list is full", ErrorCategoryServer, false},
+	RteBlacklistExist:                       {"the address range is already in the blacklist", ErrorCategoryConflict, false},
+	RteBlacklistNotFound:                    {"the blocked connection range does not exist", ErrorCategoryNotFound, false},
+	RteInBlacklist:                          {"the address is in the blacklist and was rejected", ErrorCategoryPermission, false},
+	RteIncreaseFileFailed:                   {"failed to grow the file", ErrorCategoryFile, false},
+	RteRpcInterfaceFailed:                   {"remote procedure call failed", ErrorCategoryServer, false},
+	RteConnectionFull:                       {"too many connections", ErrorCategoryNetwork, true},
+	RteOneClientConnectionFull:              {"the client has reached its maximum number of connections", ErrorCategoryNetwork, true},
+	RteServerClutterPoolNotEnough:           {"not enough network data exchange space", ErrorCategoryServer, false},
+	RteEquationClutterPoolNotEnough:         {"not enough real-time equation exchange space", ErrorCategoryServer, false},
+	RteNamedTypeNameLenError:                {"the named type name is too long", ErrorCategoryData, false},
+	RteNamedTypeLengthNotMatch:              {"the value length does not match the named type definition", ErrorCategoryData, false},
+	RteCanNotUpdateSummary:                  {"cannot update the summary data", ErrorCategoryData, false},
+	RteTooManyArvexFile:                     {"too many extension files; no more can be created", ErrorCategoryFile, false},
+	RteNotSupportedFeature:                  {"this feature is not supported in the test version", ErrorCategoryUnsupported, false},
+	RteEnsureError:                          {"verification failed; see the database log for details", ErrorCategoryData, false},
+	RteOperatorIsCancel:                     {"the operation was canceled", ErrorCategoryData, false},
+	RteMsgbodyRevError:                      {"failed to receive the message body", ErrorCategoryData, false},
+	RteUncompressFailed:                     {"decompression failed", ErrorCategoryServer, false},
+	RteCompressFailed:                       {"compression failed", ErrorCategoryServer, false},
+	RteSubscribeError:                       {"subscription failed because the previous subscription thread has not exited", ErrorCategoryData, false},
+	RteSubscribeCancelError:                 {"failed to cancel the subscription", ErrorCategoryData, false},
+	RteSubscribeCallbackFailed:              {"cannot cancel the subscription or disconnect from inside the subscription callback", ErrorCategoryServer, false},
+	RteSubscribeGreaterMaxCount:             {"too many points subscribed on one connection", ErrorCategoryData, false},
+	RteKillConnectionFailed:                 {"failed to close the connection; a connection cannot close itself", ErrorCategoryServer, false},
+	RteSubscribeNotMatch:                    {"the requested method does not match the current subscription", ErrorCategoryData, false},
+	RteNoSubscribe:                          {"the connection has no subscription, or the point is not subscribed", ErrorCategoryNotFound, false},
+	RteAlreadySubscribe:                     {"the point is already subscribed", ErrorCategoryConflict, false},
+	RteCalcPointUnsupportedWriteData:        {"data cannot be written to calculation points", ErrorCategoryUnsupported, false},
+	RteFeatureDeprecated:                    {"this feature is no longer supported", ErrorCategoryData, false},
+	RteInvalidValue:                         {"invalid data", ErrorCategoryData, false},
+	RteVerifyVercodeFailed:                  {"failed to verify the authorization code", ErrorCategoryServer, false},
+	RteInvalidPageSize:                      {"invalid data page size", ErrorCategoryData, false},
+	RteInvalidPrecision:                     {"invalid timestamp precision", ErrorCategoryData, false},
+	RteInvalidPageVersion:                   {"invalid data page version", ErrorCategoryData, false},
+	RtePageIsFull:                           {"the data page is full", ErrorCategoryFile, false},
+	RtePageNotLoaded:                        {"the data page has not been loaded", ErrorCategoryFile, false},
+	RtePageAlreadyLoaded:                    {"the data page is already loaded", ErrorCategoryConflict, false},
+	RtePageTooSmall:                         {"the data page is too small; its usable space is smaller than the data length", ErrorCategoryFile, false},
+	RtePageNoEnoughData:                     {"the data page does not contain enough data", ErrorCategoryFile, false},
+	RtePageInsertFailed:                     {"failed to insert data into the data page", ErrorCategoryFile, false},
+	RtePageNoEnoughSpace:                    {"the data page does not have enough space", ErrorCategoryFile, false},
+	RteModifingMetaData:                     {"metadata is being modified; try again later", ErrorCategoryData, false},
+	RtePageSizeNotMatch:                     {"data page size mismatch", ErrorCategoryFile, false},
+	RteSyncBegin:                            {"start of the metadata synchronization error range", ErrorCategoryServer, false},
+	RteSyncInvalidConfig:                    {"metadata synchronization: invalid configuration", ErrorCategoryServer, false},
+	RteSyncInvalidVersion:                   {"metadata synchronization: invalid version", ErrorCategoryServer, false},
+	RteSyncConfirmExpired:                   {"metadata synchronization: waiting for confirmation expired", ErrorCategoryServer, false},
+	RteSyncTooManyFwdinfo:                   {"metadata synchronization: too much forwarding information", ErrorCategoryServer, false},
+	RteSyncNotMaster:                        {"metadata synchronization: not the primary", ErrorCategoryServer, true},
+	RteSyncSyncing:                          {"metadata synchronization: synchronization in progress", ErrorCategoryServer, true},
+	RteSyncUnsynced:                         {"metadata synchronization: not synchronized", ErrorCategoryServer, false},
+	RteSyncTablePosConflict:                 {"metadata synchronization: table position conflict", ErrorCategoryConflict, false},
+	RteSyncInvalidPointId:                   {"metadata synchronization: invalid point ID", ErrorCategoryServer, false},
+	RteSyncInvalidTableId:                   {"metadata synchronization: invalid table ID", ErrorCategoryServer, false},
+	RteSyncInvalidNamedTypeId:               {"metadata synchronization: invalid named type ID", ErrorCategoryServer, false},
+	RteSyncRestoring:                        {"metadata synchronization: rebuilding metadata", ErrorCategoryServer, true},
+	RteSyncServerIsNotRunning:               {"metadata synchronization: the network service is not running", ErrorCategoryServer, true},
+	RteSyncWriteWalFailed:                   {"metadata synchronization: failed to write the WAL", ErrorCategoryServer, false},
+	RteSyncEnd:                              {"end of the metadata synchronization error range", ErrorCategoryServer, false},
+	RteNetError:                             {"start of the network error range", ErrorCategoryNetwork, true},
+	RteSockWsaeintr:                         {"the blocking call was canceled by WSACancelBlockingCall()", ErrorCategoryNetwork, true},
+	RteSockWsaeacces:                        {"the requested address is a broadcast address but the corresponding flag is not set", ErrorCategorySystem, false},
+	RteSockWsaefault:                        {"invalid memory access", ErrorCategorySystem, false},
+	RteSockWsaemfile:                        {"no more socket descriptors are available", ErrorCategorySystem, false},
+	RteSockWsaewouldblock:                   {"the socket is non-blocking and the operation would block", ErrorCategoryNetwork, true},
+	RteSockWsaeinprogress:                   {"a blocking Windows Sockets operation is in progress", ErrorCategoryNetwork, true},
+	RteSockWsaealready:                      {"a non-blocking connect() is already in progress on the socket", ErrorCategorySystem, false},
+	RteSockWsaenotsock:                      {"the descriptor is not a socket", ErrorCategorySystem, false},
+	RteSockWsaedestaddrreq:                  {"a destination address is required", ErrorCategorySystem, false},
+	RteSockWsaemsgsize:                      {"the message is too large for the message-oriented socket", ErrorCategorySystem, false},
+	RteSockWsaeprototype:                    {"the protocol is the wrong type for this socket", ErrorCategorySystem, false},
+	RteSockWsaeprotonosupport:               {"the protocol is not supported", ErrorCategorySystem, false},
+	RteSockWsaesocktnosupport:               {"the socket type is not supported in this address family", ErrorCategorySystem, false},
+	RteSockWsaeopnotsupp:                    {"MSG_OOB was specified but the socket is not stream-oriented", ErrorCategorySystem, false},
+	RteSockWsaeafnosupport:                  {"the address family is not supported", ErrorCategorySystem, false},
+	RteSockWsaeaddrinuse:                    {"the local address of the socket is already in use", ErrorCategorySystem, false},
+	RteSockWsaeaddrnotavail:                 {"invalid remote address", ErrorCategorySystem, false},
+	RteSockWsaenetdown:                      {"Windows Sockets detected that the network subsystem has failed", ErrorCategoryNetwork, true},
+	RteSockWsaenetunreach:                   {"the network cannot reach the host", ErrorCategoryNetwork, true},
+	RteSockWsaenetreset:                     {"keep-alive detected a failure during the operation and the connection was broken", ErrorCategoryNetwork, true},
+	RteSockWsaeconnaborted:                  {"the connection was aborted because of a timeout or other failure", ErrorCategoryNetwork, true},
+	RteSockWsaeconnreset:                    {"the connection was reset", ErrorCategoryNetwork, true},
+	RteSockWsaenobufs:                       {"no buffer space is available", ErrorCategoryNetwork, true},
+	RteSockWsaeisconn:                       {"the socket is already connected", ErrorCategorySystem, false},
+	RteSockWsaenotconn:                      {"the socket is not connected", ErrorCategoryNetwork, true},
+	RteSockWsaeshutdown:                     {"the socket has been shut down and the connection is closed", ErrorCategoryNetwork, true},
+	RteSockWsaetimedout:                     {"the connection attempt timed out", ErrorCategoryNetwork, true},
+	RteSockWsaeconnrefused:                  {"the connection was refused", ErrorCategoryNetwork, true},
+	RteSockWsaeclose:                        {"the connection was closed", ErrorCategoryNetwork, true},
+	RteSockWsanotinitialised:                {"the Windows Sockets DLL is not initialized", ErrorCategorySystem, false},
+	RteCErrnoError:                          {"start of the C errno error range", ErrorCategorySystem, false},
+	RteCErrnoEperm:                          {"operation not permitted", ErrorCategoryPermission, false},
+	RteCErrnoEnoent:                         {"no such file or directory", ErrorCategoryNotFound, false},
+	RteCErrnoEsrch:                          {"no such process", ErrorCategoryNotFound, false},
+	RteCErrnoEintr:                          {"interrupted system call", ErrorCategorySystem, true},
+	RteCErrnoEio:                            {"I/O error", ErrorCategoryFile, false},
+	RteCErrnoEnxio:                          {"no such device or address", ErrorCategoryNotFound, false},
+	RteCErrnoE2big:                          {"argument list too long", ErrorCategoryData, false},
+	RteCErrnoEnoexec:                        {"exec format error", ErrorCategorySystem, false},
+	RteCErrnoEbadf:                          {"bad file number", ErrorCategoryFile, false},
+	RteCErrnoEchild:                         {"no child processes", ErrorCategorySystem, false},
+	RteCErrnoEagain:                         {"try again", ErrorCategorySystem, true},
+	RteCErrnoEnomem:                         {"out of memory", ErrorCategorySystem, true},
+	RteCErrnoEacces:                         {"permission denied", ErrorCategoryPermission, false},
+	RteCErrnoEfault:                         {"bad address", ErrorCategorySystem, false},
+	RteCErrnoEnotblk:                        {"block device required", ErrorCategorySystem, false},
+	RteCErrnoEbusy:                          {"device or resource busy", ErrorCategorySystem, true},
+	RteCErrnoEexist:                         {"file exists", ErrorCategoryConflict, false},
+	RteCErrnoExdev:                          {"cross-device link", ErrorCategoryFile, false},
+	RteCErrnoEnodev:                         {"no such device", ErrorCategoryNotFound, false},
+	RteCErrnoEnotdir:                        {"not a directory", ErrorCategoryFile, false},
+	RteCErrnoEisdir:                         {"is a directory", ErrorCategoryFile, false},
+	RteCErrnoEinval:                         {"invalid argument", ErrorCategoryData, false},
+	RteCErrnoEnfile:                         {"file table overflow", ErrorCategorySystem, true},
+	RteCErrnoEmfile:                         {"too many open files", ErrorCategorySystem, true},
+	RteCErrnoEnotty:                         {"not a typewriter", ErrorCategorySystem, false},
+	RteCErrnoEtxtbsy:                        {"text file busy", ErrorCategoryFile, false},
+	RteCErrnoEfbig:                          {"file too large", ErrorCategoryFile, false},
+	RteCErrnoEnospc:                         {"no space left on device", ErrorCategoryFile, true},
+	RteCErrnoEspipe:                         {"illegal seek", ErrorCategoryFile, false},
+	RteCErrnoErofs:                          {"read-only file system", ErrorCategoryPermission, false},
+	RteCErrnoEmlink:                         {"too many links", ErrorCategoryFile, false},
+	RteCErrnoEpipe:                          {"broken pipe", ErrorCategoryNetwork, true},
+	RteCErrnoEdom:                           {"math argument out of domain of func", ErrorCategoryData, false},
+	RteCErrnoErange:                         {"math result not representable", ErrorCategoryData, false},
+	RteCErrnoEdeadlk:                        {"resource deadlock would occur", ErrorCategorySystem, false},
+	RteCErrnoEnametoolong:                   {"file name too long", ErrorCategoryFile, false},
+	RteCErrnoEnolck:                         {"no record locks available", ErrorCategorySystem, false},
+	RteCErrnoEnosys:                         {"function not implemented", ErrorCategorySystem, false},
+	RteCErrnoEnotempty:                      {"directory not empty", ErrorCategoryFile, false},
+	RteCErrnoEloop:                          {"too many symbolic links encountered", ErrorCategoryFile, false},
+	RteCErrnoEnomsg:                         {"no message of desired type", ErrorCategorySystem, false},
+	RteCErrnoEidrm:                          {"identifier removed", ErrorCategorySystem, false},
+	RteCErrnoEchrng:                         {"channel number out of range", ErrorCategorySystem, false},
+	RteCErrnoEl2nsync:                       {"level 2 not synchronized", ErrorCategorySystem, false},
+	RteCErrnoEl3hlt:                         {"level 3 halted", ErrorCategorySystem, false},
+	RteCErrnoEl3rst:                         {"level 3 reset", ErrorCategorySystem, false},
+	RteCErrnoElnrng:                         {"link number out of range", ErrorCategorySystem, false},
+	RteCErrnoEunatch:                        {"protocol driver not attached", ErrorCategorySystem, false},
+	RteCErrnoEnocsi:                         {"no CSI structure available", ErrorCategorySystem, false},
+	RteCErrnoEl2hlt:                         {"level 2 halted", ErrorCategorySystem, false},
+	RteCErrnoEbade:                          {"invalid exchange", ErrorCategorySystem, false},
+	RteCErrnoEbadr:                          {"invalid request descriptor", ErrorCategorySystem, false},
+	RteCErrnoExfull:                         {"exchange full", ErrorCategorySystem, false},
+	RteCErrnoEnoano:                         {"no anode", ErrorCategorySystem, false},
+	RteCErrnoEbadrqc:                        {"invalid request code", ErrorCategorySystem, false},
+	RteCErrnoEbadslt:                        {"invalid slot", ErrorCategorySystem, false},
+	RteCErrnoEbfont:                         {"bad font file format", ErrorCategorySystem, false},
+	RteCErrnoEnostr:                         {"device not a stream", ErrorCategorySystem, false},
+	RteCErrnoEnodata:                        {"no data available", ErrorCategorySystem, false},
+	RteCErrnoEtime:                          {"timer expired", ErrorCategorySystem, false},
+	RteCErrnoEnosr:                          {"out of streams resources", ErrorCategorySystem, false},
+	RteCErrnoEnonet:                         {"machine is not on the network", ErrorCategoryNetwork, true},
+	RteCErrnoEnopkg:                         {"package not installed", ErrorCategorySystem, false},
+	RteCErrnoEremote:                        {"object is remote", ErrorCategorySystem, false},
+	RteCErrnoEnolink:                        {"link has been severed", ErrorCategoryNetwork, true},
+	RteCErrnoEadv:                           {"advertise error", ErrorCategorySystem, false},
+	RteCErrnoEsrmnt:                         {"srmount error", ErrorCategorySystem, false},
+	RteCErrnoEcomm:                          {"communication error on send", ErrorCategoryNetwork, true},
+	RteCErrnoEproto:                         {"protocol error", ErrorCategoryNetwork, true},
+	RteCErrnoEmultihop:                      {"multihop attempted", ErrorCategorySystem, false},
+	RteCErrnoEdotdot:                        {"RFS specific error", ErrorCategorySystem, false},
+	RteCErrnoEbadmsg:                        {"not a data message", ErrorCategoryData, false},
+	RteCErrnoEoverflow:                      {"value too large for defined data type", ErrorCategoryData, false},
+	RteCErrnoEnotuniq:                       {"name not unique on network", ErrorCategorySystem, false},
+	RteCErrnoEbadfd:                         {"file descriptor in bad state", ErrorCategorySystem, false},
+	RteCErrnoEremchg:                        {"remote address changed", ErrorCategorySystem, false},
+	RteCErrnoElibacc:                        {"can not access a needed shared library", ErrorCategorySystem, false},
+	RteCErrnoElibbad:                        {"accessing a corrupted shared library", ErrorCategorySystem, false},
+	RteCErrnoElibscn:                        {".lib section in a.out corrupted", ErrorCategorySystem, false},
+	RteCErrnoElibmax:                        {"attempting to link in too many shared libraries", ErrorCategorySystem, false},
+	RteCErrnoElibexec:                       {"cannot exec a shared library directly", ErrorCategorySystem, false},
+	RteCErrnoEilseq:                         {"illegal byte sequence", ErrorCategoryData, false},
+	RteCErrnoErestart:                       {"interrupted system call should be restarted", ErrorCategorySystem, false},
+	RteCErrnoEstrpipe:                       {"streams pipe error", ErrorCategorySystem, false},
+	RteCErrnoEusers:                         {"too many users", ErrorCategorySystem, false},
+	RteCErrnoEnotsock:                       {"socket operation on non-socket", ErrorCategorySystem, false},
+	RteCErrnoEdestaddrreq:                   {"destination address required", ErrorCategorySystem, false},
+	RteCErrnoEmsgsize:                       {"message too long", ErrorCategorySystem, false},
+	RteCErrnoEprototype:                     {"protocol wrong type for socket", ErrorCategorySystem, false},
+	RteCErrnoEnoprotoopt:                    {"protocol not available", ErrorCategorySystem, false},
+	RteCErrnoEprotonosupport:                {"protocol not supported", ErrorCategorySystem, false},
+	RteCErrnoEsocktnosupport:                {"socket type not supported", ErrorCategorySystem, false},
+	RteCErrnoEopnotsupp:                     {"operation not supported on transport endpoint", ErrorCategorySystem, false},
+	RteCErrnoEpfnosupport:                   {"protocol family not supported", ErrorCategorySystem, false},
+	RteCErrnoEafnosupport:                   {"address family not supported by protocol", ErrorCategorySystem, false},
+	RteCErrnoEaddrinuse:                     {"address already in use", ErrorCategorySystem, false},
+	RteCErrnoEaddrnotavail:                  {"cannot assign requested address", ErrorCategorySystem, false},
+	RteCErrnoEnetdown:                       {"network is down", ErrorCategoryNetwork, true},
+	RteCErrnoEnetunreach:                    {"network is unreachable", ErrorCategoryNetwork, true},
+	RteCErrnoEnetreset:                      {"network dropped connection because of reset", ErrorCategoryNetwork, true},
+	RteCErrnoEconnaborted:                   {"software caused connection abort", ErrorCategoryNetwork, true},
+	RteCErrnoEconnreset:                     {"connection reset by peer", ErrorCategoryNetwork, true},
+	RteCErrnoEnobufs:                        {"no buffer space available", ErrorCategoryNetwork, true},
+	RteCErrnoEisconn:                        {"transport endpoint is already connected", ErrorCategorySystem, false},
+	RteCErrnoEnotconn:                       {"transport endpoint is not connected", ErrorCategoryNetwork, true},
+	RteCErrnoEshutdown:                      {"cannot send after transport endpoint shutdown", ErrorCategoryNetwork, true},
+	RteCErrnoEtoomanyrefs:                   {"too many references: cannot splice", ErrorCategorySystem, false},
+	RteCErrnoEtimedout:                      {"connection timed out", ErrorCategoryNetwork, true},
+	RteCErrnoEconnrefused:                   {"connection refused", ErrorCategoryNetwork, true},
+	RteCErrnoEhostdown:                      {"host is down", ErrorCategoryNetwork, true},
+	RteCErrnoEhostunreach:                   {"no route to host", ErrorCategoryNetwork, true},
+	RteCErrnoEalready:                       {"operation already in progress", ErrorCategorySystem, false},
+	RteCErrnoEinprogress:                    {"operation now in progress", ErrorCategorySystem, false},
+	RteCErrnoEstale:                         {"stale file handle", ErrorCategorySystem, false},
+	RteCErrnoEuclean:                        {"structure needs cleaning", ErrorCategorySystem, false},
+	RteCErrnoEnotnam:                        {"not a XENIX named type file", ErrorCategorySystem, false},
+	RteCErrnoEnavail:                        {"no XENIX semaphores available", ErrorCategorySystem, false},
+	RteCErrnoEisnam:                         {"is a named type file", ErrorCategorySystem, false},
+	RteCErrnoEremoteio:                      {"remote I/O error", ErrorCategoryNetwork, true},
+	RteCErrnoEdquot:                         {"quota exceeded", ErrorCategoryFile, false},
+	RteCErrnoEnomedium:                      {"no medium found", ErrorCategorySystem, false},
+	RteCErrnoEmediumtype:                    {"wrong medium type", ErrorCategorySystem, false},
+	RteCErrnoEcanceled:                      {"operation canceled", ErrorCategorySystem, false},
+	RteCErrnoEnokey:                         {"required key not available", ErrorCategorySystem, false},
+	RteCErrnoEkeyexpired:                    {"key has expired", ErrorCategorySystem, false},
+	RteCErrnoEkeyrevoked:                    {"key has been revoked", ErrorCategorySystem, false},
+	RteCErrnoEkeyrejected:                   {"key was rejected by service", ErrorCategorySystem, false},
+	RteCErrnoEownerdead:                     {"owner died", ErrorCategorySystem, false},
+	RteCErrnoEnotrecoverable:                {"state not recoverable", ErrorCategorySystem, false},
+	RteCErrnoErfkill:                        {"operation not possible due to RF-kill", ErrorCategorySystem, false},
+	RteCErrnoEhwpoison:                      {"memory page has hardware error", ErrorCategorySystem, false},
+	RteIpcError:                             {"start of the IPC error range", ErrorCategorySystem, false},
+	RteIpcErrorEnd:                          {"end of the IPC error range", ErrorCategorySystem, false},
+}
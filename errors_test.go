@@ -0,0 +1,83 @@
+package rtdb_api
+
+import (
+	"errors"
+	"testing"
+)
+
+// 错误码的分类、重试与多语言描述
+func TestRtdbError_Category(t *testing.T) {
+	tests := []struct {
+		rte       RtdbError
+		category  ErrorCategory
+		retryable bool
+	}{
+		{RteOk, ErrorCategoryNone, false},
+		{RtePointNotFound, ErrorCategoryNotFound, false},
+		{RteReduplicateTag, ErrorCategoryConflict, false},
+		{RteWrongPassword, ErrorCategoryPermission, false},
+		{RteConnectTimeOut, ErrorCategoryNetwork, true},
+		{RteCErrnoEconnreset, ErrorCategoryNetwork, true},
+		{RteWindowsError + 5, ErrorCategorySystem, false},
+	}
+	for _, tt := range tests {
+		if tt.rte.Category() != tt.category {
+			t.Errorf("0x%08X: 期望分类%s, 实际%s", uint32(tt.rte), tt.category, tt.rte.Category())
+		}
+		if tt.rte.Retryable() != tt.retryable {
+			t.Errorf("0x%08X: 期望可重试%v", uint32(tt.rte), tt.retryable)
+		}
+	}
+
+	if RtePointNotFound.Message(ErrorLocaleEnglish) != "the requested point does not exist or is invalid" {
+		t.Error("英文描述错误", RtePointNotFound.Message(ErrorLocaleEnglish))
+	}
+	if RtePointNotFound.Message(ErrorLocaleChinese) != "要求访问的标签点不存在或无效" {
+		t.Error("中文描述错误", RtePointNotFound.Message(ErrorLocaleChinese))
+	}
+}
+
+// 通过 errors.Is 和 errors.As 判断easy接口返回的错误
+func TestOpError_IsAs(t *testing.T) {
+	conn, err := LoginWithBackend(NewMemoryBackend(), "127.0.0.1", Port, Username, Password)
+	if err != nil {
+		t.Fatal("登录用户失败", err)
+	}
+	defer func() { _ = conn.Logout() }()
+
+	_, err = conn.GetPoint(42)
+	if !errors.Is(err, RtePointNotFound) {
+		t.Fatal("期望标签点不存在", err)
+	}
+	var opErr *OpError
+	if !errors.As(err, &opErr) {
+		t.Fatal("期望OpError", err)
+	}
+	if opErr.PointID != 42 || opErr.Category() != ErrorCategoryNotFound || opErr.Retryable() {
+		t.Error("错误详情不正确", opErr)
+	}
+	if ErrorCategoryOf(err) != ErrorCategoryNotFound || IsRetryable(err) {
+		t.Error("错误分类不正确", err)
+	}
+
+	locale := GetErrorLocale()
+	defer SetErrorLocale(locale)
+	SetErrorLocale(ErrorLocaleEnglish)
+	if err.Error() != "GetPoint: point 42: the requested point does not exist or is invalid (0xFFFF000D)" {
+		t.Error("英文错误信息不正确", err)
+	}
+}
+
+// 默认使用中文, 只有显式指定时才使用英文
+func TestLocaleFromEnv(t *testing.T) {
+	t.Setenv("LANG", "en_US.UTF-8")
+	t.Setenv("LC_ALL", "C")
+	t.Setenv(EnvErrorLocale, "")
+	if localeFromEnv() != ErrorLocaleChinese {
+		t.Error("系统语言不应改变默认的中文错误信息")
+	}
+	t.Setenv(EnvErrorLocale, "en")
+	if localeFromEnv() != ErrorLocaleEnglish {
+		t.Error("RTDB_API_ERROR_LOCALE=en 时应当使用英文")
+	}
+}
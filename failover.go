@@ -0,0 +1,247 @@
+package rtdb_api
+
+import (
+	"errors"
+	"net"
+	"sync"
+	"time"
+)
+
+// Endpoint 服务端地址
+type Endpoint struct {
+	HostIp string // 主机IP
+	Port   int32  // 端口
+}
+
+// SyncLag 节点的同步滞后情况，用于监控备库是否落后于主库
+type SyncLag struct {
+	Node       int32          // 节点编号，从1开始
+	Role       RtdbSyncRole   // 角色
+	Status     RtdbSyncStatus // 同步状态
+	IpString   string         // 节点IP地址
+	Version    uint64         // 同步版本
+	VersionLag uint64         // 落后于主库的版本数，主库自身为0
+	DataSize   uint64         // 堆积数据大小
+}
+
+// LoginEndpoints 登录数据库，从多个候选地址中选择主库进行连接
+//
+// input:
+//   - endpoints 候选服务端地址列表，按优先级排列
+//   - userName 用户名
+//   - password 密码
+//
+// output:
+//   - RtdbConnect(conn) 返回数据库连接, 如果候选地址中没有主库，则返回第一个可以登录的连接
+func LoginEndpoints(endpoints []Endpoint, userName string, password string) (*RtdbConnect, error) {
+	backend, err := defaultBackend()
+	if err != nil {
+		return nil, err
+	}
+	return LoginEndpointsWithBackend(backend, endpoints, userName, password)
+}
+
+// LoginEndpointsWithBackend 同 LoginEndpoints, 使用指定的后端登录数据库
+func LoginEndpointsWithBackend(backend Backend, endpoints []Endpoint, userName string, password string) (*RtdbConnect, error) {
+	if len(endpoints) == 0 {
+		return nil, errors.New("服务端地址列表不能为空")
+	}
+	backend = guardBackend(backend)
+
+	var fallback *RtdbConnect
+	var lastErr error
+	for _, endpoint := range endpoints {
+		conn, err := loginEndpoint(backend, endpoint, userName, password)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		conn.Endpoints = endpoints
+		if len(endpoints) == 1 || isPrimaryEndpoint(endpoint, conn.SyncInfos) {
+			if fallback != nil {
+				_ = fallback.Logout()
+			}
+			return conn, nil
+		}
+		if fallback == nil {
+			fallback = conn
+		} else {
+			_ = conn.Logout()
+		}
+	}
+	if fallback != nil {
+		return fallback, nil
+	}
+	return nil, lastErr
+}
+
+// lookupHost 解析主机名, 测试时可以替换
+var lookupHost = net.LookupHost
+
+// isPrimaryEndpoint 根据元数据同步信息判断该地址是否为主库, 地址为主机名时先解析成IP再比较
+func isPrimaryEndpoint(endpoint Endpoint, infos []RtdbSyncInfo) bool {
+	if len(infos) == 1 {
+		return true
+	}
+	addrs := resolveHost(endpoint.HostIp)
+	for _, info := range infos {
+		ip := net.ParseIP(info.IpString)
+		for _, addr := range addrs {
+			if info.IpString == addr || (ip != nil && ip.Equal(net.ParseIP(addr))) {
+				return info.Role == RtdbSyncRoleMaster
+			}
+		}
+	}
+	return false
+}
+
+// resolveHost 主机的地址列表, 第一个为原始字符串, 解析失败时只有原始字符串
+func resolveHost(host string) []string {
+	addrs := []string{host}
+	if net.ParseIP(host) != nil {
+		return addrs
+	}
+	if resolved, err := lookupHost(host); err == nil {
+		addrs = append(addrs, resolved...)
+	}
+	return addrs
+}
+
+// computeSyncLags 根据元数据同步信息计算各节点相对主库的滞后
+func computeSyncLags(infos []RtdbSyncInfo) []SyncLag {
+	primaryVersion := uint64(0)
+	for _, info := range infos {
+		if info.Role == RtdbSyncRoleMaster {
+			primaryVersion = info.Version
+			break
+		}
+	}
+	lags := make([]SyncLag, 0, len(infos))
+	for i, info := range infos {
+		lag := SyncLag{
+			Node:     int32(i + 1),
+			Role:     info.Role,
+			Status:   info.Status,
+			IpString: info.IpString,
+			Version:  info.Version,
+			DataSize: info.DataSize,
+		}
+		if primaryVersion > info.Version {
+			lag.VersionLag = primaryVersion - info.Version
+		}
+		lags = append(lags, lag)
+	}
+	return lags
+}
+
+// Endpoint 当前连接的服务端地址
+func (c *RtdbConnect) Endpoint() Endpoint {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return Endpoint{HostIp: c.HostIp, Port: c.Port}
+}
+
+// GetSyncLags 获取各节点的同步滞后情况(堆积数据大小和版本差)，同时刷新 SyncInfos
+//
+// output:
+//   - []SyncLag(lags) 各节点同步滞后信息
+func (c *RtdbConnect) GetSyncLags() ([]SyncLag, error) {
+	handle, release := c.acquire()
+	defer release()
+	infos, errs, rte := c.backend.RawRtdbbGetMetaSyncInfoWarp(handle, 0)
+	if !RteIsOk(rte) {
+		return nil, c.opError("GetSyncLags", rte, 0)
+	}
+	for _, rte := range errs {
+		if !RteIsOk(rte) {
+			return nil, c.opError("GetSyncLags", rte, 0)
+		}
+	}
+	c.mu.Lock()
+	c.SyncInfos = infos
+	c.mu.Unlock()
+	return computeSyncLags(infos), nil
+}
+
+// CheckFailover 检查当前连接是否仍然指向可用的主库，当连接不可用或主库角色发生变化时，切换到新的主库
+//
+// output:
+//   - bool(switched) 是否发生了切换
+func (c *RtdbConnect) CheckFailover() (bool, error) {
+	current := c.Endpoint()
+	c.mu.RLock()
+	endpoints := c.Endpoints
+	c.mu.RUnlock()
+
+	handle, release := c.acquire()
+	healthy := RteIsOk(c.backend.RawRtdbJudgeConnectStatusWarp(handle))
+	release()
+	if healthy {
+		if _, err := c.GetSyncLags(); err != nil {
+			healthy = false
+		} else {
+			c.mu.RLock()
+			primary := len(endpoints) <= 1 || isPrimaryEndpoint(current, c.SyncInfos)
+			c.mu.RUnlock()
+			if primary {
+				return false, nil
+			}
+		}
+	}
+
+	conn, err := LoginEndpointsWithBackend(c.backend, endpoints, c.UserName, c.Password)
+	if err != nil {
+		return false, err
+	}
+	if healthy && conn.Endpoint() == current {
+		// 没有找到更合适的主库，保持原连接
+		_ = conn.Logout()
+		return false, nil
+	}
+
+	c.mu.Lock()
+	oldHandle := c.ConnectHandle
+	c.HostIp = conn.HostIp
+	c.Port = conn.Port
+	c.ConnectHandle = conn.ConnectHandle
+	c.Priv = conn.Priv
+	c.SyncInfos = conn.SyncInfos
+	c.SocketHandles = conn.SocketHandles
+	c.ServerOsType = conn.ServerOsType
+	c.StringBlobMaxLen = conn.StringBlobMaxLen
+	c.mu.Unlock()
+
+	// 其他协程可能仍在使用旧句柄, 断开连接前等待这些调用结束(参见 guardedBackend)
+	_ = c.backend.RawRtdbDisconnectWarp(oldHandle)
+	return true, nil
+}
+
+// StartFailover 启动后台主备切换检测
+//
+// input:
+//   - interval 检测间隔
+//   - onSwitch 检测结果回调，发生切换或检测出错时调用，可以为nil
+//
+// output:
+//   - func() 停止检测
+func (c *RtdbConnect) StartFailover(interval time.Duration, onSwitch func(from Endpoint, to Endpoint, err error)) func() {
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				from := c.Endpoint()
+				switched, err := c.CheckFailover()
+				if onSwitch != nil && (switched || err != nil) {
+					onSwitch(from, c.Endpoint(), err)
+				}
+			}
+		}
+	}()
+	once := sync.Once{}
+	return func() { once.Do(func() { close(done) }) }
+}
@@ -0,0 +1,197 @@
+package rtdb_api
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+// 多地址登录，自动选择主库
+func TestLoginEndpoints(t *testing.T) {
+	conn, err := LoginEndpoints([]Endpoint{{HostIp: Hostname, Port: Port}}, Username, Password)
+	if err != nil {
+		t.Fatal("登录用户失败", err)
+	}
+	defer func() { _ = conn.Logout() }()
+
+	lags, err := conn.GetSyncLags()
+	if err != nil {
+		t.Error("获取同步滞后信息失败：", err)
+		return
+	}
+	fmt.Println(conn.Endpoint(), lags)
+
+	switched, err := conn.CheckFailover()
+	if err != nil {
+		t.Error("主备切换检测失败：", err)
+		return
+	}
+	if switched {
+		t.Error("单机模式不应该发生切换")
+	}
+}
+
+// 主库判断
+func TestIsPrimaryEndpoint(t *testing.T) {
+	infos := []RtdbSyncInfo{
+		{Role: RtdbSyncRoleSlave, IpString: "10.0.0.1", Version: 90, DataSize: 1024},
+		{Role: RtdbSyncRoleMaster, IpString: "10.0.0.2", Version: 100},
+	}
+	if isPrimaryEndpoint(Endpoint{HostIp: "10.0.0.1", Port: Port}, infos) {
+		t.Error("10.0.0.1 不是主库")
+	}
+	if !isPrimaryEndpoint(Endpoint{HostIp: "10.0.0.2", Port: Port}, infos) {
+		t.Error("10.0.0.2 应该是主库")
+	}
+	if isPrimaryEndpoint(Endpoint{HostIp: "unknown-host", Port: Port}, infos) {
+		t.Error("无法匹配的地址不应该是主库")
+	}
+	if !isPrimaryEndpoint(Endpoint{HostIp: "unknown-host", Port: Port}, infos[:1]) {
+		t.Error("单机模式总是主库")
+	}
+
+	// 按主机名配置时解析成IP再比较
+	defer func(old func(string) ([]string, error)) { lookupHost = old }(lookupHost)
+	lookupHost = func(host string) ([]string, error) {
+		if host == "db-primary" {
+			return []string{"10.0.0.2"}, nil
+		}
+		return nil, fmt.Errorf("unknown host %s", host)
+	}
+	if !isPrimaryEndpoint(Endpoint{HostIp: "db-primary", Port: Port}, infos) {
+		t.Error("db-primary 解析为 10.0.0.2, 应该是主库")
+	}
+
+	lags := computeSyncLags(infos)
+	if lags[0].VersionLag != 10 || lags[0].DataSize != 1024 || lags[0].Node != 1 {
+		t.Error("备库滞后计算错误", lags[0])
+	}
+	if lags[1].VersionLag != 0 {
+		t.Error("主库滞后应该为0", lags[1])
+	}
+}
+
+// 断开连接前等待句柄上进行中的调用结束
+func TestGuardedBackendDrain(t *testing.T) {
+	backend := guardBackend(NewMemoryBackend()).(*guardedBackend)
+	if guardBackend(backend) != backend {
+		t.Fatal("重复包装")
+	}
+	handle, rte := backend.RawRtdbConnectWarp("127.0.0.1", Port)
+	if !RteIsOk(rte) {
+		t.Fatal(rte)
+	}
+	release := backend.enter(handle)
+	done := make(chan struct{})
+	go func() {
+		_ = backend.RawRtdbDisconnectWarp(handle)
+		close(done)
+	}()
+	select {
+	case <-done:
+		t.Fatal("进行中的调用结束之前断开了连接")
+	case <-time.After(50 * time.Millisecond):
+	}
+	release()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("调用结束后没有断开连接")
+	}
+}
+
+// unhealthyBackend 连接状态检测总是失败的后端, 每次 CheckFailover 都会切换到新的句柄
+type unhealthyBackend struct {
+	Backend
+}
+
+func (b unhealthyBackend) RawRtdbJudgeConnectStatusWarp(handle ConnectHandle) RtdbError {
+	return RteUnknownError
+}
+
+// 主备切换替换并断开旧句柄时, 并发的调用不会使用已经断开的句柄
+func TestCheckFailover_ConcurrentCalls(t *testing.T) {
+	conn, err := LoginWithBackend(unhealthyBackend{NewMemoryBackend()}, "127.0.0.1", Port, Username, Password)
+	if err != nil {
+		t.Fatal("登录用户失败", err)
+	}
+	defer func() { _ = conn.Logout() }()
+	if _, err := conn.CreateTable("failover", "主备切换"); err != nil {
+		t.Fatal(err)
+	}
+
+	stop := make(chan struct{})
+	errs := make(chan error, 8)
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+				}
+				if _, err := conn.GetTables(); err != nil {
+					errs <- err
+					return
+				}
+			}
+		}()
+	}
+	handles := make(map[ConnectHandle]bool)
+	for i := 0; i < 200; i++ {
+		switched, err := conn.CheckFailover()
+		if err != nil || !switched {
+			t.Fatal("期望切换到新的句柄", switched, err)
+		}
+		handles[conn.handle()] = true
+	}
+	close(stop)
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Error("切换期间的调用失败", err)
+	}
+	if len(handles) < 2 {
+		t.Error("没有切换句柄", handles)
+	}
+}
+
+// acquire 登记的调用结束之前, 主备切换不会断开该调用使用的句柄
+func TestCheckFailover_WaitsForAcquire(t *testing.T) {
+	conn, err := LoginWithBackend(unhealthyBackend{NewMemoryBackend()}, "127.0.0.1", Port, Username, Password)
+	if err != nil {
+		t.Fatal("登录用户失败", err)
+	}
+	defer func() { _ = conn.Logout() }()
+
+	handle, release := conn.acquire()
+	done := make(chan struct{})
+	go func() {
+		_, _ = conn.CheckFailover()
+		close(done)
+	}()
+	select {
+	case <-done:
+		t.Fatal("进行中的调用结束之前断开了旧句柄")
+	case <-time.After(50 * time.Millisecond):
+	}
+	if conn.handle() == handle {
+		t.Fatal("期望已经替换为新的句柄")
+	}
+	if _, rte := conn.backend.RawRtdbHostTime64Warp(handle); !RteIsOk(rte) {
+		t.Error("旧句柄在调用结束前应该仍然可用", rte)
+	}
+	release()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("调用结束后没有完成切换")
+	}
+	if _, rte := conn.backend.RawRtdbHostTime64Warp(handle); RteIsOk(rte) {
+		t.Error("切换完成后旧句柄应该已经断开")
+	}
+}
@@ -0,0 +1,57 @@
+//go:build ignore
+
+// 生成 embed_sha256.go, 记录 clibrary 中每个平台动态库的SHA256, 由 embed.go 中的 go:generate 调用
+
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+)
+
+// libraries 常量名与动态库路径, 与 embed.go 中的 go:embed 一一对应
+var libraries = []struct {
+	name string
+	path string
+}{
+	{"linuxAmd64RtdbSoSha256", "clibrary/linux_amd64/librtdbapi.so"},
+	{"linuxArm64RtdbSoSha256", "clibrary/linux_arm64/librtdbapi.so"},
+	{"windowsAmd32RtdbSoSha256", "clibrary/windows_amd32/rtdbapi.dll"},
+	{"windowsAmd64RtdbSoSha256", "clibrary/windows_amd64/rtdbapi.dll"},
+}
+
+func main() {
+	buf := bytes.Buffer{}
+	buf.WriteString("// Code generated by gen_sha256.go; DO NOT EDIT.\n\n")
+	buf.WriteString("//go:build !rtdb_noembed && cgo\n\n")
+	buf.WriteString("package rtdb_api\n\n")
+	buf.WriteString("// 内置动态库的SHA256, 更新 clibrary 中的动态库后需要执行 go generate 重新生成, 空文件的校验值为空字符串\n")
+	buf.WriteString("const (\n")
+	for _, lib := range libraries {
+		data, err := os.ReadFile(lib.path)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(data) == 0 {
+			// 占位的空文件不记录校验值, 加载时报告没有内置动态库, 放入实际发布的动态库后重新生成
+			log.Printf("%s 为空, %s 不记录SHA256", lib.path, lib.name)
+			fmt.Fprintf(&buf, "\t%s = \"\" // %s, 空文件\n", lib.name, lib.path)
+			continue
+		}
+		sum := sha256.Sum256(data)
+		fmt.Fprintf(&buf, "\t%s = %q // %s\n", lib.name, hex.EncodeToString(sum[:]), lib.path)
+	}
+	buf.WriteString(")\n")
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("embed_sha256.go", src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
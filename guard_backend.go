@@ -0,0 +1,651 @@
+package rtdb_api
+
+import "sync"
+
+// guardedBackend 记录每个连接句柄上进行中的Raw调用, 方法与 Backend 一一对应
+//   - 断开连接(RawRtdbDisconnectWarp)前等待该句柄上进行中的调用结束, 避免主备切换或登出时其他协程仍在使用已经释放的句柄
+type guardedBackend struct {
+	next  Backend
+	guard *handleGuard // 同一个后端的所有包装共享
+}
+
+// handleGuard 每个连接句柄上进行中的调用个数
+type handleGuard struct {
+	mu       sync.Mutex
+	cond     *sync.Cond
+	inflight map[ConnectHandle]int
+}
+
+// guardBackend 包装后端, 已经包装过的后端直接返回
+func guardBackend(next Backend) Backend {
+	if b, ok := next.(*guardedBackend); ok {
+		return b
+	}
+	guard := &handleGuard{inflight: make(map[ConnectHandle]int)}
+	guard.cond = sync.NewCond(&guard.mu)
+	return &guardedBackend{next: next, guard: guard}
+}
+
+// enter 开始一次调用, 返回结束调用的函数
+func (b *guardedBackend) enter(handle ConnectHandle) func() {
+	g := b.guard
+	g.mu.Lock()
+	g.inflight[handle]++
+	g.mu.Unlock()
+	return func() {
+		g.mu.Lock()
+		if g.inflight[handle]--; g.inflight[handle] <= 0 {
+			delete(g.inflight, handle)
+			g.cond.Broadcast()
+		}
+		g.mu.Unlock()
+	}
+}
+
+// drain 等待句柄上进行中的调用全部结束
+func (b *guardedBackend) drain(handle ConnectHandle) {
+	g := b.guard
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	for g.inflight[handle] > 0 {
+		g.cond.Wait()
+	}
+}
+
+func (b *guardedBackend) RawRtdbGetApiVersionWarp() (ApiVersion, RtdbError) {
+	return b.next.RawRtdbGetApiVersionWarp()
+}
+
+func (b *guardedBackend) RawRtdbSetOptionWarp(optionType RtdbApiOption, value int32) RtdbError {
+	return b.next.RawRtdbSetOptionWarp(optionType, value)
+}
+
+func (b *guardedBackend) RawRtdbConnectWarp(hostname string, port int32) (ConnectHandle, RtdbError) {
+	return b.next.RawRtdbConnectWarp(hostname, port)
+}
+
+func (b *guardedBackend) RawRtdbLoginWarp(handle ConnectHandle, user string, password string) (PrivGroup, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbLoginWarp(handle, user, password)
+}
+
+func (b *guardedBackend) RawRtdbDisconnectWarp(handle ConnectHandle) RtdbError {
+	b.drain(handle)
+	return b.next.RawRtdbDisconnectWarp(handle)
+}
+
+func (b *guardedBackend) RawRtdbGetDbInfo1Warp(handle ConnectHandle, param RtdbParam) (ParamString, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbGetDbInfo1Warp(handle, param)
+}
+
+func (b *guardedBackend) RawRtdbGetDbInfo2Warp(handle ConnectHandle, param RtdbParam) (ParamInt, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbGetDbInfo2Warp(handle, param)
+}
+
+func (b *guardedBackend) RawRtdbSetDbInfo1Warp(handle ConnectHandle, param RtdbParam, value ParamString) RtdbError {
+	defer b.enter(handle)()
+	return b.next.RawRtdbSetDbInfo1Warp(handle, param, value)
+}
+
+func (b *guardedBackend) RawRtdbSetDbInfo2Warp(handle ConnectHandle, param RtdbParam, value ParamInt) RtdbError {
+	defer b.enter(handle)()
+	return b.next.RawRtdbSetDbInfo2Warp(handle, param, value)
+}
+
+func (b *guardedBackend) RawRtdbConnectionCountWarp(handle ConnectHandle, nodeNumber int32) (int32, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbConnectionCountWarp(handle, nodeNumber)
+}
+
+func (b *guardedBackend) RawRtdbGetConnectionsWarp(handle ConnectHandle, nodeNumber int32, count int32) ([]SocketHandle, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbGetConnectionsWarp(handle, nodeNumber, count)
+}
+
+func (b *guardedBackend) RawRtdbGetOwnConnectionWarp(handle ConnectHandle, nodeNumber int32) (SocketHandle, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbGetOwnConnectionWarp(handle, nodeNumber)
+}
+
+func (b *guardedBackend) RawRtdbGetConnectionInfoIpv6Warp(handle ConnectHandle, nodeNumber int32, socket SocketHandle) (RtdbHostConnectInfoIpv6, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbGetConnectionInfoIpv6Warp(handle, nodeNumber, socket)
+}
+
+func (b *guardedBackend) RawRtdbOsType(handle ConnectHandle) (RtdbOsType, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbOsType(handle)
+}
+
+func (b *guardedBackend) RawRtdbChangePasswordWarp(handle ConnectHandle, user string, password string) RtdbError {
+	defer b.enter(handle)()
+	return b.next.RawRtdbChangePasswordWarp(handle, user, password)
+}
+
+func (b *guardedBackend) RawRtdbChangeMyPasswordWarp(handle ConnectHandle, oldPwd string, newPwd string) RtdbError {
+	defer b.enter(handle)()
+	return b.next.RawRtdbChangeMyPasswordWarp(handle, oldPwd, newPwd)
+}
+
+func (b *guardedBackend) RawRtdbGetPrivWarp(handle ConnectHandle) (PrivGroup, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbGetPrivWarp(handle)
+}
+
+func (b *guardedBackend) RawRtdbChangePrivWarp(handle ConnectHandle, user string, priv PrivGroup) RtdbError {
+	defer b.enter(handle)()
+	return b.next.RawRtdbChangePrivWarp(handle, user, priv)
+}
+
+func (b *guardedBackend) RawRtdbAddUserWarp(handle ConnectHandle, user string, password string, priv PrivGroup) RtdbError {
+	defer b.enter(handle)()
+	return b.next.RawRtdbAddUserWarp(handle, user, password, priv)
+}
+
+func (b *guardedBackend) RawRtdbRemoveUserWarp(handle ConnectHandle, user string) RtdbError {
+	defer b.enter(handle)()
+	return b.next.RawRtdbRemoveUserWarp(handle, user)
+}
+
+func (b *guardedBackend) RawRtdbLockUserWarp(handle ConnectHandle, user string, lock Switch) RtdbError {
+	defer b.enter(handle)()
+	return b.next.RawRtdbLockUserWarp(handle, user, lock)
+}
+
+func (b *guardedBackend) RawRtdbGetUsersWarp(handle ConnectHandle) ([]RtdbUserInfo, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbGetUsersWarp(handle)
+}
+
+func (b *guardedBackend) RawRtdbAddBlacklistWarp(handle ConnectHandle, addr string, mask string, desc string) RtdbError {
+	defer b.enter(handle)()
+	return b.next.RawRtdbAddBlacklistWarp(handle, addr, mask, desc)
+}
+
+func (b *guardedBackend) RawRtdbUpdateBlacklistWarp(handle ConnectHandle, oldAddr string, oldMask string, newAddr string, newMask string, newDesc string) RtdbError {
+	defer b.enter(handle)()
+	return b.next.RawRtdbUpdateBlacklistWarp(handle, oldAddr, oldMask, newAddr, newMask, newDesc)
+}
+
+func (b *guardedBackend) RawRtdbRemoveBlacklistWarp(handle ConnectHandle, addr string, mask string) RtdbError {
+	defer b.enter(handle)()
+	return b.next.RawRtdbRemoveBlacklistWarp(handle, addr, mask)
+}
+
+func (b *guardedBackend) RawRtdbGetBlacklistWarp(handle ConnectHandle) ([]BlackList, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbGetBlacklistWarp(handle)
+}
+
+func (b *guardedBackend) RawRtdbAddAuthorizationWarp(handle ConnectHandle, addr string, mask string, desc string, priv PrivGroup) RtdbError {
+	defer b.enter(handle)()
+	return b.next.RawRtdbAddAuthorizationWarp(handle, addr, mask, desc, priv)
+}
+
+func (b *guardedBackend) RawRtdbUpdateAuthorizationWarp(handle ConnectHandle, oldAddr string, oldMask string, newAddr string, newMask string, newDesc string, priv PrivGroup) RtdbError {
+	defer b.enter(handle)()
+	return b.next.RawRtdbUpdateAuthorizationWarp(handle, oldAddr, oldMask, newAddr, newMask, newDesc, priv)
+}
+
+func (b *guardedBackend) RawRtdbRemoveAuthorizationWarp(handle ConnectHandle, addr string, mask string) RtdbError {
+	defer b.enter(handle)()
+	return b.next.RawRtdbRemoveAuthorizationWarp(handle, addr, mask)
+}
+
+func (b *guardedBackend) RawRtdbGetAuthorizationsWarp(handle ConnectHandle) ([]AuthorizationsList, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbGetAuthorizationsWarp(handle)
+}
+
+func (b *guardedBackend) RawRtdbHostTime64Warp(handle ConnectHandle) (TimestampType, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbHostTime64Warp(handle)
+}
+
+func (b *guardedBackend) RawRtdbFormatTimespanWarp(timespan int32) (string, RtdbError) {
+	return b.next.RawRtdbFormatTimespanWarp(timespan)
+}
+
+func (b *guardedBackend) RawRtdbParseTimespanWarp(tStr string) (DateTimeType, RtdbError) {
+	return b.next.RawRtdbParseTimespanWarp(tStr)
+}
+
+func (b *guardedBackend) RawRtdbParseTimeWarp(tStr string) (TimestampType, SubtimeType, RtdbError) {
+	return b.next.RawRtdbParseTimeWarp(tStr)
+}
+
+func (b *guardedBackend) RawRtdbSetTimeoutWarp(handle ConnectHandle, socket SocketHandle, timeout DateTimeType) RtdbError {
+	defer b.enter(handle)()
+	return b.next.RawRtdbSetTimeoutWarp(handle, socket, timeout)
+}
+
+func (b *guardedBackend) RawRtdbGetTimeoutWarp(handle ConnectHandle, socket SocketHandle) (DateTimeType, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbGetTimeoutWarp(handle, socket)
+}
+
+func (b *guardedBackend) RawRtdbKillConnectionWarp(handle ConnectHandle, socket SocketHandle) RtdbError {
+	defer b.enter(handle)()
+	return b.next.RawRtdbKillConnectionWarp(handle, socket)
+}
+
+func (b *guardedBackend) RawRtdbGetLogicalDriversWarp(handle ConnectHandle) ([]string, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbGetLogicalDriversWarp(handle)
+}
+
+func (b *guardedBackend) RawRtdbOpenPathWarp(handle ConnectHandle, dir string) RtdbError {
+	defer b.enter(handle)()
+	return b.next.RawRtdbOpenPathWarp(handle, dir)
+}
+
+func (b *guardedBackend) RawRtdbReadPath64Warp(handle ConnectHandle) (DirItem, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbReadPath64Warp(handle)
+}
+
+func (b *guardedBackend) RawRtdbClosePathWarp(handle ConnectHandle) RtdbError {
+	defer b.enter(handle)()
+	return b.next.RawRtdbClosePathWarp(handle)
+}
+
+func (b *guardedBackend) RawRtdbMkdirWarp(handle ConnectHandle, dirName string) RtdbError {
+	defer b.enter(handle)()
+	return b.next.RawRtdbMkdirWarp(handle, dirName)
+}
+
+func (b *guardedBackend) RawRtdbGetFileSizeWarp(handle ConnectHandle, filePath string) (int64, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbGetFileSizeWarp(handle, filePath)
+}
+
+func (b *guardedBackend) RawRtdbReadFileWarp(handle ConnectHandle, filePath string, pos int64, cacheSize int64) ([]byte, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbReadFileWarp(handle, filePath, pos, cacheSize)
+}
+
+func (b *guardedBackend) RawRtdbGetMaxBlobLenWarp(handle ConnectHandle) (int32, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbGetMaxBlobLenWarp(handle)
+}
+
+func (b *guardedBackend) RawRtdbFormatQualityWarp(handle ConnectHandle, qualities []Quality) ([]string, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbFormatQualityWarp(handle, qualities)
+}
+
+func (b *guardedBackend) RawRtdbJudgeConnectStatusWarp(handle ConnectHandle) RtdbError {
+	defer b.enter(handle)()
+	return b.next.RawRtdbJudgeConnectStatusWarp(handle)
+}
+
+func (b *guardedBackend) RawRtdbbAppendTableWarp(handle ConnectHandle, tableName, tableDesc string) (RtdbTable, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbbAppendTableWarp(handle, tableName, tableDesc)
+}
+
+func (b *guardedBackend) RawRtdbbRemoveTableByIdWarp(handle ConnectHandle, tableID TableID) RtdbError {
+	defer b.enter(handle)()
+	return b.next.RawRtdbbRemoveTableByIdWarp(handle, tableID)
+}
+
+func (b *guardedBackend) RawRtdbbTablesCountWarp(handle ConnectHandle) (int32, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbbTablesCountWarp(handle)
+}
+
+func (b *guardedBackend) RawRtdbbGetTablesWarp(handle ConnectHandle, count int32) ([]TableID, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbbGetTablesWarp(handle, count)
+}
+
+func (b *guardedBackend) RawRtdbbGetTablePropertyByIdWarp(handle ConnectHandle, tableID TableID) (RtdbTable, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbbGetTablePropertyByIdWarp(handle, tableID)
+}
+
+func (b *guardedBackend) RawRtdbbInsertMaxPointWarp(handle ConnectHandle, base *RtdbPoint, scan *RtdbScan, calc *RtdbCalc) (*RtdbPoint, *RtdbScan, *RtdbCalc, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbbInsertMaxPointWarp(handle, base, scan, calc)
+}
+
+func (b *guardedBackend) RawRtdbbRemovePointByIdWarp(handle ConnectHandle, id PointID) RtdbError {
+	defer b.enter(handle)()
+	return b.next.RawRtdbbRemovePointByIdWarp(handle, id)
+}
+
+func (b *guardedBackend) RawRtdbbInsertNamedTypePointWarp(handle ConnectHandle, base *RtdbPoint, scan *RtdbScan, name string) (*RtdbPoint, *RtdbScan, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbbInsertNamedTypePointWarp(handle, base, scan, name)
+}
+
+func (b *guardedBackend) RawRtdbbMovePointByIdWarp(handle ConnectHandle, id PointID, tableName string) RtdbError {
+	defer b.enter(handle)()
+	return b.next.RawRtdbbMovePointByIdWarp(handle, id, tableName)
+}
+
+func (b *guardedBackend) RawRtdbbGetMaxPointsPropertyWarp(handle ConnectHandle, ids []PointID) ([]RtdbPoint, []RtdbScan, []RtdbCalc, []RtdbError, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbbGetMaxPointsPropertyWarp(handle, ids)
+}
+
+func (b *guardedBackend) RawRtdbbSearchExWarp(handle ConnectHandle, maxCount int32, tagMask, tableMask, source, unit, desc, instrument, typeMask string, classOfMask RtdbType, timeUnitMask RtdbPrecision, otherTypeMask RtdbSearch, otherTypeMaskValue string, model RtdbSortFlag) ([]PointID, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbbSearchExWarp(handle, maxCount, tagMask, tableMask, source, unit, desc, instrument, typeMask, classOfMask, timeUnitMask, otherTypeMask, otherTypeMaskValue, model)
+}
+
+func (b *guardedBackend) RawRtdbbSearchPointsCountWarp(handle ConnectHandle, tagMask, tableMask, source, unit, desc, instrument, typeMask string, classOfMask RtdbType, timeUnitMask RtdbPrecision, otherTypeMask RtdbSearch, otherTypeMaskValue string) (int32, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbbSearchPointsCountWarp(handle, tagMask, tableMask, source, unit, desc, instrument, typeMask, classOfMask, timeUnitMask, otherTypeMask, otherTypeMaskValue)
+}
+
+func (b *guardedBackend) RawRtdbbUpdateMaxPointPropertyWarp(handle ConnectHandle, base *RtdbPoint, scan *RtdbScan, calc *RtdbCalc) RtdbError {
+	defer b.enter(handle)()
+	return b.next.RawRtdbbUpdateMaxPointPropertyWarp(handle, base, scan, calc)
+}
+
+func (b *guardedBackend) RawRtdbbFindPointsExWarp(handle ConnectHandle, tableDotTags []string) ([]PointID, []RtdbType, []RtdbClass, []RtdbPrecision, []RtdbError, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbbFindPointsExWarp(handle, tableDotTags)
+}
+
+func (b *guardedBackend) RawRtdbbUpdateTableNameWarp(handle ConnectHandle, id TableID, name string) RtdbError {
+	defer b.enter(handle)()
+	return b.next.RawRtdbbUpdateTableNameWarp(handle, id, name)
+}
+
+func (b *guardedBackend) RawRtdbbUpdateTableDescByIdWarp(handle ConnectHandle, id TableID, desc string) RtdbError {
+	defer b.enter(handle)()
+	return b.next.RawRtdbbUpdateTableDescByIdWarp(handle, id, desc)
+}
+
+func (b *guardedBackend) RawRtdbbRecoverPointWarp(handle ConnectHandle, tableID TableID, pointID PointID) RtdbError {
+	defer b.enter(handle)()
+	return b.next.RawRtdbbRecoverPointWarp(handle, tableID, pointID)
+}
+
+func (b *guardedBackend) RawRtdbbPurgePointWarp(handle ConnectHandle, id PointID) RtdbError {
+	defer b.enter(handle)()
+	return b.next.RawRtdbbPurgePointWarp(handle, id)
+}
+
+func (b *guardedBackend) RawRtdbbGetRecycledPointsCountWarp(handle ConnectHandle) (int32, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbbGetRecycledPointsCountWarp(handle)
+}
+
+func (b *guardedBackend) RawRtdbbGetRecycledPointsWarp(handle ConnectHandle, count int32) ([]PointID, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbbGetRecycledPointsWarp(handle, count)
+}
+
+func (b *guardedBackend) RawRtdbbSearchRecycledPointsInBatchesWarp(handle ConnectHandle, start int32, count int32, tagMask, fullMask, source, unit, desc, instrument string, mode RtdbSortFlag) ([]PointID, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbbSearchRecycledPointsInBatchesWarp(handle, start, count, tagMask, fullMask, source, unit, desc, instrument, mode)
+}
+
+func (b *guardedBackend) RawRtdbbGetRecycledMaxPointPropertyWarp(handle ConnectHandle, id PointID) (*RtdbPoint, *RtdbScan, *RtdbCalc, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbbGetRecycledMaxPointPropertyWarp(handle, id)
+}
+
+func (b *guardedBackend) RawRtdbbClearRecyclerWarp(handle ConnectHandle) RtdbError {
+	defer b.enter(handle)()
+	return b.next.RawRtdbbClearRecyclerWarp(handle)
+}
+
+func (b *guardedBackend) RawRtdbbCreateNamedTypeWarp(handle ConnectHandle, name string, desc string, fields ...RtdbDataTypeField) RtdbError {
+	defer b.enter(handle)()
+	return b.next.RawRtdbbCreateNamedTypeWarp(handle, name, desc, fields...)
+}
+
+func (b *guardedBackend) RawRtdbbGetNamedTypesCountWarp(handle ConnectHandle) (int32, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbbGetNamedTypesCountWarp(handle)
+}
+
+func (b *guardedBackend) RawRtdbbGetAllNamedTypesWarp(handle ConnectHandle, count int32) ([]string, []int32, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbbGetAllNamedTypesWarp(handle, count)
+}
+
+func (b *guardedBackend) RawRtdbbGetNamedTypeWarp(handle ConnectHandle, name string, fieldCount int32) ([]RtdbDataTypeField, int32, string, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbbGetNamedTypeWarp(handle, name, fieldCount)
+}
+
+func (b *guardedBackend) RawRtdbbRemoveNamedTypeWarp(handle ConnectHandle, name string) RtdbError {
+	defer b.enter(handle)()
+	return b.next.RawRtdbbRemoveNamedTypeWarp(handle, name)
+}
+
+func (b *guardedBackend) RawRtdbbGetNamedTypeNamesPropertyWarp(handle ConnectHandle, ids []PointID) ([]string, []int32, []RtdbError, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbbGetNamedTypeNamesPropertyWarp(handle, ids)
+}
+
+func (b *guardedBackend) RawRtdbbGetRecycledNamedTypeNamesPropertyWarp(handle ConnectHandle, ids []PointID) ([]string, []int32, []RtdbError, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbbGetRecycledNamedTypeNamesPropertyWarp(handle, ids)
+}
+
+func (b *guardedBackend) RawRtdbbGetNamedTypePointsCountWarp(handle ConnectHandle, name string) (int32, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbbGetNamedTypePointsCountWarp(handle, name)
+}
+
+func (b *guardedBackend) RawRtdbbGetBaseTypePointsCountWarp(handle ConnectHandle, rtdbType RtdbType) (int32, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbbGetBaseTypePointsCountWarp(handle, rtdbType)
+}
+
+func (b *guardedBackend) RawRtdbbModifyNamedTypeWarp(handle ConnectHandle, name string, modifyName *string, modifyDesc *string, fieldNames []string, fieldDescs []string) RtdbError {
+	defer b.enter(handle)()
+	return b.next.RawRtdbbModifyNamedTypeWarp(handle, name, modifyName, modifyDesc, fieldNames, fieldDescs)
+}
+
+func (b *guardedBackend) RawRtdbWriteNamedTypeFieldByName32Warp(handle ConnectHandle, typeName string, fieldName string, fieldType RtdbType, object []byte, field []byte) RtdbError {
+	defer b.enter(handle)()
+	return b.next.RawRtdbWriteNamedTypeFieldByName32Warp(handle, typeName, fieldName, fieldType, object, field)
+}
+
+func (b *guardedBackend) RawRtdbWriteNamedTypeFieldByPos32Warp(handle ConnectHandle, typeName string, fieldPos int32, fieldType RtdbType, object []byte, field []byte) RtdbError {
+	defer b.enter(handle)()
+	return b.next.RawRtdbWriteNamedTypeFieldByPos32Warp(handle, typeName, fieldPos, fieldType, object, field)
+}
+
+func (b *guardedBackend) RawRtdbReadNamedTypeFieldByName32Warp(handle ConnectHandle, typeName string, fieldName string, fieldType RtdbType, object []byte, fieldLen int32) ([]byte, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbReadNamedTypeFieldByName32Warp(handle, typeName, fieldName, fieldType, object, fieldLen)
+}
+
+func (b *guardedBackend) RawRtdbReadNamedTypeFieldByPos32Warp(handle ConnectHandle, typeName string, fieldPos int32, fieldType RtdbType, object []byte, fieldLen int32) ([]byte, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbReadNamedTypeFieldByPos32Warp(handle, typeName, fieldPos, fieldType, object, fieldLen)
+}
+
+func (b *guardedBackend) RawRtdbNamedTypeNameFieldCheckWarp(checkName string, flag byte) RtdbError {
+	return b.next.RawRtdbNamedTypeNameFieldCheckWarp(checkName, flag)
+}
+
+func (b *guardedBackend) RawRtdbbGetMetaSyncInfoWarp(handle ConnectHandle, nodeNumber int32) ([]RtdbSyncInfo, []RtdbError, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbbGetMetaSyncInfoWarp(handle, nodeNumber)
+}
+
+func (b *guardedBackend) RawRtdbaGetArchivesCountWarp(handle ConnectHandle) (int32, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbaGetArchivesCountWarp(handle)
+}
+
+func (b *guardedBackend) RawRtdbaGetArchivesWarp(handle ConnectHandle, maxCount int32) ([]string, []string, []RtdbArchiveState, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbaGetArchivesWarp(handle, maxCount)
+}
+
+func (b *guardedBackend) RawRtdbsPutSnapshots64Warp(handle ConnectHandle, ids []PointID, datetimes []TimestampType, subtimes []SubtimeType, values []float64, states []int64, qualities []Quality) ([]RtdbError, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbsPutSnapshots64Warp(handle, ids, datetimes, subtimes, values, states, qualities)
+}
+
+func (b *guardedBackend) RawRtdbsFixSnapshots64Warp(handle ConnectHandle, ids []PointID, datetimes []TimestampType, subtimes []SubtimeType, values []float64, states []int64, qualities []Quality) ([]RtdbError, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbsFixSnapshots64Warp(handle, ids, datetimes, subtimes, values, states, qualities)
+}
+
+func (b *guardedBackend) RawRtdbsPutCoorSnapshots64Warp(handle ConnectHandle, ids []PointID, datetimes []TimestampType, subtimes []SubtimeType, xs []float32, ys []float32, qualities []Quality) ([]RtdbError, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbsPutCoorSnapshots64Warp(handle, ids, datetimes, subtimes, xs, ys, qualities)
+}
+
+func (b *guardedBackend) RawRtdbsFixCoorSnapshots64Warp(handle ConnectHandle, ids []PointID, datetimes []TimestampType, subtimes []SubtimeType, xs []float32, ys []float32, qualities []Quality) ([]RtdbError, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbsFixCoorSnapshots64Warp(handle, ids, datetimes, subtimes, xs, ys, qualities)
+}
+
+func (b *guardedBackend) RawRtdbsPutBlobSnapshots64Warp(handle ConnectHandle, ids []PointID, datetimes []TimestampType, subtimes []SubtimeType, blobs [][]byte, qualities []Quality) ([]RtdbError, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbsPutBlobSnapshots64Warp(handle, ids, datetimes, subtimes, blobs, qualities)
+}
+
+func (b *guardedBackend) RawRtdbsPutDatetimeSnapshots64Warp(handle ConnectHandle, ids []PointID, datetimes []TimestampType, subtimes []SubtimeType, dtValues []string, qualities []Quality) ([]RtdbError, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbsPutDatetimeSnapshots64Warp(handle, ids, datetimes, subtimes, dtValues, qualities)
+}
+
+func (b *guardedBackend) RawRtdbsPutNamedTypeSnapshots64Warp(handle ConnectHandle, ids []PointID, datetimes []TimestampType, subtimes []SubtimeType, objects [][]byte, qualities []Quality) ([]RtdbError, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbsPutNamedTypeSnapshots64Warp(handle, ids, datetimes, subtimes, objects, qualities)
+}
+
+func (b *guardedBackend) RawRtdbsGetSnapshots64Warp(handle ConnectHandle, ids []PointID) ([]TimestampType, []SubtimeType, []float64, []int64, []Quality, []RtdbError, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbsGetSnapshots64Warp(handle, ids)
+}
+
+func (b *guardedBackend) RawRtdbsGetCoorSnapshots64Warp(handle ConnectHandle, ids []PointID) ([]TimestampType, []SubtimeType, []float32, []float32, []Quality, []RtdbError, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbsGetCoorSnapshots64Warp(handle, ids)
+}
+
+func (b *guardedBackend) RawRtdbsGetBlobSnapshots64Warp(handle ConnectHandle, ids []PointID, maxLen int32) ([]TimestampType, []SubtimeType, [][]byte, []Quality, []RtdbError, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbsGetBlobSnapshots64Warp(handle, ids, maxLen)
+}
+
+func (b *guardedBackend) RawRtdbsGetDatetimeSnapshots64Warp(handle ConnectHandle, ids []PointID, typ int16) ([]TimestampType, []SubtimeType, []string, []Quality, []RtdbError, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbsGetDatetimeSnapshots64Warp(handle, ids, typ)
+}
+
+func (b *guardedBackend) RawRtdbsGetNamedTypeSnapshots64Warp(handle ConnectHandle, ids []PointID, lens []int32) ([]TimestampType, []SubtimeType, [][]byte, []Quality, []RtdbError, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbsGetNamedTypeSnapshots64Warp(handle, ids, lens)
+}
+
+func (b *guardedBackend) RawRtdbsSubscribeSnapshotsEx64Warp(handle ConnectHandle, ids []PointID, options RtdbSubscribeOption, callback SnapshotEventFunc) ([]RtdbError, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbsSubscribeSnapshotsEx64Warp(handle, ids, options, callback)
+}
+
+func (b *guardedBackend) RawRtdbsSubscribeDeltaSnapshots64Warp(handle ConnectHandle, ids []PointID, deltaValues []float64, deltaStates []int64, options RtdbSubscribeOption, callback SnapshotEventFunc) ([]RtdbError, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbsSubscribeDeltaSnapshots64Warp(handle, ids, deltaValues, deltaStates, options, callback)
+}
+
+func (b *guardedBackend) RawRtdbsChangeSubscribeSnapshotsWarp(handle ConnectHandle, ids []PointID, deltaValues []float64, deltaStates []int64, changedTypes []RtdbSubscribeChangeType) ([]RtdbError, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbsChangeSubscribeSnapshotsWarp(handle, ids, deltaValues, deltaStates, changedTypes)
+}
+
+func (b *guardedBackend) RawRtdbsCancelSubscribeSnapshotsWarp(handle ConnectHandle) RtdbError {
+	defer b.enter(handle)()
+	return b.next.RawRtdbsCancelSubscribeSnapshotsWarp(handle)
+}
+
+func (b *guardedBackend) RawRtdbhGetSingleValue64Warp(handle ConnectHandle, id PointID, mode RtdbHisMode, datetime TimestampType, subtime SubtimeType) (TimestampType, SubtimeType, float64, int64, Quality, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbhGetSingleValue64Warp(handle, id, mode, datetime, subtime)
+}
+
+func (b *guardedBackend) RawRtdbhGetSingleCoorValue64Warp(handle ConnectHandle, id PointID, mode RtdbHisMode, datetime TimestampType, subtime SubtimeType) (TimestampType, SubtimeType, float32, float32, Quality, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbhGetSingleCoorValue64Warp(handle, id, mode, datetime, subtime)
+}
+
+func (b *guardedBackend) RawRtdbhGetSingleBlobValue64Warp(handle ConnectHandle, id PointID, mode RtdbHisMode, datetime TimestampType, subtime SubtimeType, maxLen int32) (TimestampType, SubtimeType, []byte, Quality, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbhGetSingleBlobValue64Warp(handle, id, mode, datetime, subtime, maxLen)
+}
+
+func (b *guardedBackend) RawRtdbhGetSingleDatetimeValue64Warp(handle ConnectHandle, id PointID, mode RtdbHisMode, datetime TimestampType, subtime SubtimeType, dtType int16) (TimestampType, SubtimeType, []byte, Quality, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbhGetSingleDatetimeValue64Warp(handle, id, mode, datetime, subtime, dtType)
+}
+
+func (b *guardedBackend) RawRtdbhGetSingleNamedTypeValue64Warp(handle ConnectHandle, id PointID, mode RtdbHisMode, datetime TimestampType, subtime SubtimeType, length int32) (TimestampType, SubtimeType, []byte, Quality, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbhGetSingleNamedTypeValue64Warp(handle, id, mode, datetime, subtime, length)
+}
+
+func (b *guardedBackend) RawRtdbhGetArchivedValues64Warp(handle ConnectHandle, id PointID, count int32, datetime1 TimestampType, subtime1 SubtimeType, datetime2 TimestampType, subtime2 SubtimeType) ([]TimestampType, []SubtimeType, []float64, []int64, []Quality, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbhGetArchivedValues64Warp(handle, id, count, datetime1, subtime1, datetime2, subtime2)
+}
+
+func (b *guardedBackend) RawRtdbhGetArchivedCoorValues64Warp(handle ConnectHandle, id PointID, count int32, datetime1 TimestampType, subtime1 SubtimeType, datetime2 TimestampType, subtime2 SubtimeType) ([]TimestampType, []SubtimeType, []float32, []float32, []Quality, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbhGetArchivedCoorValues64Warp(handle, id, count, datetime1, subtime1, datetime2, subtime2)
+}
+
+func (b *guardedBackend) RawRtdbhGetArchivedBlobValues64Warp(handle ConnectHandle, id PointID, maxLen int32, maxCount int32, datetime1 TimestampType, subtime1 SubtimeType, datetime2 TimestampType, subtime2 SubtimeType) ([]TimestampType, []SubtimeType, [][]byte, []Quality, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbhGetArchivedBlobValues64Warp(handle, id, maxLen, maxCount, datetime1, subtime1, datetime2, subtime2)
+}
+
+func (b *guardedBackend) RawRtdbhGetArchivedDatetimeValues64Warp(handle ConnectHandle, id PointID, maxCount int32, datetime1 TimestampType, subtime1 SubtimeType, datetime2 TimestampType, subtime2 SubtimeType, dtType int16) ([]TimestampType, []SubtimeType, [][]byte, []Quality, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbhGetArchivedDatetimeValues64Warp(handle, id, maxCount, datetime1, subtime1, datetime2, subtime2, dtType)
+}
+
+func (b *guardedBackend) RawRtdbhGetArchivedNamedTypeValues64Warp(handle ConnectHandle, id PointID, datetime1 TimestampType, subtime1 SubtimeType, datetime2 TimestampType, subtime2 SubtimeType, length int32, maxCount int32) ([]TimestampType, []SubtimeType, [][]byte, []Quality, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbhGetArchivedNamedTypeValues64Warp(handle, id, datetime1, subtime1, datetime2, subtime2, length, maxCount)
+}
+
+func (b *guardedBackend) RawRtdbhGetInterpoValues64Warp(handle ConnectHandle, id PointID, count int32, datetime1 TimestampType, subtime1 SubtimeType, datetime2 TimestampType, subtime2 SubtimeType) ([]TimestampType, []SubtimeType, []float64, []int64, []Quality, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbhGetInterpoValues64Warp(handle, id, count, datetime1, subtime1, datetime2, subtime2)
+}
+
+func (b *guardedBackend) RawRtdbhSummaryDataWarp(handle ConnectHandle, id PointID, datetime1 TimestampType, subtime1 SubtimeType, datetime2 TimestampType, subtime2 SubtimeType) (*RtdbSummaryData, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbhSummaryDataWarp(handle, id, datetime1, subtime1, datetime2, subtime2)
+}
+
+func (b *guardedBackend) RawRtdbhPutArchivedDatetimeValues64Warp(handle ConnectHandle, ids []PointID, datetimes []TimestampType, subtimes []SubtimeType, dtValues []string, qualities []Quality) ([]RtdbError, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbhPutArchivedDatetimeValues64Warp(handle, ids, datetimes, subtimes, dtValues, qualities)
+}
+
+func (b *guardedBackend) RawRtdbhPutArchivedValues64Warp(handle ConnectHandle, ids []PointID, datetimes []TimestampType, subtimes []SubtimeType, values []float64, states []int64, qualities []Quality) ([]RtdbError, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbhPutArchivedValues64Warp(handle, ids, datetimes, subtimes, values, states, qualities)
+}
+
+func (b *guardedBackend) RawRtdbhPutArchivedCoorValues64Warp(handle ConnectHandle, ids []PointID, datetimes []TimestampType, subtimes []SubtimeType, xs []float32, ys []float32, qualities []Quality) ([]RtdbError, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbhPutArchivedCoorValues64Warp(handle, ids, datetimes, subtimes, xs, ys, qualities)
+}
+
+func (b *guardedBackend) RawRtdbhPutArchivedBlobValues64Warp(handle ConnectHandle, ids []PointID, datetimes []TimestampType, subtimes []SubtimeType, blobs [][]byte, qualities []Quality) ([]RtdbError, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbhPutArchivedBlobValues64Warp(handle, ids, datetimes, subtimes, blobs, qualities)
+}
+
+func (b *guardedBackend) RawRtdbhPutArchivedNamedTypeValues64Warp(handle ConnectHandle, ids []PointID, datetimes []TimestampType, subtimes []SubtimeType, objects [][]byte, qualities []Quality) ([]RtdbError, RtdbError) {
+	defer b.enter(handle)()
+	return b.next.RawRtdbhPutArchivedNamedTypeValues64Warp(handle, ids, datetimes, subtimes, objects, qualities)
+}
@@ -0,0 +1,386 @@
+package rtdb_api
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// InfluxTag InfluxDB行协议中的标签
+type InfluxTag struct {
+	Key   string
+	Value string
+}
+
+// InfluxField InfluxDB行协议中的字段
+type InfluxField struct {
+	Key string
+
+	// Value 字段值, 类型为 float64、int64、uint64、bool 或 string
+	Value any
+}
+
+// InfluxPoint InfluxDB行协议中的一行
+type InfluxPoint struct {
+	Measurement string
+	Tags        []InfluxTag
+	Fields      []InfluxField
+
+	// Timestamp 时间戳, 行中没有时间戳时为解析时传入的当前时间
+	Timestamp time.Time
+}
+
+// Tag 获取标签值
+func (p *InfluxPoint) Tag(key string) (string, bool) {
+	for _, tag := range p.Tags {
+		if tag.Key == key {
+			return tag.Value, true
+		}
+	}
+	return "", false
+}
+
+// InfluxLineError 解析行协议时单行的错误
+type InfluxLineError struct {
+	// Line 行号, 从1开始
+	Line int
+
+	// Err 错误原因
+	Err error
+}
+
+func (e *InfluxLineError) Error() string {
+	return fmt.Sprintf("第%d行: %v", e.Line, e.Err)
+}
+
+func (e *InfluxLineError) Unwrap() error {
+	return e.Err
+}
+
+// ParseInfluxPrecision 解析时间戳精度, 支持InfluxDB v1与v2的写法: n/ns、u/us/µ、ms、s、m、h, 为空时为纳秒
+func ParseInfluxPrecision(s string) (time.Duration, error) {
+	switch s {
+	case "", "n", "ns":
+		return time.Nanosecond, nil
+	case "u", "us", "µ":
+		return time.Microsecond, nil
+	case "ms":
+		return time.Millisecond, nil
+	case "s":
+		return time.Second, nil
+	case "m":
+		return time.Minute, nil
+	case "h":
+		return time.Hour, nil
+	default:
+		return 0, fmt.Errorf("未知的时间戳精度%q", s)
+	}
+}
+
+// ParseInfluxLine 解析一行InfluxDB行协议, 格式为 measurement[,tag=value...] field=value[,field=value...] [timestamp]
+//   - 字段值: 浮点数 1.5、有符号整数 1i、无符号整数 1u、布尔值 t/true/f/false、字符串 "abc"
+//   - measurement 中的逗号与空格, 标签与字段名中的逗号、等号与空格需要使用反斜杠转义
+//
+// input:
+//   - line 一行数据, 不能为空行或注释
+//   - precision 时间戳精度
+//   - now 行中没有时间戳时使用的时间
+func ParseInfluxLine(line string, precision time.Duration, now time.Time) (InfluxPoint, error) {
+	p := InfluxPoint{}
+	measurement, i := influxScan(line, 0, ", ")
+	if measurement == "" {
+		return p, errors.New("measurement不能为空")
+	}
+	p.Measurement = influxUnescape(measurement, ", ")
+
+	for i < len(line) && line[i] == ',' {
+		tag, next := influxScan(line, i+1, ", ")
+		key, value, ok := influxCut(tag)
+		if !ok || key == "" || value == "" {
+			return p, fmt.Errorf("标签%q格式错误", tag)
+		}
+		p.Tags = append(p.Tags, InfluxTag{Key: influxUnescape(key, ",= "), Value: influxUnescape(value, ",= ")})
+		i = next
+	}
+	if i >= len(line) || line[i] != ' ' {
+		return p, errors.New("缺少字段")
+	}
+
+	for i < len(line) && (line[i] == ' ' || (line[i] == ',' && len(p.Fields) != 0)) {
+		i++
+		key, next := influxScan(line, i, ",= ")
+		if key == "" || next >= len(line) || line[next] != '=' {
+			return p, fmt.Errorf("字段%q格式错误", key)
+		}
+		key = influxUnescape(key, ",= ")
+		value, next, err := influxFieldValue(line, next+1)
+		if err != nil {
+			return p, fmt.Errorf("字段%s: %w", key, err)
+		}
+		p.Fields = append(p.Fields, InfluxField{Key: key, Value: value})
+		i = next
+		if i < len(line) && line[i] == ' ' {
+			break
+		}
+	}
+
+	rest := strings.TrimSpace(line[i:])
+	if rest == "" {
+		p.Timestamp = now
+		return p, nil
+	}
+	ts, err := strconv.ParseInt(rest, 10, 64)
+	if err != nil {
+		return p, fmt.Errorf("时间戳%q格式错误", rest)
+	}
+	p.Timestamp = time.Unix(0, ts*int64(precision))
+	return p, nil
+}
+
+// influxScan 从start开始读取到未转义的结束字符为止, 返回读取的内容与结束字符的位置
+func influxScan(line string, start int, stops string) (string, int) {
+	i := start
+	for i < len(line) {
+		if line[i] == '\\' && i+1 < len(line) {
+			i += 2
+			continue
+		}
+		if strings.IndexByte(stops, line[i]) >= 0 {
+			break
+		}
+		i++
+	}
+	return line[start:i], i
+}
+
+// influxCut 在第一个未转义的等号处分割
+func influxCut(s string) (string, string, bool) {
+	key, i := influxScan(s, 0, "=")
+	if i >= len(s) {
+		return s, "", false
+	}
+	return key, s[i+1:], true
+}
+
+// influxUnescape 去除特殊字符前的反斜杠
+func influxUnescape(s string, specials string) string {
+	if strings.IndexByte(s, '\\') < 0 {
+		return s
+	}
+	b := strings.Builder{}
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+1 < len(s) && strings.IndexByte(specials, s[i+1]) >= 0 {
+			i++
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
+
+// influxFieldValue 解析字段值, 返回字段值与结束位置
+func influxFieldValue(line string, start int) (any, int, error) {
+	if start < len(line) && line[start] == '"' {
+		b := strings.Builder{}
+		for i := start + 1; i < len(line); i++ {
+			switch {
+			case line[i] == '\\' && i+1 < len(line) && (line[i+1] == '"' || line[i+1] == '\\'):
+				i++
+				b.WriteByte(line[i])
+			case line[i] == '"':
+				return b.String(), i + 1, nil
+			default:
+				b.WriteByte(line[i])
+			}
+		}
+		return nil, len(line), errors.New("字符串缺少结束的引号")
+	}
+
+	raw, next := influxScan(line, start, ", ")
+	if raw == "" {
+		return nil, next, errors.New("字段值不能为空")
+	}
+	switch raw {
+	case "t", "T", "true", "True", "TRUE":
+		return true, next, nil
+	case "f", "F", "false", "False", "FALSE":
+		return false, next, nil
+	}
+	switch raw[len(raw)-1] {
+	case 'i':
+		v, err := strconv.ParseInt(raw[:len(raw)-1], 10, 64)
+		return v, next, err
+	case 'u':
+		v, err := strconv.ParseUint(raw[:len(raw)-1], 10, 64)
+		return v, next, err
+	}
+	v, err := strconv.ParseFloat(raw, 64)
+	return v, next, err
+}
+
+// InfluxTemplate 将 measurement、标签与字段映射为标签点全名的模板
+type InfluxTemplate struct {
+	// Measurement 匹配的measurement, 支持 path.Match 的通配符, 为空时匹配所有
+	Measurement string
+
+	// Name 标签点全名, 其中 {measurement}、{field} 以及 {标签名} 会被替换,
+	// 例如 "{measurement}.{host}_{field}", 为空时为 "{measurement}.{field}"
+	Name string
+
+	// Point 自动创建标签点时使用的属性模板, 名称、表与数值类型(为空时)会被替换, 为nil时使用 NewPointInfo 的默认值
+	Point *PointInfo
+}
+
+// match 判断是否匹配
+func (t *InfluxTemplate) match(measurement string) bool {
+	if t.Measurement == "" {
+		return true
+	}
+	ok, _ := path.Match(t.Measurement, measurement)
+	return ok
+}
+
+// tableDotTag 根据模板生成标签点全名
+func (t *InfluxTemplate) tableDotTag(p *InfluxPoint, field string) (string, error) {
+	name := t.Name
+	if name == "" {
+		name = "{measurement}.{field}"
+	}
+	b := strings.Builder{}
+	for {
+		open := strings.IndexByte(name, '{')
+		if open < 0 {
+			b.WriteString(name)
+			break
+		}
+		end := strings.IndexByte(name[open:], '}')
+		if end < 0 {
+			return "", fmt.Errorf("模板%q格式错误", t.Name)
+		}
+		b.WriteString(name[:open])
+		key := name[open+1 : open+end]
+		switch key {
+		case "measurement":
+			b.WriteString(p.Measurement)
+		case "field":
+			b.WriteString(field)
+		default:
+			value, ok := p.Tag(key)
+			if !ok {
+				return "", fmt.Errorf("缺少模板需要的标签%s", key)
+			}
+			b.WriteString(value)
+		}
+		name = name[open+end+1:]
+	}
+	tableDotTag := b.String()
+	if table, tag, ok := strings.Cut(tableDotTag, "."); !ok || table == "" || tag == "" {
+		return "", fmt.Errorf("%q不是合法的标签点全名", tableDotTag)
+	}
+	return tableDotTag, nil
+}
+
+// influxValueType 根据字段值推断自动创建的标签点的数值类型
+func influxValueType(v any) ValueType {
+	switch v.(type) {
+	case bool:
+		return ValueTypeBool
+	case int64, uint64:
+		return ValueTypeInt64
+	case string:
+		return ValueTypeString
+	default:
+		return ValueTypeFloat64
+	}
+}
+
+// influxIntRanges 整数类型的取值范围
+var influxIntRanges = map[RtdbType][2]float64{
+	RtdbTypeUint8:  {0, math.MaxUint8},
+	RtdbTypeInt8:   {math.MinInt8, math.MaxInt8},
+	RtdbTypeChar:   {0, math.MaxUint8},
+	RtdbTypeUint16: {0, math.MaxUint16},
+	RtdbTypeInt16:  {math.MinInt16, math.MaxInt16},
+	RtdbTypeUint32: {0, math.MaxUint32},
+	RtdbTypeInt32:  {math.MinInt32, math.MaxInt32},
+	RtdbTypeInt64:  {math.MinInt64, math.MaxInt64},
+}
+
+// influxTvq 将字段值转换成标签点数值类型的TVQ
+//   - bool: 布尔值, 或者数字(非0为true)
+//   - 整数: 整数、没有小数部分的浮点数或布尔值, 超出类型范围时返回错误
+//   - 浮点数: 数字或布尔值
+//   - string: 字符串, 数字与布尔值会被转换成字符串
+//   - datetime 与 blob: 字符串
+func influxTvq(vt ValueType, ts time.Time, v any) (TVQ, error) {
+	tvq := TVQ{Timestamp: ts, Type: vt, Quality: QualityGood}
+	rtdbType, _ := vt.ToRawType()
+	number, isNumber := 0.0, true
+	switch x := v.(type) {
+	case float64:
+		number = x
+	case int64:
+		number = float64(x)
+	case uint64:
+		number = float64(x)
+	case bool:
+		number = float64(BoolToInt64(x))
+	default:
+		isNumber = false
+	}
+
+	switch rtdbType {
+	case RtdbTypeBool:
+		if !isNumber {
+			break
+		}
+		tvq.Value.IntValue = BoolToInt64(number != 0)
+		return tvq, nil
+	case RtdbTypeUint8, RtdbTypeInt8, RtdbTypeChar, RtdbTypeUint16, RtdbTypeInt16, RtdbTypeUint32, RtdbTypeInt32, RtdbTypeInt64:
+		if !isNumber {
+			break
+		}
+		bounds := influxIntRanges[rtdbType]
+		if number != math.Trunc(number) || number < bounds[0] || number > bounds[1] {
+			return TVQ{}, fmt.Errorf("%v超出%s类型的范围", v, vt)
+		}
+		switch x := v.(type) {
+		case int64:
+			tvq.Value.IntValue = x
+		case uint64:
+			if x > math.MaxInt64 {
+				return TVQ{}, fmt.Errorf("%v超出%s类型的范围", v, vt)
+			}
+			tvq.Value.IntValue = int64(x)
+		default:
+			if number >= math.MaxInt64 {
+				return TVQ{}, fmt.Errorf("%v超出%s类型的范围", v, vt)
+			}
+			tvq.Value.IntValue = int64(number)
+		}
+		return tvq, nil
+	case RtdbTypeReal16, RtdbTypeReal32, RtdbTypeReal64, RtdbTypeFp16, RtdbTypeFp32, RtdbTypeFp64:
+		if !isNumber {
+			break
+		}
+		tvq.Value.FloatValue = number
+		return tvq, nil
+	case RtdbTypeString:
+		tvq.Value.StringValue = fmt.Sprint(v)
+		return tvq, nil
+	case RtdbTypeDatetime:
+		if s, ok := v.(string); ok {
+			tvq.Value.StringValue = s
+			return tvq, nil
+		}
+	case RtdbTypeBlob:
+		if s, ok := v.(string); ok {
+			tvq.Value.BytesValue = []byte(s)
+			return tvq, nil
+		}
+	}
+	return TVQ{}, fmt.Errorf("%T类型的字段值不能写入%s类型的标签点", v, vt)
+}
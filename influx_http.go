@@ -0,0 +1,268 @@
+package rtdb_api
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// InfluxOptions InfluxDB行协议写入的选项
+type InfluxOptions struct {
+	// Templates 标签点全名模板, 按顺序使用第一个匹配的模板, 为空时使用 "{measurement}.{field}"
+	Templates []InfluxTemplate
+
+	// AutoCreate 是否自动创建不存在的标签点(以及表)
+	AutoCreate bool
+
+	// BatchSize 每次调用 WriteSection 写入的数值个数, 为0时为1000
+	BatchSize int
+
+	// MaxBodySize ServeHTTP 请求体(以及gzip解压后)的最大字节数, 为0时为32MB
+	MaxBodySize int64
+}
+
+// InfluxHandler 将InfluxDB行协议写入数据库, 实现了 http.Handler, 可以挂载到 /write 或 /api/v2/write
+//   - 每个字段对应一个标签点, 通过模板生成标签点全名, 字段值转换成标签点的数值类型
+//   - 标签点信息会被缓存, 修改或删除标签点后需要重新创建 InfluxHandler
+type InfluxHandler struct {
+	conn *RtdbConnect
+	opts InfluxOptions
+
+	mu     sync.Mutex
+	points map[string]*PointInfo
+	tables map[string]TableID
+}
+
+// NewInfluxHandler 创建InfluxDB行协议写入器
+//
+// input:
+//   - conn 数据库连接
+//   - opts 选项
+func NewInfluxHandler(conn *RtdbConnect, opts InfluxOptions) *InfluxHandler {
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = 1000
+	}
+	if opts.MaxBodySize <= 0 {
+		opts.MaxBodySize = 32 << 20
+	}
+	if len(opts.Templates) == 0 {
+		opts.Templates = []InfluxTemplate{{}}
+	}
+	return &InfluxHandler{conn: conn, opts: opts, points: make(map[string]*PointInfo)}
+}
+
+// InfluxWriteResult 写入结果
+type InfluxWriteResult struct {
+	// Written 成功写入的数值个数
+	Written int
+
+	// Errors 解析或写入失败的行与字段
+	Errors []error
+}
+
+// Write 读取行协议并写入数据库, 空行与#开头的注释行会被跳过
+//   - 单行的解析或写入错误记录在结果中, 不影响其他行
+//   - 连接等错误会中断写入并返回错误
+//
+// input:
+//   - r 输入
+//   - precision 时间戳精度
+func (h *InfluxHandler) Write(r io.Reader, precision time.Duration) (InfluxWriteResult, error) {
+	result := InfluxWriteResult{}
+	now := time.Now()
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	batch := make([]PTVQ, 0, h.opts.BatchSize)
+	lines := make([]int, 0, h.opts.BatchSize) // batch中每个数值所在的行号
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		errs, err := h.conn.WriteSection(false, batch)
+		if err != nil {
+			return err
+		}
+		for i, e := range errs {
+			if e != nil {
+				result.Errors = append(result.Errors, &InfluxLineError{Line: lines[i], Err: fmt.Errorf("%s: %w", batch[i].PointInfo.TableDotTag, e)})
+			} else {
+				result.Written++
+			}
+		}
+		batch, lines = batch[:0], lines[:0]
+		return nil
+	}
+
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || text[0] == '#' {
+			continue
+		}
+		p, err := ParseInfluxLine(text, precision, now)
+		if err != nil {
+			result.Errors = append(result.Errors, &InfluxLineError{Line: line, Err: err})
+			continue
+		}
+		for _, field := range p.Fields {
+			info, err := h.resolve(&p, field)
+			if err != nil {
+				result.Errors = append(result.Errors, &InfluxLineError{Line: line, Err: err})
+				continue
+			}
+			tvq, err := influxTvq(info.ValueType, p.Timestamp, field.Value)
+			if err != nil {
+				result.Errors = append(result.Errors, &InfluxLineError{Line: line, Err: fmt.Errorf("%s: %w", info.TableDotTag, err)})
+				continue
+			}
+			batch = append(batch, PTVQ{PointInfo: info, TVQ: tvq})
+			lines = append(lines, line)
+		}
+		if len(batch) >= h.opts.BatchSize {
+			if err := flush(); err != nil {
+				return result, err
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return result, err
+	}
+	return result, flush()
+}
+
+// resolve 查找字段对应的标签点, 不存在时根据选项自动创建
+func (h *InfluxHandler) resolve(p *InfluxPoint, field InfluxField) (*PointInfo, error) {
+	var template *InfluxTemplate
+	for i := range h.opts.Templates {
+		if h.opts.Templates[i].match(p.Measurement) {
+			template = &h.opts.Templates[i]
+			break
+		}
+	}
+	if template == nil {
+		return nil, fmt.Errorf("measurement %s 没有匹配的模板", p.Measurement)
+	}
+	tableDotTag, err := template.tableDotTag(p, field.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if info, ok := h.points[tableDotTag]; ok {
+		return info, nil
+	}
+	info, err := h.conn.findPoint(tableDotTag)
+	if err != nil {
+		return nil, err
+	}
+	if info == nil {
+		if !h.opts.AutoCreate {
+			return nil, fmt.Errorf("%s: %w", tableDotTag, RtePointNotFound)
+		}
+		if info, err = h.create(template, tableDotTag, field.Value); err != nil {
+			return nil, fmt.Errorf("%s: %w", tableDotTag, err)
+		}
+	}
+	h.points[tableDotTag] = info
+	return info, nil
+}
+
+// create 根据模板创建标签点, 表不存在时同时创建表
+func (h *InfluxHandler) create(template *InfluxTemplate, tableDotTag string, value any) (*PointInfo, error) {
+	table, name, _ := strings.Cut(tableDotTag, ".")
+	if h.tables == nil {
+		tables, err := h.conn.GetTables()
+		if err != nil {
+			return nil, err
+		}
+		h.tables = make(map[string]TableID, len(tables))
+		for _, t := range tables {
+			h.tables[t.Name] = t.ID
+		}
+	}
+	tableID, ok := h.tables[table]
+	if !ok {
+		created, err := h.conn.CreateTable(table, "")
+		if err != nil {
+			return nil, err
+		}
+		tableID = created.ID
+		h.tables[table] = tableID
+	}
+
+	info := NewPointInfo(name, tableID, influxValueType(value), PointBase, RtdbPrecisionNano, "", "")
+	if template.Point != nil {
+		point := *template.Point
+		point.Name, point.TableID = name, tableID
+		if point.ValueType == "" {
+			point.ValueType = info.ValueType
+		}
+		info = &point
+	}
+	return h.conn.AddPoint(info)
+}
+
+// ServeHTTP 实现 http.Handler, 兼容InfluxDB的写入接口
+//   - 只支持POST, 请求体可以使用gzip压缩, 查询参数precision为时间戳精度
+//   - 请求体或者解压后的内容超过 MaxBodySize 时返回413
+//   - 全部写入成功时返回204, 部分失败时返回400以及JSON格式的错误信息
+func (h *InfluxHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		influxHttpError(w, http.StatusMethodNotAllowed, "只支持POST")
+		return
+	}
+	precision, err := ParseInfluxPrecision(r.URL.Query().Get("precision"))
+	if err != nil {
+		influxHttpError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	body := http.MaxBytesReader(w, r.Body, h.opts.MaxBodySize)
+	if r.Header.Get("Content-Encoding") == "gzip" {
+		gz, err := gzip.NewReader(body)
+		if err != nil {
+			influxHttpError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		defer func() { _ = gz.Close() }()
+		body = http.MaxBytesReader(w, gz, h.opts.MaxBodySize)
+	}
+
+	result, err := h.Write(body, precision)
+	var tooLarge *http.MaxBytesError
+	if errors.As(err, &tooLarge) {
+		influxHttpError(w, http.StatusRequestEntityTooLarge, fmt.Sprintf("请求体超过%d字节", tooLarge.Limit))
+		return
+	}
+	if err != nil {
+		influxHttpError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if len(result.Errors) != 0 {
+		msgs := make([]string, 0, 10)
+		for _, e := range result.Errors[:min(len(result.Errors), 10)] {
+			msgs = append(msgs, e.Error())
+		}
+		if len(result.Errors) > 10 {
+			msgs = append(msgs, fmt.Sprintf("另有%d个错误", len(result.Errors)-10))
+		}
+		influxHttpError(w, http.StatusBadRequest, fmt.Sprintf("partial write: 写入%d个, 失败%d个: %s", result.Written, len(result.Errors), strings.Join(msgs, "; ")))
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// influxHttpError 返回InfluxDB格式的错误, 例如 {"error":"..."}
+func influxHttpError(w http.ResponseWriter, code int, msg string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Influxdb-Error", msg)
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(map[string]string{"error": msg})
+}
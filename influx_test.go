@@ -0,0 +1,164 @@
+package rtdb_api
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// 解析InfluxDB行协议
+func TestParseInfluxLine(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	cases := []struct {
+		line string
+		want InfluxPoint
+	}{
+		{
+			line: `cpu,host=a\ b,dc=x\,y usage=1.5,count=3i,big=4u,ok=t,msg="say \"hi\", bye" 1700000000123`,
+			want: InfluxPoint{
+				Measurement: "cpu",
+				Tags:        []InfluxTag{{"host", "a b"}, {"dc", "x,y"}},
+				Fields:      []InfluxField{{"usage", 1.5}, {"count", int64(3)}, {"big", uint64(4)}, {"ok", true}, {"msg", `say "hi", bye`}},
+				Timestamp:   time.UnixMilli(1700000000123),
+			},
+		},
+		{
+			line: `my\ meas f\=x=FALSE`,
+			want: InfluxPoint{Measurement: "my meas", Fields: []InfluxField{{"f=x", false}}, Timestamp: now},
+		},
+	}
+	for _, c := range cases {
+		got, err := ParseInfluxLine(c.line, time.Millisecond, now)
+		if err != nil {
+			t.Fatal(c.line, err)
+		}
+		if !got.Timestamp.Equal(c.want.Timestamp) {
+			t.Errorf("%s: 时间戳错误 %v", c.line, got.Timestamp)
+		}
+		got.Timestamp, c.want.Timestamp = time.Time{}, time.Time{}
+		if !reflect.DeepEqual(got, c.want) {
+			t.Errorf("%s:\n%+v", c.line, got)
+		}
+	}
+
+	for _, line := range []string{"cpu", "cpu,host usage=1", "cpu usage=", `cpu msg="abc`, "cpu usage=1 abc", "cpu usage=1x"} {
+		if _, err := ParseInfluxLine(line, time.Nanosecond, now); err == nil {
+			t.Errorf("%s: 期望格式错误", line)
+		}
+	}
+}
+
+// 通过HTTP写入行协议, 使用模板映射标签点并自动创建
+func TestInfluxHandler(t *testing.T) {
+	conn, err := LoginWithBackend(NewMemoryBackend(), "127.0.0.1", Port, Username, Password)
+	if err != nil {
+		t.Fatal("登录用户失败", err)
+	}
+	defer func() { _ = conn.Logout() }()
+	table, err := conn.CreateTable("plant", "工厂")
+	if err != nil {
+		t.Fatal(err)
+	}
+	level, err := conn.AddPoint(NewPointInfo("a_level", table.ID, ValueTypeInt8, PointBase, RtdbPrecisionMilli, "", ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := NewPointInfo("", 0, "", PointBase, RtdbPrecisionMilli, "", "自动创建")
+	handler := NewInfluxHandler(conn, InfluxOptions{
+		Templates: []InfluxTemplate{
+			{Measurement: "tank*", Name: "plant.{id}_{field}", Point: template},
+			{Measurement: "skip", Name: "plant.skip"},
+		},
+		AutoCreate: true,
+		BatchSize:  2,
+	})
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	body := "# 注释\n" +
+		"tank,id=a level=12i,temp=21.5 1700000000\n" +
+		"tank2,id=a level=300i 1700000001\n" +
+		"tank,id=b on=true 1700000002\n" +
+		"other value=1 1700000003\n" +
+		"tank level=1 1700000004\n"
+	resp, err := http.Post(server.URL+"/write?precision=s", "text/plain", strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusBadRequest || !strings.Contains(resp.Header.Get("X-Influxdb-Error"), "失败3个") {
+		t.Error("期望部分写入失败", resp.StatusCode, resp.Header.Get("X-Influxdb-Error"))
+	}
+
+	if tvq, err := conn.ReadValue(level, RtdbHisModeExact, time.Unix(1700000000, 0)); err != nil || tvq.Value.IntValue != 12 {
+		t.Error("写入已存在的标签点失败", tvq, err)
+	}
+	infos, _, err := conn.FindPoints([]string{"plant.a_temp", "plant.b_on"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if infos[0] == nil || infos[0].ValueType != ValueTypeFloat64 || infos[0].Desc != "自动创建" || infos[1] == nil || infos[1].ValueType != ValueTypeBool {
+		t.Fatal("自动创建标签点错误", infos)
+	}
+	if tvq, err := conn.ReadValue(infos[1], RtdbHisModeExact, time.Unix(1700000002, 0)); err != nil || tvq.Value.IntValue != 1 {
+		t.Error("写入自动创建的标签点失败", tvq, err)
+	}
+
+	resp, err = http.Get(server.URL + "/write")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusMethodNotAllowed {
+		t.Error("期望只支持POST", resp.StatusCode)
+	}
+	resp, err = http.Post(server.URL+"/write", "text/plain", strings.NewReader("tank,id=a temp=22 1700000005000000000\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusNoContent {
+		t.Error("期望写入成功", resp.StatusCode)
+	}
+
+	// 写入错误按原始顺序对应到行号, 与 WriteSection 按时间排序无关
+	gone, err := conn.AddPoint(NewPointInfo("gone", table.ID, ValueTypeFloat64, PointBase, RtdbPrecisionMilli, "", ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	handler = NewInfluxHandler(conn, InfluxOptions{Templates: []InfluxTemplate{{Name: "plant.{field}"}}})
+	if _, err := handler.Write(strings.NewReader("m gone=1 1700000100\n"), time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if err := conn.DeletePoint(gone.ID); err != nil {
+		t.Fatal(err)
+	}
+	lines := "m a_level=1i 1700000210\n" +
+		"m gone=2 1700000205\n" +
+		"m a_level=2i 1700000201\n"
+	result, err := handler.Write(strings.NewReader(lines), time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var lineErr *InfluxLineError
+	if result.Written != 2 || len(result.Errors) != 1 || !errors.As(result.Errors[0], &lineErr) || lineErr.Line != 2 || !errors.Is(lineErr, RtePointNotFound) {
+		t.Errorf("写入错误对应的行错误 %+v", result)
+	}
+
+	// 请求体大小限制
+	server = httptest.NewServer(NewInfluxHandler(conn, InfluxOptions{MaxBodySize: 16}))
+	defer server.Close()
+	resp, err = http.Post(server.URL+"/write", "text/plain", strings.NewReader(strings.Repeat("tank,id=a temp=22\n", 10)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusRequestEntityTooLarge {
+		t.Error("期望请求体过大", resp.StatusCode)
+	}
+}
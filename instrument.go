@@ -0,0 +1,181 @@
+package rtdb_api
+
+import (
+	"context"
+	"log/slog"
+	"sync/atomic"
+	"time"
+)
+
+// Tracer 链路追踪接口, 参照 OpenTelemetry 的 trace.Tracer 设计, 通过简单的适配即可接入
+type Tracer interface {
+	// Start 开始一个Span
+	Start(ctx context.Context, spanName string) (context.Context, Span)
+}
+
+// Span 链路追踪中的一次操作, 参照 OpenTelemetry 的 trace.Span 设计
+type Span interface {
+	// SetAttribute 设置属性, value 为 string、int64、bool 之一
+	SetAttribute(key string, value any)
+
+	// RecordError 记录错误
+	RecordError(err error)
+
+	// End 结束Span
+	End()
+}
+
+// CallInfo 一次Raw调用的信息
+type CallInfo struct {
+	Op      string        // Raw函数名称, 例如 RawRtdbsPutSnapshots64Warp
+	Handle  ConnectHandle // 连接句柄, 与连接无关的调用为0
+	Count   int           // 批量调用的条目数量, 单个标签点为1, 与标签点无关的调用为0
+	Latency time.Duration // 调用耗时
+	Rte     RtdbError     // 调用结果
+	Start   time.Time     // 开始时间
+}
+
+// Instrumentation Raw调用的观测配置, 所有字段均为可选
+//   - Logger: 成功的调用输出Debug日志, 失败的调用输出Warn日志
+//   - Tracer: 每次调用创建一个Span, 名称为Raw函数名称
+//   - OnCall: 每次调用结束后回调, 用于统计指标等
+type Instrumentation struct {
+	Logger *slog.Logger
+	Tracer Tracer
+	OnCall func(call CallInfo)
+}
+
+// enabled 是否需要记录
+func (inst *Instrumentation) enabled() bool {
+	return inst != nil && (inst.Logger != nil || inst.Tracer != nil || inst.OnCall != nil)
+}
+
+// defaultInstrumentation Login/LoginEndpoints 使用的观测配置
+var defaultInstrumentation atomic.Pointer[Instrumentation]
+
+// SetDefaultInstrumentation 设置 Login/LoginEndpoints 使用的观测配置, 对之后登录的连接生效
+//
+// input:
+//   - inst 观测配置, 为nil时关闭, 关闭后没有任何额外开销
+func SetDefaultInstrumentation(inst *Instrumentation) {
+	defaultInstrumentation.Store(inst)
+}
+
+// NewInstrumentedBackend 为后端增加日志与链路追踪, 配合 LoginWithBackend 使用
+//
+// input:
+//   - next 被包装的后端
+//   - inst 观测配置, 为nil或者没有配置任何字段时直接返回next
+func NewInstrumentedBackend(next Backend, inst *Instrumentation) Backend {
+	if !inst.enabled() {
+		return next
+	}
+	return &instrumentedBackend{next: next, inst: inst}
+}
+
+// instrumentedCall 进行中的Raw调用
+type instrumentedCall struct {
+	inst  *Instrumentation
+	info  CallInfo
+	span  Span
+	ctx   context.Context
+	start time.Time
+}
+
+// start 开始记录一次Raw调用, Span的父节点为 *Context 方法传入的ctx
+func (b *instrumentedBackend) start(op string, handle ConnectHandle, count int) *instrumentedCall {
+	call := &instrumentedCall{
+		inst:  b.inst,
+		info:  CallInfo{Op: op, Handle: handle, Count: count},
+		ctx:   b.ctx,
+		start: time.Now(),
+	}
+	if call.ctx == nil {
+		call.ctx = context.Background()
+	}
+	if b.inst.Tracer != nil {
+		call.ctx, call.span = b.inst.Tracer.Start(call.ctx, op)
+		call.span.SetAttribute("rtdb.op", op)
+		call.span.SetAttribute("rtdb.handle", int64(handle))
+		call.span.SetAttribute("rtdb.count", int64(count))
+	}
+	return call
+}
+
+// end 结束记录一次Raw调用
+func (call *instrumentedCall) end(rte RtdbError) {
+	call.info.Start = call.start
+	call.info.Latency = time.Since(call.start)
+	call.info.Rte = rte
+
+	if call.span != nil {
+		call.span.SetAttribute("rtdb.error_code", int64(rte))
+		if !RteIsOk(rte) {
+			call.span.RecordError(rte)
+		}
+		call.span.End()
+	}
+
+	if logger := call.inst.Logger; logger != nil {
+		level := slog.LevelDebug
+		if !RteIsOk(rte) {
+			level = slog.LevelWarn
+		}
+		if logger.Enabled(call.ctx, level) {
+			attrs := []slog.Attr{
+				slog.String("op", call.info.Op),
+				slog.Int64("handle", int64(call.info.Handle)),
+				slog.Int("count", call.info.Count),
+				slog.Duration("latency", call.info.Latency),
+			}
+			if !RteIsOk(rte) {
+				attrs = append(attrs, slog.String("error", rte.Error()), slog.Uint64("code", uint64(rte)))
+			}
+			logger.LogAttrs(call.ctx, level, "rtdb call", attrs...)
+		}
+	}
+
+	if call.inst.OnCall != nil {
+		call.inst.OnCall(call.info)
+	}
+}
+
+// backendWithContext 返回以ctx作为Span父节点的后端, 与原后端共享状态, 没有链路追踪时返回b
+func backendWithContext(b Backend, ctx context.Context) Backend {
+	switch b := b.(type) {
+	case *guardedBackend:
+		if next := backendWithContext(b.next, ctx); next != b.next {
+			return &guardedBackend{next: next, guard: b.guard}
+		}
+	case *instrumentedBackend:
+		return &instrumentedBackend{next: b.next, inst: b.inst, ctx: ctx}
+	}
+	return b
+}
+
+// withContext 返回以ctx作为链路追踪父节点的连接视图, 与c共享连接句柄和后端状态
+//   - 没有启用链路追踪时直接返回c
+func (c *RtdbConnect) withContext(ctx context.Context) *RtdbConnect {
+	backend := backendWithContext(c.backend, ctx)
+	if backend == c.backend {
+		return c
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return &RtdbConnect{
+		HostIp:           c.HostIp,
+		Port:             c.Port,
+		UserName:         c.UserName,
+		Password:         c.Password,
+		ConnectHandle:    c.ConnectHandle,
+		Priv:             c.Priv,
+		SyncInfos:        c.SyncInfos,
+		SocketHandles:    c.SocketHandles,
+		ServerOsType:     c.ServerOsType,
+		StringBlobMaxLen: c.StringBlobMaxLen,
+		Endpoints:        c.Endpoints,
+		backend:          backend,
+		metrics:          c.metrics,
+		parent:           c,
+	}
+}
@@ -0,0 +1,857 @@
+package rtdb_api
+
+import "context"
+
+// instrumentedBackend 记录每一次Raw调用的后端, 方法与 Backend 一一对应
+type instrumentedBackend struct {
+	next Backend
+	inst *Instrumentation
+	ctx  context.Context // Span的父节点, 为nil时使用 context.Background()
+}
+
+func (b *instrumentedBackend) RawRtdbGetApiVersionWarp() (ApiVersion, RtdbError) {
+	call := b.start("RawRtdbGetApiVersionWarp", 0, 0)
+	r0, rte := b.next.RawRtdbGetApiVersionWarp()
+	call.end(rte)
+	return r0, rte
+}
+
+func (b *instrumentedBackend) RawRtdbSetOptionWarp(optionType RtdbApiOption, value int32) RtdbError {
+	call := b.start("RawRtdbSetOptionWarp", 0, 0)
+	rte := b.next.RawRtdbSetOptionWarp(optionType, value)
+	call.end(rte)
+	return rte
+}
+
+func (b *instrumentedBackend) RawRtdbConnectWarp(hostname string, port int32) (ConnectHandle, RtdbError) {
+	call := b.start("RawRtdbConnectWarp", 0, 0)
+	r0, rte := b.next.RawRtdbConnectWarp(hostname, port)
+	call.end(rte)
+	return r0, rte
+}
+
+func (b *instrumentedBackend) RawRtdbLoginWarp(handle ConnectHandle, user string, password string) (PrivGroup, RtdbError) {
+	call := b.start("RawRtdbLoginWarp", handle, 0)
+	r0, rte := b.next.RawRtdbLoginWarp(handle, user, password)
+	call.end(rte)
+	return r0, rte
+}
+
+func (b *instrumentedBackend) RawRtdbDisconnectWarp(handle ConnectHandle) RtdbError {
+	call := b.start("RawRtdbDisconnectWarp", handle, 0)
+	rte := b.next.RawRtdbDisconnectWarp(handle)
+	call.end(rte)
+	return rte
+}
+
+func (b *instrumentedBackend) RawRtdbGetDbInfo1Warp(handle ConnectHandle, param RtdbParam) (ParamString, RtdbError) {
+	call := b.start("RawRtdbGetDbInfo1Warp", handle, 0)
+	r0, rte := b.next.RawRtdbGetDbInfo1Warp(handle, param)
+	call.end(rte)
+	return r0, rte
+}
+
+func (b *instrumentedBackend) RawRtdbGetDbInfo2Warp(handle ConnectHandle, param RtdbParam) (ParamInt, RtdbError) {
+	call := b.start("RawRtdbGetDbInfo2Warp", handle, 0)
+	r0, rte := b.next.RawRtdbGetDbInfo2Warp(handle, param)
+	call.end(rte)
+	return r0, rte
+}
+
+func (b *instrumentedBackend) RawRtdbSetDbInfo1Warp(handle ConnectHandle, param RtdbParam, value ParamString) RtdbError {
+	call := b.start("RawRtdbSetDbInfo1Warp", handle, 0)
+	rte := b.next.RawRtdbSetDbInfo1Warp(handle, param, value)
+	call.end(rte)
+	return rte
+}
+
+func (b *instrumentedBackend) RawRtdbSetDbInfo2Warp(handle ConnectHandle, param RtdbParam, value ParamInt) RtdbError {
+	call := b.start("RawRtdbSetDbInfo2Warp", handle, 0)
+	rte := b.next.RawRtdbSetDbInfo2Warp(handle, param, value)
+	call.end(rte)
+	return rte
+}
+
+func (b *instrumentedBackend) RawRtdbConnectionCountWarp(handle ConnectHandle, nodeNumber int32) (int32, RtdbError) {
+	call := b.start("RawRtdbConnectionCountWarp", handle, 0)
+	r0, rte := b.next.RawRtdbConnectionCountWarp(handle, nodeNumber)
+	call.end(rte)
+	return r0, rte
+}
+
+func (b *instrumentedBackend) RawRtdbGetConnectionsWarp(handle ConnectHandle, nodeNumber int32, count int32) ([]SocketHandle, RtdbError) {
+	call := b.start("RawRtdbGetConnectionsWarp", handle, 0)
+	r0, rte := b.next.RawRtdbGetConnectionsWarp(handle, nodeNumber, count)
+	call.end(rte)
+	return r0, rte
+}
+
+func (b *instrumentedBackend) RawRtdbGetOwnConnectionWarp(handle ConnectHandle, nodeNumber int32) (SocketHandle, RtdbError) {
+	call := b.start("RawRtdbGetOwnConnectionWarp", handle, 0)
+	r0, rte := b.next.RawRtdbGetOwnConnectionWarp(handle, nodeNumber)
+	call.end(rte)
+	return r0, rte
+}
+
+func (b *instrumentedBackend) RawRtdbGetConnectionInfoIpv6Warp(handle ConnectHandle, nodeNumber int32, socket SocketHandle) (RtdbHostConnectInfoIpv6, RtdbError) {
+	call := b.start("RawRtdbGetConnectionInfoIpv6Warp", handle, 0)
+	r0, rte := b.next.RawRtdbGetConnectionInfoIpv6Warp(handle, nodeNumber, socket)
+	call.end(rte)
+	return r0, rte
+}
+
+func (b *instrumentedBackend) RawRtdbOsType(handle ConnectHandle) (RtdbOsType, RtdbError) {
+	call := b.start("RawRtdbOsType", handle, 0)
+	r0, rte := b.next.RawRtdbOsType(handle)
+	call.end(rte)
+	return r0, rte
+}
+
+func (b *instrumentedBackend) RawRtdbChangePasswordWarp(handle ConnectHandle, user string, password string) RtdbError {
+	call := b.start("RawRtdbChangePasswordWarp", handle, 0)
+	rte := b.next.RawRtdbChangePasswordWarp(handle, user, password)
+	call.end(rte)
+	return rte
+}
+
+func (b *instrumentedBackend) RawRtdbChangeMyPasswordWarp(handle ConnectHandle, oldPwd string, newPwd string) RtdbError {
+	call := b.start("RawRtdbChangeMyPasswordWarp", handle, 0)
+	rte := b.next.RawRtdbChangeMyPasswordWarp(handle, oldPwd, newPwd)
+	call.end(rte)
+	return rte
+}
+
+func (b *instrumentedBackend) RawRtdbGetPrivWarp(handle ConnectHandle) (PrivGroup, RtdbError) {
+	call := b.start("RawRtdbGetPrivWarp", handle, 0)
+	r0, rte := b.next.RawRtdbGetPrivWarp(handle)
+	call.end(rte)
+	return r0, rte
+}
+
+func (b *instrumentedBackend) RawRtdbChangePrivWarp(handle ConnectHandle, user string, priv PrivGroup) RtdbError {
+	call := b.start("RawRtdbChangePrivWarp", handle, 0)
+	rte := b.next.RawRtdbChangePrivWarp(handle, user, priv)
+	call.end(rte)
+	return rte
+}
+
+func (b *instrumentedBackend) RawRtdbAddUserWarp(handle ConnectHandle, user string, password string, priv PrivGroup) RtdbError {
+	call := b.start("RawRtdbAddUserWarp", handle, 0)
+	rte := b.next.RawRtdbAddUserWarp(handle, user, password, priv)
+	call.end(rte)
+	return rte
+}
+
+func (b *instrumentedBackend) RawRtdbRemoveUserWarp(handle ConnectHandle, user string) RtdbError {
+	call := b.start("RawRtdbRemoveUserWarp", handle, 0)
+	rte := b.next.RawRtdbRemoveUserWarp(handle, user)
+	call.end(rte)
+	return rte
+}
+
+func (b *instrumentedBackend) RawRtdbLockUserWarp(handle ConnectHandle, user string, lock Switch) RtdbError {
+	call := b.start("RawRtdbLockUserWarp", handle, 0)
+	rte := b.next.RawRtdbLockUserWarp(handle, user, lock)
+	call.end(rte)
+	return rte
+}
+
+func (b *instrumentedBackend) RawRtdbGetUsersWarp(handle ConnectHandle) ([]RtdbUserInfo, RtdbError) {
+	call := b.start("RawRtdbGetUsersWarp", handle, 0)
+	r0, rte := b.next.RawRtdbGetUsersWarp(handle)
+	call.end(rte)
+	return r0, rte
+}
+
+func (b *instrumentedBackend) RawRtdbAddBlacklistWarp(handle ConnectHandle, addr string, mask string, desc string) RtdbError {
+	call := b.start("RawRtdbAddBlacklistWarp", handle, 0)
+	rte := b.next.RawRtdbAddBlacklistWarp(handle, addr, mask, desc)
+	call.end(rte)
+	return rte
+}
+
+func (b *instrumentedBackend) RawRtdbUpdateBlacklistWarp(handle ConnectHandle, oldAddr string, oldMask string, newAddr string, newMask string, newDesc string) RtdbError {
+	call := b.start("RawRtdbUpdateBlacklistWarp", handle, 0)
+	rte := b.next.RawRtdbUpdateBlacklistWarp(handle, oldAddr, oldMask, newAddr, newMask, newDesc)
+	call.end(rte)
+	return rte
+}
+
+func (b *instrumentedBackend) RawRtdbRemoveBlacklistWarp(handle ConnectHandle, addr string, mask string) RtdbError {
+	call := b.start("RawRtdbRemoveBlacklistWarp", handle, 0)
+	rte := b.next.RawRtdbRemoveBlacklistWarp(handle, addr, mask)
+	call.end(rte)
+	return rte
+}
+
+func (b *instrumentedBackend) RawRtdbGetBlacklistWarp(handle ConnectHandle) ([]BlackList, RtdbError) {
+	call := b.start("RawRtdbGetBlacklistWarp", handle, 0)
+	r0, rte := b.next.RawRtdbGetBlacklistWarp(handle)
+	call.end(rte)
+	return r0, rte
+}
+
+func (b *instrumentedBackend) RawRtdbAddAuthorizationWarp(handle ConnectHandle, addr string, mask string, desc string, priv PrivGroup) RtdbError {
+	call := b.start("RawRtdbAddAuthorizationWarp", handle, 0)
+	rte := b.next.RawRtdbAddAuthorizationWarp(handle, addr, mask, desc, priv)
+	call.end(rte)
+	return rte
+}
+
+func (b *instrumentedBackend) RawRtdbUpdateAuthorizationWarp(handle ConnectHandle, oldAddr string, oldMask string, newAddr string, newMask string, newDesc string, priv PrivGroup) RtdbError {
+	call := b.start("RawRtdbUpdateAuthorizationWarp", handle, 0)
+	rte := b.next.RawRtdbUpdateAuthorizationWarp(handle, oldAddr, oldMask, newAddr, newMask, newDesc, priv)
+	call.end(rte)
+	return rte
+}
+
+func (b *instrumentedBackend) RawRtdbRemoveAuthorizationWarp(handle ConnectHandle, addr string, mask string) RtdbError {
+	call := b.start("RawRtdbRemoveAuthorizationWarp", handle, 0)
+	rte := b.next.RawRtdbRemoveAuthorizationWarp(handle, addr, mask)
+	call.end(rte)
+	return rte
+}
+
+func (b *instrumentedBackend) RawRtdbGetAuthorizationsWarp(handle ConnectHandle) ([]AuthorizationsList, RtdbError) {
+	call := b.start("RawRtdbGetAuthorizationsWarp", handle, 0)
+	r0, rte := b.next.RawRtdbGetAuthorizationsWarp(handle)
+	call.end(rte)
+	return r0, rte
+}
+
+func (b *instrumentedBackend) RawRtdbHostTime64Warp(handle ConnectHandle) (TimestampType, RtdbError) {
+	call := b.start("RawRtdbHostTime64Warp", handle, 0)
+	r0, rte := b.next.RawRtdbHostTime64Warp(handle)
+	call.end(rte)
+	return r0, rte
+}
+
+func (b *instrumentedBackend) RawRtdbFormatTimespanWarp(timespan int32) (string, RtdbError) {
+	call := b.start("RawRtdbFormatTimespanWarp", 0, 0)
+	r0, rte := b.next.RawRtdbFormatTimespanWarp(timespan)
+	call.end(rte)
+	return r0, rte
+}
+
+func (b *instrumentedBackend) RawRtdbParseTimespanWarp(tStr string) (DateTimeType, RtdbError) {
+	call := b.start("RawRtdbParseTimespanWarp", 0, 0)
+	r0, rte := b.next.RawRtdbParseTimespanWarp(tStr)
+	call.end(rte)
+	return r0, rte
+}
+
+func (b *instrumentedBackend) RawRtdbParseTimeWarp(tStr string) (TimestampType, SubtimeType, RtdbError) {
+	call := b.start("RawRtdbParseTimeWarp", 0, 0)
+	r0, r1, rte := b.next.RawRtdbParseTimeWarp(tStr)
+	call.end(rte)
+	return r0, r1, rte
+}
+
+func (b *instrumentedBackend) RawRtdbSetTimeoutWarp(handle ConnectHandle, socket SocketHandle, timeout DateTimeType) RtdbError {
+	call := b.start("RawRtdbSetTimeoutWarp", handle, 0)
+	rte := b.next.RawRtdbSetTimeoutWarp(handle, socket, timeout)
+	call.end(rte)
+	return rte
+}
+
+func (b *instrumentedBackend) RawRtdbGetTimeoutWarp(handle ConnectHandle, socket SocketHandle) (DateTimeType, RtdbError) {
+	call := b.start("RawRtdbGetTimeoutWarp", handle, 0)
+	r0, rte := b.next.RawRtdbGetTimeoutWarp(handle, socket)
+	call.end(rte)
+	return r0, rte
+}
+
+func (b *instrumentedBackend) RawRtdbKillConnectionWarp(handle ConnectHandle, socket SocketHandle) RtdbError {
+	call := b.start("RawRtdbKillConnectionWarp", handle, 0)
+	rte := b.next.RawRtdbKillConnectionWarp(handle, socket)
+	call.end(rte)
+	return rte
+}
+
+func (b *instrumentedBackend) RawRtdbGetLogicalDriversWarp(handle ConnectHandle) ([]string, RtdbError) {
+	call := b.start("RawRtdbGetLogicalDriversWarp", handle, 0)
+	r0, rte := b.next.RawRtdbGetLogicalDriversWarp(handle)
+	call.end(rte)
+	return r0, rte
+}
+
+func (b *instrumentedBackend) RawRtdbOpenPathWarp(handle ConnectHandle, dir string) RtdbError {
+	call := b.start("RawRtdbOpenPathWarp", handle, 0)
+	rte := b.next.RawRtdbOpenPathWarp(handle, dir)
+	call.end(rte)
+	return rte
+}
+
+func (b *instrumentedBackend) RawRtdbReadPath64Warp(handle ConnectHandle) (DirItem, RtdbError) {
+	call := b.start("RawRtdbReadPath64Warp", handle, 0)
+	r0, rte := b.next.RawRtdbReadPath64Warp(handle)
+	call.end(rte)
+	return r0, rte
+}
+
+func (b *instrumentedBackend) RawRtdbClosePathWarp(handle ConnectHandle) RtdbError {
+	call := b.start("RawRtdbClosePathWarp", handle, 0)
+	rte := b.next.RawRtdbClosePathWarp(handle)
+	call.end(rte)
+	return rte
+}
+
+func (b *instrumentedBackend) RawRtdbMkdirWarp(handle ConnectHandle, dirName string) RtdbError {
+	call := b.start("RawRtdbMkdirWarp", handle, 0)
+	rte := b.next.RawRtdbMkdirWarp(handle, dirName)
+	call.end(rte)
+	return rte
+}
+
+func (b *instrumentedBackend) RawRtdbGetFileSizeWarp(handle ConnectHandle, filePath string) (int64, RtdbError) {
+	call := b.start("RawRtdbGetFileSizeWarp", handle, 0)
+	r0, rte := b.next.RawRtdbGetFileSizeWarp(handle, filePath)
+	call.end(rte)
+	return r0, rte
+}
+
+func (b *instrumentedBackend) RawRtdbReadFileWarp(handle ConnectHandle, filePath string, pos int64, cacheSize int64) ([]byte, RtdbError) {
+	call := b.start("RawRtdbReadFileWarp", handle, 0)
+	r0, rte := b.next.RawRtdbReadFileWarp(handle, filePath, pos, cacheSize)
+	call.end(rte)
+	return r0, rte
+}
+
+func (b *instrumentedBackend) RawRtdbGetMaxBlobLenWarp(handle ConnectHandle) (int32, RtdbError) {
+	call := b.start("RawRtdbGetMaxBlobLenWarp", handle, 0)
+	r0, rte := b.next.RawRtdbGetMaxBlobLenWarp(handle)
+	call.end(rte)
+	return r0, rte
+}
+
+func (b *instrumentedBackend) RawRtdbFormatQualityWarp(handle ConnectHandle, qualities []Quality) ([]string, RtdbError) {
+	call := b.start("RawRtdbFormatQualityWarp", handle, len(qualities))
+	r0, rte := b.next.RawRtdbFormatQualityWarp(handle, qualities)
+	call.end(rte)
+	return r0, rte
+}
+
+func (b *instrumentedBackend) RawRtdbJudgeConnectStatusWarp(handle ConnectHandle) RtdbError {
+	call := b.start("RawRtdbJudgeConnectStatusWarp", handle, 0)
+	rte := b.next.RawRtdbJudgeConnectStatusWarp(handle)
+	call.end(rte)
+	return rte
+}
+
+func (b *instrumentedBackend) RawRtdbbAppendTableWarp(handle ConnectHandle, tableName, tableDesc string) (RtdbTable, RtdbError) {
+	call := b.start("RawRtdbbAppendTableWarp", handle, 0)
+	r0, rte := b.next.RawRtdbbAppendTableWarp(handle, tableName, tableDesc)
+	call.end(rte)
+	return r0, rte
+}
+
+func (b *instrumentedBackend) RawRtdbbRemoveTableByIdWarp(handle ConnectHandle, tableID TableID) RtdbError {
+	call := b.start("RawRtdbbRemoveTableByIdWarp", handle, 0)
+	rte := b.next.RawRtdbbRemoveTableByIdWarp(handle, tableID)
+	call.end(rte)
+	return rte
+}
+
+func (b *instrumentedBackend) RawRtdbbTablesCountWarp(handle ConnectHandle) (int32, RtdbError) {
+	call := b.start("RawRtdbbTablesCountWarp", handle, 0)
+	r0, rte := b.next.RawRtdbbTablesCountWarp(handle)
+	call.end(rte)
+	return r0, rte
+}
+
+func (b *instrumentedBackend) RawRtdbbGetTablesWarp(handle ConnectHandle, count int32) ([]TableID, RtdbError) {
+	call := b.start("RawRtdbbGetTablesWarp", handle, 0)
+	r0, rte := b.next.RawRtdbbGetTablesWarp(handle, count)
+	call.end(rte)
+	return r0, rte
+}
+
+func (b *instrumentedBackend) RawRtdbbGetTablePropertyByIdWarp(handle ConnectHandle, tableID TableID) (RtdbTable, RtdbError) {
+	call := b.start("RawRtdbbGetTablePropertyByIdWarp", handle, 0)
+	r0, rte := b.next.RawRtdbbGetTablePropertyByIdWarp(handle, tableID)
+	call.end(rte)
+	return r0, rte
+}
+
+func (b *instrumentedBackend) RawRtdbbInsertMaxPointWarp(handle ConnectHandle, base *RtdbPoint, scan *RtdbScan, calc *RtdbCalc) (*RtdbPoint, *RtdbScan, *RtdbCalc, RtdbError) {
+	call := b.start("RawRtdbbInsertMaxPointWarp", handle, 0)
+	r0, r1, r2, rte := b.next.RawRtdbbInsertMaxPointWarp(handle, base, scan, calc)
+	call.end(rte)
+	return r0, r1, r2, rte
+}
+
+func (b *instrumentedBackend) RawRtdbbRemovePointByIdWarp(handle ConnectHandle, id PointID) RtdbError {
+	call := b.start("RawRtdbbRemovePointByIdWarp", handle, 1)
+	rte := b.next.RawRtdbbRemovePointByIdWarp(handle, id)
+	call.end(rte)
+	return rte
+}
+
+func (b *instrumentedBackend) RawRtdbbInsertNamedTypePointWarp(handle ConnectHandle, base *RtdbPoint, scan *RtdbScan, name string) (*RtdbPoint, *RtdbScan, RtdbError) {
+	call := b.start("RawRtdbbInsertNamedTypePointWarp", handle, 0)
+	r0, r1, rte := b.next.RawRtdbbInsertNamedTypePointWarp(handle, base, scan, name)
+	call.end(rte)
+	return r0, r1, rte
+}
+
+func (b *instrumentedBackend) RawRtdbbMovePointByIdWarp(handle ConnectHandle, id PointID, tableName string) RtdbError {
+	call := b.start("RawRtdbbMovePointByIdWarp", handle, 1)
+	rte := b.next.RawRtdbbMovePointByIdWarp(handle, id, tableName)
+	call.end(rte)
+	return rte
+}
+
+func (b *instrumentedBackend) RawRtdbbGetMaxPointsPropertyWarp(handle ConnectHandle, ids []PointID) ([]RtdbPoint, []RtdbScan, []RtdbCalc, []RtdbError, RtdbError) {
+	call := b.start("RawRtdbbGetMaxPointsPropertyWarp", handle, len(ids))
+	r0, r1, r2, r3, rte := b.next.RawRtdbbGetMaxPointsPropertyWarp(handle, ids)
+	call.end(rte)
+	return r0, r1, r2, r3, rte
+}
+
+func (b *instrumentedBackend) RawRtdbbSearchExWarp(handle ConnectHandle, maxCount int32, tagMask, tableMask, source, unit, desc, instrument, typeMask string, classOfMask RtdbType, timeUnitMask RtdbPrecision, otherTypeMask RtdbSearch, otherTypeMaskValue string, model RtdbSortFlag) ([]PointID, RtdbError) {
+	call := b.start("RawRtdbbSearchExWarp", handle, 0)
+	r0, rte := b.next.RawRtdbbSearchExWarp(handle, maxCount, tagMask, tableMask, source, unit, desc, instrument, typeMask, classOfMask, timeUnitMask, otherTypeMask, otherTypeMaskValue, model)
+	call.end(rte)
+	return r0, rte
+}
+
+func (b *instrumentedBackend) RawRtdbbSearchPointsCountWarp(handle ConnectHandle, tagMask, tableMask, source, unit, desc, instrument, typeMask string, classOfMask RtdbType, timeUnitMask RtdbPrecision, otherTypeMask RtdbSearch, otherTypeMaskValue string) (int32, RtdbError) {
+	call := b.start("RawRtdbbSearchPointsCountWarp", handle, 0)
+	r0, rte := b.next.RawRtdbbSearchPointsCountWarp(handle, tagMask, tableMask, source, unit, desc, instrument, typeMask, classOfMask, timeUnitMask, otherTypeMask, otherTypeMaskValue)
+	call.end(rte)
+	return r0, rte
+}
+
+func (b *instrumentedBackend) RawRtdbbUpdateMaxPointPropertyWarp(handle ConnectHandle, base *RtdbPoint, scan *RtdbScan, calc *RtdbCalc) RtdbError {
+	call := b.start("RawRtdbbUpdateMaxPointPropertyWarp", handle, 0)
+	rte := b.next.RawRtdbbUpdateMaxPointPropertyWarp(handle, base, scan, calc)
+	call.end(rte)
+	return rte
+}
+
+func (b *instrumentedBackend) RawRtdbbFindPointsExWarp(handle ConnectHandle, tableDotTags []string) ([]PointID, []RtdbType, []RtdbClass, []RtdbPrecision, []RtdbError, RtdbError) {
+	call := b.start("RawRtdbbFindPointsExWarp", handle, len(tableDotTags))
+	r0, r1, r2, r3, r4, rte := b.next.RawRtdbbFindPointsExWarp(handle, tableDotTags)
+	call.end(rte)
+	return r0, r1, r2, r3, r4, rte
+}
+
+func (b *instrumentedBackend) RawRtdbbUpdateTableNameWarp(handle ConnectHandle, id TableID, name string) RtdbError {
+	call := b.start("RawRtdbbUpdateTableNameWarp", handle, 0)
+	rte := b.next.RawRtdbbUpdateTableNameWarp(handle, id, name)
+	call.end(rte)
+	return rte
+}
+
+func (b *instrumentedBackend) RawRtdbbUpdateTableDescByIdWarp(handle ConnectHandle, id TableID, desc string) RtdbError {
+	call := b.start("RawRtdbbUpdateTableDescByIdWarp", handle, 0)
+	rte := b.next.RawRtdbbUpdateTableDescByIdWarp(handle, id, desc)
+	call.end(rte)
+	return rte
+}
+
+func (b *instrumentedBackend) RawRtdbbRecoverPointWarp(handle ConnectHandle, tableID TableID, pointID PointID) RtdbError {
+	call := b.start("RawRtdbbRecoverPointWarp", handle, 1)
+	rte := b.next.RawRtdbbRecoverPointWarp(handle, tableID, pointID)
+	call.end(rte)
+	return rte
+}
+
+func (b *instrumentedBackend) RawRtdbbPurgePointWarp(handle ConnectHandle, id PointID) RtdbError {
+	call := b.start("RawRtdbbPurgePointWarp", handle, 1)
+	rte := b.next.RawRtdbbPurgePointWarp(handle, id)
+	call.end(rte)
+	return rte
+}
+
+func (b *instrumentedBackend) RawRtdbbGetRecycledPointsCountWarp(handle ConnectHandle) (int32, RtdbError) {
+	call := b.start("RawRtdbbGetRecycledPointsCountWarp", handle, 0)
+	r0, rte := b.next.RawRtdbbGetRecycledPointsCountWarp(handle)
+	call.end(rte)
+	return r0, rte
+}
+
+func (b *instrumentedBackend) RawRtdbbGetRecycledPointsWarp(handle ConnectHandle, count int32) ([]PointID, RtdbError) {
+	call := b.start("RawRtdbbGetRecycledPointsWarp", handle, 0)
+	r0, rte := b.next.RawRtdbbGetRecycledPointsWarp(handle, count)
+	call.end(rte)
+	return r0, rte
+}
+
+func (b *instrumentedBackend) RawRtdbbSearchRecycledPointsInBatchesWarp(handle ConnectHandle, start int32, count int32, tagMask, fullMask, source, unit, desc, instrument string, mode RtdbSortFlag) ([]PointID, RtdbError) {
+	call := b.start("RawRtdbbSearchRecycledPointsInBatchesWarp", handle, 0)
+	r0, rte := b.next.RawRtdbbSearchRecycledPointsInBatchesWarp(handle, start, count, tagMask, fullMask, source, unit, desc, instrument, mode)
+	call.end(rte)
+	return r0, rte
+}
+
+func (b *instrumentedBackend) RawRtdbbGetRecycledMaxPointPropertyWarp(handle ConnectHandle, id PointID) (*RtdbPoint, *RtdbScan, *RtdbCalc, RtdbError) {
+	call := b.start("RawRtdbbGetRecycledMaxPointPropertyWarp", handle, 1)
+	r0, r1, r2, rte := b.next.RawRtdbbGetRecycledMaxPointPropertyWarp(handle, id)
+	call.end(rte)
+	return r0, r1, r2, rte
+}
+
+func (b *instrumentedBackend) RawRtdbbClearRecyclerWarp(handle ConnectHandle) RtdbError {
+	call := b.start("RawRtdbbClearRecyclerWarp", handle, 0)
+	rte := b.next.RawRtdbbClearRecyclerWarp(handle)
+	call.end(rte)
+	return rte
+}
+
+func (b *instrumentedBackend) RawRtdbbCreateNamedTypeWarp(handle ConnectHandle, name string, desc string, fields ...RtdbDataTypeField) RtdbError {
+	call := b.start("RawRtdbbCreateNamedTypeWarp", handle, len(fields))
+	rte := b.next.RawRtdbbCreateNamedTypeWarp(handle, name, desc, fields...)
+	call.end(rte)
+	return rte
+}
+
+func (b *instrumentedBackend) RawRtdbbGetNamedTypesCountWarp(handle ConnectHandle) (int32, RtdbError) {
+	call := b.start("RawRtdbbGetNamedTypesCountWarp", handle, 0)
+	r0, rte := b.next.RawRtdbbGetNamedTypesCountWarp(handle)
+	call.end(rte)
+	return r0, rte
+}
+
+func (b *instrumentedBackend) RawRtdbbGetAllNamedTypesWarp(handle ConnectHandle, count int32) ([]string, []int32, RtdbError) {
+	call := b.start("RawRtdbbGetAllNamedTypesWarp", handle, 0)
+	r0, r1, rte := b.next.RawRtdbbGetAllNamedTypesWarp(handle, count)
+	call.end(rte)
+	return r0, r1, rte
+}
+
+func (b *instrumentedBackend) RawRtdbbGetNamedTypeWarp(handle ConnectHandle, name string, fieldCount int32) ([]RtdbDataTypeField, int32, string, RtdbError) {
+	call := b.start("RawRtdbbGetNamedTypeWarp", handle, 0)
+	r0, r1, r2, rte := b.next.RawRtdbbGetNamedTypeWarp(handle, name, fieldCount)
+	call.end(rte)
+	return r0, r1, r2, rte
+}
+
+func (b *instrumentedBackend) RawRtdbbRemoveNamedTypeWarp(handle ConnectHandle, name string) RtdbError {
+	call := b.start("RawRtdbbRemoveNamedTypeWarp", handle, 0)
+	rte := b.next.RawRtdbbRemoveNamedTypeWarp(handle, name)
+	call.end(rte)
+	return rte
+}
+
+func (b *instrumentedBackend) RawRtdbbGetNamedTypeNamesPropertyWarp(handle ConnectHandle, ids []PointID) ([]string, []int32, []RtdbError, RtdbError) {
+	call := b.start("RawRtdbbGetNamedTypeNamesPropertyWarp", handle, len(ids))
+	r0, r1, r2, rte := b.next.RawRtdbbGetNamedTypeNamesPropertyWarp(handle, ids)
+	call.end(rte)
+	return r0, r1, r2, rte
+}
+
+func (b *instrumentedBackend) RawRtdbbGetRecycledNamedTypeNamesPropertyWarp(handle ConnectHandle, ids []PointID) ([]string, []int32, []RtdbError, RtdbError) {
+	call := b.start("RawRtdbbGetRecycledNamedTypeNamesPropertyWarp", handle, len(ids))
+	r0, r1, r2, rte := b.next.RawRtdbbGetRecycledNamedTypeNamesPropertyWarp(handle, ids)
+	call.end(rte)
+	return r0, r1, r2, rte
+}
+
+func (b *instrumentedBackend) RawRtdbbGetNamedTypePointsCountWarp(handle ConnectHandle, name string) (int32, RtdbError) {
+	call := b.start("RawRtdbbGetNamedTypePointsCountWarp", handle, 0)
+	r0, rte := b.next.RawRtdbbGetNamedTypePointsCountWarp(handle, name)
+	call.end(rte)
+	return r0, rte
+}
+
+func (b *instrumentedBackend) RawRtdbbGetBaseTypePointsCountWarp(handle ConnectHandle, rtdbType RtdbType) (int32, RtdbError) {
+	call := b.start("RawRtdbbGetBaseTypePointsCountWarp", handle, 0)
+	r0, rte := b.next.RawRtdbbGetBaseTypePointsCountWarp(handle, rtdbType)
+	call.end(rte)
+	return r0, rte
+}
+
+func (b *instrumentedBackend) RawRtdbbModifyNamedTypeWarp(handle ConnectHandle, name string, modifyName *string, modifyDesc *string, fieldNames []string, fieldDescs []string) RtdbError {
+	call := b.start("RawRtdbbModifyNamedTypeWarp", handle, len(fieldNames))
+	rte := b.next.RawRtdbbModifyNamedTypeWarp(handle, name, modifyName, modifyDesc, fieldNames, fieldDescs)
+	call.end(rte)
+	return rte
+}
+
+func (b *instrumentedBackend) RawRtdbWriteNamedTypeFieldByName32Warp(handle ConnectHandle, typeName string, fieldName string, fieldType RtdbType, object []byte, field []byte) RtdbError {
+	call := b.start("RawRtdbWriteNamedTypeFieldByName32Warp", handle, 0)
+	rte := b.next.RawRtdbWriteNamedTypeFieldByName32Warp(handle, typeName, fieldName, fieldType, object, field)
+	call.end(rte)
+	return rte
+}
+
+func (b *instrumentedBackend) RawRtdbWriteNamedTypeFieldByPos32Warp(handle ConnectHandle, typeName string, fieldPos int32, fieldType RtdbType, object []byte, field []byte) RtdbError {
+	call := b.start("RawRtdbWriteNamedTypeFieldByPos32Warp", handle, 0)
+	rte := b.next.RawRtdbWriteNamedTypeFieldByPos32Warp(handle, typeName, fieldPos, fieldType, object, field)
+	call.end(rte)
+	return rte
+}
+
+func (b *instrumentedBackend) RawRtdbReadNamedTypeFieldByName32Warp(handle ConnectHandle, typeName string, fieldName string, fieldType RtdbType, object []byte, fieldLen int32) ([]byte, RtdbError) {
+	call := b.start("RawRtdbReadNamedTypeFieldByName32Warp", handle, 0)
+	r0, rte := b.next.RawRtdbReadNamedTypeFieldByName32Warp(handle, typeName, fieldName, fieldType, object, fieldLen)
+	call.end(rte)
+	return r0, rte
+}
+
+func (b *instrumentedBackend) RawRtdbReadNamedTypeFieldByPos32Warp(handle ConnectHandle, typeName string, fieldPos int32, fieldType RtdbType, object []byte, fieldLen int32) ([]byte, RtdbError) {
+	call := b.start("RawRtdbReadNamedTypeFieldByPos32Warp", handle, 0)
+	r0, rte := b.next.RawRtdbReadNamedTypeFieldByPos32Warp(handle, typeName, fieldPos, fieldType, object, fieldLen)
+	call.end(rte)
+	return r0, rte
+}
+
+func (b *instrumentedBackend) RawRtdbNamedTypeNameFieldCheckWarp(checkName string, flag byte) RtdbError {
+	call := b.start("RawRtdbNamedTypeNameFieldCheckWarp", 0, 0)
+	rte := b.next.RawRtdbNamedTypeNameFieldCheckWarp(checkName, flag)
+	call.end(rte)
+	return rte
+}
+
+func (b *instrumentedBackend) RawRtdbbGetMetaSyncInfoWarp(handle ConnectHandle, nodeNumber int32) ([]RtdbSyncInfo, []RtdbError, RtdbError) {
+	call := b.start("RawRtdbbGetMetaSyncInfoWarp", handle, 0)
+	r0, r1, rte := b.next.RawRtdbbGetMetaSyncInfoWarp(handle, nodeNumber)
+	call.end(rte)
+	return r0, r1, rte
+}
+
+func (b *instrumentedBackend) RawRtdbaGetArchivesCountWarp(handle ConnectHandle) (int32, RtdbError) {
+	call := b.start("RawRtdbaGetArchivesCountWarp", handle, 0)
+	r0, rte := b.next.RawRtdbaGetArchivesCountWarp(handle)
+	call.end(rte)
+	return r0, rte
+}
+
+func (b *instrumentedBackend) RawRtdbaGetArchivesWarp(handle ConnectHandle, maxCount int32) ([]string, []string, []RtdbArchiveState, RtdbError) {
+	call := b.start("RawRtdbaGetArchivesWarp", handle, 0)
+	r0, r1, r2, rte := b.next.RawRtdbaGetArchivesWarp(handle, maxCount)
+	call.end(rte)
+	return r0, r1, r2, rte
+}
+
+func (b *instrumentedBackend) RawRtdbsPutSnapshots64Warp(handle ConnectHandle, ids []PointID, datetimes []TimestampType, subtimes []SubtimeType, values []float64, states []int64, qualities []Quality) ([]RtdbError, RtdbError) {
+	call := b.start("RawRtdbsPutSnapshots64Warp", handle, len(ids))
+	r0, rte := b.next.RawRtdbsPutSnapshots64Warp(handle, ids, datetimes, subtimes, values, states, qualities)
+	call.end(rte)
+	return r0, rte
+}
+
+func (b *instrumentedBackend) RawRtdbsFixSnapshots64Warp(handle ConnectHandle, ids []PointID, datetimes []TimestampType, subtimes []SubtimeType, values []float64, states []int64, qualities []Quality) ([]RtdbError, RtdbError) {
+	call := b.start("RawRtdbsFixSnapshots64Warp", handle, len(ids))
+	r0, rte := b.next.RawRtdbsFixSnapshots64Warp(handle, ids, datetimes, subtimes, values, states, qualities)
+	call.end(rte)
+	return r0, rte
+}
+
+func (b *instrumentedBackend) RawRtdbsPutCoorSnapshots64Warp(handle ConnectHandle, ids []PointID, datetimes []TimestampType, subtimes []SubtimeType, xs []float32, ys []float32, qualities []Quality) ([]RtdbError, RtdbError) {
+	call := b.start("RawRtdbsPutCoorSnapshots64Warp", handle, len(ids))
+	r0, rte := b.next.RawRtdbsPutCoorSnapshots64Warp(handle, ids, datetimes, subtimes, xs, ys, qualities)
+	call.end(rte)
+	return r0, rte
+}
+
+func (b *instrumentedBackend) RawRtdbsFixCoorSnapshots64Warp(handle ConnectHandle, ids []PointID, datetimes []TimestampType, subtimes []SubtimeType, xs []float32, ys []float32, qualities []Quality) ([]RtdbError, RtdbError) {
+	call := b.start("RawRtdbsFixCoorSnapshots64Warp", handle, len(ids))
+	r0, rte := b.next.RawRtdbsFixCoorSnapshots64Warp(handle, ids, datetimes, subtimes, xs, ys, qualities)
+	call.end(rte)
+	return r0, rte
+}
+
+func (b *instrumentedBackend) RawRtdbsPutBlobSnapshots64Warp(handle ConnectHandle, ids []PointID, datetimes []TimestampType, subtimes []SubtimeType, blobs [][]byte, qualities []Quality) ([]RtdbError, RtdbError) {
+	call := b.start("RawRtdbsPutBlobSnapshots64Warp", handle, len(ids))
+	r0, rte := b.next.RawRtdbsPutBlobSnapshots64Warp(handle, ids, datetimes, subtimes, blobs, qualities)
+	call.end(rte)
+	return r0, rte
+}
+
+func (b *instrumentedBackend) RawRtdbsPutDatetimeSnapshots64Warp(handle ConnectHandle, ids []PointID, datetimes []TimestampType, subtimes []SubtimeType, dtValues []string, qualities []Quality) ([]RtdbError, RtdbError) {
+	call := b.start("RawRtdbsPutDatetimeSnapshots64Warp", handle, len(ids))
+	r0, rte := b.next.RawRtdbsPutDatetimeSnapshots64Warp(handle, ids, datetimes, subtimes, dtValues, qualities)
+	call.end(rte)
+	return r0, rte
+}
+
+func (b *instrumentedBackend) RawRtdbsPutNamedTypeSnapshots64Warp(handle ConnectHandle, ids []PointID, datetimes []TimestampType, subtimes []SubtimeType, objects [][]byte, qualities []Quality) ([]RtdbError, RtdbError) {
+	call := b.start("RawRtdbsPutNamedTypeSnapshots64Warp", handle, len(ids))
+	r0, rte := b.next.RawRtdbsPutNamedTypeSnapshots64Warp(handle, ids, datetimes, subtimes, objects, qualities)
+	call.end(rte)
+	return r0, rte
+}
+
+func (b *instrumentedBackend) RawRtdbsGetSnapshots64Warp(handle ConnectHandle, ids []PointID) ([]TimestampType, []SubtimeType, []float64, []int64, []Quality, []RtdbError, RtdbError) {
+	call := b.start("RawRtdbsGetSnapshots64Warp", handle, len(ids))
+	r0, r1, r2, r3, r4, r5, rte := b.next.RawRtdbsGetSnapshots64Warp(handle, ids)
+	call.end(rte)
+	return r0, r1, r2, r3, r4, r5, rte
+}
+
+func (b *instrumentedBackend) RawRtdbsGetCoorSnapshots64Warp(handle ConnectHandle, ids []PointID) ([]TimestampType, []SubtimeType, []float32, []float32, []Quality, []RtdbError, RtdbError) {
+	call := b.start("RawRtdbsGetCoorSnapshots64Warp", handle, len(ids))
+	r0, r1, r2, r3, r4, r5, rte := b.next.RawRtdbsGetCoorSnapshots64Warp(handle, ids)
+	call.end(rte)
+	return r0, r1, r2, r3, r4, r5, rte
+}
+
+func (b *instrumentedBackend) RawRtdbsGetBlobSnapshots64Warp(handle ConnectHandle, ids []PointID, maxLen int32) ([]TimestampType, []SubtimeType, [][]byte, []Quality, []RtdbError, RtdbError) {
+	call := b.start("RawRtdbsGetBlobSnapshots64Warp", handle, len(ids))
+	r0, r1, r2, r3, r4, rte := b.next.RawRtdbsGetBlobSnapshots64Warp(handle, ids, maxLen)
+	call.end(rte)
+	return r0, r1, r2, r3, r4, rte
+}
+
+func (b *instrumentedBackend) RawRtdbsGetDatetimeSnapshots64Warp(handle ConnectHandle, ids []PointID, typ int16) ([]TimestampType, []SubtimeType, []string, []Quality, []RtdbError, RtdbError) {
+	call := b.start("RawRtdbsGetDatetimeSnapshots64Warp", handle, len(ids))
+	r0, r1, r2, r3, r4, rte := b.next.RawRtdbsGetDatetimeSnapshots64Warp(handle, ids, typ)
+	call.end(rte)
+	return r0, r1, r2, r3, r4, rte
+}
+
+func (b *instrumentedBackend) RawRtdbsGetNamedTypeSnapshots64Warp(handle ConnectHandle, ids []PointID, lens []int32) ([]TimestampType, []SubtimeType, [][]byte, []Quality, []RtdbError, RtdbError) {
+	call := b.start("RawRtdbsGetNamedTypeSnapshots64Warp", handle, len(ids))
+	r0, r1, r2, r3, r4, rte := b.next.RawRtdbsGetNamedTypeSnapshots64Warp(handle, ids, lens)
+	call.end(rte)
+	return r0, r1, r2, r3, r4, rte
+}
+
+func (b *instrumentedBackend) RawRtdbsSubscribeSnapshotsEx64Warp(handle ConnectHandle, ids []PointID, options RtdbSubscribeOption, callback SnapshotEventFunc) ([]RtdbError, RtdbError) {
+	call := b.start("RawRtdbsSubscribeSnapshotsEx64Warp", handle, len(ids))
+	r0, rte := b.next.RawRtdbsSubscribeSnapshotsEx64Warp(handle, ids, options, callback)
+	call.end(rte)
+	return r0, rte
+}
+
+func (b *instrumentedBackend) RawRtdbsSubscribeDeltaSnapshots64Warp(handle ConnectHandle, ids []PointID, deltaValues []float64, deltaStates []int64, options RtdbSubscribeOption, callback SnapshotEventFunc) ([]RtdbError, RtdbError) {
+	call := b.start("RawRtdbsSubscribeDeltaSnapshots64Warp", handle, len(ids))
+	r0, rte := b.next.RawRtdbsSubscribeDeltaSnapshots64Warp(handle, ids, deltaValues, deltaStates, options, callback)
+	call.end(rte)
+	return r0, rte
+}
+
+func (b *instrumentedBackend) RawRtdbsChangeSubscribeSnapshotsWarp(handle ConnectHandle, ids []PointID, deltaValues []float64, deltaStates []int64, changedTypes []RtdbSubscribeChangeType) ([]RtdbError, RtdbError) {
+	call := b.start("RawRtdbsChangeSubscribeSnapshotsWarp", handle, len(ids))
+	r0, rte := b.next.RawRtdbsChangeSubscribeSnapshotsWarp(handle, ids, deltaValues, deltaStates, changedTypes)
+	call.end(rte)
+	return r0, rte
+}
+
+func (b *instrumentedBackend) RawRtdbsCancelSubscribeSnapshotsWarp(handle ConnectHandle) RtdbError {
+	call := b.start("RawRtdbsCancelSubscribeSnapshotsWarp", handle, 0)
+	rte := b.next.RawRtdbsCancelSubscribeSnapshotsWarp(handle)
+	call.end(rte)
+	return rte
+}
+
+func (b *instrumentedBackend) RawRtdbhGetSingleValue64Warp(handle ConnectHandle, id PointID, mode RtdbHisMode, datetime TimestampType, subtime SubtimeType) (TimestampType, SubtimeType, float64, int64, Quality, RtdbError) {
+	call := b.start("RawRtdbhGetSingleValue64Warp", handle, 1)
+	r0, r1, r2, r3, r4, rte := b.next.RawRtdbhGetSingleValue64Warp(handle, id, mode, datetime, subtime)
+	call.end(rte)
+	return r0, r1, r2, r3, r4, rte
+}
+
+func (b *instrumentedBackend) RawRtdbhGetSingleCoorValue64Warp(handle ConnectHandle, id PointID, mode RtdbHisMode, datetime TimestampType, subtime SubtimeType) (TimestampType, SubtimeType, float32, float32, Quality, RtdbError) {
+	call := b.start("RawRtdbhGetSingleCoorValue64Warp", handle, 1)
+	r0, r1, r2, r3, r4, rte := b.next.RawRtdbhGetSingleCoorValue64Warp(handle, id, mode, datetime, subtime)
+	call.end(rte)
+	return r0, r1, r2, r3, r4, rte
+}
+
+func (b *instrumentedBackend) RawRtdbhGetSingleBlobValue64Warp(handle ConnectHandle, id PointID, mode RtdbHisMode, datetime TimestampType, subtime SubtimeType, maxLen int32) (TimestampType, SubtimeType, []byte, Quality, RtdbError) {
+	call := b.start("RawRtdbhGetSingleBlobValue64Warp", handle, 1)
+	r0, r1, r2, r3, rte := b.next.RawRtdbhGetSingleBlobValue64Warp(handle, id, mode, datetime, subtime, maxLen)
+	call.end(rte)
+	return r0, r1, r2, r3, rte
+}
+
+func (b *instrumentedBackend) RawRtdbhGetSingleDatetimeValue64Warp(handle ConnectHandle, id PointID, mode RtdbHisMode, datetime TimestampType, subtime SubtimeType, dtType int16) (TimestampType, SubtimeType, []byte, Quality, RtdbError) {
+	call := b.start("RawRtdbhGetSingleDatetimeValue64Warp", handle, 1)
+	r0, r1, r2, r3, rte := b.next.RawRtdbhGetSingleDatetimeValue64Warp(handle, id, mode, datetime, subtime, dtType)
+	call.end(rte)
+	return r0, r1, r2, r3, rte
+}
+
+func (b *instrumentedBackend) RawRtdbhGetSingleNamedTypeValue64Warp(handle ConnectHandle, id PointID, mode RtdbHisMode, datetime TimestampType, subtime SubtimeType, length int32) (TimestampType, SubtimeType, []byte, Quality, RtdbError) {
+	call := b.start("RawRtdbhGetSingleNamedTypeValue64Warp", handle, 1)
+	r0, r1, r2, r3, rte := b.next.RawRtdbhGetSingleNamedTypeValue64Warp(handle, id, mode, datetime, subtime, length)
+	call.end(rte)
+	return r0, r1, r2, r3, rte
+}
+
+func (b *instrumentedBackend) RawRtdbhGetArchivedValues64Warp(handle ConnectHandle, id PointID, count int32, datetime1 TimestampType, subtime1 SubtimeType, datetime2 TimestampType, subtime2 SubtimeType) ([]TimestampType, []SubtimeType, []float64, []int64, []Quality, RtdbError) {
+	call := b.start("RawRtdbhGetArchivedValues64Warp", handle, 1)
+	r0, r1, r2, r3, r4, rte := b.next.RawRtdbhGetArchivedValues64Warp(handle, id, count, datetime1, subtime1, datetime2, subtime2)
+	call.end(rte)
+	return r0, r1, r2, r3, r4, rte
+}
+
+func (b *instrumentedBackend) RawRtdbhGetArchivedCoorValues64Warp(handle ConnectHandle, id PointID, count int32, datetime1 TimestampType, subtime1 SubtimeType, datetime2 TimestampType, subtime2 SubtimeType) ([]TimestampType, []SubtimeType, []float32, []float32, []Quality, RtdbError) {
+	call := b.start("RawRtdbhGetArchivedCoorValues64Warp", handle, 1)
+	r0, r1, r2, r3, r4, rte := b.next.RawRtdbhGetArchivedCoorValues64Warp(handle, id, count, datetime1, subtime1, datetime2, subtime2)
+	call.end(rte)
+	return r0, r1, r2, r3, r4, rte
+}
+
+func (b *instrumentedBackend) RawRtdbhGetArchivedBlobValues64Warp(handle ConnectHandle, id PointID, maxLen int32, maxCount int32, datetime1 TimestampType, subtime1 SubtimeType, datetime2 TimestampType, subtime2 SubtimeType) ([]TimestampType, []SubtimeType, [][]byte, []Quality, RtdbError) {
+	call := b.start("RawRtdbhGetArchivedBlobValues64Warp", handle, 1)
+	r0, r1, r2, r3, rte := b.next.RawRtdbhGetArchivedBlobValues64Warp(handle, id, maxLen, maxCount, datetime1, subtime1, datetime2, subtime2)
+	call.end(rte)
+	return r0, r1, r2, r3, rte
+}
+
+func (b *instrumentedBackend) RawRtdbhGetArchivedDatetimeValues64Warp(handle ConnectHandle, id PointID, maxCount int32, datetime1 TimestampType, subtime1 SubtimeType, datetime2 TimestampType, subtime2 SubtimeType, dtType int16) ([]TimestampType, []SubtimeType, [][]byte, []Quality, RtdbError) {
+	call := b.start("RawRtdbhGetArchivedDatetimeValues64Warp", handle, 1)
+	r0, r1, r2, r3, rte := b.next.RawRtdbhGetArchivedDatetimeValues64Warp(handle, id, maxCount, datetime1, subtime1, datetime2, subtime2, dtType)
+	call.end(rte)
+	return r0, r1, r2, r3, rte
+}
+
+func (b *instrumentedBackend) RawRtdbhGetArchivedNamedTypeValues64Warp(handle ConnectHandle, id PointID, datetime1 TimestampType, subtime1 SubtimeType, datetime2 TimestampType, subtime2 SubtimeType, length int32, maxCount int32) ([]TimestampType, []SubtimeType, [][]byte, []Quality, RtdbError) {
+	call := b.start("RawRtdbhGetArchivedNamedTypeValues64Warp", handle, 1)
+	r0, r1, r2, r3, rte := b.next.RawRtdbhGetArchivedNamedTypeValues64Warp(handle, id, datetime1, subtime1, datetime2, subtime2, length, maxCount)
+	call.end(rte)
+	return r0, r1, r2, r3, rte
+}
+
+func (b *instrumentedBackend) RawRtdbhGetInterpoValues64Warp(handle ConnectHandle, id PointID, count int32, datetime1 TimestampType, subtime1 SubtimeType, datetime2 TimestampType, subtime2 SubtimeType) ([]TimestampType, []SubtimeType, []float64, []int64, []Quality, RtdbError) {
+	call := b.start("RawRtdbhGetInterpoValues64Warp", handle, 1)
+	r0, r1, r2, r3, r4, rte := b.next.RawRtdbhGetInterpoValues64Warp(handle, id, count, datetime1, subtime1, datetime2, subtime2)
+	call.end(rte)
+	return r0, r1, r2, r3, r4, rte
+}
+
+func (b *instrumentedBackend) RawRtdbhSummaryDataWarp(handle ConnectHandle, id PointID, datetime1 TimestampType, subtime1 SubtimeType, datetime2 TimestampType, subtime2 SubtimeType) (*RtdbSummaryData, RtdbError) {
+	call := b.start("RawRtdbhSummaryDataWarp", handle, 1)
+	r0, rte := b.next.RawRtdbhSummaryDataWarp(handle, id, datetime1, subtime1, datetime2, subtime2)
+	call.end(rte)
+	return r0, rte
+}
+
+func (b *instrumentedBackend) RawRtdbhPutArchivedDatetimeValues64Warp(handle ConnectHandle, ids []PointID, datetimes []TimestampType, subtimes []SubtimeType, dtValues []string, qualities []Quality) ([]RtdbError, RtdbError) {
+	call := b.start("RawRtdbhPutArchivedDatetimeValues64Warp", handle, len(ids))
+	r0, rte := b.next.RawRtdbhPutArchivedDatetimeValues64Warp(handle, ids, datetimes, subtimes, dtValues, qualities)
+	call.end(rte)
+	return r0, rte
+}
+
+func (b *instrumentedBackend) RawRtdbhPutArchivedValues64Warp(handle ConnectHandle, ids []PointID, datetimes []TimestampType, subtimes []SubtimeType, values []float64, states []int64, qualities []Quality) ([]RtdbError, RtdbError) {
+	call := b.start("RawRtdbhPutArchivedValues64Warp", handle, len(ids))
+	r0, rte := b.next.RawRtdbhPutArchivedValues64Warp(handle, ids, datetimes, subtimes, values, states, qualities)
+	call.end(rte)
+	return r0, rte
+}
+
+func (b *instrumentedBackend) RawRtdbhPutArchivedCoorValues64Warp(handle ConnectHandle, ids []PointID, datetimes []TimestampType, subtimes []SubtimeType, xs []float32, ys []float32, qualities []Quality) ([]RtdbError, RtdbError) {
+	call := b.start("RawRtdbhPutArchivedCoorValues64Warp", handle, len(ids))
+	r0, rte := b.next.RawRtdbhPutArchivedCoorValues64Warp(handle, ids, datetimes, subtimes, xs, ys, qualities)
+	call.end(rte)
+	return r0, rte
+}
+
+func (b *instrumentedBackend) RawRtdbhPutArchivedBlobValues64Warp(handle ConnectHandle, ids []PointID, datetimes []TimestampType, subtimes []SubtimeType, blobs [][]byte, qualities []Quality) ([]RtdbError, RtdbError) {
+	call := b.start("RawRtdbhPutArchivedBlobValues64Warp", handle, len(ids))
+	r0, rte := b.next.RawRtdbhPutArchivedBlobValues64Warp(handle, ids, datetimes, subtimes, blobs, qualities)
+	call.end(rte)
+	return r0, rte
+}
+
+func (b *instrumentedBackend) RawRtdbhPutArchivedNamedTypeValues64Warp(handle ConnectHandle, ids []PointID, datetimes []TimestampType, subtimes []SubtimeType, objects [][]byte, qualities []Quality) ([]RtdbError, RtdbError) {
+	call := b.start("RawRtdbhPutArchivedNamedTypeValues64Warp", handle, len(ids))
+	r0, rte := b.next.RawRtdbhPutArchivedNamedTypeValues64Warp(handle, ids, datetimes, subtimes, objects, qualities)
+	call.end(rte)
+	return r0, rte
+}
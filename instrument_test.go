@@ -0,0 +1,118 @@
+package rtdb_api
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+// testSpan 记录属性的Span
+type testSpan struct {
+	name   string
+	attrs  map[string]any
+	err    error
+	ended  bool
+	parent any // 开始Span时ctx中 testTraceKey 的值
+}
+
+// testTraceKey 调用方ctx中的链路标识
+type testTraceKey struct{}
+
+func (s *testSpan) SetAttribute(key string, value any) { s.attrs[key] = value }
+func (s *testSpan) RecordError(err error)              { s.err = err }
+func (s *testSpan) End()                               { s.ended = true }
+
+// testTracer 记录所有Span的Tracer
+type testTracer struct {
+	spans []*testSpan
+}
+
+func (t *testTracer) Start(ctx context.Context, spanName string) (context.Context, Span) {
+	span := &testSpan{name: spanName, attrs: make(map[string]any), parent: ctx.Value(testTraceKey{})}
+	t.spans = append(t.spans, span)
+	return ctx, span
+}
+
+// 记录Raw调用的日志与Span
+func TestNewInstrumentedBackend(t *testing.T) {
+	backend := NewMemoryBackend()
+	if NewInstrumentedBackend(backend, nil) != Backend(backend) {
+		t.Fatal("未开启观测时应直接返回原后端")
+	}
+
+	buf := &bytes.Buffer{}
+	tracer := &testTracer{}
+	calls := make([]CallInfo, 0)
+	inst := &Instrumentation{
+		Logger: slog.New(slog.NewTextHandler(buf, &slog.HandlerOptions{Level: slog.LevelDebug})),
+		Tracer: tracer,
+		OnCall: func(call CallInfo) { calls = append(calls, call) },
+	}
+	conn, err := LoginWithBackend(NewInstrumentedBackend(backend, inst), "127.0.0.1", Port, Username, Password)
+	if err != nil {
+		t.Fatal("登录用户失败", err)
+	}
+	defer func() { _ = conn.Logout() }()
+
+	_, errs, err := conn.GetPoints([]PointID{1, 2, 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if errs[0] == nil {
+		t.Fatal("期望标签点不存在")
+	}
+
+	last := calls[len(calls)-1]
+	if last.Op != "RawRtdbbGetMaxPointsPropertyWarp" || last.Count != 3 || last.Handle != conn.ConnectHandle {
+		t.Error("调用信息不正确", last)
+	}
+	span := tracer.spans[len(tracer.spans)-1]
+	if span.name != "RawRtdbbGetMaxPointsPropertyWarp" || !span.ended || span.attrs["rtdb.count"] != int64(3) {
+		t.Error("Span不正确", span)
+	}
+	if !strings.Contains(buf.String(), "op=RawRtdbConnectWarp") || !strings.Contains(buf.String(), "count=3") {
+		t.Error("日志不正确", buf.String())
+	}
+}
+
+// *Context 方法的Span以调用方的ctx为父节点
+func TestInstrumentedBackend_ContextParent(t *testing.T) {
+	tracer := &testTracer{}
+	backend := NewInstrumentedBackend(NewMemoryBackend(), &Instrumentation{Tracer: tracer})
+	logins := map[string]func() (*RtdbConnect, error){
+		"单机": func() (*RtdbConnect, error) {
+			return LoginWithBackend(backend, "127.0.0.1", Port, Username, Password)
+		},
+		"主备": func() (*RtdbConnect, error) {
+			return LoginEndpointsWithBackend(backend, []Endpoint{{HostIp: "127.0.0.1", Port: Port}}, Username, Password)
+		},
+	}
+	for name, login := range logins {
+		t.Run(name, func(t *testing.T) {
+			conn, err := login()
+			if err != nil {
+				t.Fatal("登录用户失败", err)
+			}
+			defer func() { _ = conn.Logout() }()
+
+			ctx := context.WithValue(context.Background(), testTraceKey{}, name)
+			if _, err := conn.GetTablesContext(ctx); err != nil {
+				t.Fatal(err)
+			}
+			span := tracer.spans[len(tracer.spans)-1]
+			if span.name != "RawRtdbbGetTablesWarp" || span.parent != name {
+				t.Errorf("Span应以调用方ctx为父节点 %+v", span)
+			}
+
+			// 没有ctx的调用仍然从 context.Background() 开始
+			if _, err := conn.GetTables(); err != nil {
+				t.Fatal(err)
+			}
+			if span := tracer.spans[len(tracer.spans)-1]; span.parent != nil {
+				t.Errorf("Span不应有父节点 %+v", span)
+			}
+		})
+	}
+}
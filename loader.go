@@ -0,0 +1,178 @@
+//go:build cgo
+
+package rtdb_api
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"strings"
+)
+
+const (
+	// EnvLibraryPath 指定系统中已安装的动态库路径，设置后不再使用内置动态库
+	EnvLibraryPath = "RTDB_API_LIBRARY"
+
+	// EnvLibrarySha256 动态库的SHA256校验值(十六进制)，设置后加载前会校验动态库文件
+	EnvLibrarySha256 = "RTDB_API_LIBRARY_SHA256"
+
+	// EnvLibraryDir 释放内置动态库时使用的目录，默认为系统临时目录
+	EnvLibraryDir = "RTDB_API_LIBRARY_DIR"
+)
+
+var errUnsupportedPlatform = errors.New("不支持的平台")
+
+// libraryLoadErr 初始化时加载动态库失败的原因，登录时返回给调用方
+var libraryLoadErr error
+
+func init() {
+	// 优先使用环境变量指定的动态库
+	if path := os.Getenv(EnvLibraryPath); path != "" {
+		libraryLoadErr = LoadLibraryWithChecksum(path, os.Getenv(EnvLibrarySha256))
+		return
+	}
+
+	data, name, sum, err := embeddedLibrary()
+	if err != nil {
+		// 没有内置动态库时，等待调用方通过 LoadLibrary 加载
+		libraryLoadErr = err
+		return
+	}
+	libraryLoadErr = loadEmbeddedLibrary(data, name, sum)
+}
+
+// checkLibraryLoaded 检查动态库是否已加载
+func checkLibraryLoaded() error {
+	if nativeLibraryLoaded() {
+		return nil
+	}
+	if libraryLoadErr != nil {
+		return fmt.Errorf("动态库未加载：%w", libraryLoadErr)
+	}
+	return errors.New("动态库未加载")
+}
+
+// LoadLibrary 加载指定路径的动态库，替换当前使用的动态库
+//
+// input:
+//   - path 动态库路径
+//
+// 备注：需要在登录之前调用，已经建立的连接仍然使用原来的动态库中的句柄
+func LoadLibrary(path string) error {
+	return LoadLibraryWithChecksum(path, "")
+}
+
+// LoadLibraryWithChecksum 校验动态库的SHA256后加载
+//
+// input:
+//   - path 动态库路径
+//   - sha256Hex 期望的SHA256校验值(十六进制，不区分大小写)，为空时不校验
+func LoadLibraryWithChecksum(path string, sha256Hex string) error {
+	if sha256Hex != "" {
+		if err := verifyFileChecksum(path, sha256Hex); err != nil {
+			return err
+		}
+	}
+	return loadNativeLibrary(path)
+}
+
+// loadEmbeddedLibrary 将内置动态库释放后加载
+//   - Linux中优先写入匿名内存文件(memfd)，不在磁盘上留下文件
+//   - 否则写入每个进程独立的临时文件，避免多个进程或不同版本之间相互覆盖
+//   - 释放后的文件按 expected 校验，expected 由 go generate 在构建前生成(embed_sha256.go)，与内置的数据无关
+//
+// input:
+//   - data 动态库内容
+//   - name 动态库文件名
+//   - expected 期望的SHA256(十六进制)
+func loadEmbeddedLibrary(data []byte, name string, expected string) error {
+	if len(data) == 0 {
+		return fmt.Errorf("内置动态库%s为空", name)
+	}
+	if expected == "" {
+		return fmt.Errorf("内置动态库%s没有记录SHA256, 放入动态库后请执行 go generate", name)
+	}
+
+	if path, cleanup, err := writeMemfd(name, data); err == nil {
+		defer cleanup()
+		if err := verifyFileChecksum(path, expected); err != nil {
+			return err
+		}
+		return loadNativeLibrary(path)
+	}
+
+	path, err := writeTempLibrary(os.Getenv(EnvLibraryDir), name, data)
+	if err != nil {
+		return err
+	}
+	if runtime.GOOS != "windows" {
+		// Linux中动态库加载后即可删除文件，Windows中加载后的文件无法删除
+		defer func() { _ = os.Remove(path) }()
+	}
+	if err := verifyFileChecksum(path, expected); err != nil {
+		return err
+	}
+	return loadNativeLibrary(path)
+}
+
+// writeTempLibrary 将动态库写入唯一命名的临时文件
+//
+// output:
+//   - string(path) 临时文件路径
+func writeTempLibrary(dir string, name string, data []byte) (string, error) {
+	ext := ""
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		name, ext = name[:i], name[i:]
+	}
+	file, err := os.CreateTemp(dir, name+"-*"+ext)
+	if err != nil {
+		return "", fmt.Errorf("创建临时动态库文件失败：%w", err)
+	}
+	path := file.Name()
+	if _, err := file.Write(data); err != nil {
+		_ = file.Close()
+		_ = os.Remove(path)
+		return "", fmt.Errorf("写入临时动态库文件失败：%w", err)
+	}
+	if err := file.Close(); err != nil {
+		_ = os.Remove(path)
+		return "", fmt.Errorf("写入临时动态库文件失败：%w", err)
+	}
+	if err := os.Chmod(path, 0755); err != nil {
+		_ = os.Remove(path)
+		return "", fmt.Errorf("设置临时动态库文件权限失败：%w", err)
+	}
+	return path, nil
+}
+
+// verifyFileChecksum 校验文件的SHA256
+func verifyFileChecksum(path string, expected string) error {
+	want, err := hex.DecodeString(strings.TrimSpace(expected))
+	if err != nil || len(want) != sha256.Size {
+		return fmt.Errorf("无效的SHA256校验值：%s", expected)
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("打开动态库%s失败：%w", path, err)
+	}
+	defer func() { _ = file.Close() }()
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return fmt.Errorf("读取动态库%s失败：%w", path, err)
+	}
+	if got := hash.Sum(nil); !bytes.Equal(got, want) {
+		return fmt.Errorf("动态库%s校验失败：期望%s，实际%s", path, hex.EncodeToString(want), hex.EncodeToString(got))
+	}
+	return nil
+}
+
+// sha256Hex 计算数据的SHA256(十六进制)
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,51 @@
+//go:build linux && cgo
+
+// 一些跨平台函数
+
+package rtdb_api
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+	"syscall"
+	"unsafe"
+)
+
+// memfdCreateTrap 各架构下 memfd_create 的系统调用号
+func memfdCreateTrap() (uintptr, bool) {
+	switch runtime.GOARCH {
+	case "amd64":
+		return 319, true
+	case "arm64":
+		return 279, true
+	default:
+		return 0, false
+	}
+}
+
+// writeMemfd 将动态库写入匿名内存文件，不依赖临时目录是否可写、可执行
+//
+// output:
+//   - string(path) 可用于加载的文件路径
+//   - func()(cleanup) 加载完成后释放文件
+func writeMemfd(name string, data []byte) (string, func(), error) {
+	trap, ok := memfdCreateTrap()
+	if !ok {
+		return "", nil, errUnsupportedPlatform
+	}
+	cName, err := syscall.BytePtrFromString(name)
+	if err != nil {
+		return "", nil, err
+	}
+	fd, _, errno := syscall.Syscall(trap, uintptr(unsafe.Pointer(cName)), 0, 0)
+	if errno != 0 {
+		return "", nil, errno
+	}
+	file := os.NewFile(fd, name)
+	if _, err := file.Write(data); err != nil {
+		_ = file.Close()
+		return "", nil, err
+	}
+	return fmt.Sprintf("/proc/self/fd/%d", fd), func() { _ = file.Close() }, nil
+}
@@ -0,0 +1,55 @@
+//go:build cgo
+
+package rtdb_api
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestWriteTempLibrary(t *testing.T) {
+	dir := t.TempDir()
+	data := []byte("rtdb library")
+
+	path1, err := writeTempLibrary(dir, "librtdbapi.so", data)
+	if err != nil {
+		t.Fatal("写入临时动态库失败：", err)
+	}
+	path2, err := writeTempLibrary(dir, "librtdbapi.so", data)
+	if err != nil {
+		t.Fatal("写入临时动态库失败：", err)
+	}
+	if path1 == path2 {
+		t.Fatal("临时动态库文件名重复：", path1)
+	}
+	if filepath.Ext(path1) != ".so" {
+		t.Fatal("临时动态库扩展名错误：", path1)
+	}
+	if err := verifyFileChecksum(path1, sha256Hex(data)); err != nil {
+		t.Fatal("校验临时动态库失败：", err)
+	}
+
+	if err := os.WriteFile(path2, []byte("tampered"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := verifyFileChecksum(path2, sha256Hex(data)); err == nil {
+		t.Fatal("被修改的动态库应当校验失败")
+	}
+	if err := verifyFileChecksum(path1, "not-a-checksum"); err == nil {
+		t.Fatal("无效的校验值应当返回错误")
+	}
+}
+
+func TestLoadLibraryWithChecksum(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "librtdbapi.so")
+	if err := os.WriteFile(path, []byte("rtdb library"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := LoadLibraryWithChecksum(path, sha256Hex([]byte("other"))); err == nil {
+		t.Fatal("校验值不匹配时不应加载动态库")
+	}
+	if err := LoadLibrary(path); err == nil {
+		t.Fatal("无效的动态库应当加载失败")
+	}
+}
@@ -0,0 +1,10 @@
+//go:build windows && cgo
+
+// 一些跨平台函数
+
+package rtdb_api
+
+// writeMemfd Windows中不支持匿名内存文件，使用临时文件代替
+func writeMemfd(_name string, _data []byte) (string, func(), error) {
+	return "", nil, errUnsupportedPlatform
+}
@@ -0,0 +1,833 @@
+package rtdb_api
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// binaryVersion 二进制编码的版本号, 写在编码结果的第一个字节
+//   - 版本1: 时间戳为秒(varint)+纳秒(uvarint), 浮点数为IEEE 754, 整数为varint, 字符串与数据块带有长度前缀
+const binaryVersion = 1
+
+// qualityNames 数据库预定义质量码的名称
+var qualityNames = map[Quality]string{
+	QualityGood:      "good",
+	QualityNoData:    "no_data",
+	QualityCreated:   "created",
+	QualityShutdown:  "shutdown",
+	QualityCalcOff:   "calc_off",
+	QualityBad:       "bad",
+	QualityDivByZero: "div_by_zero",
+	QualityRemoved:   "removed",
+}
+
+// String 质量码名称, 非预定义的质量码为十进制数字
+func (q Quality) String() string {
+	if name, ok := qualityNames[q]; ok {
+		return name
+	}
+	return strconv.Itoa(int(q))
+}
+
+// MarshalText 实现 encoding.TextMarshaler, 参见 String
+func (q Quality) MarshalText() ([]byte, error) {
+	return []byte(q.String()), nil
+}
+
+// UnmarshalText 实现 encoding.TextUnmarshaler, 支持名称与十进制数字
+func (q *Quality) UnmarshalText(text []byte) error {
+	for value, name := range qualityNames {
+		if name == string(text) {
+			*q = value
+			return nil
+		}
+	}
+	value, err := strconv.ParseInt(string(text), 10, 16)
+	if err != nil {
+		return fmt.Errorf("未知的质量码%q", text)
+	}
+	*q = Quality(value)
+	return nil
+}
+
+// MarshalJSON 实现 json.Marshaler, 编码为字符串
+func (q Quality) MarshalJSON() ([]byte, error) {
+	return json.Marshal(q.String())
+}
+
+// UnmarshalJSON 实现 json.Unmarshaler, 支持字符串与数字
+func (q *Quality) UnmarshalJSON(data []byte) error {
+	return unmarshalJSONText(data, q)
+}
+
+// pointClassNames 标签点类别的名称
+var pointClassNames = map[PointClass]string{
+	PointBase:     "base",
+	PointScan:     "scan",
+	PointCalc:     "calc",
+	PointScanCalc: "scan_calc",
+}
+
+// String 标签点类别名称
+func (p PointClass) String() string {
+	if name, ok := pointClassNames[p]; ok {
+		return name
+	}
+	return strconv.Itoa(int(p))
+}
+
+// MarshalText 实现 encoding.TextMarshaler, 参见 String
+func (p PointClass) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+// UnmarshalText 实现 encoding.TextUnmarshaler, 支持名称与十进制数字
+func (p *PointClass) UnmarshalText(text []byte) error {
+	for value, name := range pointClassNames {
+		if name == string(text) {
+			*p = value
+			return nil
+		}
+	}
+	value, err := strconv.ParseInt(string(text), 10, 32)
+	if err != nil {
+		return fmt.Errorf("未知的标签点类别%q", text)
+	}
+	*p = PointClass(value)
+	return nil
+}
+
+// MarshalJSON 实现 json.Marshaler, 编码为字符串
+func (p PointClass) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.String())
+}
+
+// UnmarshalJSON 实现 json.Unmarshaler, 支持字符串与数字
+func (p *PointClass) UnmarshalJSON(data []byte) error {
+	return unmarshalJSONText(data, p)
+}
+
+// MarshalText 实现 encoding.TextMarshaler, 自定义类型为类型名称
+func (vt ValueType) MarshalText() ([]byte, error) {
+	if vt == "" {
+		return nil, errors.New("数值类型不能为空")
+	}
+	return []byte(vt), nil
+}
+
+// UnmarshalText 实现 encoding.TextUnmarshaler
+func (vt *ValueType) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		return errors.New("数值类型不能为空")
+	}
+	*vt = ValueType(text)
+	return nil
+}
+
+// MarshalJSON 实现 json.Marshaler
+func (vt ValueType) MarshalJSON() ([]byte, error) {
+	text, err := vt.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON 实现 json.Unmarshaler
+func (vt *ValueType) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err != nil {
+		return err
+	}
+	return vt.UnmarshalText([]byte(text))
+}
+
+// unmarshalJSONText 将JSON字符串或数字交给 UnmarshalText 处理
+func unmarshalJSONText(data []byte, v interface{ UnmarshalText([]byte) error }) error {
+	var text string
+	if len(data) != 0 && data[0] == '"' {
+		if err := json.Unmarshal(data, &text); err != nil {
+			return err
+		}
+	} else {
+		text = string(data)
+	}
+	return v.UnmarshalText([]byte(text))
+}
+
+// tvqJSON TVQ的JSON结构, 数值v的格式由type决定
+//   - bool: true/false
+//   - 整数与浮点数: 数字, 浮点数的NaN与±Inf为字符串"NaN"、"+Inf"、"-Inf"
+//   - coor: {"x":1,"y":2}
+//   - string与datetime: 字符串
+//   - blob与自定义类型: base64字符串
+type tvqJSON struct {
+	Timestamp time.Time       `json:"t"`
+	Type      ValueType       `json:"type"`
+	Value     json.RawMessage `json:"v"`
+	Quality   Quality         `json:"q"`
+}
+
+// coordinatesJSON 坐标的JSON结构
+type coordinatesJSON struct {
+	X float32 `json:"x"`
+	Y float32 `json:"y"`
+}
+
+// toJSON 转换成JSON结构
+func (v *TVQ) toJSON() (tvqJSON, error) {
+	var value any
+	rtdbType, _ := v.Type.ToRawType()
+	switch rtdbType {
+	case RtdbTypeBool:
+		value = Int64ToBool(v.Value.IntValue)
+	case RtdbTypeUint8, RtdbTypeInt8, RtdbTypeChar, RtdbTypeUint16, RtdbTypeInt16, RtdbTypeUint32, RtdbTypeInt32, RtdbTypeInt64:
+		value = v.Value.IntValue
+	case RtdbTypeReal16, RtdbTypeReal32, RtdbTypeReal64, RtdbTypeFp16, RtdbTypeFp32, RtdbTypeFp64:
+		f := v.Value.FloatValue
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			value = strconv.FormatFloat(f, 'g', -1, 64)
+		} else {
+			value = f
+		}
+	case RtdbTypeCoor:
+		value = coordinatesJSON{X: v.Value.CoordinatesValue.X, Y: v.Value.CoordinatesValue.Y}
+	case RtdbTypeString, RtdbTypeDatetime:
+		value = v.Value.StringValue
+	default:
+		value = v.Value.BytesValue
+	}
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return tvqJSON{}, err
+	}
+	return tvqJSON{Timestamp: v.Timestamp, Type: v.Type, Value: raw, Quality: v.Quality}, nil
+}
+
+// fromJSON 从JSON结构转换
+func (v *TVQ) fromJSON(j tvqJSON) error {
+	value := AnyValue{}
+	rtdbType, _ := j.Type.ToRawType()
+	var err error
+	switch rtdbType {
+	case RtdbTypeBool:
+		b := false
+		err = json.Unmarshal(j.Value, &b)
+		value.IntValue = BoolToInt64(b)
+	case RtdbTypeUint8, RtdbTypeInt8, RtdbTypeChar, RtdbTypeUint16, RtdbTypeInt16, RtdbTypeUint32, RtdbTypeInt32, RtdbTypeInt64:
+		err = json.Unmarshal(j.Value, &value.IntValue)
+	case RtdbTypeReal16, RtdbTypeReal32, RtdbTypeReal64, RtdbTypeFp16, RtdbTypeFp32, RtdbTypeFp64:
+		if len(j.Value) != 0 && j.Value[0] == '"' {
+			text := ""
+			if err = json.Unmarshal(j.Value, &text); err == nil {
+				value.FloatValue, err = strconv.ParseFloat(text, 64)
+			}
+		} else {
+			err = json.Unmarshal(j.Value, &value.FloatValue)
+		}
+	case RtdbTypeCoor:
+		xy := coordinatesJSON{}
+		err = json.Unmarshal(j.Value, &xy)
+		value.CoordinatesValue = Coordinates{X: xy.X, Y: xy.Y}
+	case RtdbTypeString, RtdbTypeDatetime:
+		err = json.Unmarshal(j.Value, &value.StringValue)
+	default:
+		err = json.Unmarshal(j.Value, &value.BytesValue)
+	}
+	if err != nil {
+		return fmt.Errorf("%s类型的数值格式错误: %w", j.Type, err)
+	}
+	*v = TVQ{Timestamp: j.Timestamp, Type: j.Type, Value: value, Quality: j.Quality}
+	return nil
+}
+
+// MarshalJSON 实现 json.Marshaler, 例如 {"t":"2023-11-14T22:13:20.000000123Z","type":"float64","v":21.5,"q":"good"}
+func (v TVQ) MarshalJSON() ([]byte, error) {
+	j, err := v.toJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(j)
+}
+
+// UnmarshalJSON 实现 json.Unmarshaler
+func (v *TVQ) UnmarshalJSON(data []byte) error {
+	j := tvqJSON{}
+	if err := json.Unmarshal(data, &j); err != nil {
+		return err
+	}
+	return v.fromJSON(j)
+}
+
+// ptvqJSON PTVQ的JSON结构, 只包含标签点ID、全名与时间戳精度
+type ptvqJSON struct {
+	ID        PointID       `json:"id"`
+	Tag       string        `json:"tag,omitempty"`
+	Precision RtdbPrecision `json:"precision"`
+	tvqJSON
+}
+
+// MarshalJSON 实现 json.Marshaler, 例如 {"id":1,"tag":"demo.temp","precision":3,"t":"...","type":"float64","v":21.5,"q":"good"}
+func (p PTVQ) MarshalJSON() ([]byte, error) {
+	j, err := p.TVQ.toJSON()
+	if err != nil {
+		return nil, err
+	}
+	rtn := ptvqJSON{tvqJSON: j}
+	if p.PointInfo != nil {
+		rtn.ID, rtn.Tag, rtn.Precision = p.PointInfo.ID, p.PointInfo.TableDotTag, p.PointInfo.Precision
+	}
+	return json.Marshal(rtn)
+}
+
+// UnmarshalJSON 实现 json.Unmarshaler
+//   - PointInfo 只包含ID、TableDotTag、Name、ValueType、Precision, 需要完整的标签点信息时请使用 GetPoint
+func (p *PTVQ) UnmarshalJSON(data []byte) error {
+	j := ptvqJSON{}
+	if err := json.Unmarshal(data, &j); err != nil {
+		return err
+	}
+	tvq := TVQ{}
+	if err := tvq.fromJSON(j.tvqJSON); err != nil {
+		return err
+	}
+	*p = PTVQ{PointInfo: partialPointInfo(j.ID, j.Tag, tvq.Type, j.Precision), TVQ: tvq}
+	return nil
+}
+
+// partialPointInfo 根据PTVQ中的标签点信息创建 PointInfo
+func partialPointInfo(id PointID, tag string, vt ValueType, precision RtdbPrecision) *PointInfo {
+	name := tag
+	if i := strings.LastIndex(tag, "."); i >= 0 {
+		name = tag[i+1:]
+	}
+	return &PointInfo{ID: id, Name: name, TableDotTag: tag, ValueType: vt, Precision: precision}
+}
+
+// pointInfoJSON PointInfo的JSON结构
+type pointInfoJSON struct {
+	ID        PointID       `json:"id"`
+	TableID   TableID       `json:"table_id"`
+	Name      string        `json:"name"`
+	ValueType ValueType     `json:"value_type"`
+	Class     PointClass    `json:"class"`
+	Precision RtdbPrecision `json:"precision"`
+
+	Desc           string     `json:"desc,omitempty"`
+	Unit           string     `json:"unit,omitempty"`
+	Archive        bool       `json:"archive"`
+	Digits         int16      `json:"digits"`
+	Shutdown       bool       `json:"shutdown"`
+	LowLimit       float32    `json:"low_limit"`
+	HighLimit      float32    `json:"high_limit"`
+	Step           bool       `json:"step"`
+	Typical        float32    `json:"typical"`
+	Compress       bool       `json:"compress"`
+	CompDev        float32    `json:"comp_dev"`
+	CompDevPercent float32    `json:"comp_dev_percent"`
+	CompTimeMax    int32      `json:"comp_time_max"`
+	CompTimeMin    int32      `json:"comp_time_min"`
+	ExcDev         float32    `json:"exc_dev"`
+	ExcDevPercent  float32    `json:"exc_dev_percent"`
+	ExcTimeMax     int32      `json:"exc_time_max"`
+	ExcTimeMin     int32      `json:"exc_time_min"`
+	Mirror         RtdbMirror `json:"mirror"`
+	Summary        bool       `json:"summary"`
+
+	Source     string                         `json:"source,omitempty"`
+	Scan       bool                           `json:"scan"`
+	Instrument string                         `json:"instrument,omitempty"`
+	Locations  [RtdbConstLocationsSize]int32  `json:"locations"`
+	UserInts   [RtdbConstUserintSize]int32    `json:"user_ints"`
+	UserReals  [RtdbConstUserrealSize]float32 `json:"user_reals"`
+
+	Equation string       `json:"equation,omitempty"`
+	Trigger  RtdbTrigger  `json:"trigger"`
+	TimeCopy RtdbTimeCopy `json:"time_copy"`
+	Period   int32        `json:"period"`
+
+	NamedType   *namedTypeJSON `json:"named_type,omitempty"`
+	TableDotTag string         `json:"table_dot_tag,omitempty"`
+	ChangeDate  DateTimeType   `json:"change_date,omitempty"`
+	Changer     string         `json:"changer,omitempty"`
+	CreateDate  DateTimeType   `json:"create_date,omitempty"`
+	Creator     string         `json:"creator,omitempty"`
+}
+
+// namedTypeJSON NamedType的JSON结构
+type namedTypeJSON struct {
+	Name   string           `json:"name"`
+	Desc   string           `json:"desc,omitempty"`
+	Length int32            `json:"length"`
+	Fields []namedFieldJSON `json:"fields"`
+}
+
+// namedFieldJSON RtdbDataTypeField的JSON结构
+type namedFieldJSON struct {
+	Name   string   `json:"name"`
+	Type   RtdbType `json:"type"`
+	Length int32    `json:"length"`
+	Desc   string   `json:"desc,omitempty"`
+}
+
+// switchToBool Switch转换成bool
+func switchToBool(s Switch) bool {
+	return s != OFF
+}
+
+// boolToSwitch bool转换成Switch
+func boolToSwitch(b bool) Switch {
+	if b {
+		return ON
+	}
+	return OFF
+}
+
+// MarshalJSON 实现 json.Marshaler, 字段名称为蛇形命名, Switch编码为bool
+func (p PointInfo) MarshalJSON() ([]byte, error) {
+	j := pointInfoJSON{
+		ID: p.ID, TableID: p.TableID, Name: p.Name, ValueType: p.ValueType, Class: p.Class, Precision: p.Precision,
+		Desc: p.Desc, Unit: p.Unit, Archive: switchToBool(p.Archive), Digits: p.Digits, Shutdown: switchToBool(p.Shutdown),
+		LowLimit: p.LowLimit, HighLimit: p.HighLimit, Step: switchToBool(p.Step), Typical: p.Typical,
+		Compress: switchToBool(p.Compress), CompDev: p.CompDev, CompDevPercent: p.CompDevPercent,
+		CompTimeMax: p.CompTimeMax, CompTimeMin: p.CompTimeMin, ExcDev: p.ExcDev, ExcDevPercent: p.ExcDevPercent,
+		ExcTimeMax: p.ExcTimeMax, ExcTimeMin: p.ExcTimeMin, Mirror: p.Mirror, Summary: switchToBool(p.Summary),
+		Source: p.Source, Scan: switchToBool(p.Scan), Instrument: p.Instrument,
+		Locations: p.Locations, UserInts: p.UserInts, UserReals: p.UserReals,
+		Equation: p.Equation, Trigger: p.Trigger, TimeCopy: p.TimeCopy, Period: p.Period,
+		TableDotTag: p.TableDotTag, ChangeDate: p.ChangeDate, Changer: p.Changer, CreateDate: p.CreateDate, Creator: p.Creator,
+	}
+	if p.NamedType.Name != "" || len(p.NamedType.Fields) != 0 {
+		j.NamedType = &namedTypeJSON{Name: p.NamedType.Name, Desc: p.NamedType.Desc, Length: p.NamedType.Length}
+		for _, field := range p.NamedType.Fields {
+			j.NamedType.Fields = append(j.NamedType.Fields, namedFieldJSON(field))
+		}
+	}
+	return json.Marshal(j)
+}
+
+// UnmarshalJSON 实现 json.Unmarshaler
+func (p *PointInfo) UnmarshalJSON(data []byte) error {
+	j := pointInfoJSON{}
+	if err := json.Unmarshal(data, &j); err != nil {
+		return err
+	}
+	*p = PointInfo{
+		ID: j.ID, TableID: j.TableID, Name: j.Name, ValueType: j.ValueType, Class: j.Class, Precision: j.Precision,
+		Desc: j.Desc, Unit: j.Unit, Archive: boolToSwitch(j.Archive), Digits: j.Digits, Shutdown: boolToSwitch(j.Shutdown),
+		LowLimit: j.LowLimit, HighLimit: j.HighLimit, Step: boolToSwitch(j.Step), Typical: j.Typical,
+		Compress: boolToSwitch(j.Compress), CompDev: j.CompDev, CompDevPercent: j.CompDevPercent,
+		CompTimeMax: j.CompTimeMax, CompTimeMin: j.CompTimeMin, ExcDev: j.ExcDev, ExcDevPercent: j.ExcDevPercent,
+		ExcTimeMax: j.ExcTimeMax, ExcTimeMin: j.ExcTimeMin, Mirror: j.Mirror, Summary: boolToSwitch(j.Summary),
+		Source: j.Source, Scan: boolToSwitch(j.Scan), Instrument: j.Instrument,
+		Locations: j.Locations, UserInts: j.UserInts, UserReals: j.UserReals,
+		Equation: j.Equation, Trigger: j.Trigger, TimeCopy: j.TimeCopy, Period: j.Period,
+		TableDotTag: j.TableDotTag, ChangeDate: j.ChangeDate, Changer: j.Changer, CreateDate: j.CreateDate, Creator: j.Creator,
+	}
+	if j.NamedType != nil {
+		p.NamedType = NamedType{Name: j.NamedType.Name, Desc: j.NamedType.Desc, Length: j.NamedType.Length}
+		for _, field := range j.NamedType.Fields {
+			p.NamedType.Fields = append(p.NamedType.Fields, RtdbDataTypeField(field))
+		}
+	}
+	return nil
+}
+
+// binaryWriter 二进制编码
+type binaryWriter struct {
+	buf []byte
+}
+
+// newBinaryWriter 创建二进制编码, 写入版本号
+func newBinaryWriter() *binaryWriter {
+	return &binaryWriter{buf: []byte{binaryVersion}}
+}
+
+func (w *binaryWriter) u8(v uint8) {
+	w.buf = append(w.buf, v)
+}
+
+func (w *binaryWriter) varint(v int64) {
+	w.buf = binary.AppendVarint(w.buf, v)
+}
+
+func (w *binaryWriter) uvarint(v uint64) {
+	w.buf = binary.AppendUvarint(w.buf, v)
+}
+
+func (w *binaryWriter) f32(v float32) {
+	w.buf = binary.LittleEndian.AppendUint32(w.buf, math.Float32bits(v))
+}
+
+func (w *binaryWriter) f64(v float64) {
+	w.buf = binary.LittleEndian.AppendUint64(w.buf, math.Float64bits(v))
+}
+
+func (w *binaryWriter) bytes(v []byte) {
+	w.uvarint(uint64(len(v)))
+	w.buf = append(w.buf, v...)
+}
+
+func (w *binaryWriter) str(v string) {
+	w.uvarint(uint64(len(v)))
+	w.buf = append(w.buf, v...)
+}
+
+func (w *binaryWriter) time(v time.Time) {
+	w.varint(v.Unix())
+	w.uvarint(uint64(v.Nanosecond()))
+}
+
+// errBinaryTruncated 二进制数据不完整
+var errBinaryTruncated = errors.New("二进制数据不完整")
+
+// binaryReader 二进制解码, 出错后的读取均返回零值, 最终通过 err 获取第一个错误
+type binaryReader struct {
+	data []byte
+	err  error
+}
+
+// newBinaryReader 创建二进制解码, 检查版本号
+func newBinaryReader(data []byte) *binaryReader {
+	r := &binaryReader{data: data}
+	if version := r.u8(); r.err == nil && version != binaryVersion {
+		r.err = fmt.Errorf("不支持的二进制编码版本%d", version)
+	}
+	return r
+}
+
+func (r *binaryReader) take(n int) []byte {
+	if r.err != nil {
+		return nil
+	}
+	if n < 0 || n > len(r.data) {
+		r.err = errBinaryTruncated
+		return nil
+	}
+	rtn := r.data[:n]
+	r.data = r.data[n:]
+	return rtn
+}
+
+func (r *binaryReader) u8() uint8 {
+	if b := r.take(1); b != nil {
+		return b[0]
+	}
+	return 0
+}
+
+func (r *binaryReader) varint(bits int) int64 {
+	if r.err != nil {
+		return 0
+	}
+	v, n := binary.Varint(r.data)
+	if n <= 0 {
+		r.err = errBinaryTruncated
+		return 0
+	}
+	if bits < 64 && (v < -1<<(bits-1) || v >= 1<<(bits-1)) {
+		r.err = fmt.Errorf("整数%d超过了%d位", v, bits)
+		return 0
+	}
+	r.data = r.data[n:]
+	return v
+}
+
+func (r *binaryReader) uvarint() uint64 {
+	if r.err != nil {
+		return 0
+	}
+	v, n := binary.Uvarint(r.data)
+	if n <= 0 {
+		r.err = errBinaryTruncated
+		return 0
+	}
+	r.data = r.data[n:]
+	return v
+}
+
+func (r *binaryReader) f32() float32 {
+	if b := r.take(4); b != nil {
+		return math.Float32frombits(binary.LittleEndian.Uint32(b))
+	}
+	return 0
+}
+
+func (r *binaryReader) f64() float64 {
+	if b := r.take(8); b != nil {
+		return math.Float64frombits(binary.LittleEndian.Uint64(b))
+	}
+	return 0
+}
+
+func (r *binaryReader) bytes() []byte {
+	n := r.uvarint()
+	if n > uint64(len(r.data)) {
+		r.err = errBinaryTruncated
+		return nil
+	}
+	if b := r.take(int(n)); b != nil {
+		return append([]byte(nil), b...)
+	}
+	return nil
+}
+
+func (r *binaryReader) str() string {
+	return string(r.bytes())
+}
+
+func (r *binaryReader) time() time.Time {
+	sec := r.varint(64)
+	nsec := r.uvarint()
+	if r.err == nil && nsec >= uint64(time.Second) {
+		r.err = fmt.Errorf("纳秒数%d超出范围", nsec)
+	}
+	return time.Unix(sec, int64(nsec))
+}
+
+// finish 结束解码, 检查是否有多余的数据
+func (r *binaryReader) finish() error {
+	if r.err == nil && len(r.data) != 0 {
+		r.err = fmt.Errorf("二进制数据有%d字节多余的数据", len(r.data))
+	}
+	return r.err
+}
+
+// writeTVQ 编码TVQ
+func (w *binaryWriter) writeTVQ(v *TVQ) {
+	w.str(string(v.Type))
+	w.time(v.Timestamp)
+	w.varint(int64(v.Quality))
+	rtdbType, _ := v.Type.ToRawType()
+	switch rtdbType {
+	case RtdbTypeBool, RtdbTypeUint8, RtdbTypeInt8, RtdbTypeChar, RtdbTypeUint16, RtdbTypeInt16, RtdbTypeUint32, RtdbTypeInt32, RtdbTypeInt64:
+		w.varint(v.Value.IntValue)
+	case RtdbTypeReal16, RtdbTypeReal32, RtdbTypeReal64, RtdbTypeFp16, RtdbTypeFp32, RtdbTypeFp64:
+		w.f64(v.Value.FloatValue)
+	case RtdbTypeCoor:
+		w.f32(v.Value.CoordinatesValue.X)
+		w.f32(v.Value.CoordinatesValue.Y)
+	case RtdbTypeString, RtdbTypeDatetime:
+		w.str(v.Value.StringValue)
+	default:
+		w.bytes(v.Value.BytesValue)
+	}
+}
+
+// readTVQ 解码TVQ
+func (r *binaryReader) readTVQ() TVQ {
+	v := TVQ{Type: ValueType(r.str())}
+	v.Timestamp = r.time()
+	v.Quality = Quality(r.varint(16))
+	rtdbType, _ := v.Type.ToRawType()
+	switch rtdbType {
+	case RtdbTypeBool, RtdbTypeUint8, RtdbTypeInt8, RtdbTypeChar, RtdbTypeUint16, RtdbTypeInt16, RtdbTypeUint32, RtdbTypeInt32, RtdbTypeInt64:
+		v.Value.IntValue = r.varint(64)
+	case RtdbTypeReal16, RtdbTypeReal32, RtdbTypeReal64, RtdbTypeFp16, RtdbTypeFp32, RtdbTypeFp64:
+		v.Value.FloatValue = r.f64()
+	case RtdbTypeCoor:
+		v.Value.CoordinatesValue.X = r.f32()
+		v.Value.CoordinatesValue.Y = r.f32()
+	case RtdbTypeString, RtdbTypeDatetime:
+		v.Value.StringValue = r.str()
+	default:
+		v.Value.BytesValue = r.bytes()
+	}
+	return v
+}
+
+// MarshalBinary 实现 encoding.BinaryMarshaler, 第一个字节为版本号, 纳秒时间戳与坐标均无损
+func (v TVQ) MarshalBinary() ([]byte, error) {
+	w := newBinaryWriter()
+	w.writeTVQ(&v)
+	return w.buf, nil
+}
+
+// UnmarshalBinary 实现 encoding.BinaryUnmarshaler
+func (v *TVQ) UnmarshalBinary(data []byte) error {
+	r := newBinaryReader(data)
+	tvq := r.readTVQ()
+	if err := r.finish(); err != nil {
+		return err
+	}
+	*v = tvq
+	return nil
+}
+
+// MarshalBinary 实现 encoding.BinaryMarshaler, 只包含标签点ID、全名与时间戳精度
+func (p PTVQ) MarshalBinary() ([]byte, error) {
+	w := newBinaryWriter()
+	info := PointInfo{}
+	if p.PointInfo != nil {
+		info = *p.PointInfo
+	}
+	w.varint(int64(info.ID))
+	w.str(info.TableDotTag)
+	w.varint(int64(info.Precision))
+	w.writeTVQ(&p.TVQ)
+	return w.buf, nil
+}
+
+// UnmarshalBinary 实现 encoding.BinaryUnmarshaler, PointInfo的内容参见 PTVQ.UnmarshalJSON
+func (p *PTVQ) UnmarshalBinary(data []byte) error {
+	r := newBinaryReader(data)
+	id := PointID(r.varint(32))
+	tag := r.str()
+	precision := RtdbPrecision(r.varint(8))
+	tvq := r.readTVQ()
+	if err := r.finish(); err != nil {
+		return err
+	}
+	*p = PTVQ{PointInfo: partialPointInfo(id, tag, tvq.Type, precision), TVQ: tvq}
+	return nil
+}
+
+// MarshalBinary 实现 encoding.BinaryMarshaler, 包含所有属性
+func (p PointInfo) MarshalBinary() ([]byte, error) {
+	w := newBinaryWriter()
+	w.varint(int64(p.ID))
+	w.varint(int64(p.TableID))
+	w.str(p.Name)
+	w.str(string(p.ValueType))
+	w.varint(int64(p.Class))
+	w.varint(int64(p.Precision))
+
+	w.str(p.Desc)
+	w.str(p.Unit)
+	w.u8(uint8(p.Archive))
+	w.varint(int64(p.Digits))
+	w.u8(uint8(p.Shutdown))
+	w.f32(p.LowLimit)
+	w.f32(p.HighLimit)
+	w.u8(uint8(p.Step))
+	w.f32(p.Typical)
+	w.u8(uint8(p.Compress))
+	w.f32(p.CompDev)
+	w.f32(p.CompDevPercent)
+	w.varint(int64(p.CompTimeMax))
+	w.varint(int64(p.CompTimeMin))
+	w.f32(p.ExcDev)
+	w.f32(p.ExcDevPercent)
+	w.varint(int64(p.ExcTimeMax))
+	w.varint(int64(p.ExcTimeMin))
+	w.varint(int64(p.Mirror))
+	w.u8(uint8(p.Summary))
+
+	w.str(p.Source)
+	w.u8(uint8(p.Scan))
+	w.str(p.Instrument)
+	for _, v := range p.Locations {
+		w.varint(int64(v))
+	}
+	for _, v := range p.UserInts {
+		w.varint(int64(v))
+	}
+	for _, v := range p.UserReals {
+		w.f32(v)
+	}
+
+	w.str(p.Equation)
+	w.u8(uint8(p.Trigger))
+	w.u8(uint8(p.TimeCopy))
+	w.varint(int64(p.Period))
+
+	w.str(p.NamedType.Name)
+	w.str(p.NamedType.Desc)
+	w.varint(int64(p.NamedType.Length))
+	w.uvarint(uint64(len(p.NamedType.Fields)))
+	for _, field := range p.NamedType.Fields {
+		w.str(field.Name)
+		w.varint(int64(field.Type))
+		w.varint(int64(field.Length))
+		w.str(field.Desc)
+	}
+	w.str(p.TableDotTag)
+	w.uvarint(uint64(p.ChangeDate))
+	w.str(p.Changer)
+	w.uvarint(uint64(p.CreateDate))
+	w.str(p.Creator)
+	return w.buf, nil
+}
+
+// UnmarshalBinary 实现 encoding.BinaryUnmarshaler
+func (p *PointInfo) UnmarshalBinary(data []byte) error {
+	r := newBinaryReader(data)
+	info := PointInfo{}
+	info.ID = PointID(r.varint(32))
+	info.TableID = TableID(r.varint(32))
+	info.Name = r.str()
+	info.ValueType = ValueType(r.str())
+	info.Class = PointClass(r.varint(32))
+	info.Precision = RtdbPrecision(r.varint(8))
+
+	info.Desc = r.str()
+	info.Unit = r.str()
+	info.Archive = Switch(r.u8())
+	info.Digits = int16(r.varint(16))
+	info.Shutdown = Switch(r.u8())
+	info.LowLimit = r.f32()
+	info.HighLimit = r.f32()
+	info.Step = Switch(r.u8())
+	info.Typical = r.f32()
+	info.Compress = Switch(r.u8())
+	info.CompDev = r.f32()
+	info.CompDevPercent = r.f32()
+	info.CompTimeMax = int32(r.varint(32))
+	info.CompTimeMin = int32(r.varint(32))
+	info.ExcDev = r.f32()
+	info.ExcDevPercent = r.f32()
+	info.ExcTimeMax = int32(r.varint(32))
+	info.ExcTimeMin = int32(r.varint(32))
+	info.Mirror = RtdbMirror(r.varint(8))
+	info.Summary = Switch(r.u8())
+
+	info.Source = r.str()
+	info.Scan = Switch(r.u8())
+	info.Instrument = r.str()
+	for i := range info.Locations {
+		info.Locations[i] = int32(r.varint(32))
+	}
+	for i := range info.UserInts {
+		info.UserInts[i] = int32(r.varint(32))
+	}
+	for i := range info.UserReals {
+		info.UserReals[i] = r.f32()
+	}
+
+	info.Equation = r.str()
+	info.Trigger = RtdbTrigger(r.u8())
+	info.TimeCopy = RtdbTimeCopy(r.u8())
+	info.Period = int32(r.varint(32))
+
+	info.NamedType.Name = r.str()
+	info.NamedType.Desc = r.str()
+	info.NamedType.Length = int32(r.varint(32))
+	count := r.uvarint()
+	if count > uint64(len(r.data)) {
+		r.err = errBinaryTruncated
+	}
+	for i := uint64(0); i < count && r.err == nil; i++ {
+		field := RtdbDataTypeField{}
+		field.Name = r.str()
+		field.Type = RtdbType(r.varint(32))
+		field.Length = int32(r.varint(32))
+		field.Desc = r.str()
+		info.NamedType.Fields = append(info.NamedType.Fields, field)
+	}
+	info.TableDotTag = r.str()
+	info.ChangeDate = DateTimeType(r.uvarint())
+	info.Changer = r.str()
+	info.CreateDate = DateTimeType(r.uvarint())
+	info.Creator = r.str()
+	if err := r.finish(); err != nil {
+		return err
+	}
+	*p = info
+	return nil
+}
@@ -0,0 +1,176 @@
+package rtdb_api
+
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TVQ与PTVQ的JSON与二进制编码
+func TestTVQ_Marshal(t *testing.T) {
+	now := time.Unix(1700000000, 123)
+	tvqs := []TVQ{
+		{Timestamp: now, Type: ValueTypeBool, Value: AnyValue{IntValue: 1}, Quality: QualityGood},
+		{Timestamp: now, Type: ValueTypeInt64, Value: AnyValue{IntValue: math.MinInt64}, Quality: QualityBad},
+		{Timestamp: now, Type: ValueTypeFloat64, Value: AnyValue{FloatValue: 21.5}, Quality: QualityOpc + 3},
+		{Timestamp: now, Type: ValueTypeFloat32, Value: AnyValue{FloatValue: math.Inf(-1)}, Quality: QualityGood},
+		{Timestamp: now, Type: ValueTypeCoor, Value: AnyValue{CoordinatesValue: Coordinates{X: 1.25, Y: -2}}, Quality: QualityGood},
+		{Timestamp: now, Type: ValueTypeString, Value: AnyValue{StringValue: "温度"}, Quality: QualityGood},
+		{Timestamp: now, Type: ValueTypeBlob, Value: AnyValue{BytesValue: []byte{0, 1, 2}}, Quality: QualityGood},
+		{Timestamp: now, Type: ValueType("sensor"), Value: AnyValue{BytesValue: []byte{9}}, Quality: QualityGood},
+	}
+	for _, tvq := range tvqs {
+		data, err := json.Marshal(tvq)
+		if err != nil {
+			t.Fatal(tvq.Type, err)
+		}
+		got := TVQ{}
+		if err := json.Unmarshal(data, &got); err != nil {
+			t.Fatal(tvq.Type, err)
+		}
+		if !tvqEqual(got, tvq) {
+			t.Errorf("JSON编码结果错误: %s\n%+v", data, got)
+		}
+
+		bin, err := tvq.MarshalBinary()
+		if err != nil {
+			t.Fatal(tvq.Type, err)
+		}
+		got = TVQ{}
+		if err := got.UnmarshalBinary(bin); err != nil {
+			t.Fatal(tvq.Type, err)
+		}
+		if !tvqEqual(got, tvq) {
+			t.Errorf("二进制编码结果错误: %+v", got)
+		}
+	}
+
+	nan := TVQ{Timestamp: now, Type: ValueTypeFloat64, Value: AnyValue{FloatValue: math.NaN()}}
+	data, err := json.Marshal(nan)
+	if err != nil || !strings.Contains(string(data), `"v":"NaN"`) || !strings.Contains(string(data), `"q":"good"`) {
+		t.Errorf("NaN编码错误: %s %v", data, err)
+	}
+	if err := json.Unmarshal(data, &nan); err != nil || !math.IsNaN(nan.Value.FloatValue) {
+		t.Error("NaN解码错误", err)
+	}
+	if err := json.Unmarshal([]byte(`{"t":"2023-11-14T22:13:20Z","type":"int32","v":"x","q":0}`), &nan); err == nil {
+		t.Error("期望数值格式错误")
+	}
+
+	info := &PointInfo{ID: 7, Name: "temp", TableDotTag: "demo.temp", ValueType: ValueTypeFloat64, Precision: RtdbPrecisionNano}
+	ptvq := PTVQ{PointInfo: info, TVQ: tvqs[2]}
+	data, err = json.Marshal(ptvq)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := PTVQ{}
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got.PointInfo, info) || !tvqEqual(got.TVQ, ptvq.TVQ) {
+		t.Errorf("PTVQ JSON编码结果错误: %s", data)
+	}
+	bin, err := ptvq.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	got = PTVQ{}
+	if err := got.UnmarshalBinary(bin); err != nil || !reflect.DeepEqual(got.PointInfo, info) || !tvqEqual(got.TVQ, ptvq.TVQ) {
+		t.Error("PTVQ二进制编码结果错误", got, err)
+	}
+
+	bin[0] = binaryVersion + 1
+	if err := got.UnmarshalBinary(bin); err == nil {
+		t.Error("期望不支持的版本")
+	}
+	if err := got.UnmarshalBinary(bin[:0]); err == nil {
+		t.Error("期望数据不完整")
+	}
+}
+
+// tvqEqual 比较TVQ, 时间戳只比较时刻不比较时区
+func tvqEqual(a, b TVQ) bool {
+	if !a.Timestamp.Equal(b.Timestamp) {
+		return false
+	}
+	a.Timestamp, b.Timestamp = time.Time{}, time.Time{}
+	return reflect.DeepEqual(a, b)
+}
+
+// PointInfo的JSON与二进制编码
+func TestPointInfo_Marshal(t *testing.T) {
+	info := NewPointInfo("temp", 3, ValueType("sensor"), PointScanCalc, RtdbPrecisionMicro, "℃", "温度")
+	info.ID = 12
+	info.TableDotTag = "demo.temp"
+	info.Archive = ON
+	info.LowLimit, info.HighLimit = -10, 120
+	info.Locations = [RtdbConstLocationsSize]int32{1, 2, 3, 4, 5}
+	info.UserReals = [RtdbConstUserrealSize]float32{0.5, -0.5}
+	info.Equation = "'demo.a' + 1"
+	info.NamedType = NamedType{Name: "sensor", Length: 4, Fields: []RtdbDataTypeField{{Name: "temp", Type: RtdbTypeReal32, Length: 4, Desc: "温度"}}}
+	info.CreateDate = 1700000000
+	info.Creator = "sa"
+
+	data, err := json.Marshal(info)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), `"class":"scan_calc"`) || !strings.Contains(string(data), `"table_dot_tag":"demo.temp"`) {
+		t.Errorf("JSON格式错误: %s", data)
+	}
+	got := PointInfo{}
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(&got, info) {
+		t.Errorf("JSON编码结果错误:\n%+v\n%+v", got, *info)
+	}
+
+	bin, err := info.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	got = PointInfo{}
+	if err := got.UnmarshalBinary(bin); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(&got, info) {
+		t.Errorf("二进制编码结果错误:\n%+v\n%+v", got, *info)
+	}
+	if err := got.UnmarshalBinary(bin[:len(bin)-1]); err == nil {
+		t.Error("期望数据不完整")
+	}
+	if err := got.UnmarshalBinary(append(bin, 0)); err == nil {
+		t.Error("期望多余的数据")
+	}
+}
+
+// 质量码与标签点类别的文本编码
+func TestQuality_Text(t *testing.T) {
+	var q Quality
+	if err := json.Unmarshal([]byte(`"div_by_zero"`), &q); err != nil || q != QualityDivByZero {
+		t.Error("质量码名称解码错误", q, err)
+	}
+	if err := json.Unmarshal([]byte(`513`), &q); err != nil || q != QualityUser+1 || q.String() != "513" {
+		t.Error("质量码数字解码错误", q, err)
+	}
+	if err := q.UnmarshalText([]byte("unknown")); err == nil {
+		t.Error("期望未知的质量码")
+	}
+
+	var p PointClass
+	if err := json.Unmarshal([]byte(`"calc"`), &p); err != nil || p != PointCalc {
+		t.Error("标签点类别解码错误", p, err)
+	}
+	if err := json.Unmarshal([]byte(`1`), &p); err != nil || p != PointScan {
+		t.Error("标签点类别数字解码错误", p, err)
+	}
+
+	var vt ValueType
+	if err := json.Unmarshal([]byte(`""`), &vt); err == nil {
+		t.Error("期望数值类型为空")
+	}
+}
@@ -0,0 +1,349 @@
+package rtdb_api
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// WriteBucketNumber 整数与浮点数, 对应 WriteSection 中的数值分组
+	WriteBucketNumber = "number"
+
+	// WriteBucketCoor 坐标
+	WriteBucketCoor = "coor"
+
+	// WriteBucketBlob String与Blob
+	WriteBucketBlob = "blob"
+
+	// WriteBucketNamed 自定义类型
+	WriteBucketNamed = "named"
+
+	// WriteBucketDatetime 日期
+	WriteBucketDatetime = "datetime"
+)
+
+// Metrics 客户端指标, 通过 RtdbConnect.SetMetrics 设置后由easy封装自动上报
+//   - 自定义实现可以对接任意指标库, 内置实现为 MetricsRegistry
+type Metrics interface {
+	// PointsWritten 写入成功的点值数量
+	//   - bucket 类型分组, WriteBucketNumber 等
+	PointsWritten(bucket string, count int)
+
+	// ArchiveFallbacks 因 RteTimestampEarlierThanSnapshot 改为写入存档的点值数量
+	ArchiveFallbacks(bucket string, count int)
+
+	// Failure 失败的操作, 批量操作中每个失败的条目记录一次
+	Failure(op string, rte RtdbError)
+
+	// ReadLatency 读取历史数据的耗时
+	ReadLatency(mode RtdbHisMode, latency time.Duration)
+
+	// SubscriptionEvents 收到的订阅事件
+	//   - count 事件中的标签点数量
+	//   - lag 事件中数值时间戳与接收时间的最大差值, 非数据事件为0
+	SubscriptionEvents(event RtdbEventType, count int, lag time.Duration)
+}
+
+// SetMetrics 设置客户端指标, 为nil时不统计
+func (c *RtdbConnect) SetMetrics(m Metrics) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.metrics = m
+}
+
+// getMetrics 获取客户端指标
+func (c *RtdbConnect) getMetrics() Metrics {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.metrics
+}
+
+// opError 将RtdbError转换成带有操作信息的 error, 并记录失败指标
+func (c *RtdbConnect) opError(op string, rte RtdbError, id PointID) error {
+	err := newOpError(op, rte, id)
+	if err != nil {
+		if m := c.getMetrics(); m != nil {
+			m.Failure(op, rte)
+		}
+	}
+	return err
+}
+
+// opErrors 批量转换RtdbError, 并记录失败指标
+func (c *RtdbConnect) opErrors(op string, ids []PointID, rtes []RtdbError) []error {
+	errs := newOpErrors(op, ids, rtes)
+	if m := c.getMetrics(); m != nil {
+		for _, rte := range rtes {
+			if !RteIsOk(rte) {
+				m.Failure(op, rte)
+			}
+		}
+	}
+	return errs
+}
+
+// observeWrite 记录写入指标
+func (c *RtdbConnect) observeWrite(bucket string, rtes []RtdbError, fallbacks int) {
+	m := c.getMetrics()
+	if m == nil {
+		return
+	}
+	count := 0
+	for _, rte := range rtes {
+		if RteIsOk(rte) {
+			count++
+		}
+	}
+	m.PointsWritten(bucket, count)
+	if fallbacks != 0 {
+		m.ArchiveFallbacks(bucket, fallbacks)
+	}
+}
+
+// observeRead 记录读取耗时, 配合 defer 使用
+func (c *RtdbConnect) observeRead(mode RtdbHisMode, start time.Time) {
+	if m := c.getMetrics(); m != nil {
+		m.ReadLatency(mode, time.Since(start))
+	}
+}
+
+// DefaultLatencyBuckets 默认的耗时直方图分桶(秒)
+var DefaultLatencyBuckets = []float64{0.0005, 0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// MetricsRegistry 进程内的指标注册表, 实现 Metrics 接口, 并以Prometheus文本格式输出
+//   - rtdb_points_written_total{type} 写入成功的点值数量
+//   - rtdb_archive_fallbacks_total{type} 改为写入存档的点值数量
+//   - rtdb_failures_total{op,code,category} 失败次数
+//   - rtdb_read_latency_seconds{mode} 读取历史数据的耗时
+//   - rtdb_subscription_events_total{event} 订阅事件数量
+//   - rtdb_subscription_points_total{event} 订阅事件中的标签点数量
+//   - rtdb_subscription_lag_seconds 订阅数据的延迟
+type MetricsRegistry struct {
+	mu                 sync.Mutex
+	pointsWritten      *counterVec
+	archiveFallbacks   *counterVec
+	failures           *counterVec
+	readLatency        *histogramVec
+	subscriptionEvents *counterVec
+	subscriptionPoints *counterVec
+	subscriptionLag    *histogramVec
+}
+
+// NewMetricsRegistry 创建指标注册表
+func NewMetricsRegistry() *MetricsRegistry {
+	return &MetricsRegistry{
+		pointsWritten:      newCounterVec("rtdb_points_written_total", "Number of values written successfully, by type bucket.", "type"),
+		archiveFallbacks:   newCounterVec("rtdb_archive_fallbacks_total", "Number of values written to the archive because they were earlier than the snapshot.", "type"),
+		failures:           newCounterVec("rtdb_failures_total", "Number of failed operations, by operation and error code.", "op", "code", "category"),
+		readLatency:        newHistogramVec("rtdb_read_latency_seconds", "Latency of history reads, by history mode.", DefaultLatencyBuckets, "mode"),
+		subscriptionEvents: newCounterVec("rtdb_subscription_events_total", "Number of subscription events received, by event type.", "event"),
+		subscriptionPoints: newCounterVec("rtdb_subscription_points_total", "Number of points carried by subscription events, by event type.", "event"),
+		subscriptionLag:    newHistogramVec("rtdb_subscription_lag_seconds", "Delay between value timestamps and subscription event delivery.", DefaultLatencyBuckets),
+	}
+}
+
+func (r *MetricsRegistry) PointsWritten(bucket string, count int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.pointsWritten.add(float64(count), bucket)
+}
+
+func (r *MetricsRegistry) ArchiveFallbacks(bucket string, count int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.archiveFallbacks.add(float64(count), bucket)
+}
+
+func (r *MetricsRegistry) Failure(op string, rte RtdbError) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.failures.add(1, op, fmt.Sprintf("0x%08X", uint32(rte)), rte.Category().String())
+}
+
+func (r *MetricsRegistry) ReadLatency(mode RtdbHisMode, latency time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.readLatency.observe(latency.Seconds(), mode.String())
+}
+
+func (r *MetricsRegistry) SubscriptionEvents(event RtdbEventType, count int, lag time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.subscriptionEvents.add(1, event.String())
+	r.subscriptionPoints.add(float64(count), event.String())
+	if event == RtdbEventData {
+		r.subscriptionLag.observe(lag.Seconds())
+	}
+}
+
+// WriteText 以Prometheus文本格式(0.0.4)输出所有指标
+func (r *MetricsRegistry) WriteText(w io.Writer) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	bw := bufio.NewWriter(w)
+	r.pointsWritten.write(bw)
+	r.archiveFallbacks.write(bw)
+	r.failures.write(bw)
+	r.readLatency.write(bw)
+	r.subscriptionEvents.write(bw)
+	r.subscriptionPoints.write(bw)
+	r.subscriptionLag.write(bw)
+	return bw.Flush()
+}
+
+// ServeHTTP 实现 http.Handler, 可以直接挂载到 /metrics
+func (r *MetricsRegistry) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	_ = r.WriteText(w)
+}
+
+// counterVec 带标签的计数器
+type counterVec struct {
+	name   string
+	help   string
+	labels []string
+	values map[string]float64
+}
+
+// newCounterVec 创建计数器
+func newCounterVec(name string, help string, labels ...string) *counterVec {
+	return &counterVec{name: name, help: help, labels: labels, values: make(map[string]float64)}
+}
+
+// add 增加计数
+func (v *counterVec) add(delta float64, labelValues ...string) {
+	v.values[formatLabels(v.labels, labelValues)] += delta
+}
+
+// write 输出计数器
+func (v *counterVec) write(w *bufio.Writer) {
+	_, _ = fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s counter\n", v.name, v.help, v.name)
+	for _, key := range sortedKeys(v.values) {
+		_, _ = fmt.Fprintf(w, "%s%s %s\n", v.name, key, formatFloat(v.values[key]))
+	}
+}
+
+// histogram 直方图
+type histogram struct {
+	counts []uint64 // 每个分桶的数量(非累计)
+	count  uint64
+	sum    float64
+}
+
+// histogramVec 带标签的直方图
+type histogramVec struct {
+	name    string
+	help    string
+	buckets []float64
+	labels  []string
+	values  map[string]*histogram
+	keys    map[string][]string
+}
+
+// newHistogramVec 创建直方图
+func newHistogramVec(name string, help string, buckets []float64, labels ...string) *histogramVec {
+	return &histogramVec{
+		name:    name,
+		help:    help,
+		buckets: buckets,
+		labels:  labels,
+		values:  make(map[string]*histogram),
+		keys:    make(map[string][]string),
+	}
+}
+
+// observe 记录一个样本
+func (v *histogramVec) observe(value float64, labelValues ...string) {
+	key := formatLabels(v.labels, labelValues)
+	h, ok := v.values[key]
+	if !ok {
+		h = &histogram{counts: make([]uint64, len(v.buckets))}
+		v.values[key] = h
+		v.keys[key] = labelValues
+	}
+	for i, bound := range v.buckets {
+		if value <= bound {
+			h.counts[i]++
+			break
+		}
+	}
+	h.count++
+	h.sum += value
+}
+
+// write 输出直方图
+func (v *histogramVec) write(w *bufio.Writer) {
+	_, _ = fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s histogram\n", v.name, v.help, v.name)
+	keys := make([]string, 0, len(v.values))
+	for key := range v.values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	labels := append(append([]string(nil), v.labels...), "le")
+	for _, key := range keys {
+		h, labelValues := v.values[key], v.keys[key]
+		cumulative := uint64(0)
+		for i, bound := range v.buckets {
+			cumulative += h.counts[i]
+			le := formatLabels(labels, append(append([]string(nil), labelValues...), formatFloat(bound)))
+			_, _ = fmt.Fprintf(w, "%s_bucket%s %d\n", v.name, le, cumulative)
+		}
+		inf := formatLabels(labels, append(append([]string(nil), labelValues...), "+Inf"))
+		_, _ = fmt.Fprintf(w, "%s_bucket%s %d\n", v.name, inf, h.count)
+		_, _ = fmt.Fprintf(w, "%s_sum%s %s\n", v.name, key, formatFloat(h.sum))
+		_, _ = fmt.Fprintf(w, "%s_count%s %d\n", v.name, key, h.count)
+	}
+}
+
+// labelValueEscaper Prometheus文本格式的标签值转义, 只转义反斜杠、双引号和换行, 其他字符按UTF-8原样输出
+var labelValueEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// formatLabels 格式化标签, 例如 {type="number"}
+func formatLabels(names []string, values []string) string {
+	if len(names) == 0 {
+		return ""
+	}
+	b := strings.Builder{}
+	b.WriteByte('{')
+	for i, name := range names {
+		if i != 0 {
+			b.WriteByte(',')
+		}
+		value := ""
+		if i < len(values) {
+			value = values[i]
+		}
+		b.WriteString(name)
+		b.WriteString("=\"")
+		b.WriteString(labelValueEscaper.Replace(value))
+		b.WriteByte('"')
+	}
+	b.WriteByte('}')
+	return b.String()
+}
+
+// formatFloat 格式化数值
+func formatFloat(value float64) string {
+	if math.IsInf(value, 1) {
+		return "+Inf"
+	}
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
+
+// sortedKeys 获取排好序的键
+func sortedKeys(m map[string]float64) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,74 @@
+package rtdb_api
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+// 写入、存档回退、失败与读取耗时指标
+func TestMetricsRegistry(t *testing.T) {
+	conn, err := LoginWithBackend(NewMemoryBackend(), "127.0.0.1", Port, Username, Password)
+	if err != nil {
+		t.Fatal("登录用户失败", err)
+	}
+	defer func() { _ = conn.Logout() }()
+
+	registry := NewMetricsRegistry()
+	conn.SetMetrics(registry)
+
+	table, err := conn.CreateTable("metrics", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	info, err := conn.AddPoint(NewPointInfo("temp", table.ID, ValueTypeFloat32, PointBase, RtdbPrecisionMilli, "", ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Unix(1700000000, 0)
+	tvqs := []TVQ{NewTvqFloat32(now, 1, QualityGood), NewTvqFloat32(now.Add(time.Second), 2, QualityGood)}
+	if _, err := conn.WriteValues(info, false, tvqs); err != nil {
+		t.Fatal(err)
+	}
+	if err := conn.WriteValue(info, false, NewTvqFloat32(now.Add(-time.Second), 0, QualityGood)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := conn.ReadValue(info, RtdbHisModeExact, now); err != nil {
+		t.Fatal(err)
+	}
+	_, _ = conn.GetPoint(1000)
+	registry.SubscriptionEvents(RtdbEventData, 3, 20*time.Millisecond)
+
+	buf := &bytes.Buffer{}
+	if err := registry.WriteText(buf); err != nil {
+		t.Fatal(err)
+	}
+	text := buf.String()
+	for _, line := range []string{
+		`rtdb_points_written_total{type="number"} 3`,
+		`rtdb_archive_fallbacks_total{type="number"} 1`,
+		`rtdb_failures_total{op="GetPoint",code="0xFFFF000D",category="not-found"} 1`,
+		`rtdb_read_latency_seconds_count{mode="exact"} 1`,
+		`rtdb_subscription_events_total{event="data"} 1`,
+		`rtdb_subscription_points_total{event="data"} 3`,
+		`rtdb_subscription_lag_seconds_bucket{le="0.025"} 1`,
+		`rtdb_subscription_lag_seconds_bucket{le="+Inf"} 1`,
+	} {
+		if !strings.Contains(text, line+"\n") {
+			t.Errorf("缺少指标 %s\n%s", line, text)
+		}
+	}
+}
+
+// 标签值按Prometheus文本格式转义, 非ASCII字符原样输出
+func TestFormatLabels(t *testing.T) {
+	got := formatLabels([]string{"op", "desc"}, []string{"写入\t", "a\\b\"c\nd"})
+	want := "{op=\"写入\t\",desc=\"a\\\\b\\\"c\\nd\"}"
+	if got != want {
+		t.Errorf("标签格式错误 %s, 期望 %s", got, want)
+	}
+	if formatLabels(nil, nil) != "" {
+		t.Error("没有标签时应为空")
+	}
+}
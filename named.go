@@ -0,0 +1,674 @@
+package rtdb_api
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ErrNamedTypeMismatch 结构体与服务端的自定义类型定义不一致
+var ErrNamedTypeMismatch = errors.New("结构体与自定义类型不一致")
+
+// namedTypeTag 自定义类型的结构体标签, 格式为 `rtdb:"名称,类型,长度"`
+//   - 名称为空时使用Go字段名称, 为"-"时忽略该字段, 未导出的字段同样忽略
+//   - 类型为 ValueType 的名称, 为空时根据Go类型推断, float32推断为float32, string推断为string, []byte与[N]byte推断为blob
+//   - 长度只对string与blob有效, [N]byte的长度默认为N
+//   - desc 标签为字段描述, 只在 NamedTypeOf 中使用
+//   - 数值为本包自己的编码: 按照字段顺序紧密排列, 使用小端字节序, 长度为所有字段长度的累加和;
+//     没有与服务端的内存布局校验过, 需要与其他客户端交换数值时可以通过 NamedValue 按字段存取
+//   - string字段不足长度时以0填充, 解码时去掉末尾的0
+//
+// 例如:
+//
+//	type Sensor struct {
+//		Temp   float32 `rtdb:"temp,float32" desc:"温度"`
+//		Level  float32 `rtdb:"level,float16"`
+//		State  uint8   `rtdb:"state,char"`
+//		Name   string  `rtdb:"name,string,16"`
+//		Data   [8]byte `rtdb:"data"`
+//		Ignore int     `rtdb:"-"`
+//	}
+const namedTypeTag = "rtdb"
+
+// namedField 结构体字段与自定义类型字段的对应关系
+type namedField struct {
+	index  []int             // 结构体字段的索引
+	field  RtdbDataTypeField // 自定义类型字段
+	offset int               // 字段在数值中的偏移
+}
+
+// namedLayout 结构体对应的自定义类型布局
+type namedLayout struct {
+	fields []namedField
+	length int
+}
+
+// namedLayouts 结构体类型 -> *namedLayout
+var namedLayouts sync.Map
+
+var coordinatesType = reflect.TypeOf(Coordinates{})
+
+// RtdbTypeSize 基本类型的字节长度, string、blob、datetime与自定义类型的长度不固定, 返回0
+func RtdbTypeSize(typ RtdbType) int32 {
+	switch typ {
+	case RtdbTypeBool, RtdbTypeUint8, RtdbTypeInt8, RtdbTypeChar:
+		return 1
+	case RtdbTypeUint16, RtdbTypeInt16, RtdbTypeReal16, RtdbTypeFp16:
+		return 2
+	case RtdbTypeUint32, RtdbTypeInt32, RtdbTypeReal32, RtdbTypeFp32:
+		return 4
+	case RtdbTypeInt64, RtdbTypeReal64, RtdbTypeCoor, RtdbTypeFp64:
+		return 8
+	default:
+		return 0
+	}
+}
+
+// NamedTypeOf 根据结构体定义生成自定义类型, 配合 AddNamedType 使用
+//
+// input:
+//   - name 自定义类型名称
+//   - desc 自定义类型描述
+//   - v 结构体或结构体指针
+//
+// output:
+//   - *NamedType(typ) 自定义类型
+func NamedTypeOf(name string, desc string, v any) (*NamedType, error) {
+	layout, err := namedLayoutOf(reflect.TypeOf(v))
+	if err != nil {
+		return nil, err
+	}
+	fields := make([]RtdbDataTypeField, len(layout.fields))
+	for i, f := range layout.fields {
+		fields[i] = f.field
+	}
+	return &NamedType{Name: name, Fields: fields, Desc: desc, Length: int32(layout.length)}, nil
+}
+
+// ValidateNamedType 检查结构体与自定义类型的字段名称、类型、长度是否一致
+//
+// input:
+//   - typ 自定义类型, 通常来自 GetNamedType
+//   - v 结构体或结构体指针
+func ValidateNamedType(typ *NamedType, v any) error {
+	layout, err := namedLayoutOf(reflect.TypeOf(v))
+	if err != nil {
+		return err
+	}
+	if len(layout.fields) != len(typ.Fields) {
+		return fmt.Errorf("%w: 自定义类型%s有%d个字段, 结构体有%d个字段", ErrNamedTypeMismatch, typ.Name, len(typ.Fields), len(layout.fields))
+	}
+	for i, f := range layout.fields {
+		expect := typ.Fields[i]
+		if !strings.EqualFold(expect.Name, f.field.Name) {
+			return fmt.Errorf("%w: 第%d个字段应为%s, 结构体中为%s", ErrNamedTypeMismatch, i, expect.Name, f.field.Name)
+		}
+		if expect.Type != f.field.Type {
+			return fmt.Errorf("%w: 字段%s的类型应为%s, 结构体中为%s", ErrNamedTypeMismatch, expect.Name, FromRawType(expect.Type, ""), FromRawType(f.field.Type, ""))
+		}
+		if expect.Length != 0 && expect.Length != f.field.Length {
+			return fmt.Errorf("%w: 字段%s的长度应为%d, 结构体中为%d", ErrNamedTypeMismatch, expect.Name, expect.Length, f.field.Length)
+		}
+	}
+	if typ.Length != 0 && int(typ.Length) != layout.length {
+		return fmt.Errorf("%w: 自定义类型%s的长度为%d, 结构体为%d", ErrNamedTypeMismatch, typ.Name, typ.Length, layout.length)
+	}
+	return nil
+}
+
+// MarshalNamedType 将结构体编码为自定义类型数值, 编码方式参见 namedTypeTag, 可以直接用于 NewTvqNamed
+//
+// input:
+//   - v 结构体或结构体指针
+//
+// output:
+//   - []byte(data) 自定义类型数值
+func MarshalNamedType(v any) ([]byte, error) {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return nil, errors.New("不能编码空指针")
+		}
+		rv = rv.Elem()
+	}
+	layout, err := namedLayoutOf(rv.Type())
+	if err != nil {
+		return nil, err
+	}
+	data := make([]byte, layout.length)
+	for _, f := range layout.fields {
+		if err := encodeNamedField(data[f.offset:f.offset+int(f.field.Length)], f.field, rv.FieldByIndex(f.index)); err != nil {
+			return nil, err
+		}
+	}
+	return data, nil
+}
+
+// UnmarshalNamedType 将自定义类型数值解码到结构体
+//
+// input:
+//   - data 自定义类型数值, 通常来自 TVQ.Value.BytesValue
+//   - v 结构体指针
+func UnmarshalNamedType(data []byte, v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return errors.New("解码的目标必须是非空的结构体指针")
+	}
+	rv = rv.Elem()
+	layout, err := namedLayoutOf(rv.Type())
+	if err != nil {
+		return err
+	}
+	if len(data) < layout.length {
+		return fmt.Errorf("自定义类型数值长度为%d, 结构体需要%d", len(data), layout.length)
+	}
+	for _, f := range layout.fields {
+		decodeNamedField(data[f.offset:f.offset+int(f.field.Length)], f.field, rv.FieldByIndex(f.index))
+	}
+	return nil
+}
+
+// AddNamedTypeOf 根据结构体定义创建自定义类型, 参见 NamedTypeOf
+//
+// input:
+//   - name 自定义类型名称
+//   - desc 自定义类型描述
+//   - v 结构体或结构体指针
+func (c *RtdbConnect) AddNamedTypeOf(name string, desc string, v any) error {
+	typ, err := NamedTypeOf(name, desc, v)
+	if err != nil {
+		return err
+	}
+	return c.AddNamedType(typ.Name, typ.Desc, typ.Fields...)
+}
+
+// CheckNamedType 检查结构体与服务端的自定义类型是否一致, 参见 ValidateNamedType
+//
+// input:
+//   - name 自定义类型名称
+//   - v 结构体或结构体指针
+func (c *RtdbConnect) CheckNamedType(name string, v any) error {
+	typ, err := c.GetNamedType(name)
+	if err != nil {
+		return err
+	}
+	return ValidateNamedType(typ, v)
+}
+
+// CheckNamedTypeName 检查自定义类型名称或字段名称是否符合规则
+//   - 只允许使用26个英文字母、数字0-9、下划线, 必须以字母作为首字母, 大小写不敏感
+func (c *RtdbConnect) CheckNamedTypeName(name string) error {
+	rte := c.backend.RawRtdbNamedTypeNameFieldCheckWarp(name, 0)
+	return c.opError("CheckNamedTypeName", rte, 0)
+}
+
+// NamedValue 自定义类型数值, 通过API库提供的字段读写函数按字段存取
+type NamedValue struct {
+	conn *RtdbConnect
+	typ  *NamedType
+	data []byte
+}
+
+// NewNamedValue 创建自定义类型数值
+//
+// input:
+//   - typeName 自定义类型名称
+//   - data 自定义类型数值, 为nil时创建全0的数值, 不为nil时复制一份, 之后修改data不影响 NamedValue
+func (c *RtdbConnect) NewNamedValue(typeName string, data []byte) (*NamedValue, error) {
+	typ, err := c.GetNamedType(typeName)
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		data = make([]byte, typ.Length)
+	} else if len(data) != int(typ.Length) {
+		return nil, fmt.Errorf("自定义类型%s的长度为%d, 数值长度为%d", typeName, typ.Length, len(data))
+	} else {
+		data = bytes.Clone(data)
+	}
+	return &NamedValue{conn: c, typ: typ, data: data}, nil
+}
+
+// Type 自定义类型
+func (v *NamedValue) Type() *NamedType {
+	return v.typ
+}
+
+// Bytes 自定义类型数值, 可以直接用于 NewTvqNamed
+func (v *NamedValue) Bytes() []byte {
+	return v.data
+}
+
+// Get 按名称读取字段, 返回值的Go类型参见 PointValue, float16读取为float32
+//
+// input:
+//   - name 字段名称, 大小写不敏感
+func (v *NamedValue) Get(name string) (any, error) {
+	pos, err := v.fieldPos(name)
+	if err != nil {
+		return nil, err
+	}
+	return v.GetAt(pos)
+}
+
+// Set 按名称填充字段
+//
+// input:
+//   - name 字段名称, 大小写不敏感
+//   - value 字段数值, Go类型需与字段类型一致, 参见 PointValue
+func (v *NamedValue) Set(name string, value any) error {
+	pos, err := v.fieldPos(name)
+	if err != nil {
+		return err
+	}
+	return v.SetAt(pos, value)
+}
+
+// GetAt 按位置读取字段
+//
+// input:
+//   - pos 字段位置, 从0开始
+func (v *NamedValue) GetAt(pos int) (any, error) {
+	field, err := v.fieldAt(pos)
+	if err != nil {
+		return nil, err
+	}
+	buf, rte := v.conn.backend.RawRtdbReadNamedTypeFieldByPos32Warp(v.conn.handle(), v.typ.Name, int32(pos), field.Type, v.data, field.Length)
+	if !RteIsOk(rte) {
+		return nil, v.conn.opError("NamedValue.Get", rte, 0)
+	}
+	t := namedFieldGoType(field.Type)
+	if t == nil {
+		return nil, fmt.Errorf("字段%s: 不支持的数值类型%s", field.Name, FromRawType(field.Type, ""))
+	}
+	rv := reflect.New(t).Elem()
+	decodeNamedField(buf, field, rv)
+	return rv.Interface(), nil
+}
+
+// SetAt 按位置填充字段
+//
+// input:
+//   - pos 字段位置, 从0开始
+//   - value 字段数值
+func (v *NamedValue) SetAt(pos int, value any) error {
+	field, err := v.fieldAt(pos)
+	if err != nil {
+		return err
+	}
+	rv := reflect.ValueOf(value)
+	if !rv.IsValid() || !goTypeMatches(field.Type, rv.Type()) {
+		return fmt.Errorf("字段%s: Go类型%T不能对应数值类型%s", field.Name, value, FromRawType(field.Type, ""))
+	}
+	buf := make([]byte, field.Length)
+	if err := encodeNamedField(buf, field, rv); err != nil {
+		return err
+	}
+	rte := v.conn.backend.RawRtdbWriteNamedTypeFieldByPos32Warp(v.conn.handle(), v.typ.Name, int32(pos), field.Type, v.data, buf)
+	return v.conn.opError("NamedValue.Set", rte, 0)
+}
+
+// fieldPos 按名称查找字段位置
+func (v *NamedValue) fieldPos(name string) (int, error) {
+	for i, field := range v.typ.Fields {
+		if strings.EqualFold(field.Name, name) {
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("自定义类型%s没有字段%s", v.typ.Name, name)
+}
+
+// fieldAt 按位置获取字段定义, 基本类型的长度由类型决定
+func (v *NamedValue) fieldAt(pos int) (RtdbDataTypeField, error) {
+	if pos < 0 || pos >= len(v.typ.Fields) {
+		return RtdbDataTypeField{}, fmt.Errorf("自定义类型%s没有第%d个字段", v.typ.Name, pos)
+	}
+	field := v.typ.Fields[pos]
+	if size := RtdbTypeSize(field.Type); size != 0 {
+		field.Length = size
+	}
+	return field, nil
+}
+
+// namedFieldGoType 字段类型对应的Go类型
+func namedFieldGoType(typ RtdbType) reflect.Type {
+	switch typ {
+	case RtdbTypeBool:
+		return reflect.TypeOf(false)
+	case RtdbTypeUint8, RtdbTypeChar:
+		return reflect.TypeOf(uint8(0))
+	case RtdbTypeInt8:
+		return reflect.TypeOf(int8(0))
+	case RtdbTypeUint16:
+		return reflect.TypeOf(uint16(0))
+	case RtdbTypeInt16:
+		return reflect.TypeOf(int16(0))
+	case RtdbTypeUint32:
+		return reflect.TypeOf(uint32(0))
+	case RtdbTypeInt32:
+		return reflect.TypeOf(int32(0))
+	case RtdbTypeInt64:
+		return reflect.TypeOf(int64(0))
+	case RtdbTypeReal16, RtdbTypeReal32:
+		return reflect.TypeOf(float32(0))
+	case RtdbTypeReal64:
+		return reflect.TypeOf(float64(0))
+	case RtdbTypeCoor:
+		return coordinatesType
+	case RtdbTypeString:
+		return reflect.TypeOf("")
+	case RtdbTypeBlob:
+		return reflect.TypeOf([]byte(nil))
+	default:
+		return nil
+	}
+}
+
+// namedLayoutOf 解析结构体的自定义类型布局, 结果会被缓存
+func namedLayoutOf(t reflect.Type) (*namedLayout, error) {
+	for t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("自定义类型只支持结构体, 实际为%v", t)
+	}
+	if layout, ok := namedLayouts.Load(t); ok {
+		return layout.(*namedLayout), nil
+	}
+
+	layout := &namedLayout{}
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		tag := sf.Tag.Get(namedTypeTag)
+		if !sf.IsExported() || tag == "-" {
+			continue
+		}
+		field, err := parseNamedField(sf, tag)
+		if err != nil {
+			return nil, err
+		}
+		layout.fields = append(layout.fields, namedField{index: sf.Index, field: field, offset: layout.length})
+		layout.length += int(field.Length)
+	}
+	if len(layout.fields) == 0 {
+		return nil, fmt.Errorf("结构体%v没有可用的字段", t)
+	}
+
+	actual, _ := namedLayouts.LoadOrStore(t, layout)
+	return actual.(*namedLayout), nil
+}
+
+// parseNamedField 解析结构体字段的标签
+func parseNamedField(sf reflect.StructField, tag string) (RtdbDataTypeField, error) {
+	parts := strings.Split(tag, ",")
+	name := strings.TrimSpace(parts[0])
+	if name == "" {
+		name = sf.Name
+	}
+	field := RtdbDataTypeField{Name: name, Desc: sf.Tag.Get("desc")}
+
+	typeName := ""
+	if len(parts) > 1 {
+		typeName = strings.TrimSpace(parts[1])
+	}
+	if typeName == "" {
+		typ, ok := inferRtdbType(sf.Type)
+		if !ok {
+			return field, fmt.Errorf("字段%s: 无法根据Go类型%v推断数值类型", sf.Name, sf.Type)
+		}
+		field.Type = typ
+	} else {
+		typ, _ := ValueType(typeName).ToRawType()
+		if typ == RtdbTypeNamedT {
+			return field, fmt.Errorf("字段%s: 未知的数值类型%s", sf.Name, typeName)
+		}
+		field.Type = typ
+	}
+	if !goTypeMatches(field.Type, sf.Type) {
+		return field, fmt.Errorf("字段%s: Go类型%v不能对应数值类型%s", sf.Name, sf.Type, FromRawType(field.Type, ""))
+	}
+
+	field.Length = RtdbTypeSize(field.Type)
+	if field.Type == RtdbTypeString || field.Type == RtdbTypeBlob {
+		if sf.Type.Kind() == reflect.Array {
+			field.Length = int32(sf.Type.Len())
+		}
+		if len(parts) > 2 {
+			length, err := strconv.Atoi(strings.TrimSpace(parts[2]))
+			if err != nil || length <= 0 {
+				return field, fmt.Errorf("字段%s: 无效的长度%s", sf.Name, parts[2])
+			}
+			if sf.Type.Kind() == reflect.Array && length != sf.Type.Len() {
+				return field, fmt.Errorf("字段%s: 长度%d与数组长度%d不一致", sf.Name, length, sf.Type.Len())
+			}
+			field.Length = int32(length)
+		}
+		if field.Length == 0 {
+			return field, fmt.Errorf("字段%s: string与blob类型需要指定长度", sf.Name)
+		}
+	}
+	return field, nil
+}
+
+// inferRtdbType 根据Go类型推断数值类型
+func inferRtdbType(t reflect.Type) (RtdbType, bool) {
+	if t == coordinatesType {
+		return RtdbTypeCoor, true
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return RtdbTypeBool, true
+	case reflect.Uint8:
+		return RtdbTypeUint8, true
+	case reflect.Int8:
+		return RtdbTypeInt8, true
+	case reflect.Uint16:
+		return RtdbTypeUint16, true
+	case reflect.Int16:
+		return RtdbTypeInt16, true
+	case reflect.Uint32:
+		return RtdbTypeUint32, true
+	case reflect.Int32:
+		return RtdbTypeInt32, true
+	case reflect.Int64:
+		return RtdbTypeInt64, true
+	case reflect.Float32:
+		return RtdbTypeReal32, true
+	case reflect.Float64:
+		return RtdbTypeReal64, true
+	case reflect.String:
+		return RtdbTypeString, true
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return RtdbTypeBlob, true
+		}
+	}
+	return 0, false
+}
+
+// goTypeMatches 判断Go类型能否对应数值类型
+func goTypeMatches(typ RtdbType, t reflect.Type) bool {
+	switch typ {
+	case RtdbTypeBool:
+		return t.Kind() == reflect.Bool
+	case RtdbTypeUint8, RtdbTypeChar:
+		return t.Kind() == reflect.Uint8
+	case RtdbTypeInt8:
+		return t.Kind() == reflect.Int8
+	case RtdbTypeUint16:
+		return t.Kind() == reflect.Uint16
+	case RtdbTypeInt16:
+		return t.Kind() == reflect.Int16
+	case RtdbTypeUint32:
+		return t.Kind() == reflect.Uint32
+	case RtdbTypeInt32:
+		return t.Kind() == reflect.Int32
+	case RtdbTypeInt64:
+		return t.Kind() == reflect.Int64
+	case RtdbTypeReal16, RtdbTypeReal32:
+		return t.Kind() == reflect.Float32
+	case RtdbTypeReal64:
+		return t.Kind() == reflect.Float64
+	case RtdbTypeCoor:
+		return t == coordinatesType
+	case RtdbTypeString:
+		return t.Kind() == reflect.String
+	case RtdbTypeBlob:
+		return (t.Kind() == reflect.Slice || t.Kind() == reflect.Array) && t.Elem().Kind() == reflect.Uint8
+	default: /* datetime与定点数的内存布局未公开 */
+		return false
+	}
+}
+
+// encodeNamedField 编码单个字段
+func encodeNamedField(buf []byte, field RtdbDataTypeField, v reflect.Value) error {
+	le := binary.LittleEndian
+	switch field.Type {
+	case RtdbTypeBool:
+		if v.Bool() {
+			buf[0] = 1
+		}
+	case RtdbTypeUint8, RtdbTypeChar:
+		buf[0] = uint8(v.Uint())
+	case RtdbTypeInt8:
+		buf[0] = uint8(v.Int())
+	case RtdbTypeUint16:
+		le.PutUint16(buf, uint16(v.Uint()))
+	case RtdbTypeInt16:
+		le.PutUint16(buf, uint16(v.Int()))
+	case RtdbTypeUint32:
+		le.PutUint32(buf, uint32(v.Uint()))
+	case RtdbTypeInt32:
+		le.PutUint32(buf, uint32(v.Int()))
+	case RtdbTypeInt64:
+		le.PutUint64(buf, uint64(v.Int()))
+	case RtdbTypeReal16:
+		le.PutUint16(buf, Float32ToHalf(float32(v.Float())))
+	case RtdbTypeReal32:
+		le.PutUint32(buf, math.Float32bits(float32(v.Float())))
+	case RtdbTypeReal64:
+		le.PutUint64(buf, math.Float64bits(v.Float()))
+	case RtdbTypeCoor:
+		xy := v.Interface().(Coordinates)
+		le.PutUint32(buf, math.Float32bits(xy.X))
+		le.PutUint32(buf[4:], math.Float32bits(xy.Y))
+	case RtdbTypeString:
+		if v.Len() > len(buf) {
+			return fmt.Errorf("字段%s: 字符串长度%d超过了%d", field.Name, v.Len(), len(buf))
+		}
+		copy(buf, v.String())
+	case RtdbTypeBlob:
+		if v.Len() > len(buf) {
+			return fmt.Errorf("字段%s: 数据长度%d超过了%d", field.Name, v.Len(), len(buf))
+		}
+		reflect.Copy(reflect.ValueOf(buf), v)
+	}
+	return nil
+}
+
+// decodeNamedField 解码单个字段
+func decodeNamedField(buf []byte, field RtdbDataTypeField, v reflect.Value) {
+	le := binary.LittleEndian
+	switch field.Type {
+	case RtdbTypeBool:
+		v.SetBool(buf[0] != 0)
+	case RtdbTypeUint8, RtdbTypeChar:
+		v.SetUint(uint64(buf[0]))
+	case RtdbTypeInt8:
+		v.SetInt(int64(int8(buf[0])))
+	case RtdbTypeUint16:
+		v.SetUint(uint64(le.Uint16(buf)))
+	case RtdbTypeInt16:
+		v.SetInt(int64(int16(le.Uint16(buf))))
+	case RtdbTypeUint32:
+		v.SetUint(uint64(le.Uint32(buf)))
+	case RtdbTypeInt32:
+		v.SetInt(int64(int32(le.Uint32(buf))))
+	case RtdbTypeInt64:
+		v.SetInt(int64(le.Uint64(buf)))
+	case RtdbTypeReal16:
+		v.SetFloat(float64(HalfToFloat32(le.Uint16(buf))))
+	case RtdbTypeReal32:
+		v.SetFloat(float64(math.Float32frombits(le.Uint32(buf))))
+	case RtdbTypeReal64:
+		v.SetFloat(math.Float64frombits(le.Uint64(buf)))
+	case RtdbTypeCoor:
+		v.Set(reflect.ValueOf(Coordinates{
+			X: math.Float32frombits(le.Uint32(buf)),
+			Y: math.Float32frombits(le.Uint32(buf[4:])),
+		}))
+	case RtdbTypeString:
+		if i := bytes.IndexByte(buf, 0); i >= 0 {
+			buf = buf[:i]
+		}
+		v.SetString(string(buf))
+	case RtdbTypeBlob:
+		if v.Kind() == reflect.Array {
+			reflect.Copy(v, reflect.ValueOf(buf))
+		} else {
+			v.SetBytes(append([]byte(nil), buf...))
+		}
+	}
+}
+
+// Float32ToHalf float32转换成IEEE 754半精度浮点数(float16)
+func Float32ToHalf(f float32) uint16 {
+	bits := math.Float32bits(f)
+	sign := uint16(bits>>16) & 0x8000
+	exp := int32(bits>>23) & 0xFF
+	mant := bits & 0x7FFFFF
+	switch {
+	case exp == 0xFF: /* Inf与NaN */
+		if mant != 0 {
+			return sign | 0x7E00
+		}
+		return sign | 0x7C00
+	case exp-127+15 >= 0x1F: /* 溢出 */
+		return sign | 0x7C00
+	case exp-127+15 <= 0: /* 非规格化数 */
+		if exp-127+15 < -10 {
+			return sign
+		}
+		mant |= 0x800000
+		shift := uint32(14 - (exp - 127 + 15))
+		half := uint16(mant >> shift)
+		if (mant>>(shift-1))&1 != 0 {
+			half++
+		}
+		return sign | half
+	default:
+		half := sign | uint16(exp-127+15)<<10 | uint16(mant>>13)
+		if mant&0x1000 != 0 { /* 舍入 */
+			half++
+		}
+		return half
+	}
+}
+
+// HalfToFloat32 IEEE 754半精度浮点数(float16)转换成float32
+func HalfToFloat32(h uint16) float32 {
+	sign := uint32(h&0x8000) << 16
+	exp := uint32(h>>10) & 0x1F
+	mant := uint32(h & 0x3FF)
+	switch {
+	case exp == 0x1F:
+		return math.Float32frombits(sign | 0x7F800000 | mant<<13)
+	case exp == 0:
+		if mant == 0 {
+			return math.Float32frombits(sign)
+		}
+		f := float32(mant) / 1024 / 16384
+		if sign != 0 {
+			return -f
+		}
+		return f
+	default:
+		return math.Float32frombits(sign | (exp-15+127)<<23 | mant<<13)
+	}
+}
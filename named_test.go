@@ -0,0 +1,225 @@
+package rtdb_api
+
+import (
+	"context"
+	"errors"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+type namedSensor struct {
+	Temp   float32     `rtdb:"temp,float32" desc:"温度"`
+	Level  float32     `rtdb:"level,float16"`
+	State  uint8       `rtdb:"state,char"`
+	Count  int64       `rtdb:"count"`
+	Pos    Coordinates `rtdb:"pos"`
+	Name   string      `rtdb:"name,string,8"`
+	Data   [4]byte     `rtdb:"data"`
+	Ignore int         `rtdb:"-"`
+}
+
+// 结构体与自定义类型数值的编码与解码
+func TestMarshalNamedType(t *testing.T) {
+	typ, err := NamedTypeOf("sensor", "传感器", namedSensor{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(typ.Fields) != 7 || typ.Length != 4+2+1+8+8+8+4 {
+		t.Fatal("自定义类型定义错误", typ)
+	}
+	if typ.Fields[0].Desc != "温度" || typ.Fields[1].Type != RtdbTypeReal16 || typ.Fields[5].Length != 8 {
+		t.Error("字段定义错误", typ.Fields)
+	}
+
+	src := namedSensor{Temp: 21.5, Level: 0.75, State: 'A', Count: -3, Pos: Coordinates{X: 1, Y: 2}, Name: "泵1", Data: [4]byte{1, 2, 3, 4}, Ignore: 9}
+	data, err := MarshalNamedType(&src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(data) != int(typ.Length) || data[6] != 'A' || data[7] != 0xFD {
+		t.Error("编码结果错误", data)
+	}
+
+	dst := namedSensor{}
+	if err := UnmarshalNamedType(data, &dst); err != nil {
+		t.Fatal(err)
+	}
+	src.Ignore = 0
+	if dst != src {
+		t.Errorf("解码结果错误: %+v", dst)
+	}
+
+	src.Name = "超过八个字节的名称"
+	if _, err := MarshalNamedType(src); err == nil {
+		t.Error("期望字符串超长")
+	}
+	if err := UnmarshalNamedType(data[:10], &dst); err == nil {
+		t.Error("期望数值长度不足")
+	}
+	type badString struct {
+		Name string `rtdb:"name"`
+	}
+	if _, err := NamedTypeOf("bad", "", badString{}); err == nil {
+		t.Error("期望string缺少长度")
+	}
+}
+
+// 半精度浮点数转换
+func TestFloat32ToHalf(t *testing.T) {
+	for _, f := range []float32{0, 1, -2, 0.5, 65504, 6.1035156e-05, 5.9604645e-08} {
+		if got := HalfToFloat32(Float32ToHalf(f)); got != f {
+			t.Errorf("%v: 转换结果%v", f, got)
+		}
+	}
+	if Float32ToHalf(1) != 0x3C00 || Float32ToHalf(-2) != 0xC000 {
+		t.Error("编码错误")
+	}
+	if !math.IsInf(float64(HalfToFloat32(Float32ToHalf(1e6))), 1) {
+		t.Error("期望溢出为Inf")
+	}
+}
+
+// 根据结构体创建自定义类型, 检查定义并读写数值
+func TestRtdbConnect_NamedTypeOf(t *testing.T) {
+	conn, err := LoginWithBackend(NewMemoryBackend(), "127.0.0.1", Port, Username, Password)
+	if err != nil {
+		t.Fatal("登录用户失败", err)
+	}
+	defer func() { _ = conn.Logout() }()
+
+	if err := conn.AddNamedTypeOf("sensor", "传感器", namedSensor{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := conn.CheckNamedType("sensor", &namedSensor{}); err != nil {
+		t.Error("检查自定义类型失败", err)
+	}
+	type other struct {
+		Temp float64 `rtdb:"temp"`
+	}
+	if err := conn.CheckNamedType("sensor", other{}); !errors.Is(err, ErrNamedTypeMismatch) {
+		t.Error("期望自定义类型不一致", err)
+	}
+
+	table, err := conn.CreateTable("named", "自定义类型表")
+	if err != nil {
+		t.Fatal(err)
+	}
+	info, err := conn.AddPoint(NewPointInfo("s1", table.ID, ValueType("sensor"), PointBase, RtdbPrecisionMilli, "", ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	point, err := BindPoint[[]byte](conn, info)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	src := namedSensor{Temp: 30, Name: "a"}
+	data, err := MarshalNamedType(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.UnixMilli(1700000000123)
+	if err := point.Write(context.Background(), now, data, QualityGood); err != nil {
+		t.Fatal(err)
+	}
+	value, _, _, err := point.Read(context.Background(), RtdbHisModeExact, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dst := namedSensor{}
+	if err := UnmarshalNamedType(value, &dst); err != nil || dst != src {
+		t.Errorf("读取自定义类型失败: %+v %v", dst, err)
+	}
+}
+
+// 通过字段读写函数存取自定义类型数值
+func TestNamedValue_GetSet(t *testing.T) {
+	conn, err := LoginWithBackend(NewMemoryBackend(), "127.0.0.1", Port, Username, Password)
+	if err != nil {
+		t.Fatal("登录用户失败", err)
+	}
+	defer func() { _ = conn.Logout() }()
+
+	if err := conn.CheckNamedTypeName("sensor_1"); err != nil {
+		t.Error("名称应当合法", err)
+	}
+	if err := conn.CheckNamedTypeName("1sensor"); !errors.Is(err, RteCheckNamedTypeNameError) {
+		t.Error("期望名称不合法", err)
+	}
+	if err := conn.AddNamedTypeOf("sensor", "传感器", namedSensor{}); err != nil {
+		t.Fatal(err)
+	}
+
+	value, err := conn.NewNamedValue("sensor", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	src := namedSensor{Temp: 21.5, Level: 0.75, State: 'A', Count: -3, Pos: Coordinates{X: 1, Y: 2}, Name: "泵1", Data: [4]byte{1, 2, 3, 4}}
+	sets := map[string]any{
+		"temp": src.Temp, "level": src.Level, "STATE": src.State, "count": src.Count,
+		"pos": src.Pos, "name": src.Name, "data": src.Data[:],
+	}
+	for name, v := range sets {
+		if err := value.Set(name, v); err != nil {
+			t.Fatal(name, err)
+		}
+	}
+	data, err := MarshalNamedType(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(value.Bytes()) != len(data) {
+		t.Fatalf("数值长度错误 %d, 期望 %d", len(value.Bytes()), len(data))
+	}
+	// 逐个字段比对字段读写函数与结构体编码的结果
+	encoded, err := conn.NewNamedValue("sensor", data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, field := range value.Type().Fields {
+		got, err := value.GetAt(i)
+		if err != nil {
+			t.Fatal(field.Name, err)
+		}
+		want, err := encoded.GetAt(i)
+		if err != nil {
+			t.Fatal(field.Name, err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("字段%s不一致: 字段读写函数%v, 结构体编码%v", field.Name, got, want)
+		}
+		for name, v := range sets {
+			if strings.EqualFold(name, field.Name) && !reflect.DeepEqual(got, v) {
+				t.Errorf("字段%s错误 %v, 期望 %v", field.Name, got, v)
+			}
+		}
+	}
+
+	// 复制调用方的数值, 之后修改任意一方互不影响
+	data[0] ^= 0xff
+	if v, err := encoded.GetAt(0); err != nil || v != src.Temp {
+		t.Error("修改调用方的数值后读取结果改变", v, err)
+	}
+	if err := encoded.Set("count", int64(7)); err != nil {
+		t.Fatal(err)
+	}
+	if decoded := (namedSensor{}); UnmarshalNamedType(data, &decoded) != nil || decoded.Count != src.Count {
+		t.Error("Set 修改了调用方的数值", decoded.Count)
+	}
+
+	if v, err := value.Get("name"); err != nil || v != "泵1" {
+		t.Error("读取字段失败", v, err)
+	}
+	if v, err := value.GetAt(1); err != nil || v != float32(0.75) {
+		t.Error("读取字段失败", v, err)
+	}
+	if err := value.Set("temp", 1.5); err == nil {
+		t.Error("期望类型不匹配")
+	}
+	if err := value.Set("unknown", 1); err == nil {
+		t.Error("期望字段不存在")
+	}
+}
@@ -0,0 +1,520 @@
+package rtdb_api
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"time"
+)
+
+// Parquet文件的魔数, 位于文件的开头与结尾
+const parquetMagic = "PAR1"
+
+// Parquet的物理类型
+const (
+	parquetBoolean   = int32(0)
+	parquetInt32     = int32(1)
+	parquetInt64     = int32(2)
+	parquetFloat     = int32(4)
+	parquetDouble    = int32(5)
+	parquetByteArray = int32(6)
+)
+
+// Parquet的编码方式
+const (
+	parquetEncodingPlain = int32(0)
+	parquetEncodingRle   = int32(3)
+)
+
+// Parquet的旧版逻辑类型(ConvertedType)
+const (
+	parquetConvertedNone  = int32(-1)
+	parquetConvertedUTF8  = int32(0)
+	parquetConvertedInt16 = int32(16)
+)
+
+// thrift compact protocol 的字段类型
+const (
+	thriftBoolTrue  = byte(1)
+	thriftBoolFalse = byte(2)
+	thriftByte      = byte(3)
+	thriftI32       = byte(5)
+	thriftI64       = byte(6)
+	thriftBinary    = byte(8)
+	thriftList      = byte(9)
+	thriftStruct    = byte(12)
+)
+
+// thriftWriter 按照 thrift compact protocol 编码Parquet的元数据
+type thriftWriter struct {
+	buf   []byte
+	last  int16
+	stack []int16
+}
+
+// field 写入字段头
+func (t *thriftWriter) field(id int16, typ byte) {
+	if delta := id - t.last; delta > 0 && delta <= 15 {
+		t.buf = append(t.buf, byte(delta)<<4|typ)
+	} else {
+		t.buf = append(t.buf, typ)
+		t.buf = binary.AppendVarint(t.buf, int64(id))
+	}
+	t.last = id
+}
+
+func (t *thriftWriter) i8(id int16, v int8) {
+	t.field(id, thriftByte)
+	t.buf = append(t.buf, byte(v))
+}
+
+func (t *thriftWriter) i32(id int16, v int32) {
+	t.field(id, thriftI32)
+	t.buf = binary.AppendVarint(t.buf, int64(v))
+}
+
+func (t *thriftWriter) i64(id int16, v int64) {
+	t.field(id, thriftI64)
+	t.buf = binary.AppendVarint(t.buf, v)
+}
+
+func (t *thriftWriter) bool(id int16, v bool) {
+	if v {
+		t.field(id, thriftBoolTrue)
+	} else {
+		t.field(id, thriftBoolFalse)
+	}
+}
+
+func (t *thriftWriter) binary(id int16, v []byte) {
+	t.field(id, thriftBinary)
+	t.rawBinary(v)
+}
+
+func (t *thriftWriter) rawBinary(v []byte) {
+	t.buf = binary.AppendUvarint(t.buf, uint64(len(v)))
+	t.buf = append(t.buf, v...)
+}
+
+// structBegin 开始写入结构体字段, id为0时为列表中的元素
+func (t *thriftWriter) structBegin(id int16) {
+	if id != 0 {
+		t.field(id, thriftStruct)
+	}
+	t.stack = append(t.stack, t.last)
+	t.last = 0
+}
+
+// structEnd 结束结构体
+func (t *thriftWriter) structEnd() {
+	t.buf = append(t.buf, 0)
+	t.last = t.stack[len(t.stack)-1]
+	t.stack = t.stack[:len(t.stack)-1]
+}
+
+// list 写入列表头, 之后依次写入n个元素
+func (t *thriftWriter) list(id int16, elem byte, n int) {
+	t.field(id, thriftList)
+	if n < 15 {
+		t.buf = append(t.buf, byte(n)<<4|elem)
+	} else {
+		t.buf = append(t.buf, 0xF0|elem)
+		t.buf = binary.AppendUvarint(t.buf, uint64(n))
+	}
+}
+
+// parquetSchema Parquet的结构定义(SchemaElement)
+type parquetSchema struct {
+	root      bool
+	name      string
+	typ       int32
+	children  int32
+	converted int32
+	// logical 写入LogicalType, 为nil时不写入
+	logical func(t *thriftWriter)
+}
+
+// write 编码结构定义, 根节点与分组没有物理类型
+func (s *parquetSchema) write(t *thriftWriter) {
+	t.structBegin(0)
+	if s.children == 0 {
+		t.i32(1, s.typ)
+	}
+	if !s.root {
+		t.i32(3, 0) // REQUIRED
+	}
+	t.binary(4, []byte(s.name))
+	if s.children != 0 {
+		t.i32(5, s.children)
+	}
+	if s.converted >= 0 {
+		t.i32(6, s.converted)
+	}
+	if s.logical != nil {
+		t.structBegin(10)
+		s.logical(t)
+		t.structEnd()
+	}
+	t.structEnd()
+}
+
+// parquetColumn Parquet的一列, 缓存当前行组的数值
+type parquetColumn struct {
+	path []string
+	typ  int32
+	data []byte
+	bits int
+	rows int
+
+	// 时间列记录最大与最小值, 用于按时间筛选行组
+	stats    bool
+	min, max int64
+}
+
+func (col *parquetColumn) putInt32(v int32) {
+	col.data = binary.LittleEndian.AppendUint32(col.data, uint32(v))
+	col.rows++
+}
+
+func (col *parquetColumn) putInt64(v int64) {
+	col.data = binary.LittleEndian.AppendUint64(col.data, uint64(v))
+	if col.stats && (col.rows == 0 || v < col.min) {
+		col.min = v
+	}
+	if col.stats && (col.rows == 0 || v > col.max) {
+		col.max = v
+	}
+	col.rows++
+}
+
+func (col *parquetColumn) putFloat(v float32) {
+	col.data = binary.LittleEndian.AppendUint32(col.data, math.Float32bits(v))
+	col.rows++
+}
+
+func (col *parquetColumn) putDouble(v float64) {
+	col.data = binary.LittleEndian.AppendUint64(col.data, math.Float64bits(v))
+	col.rows++
+}
+
+func (col *parquetColumn) putBytes(v []byte) {
+	col.data = binary.LittleEndian.AppendUint32(col.data, uint32(len(v)))
+	col.data = append(col.data, v...)
+	col.rows++
+}
+
+// putBool 布尔值按位存储, 低位在前
+func (col *parquetColumn) putBool(v bool) {
+	if col.bits%8 == 0 {
+		col.data = append(col.data, 0)
+	}
+	if v {
+		col.data[len(col.data)-1] |= 1 << (col.bits % 8)
+	}
+	col.bits++
+	col.rows++
+}
+
+// reset 清空当前行组的数值
+func (col *parquetColumn) reset() {
+	col.data, col.bits, col.rows = col.data[:0], 0, 0
+}
+
+// parquetChunk 已写入的列, 用于生成元数据
+type parquetChunk struct {
+	offset int64
+	size   int64
+	rows   int64
+	stats  bool
+	min    int64
+	max    int64
+}
+
+// parquetRowGroup 已写入的行组
+type parquetRowGroup struct {
+	chunks []parquetChunk
+	rows   int64
+	size   int64
+}
+
+// ParquetWriterOptions Parquet文件的选项
+type ParquetWriterOptions struct {
+	// RowGroupDuration 行组的时间跨度, 数值的时间戳进入下一个时间段时开始新的行组, 为0时为1小时
+	RowGroupDuration time.Duration
+
+	// RowGroupMaxRows 行组的最大行数, 为0时为100000
+	RowGroupMaxRows int
+}
+
+// ParquetWriter 将单个标签点的数值写入Parquet文件
+//   - 列依次为 tag(string)、time(int64, 纳秒时间戳)、value、quality(int16)
+//   - value 的类型由标签点的数值类型决定: bool为boolean, 整数为int64, 浮点数为double,
+//     string与datetime为string, blob与自定义类型为binary, coor为包含x、y(float)的struct
+//   - 数值不压缩, 使用PLAIN编码, 所有列都不可为空
+//   - 数值需要按时间升序写入, 每个行组缓存在内存中, 写满后立即写出
+type ParquetWriter struct {
+	w       io.Writer
+	offset  int64
+	info    *PointInfo
+	opts    ParquetWriterOptions
+	schema  []parquetSchema
+	columns []*parquetColumn
+	put     func(tvq *TVQ)
+	groups  []parquetRowGroup
+	bucket  time.Time
+	rows    int64
+	err     error
+}
+
+// NewParquetWriter 创建Parquet写入器, 立即写入文件头
+//
+// input:
+//   - w 输出
+//   - info 标签点信息
+//   - opts 选项
+func NewParquetWriter(w io.Writer, info *PointInfo, opts ParquetWriterOptions) (*ParquetWriter, error) {
+	if opts.RowGroupDuration <= 0 {
+		opts.RowGroupDuration = time.Hour
+	}
+	if opts.RowGroupMaxRows <= 0 {
+		opts.RowGroupMaxRows = 100000
+	}
+	pw := &ParquetWriter{w: w, info: info, opts: opts}
+	tag := &parquetColumn{path: []string{"tag"}, typ: parquetByteArray}
+	ts := &parquetColumn{path: []string{"time"}, typ: parquetInt64, stats: true}
+	quality := &parquetColumn{path: []string{"quality"}, typ: parquetInt32}
+	pw.schema = []parquetSchema{
+		{root: true, name: "schema", children: 4, converted: parquetConvertedNone},
+		{name: "tag", typ: parquetByteArray, converted: parquetConvertedUTF8, logical: parquetStringLogical},
+		{name: "time", typ: parquetInt64, converted: parquetConvertedNone, logical: parquetNanosLogical},
+	}
+	pw.columns = []*parquetColumn{tag, ts}
+
+	rtdbType, _ := info.ValueType.ToRawType()
+	var putValue func(tvq *TVQ)
+	switch rtdbType {
+	case RtdbTypeBool:
+		value := pw.addColumn(parquetSchema{name: "value", typ: parquetBoolean, converted: parquetConvertedNone})
+		putValue = func(tvq *TVQ) { value.putBool(Int64ToBool(tvq.Value.IntValue)) }
+	case RtdbTypeUint8, RtdbTypeInt8, RtdbTypeChar, RtdbTypeUint16, RtdbTypeInt16, RtdbTypeUint32, RtdbTypeInt32, RtdbTypeInt64:
+		value := pw.addColumn(parquetSchema{name: "value", typ: parquetInt64, converted: parquetConvertedNone})
+		putValue = func(tvq *TVQ) { value.putInt64(tvq.Value.IntValue) }
+	case RtdbTypeReal16, RtdbTypeReal32, RtdbTypeReal64, RtdbTypeFp16, RtdbTypeFp32, RtdbTypeFp64:
+		value := pw.addColumn(parquetSchema{name: "value", typ: parquetDouble, converted: parquetConvertedNone})
+		putValue = func(tvq *TVQ) { value.putDouble(tvq.Value.FloatValue) }
+	case RtdbTypeString, RtdbTypeDatetime:
+		value := pw.addColumn(parquetSchema{name: "value", typ: parquetByteArray, converted: parquetConvertedUTF8, logical: parquetStringLogical})
+		putValue = func(tvq *TVQ) { value.putBytes([]byte(tvq.Value.StringValue)) }
+	case RtdbTypeCoor:
+		pw.schema = append(pw.schema, parquetSchema{name: "value", children: 2, converted: parquetConvertedNone})
+		x := pw.addColumn(parquetSchema{name: "x", typ: parquetFloat, converted: parquetConvertedNone})
+		y := pw.addColumn(parquetSchema{name: "y", typ: parquetFloat, converted: parquetConvertedNone})
+		x.path, y.path = []string{"value", "x"}, []string{"value", "y"}
+		putValue = func(tvq *TVQ) {
+			x.putFloat(tvq.Value.CoordinatesValue.X)
+			y.putFloat(tvq.Value.CoordinatesValue.Y)
+		}
+	case RtdbTypeBlob, RtdbTypeNamedT:
+		value := pw.addColumn(parquetSchema{name: "value", typ: parquetByteArray, converted: parquetConvertedNone})
+		putValue = func(tvq *TVQ) { value.putBytes(tvq.Value.BytesValue) }
+	default:
+		return nil, fmt.Errorf("不支持导出%s类型的数值", info.ValueType)
+	}
+	pw.schema = append(pw.schema, parquetSchema{name: "quality", typ: parquetInt32, converted: parquetConvertedInt16, logical: parquetInt16Logical})
+	pw.columns = append(pw.columns, quality)
+
+	tagName := []byte(info.TableDotTag)
+	pw.put = func(tvq *TVQ) {
+		tag.putBytes(tagName)
+		ts.putInt64(tvq.Timestamp.UnixNano())
+		putValue(tvq)
+		quality.putInt32(int32(int16(tvq.Quality)))
+	}
+	if err := pw.write([]byte(parquetMagic)); err != nil {
+		return nil, err
+	}
+	return pw, nil
+}
+
+// addColumn 增加值列
+func (pw *ParquetWriter) addColumn(schema parquetSchema) *parquetColumn {
+	pw.schema = append(pw.schema, schema)
+	col := &parquetColumn{path: []string{schema.name}, typ: schema.typ}
+	pw.columns = append(pw.columns, col)
+	return col
+}
+
+// parquetStringLogical 字符串的LogicalType
+func parquetStringLogical(t *thriftWriter) {
+	t.structBegin(1)
+	t.structEnd()
+}
+
+// parquetNanosLogical 纳秒UTC时间戳的LogicalType
+func parquetNanosLogical(t *thriftWriter) {
+	t.structBegin(8)
+	t.bool(1, true)
+	t.structBegin(2)
+	t.structBegin(3)
+	t.structEnd()
+	t.structEnd()
+	t.structEnd()
+}
+
+// parquetInt16Logical 16位有符号整数的LogicalType
+func parquetInt16Logical(t *thriftWriter) {
+	t.structBegin(10)
+	t.i8(1, 16)
+	t.bool(2, true)
+	t.structEnd()
+}
+
+// write 写入数据并记录偏移量
+func (pw *ParquetWriter) write(data []byte) error {
+	if pw.err != nil {
+		return pw.err
+	}
+	n, err := pw.w.Write(data)
+	pw.offset += int64(n)
+	pw.err = err
+	return err
+}
+
+// Rows 已写入的行数
+func (pw *ParquetWriter) Rows() int64 {
+	return pw.rows
+}
+
+// Write 写入一个数值, 数值的时间戳进入下一个时间段或者行数达到上限时先写出当前行组
+func (pw *ParquetWriter) Write(tvq TVQ) error {
+	if pw.err != nil {
+		return pw.err
+	}
+	bucket := tvq.Timestamp.Truncate(pw.opts.RowGroupDuration)
+	rows := pw.columns[0].rows
+	if rows != 0 && (!bucket.Equal(pw.bucket) || rows >= pw.opts.RowGroupMaxRows) {
+		if err := pw.flush(); err != nil {
+			return err
+		}
+	}
+	pw.bucket = bucket
+	pw.put(&tvq)
+	pw.rows++
+	return nil
+}
+
+// flush 写出当前行组, 每列一个数据页
+func (pw *ParquetWriter) flush() error {
+	rows := pw.columns[0].rows
+	if rows == 0 {
+		return nil
+	}
+	group := parquetRowGroup{rows: int64(rows)}
+	for _, col := range pw.columns {
+		t := thriftWriter{}
+		t.i32(1, 0) // DATA_PAGE
+		t.i32(2, int32(len(col.data)))
+		t.i32(3, int32(len(col.data)))
+		t.structBegin(5)
+		t.i32(1, int32(col.rows))
+		t.i32(2, parquetEncodingPlain)
+		t.i32(3, parquetEncodingRle)
+		t.i32(4, parquetEncodingRle)
+		t.structEnd()
+		t.buf = append(t.buf, 0)
+
+		chunk := parquetChunk{offset: pw.offset, size: int64(len(t.buf) + len(col.data)), rows: int64(col.rows), stats: col.stats, min: col.min, max: col.max}
+		if err := pw.write(t.buf); err != nil {
+			return err
+		}
+		if err := pw.write(col.data); err != nil {
+			return err
+		}
+		group.chunks = append(group.chunks, chunk)
+		group.size += chunk.size
+		col.reset()
+	}
+	pw.groups = append(pw.groups, group)
+	return nil
+}
+
+// Close 写出剩余的行组以及文件尾, 不会关闭输出
+func (pw *ParquetWriter) Close() error {
+	if err := pw.flush(); err != nil {
+		return err
+	}
+
+	t := thriftWriter{}
+	t.i32(1, 1)
+	t.list(2, thriftStruct, len(pw.schema))
+	for i := range pw.schema {
+		pw.schema[i].write(&t)
+	}
+	t.i64(3, pw.rows)
+	t.list(4, thriftStruct, len(pw.groups))
+	for _, group := range pw.groups {
+		t.structBegin(0)
+		t.list(1, thriftStruct, len(group.chunks))
+		for i, chunk := range group.chunks {
+			col := pw.columns[i]
+			t.structBegin(0)
+			t.i64(2, chunk.offset)
+			t.structBegin(3)
+			t.i32(1, col.typ)
+			t.list(2, thriftI32, 2)
+			t.buf = binary.AppendVarint(t.buf, int64(parquetEncodingPlain))
+			t.buf = binary.AppendVarint(t.buf, int64(parquetEncodingRle))
+			t.list(3, thriftBinary, len(col.path))
+			for _, p := range col.path {
+				t.rawBinary([]byte(p))
+			}
+			t.i32(4, 0) // UNCOMPRESSED
+			t.i64(5, chunk.rows)
+			t.i64(6, chunk.size)
+			t.i64(7, chunk.size)
+			t.i64(9, chunk.offset)
+			if chunk.stats {
+				t.structBegin(12)
+				t.i64(3, 0)
+				t.binary(5, binary.LittleEndian.AppendUint64(nil, uint64(chunk.max)))
+				t.binary(6, binary.LittleEndian.AppendUint64(nil, uint64(chunk.min)))
+				t.structEnd()
+			}
+			t.structEnd()
+			t.structEnd()
+		}
+		t.i64(2, group.size)
+		t.i64(3, group.rows)
+		t.structEnd()
+	}
+	t.list(5, thriftStruct, 3)
+	for _, kv := range [][2]string{
+		{"rtdb.tag", pw.info.TableDotTag},
+		{"rtdb.value_type", string(pw.info.ValueType)},
+		{"rtdb.precision", fmt.Sprint(pw.info.Precision)},
+	} {
+		t.structBegin(0)
+		t.binary(1, []byte(kv[0]))
+		t.binary(2, []byte(kv[1]))
+		t.structEnd()
+	}
+	t.binary(6, []byte("rtdb_api"))
+	t.buf = append(t.buf, 0)
+
+	if err := pw.write(t.buf); err != nil {
+		return err
+	}
+	if err := pw.write(binary.LittleEndian.AppendUint32(nil, uint32(len(t.buf)))); err != nil {
+		return err
+	}
+	if err := pw.write([]byte(parquetMagic)); err != nil {
+		return err
+	}
+	pw.err = errParquetClosed
+	return nil
+}
+
+// errParquetClosed 写入已关闭的Parquet文件
+var errParquetClosed = errors.New("Parquet文件已关闭")
@@ -0,0 +1,198 @@
+package rtdb_api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+)
+
+// ParquetPartition Parquet文件的分区方式
+type ParquetPartition int
+
+const (
+	// ParquetPartitionTag 每个标签点一个文件, 文件名为 <table.tag>.parquet
+	ParquetPartitionTag = ParquetPartition(0)
+
+	// ParquetPartitionDay 每个标签点每天一个文件, 文件名为 day=<2006-01-02>/<table.tag>.parquet
+	ParquetPartitionDay = ParquetPartition(1)
+)
+
+// ParquetExportOptions 导出Parquet文件的选项
+type ParquetExportOptions struct {
+	ParquetWriterOptions
+
+	// Partition 分区方式
+	Partition ParquetPartition
+
+	// Location 按天分区时使用的时区, 为nil时为time.Local
+	Location *time.Location
+
+	// Checkpoint 检查点文件, 为空时为输出目录下的 _checkpoint.json
+	Checkpoint string
+}
+
+// parquetCheckpoint 检查点, 记录已经完成的文件
+type parquetCheckpoint struct {
+	Start     time.Time        `json:"start"`
+	End       time.Time        `json:"end"`
+	Partition ParquetPartition `json:"partition"`
+	Done      []string         `json:"done"`
+}
+
+// parquetPart 一个分区, 对应一个文件
+type parquetPart struct {
+	info       *PointInfo
+	path       string
+	start, end time.Time
+}
+
+// parquetFileName 标签点全名转换成文件名
+var parquetFileName = strings.NewReplacer("/", "_", "\\", "_")
+
+// parquetParts 按照分区方式拆分导出范围
+func parquetParts(infos []*PointInfo, start, end time.Time, opts ParquetExportOptions) []parquetPart {
+	parts := make([]parquetPart, 0)
+	for _, info := range infos {
+		name := parquetFileName.Replace(info.TableDotTag) + ".parquet"
+		if opts.Partition != ParquetPartitionDay {
+			parts = append(parts, parquetPart{info: info, path: name, start: start, end: end})
+			continue
+		}
+		loc := opts.Location
+		if loc == nil {
+			loc = time.Local
+		}
+		day := start.In(loc)
+		day = time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, loc)
+		for !day.After(end) {
+			next := day.AddDate(0, 0, 1)
+			part := parquetPart{info: info, path: filepath.Join("day="+day.Format(time.DateOnly), name), start: day, end: next.Add(-time.Nanosecond)}
+			if part.start.Before(start) {
+				part.start = start
+			}
+			if part.end.After(end) {
+				part.end = end
+			}
+			parts = append(parts, part)
+			day = next
+		}
+	}
+	return parts
+}
+
+// ExportParquet 将多个标签点一段时间内的历史数据导出为Parquet文件, 文件格式参见 ParquetWriter
+//   - 按照分区方式每个分区一个文件, 没有数值的分区不生成文件
+//   - 分批读取历史数据, 每个行组写满后立即写出, 不会将全部数据读入内存
+//   - 文件先写入临时文件(.tmp), 完成后重命名并记录到检查点
+//   - 中断后使用相同的参数再次导出时跳过检查点中已经完成的文件, 全部完成后删除检查点
+//
+// input:
+//   - dir 输出目录
+//   - infos 标签点信息
+//   - start 开始时间(包含)
+//   - end 结束时间(包含)
+//   - opts 选项
+//
+// output:
+//   - int(count) 本次导出的数值个数
+func (c *RtdbConnect) ExportParquet(dir string, infos []*PointInfo, start, end time.Time, opts ParquetExportOptions) (int, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return 0, err
+	}
+	checkpointPath := opts.Checkpoint
+	if checkpointPath == "" {
+		checkpointPath = filepath.Join(dir, "_checkpoint.json")
+	}
+	checkpoint := parquetCheckpoint{Start: start, End: end, Partition: opts.Partition, Done: make([]string, 0)}
+	if data, err := os.ReadFile(checkpointPath); err == nil {
+		saved := parquetCheckpoint{}
+		if err := json.Unmarshal(data, &saved); err != nil {
+			return 0, fmt.Errorf("检查点格式错误: %w", err)
+		}
+		if !saved.Start.Equal(start) || !saved.End.Equal(end) || saved.Partition != opts.Partition {
+			return 0, errors.New("检查点与导出参数不一致")
+		}
+		checkpoint.Done = saved.Done
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return 0, err
+	}
+
+	count := 0
+	for _, part := range parquetParts(infos, start, end, opts) {
+		if slices.Contains(checkpoint.Done, part.path) {
+			continue
+		}
+		n, err := c.exportParquetPart(filepath.Join(dir, part.path), part, opts.ParquetWriterOptions)
+		count += n
+		if err != nil {
+			return count, err
+		}
+		checkpoint.Done = append(checkpoint.Done, part.path)
+		if err := writeFileAtomic(checkpointPath, checkpoint); err != nil {
+			return count, err
+		}
+	}
+	if err := os.Remove(checkpointPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return count, err
+	}
+	return count, nil
+}
+
+// exportParquetPart 导出一个分区
+func (c *RtdbConnect) exportParquetPart(path string, part parquetPart, opts ParquetWriterOptions) (int, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return 0, err
+	}
+	tmp := path + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_ = f.Close()
+		_ = os.Remove(tmp)
+	}()
+	pw, err := NewParquetWriter(f, part.info, opts)
+	if err != nil {
+		return 0, err
+	}
+	for tvq, err := range c.ArchivedValues(part.info, part.start, part.end) {
+		if err != nil {
+			return 0, err
+		}
+		if err := pw.Write(tvq); err != nil {
+			return 0, err
+		}
+	}
+	if pw.Rows() == 0 {
+		return 0, nil
+	}
+	if err := pw.Close(); err != nil {
+		return 0, err
+	}
+	if err := f.Close(); err != nil {
+		return 0, err
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return 0, err
+	}
+	return int(pw.Rows()), nil
+}
+
+// writeFileAtomic 将v编码为JSON后写入文件, 先写入临时文件再重命名
+func writeFileAtomic(path string, v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
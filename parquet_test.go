@@ -0,0 +1,343 @@
+package rtdb_api
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// thriftReader 按照 thrift compact protocol 解码Parquet的元数据, 结构体解码为字段ID到数值的映射
+type thriftReader struct {
+	data []byte
+	pos  int
+}
+
+func (r *thriftReader) byte() byte {
+	b := r.data[r.pos]
+	r.pos++
+	return b
+}
+
+func (r *thriftReader) varint() int64 {
+	v, n := binary.Varint(r.data[r.pos:])
+	r.pos += n
+	return v
+}
+
+func (r *thriftReader) uvarint() uint64 {
+	v, n := binary.Uvarint(r.data[r.pos:])
+	r.pos += n
+	return v
+}
+
+// value 按类型解码数值, 整数均解码为int64, binary解码为string
+func (r *thriftReader) value(typ byte) any {
+	switch typ {
+	case thriftBoolTrue:
+		return true
+	case thriftBoolFalse:
+		return false
+	case thriftByte:
+		return int64(int8(r.byte()))
+	case 4, thriftI32, thriftI64:
+		return r.varint()
+	case thriftBinary:
+		n := int(r.uvarint())
+		r.pos += n
+		return string(r.data[r.pos-n : r.pos])
+	case thriftList:
+		head := r.byte()
+		n, elem := int(head>>4), head&0x0F
+		if n == 15 {
+			n = int(r.uvarint())
+		}
+		list := make([]any, n)
+		for i := range list {
+			if elem == thriftBoolTrue || elem == thriftBoolFalse {
+				list[i] = r.byte() == thriftBoolTrue
+			} else {
+				list[i] = r.value(elem)
+			}
+		}
+		return list
+	case thriftStruct:
+		return r.structure()
+	default:
+		panic(fmt.Sprintf("不支持的thrift类型%d", typ))
+	}
+}
+
+// structure 解码结构体
+func (r *thriftReader) structure() map[int16]any {
+	fields := make(map[int16]any)
+	last := int16(0)
+	for {
+		head := r.byte()
+		if head == 0 {
+			return fields
+		}
+		id := last + int16(head>>4)
+		if head>>4 == 0 {
+			id = int16(r.varint())
+		}
+		fields[id] = r.value(head & 0x0F)
+		last = id
+	}
+}
+
+// thriftStructs 将列表转换为结构体列表
+func thriftStructs(v any) []map[int16]any {
+	list := v.([]any)
+	rtn := make([]map[int16]any, len(list))
+	for i, item := range list {
+		rtn[i] = item.(map[int16]any)
+	}
+	return rtn
+}
+
+// Parquet文件的结构与按时间划分行组, 解码文件尾的FileMetaData以及每个数据页
+func TestParquetWriter(t *testing.T) {
+	info := &PointInfo{ID: 1, TableDotTag: "his.xy", ValueType: ValueTypeCoor, Precision: RtdbPrecisionNano}
+	buf := bytes.Buffer{}
+	pw, err := NewParquetWriter(&buf, info, ParquetWriterOptions{RowGroupDuration: time.Minute, RowGroupMaxRows: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := time.Unix(1700000000, 0)
+	tvqs := make([]TVQ, 0)
+	for i := 0; i < 10; i++ {
+		// 前5个数值在同一分钟内, 按行数拆分为2个行组, 之后每个数值一个行组
+		ts := start.Add(time.Duration(i) * time.Second)
+		if i >= 5 {
+			ts = start.Add(time.Duration(i) * time.Minute)
+		}
+		quality := QualityGood
+		if i%4 == 1 {
+			quality = QualityBad
+		}
+		tvq := NewTvqCoordinates(ts, float32(i), -float32(i)/2, quality)
+		tvqs = append(tvqs, tvq)
+		if err := pw.Write(tvq); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := pw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := pw.Write(NewTvqCoordinates(start, 0, 0, QualityGood)); err == nil {
+		t.Error("期望文件已关闭")
+	}
+
+	data := buf.Bytes()
+	if string(data[:4]) != parquetMagic || string(data[len(data)-4:]) != parquetMagic {
+		t.Fatal("文件头或文件尾错误")
+	}
+	footer := int(binary.LittleEndian.Uint32(data[len(data)-8:]))
+	if footer <= 0 || footer > len(data)-12 {
+		t.Fatal("元数据长度错误", footer)
+	}
+	r := thriftReader{data: data[len(data)-8-footer : len(data)-8]}
+	meta := r.structure()
+	if r.pos != footer {
+		t.Fatal("元数据没有完整解码", r.pos, footer)
+	}
+	if meta[1] != int64(1) || meta[3] != int64(10) || meta[6] != "rtdb_api" {
+		t.Errorf("文件元数据错误 %v", meta)
+	}
+	kvs := thriftStructs(meta[5])
+	if len(kvs) != 3 || kvs[0][1] != "rtdb.tag" || kvs[0][2] != "his.xy" || kvs[1][2] != "coor" {
+		t.Errorf("键值元数据错误 %v", kvs)
+	}
+
+	// 结构定义: 根节点、tag、time、value(x, y)、quality
+	schema := thriftStructs(meta[2])
+	wantSchema := []struct {
+		name      string
+		typ       any
+		children  any
+		converted any
+		logical   int16
+	}{
+		{"schema", nil, int64(4), nil, 0},
+		{"tag", int64(parquetByteArray), nil, int64(parquetConvertedUTF8), 1},
+		{"time", int64(parquetInt64), nil, nil, 8},
+		{"value", nil, int64(2), nil, 0},
+		{"x", int64(parquetFloat), nil, nil, 0},
+		{"y", int64(parquetFloat), nil, nil, 0},
+		{"quality", int64(parquetInt32), nil, int64(parquetConvertedInt16), 10},
+	}
+	if len(schema) != len(wantSchema) {
+		t.Fatalf("结构定义个数错误 %v", schema)
+	}
+	for i, w := range wantSchema {
+		s := schema[i]
+		if s[4] != w.name || s[1] != w.typ || s[5] != w.children || s[6] != w.converted {
+			t.Errorf("第%d个结构定义错误 %v", i, s)
+		}
+		if i != 0 && s[3] != int64(0) {
+			t.Errorf("%s应当为REQUIRED %v", w.name, s)
+		}
+		if w.logical != 0 {
+			logical, ok := s[10].(map[int16]any)
+			if _, found := logical[w.logical]; !ok || !found {
+				t.Errorf("%s的逻辑类型错误 %v", w.name, s[10])
+			}
+		}
+	}
+	unit := schema[2][10].(map[int16]any)[8].(map[int16]any)
+	if unit[1] != true || unit[2].(map[int16]any)[3] == nil {
+		t.Errorf("time应当为UTC纳秒时间戳 %v", unit)
+	}
+
+	// 行组与列块
+	groups := thriftStructs(meta[4])
+	wantRows := []int64{3, 2, 1, 1, 1, 1, 1}
+	if len(groups) != len(wantRows) {
+		t.Fatalf("行组个数错误 %d", len(groups))
+	}
+	paths := [][]any{{"tag"}, {"time"}, {"value", "x"}, {"value", "y"}, {"quality"}}
+	types := []int64{int64(parquetByteArray), int64(parquetInt64), int64(parquetFloat), int64(parquetFloat), int64(parquetInt32)}
+	row := 0
+	for g, group := range groups {
+		if group[3] != wantRows[g] {
+			t.Errorf("第%d个行组的行数错误 %v", g, group[3])
+		}
+		chunks := thriftStructs(group[1])
+		if len(chunks) != len(paths) {
+			t.Fatalf("第%d个行组的列数错误 %d", g, len(chunks))
+		}
+		size := int64(0)
+		for c, chunk := range chunks {
+			col := chunk[3].(map[int16]any)
+			if col[1] != types[c] || fmt.Sprint(col[3]) != fmt.Sprint(paths[c]) || col[4] != int64(0) || col[5] != wantRows[g] {
+				t.Errorf("第%d个行组第%d列的元数据错误 %v", g, c, col)
+			}
+			if fmt.Sprint(col[2]) != fmt.Sprint([]any{int64(parquetEncodingPlain), int64(parquetEncodingRle)}) {
+				t.Errorf("编码方式错误 %v", col[2])
+			}
+			if chunk[2] != col[9] || col[6] != col[7] {
+				t.Errorf("列块偏移量或大小错误 %v", col)
+			}
+			size += col[7].(int64)
+
+			// 数据页: 页头之后为PLAIN编码的数值
+			offset := int(col[9].(int64))
+			page := thriftReader{data: data, pos: offset}
+			header := page.structure()
+			pageHeader := header[5].(map[int16]any)
+			if header[1] != int64(0) || pageHeader[1] != wantRows[g] || pageHeader[2] != int64(parquetEncodingPlain) {
+				t.Errorf("数据页头错误 %v", header)
+			}
+			if int64(page.pos-offset)+header[3].(int64) != col[7] {
+				t.Errorf("数据页长度与列块大小不一致 %v", header)
+			}
+			values := data[page.pos : page.pos+int(header[3].(int64))]
+			for i := 0; i < int(wantRows[g]); i++ {
+				tvq := tvqs[row+i]
+				switch c {
+				case 0:
+					n := int(binary.LittleEndian.Uint32(values))
+					if string(values[4:4+n]) != "his.xy" {
+						t.Errorf("tag错误 %q", values[4:4+n])
+					}
+					values = values[4+n:]
+				case 1:
+					if v := int64(binary.LittleEndian.Uint64(values[8*i:])); v != tvq.Timestamp.UnixNano() {
+						t.Errorf("第%d行time错误 %d", row+i, v)
+					}
+				case 2, 3:
+					want := tvq.Value.CoordinatesValue.X
+					if c == 3 {
+						want = tvq.Value.CoordinatesValue.Y
+					}
+					if v := math.Float32frombits(binary.LittleEndian.Uint32(values[4*i:])); v != want {
+						t.Errorf("第%d行第%d列错误 %v", row+i, c, v)
+					}
+				case 4:
+					if v := int32(binary.LittleEndian.Uint32(values[4*i:])); Quality(v) != tvq.Quality {
+						t.Errorf("第%d行quality错误 %d", row+i, v)
+					}
+				}
+			}
+		}
+		if group[2] != size {
+			t.Errorf("第%d个行组的大小错误 %v", g, group[2])
+		}
+
+		// 时间列的统计信息
+		stats := chunks[1][3].(map[int16]any)[12].(map[int16]any)
+		last := row + int(wantRows[g]) - 1
+		if stats[6] != string(binary.LittleEndian.AppendUint64(nil, uint64(tvqs[row].Timestamp.UnixNano()))) ||
+			stats[5] != string(binary.LittleEndian.AppendUint64(nil, uint64(tvqs[last].Timestamp.UnixNano()))) {
+			t.Errorf("第%d个行组的时间统计错误 %v", g, stats)
+		}
+		row += int(wantRows[g])
+	}
+}
+
+// 按天分区导出, 中断后根据检查点继续
+func TestRtdbConnect_ExportParquet(t *testing.T) {
+	conn, err := LoginWithBackend(NewMemoryBackend(), "127.0.0.1", Port, Username, Password)
+	if err != nil {
+		t.Fatal("登录用户失败", err)
+	}
+	defer func() { _ = conn.Logout() }()
+	table, err := conn.CreateTable("his", "历史数据")
+	if err != nil {
+		t.Fatal(err)
+	}
+	infos := make([]*PointInfo, 0)
+	for _, name := range []string{"a", "b"} {
+		info, err := conn.AddPoint(NewPointInfo(name, table.ID, ValueTypeFloat64, PointBase, RtdbPrecisionMilli, "", ""))
+		if err != nil {
+			t.Fatal(err)
+		}
+		infos = append(infos, info)
+	}
+
+	start := time.Date(2023, 11, 14, 20, 0, 0, 0, time.UTC)
+	ptvqs := make([]PTVQ, 0)
+	for i := 0; i < 8; i++ {
+		ts := start.Add(time.Duration(i) * time.Hour)
+		ptvqs = append(ptvqs, PTVQ{PointInfo: infos[0], TVQ: NewTvqFloat64(ts, float64(i), QualityGood)})
+		ptvqs = append(ptvqs, PTVQ{PointInfo: infos[1], TVQ: NewTvqFloat64(ts, float64(-i), QualityGood)})
+	}
+	if _, err := conn.WriteArchivedValues(ptvqs); err != nil {
+		t.Fatal(err)
+	}
+
+	dir := t.TempDir()
+	end := start.Add(2 * 24 * time.Hour)
+	opts := ParquetExportOptions{Partition: ParquetPartitionDay, Location: time.UTC}
+	checkpoint := filepath.Join(dir, "_checkpoint.json")
+	done := parquetCheckpoint{Start: start, End: end, Partition: ParquetPartitionDay, Done: []string{filepath.Join("day=2023-11-14", "his.a.parquet")}}
+	if err := writeFileAtomic(checkpoint, done); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := conn.ExportParquet(dir, infos, start, end.Add(time.Hour), opts); err == nil {
+		t.Error("期望检查点与导出参数不一致")
+	}
+
+	count, err := conn.ExportParquet(dir, infos, start, end, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 12 {
+		t.Error("跳过已完成的文件后导出的数值个数错误", count)
+	}
+	files, _ := filepath.Glob(filepath.Join(dir, "day=*", "*.parquet"))
+	if len(files) != 3 {
+		t.Error("文件个数错误", files)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "day=2023-11-14", "his.a.parquet")); !os.IsNotExist(err) {
+		t.Error("已完成的文件不应当重新导出", err)
+	}
+	if _, err := os.Stat(checkpoint); !os.IsNotExist(err) {
+		t.Error("完成后应当删除检查点", err)
+	}
+}
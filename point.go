@@ -0,0 +1,191 @@
+package rtdb_api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrValueTypeMismatch Point 的Go类型与标签点的数值类型不匹配
+var ErrValueTypeMismatch = errors.New("数值类型不匹配")
+
+// PointValue Point 支持的Go类型, 与数值类型的对应关系如下
+//   - bool: ValueTypeBool
+//   - uint8: ValueTypeUint8、ValueTypeChar
+//   - int8、uint16、int16、uint32、int32、int64: 同名的数值类型
+//   - float32: ValueTypeFloat16、ValueTypeFloat32、ValueTypeFp16、ValueTypeFp32
+//   - float64: ValueTypeFloat64、ValueTypeFp64
+//   - Coordinates: ValueTypeCoor
+//   - string: ValueTypeString、ValueTypeDatetime
+//   - []byte: ValueTypeBlob、自定义类型
+type PointValue interface {
+	bool | uint8 | int8 | uint16 | int16 | uint32 | int32 | int64 | float32 | float64 | Coordinates | string | []byte
+}
+
+// Point 带类型的标签点句柄, 读写的数值类型在编译期确定, 绑定时检查与标签点的数值类型是否匹配
+type Point[T PointValue] struct {
+	conn *RtdbConnect
+	info *PointInfo
+}
+
+// BindPoint 将标签点绑定为带类型的句柄, 按 info.ID 从服务端读取标签点信息后检查数值类型, 不信任调用方传入的 info.ValueType
+//
+// input:
+//   - conn 数据库连接
+//   - info 标签点信息
+//
+// output:
+//   - *Point[T](point) 标签点句柄, 数值类型不匹配时返回 ErrValueTypeMismatch
+func BindPoint[T PointValue](conn *RtdbConnect, info *PointInfo) (*Point[T], error) {
+	return BindPointByID[T](conn, info.ID)
+}
+
+// BindPointByID 通过标签点ID获取标签点信息, 并绑定为带类型的句柄
+//
+// input:
+//   - conn 数据库连接
+//   - id 标签点ID
+func BindPointByID[T PointValue](conn *RtdbConnect, id PointID) (*Point[T], error) {
+	info, err := conn.GetPoint(id)
+	if err != nil {
+		return nil, err
+	}
+	return bindPoint[T](conn, info)
+}
+
+// BindPointByName 通过"表名.标签点名"查找标签点, 并绑定为带类型的句柄
+//
+// input:
+//   - conn 数据库连接
+//   - tableDotTag 表名.标签点名
+func BindPointByName[T PointValue](conn *RtdbConnect, tableDotTag string) (*Point[T], error) {
+	infos, errs, err := conn.FindPoints([]string{tableDotTag})
+	if err != nil {
+		return nil, err
+	}
+	if errs[0] != nil {
+		return nil, errs[0]
+	}
+	return bindPoint[T](conn, infos[0])
+}
+
+// bindPoint 检查服务端返回的标签点信息的数值类型并绑定
+func bindPoint[T PointValue](conn *RtdbConnect, info *PointInfo) (*Point[T], error) {
+	if !valueTypeMatches[T](info.ValueType) {
+		var zero T
+		return nil, fmt.Errorf("%w: 标签点%d的数值类型为%s, 不能绑定为%T", ErrValueTypeMismatch, info.ID, info.ValueType, zero)
+	}
+	return &Point[T]{conn: conn, info: info}, nil
+}
+
+// Info 标签点信息
+func (p *Point[T]) Info() *PointInfo {
+	return p.info
+}
+
+// TVQ 新建TVQ, 可以配合 PTVQ 和 WriteSection 批量写入
+func (p *Point[T]) TVQ(timestamp time.Time, value T, quality Quality) TVQ {
+	return p.info.NewTVQ(timestamp, value, quality)
+}
+
+// Write 写入数值, 早于快照的数值写入存档
+//
+// input:
+//   - ctx 用于取消调用, 参见 WriteValueContext
+//   - timestamp 时间戳
+//   - value 数值
+//   - quality 质量码
+func (p *Point[T]) Write(ctx context.Context, timestamp time.Time, value T, quality Quality) error {
+	return p.conn.WriteValueContext(ctx, p.info, false, p.TVQ(timestamp, value, quality))
+}
+
+// Read 读取历史数值
+//
+// input:
+//   - ctx 用于取消调用, 参见 ReadValueContext
+//   - mode 读取模式
+//   - timestamp 时间戳
+//
+// output:
+//   - T(value) 数值
+//   - time.Time(timestamp) 数值的时间戳
+//   - Quality(quality) 质量码
+func (p *Point[T]) Read(ctx context.Context, mode RtdbHisMode, timestamp time.Time) (T, time.Time, Quality, error) {
+	var zero T
+	tvq, err := p.conn.ReadValueContext(ctx, p.info, mode, timestamp)
+	if err != nil {
+		return zero, time.Time{}, 0, err
+	}
+	return tvqValue[T](tvq), tvq.Timestamp, tvq.Quality, nil
+}
+
+// valueTypeMatches 判断Go类型与数值类型是否匹配
+func valueTypeMatches[T PointValue](vt ValueType) bool {
+	rtdbType, _ := vt.ToRawType()
+	var zero T
+	switch any(zero).(type) {
+	case bool:
+		return rtdbType == RtdbTypeBool
+	case uint8:
+		return rtdbType == RtdbTypeUint8 || rtdbType == RtdbTypeChar
+	case int8:
+		return rtdbType == RtdbTypeInt8
+	case uint16:
+		return rtdbType == RtdbTypeUint16
+	case int16:
+		return rtdbType == RtdbTypeInt16
+	case uint32:
+		return rtdbType == RtdbTypeUint32
+	case int32:
+		return rtdbType == RtdbTypeInt32
+	case int64:
+		return rtdbType == RtdbTypeInt64
+	case float32:
+		return rtdbType == RtdbTypeReal16 || rtdbType == RtdbTypeReal32 || rtdbType == RtdbTypeFp16 || rtdbType == RtdbTypeFp32
+	case float64:
+		return rtdbType == RtdbTypeReal64 || rtdbType == RtdbTypeFp64
+	case Coordinates:
+		return rtdbType == RtdbTypeCoor
+	case string:
+		return rtdbType == RtdbTypeString || rtdbType == RtdbTypeDatetime
+	case []byte:
+		return rtdbType == RtdbTypeBlob || rtdbType == RtdbTypeNamedT
+	default:
+		return false
+	}
+}
+
+// tvqValue 从TVQ中取出Go类型的数值
+func tvqValue[T PointValue](tvq TVQ) T {
+	var rtn T
+	switch v := any(&rtn).(type) {
+	case *bool:
+		*v = Int64ToBool(tvq.Value.IntValue)
+	case *uint8:
+		*v = uint8(tvq.Value.IntValue)
+	case *int8:
+		*v = int8(tvq.Value.IntValue)
+	case *uint16:
+		*v = uint16(tvq.Value.IntValue)
+	case *int16:
+		*v = int16(tvq.Value.IntValue)
+	case *uint32:
+		*v = uint32(tvq.Value.IntValue)
+	case *int32:
+		*v = int32(tvq.Value.IntValue)
+	case *int64:
+		*v = tvq.Value.IntValue
+	case *float32:
+		*v = float32(tvq.Value.FloatValue)
+	case *float64:
+		*v = tvq.Value.FloatValue
+	case *Coordinates:
+		*v = tvq.Value.CoordinatesValue
+	case *string:
+		*v = tvq.Value.StringValue
+	case *[]byte:
+		*v = tvq.Value.BytesValue
+	}
+	return rtn
+}
@@ -0,0 +1,97 @@
+package rtdb_api
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// 带类型的标签点句柄读写数据
+func TestPoint_WriteRead(t *testing.T) {
+	conn, err := LoginWithBackend(NewMemoryBackend(), "127.0.0.1", Port, Username, Password)
+	if err != nil {
+		t.Fatal("登录用户失败", err)
+	}
+	defer func() { _ = conn.Logout() }()
+
+	table, err := conn.CreateTable("typed", "类型表")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, vt := range []ValueType{ValueTypeFloat32, ValueTypeString, ValueTypeBlob, ValueTypeCoor} {
+		if _, err := conn.AddPoint(NewPointInfo(string(vt), table.ID, vt, PointBase, RtdbPrecisionNano, "", "")); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ctx := context.Background()
+	now := time.Unix(1700000000, 123)
+
+	temp, err := BindPointByName[float32](conn, "typed.float32")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := temp.Write(ctx, now, 21.5, QualityGood); err != nil {
+		t.Fatal(err)
+	}
+	value, ts, quality, err := temp.Read(ctx, RtdbHisModeExact, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value != 21.5 || !ts.Equal(now) || quality != QualityGood {
+		t.Error("读取float32失败", value, ts, quality)
+	}
+
+	name, err := BindPointByName[string](conn, "typed.string")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := name.Write(ctx, now, "温度", QualityGood); err != nil {
+		t.Fatal(err)
+	}
+	if str, _, _, err := name.Read(ctx, RtdbHisModeExact, now); err != nil || str != "温度" {
+		t.Error("读取string失败", str, err)
+	}
+
+	blob, err := BindPointByName[[]byte](conn, "typed.blob")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := blob.Write(ctx, now, []byte{1, 2, 3}, QualityGood); err != nil {
+		t.Fatal(err)
+	}
+	if data, _, _, err := blob.Read(ctx, RtdbHisModeExactOrPrev, now.Add(time.Second)); err != nil || !bytes.Equal(data, []byte{1, 2, 3}) {
+		t.Error("读取blob失败", data, err)
+	}
+
+	coor, err := BindPointByName[Coordinates](conn, "typed.coor")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := coor.Write(ctx, now, Coordinates{X: 1, Y: 2}, QualityGood); err != nil {
+		t.Fatal(err)
+	}
+	if xy, _, _, err := coor.Read(ctx, RtdbHisModeExact, now); err != nil || xy != (Coordinates{X: 1, Y: 2}) {
+		t.Error("读取coor失败", xy, err)
+	}
+
+	// 数值类型不匹配
+	if _, err := BindPoint[int32](conn, temp.Info()); !errors.Is(err, ErrValueTypeMismatch) {
+		t.Error("期望数值类型不匹配", err)
+	}
+	if _, err := BindPointByName[float64](conn, "typed.float32"); !errors.Is(err, ErrValueTypeMismatch) {
+		t.Error("期望数值类型不匹配", err)
+	}
+
+	// 以服务端的数值类型为准, 调用方传入的 ValueType 不可信
+	forged := *temp.Info()
+	forged.ValueType = ValueTypeInt32
+	if _, err := BindPoint[int32](conn, &forged); !errors.Is(err, ErrValueTypeMismatch) {
+		t.Error("期望按服务端的数值类型检查", err)
+	}
+	if point, err := BindPoint[float32](conn, &forged); err != nil || point.Info().ValueType != ValueTypeFloat32 {
+		t.Error("期望绑定服务端的标签点信息", err)
+	}
+}
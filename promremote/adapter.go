@@ -0,0 +1,572 @@
+// Package promremote 实现Prometheus的remote-write与remote-read, 使Prometheus、Grafana等工具可以直接读写数据库
+//
+// 每个时间序列对应一个标签点, 标签与标签点全名的映射关系参见 Mapping
+package promremote
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/snappy"
+	rtdb "github.com/kkbase/rtdb_api"
+)
+
+// Mapping 标签与标签点全名的映射
+//   - 表名称为 TableLabel 标签的值, TableLabel 为空时为 Table
+//   - 标签点名称为 PointLabels 中各个标签的值, 使用 Separator 拼接
+//   - 有多个 PointLabels 时, 标签值中的'%'以及 Separator 中的字符按 %XX 转义, 保证标签点名称可以还原, 例如 node_load1 与 a 拼接为 node%5Fload1_a
+//   - 其他标签在写入时被忽略, 读取时不会返回
+type Mapping struct {
+	// Table 表名称, 为空时为 "prometheus"
+	Table string
+
+	// TableLabel 作为表名称的标签, 为空时使用 Table
+	TableLabel string
+
+	// PointLabels 组成标签点名称的标签, 为空时只有 __name__
+	PointLabels []string
+
+	// Separator 拼接标签点名称的分隔符, 为空时为 "_", 不能包含'%'
+	Separator string
+}
+
+// Options 适配器的选项
+type Options struct {
+	Mapping
+
+	// AutoCreate remote-write时是否自动创建不存在的标签点(以及表)
+	AutoCreate bool
+
+	// Point 自动创建标签点时使用的属性模板, 名称与表会被替换, 为nil时为毫秒精度的float64基本点
+	Point *rtdb.PointInfo
+
+	// BatchSize 每次调用 WriteSection 写入的样本个数, 为0时为1000
+	BatchSize int
+
+	// MaxBodySize 请求体以及snappy解压后的最大字节数, 为0时为32MB
+	MaxBodySize int64
+}
+
+// Adapter Prometheus remote-write 与 remote-read 适配器
+//   - 标签点信息会被缓存, 修改或删除标签点后需要重新创建 Adapter
+type Adapter struct {
+	conn *rtdb.RtdbConnect
+	opts Options
+
+	escaper *strings.Replacer // 转义标签值中的'%'以及分隔符中的字符
+
+	mu     sync.Mutex
+	points map[string]*rtdb.PointInfo
+	tables map[string]rtdb.TableID
+}
+
+// New 创建适配器
+//
+// input:
+//   - conn 数据库连接
+//   - opts 选项
+func New(conn *rtdb.RtdbConnect, opts Options) *Adapter {
+	if opts.Table == "" {
+		opts.Table = "prometheus"
+	}
+	if len(opts.PointLabels) == 0 {
+		opts.PointLabels = []string{"__name__"}
+	}
+	if opts.Separator == "" {
+		opts.Separator = "_"
+	}
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = 1000
+	}
+	if opts.MaxBodySize <= 0 {
+		opts.MaxBodySize = 32 << 20
+	}
+	pairs := make([]string, 0)
+	for _, r := range "%" + opts.Separator {
+		if !slices.Contains(pairs, string(r)) {
+			pairs = append(pairs, string(r), percentEscape(string(r)))
+		}
+	}
+	return &Adapter{conn: conn, opts: opts, escaper: strings.NewReplacer(pairs...), points: make(map[string]*rtdb.PointInfo)}
+}
+
+// percentEscape 将每个字节转义为 %XX
+func percentEscape(s string) string {
+	b := strings.Builder{}
+	for i := 0; i < len(s); i++ {
+		_, _ = fmt.Fprintf(&b, "%%%02X", s[i])
+	}
+	return b.String()
+}
+
+// escape 转义组成标签点名称的标签值, 只有一个标签时不需要转义
+func (a *Adapter) escape(value string) string {
+	if len(a.opts.PointLabels) == 1 {
+		return value
+	}
+	return a.escaper.Replace(value)
+}
+
+// staleNaN Prometheus用于标记序列结束的NaN
+const staleNaN = 0x7ff0000000000002
+
+// tableDotTag 根据标签生成标签点全名
+func (a *Adapter) tableDotTag(labels []Label) (string, error) {
+	value := func(name string) string {
+		for _, l := range labels {
+			if l.Name == name {
+				return l.Value
+			}
+		}
+		return ""
+	}
+	table := a.opts.Table
+	if a.opts.TableLabel != "" {
+		if table = value(a.opts.TableLabel); table == "" {
+			return "", fmt.Errorf("缺少作为表名称的标签%s", a.opts.TableLabel)
+		}
+	}
+	parts := make([]string, len(a.opts.PointLabels))
+	for i, name := range a.opts.PointLabels {
+		if parts[i] = value(name); parts[i] == "" {
+			return "", fmt.Errorf("缺少作为标签点名称的标签%s", name)
+		}
+		parts[i] = a.escape(parts[i])
+	}
+	return table + "." + strings.Join(parts, a.opts.Separator), nil
+}
+
+// labels 根据标签点全名还原标签, 按名称排序, 无法还原时返回nil
+func (a *Adapter) labels(tableDotTag string) []Label {
+	table, tag, _ := strings.Cut(tableDotTag, ".")
+	parts := []string{tag}
+	if len(a.opts.PointLabels) != 1 {
+		parts = strings.Split(tag, a.opts.Separator)
+		if len(parts) != len(a.opts.PointLabels) {
+			return nil
+		}
+		for i, part := range parts {
+			value, err := url.PathUnescape(part)
+			if err != nil || value == "" {
+				return nil
+			}
+			parts[i] = value
+		}
+	}
+	labels := make([]Label, 0, len(parts)+1)
+	if a.opts.TableLabel != "" {
+		labels = append(labels, Label{Name: a.opts.TableLabel, Value: table})
+	}
+	for i, name := range a.opts.PointLabels {
+		labels = append(labels, Label{Name: name, Value: parts[i]})
+	}
+	sort.Slice(labels, func(i, j int) bool { return labels[i].Name < labels[j].Name })
+	return labels
+}
+
+// point 查找标签点, 不存在时根据选项自动创建
+func (a *Adapter) point(tableDotTag string) (*rtdb.PointInfo, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if info, ok := a.points[tableDotTag]; ok {
+		return info, nil
+	}
+	infos, errs, err := a.conn.FindPoints([]string{tableDotTag})
+	if err != nil {
+		return nil, err
+	}
+	info := infos[0]
+	if errs[0] != nil {
+		if !errors.Is(errs[0], rtdb.RtePointNotFound) && !errors.Is(errs[0], rtdb.RteTableNotFound) {
+			return nil, errs[0]
+		}
+		if !a.opts.AutoCreate {
+			return nil, fmt.Errorf("%s: %w", tableDotTag, rtdb.RtePointNotFound)
+		}
+		if info, err = a.create(tableDotTag); err != nil {
+			return nil, fmt.Errorf("%s: %w", tableDotTag, err)
+		}
+	}
+	a.points[tableDotTag] = info
+	return info, nil
+}
+
+// create 创建标签点, 表不存在时同时创建表
+func (a *Adapter) create(tableDotTag string) (*rtdb.PointInfo, error) {
+	table, name, _ := strings.Cut(tableDotTag, ".")
+	if a.tables == nil {
+		tables, err := a.conn.GetTables()
+		if err != nil {
+			return nil, err
+		}
+		a.tables = make(map[string]rtdb.TableID, len(tables))
+		for _, t := range tables {
+			a.tables[t.Name] = t.ID
+		}
+	}
+	tableID, ok := a.tables[table]
+	if !ok {
+		created, err := a.conn.CreateTable(table, "")
+		if err != nil {
+			return nil, err
+		}
+		tableID = created.ID
+		a.tables[table] = tableID
+	}
+	info := rtdb.NewPointInfo(name, tableID, rtdb.ValueTypeFloat64, rtdb.PointBase, rtdb.RtdbPrecisionMilli, "", "")
+	if a.opts.Point != nil {
+		point := *a.opts.Point
+		point.Name, point.TableID = name, tableID
+		info = &point
+	}
+	return a.conn.AddPoint(info)
+}
+
+// intRanges 整数类型的取值范围
+var intRanges = map[rtdb.RtdbType][2]float64{
+	rtdb.RtdbTypeUint8:  {0, math.MaxUint8},
+	rtdb.RtdbTypeInt8:   {math.MinInt8, math.MaxInt8},
+	rtdb.RtdbTypeChar:   {0, math.MaxUint8},
+	rtdb.RtdbTypeUint16: {0, math.MaxUint16},
+	rtdb.RtdbTypeInt16:  {math.MinInt16, math.MaxInt16},
+	rtdb.RtdbTypeUint32: {0, math.MaxUint32},
+	rtdb.RtdbTypeInt32:  {math.MinInt32, math.MaxInt32},
+	rtdb.RtdbTypeInt64:  {math.MinInt64, math.MaxInt64},
+}
+
+// sampleTvq 样本转换成标签点数值类型的TVQ, 只支持bool、整数与浮点数类型, 整数类型的样本需要没有小数部分且不超出类型范围
+func sampleTvq(vt rtdb.ValueType, s Sample) (rtdb.TVQ, error) {
+	ts := time.UnixMilli(s.Timestamp)
+	tvq := rtdb.TVQ{Timestamp: ts, Type: vt, Quality: rtdb.QualityGood}
+	rtdbType, _ := vt.ToRawType()
+	switch rtdbType {
+	case rtdb.RtdbTypeBool:
+		tvq.Value.IntValue = rtdb.BoolToInt64(s.Value != 0)
+	case rtdb.RtdbTypeUint8, rtdb.RtdbTypeInt8, rtdb.RtdbTypeChar, rtdb.RtdbTypeUint16, rtdb.RtdbTypeInt16, rtdb.RtdbTypeUint32, rtdb.RtdbTypeInt32, rtdb.RtdbTypeInt64:
+		if s.Value != math.Trunc(s.Value) || math.IsInf(s.Value, 0) {
+			return rtdb.TVQ{}, fmt.Errorf("%v不能写入%s类型的标签点", s.Value, vt)
+		}
+		// float64(math.MaxInt64) 为 2^63, 等于上限时已经溢出
+		bounds := intRanges[rtdbType]
+		if s.Value < bounds[0] || s.Value > bounds[1] || s.Value >= math.MaxInt64 {
+			return rtdb.TVQ{}, fmt.Errorf("%v超出%s类型的范围", s.Value, vt)
+		}
+		tvq.Value.IntValue = int64(s.Value)
+	case rtdb.RtdbTypeReal16, rtdb.RtdbTypeReal32, rtdb.RtdbTypeReal64, rtdb.RtdbTypeFp16, rtdb.RtdbTypeFp32, rtdb.RtdbTypeFp64:
+		tvq.Value.FloatValue = s.Value
+	default:
+		return rtdb.TVQ{}, fmt.Errorf("不支持写入%s类型的标签点", vt)
+	}
+	return tvq, nil
+}
+
+// tvqSample TVQ转换成样本, 不是数字类型时返回false
+func tvqSample(tvq rtdb.TVQ) (Sample, bool) {
+	rtdbType, _ := tvq.Type.ToRawType()
+	s := Sample{Timestamp: tvq.Timestamp.UnixMilli()}
+	switch rtdbType {
+	case rtdb.RtdbTypeBool, rtdb.RtdbTypeUint8, rtdb.RtdbTypeInt8, rtdb.RtdbTypeChar, rtdb.RtdbTypeUint16, rtdb.RtdbTypeInt16, rtdb.RtdbTypeUint32, rtdb.RtdbTypeInt32, rtdb.RtdbTypeInt64:
+		s.Value = float64(tvq.Value.IntValue)
+	case rtdb.RtdbTypeReal16, rtdb.RtdbTypeReal32, rtdb.RtdbTypeReal64, rtdb.RtdbTypeFp16, rtdb.RtdbTypeFp32, rtdb.RtdbTypeFp64:
+		s.Value = tvq.Value.FloatValue
+	default:
+		return s, false
+	}
+	return s, true
+}
+
+// Write 将remote-write请求写入数据库, 标记序列结束的stale NaN被忽略
+//   - 单个序列或样本的错误记录在错误列表中, 不影响其他样本
+//
+// output:
+//   - int(count) 成功写入的样本个数
+//   - []error(errs) 错误列表
+func (a *Adapter) Write(req *WriteRequest) (int, []error, error) {
+	count, errs := 0, make([]error, 0)
+	batch := make([]rtdb.PTVQ, 0, a.opts.BatchSize)
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		writeErrs, err := a.conn.WriteSection(false, batch)
+		if err != nil {
+			return err
+		}
+		for i, e := range writeErrs {
+			if e != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", batch[i].PointInfo.TableDotTag, e))
+			} else {
+				count++
+			}
+		}
+		batch = batch[:0]
+		return nil
+	}
+
+	for _, ts := range req.Timeseries {
+		tableDotTag, err := a.tableDotTag(ts.Labels)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		info, err := a.point(tableDotTag)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		for _, s := range ts.Samples {
+			if math.Float64bits(s.Value) == staleNaN {
+				continue
+			}
+			tvq, err := sampleTvq(info.ValueType, s)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", tableDotTag, err))
+				continue
+			}
+			batch = append(batch, rtdb.PTVQ{PointInfo: info, TVQ: tvq})
+			if len(batch) >= a.opts.BatchSize {
+				if err := flush(); err != nil {
+					return count, errs, err
+				}
+			}
+		}
+	}
+	if err := flush(); err != nil {
+		return count, errs, err
+	}
+	return count, errs, nil
+}
+
+// matcher 编译后的标签匹配条件
+type matcher struct {
+	LabelMatcher
+	re *regexp.Regexp
+}
+
+// matches 判断标签值是否满足条件, 不存在的标签的值为空字符串
+func (m *matcher) matches(labels []Label) bool {
+	value := ""
+	for _, l := range labels {
+		if l.Name == m.Name {
+			value = l.Value
+			break
+		}
+	}
+	switch m.Type {
+	case MatchEqual:
+		return value == m.Value
+	case MatchNotEqual:
+		return value != m.Value
+	case MatchRegexp:
+		return m.re.MatchString(value)
+	default:
+		return !m.re.MatchString(value)
+	}
+}
+
+// masks 根据等于条件生成 SearchPoint 使用的表名称与标签点名称掩码
+func (a *Adapter) masks(matchers []LabelMatcher) (string, string) {
+	equal := func(name string) (string, bool) {
+		for _, m := range matchers {
+			if m.Type == MatchEqual && m.Name == name && m.Value != "" && !strings.ContainsAny(m.Value, "*?") {
+				return m.Value, true
+			}
+		}
+		return "", false
+	}
+	tableMask := a.opts.Table
+	if a.opts.TableLabel != "" {
+		var ok bool
+		if tableMask, ok = equal(a.opts.TableLabel); !ok {
+			tableMask = "*"
+		}
+	}
+	parts := make([]string, len(a.opts.PointLabels))
+	for i, name := range a.opts.PointLabels {
+		var ok bool
+		if parts[i], ok = equal(name); ok {
+			parts[i] = a.escape(parts[i])
+		} else {
+			parts[i] = "*"
+		}
+	}
+	tagMask := strings.Join(parts, a.opts.Separator)
+	for strings.Contains(tagMask, "*"+a.opts.Separator+"*") {
+		tagMask = strings.ReplaceAll(tagMask, "*"+a.opts.Separator+"*", "*")
+	}
+	return tableMask, tagMask
+}
+
+// searchPageSize 每次搜索的标签点个数
+const searchPageSize = 1000
+
+// query 执行单个查询
+func (a *Adapter) query(q Query) (QueryResult, error) {
+	result := QueryResult{Timeseries: make([]TimeSeries, 0)}
+	matchers := make([]matcher, len(q.Matchers))
+	for i, m := range q.Matchers {
+		matchers[i].LabelMatcher = m
+		if m.Type == MatchRegexp || m.Type == MatchNotRegexp {
+			re, err := regexp.Compile("^(?:" + m.Value + ")$")
+			if err != nil {
+				return result, err
+			}
+			matchers[i].re = re
+		}
+	}
+
+	tableMask, tagMask := a.masks(q.Matchers)
+	start, end := time.UnixMilli(q.StartTimestampMs), time.UnixMilli(q.EndTimestampMs)
+	for offset := int32(0); ; {
+		total, infos, errs, err := a.conn.SearchPoint(offset, searchPageSize, tagMask, tableMask, "", "", "", "", "", rtdb.RtdbTypeAny, rtdb.RtdbPrecisionAny, rtdb.RtdbSearchNull, "", 0)
+		if err != nil {
+			return result, err
+		}
+	points:
+		for i, info := range infos {
+			if errs[i] != nil {
+				return result, errs[i]
+			}
+			if _, ok := tvqSample(rtdb.TVQ{Type: info.ValueType}); !ok {
+				continue
+			}
+			labels := a.labels(info.TableDotTag)
+			if labels == nil {
+				continue
+			}
+			for _, m := range matchers {
+				if !m.matches(labels) {
+					continue points
+				}
+			}
+			series := TimeSeries{Labels: labels}
+			for tvq, err := range a.conn.ArchivedValues(info, start, end) {
+				if err != nil {
+					return result, err
+				}
+				s, _ := tvqSample(tvq)
+				series.Samples = append(series.Samples, s)
+			}
+			if len(series.Samples) != 0 {
+				result.Timeseries = append(result.Timeseries, series)
+			}
+		}
+		offset += int32(len(infos))
+		if len(infos) == 0 || offset >= total {
+			break
+		}
+	}
+	return result, nil
+}
+
+// Read 执行remote-read请求, 根据标签匹配条件搜索标签点并读取历史数据
+//   - 等于条件用于生成 SearchPoint 的掩码, 所有条件再对还原的标签逐一匹配
+//   - 只返回bool、整数与浮点数类型的标签点, 不包含质量码
+func (a *Adapter) Read(req *ReadRequest) (*ReadResponse, error) {
+	resp := &ReadResponse{Results: make([]QueryResult, 0, len(req.Queries))}
+	for _, q := range req.Queries {
+		result, err := a.query(q)
+		if err != nil {
+			return nil, err
+		}
+		resp.Results = append(resp.Results, result)
+	}
+	return resp, nil
+}
+
+// readSnappyBody 读取并解压snappy压缩的请求体, 请求体或者解压后的长度超过 MaxBodySize 时返回 *http.MaxBytesError
+func (a *Adapter) readSnappyBody(w http.ResponseWriter, r *http.Request) ([]byte, error) {
+	compressed, err := io.ReadAll(http.MaxBytesReader(w, r.Body, a.opts.MaxBodySize))
+	if err != nil {
+		return nil, err
+	}
+	// 解压前按头部记录的长度检查, 避免按伪造的长度分配内存
+	n, err := snappy.DecodedLen(compressed)
+	if err != nil {
+		return nil, err
+	}
+	if int64(n) > a.opts.MaxBodySize {
+		return nil, &http.MaxBytesError{Limit: a.opts.MaxBodySize}
+	}
+	return snappy.Decode(nil, compressed)
+}
+
+// bodyError 读取请求体失败时的响应, 超过 MaxBodySize 时返回413
+func bodyError(w http.ResponseWriter, err error) {
+	var tooLarge *http.MaxBytesError
+	if errors.As(err, &tooLarge) {
+		http.Error(w, fmt.Sprintf("请求体超过%d字节", tooLarge.Limit), http.StatusRequestEntityTooLarge)
+		return
+	}
+	http.Error(w, err.Error(), http.StatusBadRequest)
+}
+
+// WriteHandler remote-write的 http.Handler, 可以挂载到 /api/v1/write
+//   - 全部写入成功时返回204, 部分失败时返回400(Prometheus不会重试), 连接等错误返回500(Prometheus会重试)
+//   - 请求体或者解压后的长度超过 MaxBodySize 时返回413
+func (a *Adapter) WriteHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "只支持POST", http.StatusMethodNotAllowed)
+			return
+		}
+		data, err := a.readSnappyBody(w, r)
+		if err != nil {
+			bodyError(w, err)
+			return
+		}
+		req := WriteRequest{}
+		if err := req.Unmarshal(data); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		count, errs, err := a.Write(&req)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		if len(errs) != 0 {
+			http.Error(w, fmt.Sprintf("写入%d个样本, 失败%d个: %v", count, len(errs), errs[0]), http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// ReadHandler remote-read的 http.Handler, 可以挂载到 /api/v1/read, 响应类型为SAMPLES
+func (a *Adapter) ReadHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "只支持POST", http.StatusMethodNotAllowed)
+			return
+		}
+		data, err := a.readSnappyBody(w, r)
+		if err != nil {
+			bodyError(w, err)
+			return
+		}
+		req := ReadRequest{}
+		if err := req.Unmarshal(data); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		resp, err := a.Read(&req)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/x-protobuf")
+		w.Header().Set("Content-Encoding", "snappy")
+		_, _ = w.Write(snappy.Encode(nil, resp.Marshal()))
+	})
+}
@@ -0,0 +1,227 @@
+package promremote
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/golang/snappy"
+	rtdb "github.com/kkbase/rtdb_api"
+)
+
+// post 发送snappy压缩的protobuf请求
+func post(t *testing.T, url string, body []byte) *http.Response {
+	resp, err := http.Post(url, "application/x-protobuf", bytes.NewReader(snappy.Encode(nil, body)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resp
+}
+
+// 通过HTTP执行remote-write与remote-read
+func TestAdapter(t *testing.T) {
+	conn, err := rtdb.LoginWithBackend(rtdb.NewMemoryBackend(), "127.0.0.1", 6327, "sa", "golden")
+	if err != nil {
+		t.Fatal("登录用户失败", err)
+	}
+	defer func() { _ = conn.Logout() }()
+
+	adapter := New(conn, Options{
+		Mapping:    Mapping{TableLabel: "job", PointLabels: []string{"__name__", "instance"}},
+		AutoCreate: true,
+		BatchSize:  2,
+	})
+	mux := http.NewServeMux()
+	mux.Handle("/api/v1/write", adapter.WriteHandler())
+	mux.Handle("/api/v1/read", adapter.ReadHandler())
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	series := func(name, instance string, samples ...Sample) TimeSeries {
+		return TimeSeries{
+			Labels:  []Label{{"__name__", name}, {"job", "node"}, {"instance", instance}, {"env", "prod"}},
+			Samples: samples,
+		}
+	}
+	write := WriteRequest{Timeseries: []TimeSeries{
+		series("up", "a", Sample{1, 1700000000000}, Sample{1, 1700000015000}),
+		series("up", "b", Sample{0, 1700000000000}, Sample{math.Float64frombits(staleNaN), 1700000015000}),
+		series("load", "a", Sample{0.5, 1700000000000}),
+		{Labels: []Label{{"__name__", "up"}}, Samples: []Sample{{1, 1700000000000}}},
+	}}
+	resp := post(t, server.URL+"/api/v1/write", write.Marshal())
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Error("缺少标签时期望返回400", resp.StatusCode)
+	}
+	write.Timeseries = write.Timeseries[:3]
+	resp = post(t, server.URL+"/api/v1/write", write.Marshal())
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusNoContent {
+		t.Fatal("期望写入成功", resp.StatusCode)
+	}
+
+	read := ReadRequest{Queries: []Query{
+		{
+			StartTimestampMs: 1700000000000, EndTimestampMs: 1700000015000,
+			Matchers: []LabelMatcher{{MatchEqual, "__name__", "up"}, {MatchEqual, "job", "node"}},
+		},
+		{
+			StartTimestampMs: 1700000000000, EndTimestampMs: 1700000000000,
+			Matchers: []LabelMatcher{{MatchRegexp, "__name__", "up|load"}, {MatchNotEqual, "instance", "b"}},
+		},
+		{
+			StartTimestampMs: 1700000000000, EndTimestampMs: 1700000015000,
+			Matchers: []LabelMatcher{{MatchNotRegexp, "instance", "a|b"}},
+		},
+	}}
+	resp = post(t, server.URL+"/api/v1/read", read.Marshal())
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK || resp.Header.Get("Content-Encoding") != "snappy" {
+		t.Fatal("读取失败", resp.StatusCode)
+	}
+	compressed, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := snappy.Decode(nil, compressed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := ReadResponse{}
+	if err := got.Unmarshal(data); err != nil {
+		t.Fatal(err)
+	}
+
+	labels := func(name, instance string) []Label {
+		return []Label{{"__name__", name}, {"instance", instance}, {"job", "node"}}
+	}
+	want := ReadResponse{Results: []QueryResult{
+		{Timeseries: []TimeSeries{
+			{Labels: labels("up", "a"), Samples: []Sample{{1, 1700000000000}, {1, 1700000015000}}},
+			{Labels: labels("up", "b"), Samples: []Sample{{0, 1700000000000}}},
+		}},
+		{Timeseries: []TimeSeries{
+			{Labels: labels("load", "a"), Samples: []Sample{{0.5, 1700000000000}}},
+			{Labels: labels("up", "a"), Samples: []Sample{{1, 1700000000000}}},
+		}},
+		{},
+	}}
+	if len(got.Results) != len(want.Results) {
+		t.Fatalf("查询结果个数错误 %+v", got)
+	}
+	for i := range want.Results {
+		if !reflect.DeepEqual(got.Results[i].Timeseries, want.Results[i].Timeseries) {
+			t.Errorf("查询%d结果错误\n%+v", i, got.Results[i].Timeseries)
+		}
+	}
+}
+
+// 标签值中包含分隔符时标签点名称可以无歧义地还原
+func TestAdapter_Underscore(t *testing.T) {
+	conn, err := rtdb.LoginWithBackend(rtdb.NewMemoryBackend(), "127.0.0.1", 6327, "sa", "golden")
+	if err != nil {
+		t.Fatal("登录用户失败", err)
+	}
+	defer func() { _ = conn.Logout() }()
+
+	adapter := New(conn, Options{Mapping: Mapping{PointLabels: []string{"__name__", "instance"}}, AutoCreate: true})
+	labels := func(name, instance string) []Label {
+		return []Label{{"__name__", name}, {"instance", instance}}
+	}
+	write := WriteRequest{Timeseries: []TimeSeries{
+		{Labels: labels("node_load1", "a"), Samples: []Sample{{1, 1700000000000}}},
+		{Labels: labels("node_load", "1_a"), Samples: []Sample{{2, 1700000000000}}},
+		{Labels: labels("node_load1_", "a%5F"), Samples: []Sample{{3, 1700000000000}}},
+	}}
+	count, errs, err := adapter.Write(&write)
+	if err != nil || count != 3 || len(errs) != 0 {
+		t.Fatal("写入失败", count, errs, err)
+	}
+	if tag, _ := adapter.tableDotTag(labels("node_load1", "a")); tag != "prometheus.node%5Fload1_a" {
+		t.Error("标签点名称错误", tag)
+	}
+
+	resp, err := adapter.Read(&ReadRequest{Queries: []Query{
+		{StartTimestampMs: 1700000000000, EndTimestampMs: 1700000000000, Matchers: []LabelMatcher{{MatchEqual, "__name__", "node_load1"}}},
+		{StartTimestampMs: 1700000000000, EndTimestampMs: 1700000000000, Matchers: []LabelMatcher{{MatchRegexp, "__name__", "node_.*"}}},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := [][]TimeSeries{
+		{{Labels: labels("node_load1", "a"), Samples: []Sample{{1, 1700000000000}}}},
+		{
+			{Labels: labels("node_load1", "a"), Samples: []Sample{{1, 1700000000000}}},
+			{Labels: labels("node_load", "1_a"), Samples: []Sample{{2, 1700000000000}}},
+			{Labels: labels("node_load1_", "a%5F"), Samples: []Sample{{3, 1700000000000}}},
+		},
+	}
+	for i, w := range want {
+		got := resp.Results[i].Timeseries
+		sort.Slice(got, func(a, b int) bool { return got[a].Samples[0].Value < got[b].Samples[0].Value })
+		if !reflect.DeepEqual(got, w) {
+			t.Errorf("查询%d结果错误\n%+v", i, got)
+		}
+	}
+}
+
+// 请求体以及snappy头部记录的解压长度超过 MaxBodySize 时返回413
+func TestAdapter_BodySize(t *testing.T) {
+	conn, err := rtdb.LoginWithBackend(rtdb.NewMemoryBackend(), "127.0.0.1", 6327, "sa", "golden")
+	if err != nil {
+		t.Fatal("登录用户失败", err)
+	}
+	defer func() { _ = conn.Logout() }()
+	server := httptest.NewServer(New(conn, Options{MaxBodySize: 1024}).WriteHandler())
+	defer server.Close()
+
+	send := func(body []byte) int {
+		resp, err := http.Post(server.URL, "application/x-protobuf", bytes.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		_ = resp.Body.Close()
+		return resp.StatusCode
+	}
+	// 头部声明解压后约4GiB
+	forged := append(binary.AppendUvarint(nil, 4<<30-1), 0, 0, 0, 0)
+	if code := send(forged); code != http.StatusRequestEntityTooLarge {
+		t.Error("伪造的解压长度期望返回413", code)
+	}
+	if code := send(make([]byte, 2048)); code != http.StatusRequestEntityTooLarge {
+		t.Error("请求体过大期望返回413", code)
+	}
+	if code := send(snappy.Encode(nil, make([]byte, 2048))); code != http.StatusRequestEntityTooLarge {
+		t.Error("解压后过大期望返回413", code)
+	}
+}
+
+// 整数类型的样本超出类型范围时返回错误
+func TestSampleTvq(t *testing.T) {
+	cases := []struct {
+		vt    rtdb.ValueType
+		value float64
+		ok    bool
+	}{
+		{rtdb.ValueTypeInt8, 127, true},
+		{rtdb.ValueTypeInt8, 128, false},
+		{rtdb.ValueTypeUint16, -1, false},
+		{rtdb.ValueTypeInt32, 1.5, false},
+		{rtdb.ValueTypeInt64, -9.2e18, true},
+		{rtdb.ValueTypeInt64, 9.3e18, false},
+		{rtdb.ValueTypeInt64, math.NaN(), false},
+		{rtdb.ValueTypeFloat32, 1e40, true},
+	}
+	for _, c := range cases {
+		if _, err := sampleTvq(c.vt, Sample{Value: c.value}); (err == nil) != c.ok {
+			t.Errorf("%s类型写入%v的结果错误 %v", c.vt, c.value, err)
+		}
+	}
+}
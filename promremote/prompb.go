@@ -0,0 +1,409 @@
+package promremote
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// 以下消息与Prometheus的prompb(remote.proto、types.proto)兼容, 只包含读写样本需要的字段, 其余字段在解码时被忽略
+
+// Label 标签
+type Label struct {
+	Name  string
+	Value string
+}
+
+// Sample 样本
+type Sample struct {
+	Value float64
+
+	// Timestamp 毫秒时间戳
+	Timestamp int64
+}
+
+// TimeSeries 时间序列
+type TimeSeries struct {
+	Labels  []Label
+	Samples []Sample
+}
+
+// WriteRequest remote-write的请求
+type WriteRequest struct {
+	Timeseries []TimeSeries
+}
+
+// MatchType 标签匹配方式
+type MatchType int32
+
+const (
+	// MatchEqual 等于 =
+	MatchEqual = MatchType(0)
+
+	// MatchNotEqual 不等于 !=
+	MatchNotEqual = MatchType(1)
+
+	// MatchRegexp 正则匹配 =~
+	MatchRegexp = MatchType(2)
+
+	// MatchNotRegexp 正则不匹配 !~
+	MatchNotRegexp = MatchType(3)
+)
+
+// LabelMatcher 标签匹配条件
+type LabelMatcher struct {
+	Type  MatchType
+	Name  string
+	Value string
+}
+
+// Query remote-read的查询
+type Query struct {
+	// StartTimestampMs 开始时间(包含), 毫秒时间戳
+	StartTimestampMs int64
+
+	// EndTimestampMs 结束时间(包含), 毫秒时间戳
+	EndTimestampMs int64
+
+	Matchers []LabelMatcher
+}
+
+// ReadRequest remote-read的请求
+type ReadRequest struct {
+	Queries []Query
+
+	// AcceptedResponseTypes 客户端接受的响应类型, 0为SAMPLES, 1为STREAMED_XOR_CHUNKS, 本适配器只返回SAMPLES
+	AcceptedResponseTypes []int32
+}
+
+// QueryResult 单个查询的结果
+type QueryResult struct {
+	Timeseries []TimeSeries
+}
+
+// ReadResponse remote-read的响应
+type ReadResponse struct {
+	Results []QueryResult
+}
+
+// protobuf的编码类型
+const (
+	wireVarint  = 0
+	wireFixed64 = 1
+	wireBytes   = 2
+	wireFixed32 = 5
+)
+
+// errTruncated 数据不完整
+var errTruncated = errors.New("protobuf数据不完整")
+
+// encoder protobuf编码
+type encoder struct {
+	buf []byte
+}
+
+func (e *encoder) key(field int, wire int) {
+	e.buf = binary.AppendUvarint(e.buf, uint64(field<<3|wire))
+}
+
+func (e *encoder) varint(field int, v int64) {
+	if v == 0 {
+		return
+	}
+	e.key(field, wireVarint)
+	e.buf = binary.AppendUvarint(e.buf, uint64(v))
+}
+
+func (e *encoder) double(field int, v float64) {
+	if v == 0 && !math.Signbit(v) {
+		return
+	}
+	e.key(field, wireFixed64)
+	e.buf = binary.LittleEndian.AppendUint64(e.buf, math.Float64bits(v))
+}
+
+func (e *encoder) string(field int, v string) {
+	if v == "" {
+		return
+	}
+	e.key(field, wireBytes)
+	e.buf = binary.AppendUvarint(e.buf, uint64(len(v)))
+	e.buf = append(e.buf, v...)
+}
+
+// message 编码嵌套的消息
+func (e *encoder) message(field int, write func(e *encoder)) {
+	sub := encoder{}
+	write(&sub)
+	e.key(field, wireBytes)
+	e.buf = binary.AppendUvarint(e.buf, uint64(len(sub.buf)))
+	e.buf = append(e.buf, sub.buf...)
+}
+
+// decode 依次解码消息中的字段, 未知的字段被跳过
+//
+// input:
+//   - data 消息
+//   - fn 处理字段, 对于varint与fixed类型v为数值, 对于bytes类型b为内容
+func decode(data []byte, fn func(field int, wire int, v uint64, b []byte) error) error {
+	for len(data) > 0 {
+		key, n := binary.Uvarint(data)
+		if n <= 0 {
+			return errTruncated
+		}
+		data = data[n:]
+		field, wire := int(key>>3), int(key&7)
+		var v uint64
+		var b []byte
+		switch wire {
+		case wireVarint:
+			v, n = binary.Uvarint(data)
+			if n <= 0 {
+				return errTruncated
+			}
+			data = data[n:]
+		case wireFixed64:
+			if len(data) < 8 {
+				return errTruncated
+			}
+			v, data = binary.LittleEndian.Uint64(data), data[8:]
+		case wireFixed32:
+			if len(data) < 4 {
+				return errTruncated
+			}
+			v, data = uint64(binary.LittleEndian.Uint32(data)), data[4:]
+		case wireBytes:
+			size, n := binary.Uvarint(data)
+			if n <= 0 || uint64(len(data)-n) < size {
+				return errTruncated
+			}
+			b, data = data[n:n+int(size)], data[n+int(size):]
+		default:
+			return fmt.Errorf("不支持的protobuf编码类型%d", wire)
+		}
+		if err := fn(field, wire, v, b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (l *Label) marshal(e *encoder) {
+	e.string(1, l.Name)
+	e.string(2, l.Value)
+}
+
+func (l *Label) unmarshal(data []byte) error {
+	return decode(data, func(field int, wire int, v uint64, b []byte) error {
+		switch field {
+		case 1:
+			l.Name = string(b)
+		case 2:
+			l.Value = string(b)
+		}
+		return nil
+	})
+}
+
+func (s *Sample) marshal(e *encoder) {
+	e.double(1, s.Value)
+	e.varint(2, s.Timestamp)
+}
+
+func (s *Sample) unmarshal(data []byte) error {
+	return decode(data, func(field int, wire int, v uint64, b []byte) error {
+		switch field {
+		case 1:
+			s.Value = math.Float64frombits(v)
+		case 2:
+			s.Timestamp = int64(v)
+		}
+		return nil
+	})
+}
+
+func (ts *TimeSeries) marshal(e *encoder) {
+	for i := range ts.Labels {
+		e.message(1, ts.Labels[i].marshal)
+	}
+	for i := range ts.Samples {
+		e.message(2, ts.Samples[i].marshal)
+	}
+}
+
+func (ts *TimeSeries) unmarshal(data []byte) error {
+	return decode(data, func(field int, wire int, v uint64, b []byte) error {
+		switch field {
+		case 1:
+			l := Label{}
+			if err := l.unmarshal(b); err != nil {
+				return err
+			}
+			ts.Labels = append(ts.Labels, l)
+		case 2:
+			s := Sample{}
+			if err := s.unmarshal(b); err != nil {
+				return err
+			}
+			ts.Samples = append(ts.Samples, s)
+		}
+		return nil
+	})
+}
+
+// Marshal 编码为protobuf
+func (r *WriteRequest) Marshal() []byte {
+	e := encoder{}
+	for i := range r.Timeseries {
+		e.message(1, r.Timeseries[i].marshal)
+	}
+	return e.buf
+}
+
+// Unmarshal 从protobuf解码
+func (r *WriteRequest) Unmarshal(data []byte) error {
+	*r = WriteRequest{}
+	return decode(data, func(field int, wire int, v uint64, b []byte) error {
+		if field == 1 {
+			ts := TimeSeries{}
+			if err := ts.unmarshal(b); err != nil {
+				return err
+			}
+			r.Timeseries = append(r.Timeseries, ts)
+		}
+		return nil
+	})
+}
+
+func (m *LabelMatcher) marshal(e *encoder) {
+	e.varint(1, int64(m.Type))
+	e.string(2, m.Name)
+	e.string(3, m.Value)
+}
+
+func (m *LabelMatcher) unmarshal(data []byte) error {
+	return decode(data, func(field int, wire int, v uint64, b []byte) error {
+		switch field {
+		case 1:
+			m.Type = MatchType(v)
+		case 2:
+			m.Name = string(b)
+		case 3:
+			m.Value = string(b)
+		}
+		return nil
+	})
+}
+
+func (q *Query) marshal(e *encoder) {
+	e.varint(1, q.StartTimestampMs)
+	e.varint(2, q.EndTimestampMs)
+	for i := range q.Matchers {
+		e.message(3, q.Matchers[i].marshal)
+	}
+}
+
+func (q *Query) unmarshal(data []byte) error {
+	return decode(data, func(field int, wire int, v uint64, b []byte) error {
+		switch field {
+		case 1:
+			q.StartTimestampMs = int64(v)
+		case 2:
+			q.EndTimestampMs = int64(v)
+		case 3:
+			m := LabelMatcher{}
+			if err := m.unmarshal(b); err != nil {
+				return err
+			}
+			q.Matchers = append(q.Matchers, m)
+		}
+		return nil
+	})
+}
+
+// Marshal 编码为protobuf
+func (r *ReadRequest) Marshal() []byte {
+	e := encoder{}
+	for i := range r.Queries {
+		e.message(1, r.Queries[i].marshal)
+	}
+	if len(r.AcceptedResponseTypes) != 0 {
+		e.message(2, func(e *encoder) {
+			for _, t := range r.AcceptedResponseTypes {
+				e.buf = binary.AppendUvarint(e.buf, uint64(t))
+			}
+		})
+	}
+	return e.buf
+}
+
+// Unmarshal 从protobuf解码
+func (r *ReadRequest) Unmarshal(data []byte) error {
+	*r = ReadRequest{}
+	return decode(data, func(field int, wire int, v uint64, b []byte) error {
+		switch field {
+		case 1:
+			q := Query{}
+			if err := q.unmarshal(b); err != nil {
+				return err
+			}
+			r.Queries = append(r.Queries, q)
+		case 2:
+			if wire == wireVarint {
+				r.AcceptedResponseTypes = append(r.AcceptedResponseTypes, int32(v))
+				return nil
+			}
+			for len(b) > 0 {
+				t, n := binary.Uvarint(b)
+				if n <= 0 {
+					return errTruncated
+				}
+				r.AcceptedResponseTypes = append(r.AcceptedResponseTypes, int32(t))
+				b = b[n:]
+			}
+		}
+		return nil
+	})
+}
+
+func (qr *QueryResult) marshal(e *encoder) {
+	for i := range qr.Timeseries {
+		e.message(1, qr.Timeseries[i].marshal)
+	}
+}
+
+// Marshal 编码为protobuf
+func (r *ReadResponse) Marshal() []byte {
+	e := encoder{}
+	for i := range r.Results {
+		e.message(1, r.Results[i].marshal)
+	}
+	return e.buf
+}
+
+// Unmarshal 从protobuf解码
+func (r *ReadResponse) Unmarshal(data []byte) error {
+	*r = ReadResponse{}
+	return decode(data, func(field int, wire int, v uint64, b []byte) error {
+		if field != 1 {
+			return nil
+		}
+		qr := QueryResult{}
+		err := decode(b, func(field int, wire int, v uint64, b []byte) error {
+			if field == 1 {
+				ts := TimeSeries{}
+				if err := ts.unmarshal(b); err != nil {
+					return err
+				}
+				qr.Timeseries = append(qr.Timeseries, ts)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		r.Results = append(r.Results, qr)
+		return nil
+	})
+}
@@ -0,0 +1,396 @@
+package rtdb_api
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// QualityReportOptions 数据质量报告选项
+type QualityReportOptions struct {
+	// ScanRate 期望的采集周期, 为0时不检查数据缺失, 可以通过 ScanRates 为每个标签点单独指定
+	ScanRate time.Duration
+
+	// ScanRates 每个标签点的采集周期, 优先于 ScanRate
+	ScanRates map[PointID]time.Duration
+
+	// GapFactor 没有数据的时间超过采集周期的倍数时记为数据缺失, 为0时为3
+	GapFactor float64
+
+	// FlatlineDuration 数值保持不变的时间达到该值时记为死值, 为0时不检查
+	FlatlineDuration time.Duration
+
+	// FlatlineTolerance 与死值开始时的数值相差不超过该值时视为不变
+	FlatlineTolerance float64
+
+	// IgnoreRange 不检查超出量程(LowLimit~HighLimit)的数值, 量程上限不大于下限时也不检查
+	IgnoreRange bool
+}
+
+// FindingKind 数据质量问题的类型
+type FindingKind string
+
+const (
+	// FindingGap 数据缺失
+	FindingGap = FindingKind("gap")
+	// FindingBadQuality 质量码异常
+	FindingBadQuality = FindingKind("bad_quality")
+	// FindingFlatline 死值
+	FindingFlatline = FindingKind("flatline")
+	// FindingOutOfRange 超出量程
+	FindingOutOfRange = FindingKind("out_of_range")
+)
+
+// QualityFinding 一段时间内的数据质量问题
+type QualityFinding struct {
+	Kind   FindingKind `json:"kind"`   // 问题类型
+	Start  time.Time   `json:"start"`  // 开始时间
+	End    time.Time   `json:"end"`    // 结束时间
+	Count  int         `json:"count"`  // 涉及的数值个数, 数据缺失为0
+	Detail string      `json:"detail"` // 说明, 质量码异常时为 GetQualityDesc 返回的质量码说明
+
+	quality Quality // 质量码异常的质量码
+}
+
+// PointQualityReport 单个标签点的数据质量
+type PointQualityReport struct {
+	ID          PointID          `json:"id"`
+	TableDotTag string           `json:"tag"`
+	Count       int              `json:"count"`           // 数值个数
+	BadCount    int              `json:"bad_count"`       // 质量码异常的数值个数
+	Qualities   map[string]int   `json:"qualities"`       // 质量码说明与数值个数
+	Findings    []QualityFinding `json:"findings"`        // 按开始时间排列的问题
+	ScanRate    time.Duration    `json:"scan_rate"`       // 检查数据缺失使用的采集周期, 为0时没有检查数据缺失
+	Error       string           `json:"error,omitempty"` // 读取历史数据或者质量码说明失败的原因
+}
+
+// QualityReport 数据质量报告
+type QualityReport struct {
+	Start  time.Time            `json:"start"`
+	End    time.Time            `json:"end"`
+	Points []PointQualityReport `json:"points"`
+}
+
+// DataQualityReport 检查标签点一段时间内的数据完整性
+//   - 分批读取历史数据, 不会将全部数据读入内存
+//   - 数据缺失: 起止时间与数值之间、相邻数值之间没有数据的时间超过采集周期×GapFactor, 采集周期为 ScanRates 或者 ScanRate,
+//     没有采集周期的标签点不检查数据缺失, PointQualityReport.ScanRate 为0, CSV中输出一行 gap_unchecked
+//   - 质量码异常: 连续的质量码相同且不为 QualityGood 的数值
+//   - 死值与超出量程: 只检查整数与浮点数类型中质量码正常的数值
+//   - 单个标签点读取历史数据或者质量码说明失败时记录在 PointQualityReport.Error 中, 继续检查其他标签点,
+//     质量码说明失败时使用质量码的数值代替说明
+//
+// input:
+//   - infos 标签点信息
+//   - start 开始时间(包含)
+//   - end 结束时间(包含)
+//   - opts 选项
+//
+// output:
+//   - *QualityReport 报告
+func (c *RtdbConnect) DataQualityReport(infos []*PointInfo, start, end time.Time, opts QualityReportOptions) (*QualityReport, error) {
+	if opts.GapFactor <= 0 {
+		opts.GapFactor = 3
+	}
+	report := &QualityReport{Start: start, End: end, Points: make([]PointQualityReport, 0, len(infos))}
+	descs := make(map[Quality]string)
+	for _, info := range infos {
+		a := newQualityAnalyzer(info, start, end, opts)
+		for tvq, err := range c.ArchivedValues(info, start, end) {
+			if err != nil {
+				a.fail(err)
+				break
+			}
+			a.add(tvq)
+		}
+		a.finish()
+		if err := c.describeQualities(descs, a); err != nil {
+			a.fail(err)
+		}
+		report.Points = append(report.Points, a.point)
+	}
+	return report, nil
+}
+
+// describeQualities 使用 GetQualityDesc 的说明填写质量码统计与质量码异常的问题, 获取说明失败时使用质量码的数值
+func (c *RtdbConnect) describeQualities(descs map[Quality]string, a *qualityAnalyzer) error {
+	unknown := make([]Quality, 0)
+	for q := range a.qualities {
+		if _, ok := descs[q]; !ok {
+			unknown = append(unknown, q)
+		}
+	}
+	var err error
+	if len(unknown) != 0 {
+		var texts []string
+		if texts, err = c.GetQualityDesc(unknown); err == nil {
+			for i, q := range unknown {
+				descs[q] = texts[i]
+			}
+		}
+	}
+	desc := func(q Quality) string {
+		if text, ok := descs[q]; ok {
+			return text
+		}
+		return strconv.Itoa(int(q))
+	}
+	for q, count := range a.qualities {
+		a.point.Qualities[desc(q)] += count
+	}
+	for i := range a.point.Findings {
+		if a.point.Findings[i].Kind == FindingBadQuality {
+			a.point.Findings[i].Detail = desc(a.point.Findings[i].quality)
+		}
+	}
+	return err
+}
+
+// qualityAnalyzer 逐个数值检查单个标签点
+type qualityAnalyzer struct {
+	info       *PointInfo
+	start, end time.Time
+	opts       QualityReportOptions
+	scanRate   time.Duration // 采集周期, 为0时不检查数据缺失
+	number     bool          // 是否为整数或浮点数类型
+	float      bool          // 是否为浮点数类型
+
+	point     PointQualityReport
+	qualities map[Quality]int
+
+	last    *TVQ            // 上一个数值
+	bad     *QualityFinding // 正在进行的质量码异常
+	flat    *QualityFinding // 正在进行的死值
+	flatV   float64         // 死值开始时的数值
+	outside *QualityFinding // 正在进行的超出量程
+	lo, hi  float64         // 超出量程的最小值与最大值
+}
+
+// newQualityAnalyzer 新建单个标签点的检查
+func newQualityAnalyzer(info *PointInfo, start, end time.Time, opts QualityReportOptions) *qualityAnalyzer {
+	rtdbType, _ := info.ValueType.ToRawType()
+	scanRate := opts.ScanRate
+	if rate, ok := opts.ScanRates[info.ID]; ok {
+		scanRate = rate
+	}
+	if scanRate < 0 {
+		scanRate = 0
+	}
+	return &qualityAnalyzer{
+		info:     info,
+		start:    start,
+		end:      end,
+		opts:     opts,
+		scanRate: scanRate,
+		number:   isNumberType(rtdbType),
+		float:    isFloatType(rtdbType),
+		point: PointQualityReport{
+			ID:          info.ID,
+			TableDotTag: info.TableDotTag,
+			ScanRate:    scanRate,
+			Qualities:   make(map[string]int),
+			Findings:    make([]QualityFinding, 0),
+		},
+		qualities: make(map[Quality]int),
+	}
+}
+
+// add 检查一个数值
+func (a *qualityAnalyzer) add(tvq TVQ) {
+	a.point.Count++
+	a.qualities[tvq.Quality]++
+	prev := a.start
+	if a.last != nil {
+		prev = a.last.Timestamp
+	}
+	a.checkGap(prev, tvq.Timestamp)
+
+	if tvq.Quality != QualityGood {
+		a.point.BadCount++
+		a.closeFlat()
+		a.closeOutside()
+		if a.bad != nil && a.last.Quality != tvq.Quality {
+			a.closeBad()
+		}
+		if a.bad == nil {
+			a.bad = &QualityFinding{Kind: FindingBadQuality, Start: tvq.Timestamp, quality: tvq.Quality}
+		}
+		a.bad.End = tvq.Timestamp
+		a.bad.Count++
+		a.last = &tvq
+		return
+	}
+	a.closeBad()
+	if a.number {
+		a.checkFlat(tvq)
+		a.checkRange(tvq)
+	}
+	a.last = &tvq
+}
+
+// finish 结束检查, 补充最后一个数值到结束时间的数据缺失
+func (a *qualityAnalyzer) finish() {
+	a.closeBad()
+	a.closeFlat()
+	a.closeOutside()
+	if a.point.Error != "" {
+		return
+	}
+	if a.last == nil {
+		a.checkGap(a.start, a.end)
+	} else {
+		a.checkGap(a.last.Timestamp, a.end)
+	}
+	// 问题在结束时才加入, 按开始时间重新排列
+	sort.SliceStable(a.point.Findings, func(i, j int) bool {
+		return a.point.Findings[i].Start.Before(a.point.Findings[j].Start)
+	})
+}
+
+// fail 记录失败的原因, 已经有读取历史数据失败的原因时追加
+func (a *qualityAnalyzer) fail(err error) {
+	if a.point.Error != "" {
+		a.point.Error += "; "
+	}
+	a.point.Error += err.Error()
+}
+
+// checkGap 检查两个时刻之间的数据缺失
+func (a *qualityAnalyzer) checkGap(from, to time.Time) {
+	if a.scanRate <= 0 {
+		return
+	}
+	limit := time.Duration(float64(a.scanRate) * a.opts.GapFactor)
+	if gap := to.Sub(from); gap > limit {
+		detail := fmt.Sprintf("%s没有数据, 超过采集周期%s的%g倍", gap, a.scanRate, a.opts.GapFactor)
+		a.point.Findings = append(a.point.Findings, QualityFinding{Kind: FindingGap, Start: from, End: to, Detail: detail})
+	}
+}
+
+// checkFlat 检查死值
+func (a *qualityAnalyzer) checkFlat(tvq TVQ) {
+	if a.opts.FlatlineDuration <= 0 {
+		return
+	}
+	v := a.value(tvq)
+	if a.flat != nil && math.Abs(v-a.flatV) > a.opts.FlatlineTolerance {
+		a.closeFlat()
+	}
+	if a.flat == nil {
+		a.flat = &QualityFinding{Kind: FindingFlatline, Start: tvq.Timestamp}
+		a.flatV = v
+	}
+	a.flat.End = tvq.Timestamp
+	a.flat.Count++
+}
+
+// checkRange 检查超出量程
+func (a *qualityAnalyzer) checkRange(tvq TVQ) {
+	low, high := float64(a.info.LowLimit), float64(a.info.HighLimit)
+	if a.opts.IgnoreRange || high <= low {
+		return
+	}
+	v := a.value(tvq)
+	if v >= low && v <= high {
+		a.closeOutside()
+		return
+	}
+	if a.outside == nil {
+		a.outside = &QualityFinding{Kind: FindingOutOfRange, Start: tvq.Timestamp}
+		a.lo, a.hi = v, v
+	}
+	a.outside.End = tvq.Timestamp
+	a.outside.Count++
+	a.lo, a.hi = math.Min(a.lo, v), math.Max(a.hi, v)
+}
+
+// closeBad 结束质量码异常
+func (a *qualityAnalyzer) closeBad() {
+	if a.bad == nil {
+		return
+	}
+	a.point.Findings = append(a.point.Findings, *a.bad)
+	a.bad = nil
+}
+
+// closeFlat 结束死值, 持续时间不足 FlatlineDuration 时丢弃
+func (a *qualityAnalyzer) closeFlat() {
+	if a.flat == nil {
+		return
+	}
+	if a.flat.Count > 1 && a.flat.End.Sub(a.flat.Start) >= a.opts.FlatlineDuration {
+		a.flat.Detail = fmt.Sprintf("数值%s保持%s不变", strconv.FormatFloat(a.flatV, 'g', -1, 64), a.flat.End.Sub(a.flat.Start))
+		a.point.Findings = append(a.point.Findings, *a.flat)
+	}
+	a.flat = nil
+}
+
+// closeOutside 结束超出量程
+func (a *qualityAnalyzer) closeOutside() {
+	if a.outside == nil {
+		return
+	}
+	a.outside.Detail = fmt.Sprintf("数值%s~%s超出量程%s~%s", strconv.FormatFloat(a.lo, 'g', -1, 64), strconv.FormatFloat(a.hi, 'g', -1, 64),
+		formatFloat32(a.info.LowLimit), formatFloat32(a.info.HighLimit))
+	a.point.Findings = append(a.point.Findings, *a.outside)
+	a.outside = nil
+}
+
+// value 数值类型的值
+func (a *qualityAnalyzer) value(tvq TVQ) float64 {
+	if a.float {
+		return tvq.Value.FloatValue
+	}
+	return float64(tvq.Value.IntValue)
+}
+
+// WriteJSON 以JSON格式输出报告
+//
+// input:
+//   - w 输出
+func (r *QualityReport) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(r)
+}
+
+// WriteCSV 以CSV格式输出报告, 每个问题一行, 读取失败的标签点输出一行 error, 没有检查数据缺失的标签点输出一行 gap_unchecked
+//   - 先写入UTF-8的BOM, 以便Windows中的Excel打开
+//   - 列为 tag,id,kind,start,end,count,detail
+//
+// input:
+//   - w 输出
+func (r *QualityReport) WriteCSV(w io.Writer) error {
+	writer, err := newCsvWriter(w, ',')
+	if err != nil {
+		return err
+	}
+	if err := writer.Write([]string{"tag", "id", "kind", "start", "end", "count", "detail"}); err != nil {
+		return err
+	}
+	for _, point := range r.Points {
+		id := strconv.FormatInt(int64(point.ID), 10)
+		if point.Error != "" {
+			if err := writer.Write([]string{point.TableDotTag, id, "error", "", "", "", point.Error}); err != nil {
+				return err
+			}
+		}
+		if point.ScanRate <= 0 {
+			if err := writer.Write([]string{point.TableDotTag, id, "gap_unchecked", "", "", "", "没有指定采集周期, 没有检查数据缺失"}); err != nil {
+				return err
+			}
+		}
+		for _, f := range point.Findings {
+			record := []string{point.TableDotTag, id, string(f.Kind), formatCsvTime(f.Start), formatCsvTime(f.End), strconv.Itoa(f.Count), f.Detail}
+			if err := writer.Write(record); err != nil {
+				return err
+			}
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
@@ -0,0 +1,197 @@
+package rtdb_api
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDataQualityReport(t *testing.T) {
+	conn, err := LoginWithBackend(NewMemoryBackend(), "127.0.0.1", Port, Username, Password)
+	if err != nil {
+		t.Fatal("登录用户失败", err)
+	}
+	defer func() { _ = conn.Logout() }()
+	table, err := conn.CreateTable("quality", "数据质量")
+	if err != nil {
+		t.Fatal(err)
+	}
+	info := NewPointInfo("flow", table.ID, ValueTypeFloat64, PointBase, RtdbPrecisionMilli, "", "")
+	info.SetLimit(0, 100, 50)
+	info.SetException(0, 0, 0, 0)
+	info.SetCompress(OFF, 0, 0, 0, 0)
+	if info, err = conn.AddPoint(info); err != nil {
+		t.Fatal(err)
+	}
+	empty, err := conn.AddPoint(NewPointInfo("empty", table.ID, ValueTypeInt32, PointBase, RtdbPrecisionMilli, "", ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	base := time.Unix(1700000000, 0)
+	at := func(s int) time.Time { return base.Add(time.Duration(s) * time.Second) }
+	samples := []struct {
+		offset  int
+		value   float64
+		quality Quality
+	}{
+		{0, 10, QualityGood}, {1, 10, QualityGood}, {2, 10, QualityGood}, {3, 10, QualityGood}, {4, 10, QualityGood},
+		{5, 20, QualityGood}, {6, 7, QualityBad}, {7, 7, QualityBad}, {8, 150, QualityGood}, {9, 160, QualityGood},
+		{20, 50, QualityGood},
+	}
+	tvqs := make([]TVQ, len(samples))
+	for i, s := range samples {
+		tvqs[i] = NewTvqFloat64(at(s.offset), s.value, s.quality)
+	}
+	if _, err := conn.WriteValues(info, false, tvqs); err != nil {
+		t.Fatal(err)
+	}
+
+	opts := QualityReportOptions{ScanRate: time.Second, FlatlineDuration: 3 * time.Second}
+	report, err := conn.DataQualityReport([]*PointInfo{info, empty}, base, at(22), opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Points) != 2 {
+		t.Fatal("标签点个数错误", len(report.Points))
+	}
+	point := report.Points[0]
+	if point.Count != 11 || point.BadCount != 2 || point.Qualities["GOOD"] != 9 || point.Qualities["BAD"] != 2 {
+		t.Fatalf("数值统计错误 %+v", point)
+	}
+	want := []struct {
+		kind       FindingKind
+		start, end int
+		count      int
+	}{
+		{FindingFlatline, 0, 4, 5},
+		{FindingBadQuality, 6, 7, 2},
+		{FindingOutOfRange, 8, 9, 2},
+		{FindingGap, 9, 20, 0},
+	}
+	if len(point.Findings) != len(want) {
+		t.Fatalf("问题个数错误 %+v", point.Findings)
+	}
+	for i, w := range want {
+		f := point.Findings[i]
+		if f.Kind != w.kind || !f.Start.Equal(at(w.start)) || !f.End.Equal(at(w.end)) || f.Count != w.count {
+			t.Errorf("第%d个问题错误 %+v", i, f)
+		}
+	}
+	if point.Findings[1].Detail != "BAD" {
+		t.Error("质量码说明错误", point.Findings[1].Detail)
+	}
+
+	// 没有数据的标签点整段缺失
+	if f := report.Points[1].Findings; len(f) != 1 || f[0].Kind != FindingGap || !f[0].Start.Equal(base) || !f[0].End.Equal(at(22)) {
+		t.Fatalf("数据缺失错误 %+v", f)
+	}
+
+	buf := bytes.Buffer{}
+	if err := report.WriteJSON(&buf); err != nil {
+		t.Fatal(err)
+	}
+	var decoded QualityReport
+	if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if len(decoded.Points) != 2 || len(decoded.Points[0].Findings) != 4 || decoded.Points[0].Findings[1].Detail != "BAD" {
+		t.Fatalf("JSON导出错误 %s", buf.String())
+	}
+
+	buf.Reset()
+	if err := report.WriteCSV(&buf); err != nil {
+		t.Fatal(err)
+	}
+	records, err := csv.NewReader(strings.NewReader(strings.TrimPrefix(buf.String(), utf8BOM))).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 6 || records[2][2] != string(FindingBadQuality) || records[5][0] != empty.TableDotTag {
+		t.Fatalf("CSV导出错误 %q", records)
+	}
+}
+
+// qualityDescFailBackend 获取质量码说明失败的后端
+type qualityDescFailBackend struct {
+	Backend
+}
+
+func (b qualityDescFailBackend) RawRtdbFormatQualityWarp(handle ConnectHandle, qualities []Quality) ([]string, RtdbError) {
+	return nil, RteUnknownError
+}
+
+// 每个标签点使用 ScanRates 中的采集周期, 没有采集周期的标签点不检查数据缺失; 质量码说明读取失败时仍然输出报告
+func TestDataQualityReport_ScanRatesAndDescError(t *testing.T) {
+	conn, err := LoginWithBackend(qualityDescFailBackend{NewMemoryBackend()}, "127.0.0.1", Port, Username, Password)
+	if err != nil {
+		t.Fatal("登录用户失败", err)
+	}
+	defer func() { _ = conn.Logout() }()
+	table, err := conn.CreateTable("quality", "数据质量")
+	if err != nil {
+		t.Fatal(err)
+	}
+	base := time.Unix(1700000000, 0)
+	opts := QualityReportOptions{ScanRates: make(map[PointID]time.Duration)}
+	infos := make([]*PointInfo, 0)
+	for _, rate := range []time.Duration{time.Second, 10 * time.Second, 0} {
+		info, err := conn.AddPoint(NewPointInfo(fmt.Sprintf("p%d", rate/time.Second), table.ID, ValueTypeFloat64, PointBase, RtdbPrecisionMilli, "", ""))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rate > 0 {
+			opts.ScanRates[info.ID] = rate
+		}
+		tvqs := []TVQ{NewTvqFloat64(base, 1, QualityGood), NewTvqFloat64(base.Add(5*time.Second), 2, QualityBad), NewTvqFloat64(base.Add(10*time.Second), 3, QualityGood)}
+		if _, err := conn.WriteValues(info, false, tvqs); err != nil {
+			t.Fatal(err)
+		}
+		infos = append(infos, info)
+	}
+
+	report, err := conn.DataQualityReport(infos, base, base.Add(10*time.Second), opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Points) != 3 {
+		t.Fatal("标签点个数错误", len(report.Points))
+	}
+	gaps := func(point PointQualityReport) int {
+		n := 0
+		for _, f := range point.Findings {
+			if f.Kind == FindingGap {
+				n++
+			}
+		}
+		return n
+	}
+	// 周期1秒时两段5秒的间隔都超过3倍周期, 周期10秒时没有缺失
+	if n := gaps(report.Points[0]); n != 2 || report.Points[0].ScanRate != time.Second {
+		t.Errorf("周期1秒的数据缺失个数错误 %d %+v", n, report.Points[0].Findings)
+	}
+	if n := gaps(report.Points[1]); n != 0 || report.Points[1].ScanRate != 10*time.Second {
+		t.Errorf("周期10秒的数据缺失个数错误 %d %+v", n, report.Points[1].Findings)
+	}
+	if n := gaps(report.Points[2]); n != 0 || report.Points[2].ScanRate != 0 {
+		t.Errorf("没有采集周期时不应该检查数据缺失 %d %+v", n, report.Points[2])
+	}
+	for _, point := range report.Points {
+		if point.Error == "" || point.Count != 3 || point.Qualities["5"] != 1 {
+			t.Errorf("质量码说明失败时的结果错误 %+v", point)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := report.WriteCSV(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if n := strings.Count(buf.String(), "gap_unchecked"); n != 1 || !strings.Contains(buf.String(), "quality.p0,"+strconv.Itoa(int(infos[2].ID))+",gap_unchecked") {
+		t.Errorf("CSV中没有检查数据缺失的标签点错误\n%s", buf.String())
+	}
+}
@@ -0,0 +1,1973 @@
+// RTDB gRPC服务定义
+//
+// 生成代码:
+//
+//	protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative rtdb.proto
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: rtdb.proto
+
+package rtdbgrpc
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// EventType 订阅事件类型, 与 RtdbEventType 对应
+type EventType int32
+
+const (
+	EventType_EVENT_TYPE_UNSPECIFIED EventType = 0
+	// SUBSCRIBED 订阅完成, snapshots 为订阅失败的标签点, 只包含 id、tag 与 error
+	EventType_SUBSCRIBED EventType = 1
+	// DATA 快照改变, snapshots 为改变后的快照
+	EventType_DATA       EventType = 2
+	EventType_DISCONNECT EventType = 3
+	EventType_RECOVERY   EventType = 4
+	EventType_SWITCHING  EventType = 5
+	EventType_SWITCHED   EventType = 6
+)
+
+// Enum value maps for EventType.
+var (
+	EventType_name = map[int32]string{
+		0: "EVENT_TYPE_UNSPECIFIED",
+		1: "SUBSCRIBED",
+		2: "DATA",
+		3: "DISCONNECT",
+		4: "RECOVERY",
+		5: "SWITCHING",
+		6: "SWITCHED",
+	}
+	EventType_value = map[string]int32{
+		"EVENT_TYPE_UNSPECIFIED": 0,
+		"SUBSCRIBED":             1,
+		"DATA":                   2,
+		"DISCONNECT":             3,
+		"RECOVERY":               4,
+		"SWITCHING":              5,
+		"SWITCHED":               6,
+	}
+)
+
+func (x EventType) Enum() *EventType {
+	p := new(EventType)
+	*p = x
+	return p
+}
+
+func (x EventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_rtdb_proto_enumTypes[0].Descriptor()
+}
+
+func (EventType) Type() protoreflect.EnumType {
+	return &file_rtdb_proto_enumTypes[0]
+}
+
+func (x EventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EventType.Descriptor instead.
+func (EventType) EnumDescriptor() ([]byte, []int) {
+	return file_rtdb_proto_rawDescGZIP(), []int{0}
+}
+
+// PointRef 标签点, 使用ID或全名("表名称.标签点名称")指定
+type PointRef struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Ref:
+	//
+	//	*PointRef_Id
+	//	*PointRef_Tag
+	Ref           isPointRef_Ref `protobuf_oneof:"ref"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PointRef) Reset() {
+	*x = PointRef{}
+	mi := &file_rtdb_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PointRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PointRef) ProtoMessage() {}
+
+func (x *PointRef) ProtoReflect() protoreflect.Message {
+	mi := &file_rtdb_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PointRef.ProtoReflect.Descriptor instead.
+func (*PointRef) Descriptor() ([]byte, []int) {
+	return file_rtdb_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PointRef) GetRef() isPointRef_Ref {
+	if x != nil {
+		return x.Ref
+	}
+	return nil
+}
+
+func (x *PointRef) GetId() int32 {
+	if x != nil {
+		if x, ok := x.Ref.(*PointRef_Id); ok {
+			return x.Id
+		}
+	}
+	return 0
+}
+
+func (x *PointRef) GetTag() string {
+	if x != nil {
+		if x, ok := x.Ref.(*PointRef_Tag); ok {
+			return x.Tag
+		}
+	}
+	return ""
+}
+
+type isPointRef_Ref interface {
+	isPointRef_Ref()
+}
+
+type PointRef_Id struct {
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3,oneof"`
+}
+
+type PointRef_Tag struct {
+	Tag string `protobuf:"bytes,2,opt,name=tag,proto3,oneof"`
+}
+
+func (*PointRef_Id) isPointRef_Ref() {}
+
+func (*PointRef_Tag) isPointRef_Ref() {}
+
+// Error 错误信息
+type Error struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// message 错误描述
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	// category 数据库错误的分类, 例如 not-found, 非数据库错误时为空
+	Category      string `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Error) Reset() {
+	*x = Error{}
+	mi := &file_rtdb_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Error) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Error) ProtoMessage() {}
+
+func (x *Error) ProtoReflect() protoreflect.Message {
+	mi := &file_rtdb_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Error.ProtoReflect.Descriptor instead.
+func (*Error) Descriptor() ([]byte, []int) {
+	return file_rtdb_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Error) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Error) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+// Point 标签点属性, 只包含常用的基本点配置
+type Point struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TableId     int32                  `protobuf:"varint,2,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	Name        string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	TableDotTag string                 `protobuf:"bytes,4,opt,name=table_dot_tag,json=tableDotTag,proto3" json:"table_dot_tag,omitempty"`
+	// value_type 数值类型, 例如 float64
+	ValueType string `protobuf:"bytes,5,opt,name=value_type,json=valueType,proto3" json:"value_type,omitempty"`
+	// class 标签点类别, 参见 PointClass
+	Class int32 `protobuf:"varint,6,opt,name=class,proto3" json:"class,omitempty"`
+	// precision 时间戳精度, 0秒, 1毫秒, 2微秒, 3纳秒
+	Precision     int32   `protobuf:"varint,7,opt,name=precision,proto3" json:"precision,omitempty"`
+	Desc          string  `protobuf:"bytes,8,opt,name=desc,proto3" json:"desc,omitempty"`
+	Unit          string  `protobuf:"bytes,9,opt,name=unit,proto3" json:"unit,omitempty"`
+	Archive       bool    `protobuf:"varint,10,opt,name=archive,proto3" json:"archive,omitempty"`
+	Digits        int32   `protobuf:"varint,11,opt,name=digits,proto3" json:"digits,omitempty"`
+	LowLimit      float32 `protobuf:"fixed32,12,opt,name=low_limit,json=lowLimit,proto3" json:"low_limit,omitempty"`
+	HighLimit     float32 `protobuf:"fixed32,13,opt,name=high_limit,json=highLimit,proto3" json:"high_limit,omitempty"`
+	Step          bool    `protobuf:"varint,14,opt,name=step,proto3" json:"step,omitempty"`
+	Typical       float32 `protobuf:"fixed32,15,opt,name=typical,proto3" json:"typical,omitempty"`
+	Compress      bool    `protobuf:"varint,16,opt,name=compress,proto3" json:"compress,omitempty"`
+	CompDev       float32 `protobuf:"fixed32,17,opt,name=comp_dev,json=compDev,proto3" json:"comp_dev,omitempty"`
+	CompTimeMax   int32   `protobuf:"varint,18,opt,name=comp_time_max,json=compTimeMax,proto3" json:"comp_time_max,omitempty"`
+	ExcDev        float32 `protobuf:"fixed32,19,opt,name=exc_dev,json=excDev,proto3" json:"exc_dev,omitempty"`
+	ExcTimeMax    int32   `protobuf:"varint,20,opt,name=exc_time_max,json=excTimeMax,proto3" json:"exc_time_max,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Point) Reset() {
+	*x = Point{}
+	mi := &file_rtdb_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Point) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Point) ProtoMessage() {}
+
+func (x *Point) ProtoReflect() protoreflect.Message {
+	mi := &file_rtdb_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Point.ProtoReflect.Descriptor instead.
+func (*Point) Descriptor() ([]byte, []int) {
+	return file_rtdb_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Point) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Point) GetTableId() int32 {
+	if x != nil {
+		return x.TableId
+	}
+	return 0
+}
+
+func (x *Point) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Point) GetTableDotTag() string {
+	if x != nil {
+		return x.TableDotTag
+	}
+	return ""
+}
+
+func (x *Point) GetValueType() string {
+	if x != nil {
+		return x.ValueType
+	}
+	return ""
+}
+
+func (x *Point) GetClass() int32 {
+	if x != nil {
+		return x.Class
+	}
+	return 0
+}
+
+func (x *Point) GetPrecision() int32 {
+	if x != nil {
+		return x.Precision
+	}
+	return 0
+}
+
+func (x *Point) GetDesc() string {
+	if x != nil {
+		return x.Desc
+	}
+	return ""
+}
+
+func (x *Point) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *Point) GetArchive() bool {
+	if x != nil {
+		return x.Archive
+	}
+	return false
+}
+
+func (x *Point) GetDigits() int32 {
+	if x != nil {
+		return x.Digits
+	}
+	return 0
+}
+
+func (x *Point) GetLowLimit() float32 {
+	if x != nil {
+		return x.LowLimit
+	}
+	return 0
+}
+
+func (x *Point) GetHighLimit() float32 {
+	if x != nil {
+		return x.HighLimit
+	}
+	return 0
+}
+
+func (x *Point) GetStep() bool {
+	if x != nil {
+		return x.Step
+	}
+	return false
+}
+
+func (x *Point) GetTypical() float32 {
+	if x != nil {
+		return x.Typical
+	}
+	return 0
+}
+
+func (x *Point) GetCompress() bool {
+	if x != nil {
+		return x.Compress
+	}
+	return false
+}
+
+func (x *Point) GetCompDev() float32 {
+	if x != nil {
+		return x.CompDev
+	}
+	return 0
+}
+
+func (x *Point) GetCompTimeMax() int32 {
+	if x != nil {
+		return x.CompTimeMax
+	}
+	return 0
+}
+
+func (x *Point) GetExcDev() float32 {
+	if x != nil {
+		return x.ExcDev
+	}
+	return 0
+}
+
+func (x *Point) GetExcTimeMax() int32 {
+	if x != nil {
+		return x.ExcTimeMax
+	}
+	return 0
+}
+
+// Coordinates 坐标
+type Coordinates struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	X             float32                `protobuf:"fixed32,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y             float32                `protobuf:"fixed32,2,opt,name=y,proto3" json:"y,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Coordinates) Reset() {
+	*x = Coordinates{}
+	mi := &file_rtdb_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Coordinates) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Coordinates) ProtoMessage() {}
+
+func (x *Coordinates) ProtoReflect() protoreflect.Message {
+	mi := &file_rtdb_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Coordinates.ProtoReflect.Descriptor instead.
+func (*Coordinates) Descriptor() ([]byte, []int) {
+	return file_rtdb_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Coordinates) GetX() float32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *Coordinates) GetY() float32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+// TVQ 时间戳、数值与质量码, 数值字段由标签点的数值类型决定
+//   - bool: bool_value
+//   - 整数: int_value
+//   - 浮点数: float_value
+//   - coor: coor_value
+//   - string与datetime: string_value
+//   - blob与自定义类型: bytes_value
+type TVQ struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Time  *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	// Types that are valid to be assigned to Value:
+	//
+	//	*TVQ_BoolValue
+	//	*TVQ_IntValue
+	//	*TVQ_FloatValue
+	//	*TVQ_CoorValue
+	//	*TVQ_StringValue
+	//	*TVQ_BytesValue
+	Value         isTVQ_Value `protobuf_oneof:"value"`
+	Quality       int32       `protobuf:"varint,8,opt,name=quality,proto3" json:"quality,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TVQ) Reset() {
+	*x = TVQ{}
+	mi := &file_rtdb_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TVQ) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TVQ) ProtoMessage() {}
+
+func (x *TVQ) ProtoReflect() protoreflect.Message {
+	mi := &file_rtdb_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TVQ.ProtoReflect.Descriptor instead.
+func (*TVQ) Descriptor() ([]byte, []int) {
+	return file_rtdb_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TVQ) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *TVQ) GetValue() isTVQ_Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *TVQ) GetBoolValue() bool {
+	if x != nil {
+		if x, ok := x.Value.(*TVQ_BoolValue); ok {
+			return x.BoolValue
+		}
+	}
+	return false
+}
+
+func (x *TVQ) GetIntValue() int64 {
+	if x != nil {
+		if x, ok := x.Value.(*TVQ_IntValue); ok {
+			return x.IntValue
+		}
+	}
+	return 0
+}
+
+func (x *TVQ) GetFloatValue() float64 {
+	if x != nil {
+		if x, ok := x.Value.(*TVQ_FloatValue); ok {
+			return x.FloatValue
+		}
+	}
+	return 0
+}
+
+func (x *TVQ) GetCoorValue() *Coordinates {
+	if x != nil {
+		if x, ok := x.Value.(*TVQ_CoorValue); ok {
+			return x.CoorValue
+		}
+	}
+	return nil
+}
+
+func (x *TVQ) GetStringValue() string {
+	if x != nil {
+		if x, ok := x.Value.(*TVQ_StringValue); ok {
+			return x.StringValue
+		}
+	}
+	return ""
+}
+
+func (x *TVQ) GetBytesValue() []byte {
+	if x != nil {
+		if x, ok := x.Value.(*TVQ_BytesValue); ok {
+			return x.BytesValue
+		}
+	}
+	return nil
+}
+
+func (x *TVQ) GetQuality() int32 {
+	if x != nil {
+		return x.Quality
+	}
+	return 0
+}
+
+type isTVQ_Value interface {
+	isTVQ_Value()
+}
+
+type TVQ_BoolValue struct {
+	BoolValue bool `protobuf:"varint,2,opt,name=bool_value,json=boolValue,proto3,oneof"`
+}
+
+type TVQ_IntValue struct {
+	IntValue int64 `protobuf:"varint,3,opt,name=int_value,json=intValue,proto3,oneof"`
+}
+
+type TVQ_FloatValue struct {
+	FloatValue float64 `protobuf:"fixed64,4,opt,name=float_value,json=floatValue,proto3,oneof"`
+}
+
+type TVQ_CoorValue struct {
+	CoorValue *Coordinates `protobuf:"bytes,5,opt,name=coor_value,json=coorValue,proto3,oneof"`
+}
+
+type TVQ_StringValue struct {
+	StringValue string `protobuf:"bytes,6,opt,name=string_value,json=stringValue,proto3,oneof"`
+}
+
+type TVQ_BytesValue struct {
+	BytesValue []byte `protobuf:"bytes,7,opt,name=bytes_value,json=bytesValue,proto3,oneof"`
+}
+
+func (*TVQ_BoolValue) isTVQ_Value() {}
+
+func (*TVQ_IntValue) isTVQ_Value() {}
+
+func (*TVQ_FloatValue) isTVQ_Value() {}
+
+func (*TVQ_CoorValue) isTVQ_Value() {}
+
+func (*TVQ_StringValue) isTVQ_Value() {}
+
+func (*TVQ_BytesValue) isTVQ_Value() {}
+
+// Snapshot 单个标签点的快照
+type Snapshot struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Tag   string                 `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	Value *TVQ                   `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	// error 不为空时快照无效
+	Error         *Error `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Snapshot) Reset() {
+	*x = Snapshot{}
+	mi := &file_rtdb_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Snapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Snapshot) ProtoMessage() {}
+
+func (x *Snapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_rtdb_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Snapshot.ProtoReflect.Descriptor instead.
+func (*Snapshot) Descriptor() ([]byte, []int) {
+	return file_rtdb_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Snapshot) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Snapshot) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *Snapshot) GetValue() *TVQ {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *Snapshot) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type GetPointsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Points        []*PointRef            `protobuf:"bytes,1,rep,name=points,proto3" json:"points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPointsRequest) Reset() {
+	*x = GetPointsRequest{}
+	mi := &file_rtdb_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPointsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPointsRequest) ProtoMessage() {}
+
+func (x *GetPointsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rtdb_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPointsRequest.ProtoReflect.Descriptor instead.
+func (*GetPointsRequest) Descriptor() ([]byte, []int) {
+	return file_rtdb_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetPointsRequest) GetPoints() []*PointRef {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+// PointResult 单个标签点的查询结果, 与请求中的标签点一一对应
+type PointResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Point         *Point                 `protobuf:"bytes,1,opt,name=point,proto3" json:"point,omitempty"`
+	Error         *Error                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PointResult) Reset() {
+	*x = PointResult{}
+	mi := &file_rtdb_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PointResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PointResult) ProtoMessage() {}
+
+func (x *PointResult) ProtoReflect() protoreflect.Message {
+	mi := &file_rtdb_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PointResult.ProtoReflect.Descriptor instead.
+func (*PointResult) Descriptor() ([]byte, []int) {
+	return file_rtdb_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PointResult) GetPoint() *Point {
+	if x != nil {
+		return x.Point
+	}
+	return nil
+}
+
+func (x *PointResult) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type GetPointsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*PointResult         `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPointsResponse) Reset() {
+	*x = GetPointsResponse{}
+	mi := &file_rtdb_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPointsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPointsResponse) ProtoMessage() {}
+
+func (x *GetPointsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rtdb_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPointsResponse.ProtoReflect.Descriptor instead.
+func (*GetPointsResponse) Descriptor() ([]byte, []int) {
+	return file_rtdb_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetPointsResponse) GetResults() []*PointResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type ReadSnapshotsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Points        []*PointRef            `protobuf:"bytes,1,rep,name=points,proto3" json:"points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadSnapshotsRequest) Reset() {
+	*x = ReadSnapshotsRequest{}
+	mi := &file_rtdb_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadSnapshotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadSnapshotsRequest) ProtoMessage() {}
+
+func (x *ReadSnapshotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rtdb_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadSnapshotsRequest.ProtoReflect.Descriptor instead.
+func (*ReadSnapshotsRequest) Descriptor() ([]byte, []int) {
+	return file_rtdb_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ReadSnapshotsRequest) GetPoints() []*PointRef {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+// ReadSnapshotsResponse 快照, 与请求中的标签点一一对应
+type ReadSnapshotsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Snapshots     []*Snapshot            `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadSnapshotsResponse) Reset() {
+	*x = ReadSnapshotsResponse{}
+	mi := &file_rtdb_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadSnapshotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadSnapshotsResponse) ProtoMessage() {}
+
+func (x *ReadSnapshotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rtdb_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadSnapshotsResponse.ProtoReflect.Descriptor instead.
+func (*ReadSnapshotsResponse) Descriptor() ([]byte, []int) {
+	return file_rtdb_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ReadSnapshotsResponse) GetSnapshots() []*Snapshot {
+	if x != nil {
+		return x.Snapshots
+	}
+	return nil
+}
+
+type ReadHistoryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Point *PointRef              `protobuf:"bytes,1,opt,name=point,proto3" json:"point,omitempty"`
+	// start 开始时间(包含), 为空时为结束时间前1小时
+	Start *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	// end 结束时间(包含), 为空时为当前时间
+	End *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+	// limit 最多返回的个数, 为0时不限制
+	Limit         int64 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadHistoryRequest) Reset() {
+	*x = ReadHistoryRequest{}
+	mi := &file_rtdb_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadHistoryRequest) ProtoMessage() {}
+
+func (x *ReadHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rtdb_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ReadHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_rtdb_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ReadHistoryRequest) GetPoint() *PointRef {
+	if x != nil {
+		return x.Point
+	}
+	return nil
+}
+
+func (x *ReadHistoryRequest) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *ReadHistoryRequest) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *ReadHistoryRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ReadInterpolatedRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Point *PointRef              `protobuf:"bytes,1,opt,name=point,proto3" json:"point,omitempty"`
+	// start 开始时间, 为空时为结束时间前1小时
+	Start *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	// end 结束时间, 为空时为当前时间
+	End *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+	// count 插值个数, 为0时为100
+	Count         int32 `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadInterpolatedRequest) Reset() {
+	*x = ReadInterpolatedRequest{}
+	mi := &file_rtdb_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadInterpolatedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadInterpolatedRequest) ProtoMessage() {}
+
+func (x *ReadInterpolatedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rtdb_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadInterpolatedRequest.ProtoReflect.Descriptor instead.
+func (*ReadInterpolatedRequest) Descriptor() ([]byte, []int) {
+	return file_rtdb_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ReadInterpolatedRequest) GetPoint() *PointRef {
+	if x != nil {
+		return x.Point
+	}
+	return nil
+}
+
+func (x *ReadInterpolatedRequest) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *ReadInterpolatedRequest) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *ReadInterpolatedRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ReadInterpolatedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Values        []*TVQ                 `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadInterpolatedResponse) Reset() {
+	*x = ReadInterpolatedResponse{}
+	mi := &file_rtdb_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadInterpolatedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadInterpolatedResponse) ProtoMessage() {}
+
+func (x *ReadInterpolatedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rtdb_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadInterpolatedResponse.ProtoReflect.Descriptor instead.
+func (*ReadInterpolatedResponse) Descriptor() ([]byte, []int) {
+	return file_rtdb_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ReadInterpolatedResponse) GetValues() []*TVQ {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type ReadSummaryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Point *PointRef              `protobuf:"bytes,1,opt,name=point,proto3" json:"point,omitempty"`
+	// start 开始时间, 为空时从最早的数据开始
+	Start *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	// end 结束时间, 为空时统计到最近的数据
+	End           *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadSummaryRequest) Reset() {
+	*x = ReadSummaryRequest{}
+	mi := &file_rtdb_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadSummaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadSummaryRequest) ProtoMessage() {}
+
+func (x *ReadSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rtdb_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadSummaryRequest.ProtoReflect.Descriptor instead.
+func (*ReadSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_rtdb_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ReadSummaryRequest) GetPoint() *PointRef {
+	if x != nil {
+		return x.Point
+	}
+	return nil
+}
+
+func (x *ReadSummaryRequest) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *ReadSummaryRequest) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+// SummaryValue 统计值中的单个数值
+type SummaryValue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Time          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	Value         float64                `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+	Quality       int32                  `protobuf:"varint,3,opt,name=quality,proto3" json:"quality,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SummaryValue) Reset() {
+	*x = SummaryValue{}
+	mi := &file_rtdb_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SummaryValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SummaryValue) ProtoMessage() {}
+
+func (x *SummaryValue) ProtoReflect() protoreflect.Message {
+	mi := &file_rtdb_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SummaryValue.ProtoReflect.Descriptor instead.
+func (*SummaryValue) Descriptor() ([]byte, []int) {
+	return file_rtdb_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SummaryValue) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *SummaryValue) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *SummaryValue) GetQuality() int32 {
+	if x != nil {
+		return x.Quality
+	}
+	return 0
+}
+
+// Summary 统计值, 参见 RtdbSummaryData
+type Summary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	First         *SummaryValue          `protobuf:"bytes,1,opt,name=first,proto3" json:"first,omitempty"`
+	Last          *SummaryValue          `protobuf:"bytes,2,opt,name=last,proto3" json:"last,omitempty"`
+	Max           *SummaryValue          `protobuf:"bytes,3,opt,name=max,proto3" json:"max,omitempty"`
+	Min           *SummaryValue          `protobuf:"bytes,4,opt,name=min,proto3" json:"min,omitempty"`
+	Power         float64                `protobuf:"fixed64,5,opt,name=power,proto3" json:"power,omitempty"`
+	PowerAvg      float64                `protobuf:"fixed64,6,opt,name=power_avg,json=powerAvg,proto3" json:"power_avg,omitempty"`
+	Total         float64                `protobuf:"fixed64,7,opt,name=total,proto3" json:"total,omitempty"`
+	CalcAvg       float64                `protobuf:"fixed64,8,opt,name=calc_avg,json=calcAvg,proto3" json:"calc_avg,omitempty"`
+	Count         int32                  `protobuf:"varint,9,opt,name=count,proto3" json:"count,omitempty"`
+	ValidCount    int32                  `protobuf:"varint,10,opt,name=valid_count,json=validCount,proto3" json:"valid_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Summary) Reset() {
+	*x = Summary{}
+	mi := &file_rtdb_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Summary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Summary) ProtoMessage() {}
+
+func (x *Summary) ProtoReflect() protoreflect.Message {
+	mi := &file_rtdb_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Summary.ProtoReflect.Descriptor instead.
+func (*Summary) Descriptor() ([]byte, []int) {
+	return file_rtdb_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Summary) GetFirst() *SummaryValue {
+	if x != nil {
+		return x.First
+	}
+	return nil
+}
+
+func (x *Summary) GetLast() *SummaryValue {
+	if x != nil {
+		return x.Last
+	}
+	return nil
+}
+
+func (x *Summary) GetMax() *SummaryValue {
+	if x != nil {
+		return x.Max
+	}
+	return nil
+}
+
+func (x *Summary) GetMin() *SummaryValue {
+	if x != nil {
+		return x.Min
+	}
+	return nil
+}
+
+func (x *Summary) GetPower() float64 {
+	if x != nil {
+		return x.Power
+	}
+	return 0
+}
+
+func (x *Summary) GetPowerAvg() float64 {
+	if x != nil {
+		return x.PowerAvg
+	}
+	return 0
+}
+
+func (x *Summary) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *Summary) GetCalcAvg() float64 {
+	if x != nil {
+		return x.CalcAvg
+	}
+	return 0
+}
+
+func (x *Summary) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *Summary) GetValidCount() int32 {
+	if x != nil {
+		return x.ValidCount
+	}
+	return 0
+}
+
+type SubscribeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// points 订阅的标签点, 个数不能超过单连接的订阅上限(1000)
+	Points []*PointRef `protobuf:"bytes,1,rep,name=points,proto3" json:"points,omitempty"`
+	// auto_conn 网络断开后自动重连并恢复订阅, 为false时网络断开后结束推送
+	AutoConn      bool `protobuf:"varint,2,opt,name=auto_conn,json=autoConn,proto3" json:"auto_conn,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeRequest) Reset() {
+	*x = SubscribeRequest{}
+	mi := &file_rtdb_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeRequest) ProtoMessage() {}
+
+func (x *SubscribeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rtdb_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeRequest) Descriptor() ([]byte, []int) {
+	return file_rtdb_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SubscribeRequest) GetPoints() []*PointRef {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+func (x *SubscribeRequest) GetAutoConn() bool {
+	if x != nil {
+		return x.AutoConn
+	}
+	return false
+}
+
+type SubscribeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          EventType              `protobuf:"varint,1,opt,name=type,proto3,enum=rtdb.v1.EventType" json:"type,omitempty"`
+	Snapshots     []*Snapshot            `protobuf:"bytes,2,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeResponse) Reset() {
+	*x = SubscribeResponse{}
+	mi := &file_rtdb_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeResponse) ProtoMessage() {}
+
+func (x *SubscribeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rtdb_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeResponse.ProtoReflect.Descriptor instead.
+func (*SubscribeResponse) Descriptor() ([]byte, []int) {
+	return file_rtdb_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SubscribeResponse) GetType() EventType {
+	if x != nil {
+		return x.Type
+	}
+	return EventType_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *SubscribeResponse) GetSnapshots() []*Snapshot {
+	if x != nil {
+		return x.Snapshots
+	}
+	return nil
+}
+
+// WriteRequest 写入的数值, 一个消息可以包含多个数值
+type WriteRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Values []*WriteValue          `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	// fix 是否允许覆盖写入, 与上一个消息不同时先写入之前的数值
+	Fix           bool `protobuf:"varint,2,opt,name=fix,proto3" json:"fix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WriteRequest) Reset() {
+	*x = WriteRequest{}
+	mi := &file_rtdb_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WriteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteRequest) ProtoMessage() {}
+
+func (x *WriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rtdb_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteRequest.ProtoReflect.Descriptor instead.
+func (*WriteRequest) Descriptor() ([]byte, []int) {
+	return file_rtdb_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *WriteRequest) GetValues() []*WriteValue {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *WriteRequest) GetFix() bool {
+	if x != nil {
+		return x.Fix
+	}
+	return false
+}
+
+// WriteValue 单个标签点的数值, value.time 为空时为服务端当前时间
+type WriteValue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Point         *PointRef              `protobuf:"bytes,1,opt,name=point,proto3" json:"point,omitempty"`
+	Value         *TVQ                   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WriteValue) Reset() {
+	*x = WriteValue{}
+	mi := &file_rtdb_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WriteValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteValue) ProtoMessage() {}
+
+func (x *WriteValue) ProtoReflect() protoreflect.Message {
+	mi := &file_rtdb_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteValue.ProtoReflect.Descriptor instead.
+func (*WriteValue) Descriptor() ([]byte, []int) {
+	return file_rtdb_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *WriteValue) GetPoint() *PointRef {
+	if x != nil {
+		return x.Point
+	}
+	return nil
+}
+
+func (x *WriteValue) GetValue() *TVQ {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+// WriteError 写入失败的数值, index 为数值在整个请求流中的序号(从0开始)
+type WriteError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Index         int64                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Error         *Error                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WriteError) Reset() {
+	*x = WriteError{}
+	mi := &file_rtdb_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WriteError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteError) ProtoMessage() {}
+
+func (x *WriteError) ProtoReflect() protoreflect.Message {
+	mi := &file_rtdb_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteError.ProtoReflect.Descriptor instead.
+func (*WriteError) Descriptor() ([]byte, []int) {
+	return file_rtdb_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *WriteError) GetIndex() int64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *WriteError) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type WriteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Written       int64                  `protobuf:"varint,1,opt,name=written,proto3" json:"written,omitempty"`
+	Errors        []*WriteError          `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WriteResponse) Reset() {
+	*x = WriteResponse{}
+	mi := &file_rtdb_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WriteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteResponse) ProtoMessage() {}
+
+func (x *WriteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rtdb_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteResponse.ProtoReflect.Descriptor instead.
+func (*WriteResponse) Descriptor() ([]byte, []int) {
+	return file_rtdb_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *WriteResponse) GetWritten() int64 {
+	if x != nil {
+		return x.Written
+	}
+	return 0
+}
+
+func (x *WriteResponse) GetErrors() []*WriteError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+var File_rtdb_proto protoreflect.FileDescriptor
+
+const file_rtdb_proto_rawDesc = "" +
+	"\n" +
+	"\n" +
+	"rtdb.proto\x12\artdb.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"7\n" +
+	"\bPointRef\x12\x10\n" +
+	"\x02id\x18\x01 \x01(\x05H\x00R\x02id\x12\x12\n" +
+	"\x03tag\x18\x02 \x01(\tH\x00R\x03tagB\x05\n" +
+	"\x03ref\"=\n" +
+	"\x05Error\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x1a\n" +
+	"\bcategory\x18\x02 \x01(\tR\bcategory\"\x97\x04\n" +
+	"\x05Point\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x19\n" +
+	"\btable_id\x18\x02 \x01(\x05R\atableId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\"\n" +
+	"\rtable_dot_tag\x18\x04 \x01(\tR\vtableDotTag\x12\x1d\n" +
+	"\n" +
+	"value_type\x18\x05 \x01(\tR\tvalueType\x12\x14\n" +
+	"\x05class\x18\x06 \x01(\x05R\x05class\x12\x1c\n" +
+	"\tprecision\x18\a \x01(\x05R\tprecision\x12\x12\n" +
+	"\x04desc\x18\b \x01(\tR\x04desc\x12\x12\n" +
+	"\x04unit\x18\t \x01(\tR\x04unit\x12\x18\n" +
+	"\aarchive\x18\n" +
+	" \x01(\bR\aarchive\x12\x16\n" +
+	"\x06digits\x18\v \x01(\x05R\x06digits\x12\x1b\n" +
+	"\tlow_limit\x18\f \x01(\x02R\blowLimit\x12\x1d\n" +
+	"\n" +
+	"high_limit\x18\r \x01(\x02R\thighLimit\x12\x12\n" +
+	"\x04step\x18\x0e \x01(\bR\x04step\x12\x18\n" +
+	"\atypical\x18\x0f \x01(\x02R\atypical\x12\x1a\n" +
+	"\bcompress\x18\x10 \x01(\bR\bcompress\x12\x19\n" +
+	"\bcomp_dev\x18\x11 \x01(\x02R\acompDev\x12\"\n" +
+	"\rcomp_time_max\x18\x12 \x01(\x05R\vcompTimeMax\x12\x17\n" +
+	"\aexc_dev\x18\x13 \x01(\x02R\x06excDev\x12 \n" +
+	"\fexc_time_max\x18\x14 \x01(\x05R\n" +
+	"excTimeMax\")\n" +
+	"\vCoordinates\x12\f\n" +
+	"\x01x\x18\x01 \x01(\x02R\x01x\x12\f\n" +
+	"\x01y\x18\x02 \x01(\x02R\x01y\"\xba\x02\n" +
+	"\x03TVQ\x12.\n" +
+	"\x04time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12\x1f\n" +
+	"\n" +
+	"bool_value\x18\x02 \x01(\bH\x00R\tboolValue\x12\x1d\n" +
+	"\tint_value\x18\x03 \x01(\x03H\x00R\bintValue\x12!\n" +
+	"\vfloat_value\x18\x04 \x01(\x01H\x00R\n" +
+	"floatValue\x125\n" +
+	"\n" +
+	"coor_value\x18\x05 \x01(\v2\x14.rtdb.v1.CoordinatesH\x00R\tcoorValue\x12#\n" +
+	"\fstring_value\x18\x06 \x01(\tH\x00R\vstringValue\x12!\n" +
+	"\vbytes_value\x18\a \x01(\fH\x00R\n" +
+	"bytesValue\x12\x18\n" +
+	"\aquality\x18\b \x01(\x05R\aqualityB\a\n" +
+	"\x05value\"v\n" +
+	"\bSnapshot\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x10\n" +
+	"\x03tag\x18\x02 \x01(\tR\x03tag\x12\"\n" +
+	"\x05value\x18\x03 \x01(\v2\f.rtdb.v1.TVQR\x05value\x12$\n" +
+	"\x05error\x18\x04 \x01(\v2\x0e.rtdb.v1.ErrorR\x05error\"=\n" +
+	"\x10GetPointsRequest\x12)\n" +
+	"\x06points\x18\x01 \x03(\v2\x11.rtdb.v1.PointRefR\x06points\"Y\n" +
+	"\vPointResult\x12$\n" +
+	"\x05point\x18\x01 \x01(\v2\x0e.rtdb.v1.PointR\x05point\x12$\n" +
+	"\x05error\x18\x02 \x01(\v2\x0e.rtdb.v1.ErrorR\x05error\"C\n" +
+	"\x11GetPointsResponse\x12.\n" +
+	"\aresults\x18\x01 \x03(\v2\x14.rtdb.v1.PointResultR\aresults\"A\n" +
+	"\x14ReadSnapshotsRequest\x12)\n" +
+	"\x06points\x18\x01 \x03(\v2\x11.rtdb.v1.PointRefR\x06points\"H\n" +
+	"\x15ReadSnapshotsResponse\x12/\n" +
+	"\tsnapshots\x18\x01 \x03(\v2\x11.rtdb.v1.SnapshotR\tsnapshots\"\xb3\x01\n" +
+	"\x12ReadHistoryRequest\x12'\n" +
+	"\x05point\x18\x01 \x01(\v2\x11.rtdb.v1.PointRefR\x05point\x120\n" +
+	"\x05start\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12,\n" +
+	"\x03end\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x03end\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x03R\x05limit\"\xb8\x01\n" +
+	"\x17ReadInterpolatedRequest\x12'\n" +
+	"\x05point\x18\x01 \x01(\v2\x11.rtdb.v1.PointRefR\x05point\x120\n" +
+	"\x05start\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12,\n" +
+	"\x03end\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x03end\x12\x14\n" +
+	"\x05count\x18\x04 \x01(\x05R\x05count\"@\n" +
+	"\x18ReadInterpolatedResponse\x12$\n" +
+	"\x06values\x18\x01 \x03(\v2\f.rtdb.v1.TVQR\x06values\"\x9d\x01\n" +
+	"\x12ReadSummaryRequest\x12'\n" +
+	"\x05point\x18\x01 \x01(\v2\x11.rtdb.v1.PointRefR\x05point\x120\n" +
+	"\x05start\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12,\n" +
+	"\x03end\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x03end\"n\n" +
+	"\fSummaryValue\x12.\n" +
+	"\x04time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value\x12\x18\n" +
+	"\aquality\x18\x03 \x01(\x05R\aquality\"\xce\x02\n" +
+	"\aSummary\x12+\n" +
+	"\x05first\x18\x01 \x01(\v2\x15.rtdb.v1.SummaryValueR\x05first\x12)\n" +
+	"\x04last\x18\x02 \x01(\v2\x15.rtdb.v1.SummaryValueR\x04last\x12'\n" +
+	"\x03max\x18\x03 \x01(\v2\x15.rtdb.v1.SummaryValueR\x03max\x12'\n" +
+	"\x03min\x18\x04 \x01(\v2\x15.rtdb.v1.SummaryValueR\x03min\x12\x14\n" +
+	"\x05power\x18\x05 \x01(\x01R\x05power\x12\x1b\n" +
+	"\tpower_avg\x18\x06 \x01(\x01R\bpowerAvg\x12\x14\n" +
+	"\x05total\x18\a \x01(\x01R\x05total\x12\x19\n" +
+	"\bcalc_avg\x18\b \x01(\x01R\acalcAvg\x12\x14\n" +
+	"\x05count\x18\t \x01(\x05R\x05count\x12\x1f\n" +
+	"\vvalid_count\x18\n" +
+	" \x01(\x05R\n" +
+	"validCount\"Z\n" +
+	"\x10SubscribeRequest\x12)\n" +
+	"\x06points\x18\x01 \x03(\v2\x11.rtdb.v1.PointRefR\x06points\x12\x1b\n" +
+	"\tauto_conn\x18\x02 \x01(\bR\bautoConn\"l\n" +
+	"\x11SubscribeResponse\x12&\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x12.rtdb.v1.EventTypeR\x04type\x12/\n" +
+	"\tsnapshots\x18\x02 \x03(\v2\x11.rtdb.v1.SnapshotR\tsnapshots\"M\n" +
+	"\fWriteRequest\x12+\n" +
+	"\x06values\x18\x01 \x03(\v2\x13.rtdb.v1.WriteValueR\x06values\x12\x10\n" +
+	"\x03fix\x18\x02 \x01(\bR\x03fix\"Y\n" +
+	"\n" +
+	"WriteValue\x12'\n" +
+	"\x05point\x18\x01 \x01(\v2\x11.rtdb.v1.PointRefR\x05point\x12\"\n" +
+	"\x05value\x18\x02 \x01(\v2\f.rtdb.v1.TVQR\x05value\"H\n" +
+	"\n" +
+	"WriteError\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x03R\x05index\x12$\n" +
+	"\x05error\x18\x02 \x01(\v2\x0e.rtdb.v1.ErrorR\x05error\"V\n" +
+	"\rWriteResponse\x12\x18\n" +
+	"\awritten\x18\x01 \x01(\x03R\awritten\x12+\n" +
+	"\x06errors\x18\x02 \x03(\v2\x13.rtdb.v1.WriteErrorR\x06errors*|\n" +
+	"\tEventType\x12\x1a\n" +
+	"\x16EVENT_TYPE_UNSPECIFIED\x10\x00\x12\x0e\n" +
+	"\n" +
+	"SUBSCRIBED\x10\x01\x12\b\n" +
+	"\x04DATA\x10\x02\x12\x0e\n" +
+	"\n" +
+	"DISCONNECT\x10\x03\x12\f\n" +
+	"\bRECOVERY\x10\x04\x12\r\n" +
+	"\tSWITCHING\x10\x05\x12\f\n" +
+	"\bSWITCHED\x10\x062\xed\x03\n" +
+	"\x04Rtdb\x12B\n" +
+	"\tGetPoints\x12\x19.rtdb.v1.GetPointsRequest\x1a\x1a.rtdb.v1.GetPointsResponse\x12N\n" +
+	"\rReadSnapshots\x12\x1d.rtdb.v1.ReadSnapshotsRequest\x1a\x1e.rtdb.v1.ReadSnapshotsResponse\x12:\n" +
+	"\vReadHistory\x12\x1b.rtdb.v1.ReadHistoryRequest\x1a\f.rtdb.v1.TVQ0\x01\x12W\n" +
+	"\x10ReadInterpolated\x12 .rtdb.v1.ReadInterpolatedRequest\x1a!.rtdb.v1.ReadInterpolatedResponse\x12<\n" +
+	"\vReadSummary\x12\x1b.rtdb.v1.ReadSummaryRequest\x1a\x10.rtdb.v1.Summary\x12D\n" +
+	"\tSubscribe\x12\x19.rtdb.v1.SubscribeRequest\x1a\x1a.rtdb.v1.SubscribeResponse0\x01\x128\n" +
+	"\x05Write\x12\x15.rtdb.v1.WriteRequest\x1a\x16.rtdb.v1.WriteResponse(\x01B%Z#github.com/kkbase/rtdb_api/rtdbgrpcb\x06proto3"
+
+var (
+	file_rtdb_proto_rawDescOnce sync.Once
+	file_rtdb_proto_rawDescData []byte
+)
+
+func file_rtdb_proto_rawDescGZIP() []byte {
+	file_rtdb_proto_rawDescOnce.Do(func() {
+		file_rtdb_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rtdb_proto_rawDesc), len(file_rtdb_proto_rawDesc)))
+	})
+	return file_rtdb_proto_rawDescData
+}
+
+var file_rtdb_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_rtdb_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
+var file_rtdb_proto_goTypes = []any{
+	(EventType)(0),                   // 0: rtdb.v1.EventType
+	(*PointRef)(nil),                 // 1: rtdb.v1.PointRef
+	(*Error)(nil),                    // 2: rtdb.v1.Error
+	(*Point)(nil),                    // 3: rtdb.v1.Point
+	(*Coordinates)(nil),              // 4: rtdb.v1.Coordinates
+	(*TVQ)(nil),                      // 5: rtdb.v1.TVQ
+	(*Snapshot)(nil),                 // 6: rtdb.v1.Snapshot
+	(*GetPointsRequest)(nil),         // 7: rtdb.v1.GetPointsRequest
+	(*PointResult)(nil),              // 8: rtdb.v1.PointResult
+	(*GetPointsResponse)(nil),        // 9: rtdb.v1.GetPointsResponse
+	(*ReadSnapshotsRequest)(nil),     // 10: rtdb.v1.ReadSnapshotsRequest
+	(*ReadSnapshotsResponse)(nil),    // 11: rtdb.v1.ReadSnapshotsResponse
+	(*ReadHistoryRequest)(nil),       // 12: rtdb.v1.ReadHistoryRequest
+	(*ReadInterpolatedRequest)(nil),  // 13: rtdb.v1.ReadInterpolatedRequest
+	(*ReadInterpolatedResponse)(nil), // 14: rtdb.v1.ReadInterpolatedResponse
+	(*ReadSummaryRequest)(nil),       // 15: rtdb.v1.ReadSummaryRequest
+	(*SummaryValue)(nil),             // 16: rtdb.v1.SummaryValue
+	(*Summary)(nil),                  // 17: rtdb.v1.Summary
+	(*SubscribeRequest)(nil),         // 18: rtdb.v1.SubscribeRequest
+	(*SubscribeResponse)(nil),        // 19: rtdb.v1.SubscribeResponse
+	(*WriteRequest)(nil),             // 20: rtdb.v1.WriteRequest
+	(*WriteValue)(nil),               // 21: rtdb.v1.WriteValue
+	(*WriteError)(nil),               // 22: rtdb.v1.WriteError
+	(*WriteResponse)(nil),            // 23: rtdb.v1.WriteResponse
+	(*timestamppb.Timestamp)(nil),    // 24: google.protobuf.Timestamp
+}
+var file_rtdb_proto_depIdxs = []int32{
+	24, // 0: rtdb.v1.TVQ.time:type_name -> google.protobuf.Timestamp
+	4,  // 1: rtdb.v1.TVQ.coor_value:type_name -> rtdb.v1.Coordinates
+	5,  // 2: rtdb.v1.Snapshot.value:type_name -> rtdb.v1.TVQ
+	2,  // 3: rtdb.v1.Snapshot.error:type_name -> rtdb.v1.Error
+	1,  // 4: rtdb.v1.GetPointsRequest.points:type_name -> rtdb.v1.PointRef
+	3,  // 5: rtdb.v1.PointResult.point:type_name -> rtdb.v1.Point
+	2,  // 6: rtdb.v1.PointResult.error:type_name -> rtdb.v1.Error
+	8,  // 7: rtdb.v1.GetPointsResponse.results:type_name -> rtdb.v1.PointResult
+	1,  // 8: rtdb.v1.ReadSnapshotsRequest.points:type_name -> rtdb.v1.PointRef
+	6,  // 9: rtdb.v1.ReadSnapshotsResponse.snapshots:type_name -> rtdb.v1.Snapshot
+	1,  // 10: rtdb.v1.ReadHistoryRequest.point:type_name -> rtdb.v1.PointRef
+	24, // 11: rtdb.v1.ReadHistoryRequest.start:type_name -> google.protobuf.Timestamp
+	24, // 12: rtdb.v1.ReadHistoryRequest.end:type_name -> google.protobuf.Timestamp
+	1,  // 13: rtdb.v1.ReadInterpolatedRequest.point:type_name -> rtdb.v1.PointRef
+	24, // 14: rtdb.v1.ReadInterpolatedRequest.start:type_name -> google.protobuf.Timestamp
+	24, // 15: rtdb.v1.ReadInterpolatedRequest.end:type_name -> google.protobuf.Timestamp
+	5,  // 16: rtdb.v1.ReadInterpolatedResponse.values:type_name -> rtdb.v1.TVQ
+	1,  // 17: rtdb.v1.ReadSummaryRequest.point:type_name -> rtdb.v1.PointRef
+	24, // 18: rtdb.v1.ReadSummaryRequest.start:type_name -> google.protobuf.Timestamp
+	24, // 19: rtdb.v1.ReadSummaryRequest.end:type_name -> google.protobuf.Timestamp
+	24, // 20: rtdb.v1.SummaryValue.time:type_name -> google.protobuf.Timestamp
+	16, // 21: rtdb.v1.Summary.first:type_name -> rtdb.v1.SummaryValue
+	16, // 22: rtdb.v1.Summary.last:type_name -> rtdb.v1.SummaryValue
+	16, // 23: rtdb.v1.Summary.max:type_name -> rtdb.v1.SummaryValue
+	16, // 24: rtdb.v1.Summary.min:type_name -> rtdb.v1.SummaryValue
+	1,  // 25: rtdb.v1.SubscribeRequest.points:type_name -> rtdb.v1.PointRef
+	0,  // 26: rtdb.v1.SubscribeResponse.type:type_name -> rtdb.v1.EventType
+	6,  // 27: rtdb.v1.SubscribeResponse.snapshots:type_name -> rtdb.v1.Snapshot
+	21, // 28: rtdb.v1.WriteRequest.values:type_name -> rtdb.v1.WriteValue
+	1,  // 29: rtdb.v1.WriteValue.point:type_name -> rtdb.v1.PointRef
+	5,  // 30: rtdb.v1.WriteValue.value:type_name -> rtdb.v1.TVQ
+	2,  // 31: rtdb.v1.WriteError.error:type_name -> rtdb.v1.Error
+	22, // 32: rtdb.v1.WriteResponse.errors:type_name -> rtdb.v1.WriteError
+	7,  // 33: rtdb.v1.Rtdb.GetPoints:input_type -> rtdb.v1.GetPointsRequest
+	10, // 34: rtdb.v1.Rtdb.ReadSnapshots:input_type -> rtdb.v1.ReadSnapshotsRequest
+	12, // 35: rtdb.v1.Rtdb.ReadHistory:input_type -> rtdb.v1.ReadHistoryRequest
+	13, // 36: rtdb.v1.Rtdb.ReadInterpolated:input_type -> rtdb.v1.ReadInterpolatedRequest
+	15, // 37: rtdb.v1.Rtdb.ReadSummary:input_type -> rtdb.v1.ReadSummaryRequest
+	18, // 38: rtdb.v1.Rtdb.Subscribe:input_type -> rtdb.v1.SubscribeRequest
+	20, // 39: rtdb.v1.Rtdb.Write:input_type -> rtdb.v1.WriteRequest
+	9,  // 40: rtdb.v1.Rtdb.GetPoints:output_type -> rtdb.v1.GetPointsResponse
+	11, // 41: rtdb.v1.Rtdb.ReadSnapshots:output_type -> rtdb.v1.ReadSnapshotsResponse
+	5,  // 42: rtdb.v1.Rtdb.ReadHistory:output_type -> rtdb.v1.TVQ
+	14, // 43: rtdb.v1.Rtdb.ReadInterpolated:output_type -> rtdb.v1.ReadInterpolatedResponse
+	17, // 44: rtdb.v1.Rtdb.ReadSummary:output_type -> rtdb.v1.Summary
+	19, // 45: rtdb.v1.Rtdb.Subscribe:output_type -> rtdb.v1.SubscribeResponse
+	23, // 46: rtdb.v1.Rtdb.Write:output_type -> rtdb.v1.WriteResponse
+	40, // [40:47] is the sub-list for method output_type
+	33, // [33:40] is the sub-list for method input_type
+	33, // [33:33] is the sub-list for extension type_name
+	33, // [33:33] is the sub-list for extension extendee
+	0,  // [0:33] is the sub-list for field type_name
+}
+
+func init() { file_rtdb_proto_init() }
+func file_rtdb_proto_init() {
+	if File_rtdb_proto != nil {
+		return
+	}
+	file_rtdb_proto_msgTypes[0].OneofWrappers = []any{
+		(*PointRef_Id)(nil),
+		(*PointRef_Tag)(nil),
+	}
+	file_rtdb_proto_msgTypes[4].OneofWrappers = []any{
+		(*TVQ_BoolValue)(nil),
+		(*TVQ_IntValue)(nil),
+		(*TVQ_FloatValue)(nil),
+		(*TVQ_CoorValue)(nil),
+		(*TVQ_StringValue)(nil),
+		(*TVQ_BytesValue)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rtdb_proto_rawDesc), len(file_rtdb_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   23,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_rtdb_proto_goTypes,
+		DependencyIndexes: file_rtdb_proto_depIdxs,
+		EnumInfos:         file_rtdb_proto_enumTypes,
+		MessageInfos:      file_rtdb_proto_msgTypes,
+	}.Build()
+	File_rtdb_proto = out.File
+	file_rtdb_proto_goTypes = nil
+	file_rtdb_proto_depIdxs = nil
+}
@@ -0,0 +1,373 @@
+// RTDB gRPC服务定义
+//
+// 生成代码:
+//
+//	protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative rtdb.proto
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: rtdb.proto
+
+package rtdbgrpc
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Rtdb_GetPoints_FullMethodName        = "/rtdb.v1.Rtdb/GetPoints"
+	Rtdb_ReadSnapshots_FullMethodName    = "/rtdb.v1.Rtdb/ReadSnapshots"
+	Rtdb_ReadHistory_FullMethodName      = "/rtdb.v1.Rtdb/ReadHistory"
+	Rtdb_ReadInterpolated_FullMethodName = "/rtdb.v1.Rtdb/ReadInterpolated"
+	Rtdb_ReadSummary_FullMethodName      = "/rtdb.v1.Rtdb/ReadSummary"
+	Rtdb_Subscribe_FullMethodName        = "/rtdb.v1.Rtdb/Subscribe"
+	Rtdb_Write_FullMethodName            = "/rtdb.v1.Rtdb/Write"
+)
+
+// RtdbClient is the client API for Rtdb service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Rtdb 实时数据库服务
+type RtdbClient interface {
+	// GetPoints 批量获取标签点属性, 单个标签点失败时在结果中返回错误
+	GetPoints(ctx context.Context, in *GetPointsRequest, opts ...grpc.CallOption) (*GetPointsResponse, error)
+	// ReadSnapshots 批量读取快照
+	ReadSnapshots(ctx context.Context, in *ReadSnapshotsRequest, opts ...grpc.CallOption) (*ReadSnapshotsResponse, error)
+	// ReadHistory 读取一段时间内的历史存档数据, 按时间顺序逐个返回
+	ReadHistory(ctx context.Context, in *ReadHistoryRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TVQ], error)
+	// ReadInterpolated 读取一段时间内等间隔的插值, 只支持整数与浮点数类型
+	ReadInterpolated(ctx context.Context, in *ReadInterpolatedRequest, opts ...grpc.CallOption) (*ReadInterpolatedResponse, error)
+	// ReadSummary 读取一段时间内的统计值, 只支持整数与浮点数类型
+	ReadSummary(ctx context.Context, in *ReadSummaryRequest, opts ...grpc.CallOption) (*Summary, error)
+	// Subscribe 订阅快照, 第一个消息为 SUBSCRIBED 事件, 之后推送快照改变与连接状态
+	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SubscribeResponse], error)
+	// Write 批量写入数值, 服务端按批次调用 WriteSection, 客户端关闭发送后返回写入结果
+	Write(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[WriteRequest, WriteResponse], error)
+}
+
+type rtdbClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRtdbClient(cc grpc.ClientConnInterface) RtdbClient {
+	return &rtdbClient{cc}
+}
+
+func (c *rtdbClient) GetPoints(ctx context.Context, in *GetPointsRequest, opts ...grpc.CallOption) (*GetPointsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPointsResponse)
+	err := c.cc.Invoke(ctx, Rtdb_GetPoints_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rtdbClient) ReadSnapshots(ctx context.Context, in *ReadSnapshotsRequest, opts ...grpc.CallOption) (*ReadSnapshotsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReadSnapshotsResponse)
+	err := c.cc.Invoke(ctx, Rtdb_ReadSnapshots_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rtdbClient) ReadHistory(ctx context.Context, in *ReadHistoryRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TVQ], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Rtdb_ServiceDesc.Streams[0], Rtdb_ReadHistory_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ReadHistoryRequest, TVQ]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Rtdb_ReadHistoryClient = grpc.ServerStreamingClient[TVQ]
+
+func (c *rtdbClient) ReadInterpolated(ctx context.Context, in *ReadInterpolatedRequest, opts ...grpc.CallOption) (*ReadInterpolatedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReadInterpolatedResponse)
+	err := c.cc.Invoke(ctx, Rtdb_ReadInterpolated_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rtdbClient) ReadSummary(ctx context.Context, in *ReadSummaryRequest, opts ...grpc.CallOption) (*Summary, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Summary)
+	err := c.cc.Invoke(ctx, Rtdb_ReadSummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rtdbClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SubscribeResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Rtdb_ServiceDesc.Streams[1], Rtdb_Subscribe_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SubscribeRequest, SubscribeResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Rtdb_SubscribeClient = grpc.ServerStreamingClient[SubscribeResponse]
+
+func (c *rtdbClient) Write(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[WriteRequest, WriteResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Rtdb_ServiceDesc.Streams[2], Rtdb_Write_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WriteRequest, WriteResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Rtdb_WriteClient = grpc.ClientStreamingClient[WriteRequest, WriteResponse]
+
+// RtdbServer is the server API for Rtdb service.
+// All implementations must embed UnimplementedRtdbServer
+// for forward compatibility.
+//
+// Rtdb 实时数据库服务
+type RtdbServer interface {
+	// GetPoints 批量获取标签点属性, 单个标签点失败时在结果中返回错误
+	GetPoints(context.Context, *GetPointsRequest) (*GetPointsResponse, error)
+	// ReadSnapshots 批量读取快照
+	ReadSnapshots(context.Context, *ReadSnapshotsRequest) (*ReadSnapshotsResponse, error)
+	// ReadHistory 读取一段时间内的历史存档数据, 按时间顺序逐个返回
+	ReadHistory(*ReadHistoryRequest, grpc.ServerStreamingServer[TVQ]) error
+	// ReadInterpolated 读取一段时间内等间隔的插值, 只支持整数与浮点数类型
+	ReadInterpolated(context.Context, *ReadInterpolatedRequest) (*ReadInterpolatedResponse, error)
+	// ReadSummary 读取一段时间内的统计值, 只支持整数与浮点数类型
+	ReadSummary(context.Context, *ReadSummaryRequest) (*Summary, error)
+	// Subscribe 订阅快照, 第一个消息为 SUBSCRIBED 事件, 之后推送快照改变与连接状态
+	Subscribe(*SubscribeRequest, grpc.ServerStreamingServer[SubscribeResponse]) error
+	// Write 批量写入数值, 服务端按批次调用 WriteSection, 客户端关闭发送后返回写入结果
+	Write(grpc.ClientStreamingServer[WriteRequest, WriteResponse]) error
+	mustEmbedUnimplementedRtdbServer()
+}
+
+// UnimplementedRtdbServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRtdbServer struct{}
+
+func (UnimplementedRtdbServer) GetPoints(context.Context, *GetPointsRequest) (*GetPointsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPoints not implemented")
+}
+func (UnimplementedRtdbServer) ReadSnapshots(context.Context, *ReadSnapshotsRequest) (*ReadSnapshotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadSnapshots not implemented")
+}
+func (UnimplementedRtdbServer) ReadHistory(*ReadHistoryRequest, grpc.ServerStreamingServer[TVQ]) error {
+	return status.Errorf(codes.Unimplemented, "method ReadHistory not implemented")
+}
+func (UnimplementedRtdbServer) ReadInterpolated(context.Context, *ReadInterpolatedRequest) (*ReadInterpolatedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadInterpolated not implemented")
+}
+func (UnimplementedRtdbServer) ReadSummary(context.Context, *ReadSummaryRequest) (*Summary, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadSummary not implemented")
+}
+func (UnimplementedRtdbServer) Subscribe(*SubscribeRequest, grpc.ServerStreamingServer[SubscribeResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
+func (UnimplementedRtdbServer) Write(grpc.ClientStreamingServer[WriteRequest, WriteResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method Write not implemented")
+}
+func (UnimplementedRtdbServer) mustEmbedUnimplementedRtdbServer() {}
+func (UnimplementedRtdbServer) testEmbeddedByValue()              {}
+
+// UnsafeRtdbServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RtdbServer will
+// result in compilation errors.
+type UnsafeRtdbServer interface {
+	mustEmbedUnimplementedRtdbServer()
+}
+
+func RegisterRtdbServer(s grpc.ServiceRegistrar, srv RtdbServer) {
+	// If the following call pancis, it indicates UnimplementedRtdbServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Rtdb_ServiceDesc, srv)
+}
+
+func _Rtdb_GetPoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPointsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RtdbServer).GetPoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Rtdb_GetPoints_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RtdbServer).GetPoints(ctx, req.(*GetPointsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Rtdb_ReadSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadSnapshotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RtdbServer).ReadSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Rtdb_ReadSnapshots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RtdbServer).ReadSnapshots(ctx, req.(*ReadSnapshotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Rtdb_ReadHistory_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ReadHistoryRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RtdbServer).ReadHistory(m, &grpc.GenericServerStream[ReadHistoryRequest, TVQ]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Rtdb_ReadHistoryServer = grpc.ServerStreamingServer[TVQ]
+
+func _Rtdb_ReadInterpolated_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadInterpolatedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RtdbServer).ReadInterpolated(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Rtdb_ReadInterpolated_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RtdbServer).ReadInterpolated(ctx, req.(*ReadInterpolatedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Rtdb_ReadSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RtdbServer).ReadSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Rtdb_ReadSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RtdbServer).ReadSummary(ctx, req.(*ReadSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Rtdb_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RtdbServer).Subscribe(m, &grpc.GenericServerStream[SubscribeRequest, SubscribeResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Rtdb_SubscribeServer = grpc.ServerStreamingServer[SubscribeResponse]
+
+func _Rtdb_Write_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(RtdbServer).Write(&grpc.GenericServerStream[WriteRequest, WriteResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Rtdb_WriteServer = grpc.ClientStreamingServer[WriteRequest, WriteResponse]
+
+// Rtdb_ServiceDesc is the grpc.ServiceDesc for Rtdb service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Rtdb_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "rtdb.v1.Rtdb",
+	HandlerType: (*RtdbServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetPoints",
+			Handler:    _Rtdb_GetPoints_Handler,
+		},
+		{
+			MethodName: "ReadSnapshots",
+			Handler:    _Rtdb_ReadSnapshots_Handler,
+		},
+		{
+			MethodName: "ReadInterpolated",
+			Handler:    _Rtdb_ReadInterpolated_Handler,
+		},
+		{
+			MethodName: "ReadSummary",
+			Handler:    _Rtdb_ReadSummary_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ReadHistory",
+			Handler:       _Rtdb_ReadHistory_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Subscribe",
+			Handler:       _Rtdb_Subscribe_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Write",
+			Handler:       _Rtdb_Write_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "rtdb.proto",
+}
@@ -0,0 +1,625 @@
+// Package rtdbgrpc 基于 RtdbConnect 的gRPC服务, 服务定义参见 rtdb.proto
+//
+// 快照订阅使用 RtdbConnect.SubscribeSnapshots 单独登录的连接, 其他调用共用创建服务时传入的连接
+package rtdbgrpc
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative rtdb.proto
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	rtdb "github.com/kkbase/rtdb_api"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Options 服务选项
+type Options struct {
+	// BatchSize Write 每次调用 WriteSection 写入的数值个数, 为0时为1000
+	BatchSize int
+
+	// SubscribeBuffer 每个订阅缓存的事件个数, 客户端接收过慢导致缓存已满时结束订阅, 为0时为1024
+	SubscribeBuffer int
+}
+
+// Server 实现 RtdbServer
+type Server struct {
+	UnimplementedRtdbServer
+
+	conn *rtdb.RtdbConnect
+	opts Options
+}
+
+// NewServer 创建服务, 使用 RegisterRtdbServer 注册到 grpc.Server
+//
+// input:
+//   - conn 数据库连接
+//   - opts 服务选项
+func NewServer(conn *rtdb.RtdbConnect, opts Options) *Server {
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = 1000
+	}
+	if opts.SubscribeBuffer <= 0 {
+		opts.SubscribeBuffer = 1024
+	}
+	return &Server{conn: conn, opts: opts}
+}
+
+// Register 将服务注册到 grpc.Server
+func (s *Server) Register(registrar grpc.ServiceRegistrar) {
+	RegisterRtdbServer(registrar, s)
+}
+
+// GetPoints 批量获取标签点属性
+func (s *Server) GetPoints(ctx context.Context, req *GetPointsRequest) (*GetPointsResponse, error) {
+	infos, errs, err := s.resolve(req.GetPoints())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	resp := &GetPointsResponse{Results: make([]*PointResult, len(infos))}
+	for i, info := range infos {
+		if errs[i] != nil {
+			resp.Results[i] = &PointResult{Error: toError(errs[i])}
+		} else {
+			resp.Results[i] = &PointResult{Point: toPoint(info)}
+		}
+	}
+	return resp, nil
+}
+
+// ReadSnapshots 批量读取快照
+func (s *Server) ReadSnapshots(ctx context.Context, req *ReadSnapshotsRequest) (*ReadSnapshotsResponse, error) {
+	infos, errs, err := s.resolve(req.GetPoints())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	found := make([]*rtdb.PointInfo, 0, len(infos))
+	for i, info := range infos {
+		if errs[i] == nil {
+			found = append(found, info)
+		}
+	}
+	var tvqs []rtdb.TVQ
+	var readErrs []error
+	if len(found) != 0 {
+		if tvqs, readErrs, err = s.conn.ReadSnapshots(found); err != nil {
+			return nil, toStatus(err)
+		}
+	}
+	resp := &ReadSnapshotsResponse{Snapshots: make([]*Snapshot, len(infos))}
+	for i, j := 0, 0; i < len(infos); i++ {
+		if errs[i] != nil {
+			resp.Snapshots[i] = refSnapshot(req.GetPoints()[i], errs[i])
+			continue
+		}
+		resp.Snapshots[i] = toSnapshot(infos[i], tvqs[j], readErrs[j])
+		j++
+	}
+	return resp, nil
+}
+
+// ReadHistory 读取一段时间内的历史存档数据
+func (s *Server) ReadHistory(req *ReadHistoryRequest, stream grpc.ServerStreamingServer[TVQ]) error {
+	info, err := s.point(req.GetPoint())
+	if err != nil {
+		return err
+	}
+	start, end, err := timeRange(req.GetStart(), req.GetEnd())
+	if err != nil {
+		return err
+	}
+	count := int64(0)
+	for tvq, err := range s.conn.ArchivedValues(info, start, end) {
+		if err != nil {
+			return toStatus(err)
+		}
+		if err := stream.Send(toTVQ(tvq)); err != nil {
+			return err
+		}
+		if count++; req.GetLimit() > 0 && count >= req.GetLimit() {
+			break
+		}
+	}
+	return nil
+}
+
+// ReadInterpolated 读取一段时间内等间隔的插值
+func (s *Server) ReadInterpolated(ctx context.Context, req *ReadInterpolatedRequest) (*ReadInterpolatedResponse, error) {
+	info, err := s.point(req.GetPoint())
+	if err != nil {
+		return nil, err
+	}
+	start, end, err := timeRange(req.GetStart(), req.GetEnd())
+	if err != nil {
+		return nil, err
+	}
+	count := req.GetCount()
+	if count < 0 {
+		return nil, status.Error(codes.InvalidArgument, "count不能小于0")
+	}
+	if count == 0 {
+		count = 100
+	}
+	tvqs, err := s.conn.ReadInterpoValues(info, start, end, count)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	resp := &ReadInterpolatedResponse{Values: make([]*TVQ, len(tvqs))}
+	for i, tvq := range tvqs {
+		resp.Values[i] = toTVQ(tvq)
+	}
+	return resp, nil
+}
+
+// ReadSummary 读取一段时间内的统计值
+func (s *Server) ReadSummary(ctx context.Context, req *ReadSummaryRequest) (*Summary, error) {
+	info, err := s.point(req.GetPoint())
+	if err != nil {
+		return nil, err
+	}
+	var start, end time.Time
+	if req.GetStart() != nil {
+		start = req.GetStart().AsTime()
+	}
+	if req.GetEnd() != nil {
+		end = req.GetEnd().AsTime()
+	}
+	summary, err := s.conn.ReadSummary(info, start, end)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	value := func(v rtdb.SummaryValue) *SummaryValue {
+		return &SummaryValue{Time: timestamppb.New(v.Timestamp), Value: v.Value, Quality: int32(v.Quality)}
+	}
+	return &Summary{
+		First:      value(summary.First),
+		Last:       value(summary.Last),
+		Max:        value(summary.Max),
+		Min:        value(summary.Min),
+		Power:      summary.Power,
+		PowerAvg:   summary.PowerAvg,
+		Total:      summary.Total,
+		CalcAvg:    summary.CalcAvg,
+		Count:      summary.Count,
+		ValidCount: summary.ValidCount,
+	}, nil
+}
+
+// Subscribe 订阅快照
+//   - 订阅回调只把事件放入缓存, 由当前协程发送给客户端, 避免阻塞数据库API的线程
+//   - 客户端断开、订阅结束或缓存已满时取消订阅
+func (s *Server) Subscribe(req *SubscribeRequest, stream grpc.ServerStreamingServer[SubscribeResponse]) error {
+	infos, errs, err := s.resolve(req.GetPoints())
+	if err != nil {
+		return toStatus(err)
+	}
+	subscribed := &SubscribeResponse{Type: EventType_SUBSCRIBED, Snapshots: make([]*Snapshot, 0)}
+	found := make([]*rtdb.PointInfo, 0, len(infos))
+	for i, info := range infos {
+		if errs[i] != nil {
+			subscribed.Snapshots = append(subscribed.Snapshots, refSnapshot(req.GetPoints()[i], errs[i]))
+		} else {
+			found = append(found, info)
+		}
+	}
+	if len(found) == 0 {
+		if err := stream.Send(subscribed); err != nil {
+			return err
+		}
+		return status.Error(codes.NotFound, "没有可以订阅的标签点")
+	}
+
+	events := make(chan *SubscribeResponse, s.opts.SubscribeBuffer)
+	overflow := make(chan struct{})
+	var once sync.Once
+	handler := func(u rtdb.SnapshotUpdate) {
+		resp := toSubscribeResponse(u)
+		if resp == nil {
+			return
+		}
+		select {
+		case events <- resp:
+		default:
+			once.Do(func() { close(overflow) })
+		}
+	}
+	sub, subErrs, err := s.conn.SubscribeSnapshots(found, rtdb.SubscribeOptions{AutoConn: req.GetAutoConn()}, handler)
+	if err != nil {
+		return toStatus(err)
+	}
+	defer func() { _ = sub.Close() }()
+	for i, err := range subErrs {
+		if err != nil {
+			subscribed.Snapshots = append(subscribed.Snapshots, &Snapshot{Id: int32(found[i].ID), Tag: found[i].TableDotTag, Error: toError(err)})
+		}
+	}
+	if err := stream.Send(subscribed); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case resp := <-events:
+			if err := stream.Send(resp); err != nil {
+				return err
+			}
+		case <-overflow:
+			return status.Error(codes.ResourceExhausted, "订阅事件缓存已满, 客户端接收过慢")
+		case <-sub.Done():
+			// 发送订阅结束前已经缓存的事件, 例如 DISCONNECT
+			for {
+				select {
+				case resp := <-events:
+					if err := stream.Send(resp); err != nil {
+						return err
+					}
+				default:
+					return toStatus(sub.Err())
+				}
+			}
+		case <-stream.Context().Done():
+			return status.FromContextError(stream.Context().Err()).Err()
+		}
+	}
+}
+
+// Write 批量写入数值
+//   - 数值按 BatchSize 分批调用 WriteSection, fix 改变时先写入之前的数值
+//   - 单个数值失败时在结果中返回错误, 网络与连接错误时结束请求
+//   - 通过 WriteSectionContext 写入, 客户端断开时取消进行中的写入
+func (s *Server) Write(stream grpc.ClientStreamingServer[WriteRequest, WriteResponse]) error {
+	resp := &WriteResponse{Errors: make([]*WriteError, 0)}
+	fail := func(index int64, err error) {
+		resp.Errors = append(resp.Errors, &WriteError{Index: index, Error: toError(err)})
+	}
+	points := make(map[string]*rtdb.PointInfo)
+	batch, index := make([]rtdb.PTVQ, 0, s.opts.BatchSize), make([]int64, 0, s.opts.BatchSize)
+	fix := false
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		errs, err := s.conn.WriteSectionContext(stream.Context(), fix, batch)
+		if err != nil {
+			return err
+		}
+		for i, e := range errs {
+			if e != nil {
+				fail(index[i], e)
+			} else {
+				resp.Written++
+			}
+		}
+		batch, index = batch[:0], index[:0]
+		return nil
+	}
+
+	n := int64(0)
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if req.GetFix() != fix {
+			if err := flush(); err != nil {
+				return toStatus(err)
+			}
+			fix = req.GetFix()
+		}
+		for _, v := range req.GetValues() {
+			i := n
+			n++
+			ptvq, err := s.writeValue(points, v)
+			if err != nil {
+				if rtdb.ErrorCategoryOf(err) == rtdb.ErrorCategoryNetwork {
+					return toStatus(err)
+				}
+				fail(i, err)
+				continue
+			}
+			batch, index = append(batch, ptvq), append(index, i)
+			if len(batch) >= s.opts.BatchSize {
+				if err := flush(); err != nil {
+					return toStatus(err)
+				}
+			}
+		}
+	}
+	if err := flush(); err != nil {
+		return toStatus(err)
+	}
+	return stream.SendAndClose(resp)
+}
+
+// writeValue 转换单个写入的数值, 标签点信息在一次请求内缓存
+func (s *Server) writeValue(points map[string]*rtdb.PointInfo, v *WriteValue) (rtdb.PTVQ, error) {
+	key := refKey(v.GetPoint())
+	info, ok := points[key]
+	if !ok {
+		infos, errs, err := s.resolve([]*PointRef{v.GetPoint()})
+		if err != nil {
+			return rtdb.PTVQ{}, err
+		}
+		if errs[0] != nil {
+			return rtdb.PTVQ{}, errs[0]
+		}
+		info = infos[0]
+		points[key] = info
+	}
+	tvq, err := fromTVQ(info, v.GetValue())
+	if err != nil {
+		return rtdb.PTVQ{}, err
+	}
+	return rtdb.NewPTVQ(info, tvq), nil
+}
+
+// resolve 批量查找标签点, 结果与refs一一对应
+//
+// output:
+//   - []*rtdb.PointInfo 标签点属性, 查找失败时为nil
+//   - []error 每个标签点的查找结果
+func (s *Server) resolve(refs []*PointRef) ([]*rtdb.PointInfo, []error, error) {
+	infos := make([]*rtdb.PointInfo, len(refs))
+	errs := make([]error, len(refs))
+	ids, idIdx := make([]rtdb.PointID, 0), make([]int, 0)
+	tags, tagIdx := make([]string, 0), make([]int, 0)
+	for i, ref := range refs {
+		switch r := ref.GetRef().(type) {
+		case *PointRef_Id:
+			ids, idIdx = append(ids, rtdb.PointID(r.Id)), append(idIdx, i)
+		case *PointRef_Tag:
+			tags, tagIdx = append(tags, r.Tag), append(tagIdx, i)
+		default:
+			errs[i] = status.Error(codes.InvalidArgument, "缺少标签点ID或全名")
+		}
+	}
+	if len(ids) != 0 {
+		found, foundErrs, err := s.conn.GetPoints(ids)
+		if err != nil {
+			return nil, nil, err
+		}
+		for j, i := range idIdx {
+			infos[i], errs[i] = found[j], foundErrs[j]
+		}
+	}
+	if len(tags) != 0 {
+		found, foundErrs, err := s.conn.FindPoints(tags)
+		if err != nil {
+			return nil, nil, err
+		}
+		for j, i := range tagIdx {
+			infos[i], errs[i] = found[j], foundErrs[j]
+			if foundErrs[j] != nil {
+				errs[i] = fmt.Errorf("%s: %w", tags[j], foundErrs[j])
+			}
+		}
+	}
+	return infos, errs, nil
+}
+
+// point 查找单个标签点, 失败时返回 status 错误
+func (s *Server) point(ref *PointRef) (*rtdb.PointInfo, error) {
+	infos, errs, err := s.resolve([]*PointRef{ref})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	if errs[0] != nil {
+		return nil, toStatus(errs[0])
+	}
+	return infos[0], nil
+}
+
+// refKey PointRef在请求内缓存的键
+func refKey(ref *PointRef) string {
+	switch r := ref.GetRef().(type) {
+	case *PointRef_Id:
+		return fmt.Sprintf("id:%d", r.Id)
+	case *PointRef_Tag:
+		return "tag:" + r.Tag
+	}
+	return ""
+}
+
+// timeRange 历史查询的时间范围, end为空时为当前时间, start为空时为end前1小时
+func timeRange(start, end *timestamppb.Timestamp) (time.Time, time.Time, error) {
+	e := time.Now()
+	if end != nil {
+		e = end.AsTime()
+	}
+	b := e.Add(-time.Hour)
+	if start != nil {
+		b = start.AsTime()
+	}
+	if b.After(e) {
+		return time.Time{}, time.Time{}, status.Error(codes.InvalidArgument, "start不能晚于end")
+	}
+	return b, e, nil
+}
+
+// toSubscribeResponse 转换订阅事件, 不需要推送的事件返回nil
+func toSubscribeResponse(u rtdb.SnapshotUpdate) *SubscribeResponse {
+	resp := &SubscribeResponse{}
+	switch u.Type {
+	case rtdb.RtdbEventData:
+		resp.Type = EventType_DATA
+		resp.Snapshots = make([]*Snapshot, len(u.Values))
+		for i, ptvq := range u.Values {
+			resp.Snapshots[i] = toSnapshot(ptvq.PointInfo, ptvq.TVQ, u.Errors[i])
+		}
+	case rtdb.RtdbEventDisconnect:
+		resp.Type = EventType_DISCONNECT
+	case rtdb.RtdbEventRecovery:
+		resp.Type = EventType_RECOVERY
+	case rtdb.RtdbEventSwitching:
+		resp.Type = EventType_SWITCHING
+	case rtdb.RtdbEventSwitched:
+		resp.Type = EventType_SWITCHED
+	default:
+		return nil
+	}
+	return resp
+}
+
+// toStatus 数据库错误转换成gRPC状态
+func toStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return status.FromContextError(err).Err()
+	}
+	code := codes.Internal
+	switch rtdb.ErrorCategoryOf(err) {
+	case rtdb.ErrorCategoryNotFound:
+		code = codes.NotFound
+	case rtdb.ErrorCategoryConflict:
+		code = codes.AlreadyExists
+	case rtdb.ErrorCategoryData:
+		code = codes.InvalidArgument
+	case rtdb.ErrorCategoryPermission:
+		code = codes.PermissionDenied
+	case rtdb.ErrorCategoryUnsupported:
+		code = codes.Unimplemented
+	case rtdb.ErrorCategoryNetwork:
+		code = codes.Unavailable
+	}
+	return status.Error(code, err.Error())
+}
+
+// toError 转换单个标签点的错误, 数据库错误附带错误分类
+func toError(err error) *Error {
+	if st, ok := status.FromError(err); ok {
+		return &Error{Message: st.Message()}
+	}
+	e := &Error{Message: err.Error()}
+	if category := rtdb.ErrorCategoryOf(err); category != rtdb.ErrorCategoryUnknown {
+		e.Category = category.String()
+	}
+	return e
+}
+
+// toPoint 转换标签点属性
+func toPoint(info *rtdb.PointInfo) *Point {
+	return &Point{
+		Id:          int32(info.ID),
+		TableId:     int32(info.TableID),
+		Name:        info.Name,
+		TableDotTag: info.TableDotTag,
+		ValueType:   string(info.ValueType),
+		Class:       int32(info.Class),
+		Precision:   int32(info.Precision),
+		Desc:        info.Desc,
+		Unit:        info.Unit,
+		Archive:     info.Archive != rtdb.OFF,
+		Digits:      int32(info.Digits),
+		LowLimit:    info.LowLimit,
+		HighLimit:   info.HighLimit,
+		Step:        info.Step != rtdb.OFF,
+		Typical:     info.Typical,
+		Compress:    info.Compress != rtdb.OFF,
+		CompDev:     info.CompDev,
+		CompTimeMax: info.CompTimeMax,
+		ExcDev:      info.ExcDev,
+		ExcTimeMax:  info.ExcTimeMax,
+	}
+}
+
+// toSnapshot 转换单个标签点的快照
+func toSnapshot(info *rtdb.PointInfo, tvq rtdb.TVQ, err error) *Snapshot {
+	snapshot := &Snapshot{Id: int32(info.ID), Tag: info.TableDotTag}
+	if err != nil {
+		snapshot.Error = toError(err)
+	} else {
+		snapshot.Value = toTVQ(tvq)
+	}
+	return snapshot
+}
+
+// refSnapshot 查找失败的标签点对应的快照
+func refSnapshot(ref *PointRef, err error) *Snapshot {
+	return &Snapshot{Id: ref.GetId(), Tag: ref.GetTag(), Error: toError(err)}
+}
+
+// toTVQ 转换数值, 数值字段由数值类型决定
+func toTVQ(tvq rtdb.TVQ) *TVQ {
+	v := &TVQ{Time: timestamppb.New(tvq.Timestamp), Quality: int32(tvq.Quality)}
+	rtdbType, _ := tvq.Type.ToRawType()
+	switch rtdbType {
+	case rtdb.RtdbTypeBool:
+		v.Value = &TVQ_BoolValue{BoolValue: rtdb.Int64ToBool(tvq.Value.IntValue)}
+	case rtdb.RtdbTypeUint8, rtdb.RtdbTypeInt8, rtdb.RtdbTypeChar, rtdb.RtdbTypeUint16, rtdb.RtdbTypeInt16, rtdb.RtdbTypeUint32, rtdb.RtdbTypeInt32, rtdb.RtdbTypeInt64:
+		v.Value = &TVQ_IntValue{IntValue: tvq.Value.IntValue}
+	case rtdb.RtdbTypeReal16, rtdb.RtdbTypeReal32, rtdb.RtdbTypeReal64, rtdb.RtdbTypeFp16, rtdb.RtdbTypeFp32, rtdb.RtdbTypeFp64:
+		v.Value = &TVQ_FloatValue{FloatValue: tvq.Value.FloatValue}
+	case rtdb.RtdbTypeCoor:
+		v.Value = &TVQ_CoorValue{CoorValue: &Coordinates{X: tvq.Value.CoordinatesValue.X, Y: tvq.Value.CoordinatesValue.Y}}
+	case rtdb.RtdbTypeString, rtdb.RtdbTypeDatetime:
+		v.Value = &TVQ_StringValue{StringValue: tvq.Value.StringValue}
+	default:
+		v.Value = &TVQ_BytesValue{BytesValue: tvq.Value.BytesValue}
+	}
+	return v
+}
+
+// fromTVQ 按标签点的数值类型转换写入的数值, 时间戳为空时为当前时间
+func fromTVQ(info *rtdb.PointInfo, v *TVQ) (rtdb.TVQ, error) {
+	if v == nil || v.GetValue() == nil {
+		return rtdb.TVQ{}, status.Errorf(codes.InvalidArgument, "%s: 缺少数值", info.TableDotTag)
+	}
+	ts := time.Now()
+	if v.GetTime() != nil {
+		ts = v.GetTime().AsTime()
+	}
+	tvq := rtdb.TVQ{Timestamp: ts, Type: info.ValueType, Quality: rtdb.Quality(v.GetQuality())}
+	ok := false
+	rtdbType, _ := info.ValueType.ToRawType()
+	switch rtdbType {
+	case rtdb.RtdbTypeBool:
+		var x *TVQ_BoolValue
+		if x, ok = v.GetValue().(*TVQ_BoolValue); ok {
+			tvq.Value.IntValue = rtdb.BoolToInt64(x.BoolValue)
+		}
+	case rtdb.RtdbTypeUint8, rtdb.RtdbTypeInt8, rtdb.RtdbTypeChar, rtdb.RtdbTypeUint16, rtdb.RtdbTypeInt16, rtdb.RtdbTypeUint32, rtdb.RtdbTypeInt32, rtdb.RtdbTypeInt64:
+		var x *TVQ_IntValue
+		if x, ok = v.GetValue().(*TVQ_IntValue); ok {
+			tvq.Value.IntValue = x.IntValue
+		}
+	case rtdb.RtdbTypeReal16, rtdb.RtdbTypeReal32, rtdb.RtdbTypeReal64, rtdb.RtdbTypeFp16, rtdb.RtdbTypeFp32, rtdb.RtdbTypeFp64:
+		var x *TVQ_FloatValue
+		if x, ok = v.GetValue().(*TVQ_FloatValue); ok {
+			tvq.Value.FloatValue = x.FloatValue
+		}
+	case rtdb.RtdbTypeCoor:
+		var x *TVQ_CoorValue
+		if x, ok = v.GetValue().(*TVQ_CoorValue); ok {
+			tvq.Value.CoordinatesValue = rtdb.Coordinates{X: x.CoorValue.GetX(), Y: x.CoorValue.GetY()}
+		}
+	case rtdb.RtdbTypeString, rtdb.RtdbTypeDatetime:
+		var x *TVQ_StringValue
+		if x, ok = v.GetValue().(*TVQ_StringValue); ok {
+			tvq.Value.StringValue = x.StringValue
+		}
+	default:
+		var x *TVQ_BytesValue
+		if x, ok = v.GetValue().(*TVQ_BytesValue); ok {
+			tvq.Value.BytesValue = x.BytesValue
+		}
+	}
+	if !ok {
+		return rtdb.TVQ{}, status.Errorf(codes.InvalidArgument, "%s: %s类型的数值字段错误", info.TableDotTag, info.ValueType)
+	}
+	return tvq, nil
+}
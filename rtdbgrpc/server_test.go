@@ -0,0 +1,223 @@
+package rtdbgrpc
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	rtdb "github.com/kkbase/rtdb_api"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// newTestClient 使用内存后端与bufconn启动服务, 返回客户端
+func newTestClient(t *testing.T, opts Options) (RtdbClient, *rtdb.RtdbConnect) {
+	conn, err := rtdb.LoginWithBackend(rtdb.NewMemoryBackend(), "127.0.0.1", 6327, "sa", "golden")
+	if err != nil {
+		t.Fatal("登录用户失败", err)
+	}
+	t.Cleanup(func() { _ = conn.Logout() })
+
+	lis := bufconn.Listen(1 << 20)
+	gs := grpc.NewServer()
+	NewServer(conn, opts).Register(gs)
+	go func() { _ = gs.Serve(lis) }()
+	t.Cleanup(gs.Stop)
+
+	cc, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = cc.Close() })
+	return NewRtdbClient(cc), conn
+}
+
+// tag 使用全名指定标签点
+func tag(s string) *PointRef {
+	return &PointRef{Ref: &PointRef_Tag{Tag: s}}
+}
+
+// floatValue 浮点数的数值
+func floatValue(ts time.Time, v float64) *TVQ {
+	return &TVQ{Time: timestamppb.New(ts), Value: &TVQ_FloatValue{FloatValue: v}}
+}
+
+// 查询标签点, 批量写入后读取快照、历史、插值与统计值
+func TestServer(t *testing.T) {
+	client, conn := newTestClient(t, Options{BatchSize: 2})
+	ctx := context.Background()
+
+	table, err := conn.CreateTable("grpc", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	temp, err := conn.AddPoint(rtdb.NewPointInfo("temp", table.ID, rtdb.ValueTypeFloat64, rtdb.PointBase, rtdb.RtdbPrecisionMilli, "℃", ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := conn.AddPoint(rtdb.NewPointInfo("name", table.ID, rtdb.ValueTypeString, rtdb.PointBase, rtdb.RtdbPrecisionMilli, "", "")); err != nil {
+		t.Fatal(err)
+	}
+
+	points, err := client.GetPoints(ctx, &GetPointsRequest{Points: []*PointRef{
+		{Ref: &PointRef_Id{Id: int32(temp.ID)}}, tag("grpc.name"), tag("grpc.none"), {},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := points.GetResults()
+	if len(r) != 4 || r[0].GetPoint().GetTableDotTag() != "grpc.temp" || r[0].GetPoint().GetUnit() != "℃" || r[1].GetPoint().GetValueType() != "string" {
+		t.Fatalf("查询标签点结果错误 %v", r)
+	}
+	if r[2].GetError().GetCategory() != rtdb.ErrorCategoryNotFound.String() || r[3].GetError() == nil {
+		t.Errorf("查询失败的标签点错误 %v %v", r[2], r[3])
+	}
+
+	start := time.UnixMilli(1700000000000)
+	stream, err := client.Write(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	values := []*WriteValue{
+		{Point: tag("grpc.temp"), Value: floatValue(start, 1)},
+		{Point: tag("grpc.temp"), Value: floatValue(start.Add(time.Second), 2)},
+		{Point: tag("grpc.none"), Value: floatValue(start, 0)},
+		{Point: tag("grpc.name"), Value: &TVQ{Time: timestamppb.New(start), Value: &TVQ_StringValue{StringValue: "abc"}}},
+	}
+	if err := stream.Send(&WriteRequest{Values: values}); err != nil {
+		t.Fatal(err)
+	}
+	if err := stream.Send(&WriteRequest{Values: []*WriteValue{
+		{Point: tag("grpc.temp"), Value: floatValue(start.Add(2*time.Second), 3)},
+		{Point: tag("grpc.name"), Value: floatValue(start.Add(time.Second), 1)},
+	}}); err != nil {
+		t.Fatal(err)
+	}
+	written, err := stream.CloseAndRecv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if written.GetWritten() != 4 || len(written.GetErrors()) != 2 || written.GetErrors()[0].GetIndex() != 2 || written.GetErrors()[1].GetIndex() != 5 {
+		t.Fatalf("写入结果错误 %v", written)
+	}
+
+	snapshots, err := client.ReadSnapshots(ctx, &ReadSnapshotsRequest{Points: []*PointRef{tag("grpc.none"), tag("grpc.temp"), tag("grpc.name")}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := snapshots.GetSnapshots()
+	if len(s) != 3 || s[0].GetError() == nil || s[0].GetTag() != "grpc.none" || s[1].GetValue().GetFloatValue() != 3 || s[2].GetValue().GetStringValue() != "abc" {
+		t.Fatalf("快照错误 %v", s)
+	}
+
+	history, err := client.ReadHistory(ctx, &ReadHistoryRequest{Point: tag("grpc.temp"), Start: timestamppb.New(start), End: timestamppb.New(start.Add(time.Hour)), Limit: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := make([]float64, 0)
+	for {
+		v, err := history.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, v.GetFloatValue())
+	}
+	if len(got) != 2 || got[0] != 1 || got[1] != 2 {
+		t.Errorf("历史数据错误 %v", got)
+	}
+
+	history, err = client.ReadHistory(ctx, &ReadHistoryRequest{Point: tag("grpc.temp"), Start: timestamppb.New(start.Add(time.Hour)), End: timestamppb.New(start)})
+	if err == nil {
+		_, err = history.Recv()
+	}
+	if status.Code(err) != codes.InvalidArgument {
+		t.Error("start晚于end时期望InvalidArgument", err)
+	}
+
+	interpolated, err := client.ReadInterpolated(ctx, &ReadInterpolatedRequest{Point: tag("grpc.temp"), Start: timestamppb.New(start), End: timestamppb.New(start.Add(2 * time.Second)), Count: 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := interpolated.GetValues(); len(v) != 5 || v[1].GetFloatValue() != 1.5 {
+		t.Errorf("插值错误 %v", v)
+	}
+
+	summary, err := client.ReadSummary(ctx, &ReadSummaryRequest{Point: tag("grpc.temp")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if summary.GetMax().GetValue() != 3 || summary.GetMin().GetValue() != 1 || summary.GetCount() != 3 {
+		t.Errorf("统计值错误 %v", summary)
+	}
+
+	if _, err := client.ReadSummary(ctx, &ReadSummaryRequest{Point: tag("grpc.none")}); status.Code(err) != codes.NotFound {
+		t.Error("标签点不存在时期望NotFound", err)
+	}
+}
+
+// 订阅快照, 写入后推送DATA事件
+func TestServer_Subscribe(t *testing.T) {
+	client, conn := newTestClient(t, Options{})
+
+	table, err := conn.CreateTable("grpc", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	temp, err := conn.AddPoint(rtdb.NewPointInfo("temp", table.ID, rtdb.ValueTypeFloat64, rtdb.PointBase, rtdb.RtdbPrecisionMilli, "", ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	stream, err := client.Subscribe(ctx, &SubscribeRequest{Points: []*PointRef{tag("grpc.temp"), tag("grpc.none")}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	first, err := stream.Recv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first.GetType() != EventType_SUBSCRIBED || len(first.GetSnapshots()) != 1 || first.GetSnapshots()[0].GetTag() != "grpc.none" {
+		t.Fatalf("订阅结果错误 %v", first)
+	}
+
+	now := time.UnixMilli(1700000000000)
+	if err := conn.WriteValue(temp, false, rtdb.NewTvqFloat64(now, 21.5, rtdb.QualityGood)); err != nil {
+		t.Fatal(err)
+	}
+	data, err := stream.Recv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := data.GetSnapshots()
+	if data.GetType() != EventType_DATA || len(s) != 1 || s[0].GetId() != int32(temp.ID) || s[0].GetValue().GetFloatValue() != 21.5 || !s[0].GetValue().GetTime().AsTime().Equal(now) {
+		t.Fatalf("快照事件错误 %v", data)
+	}
+	cancel()
+	if _, err := stream.Recv(); status.Code(err) != codes.Canceled {
+		t.Error("取消后期望Canceled", err)
+	}
+
+	none, err := client.Subscribe(context.Background(), &SubscribeRequest{Points: []*PointRef{tag("grpc.none")}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := none.Recv(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := none.Recv(); status.Code(err) != codes.NotFound {
+		t.Error("没有可以订阅的标签点时期望NotFound", err)
+	}
+}
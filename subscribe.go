@@ -0,0 +1,674 @@
+package rtdb_api
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// SnapshotEvent 快照订阅事件, 字段含义与 rtdbs_snaps_event_ex64 回调的参数一致
+//   - RtdbEventData: IDs、Datetimes、Subtimes、Values、States、Qualities、Errors 为快照改变的标签点及其快照
+//   - RtdbEventChanged: IDs 为修改订阅的标签点, Datetimes 为修改类型(RtdbSubscribeChangeType),
+//     Values、States 为容差值, Errors 为修改结果
+//   - 其他事件的切片均为nil
+type SnapshotEvent struct {
+	Type      RtdbEventType
+	IDs       []PointID
+	Datetimes []TimestampType
+	Subtimes  []SubtimeType
+	Values    []float64
+	States    []int64
+	Qualities []Quality
+	Errors    []RtdbError
+}
+
+// SnapshotEventFunc 快照订阅的回调函数, 返回非RteOk时退出订阅
+//   - 回调在数据库API的线程中依次执行, 不能在回调中取消订阅或断开连接
+type SnapshotEventFunc func(handle ConnectHandle, event *SnapshotEvent) RtdbError
+
+// SubscribeOptions 快照订阅选项
+type SubscribeOptions struct {
+	AutoConn bool // 网络断开后自动重连并恢复订阅, 为false时网络断开后订阅结束
+}
+
+// SnapshotUpdate 快照订阅推送的事件
+type SnapshotUpdate struct {
+	Type     RtdbEventType     // 事件类型
+	Values   []PTVQ            // RtdbEventData: 快照改变的标签点及其快照
+	Errors   []error           // RtdbEventData: 与Values一一对应, 不为nil时快照无效
+	Changes  []SubscribeChange // RtdbEventChanged: 修改订阅的结果
+	Backfill bool              // RtdbEventData: 数值来自网络断开期间的历史存档, 而不是订阅事件
+}
+
+// SubscribeChange 修改订阅的结果
+type SubscribeChange struct {
+	ID         PointID                 // 标签点ID
+	Type       RtdbSubscribeChangeType // 修改类型
+	DeltaValue float64                 // 浮点数容差值
+	DeltaState int64                   // 整数容差值
+	Deadband   Deadband                // 修改后的容差, 由 Add、AddWithDeadbands 或 SetDeadbands 设置
+	Err        error                   // 修改结果
+}
+
+// SnapshotSubscription 快照订阅
+//   - 订阅使用单独登录的连接, 不影响原连接的其他调用
+//   - 事件处理函数在数据库API的线程(补推时为单独的goroutine)中依次执行, 应尽快返回, 不能在其中调用 Close
+//   - 整数与浮点数的快照来自订阅事件, 其他类型的快照在收到事件后于单独的goroutine中通过原连接读取, 期间收到的订阅事件在读取完成后依次推送
+//   - AutoConn为true时记录每个标签点最后推送的时间, 收到 RtdbEventRecovery 后先推送断开期间的历史存档(Backfill为true), 再继续推送订阅事件
+//   - 历史存档在单独的goroutine中通过订阅连接读取, 不依赖原连接, 期间收到的订阅事件在补推完成后依次推送
+type SnapshotSubscription struct {
+	parent  *RtdbConnect
+	conn    *RtdbConnect
+	opts    SubscribeOptions
+	handler func(SnapshotUpdate)
+
+	mu      sync.RWMutex
+	infos   map[PointID]*PointInfo
+	bands   map[PointID]Deadband  // 已经生效的容差
+	pending map[PointID]Deadband  // 通过 SetDeadbands 修改, 等待 RtdbEventChanged 确认的容差
+	last    map[PointID]time.Time // 每个标签点最后推送的时间
+	resync  map[PointID]bool      // 补推历史存档后, 不晚于last的订阅事件是重复的
+	closed  bool
+
+	// queueMu 保护异步推送状态, 补推或者读取快照期间收到的订阅事件缓存在queue中
+	queueMu sync.Mutex
+	async   bool
+	queue   []*SnapshotEvent
+	wg      sync.WaitGroup // 进行中的补推或者读取快照
+
+	done chan struct{}
+	once sync.Once
+	err  error
+}
+
+// SubscribeSnapshots 订阅标签点快照
+//
+// input:
+//   - infos 标签点列表, 个数不能超过 RtdbConstMaxSubscribeSnapshots
+//   - opts 订阅选项
+//   - handler 事件处理函数
+//
+// output:
+//   - *SnapshotSubscription 订阅, 使用完毕后需要调用 Close
+//   - []error 每个标签点的订阅结果, 订阅失败的标签点不会推送事件
+func (c *RtdbConnect) SubscribeSnapshots(infos []*PointInfo, opts SubscribeOptions, handler func(SnapshotUpdate)) (*SnapshotSubscription, []error, error) {
+	return c.subscribe(infos, nil, opts, handler)
+}
+
+// subscribe 订阅标签点快照, deadbands为nil时不使用容差
+func (c *RtdbConnect) subscribe(infos []*PointInfo, deadbands []Deadband, opts SubscribeOptions, handler func(SnapshotUpdate)) (*SnapshotSubscription, []error, error) {
+	if len(infos) == 0 {
+		return nil, nil, errors.New("订阅的标签点不能为空")
+	}
+	if len(infos) > int(RtdbConstMaxSubscribeSnapshots) {
+		return nil, nil, c.opError("SubscribeSnapshots", RteSubscribeGreaterMaxCount, 0)
+	}
+	conn, err := loginEndpoint(c.backend, c.Endpoint(), c.UserName, c.Password)
+	if err != nil {
+		return nil, nil, err
+	}
+	conn.metrics = c.getMetrics()
+
+	sub := &SnapshotSubscription{
+		parent:  c,
+		conn:    conn,
+		opts:    opts,
+		handler: handler,
+		infos:   make(map[PointID]*PointInfo, len(infos)),
+		bands:   make(map[PointID]Deadband, len(infos)),
+		pending: make(map[PointID]Deadband),
+		last:    make(map[PointID]time.Time, len(infos)),
+		resync:  make(map[PointID]bool),
+		done:    make(chan struct{}),
+	}
+	req := newDeltaRequest(infos, deadbands)
+	if len(req.ids) == 0 {
+		_ = conn.Logout()
+		return nil, nil, c.opError("SubscribeSnapshots", RteInvalidParameter, 0)
+	}
+	for j, id := range req.ids {
+		sub.infos[id] = infos[req.idx[j]]
+		sub.bands[id] = req.bands[j]
+	}
+	options := RtdbSubscribeOption(0)
+	if opts.AutoConn {
+		options = RtdbSubscribeOptionAutoConn
+	}
+	var rtes []RtdbError
+	var rte RtdbError
+	if deadbands == nil {
+		rtes, rte = c.backend.RawRtdbsSubscribeSnapshotsEx64Warp(conn.handle(), req.ids, options, sub.callback)
+	} else {
+		rtes, rte = c.backend.RawRtdbsSubscribeDeltaSnapshots64Warp(conn.handle(), req.ids, req.values, req.states, options, sub.callback)
+	}
+	if !RteIsOk(rte) {
+		_ = conn.Logout()
+		return nil, nil, c.opError("SubscribeSnapshots", rte, 0)
+	}
+	sub.mu.Lock()
+	for j, rte := range rtes {
+		if !RteIsOk(rte) {
+			sub.forget(req.ids[j])
+		}
+	}
+	sub.mu.Unlock()
+	return sub, c.opErrors("SubscribeSnapshots", pointIDs(infos), req.merge(rtes)), nil
+}
+
+// Points 当前订阅的标签点
+func (s *SnapshotSubscription) Points() []*PointInfo {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	infos := make([]*PointInfo, 0, len(s.infos))
+	for _, info := range s.infos {
+		infos = append(infos, info)
+	}
+	return infos
+}
+
+// count 当前订阅的标签点个数
+func (s *SnapshotSubscription) count() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.infos)
+}
+
+// Add 向订阅中添加标签点
+//
+// input:
+//   - infos 标签点列表, 添加后的个数不能超过 RtdbConstMaxSubscribeSnapshots
+//
+// output:
+//   - []error 每个标签点的添加结果, 已经订阅的标签点返回 RteAlreadySubscribe
+func (s *SnapshotSubscription) Add(infos []*PointInfo) ([]error, error) {
+	return s.AddWithDeadbands(infos, nil)
+}
+
+// AddWithDeadbands 按容差向订阅中添加标签点
+//
+// input:
+//   - infos 标签点列表, 添加后的个数不能超过 RtdbConstMaxSubscribeSnapshots
+//   - deadbands 与infos一一对应的容差, 为nil时容差为0
+//
+// output:
+//   - []error 每个标签点的添加结果, 已经订阅的标签点返回 RteAlreadySubscribe, 容差无效的标签点返回 RteInvalidParameter
+func (s *SnapshotSubscription) AddWithDeadbands(infos []*PointInfo, deadbands []Deadband) ([]error, error) {
+	if len(infos) == 0 {
+		return make([]error, 0), nil
+	}
+	req := newDeltaRequest(infos, deadbands)
+	s.mu.Lock()
+	// 先记录标签点, 以便识别添加后立即推送的快照
+	added := make(map[PointID]bool, len(req.ids))
+	for j, id := range req.ids {
+		if _, ok := s.infos[id]; !ok {
+			s.infos[id] = infos[req.idx[j]]
+			s.bands[id] = req.bands[j]
+			added[id] = true
+		}
+	}
+	if len(s.infos) > int(RtdbConstMaxSubscribeSnapshots) {
+		for id := range added {
+			s.forget(id)
+		}
+		s.mu.Unlock()
+		return nil, s.conn.opError("ChangeSubscribeSnapshots", RteSubscribeGreaterMaxCount, 0)
+	}
+	s.mu.Unlock()
+	if len(req.ids) == 0 {
+		return s.conn.opErrors("ChangeSubscribeSnapshots", pointIDs(infos), req.rtes), nil
+	}
+
+	rtes, rte := s.change(req.ids, RtdbSubscribeChangeTypeAdd, req.values, req.states)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !RteIsOk(rte) {
+		for id := range added {
+			s.forget(id)
+		}
+		return nil, s.conn.opError("ChangeSubscribeSnapshots", rte, 0)
+	}
+	for j, rte := range rtes {
+		if !RteIsOk(rte) && added[req.ids[j]] {
+			s.forget(req.ids[j])
+		}
+	}
+	return s.conn.opErrors("ChangeSubscribeSnapshots", pointIDs(infos), req.merge(rtes)), nil
+}
+
+// Remove 从订阅中删除标签点
+//
+// input:
+//   - ids 标签点ID列表
+//
+// output:
+//   - []error 每个标签点的删除结果, 没有订阅的标签点返回 RteNoSubscribe
+func (s *SnapshotSubscription) Remove(ids []PointID) ([]error, error) {
+	if len(ids) == 0 {
+		return make([]error, 0), nil
+	}
+	rtes, rte := s.change(ids, RtdbSubscribeChangeTypeRemove, make([]float64, len(ids)), make([]int64, len(ids)))
+	if !RteIsOk(rte) {
+		return nil, s.conn.opError("ChangeSubscribeSnapshots", rte, 0)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i, rte := range rtes {
+		if RteIsOk(rte) {
+			s.forget(ids[i])
+		}
+	}
+	return s.conn.opErrors("ChangeSubscribeSnapshots", ids, rtes), nil
+}
+
+// change 修改订阅
+func (s *SnapshotSubscription) change(ids []PointID, changeType RtdbSubscribeChangeType, deltaValues []float64, deltaStates []int64) ([]RtdbError, RtdbError) {
+	changedTypes := make([]RtdbSubscribeChangeType, len(ids))
+	for i := range changedTypes {
+		changedTypes[i] = changeType
+	}
+	return s.conn.backend.RawRtdbsChangeSubscribeSnapshotsWarp(s.conn.handle(), ids, deltaValues, deltaStates, changedTypes)
+}
+
+// Done 订阅结束时关闭
+func (s *SnapshotSubscription) Done() <-chan struct{} {
+	return s.done
+}
+
+// Err 订阅结束的原因, 调用 Close 结束时为nil
+func (s *SnapshotSubscription) Err() error {
+	<-s.done
+	return s.err
+}
+
+// Close 取消订阅并断开订阅连接
+func (s *SnapshotSubscription) Close() error {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return nil
+	}
+	s.closed = true
+	s.mu.Unlock()
+
+	rte := s.conn.backend.RawRtdbsCancelSubscribeSnapshotsWarp(s.conn.handle())
+	err := s.conn.opError("CancelSubscribeSnapshots", rte, 0)
+	if errors.Is(err, RteNoSubscribe) {
+		// 订阅已经因为网络断开等原因结束
+		err = nil
+	}
+	// 补推使用订阅连接, 等待结束后再登出
+	s.wg.Wait()
+	if logoutErr := s.conn.Logout(); err == nil {
+		err = logoutErr
+	}
+	s.finish(nil)
+	return err
+}
+
+// finish 结束订阅
+func (s *SnapshotSubscription) finish(err error) {
+	s.once.Do(func() {
+		s.err = err
+		close(s.done)
+	})
+}
+
+// isClosed 是否已经调用 Close
+func (s *SnapshotSubscription) isClosed() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.closed
+}
+
+// callback 订阅的回调函数, 异步推送期间缓存事件, 需要读取快照时在单独的goroutine中推送, 否则立即推送
+func (s *SnapshotSubscription) callback(handle ConnectHandle, event *SnapshotEvent) RtdbError {
+	if s.isClosed() {
+		return RteSubscribeCancelError
+	}
+
+	s.queueMu.Lock()
+	if s.async {
+		s.queue = append(s.queue, event)
+		s.queueMu.Unlock()
+		return RteOk
+	}
+	if event.Type == RtdbEventData && s.needsRead(event) {
+		// 回调中不能调用数据库API, 在单独的goroutine中读取快照
+		s.queue = append(s.queue, event)
+		started := s.startAsync(nil)
+		s.queueMu.Unlock()
+		if !started {
+			return RteSubscribeCancelError
+		}
+		return RteOk
+	}
+	s.queueMu.Unlock()
+	s.dispatch(event, true)
+
+	if event.Type == RtdbEventDisconnect && !s.opts.AutoConn {
+		s.finish(s.conn.opError("SubscribeSnapshots", RteNetError, 0))
+		return RteNetError
+	}
+	return RteOk
+}
+
+// dispatch 将原始事件转换成 SnapshotUpdate 并推送, 收到 RtdbEventRecovery 后开始补推
+//
+// output:
+//   - bool 是否开始了补推
+func (s *SnapshotSubscription) dispatch(event *SnapshotEvent, inCallback bool) bool {
+	update := SnapshotUpdate{Type: event.Type}
+	switch event.Type {
+	case RtdbEventData:
+		update.Values, update.Errors = s.values(event, !inCallback)
+	case RtdbEventChanged:
+		update.Changes = s.changes(event)
+	}
+	if s.handler != nil && (event.Type != RtdbEventData || len(update.Values) != 0) {
+		s.handler(update)
+	}
+	if event.Type != RtdbEventRecovery {
+		return false
+	}
+
+	// 回调中不能调用数据库API, 在单独的goroutine中补推
+	s.queueMu.Lock()
+	defer s.queueMu.Unlock()
+	return s.startAsync(s.backfill)
+}
+
+// startAsync 在单独的goroutine中执行fn, 再依次推送缓存的事件, 调用时需要持有queueMu
+//
+// output:
+//   - bool 是否启动, 已经调用 Close 时不启动
+func (s *SnapshotSubscription) startAsync(fn func()) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return false
+	}
+	s.wg.Add(1)
+	s.async = true
+	go func() {
+		defer s.wg.Done()
+		if fn != nil {
+			fn()
+		}
+		s.drain()
+	}()
+	return true
+}
+
+// needsRead 事件中是否有需要通过原连接读取快照的标签点
+func (s *SnapshotSubscription) needsRead(event *SnapshotEvent) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for i, id := range event.IDs {
+		info, ok := s.infos[id]
+		if !ok || !RteIsOk(event.Errors[i]) {
+			continue
+		}
+		if rtdbType, _ := info.ValueType.ToRawType(); !isNumberType(rtdbType) {
+			return true
+		}
+	}
+	return false
+}
+
+// drain 在单独的goroutine中依次推送缓存的事件, 再次收到 RtdbEventRecovery 时剩余的事件等待下一次补推完成
+func (s *SnapshotSubscription) drain() {
+	for {
+		s.queueMu.Lock()
+		if len(s.queue) == 0 || s.isClosed() {
+			s.async, s.queue = false, nil
+			s.queueMu.Unlock()
+			return
+		}
+		event := s.queue[0]
+		s.queue = s.queue[1:]
+		s.queueMu.Unlock()
+		if s.dispatch(event, false) {
+			return
+		}
+	}
+}
+
+// values 转换快照改变事件
+//   - read为true时通过原连接读取其他类型的快照, 只能在单独的goroutine中使用
+//   - read为false时(订阅回调中)不读取, 其他类型的标签点返回错误, 只有 needsRead 之后才添加订阅的标签点会出现这种情况
+func (s *SnapshotSubscription) values(event *SnapshotEvent, read bool) ([]PTVQ, []error) {
+	ptvqs := make([]PTVQ, 0, len(event.IDs))
+	ids := make([]PointID, 0, len(event.IDs))
+	rtes := make([]RtdbError, 0, len(event.IDs))
+	others := make([]int, 0)
+	s.mu.Lock()
+	for i, id := range event.IDs {
+		info, ok := s.infos[id]
+		if !ok {
+			continue
+		}
+		rtdbType, _ := info.ValueType.ToRawType()
+		ts := RtdbTimestampToGoTime(event.Datetimes[i], event.Subtimes[i], info.Precision)
+		if s.resync[id] && !ts.After(s.last[id]) {
+			continue
+		}
+		delete(s.resync, id)
+		if RteIsOk(event.Errors[i]) {
+			s.last[id] = ts
+		}
+		tvq := TVQ{Timestamp: ts, Type: info.ValueType, Quality: event.Qualities[i]}
+		if isNumberType(rtdbType) {
+			tvq = newNumberTvq(rtdbType, ts, event.Values[i], event.States[i], event.Qualities[i])
+		} else if RteIsOk(event.Errors[i]) {
+			others = append(others, len(ptvqs))
+		}
+		ptvqs = append(ptvqs, NewPTVQ(info, tvq))
+		ids = append(ids, id)
+		rtes = append(rtes, event.Errors[i])
+	}
+	s.mu.Unlock()
+	errs := s.parent.opErrors("SubscribeSnapshots", ids, rtes)
+
+	if len(others) != 0 && !read {
+		for _, idx := range others {
+			errs[idx] = errors.New("订阅回调中不能读取快照")
+		}
+		return ptvqs, errs
+	}
+	if len(others) != 0 {
+		infos := make([]*PointInfo, len(others))
+		for i, idx := range others {
+			infos[i] = ptvqs[idx].PointInfo
+		}
+		tvqs, readErrs, err := s.parent.ReadSnapshots(infos)
+		for i, idx := range others {
+			switch {
+			case err != nil:
+				errs[idx] = err
+			case readErrs[i] != nil:
+				errs[idx] = readErrs[i]
+			default:
+				ptvqs[idx].TVQ = tvqs[i]
+			}
+		}
+	}
+	return ptvqs, errs
+}
+
+// changes 转换修改订阅事件, 并同步订阅的标签点
+func (s *SnapshotSubscription) changes(event *SnapshotEvent) []SubscribeChange {
+	changes := make([]SubscribeChange, len(event.IDs))
+	errs := s.parent.opErrors("ChangeSubscribeSnapshots", event.IDs, event.Errors)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i, id := range event.IDs {
+		changes[i] = SubscribeChange{
+			ID:         id,
+			Type:       RtdbSubscribeChangeType(event.Datetimes[i]),
+			DeltaValue: event.Values[i],
+			DeltaState: event.States[i],
+			Deadband:   s.bands[id],
+			Err:        errs[i],
+		}
+		if changes[i].Type == RtdbSubscribeChangeTypeUpdate {
+			if band, ok := s.pending[id]; ok {
+				// 确认 SetDeadbands 的修改, 失败时保留原来的容差
+				changes[i].Deadband = band
+				delete(s.pending, id)
+				if errs[i] == nil {
+					s.bands[id] = band
+				}
+			}
+		}
+		if errs[i] == nil && changes[i].Type == RtdbSubscribeChangeTypeRemove {
+			s.forget(id)
+		}
+	}
+	return changes
+}
+
+// forget 删除订阅的标签点, 调用方需要持有锁
+func (s *SnapshotSubscription) forget(id PointID) {
+	delete(s.infos, id)
+	delete(s.bands, id)
+	delete(s.pending, id)
+	delete(s.last, id)
+	delete(s.resync, id)
+}
+
+// backfill 网络恢复后推送断开期间的历史存档
+//   - 只补推已经推送过数值的标签点, 范围为最后推送的时间之后到当前快照
+//   - 通过已经恢复的订阅连接读取, 原连接断开时也可以补推
+//   - 每个标签点每 ArchivedValuesPageSize 个数值推送一次, 读取失败时推送一个带错误的数值
+func (s *SnapshotSubscription) backfill() {
+	s.mu.RLock()
+	infos := make([]*PointInfo, 0, len(s.last))
+	starts := make([]time.Time, 0, len(s.last))
+	for id, last := range s.last {
+		infos = append(infos, s.infos[id])
+		starts = append(starts, last)
+	}
+	s.mu.RUnlock()
+	if len(infos) == 0 {
+		return
+	}
+
+	snapshots, snapshotErrs, err := s.conn.ReadSnapshots(infos)
+	for i, info := range infos {
+		switch {
+		case s.isClosed():
+			return
+		case err != nil:
+			s.emitBackfill(info, nil, err)
+			continue
+		case snapshotErrs[i] != nil:
+			s.emitBackfill(info, nil, snapshotErrs[i])
+			continue
+		case !snapshots[i].Timestamp.After(starts[i]):
+			continue
+		}
+		end := snapshots[i].Timestamp
+		tvqs := make([]TVQ, 0)
+		last := starts[i]
+		for tvq, err := range s.conn.ArchivedValues(info, starts[i].Add(time.Nanosecond), end) {
+			if err != nil {
+				s.emitBackfill(info, tvqs, err)
+				tvqs = nil
+				break
+			}
+			// 相同时间戳的多个数值都要推送, 只跳过已经推送过的时间
+			if !tvq.Timestamp.After(starts[i]) {
+				continue
+			}
+			last = tvq.Timestamp
+			if tvqs = append(tvqs, tvq); len(tvqs) == ArchivedValuesPageSize {
+				s.emitBackfill(info, tvqs, nil)
+				tvqs = make([]TVQ, 0)
+			}
+		}
+		if tvqs == nil {
+			continue
+		}
+		// 快照可能还没有写入历史存档
+		if last.Before(end) {
+			tvqs = append(tvqs, snapshots[i])
+		}
+		s.emitBackfill(info, tvqs, nil)
+	}
+}
+
+// emitBackfill 推送一个标签点补推的历史存档, 并更新最后推送的时间
+func (s *SnapshotSubscription) emitBackfill(info *PointInfo, tvqs []TVQ, err error) {
+	update := SnapshotUpdate{Type: RtdbEventData, Backfill: true}
+	for _, tvq := range tvqs {
+		update.Values = append(update.Values, NewPTVQ(info, tvq))
+		update.Errors = append(update.Errors, nil)
+	}
+	if err != nil {
+		update.Values = append(update.Values, NewPTVQ(info, TVQ{Type: info.ValueType}))
+		update.Errors = append(update.Errors, err)
+	}
+	if len(update.Values) == 0 {
+		return
+	}
+	if len(tvqs) != 0 {
+		s.mu.Lock()
+		if _, ok := s.infos[info.ID]; ok {
+			s.last[info.ID] = tvqs[len(tvqs)-1].Timestamp
+			s.resync[info.ID] = true
+		}
+		s.mu.Unlock()
+	}
+	if s.handler != nil && !s.isClosed() {
+		s.handler(update)
+	}
+}
+
+// backfillMark 标签点的补推状态, 在订阅之间迁移标签点时保留
+type backfillMark struct {
+	last   time.Time // 最后推送的时间
+	resync bool      // 不晚于last的订阅事件是重复的
+}
+
+// marks 读取标签点的补推状态, 没有推送过数值的标签点不返回
+func (s *SnapshotSubscription) marks(ids []PointID) map[PointID]backfillMark {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	marks := make(map[PointID]backfillMark, len(ids))
+	for _, id := range ids {
+		if last, ok := s.last[id]; ok {
+			marks[id] = backfillMark{last: last, resync: s.resync[id]}
+		}
+	}
+	return marks
+}
+
+// adoptMarks 合并从其他订阅迁移的补推状态, 只保留较晚的时间, 不在订阅中的标签点被忽略
+func (s *SnapshotSubscription) adoptMarks(marks map[PointID]backfillMark) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for id, mark := range marks {
+		if _, ok := s.infos[id]; !ok {
+			continue
+		}
+		if last, ok := s.last[id]; ok && !mark.last.After(last) {
+			continue
+		}
+		s.last[id] = mark.last
+		if mark.resync {
+			s.resync[id] = true
+		} else {
+			delete(s.resync, id)
+		}
+	}
+}
+
+// isNumberType 是否为整数或浮点数类型, 这些类型的快照可以通过 RawRtdbsGetSnapshots64Warp 读取
+func isNumberType(rtdbType RtdbType) bool {
+	switch rtdbType {
+	case RtdbTypeCoor, RtdbTypeString, RtdbTypeBlob, RtdbTypeDatetime, RtdbTypeNamedT:
+		return false
+	}
+	return true
+}
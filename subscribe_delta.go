@@ -0,0 +1,217 @@
+package rtdb_api
+
+import (
+	"math"
+)
+
+// Deadband 标签点的容差, 快照相对上一次推送的变化超过容差时才推送
+//   - 浮点数类型的标签点使用 deltaValues, 其他数值类型使用 deltaStates(向下取整)
+//   - 字符串、坐标、BLOB等类型的标签点容差只能为0
+type Deadband struct {
+	Value   float64 // 容差值, 为0时快照改变即推送
+	Percent bool    // 为true时 Value 为量程(HighLimit-LowLimit)的百分比
+}
+
+// AbsDeadband 绝对值容差
+func AbsDeadband(value float64) Deadband {
+	return Deadband{Value: value}
+}
+
+// PercentDeadband 量程百分比容差, 例如量程为0~200时 PercentDeadband(1) 相当于 AbsDeadband(2)
+func PercentDeadband(percent float64) Deadband {
+	return Deadband{Value: percent, Percent: true}
+}
+
+// Abs 按标签点的量程换算成绝对值容差
+//
+// input:
+//   - info 标签点信息
+//
+// output:
+//   - float64 绝对值容差
+//   - bool 容差是否有效, 容差为负数或者百分比容差的量程不大于0时无效
+func (d Deadband) Abs(info *PointInfo) (float64, bool) {
+	if d.Value < 0 || math.IsNaN(d.Value) || math.IsInf(d.Value, 0) {
+		return 0, false
+	}
+	if !d.Percent {
+		return d.Value, true
+	}
+	span := float64(info.HighLimit) - float64(info.LowLimit)
+	if span <= 0 {
+		return 0, false
+	}
+	return span * d.Value / 100, true
+}
+
+// deltas 转换成数据库的容差值
+func (d Deadband) deltas(info *PointInfo) (float64, int64, bool) {
+	abs, ok := d.Abs(info)
+	if !ok {
+		return 0, 0, false
+	}
+	rtdbType, _ := info.ValueType.ToRawType()
+	switch {
+	case abs == 0:
+		return 0, 0, true
+	case !isNumberType(rtdbType):
+		return 0, 0, false
+	case isFloatType(rtdbType):
+		return abs, 0, true
+	default:
+		return 0, int64(math.Floor(abs)), true
+	}
+}
+
+// isFloatType 是否为浮点数类型
+func isFloatType(rtdbType RtdbType) bool {
+	switch rtdbType {
+	case RtdbTypeReal16, RtdbTypeReal32, RtdbTypeReal64, RtdbTypeFp16, RtdbTypeFp32, RtdbTypeFp64:
+		return true
+	}
+	return false
+}
+
+// deltaRequest 按容差订阅或修改订阅的参数, 只包含容差有效的标签点
+type deltaRequest struct {
+	idx    []int // 在原标签点列表中的序号
+	ids    []PointID
+	values []float64
+	states []int64
+	bands  []Deadband
+	rtes   []RtdbError // 与原标签点列表一一对应, 容差无效的标签点为 RteInvalidParameter
+}
+
+// newDeltaRequest 换算每个标签点的容差, deadbands为nil时容差均为0
+func newDeltaRequest(infos []*PointInfo, deadbands []Deadband) *deltaRequest {
+	req := &deltaRequest{rtes: make([]RtdbError, len(infos))}
+	for i, info := range infos {
+		band := Deadband{}
+		if deadbands != nil {
+			band = deadbands[i]
+		}
+		value, state, ok := band.deltas(info)
+		if !ok {
+			req.rtes[i] = RteInvalidParameter
+			continue
+		}
+		req.add(i, info.ID, value, state, band)
+	}
+	return req
+}
+
+// add 添加容差有效的标签点
+func (req *deltaRequest) add(i int, id PointID, value float64, state int64, band Deadband) {
+	req.idx = append(req.idx, i)
+	req.ids = append(req.ids, id)
+	req.values = append(req.values, value)
+	req.states = append(req.states, state)
+	req.bands = append(req.bands, band)
+}
+
+// merge 将容差有效的标签点的结果合并到原标签点列表中
+func (req *deltaRequest) merge(rtes []RtdbError) []RtdbError {
+	for j, rte := range rtes {
+		req.rtes[req.idx[j]] = rte
+	}
+	return req.rtes
+}
+
+// pointIDs 标签点ID列表
+func pointIDs(infos []*PointInfo) []PointID {
+	ids := make([]PointID, len(infos))
+	for i, info := range infos {
+		ids[i] = info.ID
+	}
+	return ids
+}
+
+// SubscribeDeltaSnapshots 按容差订阅标签点快照, 快照变化超过容差时才推送
+//
+// input:
+//   - infos 标签点列表, 个数不能超过 RtdbConstMaxSubscribeSnapshots
+//   - deadbands 与infos一一对应的容差
+//   - opts 订阅选项
+//   - handler 事件处理函数
+//
+// output:
+//   - *SnapshotSubscription 订阅, 使用完毕后需要调用 Close
+//   - []error 每个标签点的订阅结果, 容差无效的标签点返回 RteInvalidParameter
+func (c *RtdbConnect) SubscribeDeltaSnapshots(infos []*PointInfo, deadbands []Deadband, opts SubscribeOptions, handler func(SnapshotUpdate)) (*SnapshotSubscription, []error, error) {
+	if len(deadbands) != len(infos) {
+		return nil, nil, c.opError("SubscribeSnapshots", RteInvalidParameter, 0)
+	}
+	return c.subscribe(infos, deadbands, opts, handler)
+}
+
+// Deadband 标签点当前生效的容差
+//
+// input:
+//   - id 标签点ID
+//
+// output:
+//   - Deadband 容差, SetDeadbands 的修改在收到 RtdbEventChanged 确认后生效
+//   - bool 标签点是否已经订阅
+func (s *SnapshotSubscription) Deadband(id PointID) (Deadband, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	band, ok := s.bands[id]
+	return band, ok
+}
+
+// SetDeadbands 修改已经订阅的标签点的容差
+//   - 修改结果通过 RtdbEventChanged 事件逐个标签点推送(SubscribeChange.Deadband 为修改后的容差), 确认成功后 Deadband 返回新的容差
+//
+// input:
+//   - ids 标签点ID列表
+//   - deadbands 与ids一一对应的容差
+//
+// output:
+//   - []error 每个标签点的提交结果, 没有订阅的标签点返回 RteNoSubscribe, 容差无效的标签点返回 RteInvalidParameter
+func (s *SnapshotSubscription) SetDeadbands(ids []PointID, deadbands []Deadband) ([]error, error) {
+	if len(deadbands) != len(ids) {
+		return nil, s.conn.opError("ChangeSubscribeSnapshots", RteInvalidParameter, 0)
+	}
+	if len(ids) == 0 {
+		return make([]error, 0), nil
+	}
+	req := &deltaRequest{rtes: make([]RtdbError, len(ids))}
+	s.mu.Lock()
+	for i, id := range ids {
+		info, ok := s.infos[id]
+		if !ok {
+			req.rtes[i] = RteNoSubscribe
+			continue
+		}
+		value, state, ok := deadbands[i].deltas(info)
+		if !ok {
+			req.rtes[i] = RteInvalidParameter
+			continue
+		}
+		req.add(i, id, value, state, deadbands[i])
+		// 先记录修改, 以便识别修改后立即推送的 RtdbEventChanged
+		s.pending[id] = deadbands[i]
+	}
+	s.mu.Unlock()
+	if len(req.ids) == 0 {
+		return s.conn.opErrors("ChangeSubscribeSnapshots", ids, req.rtes), nil
+	}
+
+	rtes, rte := s.change(req.ids, RtdbSubscribeChangeTypeUpdate, req.values, req.states)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !RteIsOk(rte) {
+		for j, id := range req.ids {
+			if s.pending[id] == req.bands[j] {
+				delete(s.pending, id)
+			}
+		}
+		return nil, s.conn.opError("ChangeSubscribeSnapshots", rte, 0)
+	}
+	for j, rte := range rtes {
+		if !RteIsOk(rte) {
+			delete(s.pending, req.ids[j])
+		}
+	}
+	return s.conn.opErrors("ChangeSubscribeSnapshots", ids, req.merge(rtes)), nil
+}
@@ -0,0 +1,138 @@
+package rtdb_api
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// 容差换算
+func TestDeadband(t *testing.T) {
+	info := NewPointInfo("temp", 1, ValueTypeFloat64, PointBase, RtdbPrecisionMilli, "", "")
+	info.SetLimit(-50, 150, 0)
+	if abs, ok := PercentDeadband(1).Abs(info); !ok || abs != 2 {
+		t.Error("百分比容差换算错误", abs, ok)
+	}
+	if value, state, ok := AbsDeadband(0.5).deltas(info); !ok || value != 0.5 || state != 0 {
+		t.Error("浮点数容差错误", value, state, ok)
+	}
+	count := NewPointInfo("count", 1, ValueTypeInt32, PointBase, RtdbPrecisionMilli, "", "")
+	if value, state, ok := AbsDeadband(2.5).deltas(count); !ok || value != 0 || state != 2 {
+		t.Error("整数容差错误", value, state, ok)
+	}
+	name := NewPointInfo("name", 1, ValueTypeString, PointBase, RtdbPrecisionMilli, "", "")
+	if _, _, ok := AbsDeadband(1).deltas(name); ok {
+		t.Error("字符串容差期望无效")
+	}
+	info.SetLimit(10, 10, 0)
+	if _, ok := PercentDeadband(1).Abs(info); ok {
+		t.Error("量程为0时百分比容差期望无效")
+	}
+	if _, ok := AbsDeadband(-1).Abs(info); ok {
+		t.Error("负数容差期望无效")
+	}
+}
+
+// 通过内存后端按容差订阅快照
+func TestSubscribeDeltaSnapshots(t *testing.T) {
+	conn, err := LoginWithBackend(NewMemoryBackend(), "127.0.0.1", Port, Username, Password)
+	if err != nil {
+		t.Fatal("登录用户失败", err)
+	}
+	defer func() { _ = conn.Logout() }()
+
+	table, err := conn.CreateTable("delta", "容差订阅")
+	if err != nil {
+		t.Fatal(err)
+	}
+	base := NewPointInfo("temp", table.ID, ValueTypeFloat64, PointBase, RtdbPrecisionMilli, "", "")
+	base.SetLimit(0, 200, 0)
+	temp, err := conn.AddPoint(base)
+	if err != nil {
+		t.Fatal(err)
+	}
+	count, err := conn.AddPoint(NewPointInfo("count", table.ID, ValueTypeInt32, PointBase, RtdbPrecisionMilli, "", ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	name, err := conn.AddPoint(NewPointInfo("name", table.ID, ValueTypeString, PointBase, RtdbPrecisionMilli, "", ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	updates := make(chan SnapshotUpdate, 16)
+	sub, errs, err := conn.SubscribeDeltaSnapshots([]*PointInfo{temp, count, name},
+		[]Deadband{PercentDeadband(1), AbsDeadband(5), AbsDeadband(1)}, SubscribeOptions{}, func(u SnapshotUpdate) { updates <- u })
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = sub.Close() }()
+	if errs[0] != nil || errs[1] != nil || !errors.Is(errs[2], RteInvalidParameter) || len(sub.Points()) != 2 {
+		t.Fatal("订阅结果错误", errs)
+	}
+	if band, ok := sub.Deadband(temp.ID); !ok || band != PercentDeadband(1) {
+		t.Error("容差错误", band, ok)
+	}
+	next := func() SnapshotUpdate {
+		t.Helper()
+		select {
+		case u := <-updates:
+			return u
+		case <-time.After(5 * time.Second):
+			t.Fatal("等待订阅事件超时")
+			return SnapshotUpdate{}
+		}
+	}
+
+	// temp 的容差为2, count 的容差为5, 变化不超过容差的快照不推送
+	now := time.UnixMilli(1700000000000)
+	write := func(i int, f float64, n int32) {
+		t.Helper()
+		ts := now.Add(time.Duration(i) * time.Second)
+		if _, err := conn.WriteSection(false, []PTVQ{NewPTVQ(temp, NewTvqFloat64(ts, f, QualityGood)), NewPTVQ(count, NewTvqInt32(ts, n, QualityGood))}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write(0, 10, 10)
+	if u := next(); len(u.Values) != 2 {
+		t.Fatalf("首个快照事件错误 %+v", u)
+	}
+	write(1, 11.5, 14)
+	write(2, 12.5, 15)
+	u := next()
+	if len(u.Values) != 1 || u.Values[0].PointInfo.ID != temp.ID || u.Values[0].TVQ.Value.FloatValue != 12.5 {
+		t.Fatalf("容差过滤错误 %+v", u)
+	}
+
+	// 修改容差, 通过 RtdbEventChanged 确认
+	errs, err = sub.SetDeadbands([]PointID{count.ID, temp.ID, name.ID}, []Deadband{AbsDeadband(0), AbsDeadband(-1), AbsDeadband(0)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if errs[0] != nil || !errors.Is(errs[1], RteInvalidParameter) || !errors.Is(errs[2], RteNoSubscribe) {
+		t.Fatal("修改容差结果错误", errs)
+	}
+	u = next()
+	if u.Type != RtdbEventChanged || len(u.Changes) != 1 || u.Changes[0].ID != count.ID || u.Changes[0].Err != nil ||
+		u.Changes[0].Type != RtdbSubscribeChangeTypeUpdate || u.Changes[0].Deadband != AbsDeadband(0) {
+		t.Fatalf("修改容差事件错误 %+v", u)
+	}
+	if band, _ := sub.Deadband(count.ID); band != AbsDeadband(0) {
+		t.Error("修改后的容差错误", band)
+	}
+	if band, _ := sub.Deadband(temp.ID); band != PercentDeadband(1) {
+		t.Error("修改失败时期望保留原来的容差", band)
+	}
+	write(3, 13, 17)
+	if u = next(); len(u.Values) != 1 || u.Values[0].PointInfo.ID != count.ID || u.Values[0].TVQ.Value.IntValue != 17 {
+		t.Fatalf("修改容差后的快照事件错误 %+v", u)
+	}
+
+	errs, err = sub.AddWithDeadbands([]*PointInfo{name}, []Deadband{AbsDeadband(0)})
+	if err != nil || errs[0] != nil {
+		t.Fatal("添加订阅失败", err, errs)
+	}
+	if u = next(); u.Type != RtdbEventChanged || u.Changes[0].Type != RtdbSubscribeChangeTypeAdd || u.Changes[0].Deadband != AbsDeadband(0) {
+		t.Fatalf("添加订阅事件错误 %+v", u)
+	}
+}
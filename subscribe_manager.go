@@ -0,0 +1,354 @@
+package rtdb_api
+
+import (
+	"errors"
+	"sync"
+)
+
+// SubscriptionManagerOptions 快照订阅管理器选项
+type SubscriptionManagerOptions struct {
+	SubscribeOptions
+
+	// ShardSize 每个订阅连接的标签点个数, 为0或超过 RtdbConstMaxSubscribeSnapshots 时为 RtdbConstMaxSubscribeSnapshots
+	ShardSize int
+
+	// MaxConns 最多使用的订阅连接个数, 为0时不限制
+	MaxConns int
+}
+
+// SubscriptionManager 快照订阅管理器, 订阅任意个数的标签点
+//   - 标签点按 ShardSize 分布到多个 SnapshotSubscription(每个使用单独登录的连接)
+//   - 各个连接的事件依次调用同一个事件处理函数, 不会并发调用
+//   - 删除标签点后如果可以使用更少的连接, 会把标签点迁移到其他连接并关闭多余的连接, 迁移期间可能收到重复的快照,
+//     标签点最后推送的时间随标签点迁移, 网络恢复后仍然补推断开期间的历史存档
+//   - 修改订阅的结果由 Add 与 Remove 直接返回, 不推送 RtdbEventChanged 事件
+//   - 任意一个连接的订阅因为网络断开结束时(AutoConn为false), 关闭全部订阅
+type SubscriptionManager struct {
+	parent  *RtdbConnect
+	opts    SubscriptionManagerOptions
+	handler func(SnapshotUpdate)
+	hmu     sync.Mutex
+
+	mu     sync.Mutex
+	shards []*SnapshotSubscription
+	points map[PointID]*SnapshotSubscription
+	closed bool
+
+	done chan struct{}
+	once sync.Once
+	err  error
+}
+
+// NewSubscriptionManager 创建快照订阅管理器, 通过 Add 添加标签点
+//
+// input:
+//   - opts 订阅选项
+//   - handler 事件处理函数
+//
+// output:
+//   - *SubscriptionManager 订阅管理器, 使用完毕后需要调用 Close
+func (c *RtdbConnect) NewSubscriptionManager(opts SubscriptionManagerOptions, handler func(SnapshotUpdate)) *SubscriptionManager {
+	if opts.ShardSize <= 0 || opts.ShardSize > int(RtdbConstMaxSubscribeSnapshots) {
+		opts.ShardSize = int(RtdbConstMaxSubscribeSnapshots)
+	}
+	return &SubscriptionManager{
+		parent:  c,
+		opts:    opts,
+		handler: handler,
+		points:  make(map[PointID]*SnapshotSubscription),
+		done:    make(chan struct{}),
+	}
+}
+
+// Points 当前订阅的标签点
+func (m *SubscriptionManager) Points() []*PointInfo {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	infos := make([]*PointInfo, 0, len(m.points))
+	for _, sub := range m.shards {
+		infos = append(infos, sub.Points()...)
+	}
+	return infos
+}
+
+// Shards 每个订阅连接的标签点个数
+func (m *SubscriptionManager) Shards() []int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	counts := make([]int, len(m.shards))
+	for i, sub := range m.shards {
+		counts[i] = sub.count()
+	}
+	return counts
+}
+
+// Add 添加标签点, 先填满已有的连接, 再登录新的连接
+//
+// input:
+//   - infos 标签点列表
+//
+// output:
+//   - []error 每个标签点的订阅结果, 已经订阅的标签点返回 RteAlreadySubscribe, 超过 MaxConns 时返回 RteSubscribeGreaterMaxCount
+func (m *SubscriptionManager) Add(infos []*PointInfo) ([]error, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.closed {
+		return nil, errors.New("订阅已经关闭")
+	}
+	errs := make([]error, len(infos))
+	rest := make([]int, 0, len(infos))
+	seen := make(map[PointID]bool, len(infos))
+	for i, info := range infos {
+		if _, ok := m.points[info.ID]; ok || seen[info.ID] {
+			errs[i] = m.parent.opError("ChangeSubscribeSnapshots", RteAlreadySubscribe, info.ID)
+			continue
+		}
+		seen[info.ID] = true
+		rest = append(rest, i)
+	}
+
+	for _, sub := range m.shards {
+		free := m.opts.ShardSize - sub.count()
+		if len(rest) == 0 || free <= 0 {
+			continue
+		}
+		batch := rest[:min(free, len(rest))]
+		rest = rest[len(batch):]
+		addErrs, err := sub.Add(pickInfos(infos, batch))
+		m.assign(sub, infos, batch, errs, addErrs, err)
+	}
+	for len(rest) != 0 {
+		if m.opts.MaxConns > 0 && len(m.shards) >= m.opts.MaxConns {
+			for _, i := range rest {
+				errs[i] = m.parent.opError("SubscribeSnapshots", RteSubscribeGreaterMaxCount, infos[i].ID)
+			}
+			break
+		}
+		batch := rest[:min(m.opts.ShardSize, len(rest))]
+		rest = rest[len(batch):]
+		sub, subErrs, err := m.parent.SubscribeSnapshots(pickInfos(infos, batch), m.opts.SubscribeOptions, m.deliver)
+		if m.assign(sub, infos, batch, errs, subErrs, err) == 0 {
+			if sub != nil {
+				_ = sub.Close()
+			}
+			continue
+		}
+		m.shards = append(m.shards, sub)
+		go m.watch(sub)
+	}
+	return errs, nil
+}
+
+// assign 记录添加到sub的标签点, 返回成功的个数
+func (m *SubscriptionManager) assign(sub *SnapshotSubscription, infos []*PointInfo, batch []int, errs, results []error, err error) int {
+	count := 0
+	for j, i := range batch {
+		switch {
+		case err != nil:
+			errs[i] = err
+		case results[j] != nil:
+			errs[i] = results[j]
+		default:
+			m.points[infos[i].ID] = sub
+			count++
+		}
+	}
+	return count
+}
+
+// Remove 删除标签点, 删除后重新分布标签点并关闭多余的连接
+//
+// input:
+//   - ids 标签点ID列表
+//
+// output:
+//   - []error 每个标签点的删除结果, 没有订阅的标签点返回 RteNoSubscribe
+func (m *SubscriptionManager) Remove(ids []PointID) ([]error, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.closed {
+		return nil, errors.New("订阅已经关闭")
+	}
+	errs := make([]error, len(ids))
+	groups := make(map[*SnapshotSubscription][]int)
+	for i, id := range ids {
+		sub, ok := m.points[id]
+		if !ok {
+			errs[i] = m.parent.opError("ChangeSubscribeSnapshots", RteNoSubscribe, id)
+			continue
+		}
+		groups[sub] = append(groups[sub], i)
+		delete(m.points, id)
+	}
+
+	for sub, idx := range groups {
+		if len(idx) >= sub.count() {
+			// 删除连接中全部的标签点时直接关闭连接
+			_ = sub.Close()
+			m.drop(sub)
+			continue
+		}
+		subIDs := make([]PointID, len(idx))
+		for j, i := range idx {
+			subIDs[j] = ids[i]
+		}
+		removeErrs, err := sub.Remove(subIDs)
+		for j, i := range idx {
+			switch {
+			case err != nil:
+				errs[i] = err
+			case removeErrs[j] != nil:
+				errs[i] = removeErrs[j]
+			}
+			if errs[i] != nil {
+				m.points[ids[i]] = sub
+			}
+		}
+	}
+	m.rebalance()
+	return errs, nil
+}
+
+// rebalance 标签点可以使用更少的连接时, 把标签点最少的连接中的标签点迁移到其他连接并关闭该连接
+//   - 先添加到新的连接再从原连接删除, 迁移失败时停止
+//   - 添加后以及从原连接删除前各复制一次补推状态, 包含迁移期间原连接推送的数值
+func (m *SubscriptionManager) rebalance() {
+	for {
+		need := (len(m.points) + m.opts.ShardSize - 1) / m.opts.ShardSize
+		if len(m.shards) <= need {
+			return
+		}
+		src := m.shards[0]
+		for _, sub := range m.shards[1:] {
+			if sub.count() < src.count() {
+				src = sub
+			}
+		}
+		infos := src.Points()
+		marks := src.marks(pointIDs(infos))
+		moved := make([]PointID, 0, len(infos))
+		for _, dst := range m.shards {
+			free := m.opts.ShardSize - dst.count()
+			if dst == src || free <= 0 {
+				continue
+			}
+			if len(moved) == len(infos) {
+				break
+			}
+			batch := infos[len(moved):min(len(moved)+free, len(infos))]
+			addErrs, err := dst.Add(batch)
+			ok := err == nil
+			for j, e := range addErrs {
+				if e != nil {
+					ok = false
+					continue
+				}
+				m.points[batch[j].ID] = dst
+				moved = append(moved, batch[j].ID)
+			}
+			dst.adoptMarks(marks)
+			if !ok {
+				break
+			}
+		}
+		marks = src.marks(moved)
+		for _, dst := range m.shards {
+			if dst != src {
+				dst.adoptMarks(marks)
+			}
+		}
+		if len(moved) != len(infos) {
+			_, _ = src.Remove(moved)
+			return
+		}
+		_ = src.Close()
+		m.drop(src)
+	}
+}
+
+// drop 从连接列表中删除sub
+func (m *SubscriptionManager) drop(sub *SnapshotSubscription) {
+	for i, s := range m.shards {
+		if s == sub {
+			m.shards = append(m.shards[:i], m.shards[i+1:]...)
+			return
+		}
+	}
+}
+
+// deliver 依次调用事件处理函数, 合并各个连接的事件
+func (m *SubscriptionManager) deliver(u SnapshotUpdate) {
+	if u.Type == RtdbEventChanged || m.handler == nil {
+		return
+	}
+	m.hmu.Lock()
+	defer m.hmu.Unlock()
+	m.handler(u)
+}
+
+// watch 连接的订阅因为网络断开结束时关闭全部订阅
+func (m *SubscriptionManager) watch(sub *SnapshotSubscription) {
+	err := sub.Err()
+	if err == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.closed {
+		return
+	}
+	m.closeAll()
+	m.finish(err)
+}
+
+// Done 订阅结束时关闭
+func (m *SubscriptionManager) Done() <-chan struct{} {
+	return m.done
+}
+
+// Err 订阅结束的原因, 调用 Close 结束时为nil
+func (m *SubscriptionManager) Err() error {
+	<-m.done
+	return m.err
+}
+
+// Close 取消全部订阅并断开订阅连接
+func (m *SubscriptionManager) Close() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.closed {
+		return nil
+	}
+	err := m.closeAll()
+	m.finish(nil)
+	return err
+}
+
+// closeAll 关闭全部连接
+func (m *SubscriptionManager) closeAll() error {
+	m.closed = true
+	errs := make([]error, 0, len(m.shards))
+	for _, sub := range m.shards {
+		errs = append(errs, sub.Close())
+	}
+	m.shards = nil
+	clear(m.points)
+	return errors.Join(errs...)
+}
+
+// finish 结束订阅
+func (m *SubscriptionManager) finish(err error) {
+	m.once.Do(func() {
+		m.err = err
+		close(m.done)
+	})
+}
+
+// pickInfos 按序号选出标签点
+func pickInfos(infos []*PointInfo, idx []int) []*PointInfo {
+	rtn := make([]*PointInfo, len(idx))
+	for j, i := range idx {
+		rtn[j] = infos[i]
+	}
+	return rtn
+}
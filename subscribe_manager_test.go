@@ -0,0 +1,148 @@
+package rtdb_api
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+)
+
+// 通过内存后端把标签点分布到多个订阅连接
+func TestSubscriptionManager(t *testing.T) {
+	conn, err := LoginWithBackend(NewMemoryBackend(), "127.0.0.1", Port, Username, Password)
+	if err != nil {
+		t.Fatal("登录用户失败", err)
+	}
+	defer func() { _ = conn.Logout() }()
+
+	table, err := conn.CreateTable("manager", "订阅管理器")
+	if err != nil {
+		t.Fatal(err)
+	}
+	infos := make([]*PointInfo, 7)
+	for i := range infos {
+		infos[i], err = conn.AddPoint(NewPointInfo(fmt.Sprintf("p%d", i), table.ID, ValueTypeFloat64, PointBase, RtdbPrecisionMilli, "", ""))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	updates := make(chan SnapshotUpdate, 64)
+	m := conn.NewSubscriptionManager(SubscriptionManagerOptions{ShardSize: 2, MaxConns: 3}, func(u SnapshotUpdate) { updates <- u })
+	errs, err := m.Add(infos[:5])
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, err := range errs {
+		if err != nil {
+			t.Fatal("订阅失败", err)
+		}
+	}
+	if shards := m.Shards(); !slices.Equal(shards, []int{2, 2, 1}) {
+		t.Fatal("标签点分布错误", shards)
+	}
+
+	// 第三个连接只剩一个位置, 超过 MaxConns 的标签点订阅失败
+	errs, err = m.Add([]*PointInfo{infos[5], infos[6], infos[0]})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if errs[0] != nil || !errors.Is(errs[1], RteSubscribeGreaterMaxCount) || !errors.Is(errs[2], RteAlreadySubscribe) {
+		t.Fatal("添加订阅结果错误", errs)
+	}
+	if len(m.Points()) != 6 {
+		t.Fatal("订阅的标签点个数错误", len(m.Points()))
+	}
+
+	// 各个连接的事件合并推送
+	now := time.UnixMilli(1700000000000)
+	ptvqs := make([]PTVQ, 6)
+	for i := range ptvqs {
+		ptvqs[i] = NewPTVQ(infos[i], NewTvqFloat64(now, float64(i), QualityGood))
+	}
+	if _, err := conn.WriteSection(false, ptvqs); err != nil {
+		t.Fatal(err)
+	}
+	received := make(map[PointID]float64)
+	for len(received) < 6 {
+		select {
+		case u := <-updates:
+			if u.Type != RtdbEventData {
+				t.Fatalf("快照事件错误 %+v", u)
+			}
+			for _, ptvq := range u.Values {
+				received[ptvq.PointInfo.ID] = ptvq.TVQ.Value.FloatValue
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("等待订阅事件超时", received)
+		}
+	}
+	for i := range ptvqs {
+		if received[infos[i].ID] != float64(i) {
+			t.Error("快照错误", infos[i].ID, received[infos[i].ID])
+		}
+	}
+
+	// 删除后4个标签点可以使用2个连接, 关闭多余的连接
+	errs, err = m.Remove([]PointID{infos[0].ID, infos[2].ID, infos[6].ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if errs[0] != nil || errs[1] != nil || !errors.Is(errs[2], RteNoSubscribe) {
+		t.Fatal("删除订阅结果错误", errs)
+	}
+	if shards := m.Shards(); !slices.Equal(shards, []int{2, 2}) {
+		t.Fatal("重新分布标签点错误", shards)
+	}
+	ids := make([]PointID, 0)
+	for _, info := range m.Points() {
+		ids = append(ids, info.ID)
+	}
+	slices.Sort(ids)
+	if !slices.Equal(ids, []PointID{infos[1].ID, infos[3].ID, infos[4].ID, infos[5].ID}) {
+		t.Fatal("订阅的标签点错误", ids)
+	}
+
+	// 最后推送的时间随标签点迁移, 网络恢复后可以补推
+	m.mu.Lock()
+	for _, id := range ids {
+		sub := m.points[id]
+		sub.mu.RLock()
+		last, ok := sub.last[id]
+		sub.mu.RUnlock()
+		if !ok || !last.Equal(now) {
+			t.Error("迁移后丢失了最后推送的时间", id, last)
+		}
+	}
+	m.mu.Unlock()
+
+	// 迁移后的标签点仍然推送
+	for len(updates) != 0 {
+		<-updates
+	}
+	if err := conn.WriteValue(infos[5], false, NewTvqFloat64(now.Add(time.Second), 50, QualityGood)); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case u := <-updates:
+		if len(u.Values) != 1 || u.Values[0].PointInfo.ID != infos[5].ID || u.Values[0].TVQ.Value.FloatValue != 50 {
+			t.Fatalf("快照事件错误 %+v", u)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("等待订阅事件超时")
+	}
+
+	if err := m.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Err(); err != nil {
+		t.Error("关闭订阅后期望Err为nil", err)
+	}
+	if _, err := m.Add(infos[:1]); err == nil {
+		t.Error("关闭后添加订阅期望失败")
+	}
+	if len(m.Shards()) != 0 {
+		t.Error("关闭后仍有订阅连接", m.Shards())
+	}
+}
@@ -0,0 +1,420 @@
+package rtdb_api
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// 通过内存后端订阅快照
+func TestSubscribeSnapshots(t *testing.T) {
+	conn, err := LoginWithBackend(NewMemoryBackend(), "127.0.0.1", Port, Username, Password)
+	if err != nil {
+		t.Fatal("登录用户失败", err)
+	}
+	defer func() { _ = conn.Logout() }()
+
+	table, err := conn.CreateTable("subscribe", "订阅")
+	if err != nil {
+		t.Fatal(err)
+	}
+	temp, err := conn.AddPoint(NewPointInfo("temp", table.ID, ValueTypeFloat64, PointBase, RtdbPrecisionMilli, "", ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	name, err := conn.AddPoint(NewPointInfo("name", table.ID, ValueTypeString, PointBase, RtdbPrecisionMilli, "", ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := conn.AddPoint(NewPointInfo("other", table.ID, ValueTypeInt32, PointBase, RtdbPrecisionMilli, "", ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err := conn.SubscribeSnapshots(make([]*PointInfo, RtdbConstMaxSubscribeSnapshots+1), SubscribeOptions{}, nil); !errors.Is(err, RteSubscribeGreaterMaxCount) {
+		t.Error("超过单连接订阅上限时期望失败", err)
+	}
+
+	updates := make(chan SnapshotUpdate, 16)
+	missing := &PointInfo{ID: 10000, ValueType: ValueTypeFloat64}
+	sub, errs, err := conn.SubscribeSnapshots([]*PointInfo{temp, name, missing}, SubscribeOptions{}, func(u SnapshotUpdate) { updates <- u })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if errs[0] != nil || errs[1] != nil || !errors.Is(errs[2], RtePointNotFound) {
+		t.Error("订阅结果错误", errs)
+	}
+	if len(sub.Points()) != 2 {
+		t.Error("订阅的标签点个数错误", len(sub.Points()))
+	}
+	next := func() SnapshotUpdate {
+		t.Helper()
+		select {
+		case u := <-updates:
+			return u
+		case <-time.After(5 * time.Second):
+			t.Fatal("等待订阅事件超时")
+			return SnapshotUpdate{}
+		}
+	}
+
+	now := time.UnixMilli(1700000000000)
+	if _, err := conn.WriteSection(false, []PTVQ{
+		NewPTVQ(temp, NewTvqFloat64(now, 1.5, QualityGood)),
+		NewPTVQ(other, NewTvqInt32(now, 1, QualityGood)),
+		NewPTVQ(name, NewTvqString(now, "abc", QualityGood)),
+	}); err != nil {
+		t.Fatal(err)
+	}
+	// 不同数值类型分别写入, 事件个数不确定
+	values := make([]PTVQ, 0)
+	for len(values) < 2 {
+		u := next()
+		if u.Type != RtdbEventData {
+			t.Fatalf("快照事件错误 %+v", u)
+		}
+		for _, err := range u.Errors {
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+		values = append(values, u.Values...)
+	}
+	for _, ptvq := range values {
+		switch ptvq.PointInfo.ID {
+		case temp.ID:
+			if ptvq.TVQ.Value.FloatValue != 1.5 || !ptvq.TVQ.Timestamp.Equal(now) {
+				t.Errorf("浮点数快照错误 %+v", ptvq.TVQ)
+			}
+		case name.ID:
+			if ptvq.TVQ.Value.StringValue != "abc" {
+				t.Errorf("字符串快照错误 %+v", ptvq.TVQ)
+			}
+		default:
+			t.Error("推送了未订阅的标签点", ptvq.PointInfo.ID)
+		}
+	}
+
+	// 早于快照的数据写入历史, 不会推送
+	if err := conn.WriteValue(temp, false, NewTvqFloat64(now.Add(-time.Second), 0, QualityGood)); err != nil {
+		t.Fatal(err)
+	}
+	rtes, rte := conn.Backend().RawRtdbsChangeSubscribeSnapshotsWarp(sub.conn.handle(), []PointID{name.ID, other.ID}, []float64{0, 0}, []int64{0, 0},
+		[]RtdbSubscribeChangeType{RtdbSubscribeChangeTypeRemove, RtdbSubscribeChangeTypeRemove})
+	if !RteIsOk(rte) || !RteIsOk(rtes[0]) || !errors.Is(rtes[1], RteNoSubscribe) {
+		t.Fatal("修改订阅失败", rte, rtes)
+	}
+	u := next()
+	if u.Type != RtdbEventChanged || len(u.Changes) != 2 || u.Changes[0].Err != nil || u.Changes[1].Err == nil || len(sub.Points()) != 1 {
+		t.Fatalf("修改订阅事件错误 %+v", u)
+	}
+
+	if err := conn.WriteValue(name, false, NewTvqString(now.Add(time.Second), "def", QualityGood)); err != nil {
+		t.Fatal(err)
+	}
+	if err := conn.WriteValue(temp, false, NewTvqFloat64(now.Add(time.Second), 2.5, QualityGood)); err != nil {
+		t.Fatal(err)
+	}
+	if u = next(); len(u.Values) != 1 || u.Values[0].PointInfo.ID != temp.ID {
+		t.Fatalf("取消订阅后仍然推送 %+v", u)
+	}
+
+	errs, err = sub.Add([]*PointInfo{name, temp})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if errs[0] != nil || !errors.Is(errs[1], RteAlreadySubscribe) || len(sub.Points()) != 2 {
+		t.Error("添加订阅结果错误", errs, len(sub.Points()))
+	}
+	if u = next(); u.Type != RtdbEventChanged {
+		t.Fatalf("添加订阅事件错误 %+v", u)
+	}
+	errs, err = sub.Remove([]PointID{name.ID})
+	if err != nil || errs[0] != nil || len(sub.Points()) != 1 {
+		t.Error("删除订阅结果错误", err, errs)
+	}
+	if u = next(); u.Type != RtdbEventChanged {
+		t.Fatalf("删除订阅事件错误 %+v", u)
+	}
+
+	if err := sub.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := sub.Err(); err != nil {
+		t.Error("关闭订阅后期望Err为nil", err)
+	}
+	if err := conn.WriteValue(temp, false, NewTvqFloat64(now.Add(2*time.Second), 3.5, QualityGood)); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case u := <-updates:
+		t.Errorf("关闭订阅后仍然推送 %+v", u)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+// 网络恢复后补推断开期间的历史存档
+func TestSubscribeSnapshotsBackfill(t *testing.T) {
+	backend := NewMemoryBackend()
+	conn, err := LoginWithBackend(backend, "127.0.0.1", Port, Username, Password)
+	if err != nil {
+		t.Fatal("登录用户失败", err)
+	}
+	defer func() { _ = conn.Logout() }()
+
+	table, err := conn.CreateTable("backfill", "补推")
+	if err != nil {
+		t.Fatal(err)
+	}
+	temp, err := conn.AddPoint(NewPointInfo("temp", table.ID, ValueTypeFloat64, PointBase, RtdbPrecisionMilli, "", ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	idle, err := conn.AddPoint(NewPointInfo("idle", table.ID, ValueTypeFloat64, PointBase, RtdbPrecisionMilli, "", ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	updates := make(chan SnapshotUpdate, 16)
+	sub, _, err := conn.SubscribeSnapshots([]*PointInfo{temp, idle}, SubscribeOptions{AutoConn: true}, func(u SnapshotUpdate) { updates <- u })
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = sub.Close() }()
+	next := func() SnapshotUpdate {
+		t.Helper()
+		select {
+		case u := <-updates:
+			return u
+		case <-time.After(5 * time.Second):
+			t.Fatal("等待订阅事件超时")
+			return SnapshotUpdate{}
+		}
+	}
+
+	now := time.UnixMilli(1700000000000)
+	if err := conn.WriteValue(temp, false, NewTvqFloat64(now, 1, QualityGood)); err != nil {
+		t.Fatal(err)
+	}
+	if u := next(); len(u.Values) != 1 || u.Backfill {
+		t.Fatalf("快照事件错误 %+v", u)
+	}
+
+	// 模拟网络断开: 断开期间服务端不推送事件
+	backend.mu.Lock()
+	ms := backend.subscriptions[sub.conn.handle()]
+	delete(backend.subscriptions, sub.conn.handle())
+	ms.push(&SnapshotEvent{Type: RtdbEventDisconnect})
+	backend.mu.Unlock()
+	if u := next(); u.Type != RtdbEventDisconnect {
+		t.Fatalf("断开事件错误 %+v", u)
+	}
+	for i := 1; i <= 3; i++ {
+		if err := conn.WriteValue(temp, false, NewTvqFloat64(now.Add(time.Duration(i)*time.Second), float64(i+1), QualityGood)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := conn.WriteValue(idle, false, NewTvqFloat64(now, 10, QualityGood)); err != nil {
+		t.Fatal(err)
+	}
+
+	backend.mu.Lock()
+	backend.subscriptions[sub.conn.handle()] = ms
+	ms.push(&SnapshotEvent{Type: RtdbEventRecovery})
+	// 恢复时推送的快照已经补推, 不会重复
+	backend.publish([]PointID{temp.ID})
+	backend.mu.Unlock()
+	if u := next(); u.Type != RtdbEventRecovery {
+		t.Fatalf("恢复事件错误 %+v", u)
+	}
+	u := next()
+	if u.Type != RtdbEventData || !u.Backfill || len(u.Values) != 3 {
+		t.Fatalf("补推事件错误 %+v", u)
+	}
+	for i, ptvq := range u.Values {
+		if u.Errors[i] != nil || ptvq.PointInfo.ID != temp.ID || ptvq.TVQ.Value.FloatValue != float64(i+2) {
+			t.Errorf("补推的数值错误 %+v %v", ptvq.TVQ, u.Errors[i])
+		}
+	}
+
+	if err := conn.WriteValue(temp, false, NewTvqFloat64(now.Add(4*time.Second), 5, QualityGood)); err != nil {
+		t.Fatal(err)
+	}
+	if u := next(); u.Backfill || len(u.Values) != 1 || u.Values[0].TVQ.Value.FloatValue != 5 {
+		t.Fatalf("恢复后的快照事件错误 %+v", u)
+	}
+}
+
+// 原连接断开时通过订阅连接补推, 补推期间的订阅事件在补推完成后推送
+func TestSubscribeSnapshotsBackfill_ParentDown(t *testing.T) {
+	backend := NewMemoryBackend()
+	conn, err := LoginWithBackend(backend, "127.0.0.1", Port, Username, Password)
+	if err != nil {
+		t.Fatal("登录用户失败", err)
+	}
+	writer, err := LoginWithBackend(backend, "127.0.0.1", Port, Username, Password)
+	if err != nil {
+		t.Fatal("登录用户失败", err)
+	}
+	defer func() { _ = writer.Logout() }()
+
+	table, err := writer.CreateTable("backfill", "补推")
+	if err != nil {
+		t.Fatal(err)
+	}
+	temp, err := writer.AddPoint(NewPointInfo("temp", table.ID, ValueTypeFloat64, PointBase, RtdbPrecisionMilli, "", ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	updates := make(chan SnapshotUpdate, 16)
+	entered, release := make(chan struct{}), make(chan struct{})
+	sub, _, err := conn.SubscribeSnapshots([]*PointInfo{temp}, SubscribeOptions{AutoConn: true}, func(u SnapshotUpdate) {
+		if u.Backfill {
+			close(entered)
+			<-release
+		}
+		updates <- u
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = sub.Close() }()
+	next := func() SnapshotUpdate {
+		t.Helper()
+		select {
+		case u := <-updates:
+			return u
+		case <-time.After(5 * time.Second):
+			t.Fatal("等待订阅事件超时")
+			return SnapshotUpdate{}
+		}
+	}
+	write := func(offset int, value float64) {
+		t.Helper()
+		if err := writer.WriteValue(temp, false, NewTvqFloat64(time.UnixMilli(1700000000000).Add(time.Duration(offset)*time.Second), value, QualityGood)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	write(0, 1)
+	if u := next(); len(u.Values) != 1 || u.Backfill {
+		t.Fatalf("快照事件错误 %+v", u)
+	}
+	backend.mu.Lock()
+	ms := backend.subscriptions[sub.conn.handle()]
+	delete(backend.subscriptions, sub.conn.handle())
+	ms.push(&SnapshotEvent{Type: RtdbEventDisconnect})
+	backend.mu.Unlock()
+	if u := next(); u.Type != RtdbEventDisconnect {
+		t.Fatalf("断开事件错误 %+v", u)
+	}
+	write(1, 2)
+	write(2, 3)
+	if err := conn.Logout(); err != nil {
+		t.Fatal(err)
+	}
+
+	backend.mu.Lock()
+	backend.subscriptions[sub.conn.handle()] = ms
+	ms.push(&SnapshotEvent{Type: RtdbEventRecovery})
+	backend.mu.Unlock()
+	if u := next(); u.Type != RtdbEventRecovery {
+		t.Fatalf("恢复事件错误 %+v", u)
+	}
+	select {
+	case <-entered:
+	case <-time.After(5 * time.Second):
+		t.Fatal("等待补推超时")
+	}
+	// 补推进行中, 新的订阅事件被缓存
+	write(3, 4)
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+
+	u := next()
+	if !u.Backfill || len(u.Values) != 2 || u.Errors[0] != nil || u.Values[0].TVQ.Value.FloatValue != 2 || u.Values[1].TVQ.Value.FloatValue != 3 {
+		t.Fatalf("补推事件错误 %+v", u)
+	}
+	if u := next(); u.Backfill || len(u.Values) != 1 || u.Values[0].TVQ.Value.FloatValue != 4 {
+		t.Fatalf("补推后的快照事件错误 %+v", u)
+	}
+}
+
+// callbackReadBackend 记录是否在订阅回调中读取了字符串快照
+type callbackReadBackend struct {
+	Backend
+	inCallback *atomic.Int32
+	violations *atomic.Int32
+}
+
+func (b callbackReadBackend) RawRtdbsSubscribeSnapshotsEx64Warp(handle ConnectHandle, ids []PointID, options RtdbSubscribeOption, callback SnapshotEventFunc) ([]RtdbError, RtdbError) {
+	return b.Backend.RawRtdbsSubscribeSnapshotsEx64Warp(handle, ids, options, func(handle ConnectHandle, event *SnapshotEvent) RtdbError {
+		b.inCallback.Add(1)
+		defer b.inCallback.Add(-1)
+		return callback(handle, event)
+	})
+}
+
+func (b callbackReadBackend) RawRtdbsGetBlobSnapshots64Warp(handle ConnectHandle, ids []PointID, maxLen int32) ([]TimestampType, []SubtimeType, [][]byte, []Quality, []RtdbError, RtdbError) {
+	if b.inCallback.Load() != 0 {
+		b.violations.Add(1)
+	}
+	return b.Backend.RawRtdbsGetBlobSnapshots64Warp(handle, ids, maxLen)
+}
+
+// 字符串类型的快照在单独的goroutine中读取, 读取期间收到的事件按顺序推送
+func TestSubscribeSnapshots_ReadOutsideCallback(t *testing.T) {
+	backend := callbackReadBackend{Backend: NewMemoryBackend(), inCallback: new(atomic.Int32), violations: new(atomic.Int32)}
+	conn, err := LoginWithBackend(backend, "127.0.0.1", Port, Username, Password)
+	if err != nil {
+		t.Fatal("登录用户失败", err)
+	}
+	defer func() { _ = conn.Logout() }()
+
+	table, err := conn.CreateTable("subscribe", "订阅")
+	if err != nil {
+		t.Fatal(err)
+	}
+	temp, err := conn.AddPoint(NewPointInfo("temp", table.ID, ValueTypeFloat64, PointBase, RtdbPrecisionMilli, "", ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	name, err := conn.AddPoint(NewPointInfo("name", table.ID, ValueTypeString, PointBase, RtdbPrecisionMilli, "", ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	updates := make(chan SnapshotUpdate, 16)
+	sub, _, err := conn.SubscribeSnapshots([]*PointInfo{temp, name}, SubscribeOptions{}, func(u SnapshotUpdate) { updates <- u })
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = sub.Close() }()
+
+	now := time.UnixMilli(1700000000000)
+	if err := conn.WriteValue(name, false, NewTvqString(now, "abc", QualityGood)); err != nil {
+		t.Fatal(err)
+	}
+	if err := conn.WriteValue(temp, false, NewTvqFloat64(now, 1.5, QualityGood)); err != nil {
+		t.Fatal(err)
+	}
+	want := []PointID{name.ID, temp.ID}
+	for _, id := range want {
+		select {
+		case u := <-updates:
+			if len(u.Values) != 1 || u.Values[0].PointInfo.ID != id || u.Errors[0] != nil {
+				t.Fatalf("快照事件错误 %+v", u)
+			}
+			if id == name.ID && u.Values[0].TVQ.Value.StringValue != "abc" {
+				t.Errorf("字符串快照错误 %+v", u.Values[0].TVQ)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("等待订阅事件超时")
+		}
+	}
+	if n := backend.violations.Load(); n != 0 {
+		t.Errorf("在订阅回调中读取了%d次快照", n)
+	}
+}
@@ -0,0 +1,5880 @@
+// 数据库的类型与常量定义, 与 cinclude 中的C头文件保持一致, 不依赖CGO
+
+package rtdb_api
+
+import (
+	"errors"
+	"unsafe"
+)
+
+// Switch 开关
+type Switch byte
+
+const (
+	// OFF 关闭
+	OFF = Switch(0)
+
+	// ON 开启
+	ON = Switch(1)
+)
+
+// RtdbError 数据库错误
+type RtdbError uint32
+
+// IsOk 判断当前错误是否为RteOk
+func (re RtdbError) IsOk() bool {
+	return errors.Is(re, RteOk)
+}
+
+// chineseMessage 错误码的中文描述
+func (re RtdbError) chineseMessage() string {
+	desc := ""
+	switch {
+	case errors.Is(re, RteUnknownError):
+		desc = "未知错误"
+	case errors.Is(re, RteOk):
+		desc = "操作成功完成"
+	case errors.Is(re, RteWindowsError):
+		desc = "Windows操作系统错误的起始值"
+	case errors.Is(re, RteWindowsErrorMax):
+		desc = "Windows操作系统错误的结束值"
+	case errors.Is(re, RteInvalidOpenmode):
+		desc = "无效的文件打开方式"
+	case errors.Is(re, RteOpenfileFailed):
+		desc = "打开文件失败"
+	case errors.Is(re, RteMovetoendFailed):
+		desc = "移动文件指针到文件尾失败"
+	case errors.Is(re, RteDifferReadbytes):
+		desc = "读取内容长度与要求不符"
+	case errors.Is(re, RteGetfileposFailed):
+		desc = "获取当前文件指针失败"
+	case errors.Is(re, RteFlushfileFailed):
+		desc = "刷新文件缓冲区失败"
+	case errors.Is(re, RteSetsizeFailed):
+		desc = "设置文件大小失败"
+	case errors.Is(re, RteFileNotClosed):
+		desc = "试图用未关闭的文件对象创建或打开文件"
+	case errors.Is(re, RteFileUnknown):
+		desc = "创建或打开文件时必须指定文件名"
+	case errors.Is(re, RteInvalidHeader):
+		desc = "数据文件头信息错误"
+	case errors.Is(re, RteDisabledFile):
+		desc = "数据文件无效，试图访问无效数据文件"
+	case errors.Is(re, RteFileNotOpened):
+		desc = "试图访问尚未打开的数据文件"
+	case errors.Is(re, RtePointNotFound):
+		desc = "要求访问的标签点不存在或无效"
+	case errors.Is(re, RteReadyblockNotFound):
+		desc = "数据文件中找不到从指定数据块以后的可用的空块"
+	case errors.Is(re, RteFileIsIncult):
+		desc = "文件未被使用过"
+	case errors.Is(re, RteFileIsFull):
+		desc = "数据文件已满"
+	case errors.Is(re, RteFileexIsFull):
+		desc = "数据文件扩展区已满，无法继续装载数据"
+	case errors.Is(re, RteInvalidDataType):
+		desc = "无效的数据类型"
+	case errors.Is(re, RteDatablockNotFound):
+		desc = "找不到符合时间条件的数据块"
+	case errors.Is(re, RteDataBetweenBlock):
+		desc = "数据时间位于找到的块和下一个数据块之间"
+	case errors.Is(re, RteCantModifyExistValue):
+		desc = "不允许修改已存在的数据"
+	case errors.Is(re, RteWrongdataInBlock):
+		desc = "块中有错误数据导致数据块头信息不符"
+	case errors.Is(re, RteDatatimeNotIn):
+		desc = "数据文件中没有该标签点指定时间的数据"
+	case errors.Is(re, RteNullArchivePath):
+		desc = "操作的数据文件路径为空"
+	case errors.Is(re, RteRegArchivePath):
+		desc = "数据文件已被注册"
+	case errors.Is(re, RteUnregArchivePath):
+		desc = "未注册的数据文件"
+	case errors.Is(re, RteFileInexistence):
+		desc = "指定的文件不存在"
+	case errors.Is(re, RteDataTypeNotMatch):
+		desc = "数据类型不匹配"
+	case errors.Is(re, RteFileIsReadonly):
+		desc = "不允许修改只读数据文件中的数据"
+	case errors.Is(re, RteTomanyArchiveFile):
+		desc = "过多的数据文件"
+	case errors.Is(re, RteNoPointsList):
+		desc = "缺少标签点列表"
+	case errors.Is(re, RteNoActivedArchive):
+		desc = "缺少活动文档"
+	case errors.Is(re, RteNoArchiveFile):
+		desc = "缺少数据文档"
+	case errors.Is(re, RteNeedActivedArchive):
+		desc = "只能在活动文档上执行该操作"
+	case errors.Is(re, RteInvalidTimestamp):
+		desc = "无效的时间戳"
+	case errors.Is(re, RteNeedMoreWritable):
+		desc = "非只读文档个数太少"
+	case errors.Is(re, RteNoArchiveForPut):
+		desc = "找不到合适的追加历史数据的文档"
+	case errors.Is(re, RteInvalidValueMode):
+		desc = "无效的取值模式"
+	case errors.Is(re, RteDataNotFound):
+		desc = "找不到需要的数据"
+	case errors.Is(re, RteInvalidParameter):
+		desc = "无效的参数"
+	case errors.Is(re, RteReduplicateTag):
+		desc = "重复的标签点名"
+	case errors.Is(re, RteReduplicateTabname):
+		desc = "重复的表名称"
+	case errors.Is(re, RteReduplicateTabid):
+		desc = "重复的表ID"
+	case errors.Is(re, RteTableNotFound):
+		desc = "指定的表不存在"
+	case errors.Is(re, RteUnsupportedClassof):
+		desc = "不支持的标签点类别"
+	case errors.Is(re, RteWrongOrDuplicTag):
+		desc = "错误的或重复的标签点名"
+	case errors.Is(re, RteReduplicatePt):
+		desc = "重复的标签点标识"
+	case errors.Is(re, RtePointLicenseFull):
+		desc = "标签点数超出了许可证规定的最大数目"
+	case errors.Is(re, RteTableLicenseFull):
+		desc = "标签点表个数超出了许可证规定的最大数目"
+	case errors.Is(re, RteWrongOrDuplicTabname):
+		desc = "错误的或重复的表名称"
+	case errors.Is(re, RteInvalidFileFormat):
+		desc = "无效的数据文件格式"
+	case errors.Is(re, RteWrongTabname):
+		desc = "错误的表名称"
+	case errors.Is(re, RteWrongTag):
+		desc = "错误的标签点名"
+	case errors.Is(re, RteNotInScope):
+		desc = "数值超出了应属的范围"
+	case errors.Is(re, RteCantLoadBase):
+		desc = "不能同标签点信息服务取得联系"
+	case errors.Is(re, RteCantLoadSnapshot):
+		desc = "不能同快照数据服务取得联系"
+	case errors.Is(re, RteCantLoadHistory):
+		desc = "不能同历史数据服务取得联系"
+	case errors.Is(re, RteCantLoadEquation):
+		desc = "不能同实施方程式服务取得联系"
+	case errors.Is(re, RteArraySizeNotMatch):
+		desc = "数组尺寸不匹配"
+	case errors.Is(re, RteInvalidHostAddress):
+		desc = "无效的主机地址"
+	case errors.Is(re, RteConnectFalse):
+		desc = "连接已断开"
+	case errors.Is(re, RteToomanyBytesRecved):
+		desc = "接收到的数据长度超出了指定字节长度"
+	case errors.Is(re, RteReqidRespidNotMatch):
+		desc = "应答与请求的ID不一致"
+	case errors.Is(re, RteLessBytesRecved):
+		desc = "接收到的数据长度小于指定字节长度"
+	case errors.Is(re, RteUnsupportedCalcMode):
+		desc = "不支持的计算模式"
+	case errors.Is(re, RteUnsupportedDataType):
+		desc = "不支持的标签点类型"
+	case errors.Is(re, RteInvalidExpression):
+		desc = "无效的表达式"
+	case errors.Is(re, RteIncondDataNotFound):
+		desc = "找不到符合条件的数据"
+	case errors.Is(re, RteValidDataNotFound):
+		desc = "找不到需要的有效数据"
+	case errors.Is(re, RteValueOrStateIsNan):
+		desc = "数据或状态不正常，为NAN"
+	case errors.Is(re, RteCreateMutexFailed):
+		desc = "创建互斥对象失败"
+	case errors.Is(re, RteTlsallocfail):
+		desc = "处理TLS时调用系统函数LocalAlloc()失败，可能因为内存不足导致"
+	case errors.Is(re, RteToManyPoints):
+		desc = "正在调用的API函数不支持过多的标签点数量，请参考函数声明和开发手册"
+	case errors.Is(re, RteLicInfoError):
+		desc = "获取授权许可协议信息时发生错误"
+	case errors.Is(re, RteArchiveBufferFull):
+		desc = "标签点的历史数据补写缓冲区已满，请稍后再补"
+	case errors.Is(re, RteUserNotExist):
+		desc = "用户不存在"
+	case errors.Is(re, RteUserIsLocked):
+		desc = "帐户被锁定,需要管理员为您解锁"
+	case errors.Is(re, RteWrongPassword):
+		desc = "错误的口令"
+	case errors.Is(re, RteAccessIsDenied):
+		desc = "访问被拒绝，请确定是否具有足够的权限"
+	case errors.Is(re, RteHaveNotLogin):
+		desc = "您尚未登录，请先登录"
+	case errors.Is(re, RteUserIsDeleted):
+		desc = "帐户已被删除"
+	case errors.Is(re, RteUserAlreadyExist):
+		desc = "帐户已存在"
+	case errors.Is(re, RteWrongCreateTabname):
+		desc = "创建删除表失败"
+	case errors.Is(re, RteWrongFieldValue):
+		desc = "标签点属性值有错误"
+	case errors.Is(re, RteInvalidTagId):
+		desc = "无效的标签点ID"
+	case errors.Is(re, RteCheckNamedTypeNameError):
+		desc = "无效的自定义类型名称或字段名称"
+	case errors.Is(re, RteCantLoadDispatch):
+		desc = "不能同转发服务器取得联系"
+	case errors.Is(re, RteConnectTimeOut):
+		desc = "连接已超时，需要重新登录"
+	case errors.Is(re, RteWrongLogin4):
+		desc = "账户信息验证失败，还有4次尝试机会"
+	case errors.Is(re, RteWrongLogin3):
+		desc = "账户信息验证失败，还有3次尝试机会"
+	case errors.Is(re, RteWrongLogin2):
+		desc = "账户信息验证失败，还有2次尝试机会"
+	case errors.Is(re, RteWrongLogin1):
+		desc = "账户信息验证失败，还有1次尝试机会"
+	case errors.Is(re, RteWrongDesc):
+		desc = "错误的表描述"
+	case errors.Is(re, RteWrongUnit):
+		desc = "错误的工程单位"
+	case errors.Is(re, RteWrongChanger):
+		desc = "错误的最后一次被修改的用户名"
+	case errors.Is(re, RteWrongCreator):
+		desc = "错误的标签点创建者用户名"
+	case errors.Is(re, RteWrongFull):
+		desc = "错误的标签点全名"
+	case errors.Is(re, RteWrongSource):
+		desc = "错误的数据源"
+	case errors.Is(re, RteWrongInstrument):
+		desc = "错误的设备标签"
+	case errors.Is(re, RteWrongUser):
+		desc = "错误的创建者"
+	case errors.Is(re, RteWrongEquation):
+		desc = "错误的实时方程式"
+	case errors.Is(re, RteWrongTypeName):
+		desc = "错误的自定义类型名称"
+	case errors.Is(re, RteWrongEncode):
+		desc = "编码转换时出错"
+	case errors.Is(re, RteWrongOthermask):
+		desc = "错误的搜索类型转换mask值"
+	case errors.Is(re, RteWrongType):
+		desc = "错误的搜索类型"
+	case errors.Is(re, RtePointHardwareLimited):
+		desc = "由于硬件资源限制，创建或恢复标签点失败"
+	case errors.Is(re, RteWaitingRecoverData):
+		desc = "正在等待恢复数据完成，请稍后尝试连接"
+	case errors.Is(re, RteReplicationLicMismatch):
+		desc = "双活数据库授权不一致"
+	case errors.Is(re, RteReadConfigFailed):
+		desc = "读取配置文件失败"
+	case errors.Is(re, RteUpdateConfigFailed):
+		desc = "更新配置文件失败"
+	case errors.Is(re, RteFilterTooLong):
+		desc = "filter超过最大长度"
+	case errors.Is(re, RteGetArchiveNameFail):
+		desc = "获取存档文件名失败"
+	case errors.Is(re, RteAutoMoveFailed):
+		desc = "自动移动存档文件失败"
+	case errors.Is(re, RteTimeGreaterThanHotTailArc):
+		desc = "创建/入列非闪盘存档文件的时间大于闪盘最早的存档文件"
+	case errors.Is(re, RteTimeLessThanColdBeginArc):
+		desc = "创建/入列闪盘的存档文件时间小于非闪盘最新的存档文件"
+	case errors.Is(re, RteRemoveEarliestArcFailed):
+		desc = "删除最早的存档文件失败（存档文件列表为空）"
+	case errors.Is(re, RteNoFreeTableId):
+		desc = "没有空闲的表ID可用"
+	case errors.Is(re, RteNoFreeTagPosition):
+		desc = "没有空闲的标签点位址可用"
+	case errors.Is(re, RteNoFreeScanTagPosition):
+		desc = "没有空闲的采集标签点位址可用"
+	case errors.Is(re, RteNoFreeCalcTagPosition):
+		desc = "没有空闲的计算标签点位址可用"
+	case errors.Is(re, RteInvalidIpcPosition):
+		desc = "无效的位址被用于进程间内存共享"
+	case errors.Is(re, RteWrongIpcPosition):
+		desc = "错误的位址被用于进程间内存共享"
+	case errors.Is(re, RteIpcAccessException):
+		desc = "共享内存访问异常"
+	case errors.Is(re, RteArvPageNotReady):
+		desc = "没有空闲的历史数据缓存页"
+	case errors.Is(re, RteArvexPageNotReady):
+		desc = "没有空闲的补历史数据缓存页"
+	case errors.Is(re, RteInvalidPositionFromId):
+		desc = "依据标签点ID获得的位址无效"
+	case errors.Is(re, RteNoActivePageAllocator):
+		desc = "新的活动存档无法加载页分配器"
+	case errors.Is(re, RteMapIsNotReady):
+		desc = "内存映射尚未就绪"
+	case errors.Is(re, RteFileMapFailed):
+		desc = "文件映射到内存失败"
+	case errors.Is(re, RteTimeRangeNotAllowed):
+		desc = "不允许使用的时间区间"
+	case errors.Is(re, RteNoDataForSummary):
+		desc = "找不到用于统计的源数据"
+	case errors.Is(re, RteCantOperateOnActived):
+		desc = "不允许操作活动存档文件"
+	case errors.Is(re, RteScanPointLicenseFull):
+		desc = "采集标签点数超出了许可证规定的最大数目"
+	case errors.Is(re, RteCalcPointLicenseFull):
+		desc = "计算标签点数超出了许可证规定的最大数目"
+	case errors.Is(re, RteHistorianIsShuttingdown):
+		desc = "历史数据服务正在停止"
+	case errors.Is(re, RteSnapshotIsShuttingdown):
+		desc = "实时数据服务正在停止"
+	case errors.Is(re, RteEquationIsShuttingdown):
+		desc = "实时方程式服务正在停止"
+	case errors.Is(re, RteBaseIsShuttingdown):
+		desc = "标签点信息服务正在停止"
+	case errors.Is(re, RteServerIsShuttingdown):
+		desc = "网络通信服务正在停止"
+	case errors.Is(re, RteOutOfMemory):
+		desc = "内存不足"
+	case errors.Is(re, RteInvalidPage):
+		desc = "无效的数据页，有可能是未加载"
+	case errors.Is(re, RtePageIsEmpty):
+		desc = "遇到空的数据页"
+	case errors.Is(re, RteStrOrBlobTooLong):
+		desc = "字符串或BLOB数据长度超出限制"
+	case errors.Is(re, RteCreatedOrOverdue):
+		desc = "尚未产生任何快照或快照已过期"
+	case errors.Is(re, RteArchiveInfoNotMatching):
+		desc = "历史存档文件头部信息与实际不符"
+	case errors.Is(re, RteTimeRangeOverlapping):
+		desc = "指定的时间范围与已有存档文件重叠"
+	case errors.Is(re, RteCannotShiftToActived):
+		desc = "找不到合适的存档文件用于切换成活动存档"
+	case errors.Is(re, RteIndexNotReady):
+		desc = "历史存档文件对应的索引尚未就绪"
+	case errors.Is(re, RteIndexNodeNotMatch):
+		desc = "索引节点与指向的内容不符"
+	case errors.Is(re, RteCanNotCreateIndex):
+		desc = "无法创建索引节点"
+	case errors.Is(re, RteCanNotRemoveIndex):
+		desc = "无法删除索引节点"
+	case errors.Is(re, RteInvalidFilterExpress):
+		desc = "无效的过滤器表达式"
+	case errors.Is(re, RteMoreVarInFilterExp):
+		desc = "过滤器表达式中的包含了过多的变量"
+	case errors.Is(re, RteInvalidArvPageAllocate):
+		desc = "刚分配的历史数据缓存页ID与标签点事件对象ID不匹配"
+	case errors.Is(re, RteInvalidArvexPageAllocate):
+		desc = "刚分配的补历史数据缓存页ID与标签点事件对象ID不匹配"
+	case errors.Is(re, RteBigJobIsNotDone):
+		desc = "正在执行重要的任务，请稍后再试"
+	case errors.Is(re, RteDatabaseNeedRestart):
+		desc = "数据库需要重新启动以便应用新的参数"
+	case errors.Is(re, RteInvalidTimeFormat):
+		desc = "无效的时间格式字符串"
+	case errors.Is(re, RteDataPlaybackDone):
+		desc = "历史数据回放过程已结束"
+	case errors.Is(re, RteBadEquation):
+		desc = "错误的方程式"
+	case errors.Is(re, RteNotEnoughSapce):
+		desc = "剩余磁盘空间不足"
+	case errors.Is(re, RteActivedArchiveExist):
+		desc = "已存在活动存档"
+	case errors.Is(re, RteArchiveHaveExFiles):
+		desc = "指定的存档文件具有附属文件"
+	case errors.Is(re, RteArchiveIsNotLatest):
+		desc = "指定的存档文件不是最晚的"
+	case errors.Is(re, RteDbSystemNotRunning):
+		desc = "数据库管理系统尚未完全启动"
+	case errors.Is(re, RteArchiveIsAltered):
+		desc = "存档文件内容发生变更"
+	case errors.Is(re, RteArchiveIsTooSmall):
+		desc = "不允许创建太小的存档文件和附属文件"
+	case errors.Is(re, RteInvalidIndexNode):
+		desc = "遇到无效的索引节点"
+	case errors.Is(re, RteModifySnapshotNotAllowed):
+		desc = "不允许删除或修改快照事件"
+	case errors.Is(re, RteSearchInterrupted):
+		desc = "因目标正被创建、删除或恢复，搜索被迫中断，请稍后再试"
+	case errors.Is(re, RteRecycleShutdown):
+		desc = "回收站已失效，相关操作无法完成"
+	case errors.Is(re, RteNeedToReindex):
+		desc = "索引文件缺失，或部分索引节点被损坏，需要重建索引"
+	case errors.Is(re, RteInvalidQuality):
+		desc = "无效的质量码"
+	case errors.Is(re, RteEquationNotReady):
+		desc = "实时方程式服务正在解析，请稍后再试"
+	case errors.Is(re, RteArchivesLicenseFull):
+		desc = "存档文件数已达到许可证规定的最大数目"
+	case errors.Is(re, RteRecycledLicenseFull):
+		desc = "标签点回收站容量超出了许可证规定的最大数目"
+	case errors.Is(re, RteStrBlobLicenseFull):
+		desc = "字符串或BLOB类型标签点数量超出了许可证规定的最大数目"
+	case errors.Is(re, RteNotSupportWhenDebug):
+		desc = "此功能被某个调试选项禁用"
+	case errors.Is(re, RteMappingAlreadyLoaded):
+		desc = "映射已经被加载，不允许重复加载"
+	case errors.Is(re, RteArchiveIsModified):
+		desc = "存档文件被修改，动作被中断"
+	case errors.Is(re, RteActiveArchiveFull):
+		desc = "活动文档已满"
+	case errors.Is(re, RteSplitNoData):
+		desc = "拆分数据页后所给时间区间内没有数据"
+	case errors.Is(re, RteInvalidDirectory):
+		desc = "指定的路径不存在或无效"
+	case errors.Is(re, RteArchiveLackExFiles):
+		desc = "指定存档文件的部分附属文件缺失"
+	case errors.Is(re, RteBigJobIsCanceled):
+		desc = "后台任务被取消"
+	case errors.Is(re, RteArvexBlobPageNotReady):
+		desc = "没有空闲的blob补历史数据缓存页"
+	case errors.Is(re, RteInvalidArvexBlobPageAllocate):
+		desc = "刚分配的blob补历史数据缓存页ID与标签点事件对象ID不匹配"
+	case errors.Is(re, RteTimestampEqualtoSnapshot):
+		desc = "写入的时间与快照时间相同"
+	case errors.Is(re, RteTimestampEarlierThanSnapshot):
+		desc = "写入的时间比当前快照时间较早"
+	case errors.Is(re, RteTimestampGreaterThanAllow):
+		desc = "写入的时间超过了允许的时间"
+	case errors.Is(re, RteTimestampBegintimeGreagerThanEndtime):
+		desc = "开始时间大于结束时间"
+	case errors.Is(re, RteTimestampBegintimeEqualtoEndtime):
+		desc = "开始时间等于结束时间"
+	case errors.Is(re, RteInvalidCount):
+		desc = "无效的count"
+	case errors.Is(re, RteInvalidCapacity):
+		desc = "无效的capacity"
+	case errors.Is(re, RteInvalidPath):
+		desc = "无效的路径"
+	case errors.Is(re, RteInvalidPosition):
+		desc = "无效的position"
+	case errors.Is(re, RteInvalidArvPage):
+		desc = "无效的rtdb_arv_page<RTDB_T>,未加载，或者size小于等于0"
+	case errors.Is(re, RteInvalidHisinfoItemState):
+		desc = "无效的历史信息条目"
+	case errors.Is(re, RteInvalidInterval):
+		desc = "无效的间隔"
+	case errors.Is(re, RteInvalidLength):
+		desc = "无效的字符串长度"
+	case errors.Is(re, RteInvalidSerachMode):
+		desc = "无效的search mode"
+	case errors.Is(re, RteInvalidFileId):
+		desc = "无效的存档文件ID"
+	case errors.Is(re, RteInvalidMillisecond):
+		desc = "无效的毫秒值/纳秒值"
+	case errors.Is(re, RteInvalidDeadline):
+		desc = "无效的截止时间"
+	case errors.Is(re, RteInvalidJobname):
+		desc = "无效的Job名称"
+	case errors.Is(re, RteInvalidJobstate):
+		desc = "无效的Job状态"
+	case errors.Is(re, RteInvalidProcessRate):
+		desc = "无效的Process速率"
+	case errors.Is(re, RteInvalidTableId):
+		desc = "无效的表ID"
+	case errors.Is(re, RteInvalidDataSource):
+		desc = "无效的数据源格式"
+	case errors.Is(re, RteInvalidTriggerMethod):
+		desc = "无效的触发方式"
+	case errors.Is(re, RteInvalidCalcTimeRes):
+		desc = "无效的计算结果时间戳参考方式"
+	case errors.Is(re, RteInvalidTriggerTimer):
+		desc = "无效的定时触发触发周期,不能小于1秒"
+	case errors.Is(re, RteInvalidLimit):
+		desc = "工程上限不得低于工程下限"
+	case errors.Is(re, RteInvalidCompTime):
+		desc = "无效的压缩间隔，最长压缩间隔不得小于最短压缩间隔"
+	case errors.Is(re, RteInvalidExtTime):
+		desc = "无效的例外间隔，最长例外间隔不得小于最短例外间隔"
+	case errors.Is(re, RteInvalidDigits):
+		desc = "无效的数值位数，数值位数超出了范围,-20~10"
+	case errors.Is(re, RteInvalidFullTagName):
+		desc = "标签点全名有误，找不到表名与点名的分隔符“.”"
+	case errors.Is(re, RteInvalidTableDesc):
+		desc = "表描述信息过有误"
+	case errors.Is(re, RteInvalidUserCount):
+		desc = "非法的用户个数，小于0"
+	case errors.Is(re, RteInvalidBlacklistCount):
+		desc = "非法的黑名单个数，小于0"
+	case errors.Is(re, RteInvalidAuthorizationCount):
+		desc = "非法的信任连接个数，小于0"
+	case errors.Is(re, RteInvalidBigJobType):
+		desc = "非法的大任务类型"
+	case errors.Is(re, RteInvalidSysParam):
+		desc = "无效的系统参数，调用db_set_db_info2时，参数有误"
+	case errors.Is(re, RteInvalidFileParam):
+		desc = "无效的文件路径参数，调用db_set_db_info1时，参数有误"
+	case errors.Is(re, RteInvalidFileSize):
+		desc = "文件长度有误  < 1 baserecycle.dat、scanrecycle.dat、calcrecycle.dat、snaprecycle.dat"
+	case errors.Is(re, RteInvalidTagType):
+		desc = "标签点类型有误，合法（ rtdb_bool ~ rtdb_blob)，但是不属于相应函数的处理范围"
+	case errors.Is(re, RteInvalidRecyStructPos):
+		desc = "回收站对象最后一个结构体位置非法"
+	case errors.Is(re, RteInvalidRecycleFile):
+		desc = "scanrecycle.dat、baserecycle.dat  snaprecycle.dat文件不存在或失效"
+	case errors.Is(re, RteInvalidSuffixName):
+		desc = "无效的文件后缀名"
+	case errors.Is(re, RteInsertStringFalse):
+		desc = "向数据页中插入字符串数据失败"
+	case errors.Is(re, RteBlobPageFull):
+		desc = "blob数据页已满"
+	case errors.Is(re, RteInvalidStringIteratorPointer):
+		desc = "无效的str/blob迭代器指针"
+	case errors.Is(re, RteNotEqualTagid):
+		desc = "目标页标签点ID 与 当前ID不一致"
+	case errors.Is(re, RtePathsOfArchiveAndAutobackAreSame):
+		desc = "存档文件路径与自动备份路径相同"
+	case errors.Is(re, RteXmlParseFail):
+		desc = "xml文件解析失败"
+	case errors.Is(re, RteXmlElementsAbsent):
+		desc = "xml清单文件文件内容缺失"
+	case errors.Is(re, RteXmlMismatchOnName):
+		desc = "xml清单文件与本产品不匹配"
+	case errors.Is(re, RteXmlMismatchOnVersion):
+		desc = "xml清单文件版本不匹配"
+	case errors.Is(re, RteXmlMismatchOnDatasize):
+		desc = "xml清单文件数据尺寸不匹配"
+	case errors.Is(re, RteXmlMismatchOnFileinfo):
+		desc = "xml清单文件中数据文件信息不匹配"
+	case errors.Is(re, RteXmlMismatchOnWindow):
+		desc = "xml清单文件中所有数据文件的窗口大小必须一致"
+	case errors.Is(re, RteXmlMismatchOnTypecount):
+		desc = "xml清单文件自定义数据类型的数量不匹配"
+	case errors.Is(re, RteXmlMismatchOnFieldcount):
+		desc = "xml清单文件自定义数据类型的field不匹配"
+	case errors.Is(re, RteXmlFieldMustInType):
+		desc = "xml清单文件中field标签必须嵌套在type标签中"
+	case errors.Is(re, RteInvalidNamedTypeFieldCount):
+		desc = "无效的FIELD数量"
+	case errors.Is(re, RteReduplicateFieldName):
+		desc = "重复的FIELD名字"
+	case errors.Is(re, RteInvalidNamedTypeName):
+		desc = "无效的自定义数据类型的名字"
+	case errors.Is(re, RteReduplicateNamedType):
+		desc = "已经存在的自定义数据类型"
+	case errors.Is(re, RteNotExistNamedType):
+		desc = "不存在的自定义数据类型"
+	case errors.Is(re, RteUpdateXmlFailed):
+		desc = "更新XML清单文件失败"
+	case errors.Is(re, RteNamedTypeUsedWithPoint):
+		desc = "有些标签点正在使用此自定义数据类型，不允许删除"
+	case errors.Is(re, RteNamedTypeUnsupportCalcPoint):
+		desc = "自定义数据类型不支持计算点"
+	case errors.Is(re, RteXmlMismatchOnMaxId):
+		desc = "自定义数据类型的最大ID与实际的自定义数据类型数量不一致"
+	case errors.Is(re, RteNamedTypeLicenseFull):
+		desc = "自定义数据类型的数量超出了许可证规定的最大数目"
+	case errors.Is(re, RteNoFreeNamedTypeId):
+		desc = "没有空闲的自定义数据类型的ID"
+	case errors.Is(re, RteInvalidNamedTypeId):
+		desc = "无效的自定义数据类型ID"
+	case errors.Is(re, RteInvalidNamedTypeFieldName):
+		desc = "无效的自定义数据类型的字段名字"
+	case errors.Is(re, RteNamedTypeUsedWithRecyclePoint):
+		desc = "有些回收站中的标签点正在使用此自定义数据类型，不允许删除"
+	case errors.Is(re, RteNamedTypeNameTooLong):
+		desc = "自定义类型的名字超过了允许的最大长度"
+	case errors.Is(re, RteNamedTypeFieldNameTooLong):
+		desc = "自定义类型的field 名字超过了允许的最大长度"
+	case errors.Is(re, RteInvalidNamedTypeFieldLength):
+		desc = "无效的自定义数据类型的字段长度"
+	case errors.Is(re, RteInvalidSearchMask):
+		desc = "无效的高级搜索的标签点属性mask"
+	case errors.Is(re, RteRecycledSpaceNotEnough):
+		desc = "标签点回收站空闲空间不足"
+	case errors.Is(re, RteDynamicLoadedMemoryNotInit):
+		desc = "动态加载的内存未初始化"
+	case errors.Is(re, RteForbidDynamicAllocType):
+		desc = "内存库禁止动态分配类型"
+	case errors.Is(re, RteMemorydbIndexCreateFailed):
+		desc = "内存库索引创建失败"
+	case errors.Is(re, RteWgMakeQueryReturnNull):
+		desc = "whitedb make_query_rc返回null"
+	case errors.Is(re, RteThtreadPoolCreatedFailed):
+		desc = "内存库创建线程池失败"
+	case errors.Is(re, RteMemorydbRemoveRecordFailed):
+		desc = "内存库删除记录失败"
+	case errors.Is(re, RteMemorydbConfigLoadFailed):
+		desc = "内存库配置文件加载失败"
+	case errors.Is(re, RteMemorydbProhibitDynamicAlloType):
+		desc = "内存库禁止动态分配类型"
+	case errors.Is(re, RteMemorydbDynamicAllocTypeFailed):
+		desc = "内存库动态分配类型失败"
+	case errors.Is(re, RteMemorydbStorageFileNameParseFailed):
+		desc = "内存库优先级文件名解析失败"
+	case errors.Is(re, RteMemorydbTtreeIndexDamage):
+		desc = "内存库T树索引损坏"
+	case errors.Is(re, RteMemorydbConfigFailed):
+		desc = "内存库配置文件错误"
+	case errors.Is(re, RteMemorydbValueCountNotMatch):
+		desc = "内存库记录的值个数不匹配。"
+	case errors.Is(re, RteMemorydbFieldTypeNotMatch):
+		desc = "内存库的字段类型不匹配"
+	case errors.Is(re, RteMemorydbMemoryAllocFailed):
+		desc = "内存库内存分配失败"
+	case errors.Is(re, RteMemorydbMethodParamErr):
+		desc = "内存库方法参数错误"
+	case errors.Is(re, RteMemorydbQueryResultAllocFailed):
+		desc = "内存库查询结果缓存分配失败"
+	case errors.Is(re, RteFilePathLength):
+		desc = "指定的文件路径长度错误"
+	case errors.Is(re, RteMemorydbFileVersionMatch):
+		desc = "内存库文件版本不匹配"
+	case errors.Is(re, RteMemorydbFileCrcError):
+		desc = "内存库文件CRC错误"
+	case errors.Is(re, RteMemorydbFileFlagMatch):
+		desc = "内存库文件标志错误"
+	case errors.Is(re, RteMemorydbInexistence):
+		desc = "存储库不存在"
+	case errors.Is(re, RteMemorydbLoadFailed):
+		desc = "存储库加载失败"
+	case errors.Is(re, RteNoDataInInterval):
+		desc = "指定的查询区间内没有数据。"
+	case errors.Is(re, RteCantLoadMemorydb):
+		desc = "不能与内存服务取得联系"
+	case errors.Is(re, RteQueryInWhitedb):
+		desc = "查询内存库过程中出现了错误，这是whitedb内部错误"
+	case errors.Is(re, RteNoDatabaseMemorydb):
+		desc = "没有找到指定数据类型所对应的分库"
+	case errors.Is(re, RteRecordNotGet):
+		desc = "从whitedb中获取记录失败"
+	case errors.Is(re, RteMemoryAllocErr):
+		desc = "内存库用于接收快照的缓冲区分配失败"
+	case errors.Is(re, RteEventCreateFailed):
+		desc = "用于内存库接收缓冲区的事件创建失败"
+	case errors.Is(re, RteGetPointFailed):
+		desc = "获取标签点失败"
+	case errors.Is(re, RteMemoryInitFailed):
+		desc = "内存库初始化失败"
+	case errors.Is(re, RteDatatypeNotMatch):
+		desc = "数据类型不匹配"
+	case errors.Is(re, RteGetFieldErr):
+		desc = "在whitedb获取记录的字段时出现了错误"
+	case errors.Is(re, RteMemorydbInternalErr):
+		desc = "whitedb内部未知错误"
+	case errors.Is(re, RteMemorydbRecordCreatedFailed):
+		desc = "内存库创建记录失败"
+	case errors.Is(re, RteParseNormalTypeSnapshotErr):
+		desc = "解析普通数据类型的快照失败"
+	case errors.Is(re, RteParseNamedTypeSnapshotErr):
+		desc = "解析自定义数据类型的快照失败"
+	case errors.Is(re, RteStringBlobTypeUnsupportCalcPoint):
+		desc = "string、blob类型不支持计算点"
+	case errors.Is(re, RteCoorTypeUnsupportCalcPoint):
+		desc = "坐标类型不支持计算点"
+	case errors.Is(re, RteIncludeHisData):
+		desc = "记录是历史数据，可能是无效过期的脏数据"
+	case errors.Is(re, RteThreadCreateErr):
+		desc = "线程创建失败"
+	case errors.Is(re, RteXmlCrcError):
+		desc = "xml文件crc校验失败"
+	case errors.Is(re, RteOversizeIntervals):
+		desc = "intervals >"
+	case errors.Is(re, RteDatetimesMustAscendingOrder):
+		desc = "时间必须按升序排序"
+	case errors.Is(re, RteCantLoadPerf):
+		desc = "不能同性能计数服务取得联系"
+	case errors.Is(re, RtePerfTagNotFound):
+		desc = "性能计数点不存在"
+	case errors.Is(re, RteWaitDataEmpty):
+		desc = "数据为空"
+	case errors.Is(re, RteWaitDataFull):
+		desc = "数据满了"
+	case errors.Is(re, RteDataTypeCountLess):
+		desc = "数据类型数量最小值"
+	case errors.Is(re, RteMemorydbCreateFailed):
+		desc = "内存库创建失败"
+	case errors.Is(re, RteMemorydbFieldEncodeFailed):
+		desc = "内存库字段编码失败"
+	case errors.Is(re, RteRecordCreateFailed):
+		desc = "内存库记录创建失败"
+	case errors.Is(re, RteRemoveRecordErr):
+		desc = "内存库记录删除失败"
+	case errors.Is(re, RteMemorydbFileOpenField):
+		desc = "内存库打开文件失败"
+	case errors.Is(re, RteMemorydbFileWriteFailed):
+		desc = "内存库写入文件失败"
+	case errors.Is(re, RteFilterWtihFloatAndEqual):
+		desc = "含有浮点数不等式中不能有"
+	case errors.Is(re, RteDispatchPluginNotExsit):
+		desc = "转发服务器插件不存在"
+	case errors.Is(re, RteDispatchPluginFileNotExsit):
+		desc = "转发服务器插件DLL文件不存在"
+	case errors.Is(re, RteDispatchPluginAlreadyExsit):
+		desc = "转发服务器插件已存在"
+	case errors.Is(re, RteDispatchRegisterPluginFailure):
+		desc = "插件注册失败"
+	case errors.Is(re, RteDispatchStartPluginFailure):
+		desc = "启动插件失败"
+	case errors.Is(re, RteDispatchStopPluginFailure):
+		desc = "停止插件失败"
+	case errors.Is(re, RteDispatchSetPluginEnableStatusFailure):
+		desc = "设置插件状态失败"
+	case errors.Is(re, RteDispatchGetPluginCountFailure):
+		desc = "获取插件个数信息失败"
+	case errors.Is(re, RteDispatchConfigfileNotExist):
+		desc = "转发服务配置文件不存在"
+	case errors.Is(re, RteDispatchConfigDataParseErr):
+		desc = "转发服务配置数据解析错误"
+	case errors.Is(re, RteDispatchPluginAlreadyRunning):
+		desc = "转发服务器插件已经运行"
+	case errors.Is(re, RteDispatchPluginCannotRun):
+		desc = "转发服务器插件禁止运行"
+	case errors.Is(re, RteDispatchPluginContainerUnrun):
+		desc = "转发服务器插件容器未运行"
+	case errors.Is(re, RteDispatchPluginInterfaceErr):
+		desc = "转发服务器插件接口未实现"
+	case errors.Is(re, RteDispatchPluginSaveConfigErr):
+		desc = "转发服务器保存配置文件出错"
+	case errors.Is(re, RteDispatchPluginStartErr):
+		desc = "转发服务器插件启动时失败"
+	case errors.Is(re, RteDispatchPluginStopErr):
+		desc = "转发服务器插件停止时失败"
+	case errors.Is(re, RteDispatchParseDataPageErr):
+		desc = "不支持的数据页类型"
+	case errors.Is(re, RteDispatchNotRun):
+		desc = "转发服务未启用"
+	case errors.Is(re, RteBigJobIsCanceledBecauseArcRoll):
+		desc = "因存档文件滚动，后台任务被取消"
+	case errors.Is(re, RtePerfForbiddenOperation):
+		desc = "禁止对性能表的操作"
+	case errors.Is(re, RteReduplicateTagInDestTable):
+		desc = "目标表中存在同名的标签点（用于标签点移动）"
+	case errors.Is(re, RteProtocolnotimpl):
+		desc = "用户请求的报文未实现"
+	case errors.Is(re, RteCrcerror):
+		desc = "报文CRC校验错误"
+	case errors.Is(re, RteWrongUserpw):
+		desc = "验证用户名密码失败"
+	case errors.Is(re, RteChangeUserpw):
+		desc = "修改用户名密码失败"
+	case errors.Is(re, RteInvalidHandle):
+		desc = "无效的句柄"
+	case errors.Is(re, RteInvalidSocketHandle):
+		desc = "无效的套接字句柄"
+	case errors.Is(re, RteFalse):
+		desc = "操作未成功完成，具体原因查看小错误码。"
+	case errors.Is(re, RteScanPointNotFound):
+		desc = "要求访问的采集标签点不存在或无效"
+	case errors.Is(re, RteCalcPointNotFound):
+		desc = "要求访问的计算标签点不存在或无效"
+	case errors.Is(re, RteReduplicateId):
+		desc = "重复的标签点标识"
+	case errors.Is(re, RteHandleSubscribed):
+		desc = "句柄已经被订阅"
+	case errors.Is(re, RteOtherSdkDoing):
+		desc = "另一个API正在执行"
+	case errors.Is(re, RteBatchEnd):
+		desc = "分段数据返回结束"
+	case errors.Is(re, RteAuthNotFound):
+		desc = "信任连接段不存在"
+	case errors.Is(re, RteAuthExist):
+		desc = "连接地址段已经位于信任列表中"
+	case errors.Is(re, RteAuthFull):
+		desc = "信任连接段已满"
+	case errors.Is(re, RteUserFull):
+		desc = "用户已满"
+	case errors.Is(re, RteVersionUnmatch):
+		desc = "报文或数据版本不匹配"
+	case errors.Is(re, RteInvalidPriv):
+		desc = "无效的权限"
+	case errors.Is(re, RteInvalidMask):
+		desc = "无效的子网掩码"
+	case errors.Is(re, RteInvalidUsername):
+		desc = "无效的用户名"
+	case errors.Is(re, RteInvalidMark):
+		desc = "无法识别的报文头标记"
+	case errors.Is(re, RteUnexpectedMethod):
+		desc = "意外的消息 ID"
+	case errors.Is(re, RteInvalidParamIndex):
+		desc = "无效的系统参数索引值"
+	case errors.Is(re, RteDecodePacketError):
+		desc = "解包错误"
+	case errors.Is(re, RteEncodePacketError):
+		desc = "编包错误"
+	case errors.Is(re, RteBlacklistFull):
+		desc = "阻止连接段已满"
+	case errors.Is(re, RteBlacklistExist):
+		desc = "连接地址段已经位于黑名单中"
+	case errors.Is(re, RteBlacklistNotFound):
+		desc = "阻止连接段不存在"
+	case errors.Is(re, RteInBlacklist):
+		desc = "连接地址位于黑名单中，被主动拒绝"
+	case errors.Is(re, RteIncreaseFileFailed):
+		desc = "试图增大文件失败"
+	case errors.Is(re, RteRpcInterfaceFailed):
+		desc = "远程过程接口调用失败"
+	case errors.Is(re, RteConnectionFull):
+		desc = "连接已满"
+	case errors.Is(re, RteOneClientConnectionFull):
+		desc = "连接已达到单个客户端允许连接数的最大值"
+	case errors.Is(re, RteServerClutterPoolNotEnough):
+		desc = "网络数据交换空间不足"
+	case errors.Is(re, RteEquationClutterPoolNotEnough):
+		desc = "实时方程式交换空间不足"
+	case errors.Is(re, RteNamedTypeNameLenError):
+		desc = "自定义类型的名称过长"
+	case errors.Is(re, RteNamedTypeLengthNotMatch):
+		desc = "数值长度与自定义类型的定义不符"
+	case errors.Is(re, RteCanNotUpdateSummary):
+		desc = "无法更新卫星数据"
+	case errors.Is(re, RteTooManyArvexFile):
+		desc = "附属文件太多，无法继续创建附属文件"
+	case errors.Is(re, RteNotSupportedFeature):
+		desc = "测试版本，暂时不支持此功能"
+	case errors.Is(re, RteEnsureError):
+		desc = "验证信息失败，详细信息请查看数据库日志"
+	case errors.Is(re, RteOperatorIsCancel):
+		desc = "操作被取消"
+	case errors.Is(re, RteMsgbodyRevError):
+		desc = "报文体接收失败"
+	case errors.Is(re, RteUncompressFailed):
+		desc = "解压缩失败"
+	case errors.Is(re, RteCompressFailed):
+		desc = "压缩失败"
+	case errors.Is(re, RteSubscribeError):
+		desc = "订阅失败，前一个订阅线程尚未退出"
+	case errors.Is(re, RteSubscribeCancelError):
+		desc = "取消订阅失败"
+	case errors.Is(re, RteSubscribeCallbackFailed):
+		desc = "订阅回掉函数中不能调用取消订阅、断开连接"
+	case errors.Is(re, RteSubscribeGreaterMaxCount):
+		desc = "超过单连接可订阅标签点数量"
+	case errors.Is(re, RteKillConnectionFailed):
+		desc = "断开连接失败，无法断开自身连接"
+	case errors.Is(re, RteSubscribeNotMatch):
+		desc = "请求的方法与当前的订阅不匹配"
+	case errors.Is(re, RteNoSubscribe):
+		desc = "连接还未发起订阅，或者标签点还未订阅"
+	case errors.Is(re, RteAlreadySubscribe):
+		desc = "标签点已经被订阅"
+	case errors.Is(re, RteCalcPointUnsupportedWriteData):
+		desc = "计算点不支持写入数据"
+	case errors.Is(re, RteFeatureDeprecated):
+		desc = "不再支持此功能"
+	case errors.Is(re, RteInvalidValue):
+		desc = "无效的数据"
+	case errors.Is(re, RteVerifyVercodeFailed):
+		desc = "验证授权码失败"
+	case errors.Is(re, RteInvalidPageSize):
+		desc = "无效的数据页的大小"
+	case errors.Is(re, RteInvalidPrecision):
+		desc = "无效的时间戳精度"
+	case errors.Is(re, RteInvalidPageVersion):
+		desc = "无效的数据页版本"
+	case errors.Is(re, RtePageIsFull):
+		desc = "数据页已满"
+	case errors.Is(re, RtePageNotLoaded):
+		desc = "还未加载数据页"
+	case errors.Is(re, RtePageAlreadyLoaded):
+		desc = "已经加载了数据页"
+	case errors.Is(re, RtePageTooSmall):
+		desc = "数据页太小，有效空间小于数据长度"
+	case errors.Is(re, RtePageNoEnoughData):
+		desc = "数据页中没有足够的数据"
+	case errors.Is(re, RtePageInsertFailed):
+		desc = "数据页插入数据失败"
+	case errors.Is(re, RtePageNoEnoughSpace):
+		desc = "数据页没有足够的空间"
+	case errors.Is(re, RteModifingMetaData):
+		desc = "正在修改元数据，请稍后再试"
+	case errors.Is(re, RtePageSizeNotMatch):
+		desc = "数据页大小不匹配"
+	case errors.Is(re, RteSyncBegin):
+		desc = "元数据同步错误码起始值"
+	case errors.Is(re, RteSyncInvalidConfig):
+		desc = "元数据同步-无效的配置"
+	case errors.Is(re, RteSyncInvalidVersion):
+		desc = "元数据同步-无效的版本号"
+	case errors.Is(re, RteSyncConfirmExpired):
+		desc = "元数据同步-等待确认信息过期"
+	case errors.Is(re, RteSyncTooManyFwdinfo):
+		desc = "元数据同步-转发信息过多"
+	case errors.Is(re, RteSyncNotMaster):
+		desc = "元数据同步-不是主库"
+	case errors.Is(re, RteSyncSyncing):
+		desc = "元数据同步-正在同步"
+	case errors.Is(re, RteSyncUnsynced):
+		desc = "元数据同步-未同步"
+	case errors.Is(re, RteSyncTablePosConflict):
+		desc = "元数据同步-表位置冲突"
+	case errors.Is(re, RteSyncInvalidPointId):
+		desc = "元数据同步-无效的标签点ID"
+	case errors.Is(re, RteSyncInvalidTableId):
+		desc = "元数据同步-无效的表ID"
+	case errors.Is(re, RteSyncInvalidNamedTypeId):
+		desc = "元数据同步-无效的自定义类型ID"
+	case errors.Is(re, RteSyncRestoring):
+		desc = "元数据同步-正在重建元数据"
+	case errors.Is(re, RteSyncServerIsNotRunning):
+		desc = "元数据同步-网络服务不是运行状态"
+	case errors.Is(re, RteSyncWriteWalFailed):
+		desc = "元数据同步-写WAL失败"
+	case errors.Is(re, RteSyncEnd):
+		desc = "元数据同步错误码结束值"
+	case errors.Is(re, RteNetError):
+		desc = "网络错误的起始值"
+	case errors.Is(re, RteSockWsaeintr):
+		desc = "（阻塞）调用被 WSACancelBlockingCall() 函数取消"
+	case errors.Is(re, RteSockWsaeacces):
+		desc = "请求地址是广播地址，但是相应的 flags 没设置"
+	case errors.Is(re, RteSockWsaefault):
+		desc = "非法内存访问"
+	case errors.Is(re, RteSockWsaemfile):
+		desc = "无多余的描述符可用"
+	case errors.Is(re, RteSockWsaewouldblock):
+		desc = "套接字被标识为非阻塞，但操作将被阻塞"
+	case errors.Is(re, RteSockWsaeinprogress):
+		desc = "一个阻塞的 Windows Sockets 操作正在进行"
+	case errors.Is(re, RteSockWsaealready):
+		desc = "一个非阻塞的 connect() 调用已经在指定的套接字上进行"
+	case errors.Is(re, RteSockWsaenotsock):
+		desc = "描述符不是套接字描述符"
+	case errors.Is(re, RteSockWsaedestaddrreq):
+		desc = "要求（未指定）目的地址"
+	case errors.Is(re, RteSockWsaemsgsize):
+		desc = "套接字为基于消息的，消息太大（大于底层传输支持的最大值）"
+	case errors.Is(re, RteSockWsaeprototype):
+		desc = "对此套接字来说，指定协议是错误的类型"
+	case errors.Is(re, RteSockWsaeprotonosupport):
+		desc = "不支持指定协议"
+	case errors.Is(re, RteSockWsaesocktnosupport):
+		desc = "在此地址族中不支持指定套接字类型"
+	case errors.Is(re, RteSockWsaeopnotsupp):
+		desc = "MSG_OOB 被指定，但是套接字不是流风格的"
+	case errors.Is(re, RteSockWsaeafnosupport):
+		desc = "不支持指定的地址族"
+	case errors.Is(re, RteSockWsaeaddrinuse):
+		desc = "套接字的本地地址已被使用"
+	case errors.Is(re, RteSockWsaeaddrnotavail):
+		desc = "远程地址非法"
+	case errors.Is(re, RteSockWsaenetdown):
+		desc = "Windows Sockets 检测到网络系统已经失效"
+	case errors.Is(re, RteSockWsaenetunreach):
+		desc = "网络无法到达主机"
+	case errors.Is(re, RteSockWsaenetreset):
+		desc = "在操作进行时 keep-alive 活动检测到一个失败，连接被中断"
+	case errors.Is(re, RteSockWsaeconnaborted):
+		desc = "连接因超时或其他失败而中断"
+	case errors.Is(re, RteSockWsaeconnreset):
+		desc = "连接被复位"
+	case errors.Is(re, RteSockWsaenobufs):
+		desc = "无缓冲区空间可用"
+	case errors.Is(re, RteSockWsaeisconn):
+		desc = "连接已建立"
+	case errors.Is(re, RteSockWsaenotconn):
+		desc = "套接字未建立连接"
+	case errors.Is(re, RteSockWsaeshutdown):
+		desc = "套接字已 shutdown，连接已断开"
+	case errors.Is(re, RteSockWsaetimedout):
+		desc = "连接请求超时，未能建立连接"
+	case errors.Is(re, RteSockWsaeconnrefused):
+		desc = "连接被拒绝"
+	case errors.Is(re, RteSockWsaeclose):
+		desc = "连接被关闭"
+	case errors.Is(re, RteSockWsanotinitialised):
+		desc = "Windows Sockets DLL 未初始化"
+	case errors.Is(re, RteCErrnoError):
+		desc = "C语言errno错误的起始值"
+	case errors.Is(re, RteCErrnoEperm):
+		desc = "Operation not permitted"
+	case errors.Is(re, RteCErrnoEnoent):
+		desc = "No such file or directory"
+	case errors.Is(re, RteCErrnoEsrch):
+		desc = "No such process"
+	case errors.Is(re, RteCErrnoEintr):
+		desc = "Interrupted system call"
+	case errors.Is(re, RteCErrnoEio):
+		desc = "I/O error"
+	case errors.Is(re, RteCErrnoEnxio):
+		desc = "No such device or address"
+	case errors.Is(re, RteCErrnoE2big):
+		desc = "Argument list too long"
+	case errors.Is(re, RteCErrnoEnoexec):
+		desc = "Exec format error"
+	case errors.Is(re, RteCErrnoEbadf):
+		desc = "Bad file number"
+	case errors.Is(re, RteCErrnoEchild):
+		desc = "No child processes"
+	case errors.Is(re, RteCErrnoEagain):
+		desc = "Try again"
+	case errors.Is(re, RteCErrnoEnomem):
+		desc = "Out of memory"
+	case errors.Is(re, RteCErrnoEacces):
+		desc = "Permission denied"
+	case errors.Is(re, RteCErrnoEfault):
+		desc = "Bad address"
+	case errors.Is(re, RteCErrnoEnotblk):
+		desc = "Block device required"
+	case errors.Is(re, RteCErrnoEbusy):
+		desc = "Device or resource busy"
+	case errors.Is(re, RteCErrnoEexist):
+		desc = "File exists"
+	case errors.Is(re, RteCErrnoExdev):
+		desc = "Cross-device link"
+	case errors.Is(re, RteCErrnoEnodev):
+		desc = "No such device"
+	case errors.Is(re, RteCErrnoEnotdir):
+		desc = "Not a directory"
+	case errors.Is(re, RteCErrnoEisdir):
+		desc = "Is a directory"
+	case errors.Is(re, RteCErrnoEinval):
+		desc = "Invalid argument"
+	case errors.Is(re, RteCErrnoEnfile):
+		desc = "File table overflow"
+	case errors.Is(re, RteCErrnoEmfile):
+		desc = "Too many open files"
+	case errors.Is(re, RteCErrnoEnotty):
+		desc = "Not a typewriter"
+	case errors.Is(re, RteCErrnoEtxtbsy):
+		desc = "Text file busy"
+	case errors.Is(re, RteCErrnoEfbig):
+		desc = "File too large"
+	case errors.Is(re, RteCErrnoEnospc):
+		desc = "No space left on device"
+	case errors.Is(re, RteCErrnoEspipe):
+		desc = "Illegal seek"
+	case errors.Is(re, RteCErrnoErofs):
+		desc = "Read-only file system"
+	case errors.Is(re, RteCErrnoEmlink):
+		desc = "Too many links"
+	case errors.Is(re, RteCErrnoEpipe):
+		desc = "Broken pipe"
+	case errors.Is(re, RteCErrnoEdom):
+		desc = "Math argument out of domain of func"
+	case errors.Is(re, RteCErrnoErange):
+		desc = "Math result not representable"
+	case errors.Is(re, RteCErrnoEdeadlk):
+		desc = "Resource deadlock would occur"
+	case errors.Is(re, RteCErrnoEnametoolong):
+		desc = "File name too long"
+	case errors.Is(re, RteCErrnoEnolck):
+		desc = "No record locks available"
+	case errors.Is(re, RteCErrnoEnosys):
+		desc = "Function not implemented"
+	case errors.Is(re, RteCErrnoEnotempty):
+		desc = "Directory not empty"
+	case errors.Is(re, RteCErrnoEloop):
+		desc = "Too many symbolic links encountered"
+	case errors.Is(re, RteCErrnoEnomsg):
+		desc = "No message of desired type"
+	case errors.Is(re, RteCErrnoEidrm):
+		desc = "Identifier removed"
+	case errors.Is(re, RteCErrnoEchrng):
+		desc = "Channel number out of range"
+	case errors.Is(re, RteCErrnoEl2nsync):
+		desc = "Level 2 not synchronized"
+	case errors.Is(re, RteCErrnoEl3hlt):
+		desc = "Level 3 halted"
+	case errors.Is(re, RteCErrnoEl3rst):
+		desc = "Level 3 reset"
+	case errors.Is(re, RteCErrnoElnrng):
+		desc = "Link number out of range"
+	case errors.Is(re, RteCErrnoEunatch):
+		desc = "Protocol driver not attached"
+	case errors.Is(re, RteCErrnoEnocsi):
+		desc = "No CSI structure available"
+	case errors.Is(re, RteCErrnoEl2hlt):
+		desc = "Level 2 halted"
+	case errors.Is(re, RteCErrnoEbade):
+		desc = "Invalid exchange"
+	case errors.Is(re, RteCErrnoEbadr):
+		desc = "Invalid request descriptor"
+	case errors.Is(re, RteCErrnoExfull):
+		desc = "Exchange full"
+	case errors.Is(re, RteCErrnoEnoano):
+		desc = "No anode"
+	case errors.Is(re, RteCErrnoEbadrqc):
+		desc = "Invalid request code"
+	case errors.Is(re, RteCErrnoEbadslt):
+		desc = "Invalid slot"
+	case errors.Is(re, RteCErrnoEbfont):
+		desc = "Bad font file format"
+	case errors.Is(re, RteCErrnoEnostr):
+		desc = "Device not a stream"
+	case errors.Is(re, RteCErrnoEnodata):
+		desc = "No data available"
+	case errors.Is(re, RteCErrnoEtime):
+		desc = "Timer expired"
+	case errors.Is(re, RteCErrnoEnosr):
+		desc = "Out of streams resources"
+	case errors.Is(re, RteCErrnoEnonet):
+		desc = "Machine is not on the network"
+	case errors.Is(re, RteCErrnoEnopkg):
+		desc = "Package not installed"
+	case errors.Is(re, RteCErrnoEremote):
+		desc = "Object is remote"
+	case errors.Is(re, RteCErrnoEnolink):
+		desc = "Link has been severed"
+	case errors.Is(re, RteCErrnoEadv):
+		desc = "Advertise error"
+	case errors.Is(re, RteCErrnoEsrmnt):
+		desc = "Srmount error"
+	case errors.Is(re, RteCErrnoEcomm):
+		desc = "Communication error on send"
+	case errors.Is(re, RteCErrnoEproto):
+		desc = "Protocol error"
+	case errors.Is(re, RteCErrnoEmultihop):
+		desc = "Multihop attempted"
+	case errors.Is(re, RteCErrnoEdotdot):
+		desc = "RFS specific error"
+	case errors.Is(re, RteCErrnoEbadmsg):
+		desc = "Not a data message"
+	case errors.Is(re, RteCErrnoEoverflow):
+		desc = "Value too large for defined data type"
+	case errors.Is(re, RteCErrnoEnotuniq):
+		desc = "Name not unique on network"
+	case errors.Is(re, RteCErrnoEbadfd):
+		desc = "File descriptor in bad state"
+	case errors.Is(re, RteCErrnoEremchg):
+		desc = "Remote address changed"
+	case errors.Is(re, RteCErrnoElibacc):
+		desc = "Can not access a needed shared library"
+	case errors.Is(re, RteCErrnoElibbad):
+		desc = "Accessing a corrupted shared library"
+	case errors.Is(re, RteCErrnoElibscn):
+		desc = ".lib section in a.out corrupted"
+	case errors.Is(re, RteCErrnoElibmax):
+		desc = "Attempting to link in too many shared libraries"
+	case errors.Is(re, RteCErrnoElibexec):
+		desc = "Cannot exec a shared library directly"
+	case errors.Is(re, RteCErrnoEilseq):
+		desc = "Illegal byte sequence"
+	case errors.Is(re, RteCErrnoErestart):
+		desc = "Interrupted system call should be restarted"
+	case errors.Is(re, RteCErrnoEstrpipe):
+		desc = "Streams pipe error"
+	case errors.Is(re, RteCErrnoEusers):
+		desc = "Too many users"
+	case errors.Is(re, RteCErrnoEnotsock):
+		desc = "Socket operation on non-socket"
+	case errors.Is(re, RteCErrnoEdestaddrreq):
+		desc = "Destination address required"
+	case errors.Is(re, RteCErrnoEmsgsize):
+		desc = "Message too long"
+	case errors.Is(re, RteCErrnoEprototype):
+		desc = "Protocol wrong type for socket"
+	case errors.Is(re, RteCErrnoEnoprotoopt):
+		desc = "Protocol not available"
+	case errors.Is(re, RteCErrnoEprotonosupport):
+		desc = "Protocol not supported"
+	case errors.Is(re, RteCErrnoEsocktnosupport):
+		desc = "Socket type not supported"
+	case errors.Is(re, RteCErrnoEopnotsupp):
+		desc = "Operation not supported on transport endpoint"
+	case errors.Is(re, RteCErrnoEpfnosupport):
+		desc = "Protocol family not supported"
+	case errors.Is(re, RteCErrnoEafnosupport):
+		desc = "Address family not supported by protocol"
+	case errors.Is(re, RteCErrnoEaddrinuse):
+		desc = "Address already in use"
+	case errors.Is(re, RteCErrnoEaddrnotavail):
+		desc = "Cannot assign requested address"
+	case errors.Is(re, RteCErrnoEnetdown):
+		desc = "Network is down"
+	case errors.Is(re, RteCErrnoEnetunreach):
+		desc = "Network is unreachable"
+	case errors.Is(re, RteCErrnoEnetreset):
+		desc = "Network dropped connection because of reset"
+	case errors.Is(re, RteCErrnoEconnaborted):
+		desc = "Software caused connection abort"
+	case errors.Is(re, RteCErrnoEconnreset):
+		desc = "Connection reset by peer"
+	case errors.Is(re, RteCErrnoEnobufs):
+		desc = "No buffer space available"
+	case errors.Is(re, RteCErrnoEisconn):
+		desc = "Transport endpoint is already connected"
+	case errors.Is(re, RteCErrnoEnotconn):
+		desc = "Transport endpoint is not connected"
+	case errors.Is(re, RteCErrnoEshutdown):
+		desc = "Cannot send after transport endpoint shutdown"
+	case errors.Is(re, RteCErrnoEtoomanyrefs):
+		desc = "Too many references: cannot splice"
+	case errors.Is(re, RteCErrnoEtimedout):
+		desc = "Connection timed out"
+	case errors.Is(re, RteCErrnoEconnrefused):
+		desc = "Connection refused"
+	case errors.Is(re, RteCErrnoEhostdown):
+		desc = "Host is down"
+	case errors.Is(re, RteCErrnoEhostunreach):
+		desc = "No route to host"
+	case errors.Is(re, RteCErrnoEalready):
+		desc = "Operation already in progress"
+	case errors.Is(re, RteCErrnoEinprogress):
+		desc = "Operation now in progress"
+	case errors.Is(re, RteCErrnoEstale):
+		desc = "Stale file handle"
+	case errors.Is(re, RteCErrnoEuclean):
+		desc = "Structure needs cleaning"
+	case errors.Is(re, RteCErrnoEnotnam):
+		desc = "Not a XENIX named type file"
+	case errors.Is(re, RteCErrnoEnavail):
+		desc = "No XENIX semaphores available"
+	case errors.Is(re, RteCErrnoEisnam):
+		desc = "Is a named type file"
+	case errors.Is(re, RteCErrnoEremoteio):
+		desc = "Remote I/O error"
+	case errors.Is(re, RteCErrnoEdquot):
+		desc = "Quota exceeded"
+	case errors.Is(re, RteCErrnoEnomedium):
+		desc = "No medium found"
+	case errors.Is(re, RteCErrnoEmediumtype):
+		desc = "Wrong medium type"
+	case errors.Is(re, RteCErrnoEcanceled):
+		desc = "Operation Canceled"
+	case errors.Is(re, RteCErrnoEnokey):
+		desc = "Required key not available"
+	case errors.Is(re, RteCErrnoEkeyexpired):
+		desc = "Key has expired"
+	case errors.Is(re, RteCErrnoEkeyrevoked):
+		desc = "Key has been revoked"
+	case errors.Is(re, RteCErrnoEkeyrejected):
+		desc = "Key was rejected by service"
+	case errors.Is(re, RteCErrnoEownerdead):
+		desc = "Owner died"
+	case errors.Is(re, RteCErrnoEnotrecoverable):
+		desc = "State not recoverable"
+	case errors.Is(re, RteCErrnoErfkill):
+		desc = "Operation not possible due to RF-kill"
+	case errors.Is(re, RteCErrnoEhwpoison):
+		desc = "Memory page has hardware error"
+	case errors.Is(re, RteIpcError):
+		desc = "ipc error begin"
+	case errors.Is(re, RteIpcErrorEnd):
+		desc = "ipc error end"
+	default:
+		desc = "未知错误"
+	}
+	return desc
+}
+
+// GoError 将RtdbError转换成 error
+func (re RtdbError) GoError() error {
+	if re.IsOk() {
+		return nil
+	} else {
+		return re
+	}
+}
+
+func RteIsOk(rte RtdbError) bool {
+	return errors.Is(rte, RteOk)
+}
+
+const (
+	// RteUnknownError  未知错误
+	RteUnknownError = RtdbError(0xFFFFFFFF)
+
+	// RteOk  操作成功完成
+	RteOk = RtdbError(0x00000000)
+
+	// RteWindowsError  Windows操作系统错误的起始值
+	RteWindowsError = RtdbError(0xFFF00000)
+
+	// RteWindowsErrorMax  Windows操作系统错误的结束值
+	RteWindowsErrorMax = RtdbError(0xFFFF0000)
+
+	// RteInvalidOpenmode  无效的文件打开方式
+	RteInvalidOpenmode = RtdbError(0xFFFF0001)
+
+	// RteOpenfileFailed  打开文件失败
+	RteOpenfileFailed = RtdbError(0xFFFF0002)
+
+	// RteMovetoendFailed  移动文件指针到文件尾失败
+	RteMovetoendFailed = RtdbError(0xFFFF0003)
+
+	// RteDifferReadbytes  读取内容长度与要求不符
+	RteDifferReadbytes = RtdbError(0xFFFF0004)
+
+	// RteGetfileposFailed  获取当前文件指针失败
+	RteGetfileposFailed = RtdbError(0xFFFF0005)
+
+	// RteFlushfileFailed  刷新文件缓冲区失败
+	RteFlushfileFailed = RtdbError(0xFFFF0006)
+
+	// RteSetsizeFailed  设置文件大小失败
+	RteSetsizeFailed = RtdbError(0xFFFF0007)
+
+	// RteFileNotClosed  试图用未关闭的文件对象创建或打开文件
+	RteFileNotClosed = RtdbError(0xFFFF0008)
+
+	// RteFileUnknown  创建或打开文件时必须指定文件名
+	RteFileUnknown = RtdbError(0xFFFF0009)
+
+	// RteInvalidHeader  数据文件头信息错误
+	RteInvalidHeader = RtdbError(0xFFFF000A)
+
+	// RteDisabledFile  数据文件无效，试图访问无效数据文件
+	RteDisabledFile = RtdbError(0xFFFF000B)
+
+	// RteFileNotOpened  试图访问尚未打开的数据文件
+	RteFileNotOpened = RtdbError(0xFFFF000C)
+
+	// RtePointNotFound  要求访问的标签点不存在或无效
+	RtePointNotFound = RtdbError(0xFFFF000D)
+
+	// RteReadyblockNotFound  数据文件中找不到从指定数据块以后的可用的空块
+	RteReadyblockNotFound = RtdbError(0xFFFF000E)
+
+	// RteFileIsIncult  文件未被使用过
+	RteFileIsIncult = RtdbError(0xFFFF000F)
+
+	// RteFileIsFull  数据文件已满
+	RteFileIsFull = RtdbError(0xFFFF0010)
+
+	// RteFileexIsFull  数据文件扩展区已满，无法继续装载数据
+	RteFileexIsFull = RtdbError(0xFFFF0011)
+
+	// RteInvalidDataType  无效的数据类型
+	RteInvalidDataType = RtdbError(0xFFFF0012)
+
+	// RteDatablockNotFound  找不到符合时间条件的数据块
+	RteDatablockNotFound = RtdbError(0xFFFF0013)
+
+	// RteDataBetweenBlock  数据时间位于找到的块和下一个数据块之间
+	RteDataBetweenBlock = RtdbError(0xFFFF0014)
+
+	// RteCantModifyExistValue  不允许修改已存在的数据
+	RteCantModifyExistValue = RtdbError(0xFFFF0015)
+
+	// RteWrongdataInBlock  块中有错误数据导致数据块头信息不符
+	RteWrongdataInBlock = RtdbError(0xFFFF0016)
+
+	// RteDatatimeNotIn  数据文件中没有该标签点指定时间的数据
+	RteDatatimeNotIn = RtdbError(0xFFFF0017)
+
+	// RteNullArchivePath  操作的数据文件路径为空
+	RteNullArchivePath = RtdbError(0xFFFF0018)
+
+	// RteRegArchivePath  数据文件已被注册
+	RteRegArchivePath = RtdbError(0xFFFF0019)
+
+	// RteUnregArchivePath  未注册的数据文件
+	RteUnregArchivePath = RtdbError(0xFFFF001A)
+
+	// RteFileInexistence  指定的文件不存在
+	RteFileInexistence = RtdbError(0xFFFF001B)
+
+	// RteDataTypeNotMatch  数据类型不匹配
+	RteDataTypeNotMatch = RtdbError(0xFFFF001C)
+
+	// RteFileIsReadonly  不允许修改只读数据文件中的数据
+	RteFileIsReadonly = RtdbError(0xFFFF001D)
+
+	// RteTomanyArchiveFile  过多的数据文件
+	RteTomanyArchiveFile = RtdbError(0xFFFF001E)
+
+	// RteNoPointsList  缺少标签点列表
+	RteNoPointsList = RtdbError(0xFFFF001F)
+
+	// RteNoActivedArchive  缺少活动文档
+	RteNoActivedArchive = RtdbError(0xFFFF0020)
+
+	// RteNoArchiveFile  缺少数据文档
+	RteNoArchiveFile = RtdbError(0xFFFF0021)
+
+	// RteNeedActivedArchive  只能在活动文档上执行该操作
+	RteNeedActivedArchive = RtdbError(0xFFFF0022)
+
+	// RteInvalidTimestamp  无效的时间戳
+	RteInvalidTimestamp = RtdbError(0xFFFF0023)
+
+	// RteNeedMoreWritable  非只读文档个数太少
+	RteNeedMoreWritable = RtdbError(0xFFFF0024)
+
+	// RteNoArchiveForPut  找不到合适的追加历史数据的文档
+	RteNoArchiveForPut = RtdbError(0xFFFF0025)
+
+	// RteInvalidValueMode  无效的取值模式
+	RteInvalidValueMode = RtdbError(0xFFFF0026)
+
+	// RteDataNotFound  找不到需要的数据
+	RteDataNotFound = RtdbError(0xFFFF0027)
+
+	// RteInvalidParameter  无效的参数
+	RteInvalidParameter = RtdbError(0xFFFF0028)
+
+	// RteReduplicateTag  重复的标签点名
+	RteReduplicateTag = RtdbError(0xFFFF0029)
+
+	// RteReduplicateTabname  重复的表名称
+	RteReduplicateTabname = RtdbError(0xFFFF002A)
+
+	// RteReduplicateTabid  重复的表ID
+	RteReduplicateTabid = RtdbError(0xFFFF002B)
+
+	// RteTableNotFound  指定的表不存在
+	RteTableNotFound = RtdbError(0xFFFF002C)
+
+	// RteUnsupportedClassof  不支持的标签点类别
+	RteUnsupportedClassof = RtdbError(0xFFFF002D)
+
+	// RteWrongOrDuplicTag  错误的或重复的标签点名
+	RteWrongOrDuplicTag = RtdbError(0xFFFF002E)
+
+	// RteReduplicatePt  重复的标签点标识
+	RteReduplicatePt = RtdbError(0xFFFF002F)
+
+	// RtePointLicenseFull  标签点数超出了许可证规定的最大数目
+	RtePointLicenseFull = RtdbError(0xFFFF0030)
+
+	// RteTableLicenseFull  标签点表个数超出了许可证规定的最大数目
+	RteTableLicenseFull = RtdbError(0xFFFF0031)
+
+	// RteWrongOrDuplicTabname  错误的或重复的表名称
+	RteWrongOrDuplicTabname = RtdbError(0xFFFF0032)
+
+	// RteInvalidFileFormat  无效的数据文件格式
+	RteInvalidFileFormat = RtdbError(0xFFFF0033)
+
+	// RteWrongTabname  错误的表名称
+	RteWrongTabname = RtdbError(0xFFFF0034)
+
+	// RteWrongTag  错误的标签点名
+	RteWrongTag = RtdbError(0xFFFF0035)
+
+	// RteNotInScope  数值超出了应属的范围
+	RteNotInScope = RtdbError(0xFFFF0036)
+
+	// RteCantLoadBase  不能同标签点信息服务取得联系
+	RteCantLoadBase = RtdbError(0xFFFF0037)
+
+	// RteCantLoadSnapshot  不能同快照数据服务取得联系
+	RteCantLoadSnapshot = RtdbError(0xFFFF0038)
+
+	// RteCantLoadHistory  不能同历史数据服务取得联系
+	RteCantLoadHistory = RtdbError(0xFFFF0039)
+
+	// RteCantLoadEquation  不能同实施方程式服务取得联系
+	RteCantLoadEquation = RtdbError(0xFFFF003A)
+
+	// RteArraySizeNotMatch  数组尺寸不匹配
+	RteArraySizeNotMatch = RtdbError(0xFFFF003B)
+
+	// RteInvalidHostAddress  无效的主机地址
+	RteInvalidHostAddress = RtdbError(0xFFFF003C)
+
+	// RteConnectFalse  连接已断开
+	RteConnectFalse = RtdbError(0xFFFF003D)
+
+	// RteToomanyBytesRecved  接收到的数据长度超出了指定字节长度
+	RteToomanyBytesRecved = RtdbError(0xFFFF003E)
+
+	// RteReqidRespidNotMatch  应答与请求的ID不一致
+	RteReqidRespidNotMatch = RtdbError(0xFFFF003F)
+
+	// RteLessBytesRecved  接收到的数据长度小于指定字节长度
+	RteLessBytesRecved = RtdbError(0xFFFF0040)
+
+	// RteUnsupportedCalcMode  不支持的计算模式
+	RteUnsupportedCalcMode = RtdbError(0xFFFF0041)
+
+	// RteUnsupportedDataType  不支持的标签点类型
+	RteUnsupportedDataType = RtdbError(0xFFFF0042)
+
+	// RteInvalidExpression  无效的表达式
+	RteInvalidExpression = RtdbError(0xFFFF0043)
+
+	// RteIncondDataNotFound  找不到符合条件的数据
+	RteIncondDataNotFound = RtdbError(0xFFFF0044)
+
+	// RteValidDataNotFound  找不到需要的有效数据
+	RteValidDataNotFound = RtdbError(0xFFFF0045)
+
+	// RteValueOrStateIsNan  数据或状态不正常，为NAN
+	RteValueOrStateIsNan = RtdbError(0xFFFF0046)
+
+	// RteCreateMutexFailed  创建互斥对象失败
+	RteCreateMutexFailed = RtdbError(0xFFFF0047)
+
+	// RteTlsallocfail  处理TLS时调用系统函数LocalAlloc()失败，可能因为内存不足导致
+	RteTlsallocfail = RtdbError(0xFFFF0048)
+
+	// RteToManyPoints  正在调用的API函数不支持过多的标签点数量，请参考函数声明和开发手册
+	RteToManyPoints = RtdbError(0xFFFF0049)
+
+	// RteLicInfoError  获取授权许可协议信息时发生错误
+	RteLicInfoError = RtdbError(0xFFFF004A)
+
+	// RteArchiveBufferFull  标签点的历史数据补写缓冲区已满，请稍后再补
+	RteArchiveBufferFull = RtdbError(0xFFFF004B)
+
+	// RteUserNotExist  用户不存在
+	RteUserNotExist = RtdbError(0xFFFF004C)
+
+	// RteUserIsLocked  帐户被锁定,需要管理员为您解锁
+	RteUserIsLocked = RtdbError(0xFFFF004D)
+
+	// RteWrongPassword  错误的口令
+	RteWrongPassword = RtdbError(0xFFFF004E)
+
+	// RteAccessIsDenied  访问被拒绝，请确定是否具有足够的权限
+	RteAccessIsDenied = RtdbError(0xFFFF004F)
+
+	// RteHaveNotLogin  您尚未登录，请先登录
+	RteHaveNotLogin = RtdbError(0xFFFF0050)
+
+	// RteUserIsDeleted  帐户已被删除
+	RteUserIsDeleted = RtdbError(0xFFFF0051)
+
+	// RteUserAlreadyExist  帐户已存在
+	RteUserAlreadyExist = RtdbError(0xFFFF0052)
+
+	// RteWrongCreateTabname  创建删除表失败
+	RteWrongCreateTabname = RtdbError(0xFFFF0053)
+
+	// RteWrongFieldValue  标签点属性值有错误
+	RteWrongFieldValue = RtdbError(0xFFFF0054)
+
+	// RteInvalidTagId  无效的标签点ID
+	RteInvalidTagId = RtdbError(0xFFFF0055)
+
+	// RteCheckNamedTypeNameError  无效的自定义类型名称或字段名称
+	RteCheckNamedTypeNameError = RtdbError(0xFFFF0056)
+
+	// RteCantLoadDispatch  不能同转发服务器取得联系
+	RteCantLoadDispatch = RtdbError(0xFFFF0057)
+
+	// RteConnectTimeOut  连接已超时，需要重新登录
+	RteConnectTimeOut = RtdbError(0xFFFF0058)
+
+	// RteWrongLogin4  账户信息验证失败，还有4次尝试机会
+	RteWrongLogin4 = RtdbError(0xFFFF0059)
+
+	// RteWrongLogin3  账户信息验证失败，还有3次尝试机会
+	RteWrongLogin3 = RtdbError(0xFFFF005A)
+
+	// RteWrongLogin2  账户信息验证失败，还有2次尝试机会
+	RteWrongLogin2 = RtdbError(0xFFFF005B)
+
+	// RteWrongLogin1  账户信息验证失败，还有1次尝试机会
+	RteWrongLogin1 = RtdbError(0xFFFF005C)
+
+	// RteWrongDesc  错误的表描述
+	RteWrongDesc = RtdbError(0xFFFF005D)
+
+	// RteWrongUnit  错误的工程单位
+	RteWrongUnit = RtdbError(0xFFFF005E)
+
+	// RteWrongChanger  错误的最后一次被修改的用户名
+	RteWrongChanger = RtdbError(0xFFFF005F)
+
+	// RteWrongCreator  错误的标签点创建者用户名
+	RteWrongCreator = RtdbError(0xFFFF0060)
+
+	// RteWrongFull  错误的标签点全名
+	RteWrongFull = RtdbError(0xFFFF0061)
+
+	// RteWrongSource  错误的数据源
+	RteWrongSource = RtdbError(0xFFFF0062)
+
+	// RteWrongInstrument  错误的设备标签
+	RteWrongInstrument = RtdbError(0xFFFF0063)
+
+	// RteWrongUser  错误的创建者
+	RteWrongUser = RtdbError(0xFFFF0064)
+
+	// RteWrongEquation  错误的实时方程式
+	RteWrongEquation = RtdbError(0xFFFF0065)
+
+	// RteWrongTypeName  错误的自定义类型名称
+	RteWrongTypeName = RtdbError(0xFFFF0066)
+
+	// RteWrongEncode  编码转换时出错
+	RteWrongEncode = RtdbError(0xFFFF0067)
+
+	// RteWrongOthermask  错误的搜索类型转换mask值
+	RteWrongOthermask = RtdbError(0xFFFF0068)
+
+	// RteWrongType  错误的搜索类型
+	RteWrongType = RtdbError(0xFFFF0069)
+
+	// RtePointHardwareLimited  由于硬件资源限制，创建或恢复标签点失败
+	RtePointHardwareLimited = RtdbError(0xFFFF0070)
+
+	// RteWaitingRecoverData  正在等待恢复数据完成，请稍后尝试连接
+	RteWaitingRecoverData = RtdbError(0xFFFF0071)
+
+	// RteReplicationLicMismatch  双活数据库授权不一致
+	RteReplicationLicMismatch = RtdbError(0xFFFF0072)
+
+	// RteReadConfigFailed  读取配置文件失败
+	RteReadConfigFailed = RtdbError(0xFFFF0073)
+
+	// RteUpdateConfigFailed  更新配置文件失败
+	RteUpdateConfigFailed = RtdbError(0xFFFF0074)
+
+	// RteFilterTooLong  filter超过最大长度
+	RteFilterTooLong = RtdbError(0xFFFF0075)
+
+	// RteGetArchiveNameFail  获取存档文件名失败
+	RteGetArchiveNameFail = RtdbError(0xFFFF0076)
+
+	// RteAutoMoveFailed  自动移动存档文件失败
+	RteAutoMoveFailed = RtdbError(0xFFFF0077)
+
+	// RteTimeGreaterThanHotTailArc  创建/入列非闪盘存档文件的时间大于闪盘最早的存档文件
+	RteTimeGreaterThanHotTailArc = RtdbError(0xFFFF0078)
+
+	// RteTimeLessThanColdBeginArc  创建/入列闪盘的存档文件时间小于非闪盘最新的存档文件
+	RteTimeLessThanColdBeginArc = RtdbError(0xFFFF0079)
+
+	// RteRemoveEarliestArcFailed  删除最早的存档文件失败（存档文件列表为空）
+	RteRemoveEarliestArcFailed = RtdbError(0xFFFF0080)
+
+	// RteNoFreeTableId  没有空闲的表ID可用
+	RteNoFreeTableId = RtdbError(0xFFFF1001)
+
+	// RteNoFreeTagPosition  没有空闲的标签点位址可用
+	RteNoFreeTagPosition = RtdbError(0xFFFF1002)
+
+	// RteNoFreeScanTagPosition  没有空闲的采集标签点位址可用
+	RteNoFreeScanTagPosition = RtdbError(0xFFFF1003)
+
+	// RteNoFreeCalcTagPosition  没有空闲的计算标签点位址可用
+	RteNoFreeCalcTagPosition = RtdbError(0xFFFF1004)
+
+	// RteInvalidIpcPosition  无效的位址被用于进程间内存共享
+	RteInvalidIpcPosition = RtdbError(0xFFFF1005)
+
+	// RteWrongIpcPosition  错误的位址被用于进程间内存共享
+	RteWrongIpcPosition = RtdbError(0xFFFF1006)
+
+	// RteIpcAccessException  共享内存访问异常
+	RteIpcAccessException = RtdbError(0xFFFF1007)
+
+	// RteArvPageNotReady  没有空闲的历史数据缓存页
+	RteArvPageNotReady = RtdbError(0xFFFF1008)
+
+	// RteArvexPageNotReady  没有空闲的补历史数据缓存页
+	RteArvexPageNotReady = RtdbError(0xFFFF1009)
+
+	// RteInvalidPositionFromId  依据标签点ID获得的位址无效
+	RteInvalidPositionFromId = RtdbError(0xFFFF100A)
+
+	// RteNoActivePageAllocator  新的活动存档无法加载页分配器
+	RteNoActivePageAllocator = RtdbError(0xFFFF100B)
+
+	// RteMapIsNotReady  内存映射尚未就绪
+	RteMapIsNotReady = RtdbError(0xFFFF100C)
+
+	// RteFileMapFailed  文件映射到内存失败
+	RteFileMapFailed = RtdbError(0xFFFF100D)
+
+	// RteTimeRangeNotAllowed  不允许使用的时间区间
+	RteTimeRangeNotAllowed = RtdbError(0xFFFF100E)
+
+	// RteNoDataForSummary  找不到用于统计的源数据
+	RteNoDataForSummary = RtdbError(0xFFFF100F)
+
+	// RteCantOperateOnActived  不允许操作活动存档文件
+	RteCantOperateOnActived = RtdbError(0xFFFF1010)
+
+	// RteScanPointLicenseFull  采集标签点数超出了许可证规定的最大数目
+	RteScanPointLicenseFull = RtdbError(0xFFFF1011)
+
+	// RteCalcPointLicenseFull  计算标签点数超出了许可证规定的最大数目
+	RteCalcPointLicenseFull = RtdbError(0xFFFF1012)
+
+	// RteHistorianIsShuttingdown  历史数据服务正在停止
+	RteHistorianIsShuttingdown = RtdbError(0xFFFF1013)
+
+	// RteSnapshotIsShuttingdown  实时数据服务正在停止
+	RteSnapshotIsShuttingdown = RtdbError(0xFFFF1014)
+
+	// RteEquationIsShuttingdown  实时方程式服务正在停止
+	RteEquationIsShuttingdown = RtdbError(0xFFFF1015)
+
+	// RteBaseIsShuttingdown  标签点信息服务正在停止
+	RteBaseIsShuttingdown = RtdbError(0xFFFF1016)
+
+	// RteServerIsShuttingdown  网络通信服务正在停止
+	RteServerIsShuttingdown = RtdbError(0xFFFF1017)
+
+	// RteOutOfMemory  内存不足
+	RteOutOfMemory = RtdbError(0xFFFF1018)
+
+	// RteInvalidPage  无效的数据页，有可能是未加载
+	RteInvalidPage = RtdbError(0xFFFF1019)
+
+	// RtePageIsEmpty  遇到空的数据页
+	RtePageIsEmpty = RtdbError(0xFFFF101A)
+
+	// RteStrOrBlobTooLong  字符串或BLOB数据长度超出限制
+	RteStrOrBlobTooLong = RtdbError(0xFFFF101B)
+
+	// RteCreatedOrOverdue  尚未产生任何快照或快照已过期
+	RteCreatedOrOverdue = RtdbError(0xFFFF101C)
+
+	// RteArchiveInfoNotMatching  历史存档文件头部信息与实际不符
+	RteArchiveInfoNotMatching = RtdbError(0xFFFF101D)
+
+	// RteTimeRangeOverlapping  指定的时间范围与已有存档文件重叠
+	RteTimeRangeOverlapping = RtdbError(0xFFFF101E)
+
+	// RteCannotShiftToActived  找不到合适的存档文件用于切换成活动存档
+	RteCannotShiftToActived = RtdbError(0xFFFF101F)
+
+	// RteIndexNotReady  历史存档文件对应的索引尚未就绪
+	RteIndexNotReady = RtdbError(0xFFFF1020)
+
+	// RteIndexNodeNotMatch  索引节点与指向的内容不符
+	RteIndexNodeNotMatch = RtdbError(0xFFFF1021)
+
+	// RteCanNotCreateIndex  无法创建索引节点
+	RteCanNotCreateIndex = RtdbError(0xFFFF1022)
+
+	// RteCanNotRemoveIndex  无法删除索引节点
+	RteCanNotRemoveIndex = RtdbError(0xFFFF1023)
+
+	// RteInvalidFilterExpress  无效的过滤器表达式
+	RteInvalidFilterExpress = RtdbError(0xFFFF1024)
+
+	// RteMoreVarInFilterExp  过滤器表达式中的包含了过多的变量
+	RteMoreVarInFilterExp = RtdbError(0xFFFF1025)
+
+	// RteInvalidArvPageAllocate  刚分配的历史数据缓存页ID与标签点事件对象ID不匹配
+	RteInvalidArvPageAllocate = RtdbError(0xFFFF1026)
+
+	// RteInvalidArvexPageAllocate  刚分配的补历史数据缓存页ID与标签点事件对象ID不匹配
+	RteInvalidArvexPageAllocate = RtdbError(0xFFFF1027)
+
+	// RteBigJobIsNotDone  正在执行重要的任务，请稍后再试
+	RteBigJobIsNotDone = RtdbError(0xFFFF1028)
+
+	// RteDatabaseNeedRestart  数据库需要重新启动以便应用新的参数
+	RteDatabaseNeedRestart = RtdbError(0xFFFF1029)
+
+	// RteInvalidTimeFormat  无效的时间格式字符串
+	RteInvalidTimeFormat = RtdbError(0xFFFF102A)
+
+	// RteDataPlaybackDone  历史数据回放过程已结束
+	RteDataPlaybackDone = RtdbError(0xFFFF102B)
+
+	// RteBadEquation  错误的方程式
+	RteBadEquation = RtdbError(0xFFFF102C)
+
+	// RteNotEnoughSapce  剩余磁盘空间不足
+	RteNotEnoughSapce = RtdbError(0xFFFF102D)
+
+	// RteActivedArchiveExist  已存在活动存档
+	RteActivedArchiveExist = RtdbError(0xFFFF102E)
+
+	// RteArchiveHaveExFiles  指定的存档文件具有附属文件
+	RteArchiveHaveExFiles = RtdbError(0xFFFF102F)
+
+	// RteArchiveIsNotLatest  指定的存档文件不是最晚的
+	RteArchiveIsNotLatest = RtdbError(0xFFFF1030)
+
+	// RteDbSystemNotRunning  数据库管理系统尚未完全启动
+	RteDbSystemNotRunning = RtdbError(0xFFFF1031)
+
+	// RteArchiveIsAltered  存档文件内容发生变更
+	RteArchiveIsAltered = RtdbError(0xFFFF1032)
+
+	// RteArchiveIsTooSmall  不允许创建太小的存档文件和附属文件
+	RteArchiveIsTooSmall = RtdbError(0xFFFF1033)
+
+	// RteInvalidIndexNode  遇到无效的索引节点
+	RteInvalidIndexNode = RtdbError(0xFFFF1034)
+
+	// RteModifySnapshotNotAllowed  不允许删除或修改快照事件
+	RteModifySnapshotNotAllowed = RtdbError(0xFFFF1035)
+
+	// RteSearchInterrupted  因目标正被创建、删除或恢复，搜索被迫中断，请稍后再试
+	RteSearchInterrupted = RtdbError(0xFFFF1036)
+
+	// RteRecycleShutdown  回收站已失效，相关操作无法完成
+	RteRecycleShutdown = RtdbError(0xFFFF1037)
+
+	// RteNeedToReindex  索引文件缺失，或部分索引节点被损坏，需要重建索引
+	RteNeedToReindex = RtdbError(0xFFFF1038)
+
+	// RteInvalidQuality  无效的质量码
+	RteInvalidQuality = RtdbError(0xFFFF1039)
+
+	// RteEquationNotReady  实时方程式服务正在解析，请稍后再试
+	RteEquationNotReady = RtdbError(0xFFFF103A)
+
+	// RteArchivesLicenseFull  存档文件数已达到许可证规定的最大数目
+	RteArchivesLicenseFull = RtdbError(0xFFFF103B)
+
+	// RteRecycledLicenseFull  标签点回收站容量超出了许可证规定的最大数目
+	RteRecycledLicenseFull = RtdbError(0xFFFF103C)
+
+	// RteStrBlobLicenseFull  字符串或BLOB类型标签点数量超出了许可证规定的最大数目
+	RteStrBlobLicenseFull = RtdbError(0xFFFF103D)
+
+	// RteNotSupportWhenDebug  此功能被某个调试选项禁用
+	RteNotSupportWhenDebug = RtdbError(0xFFFF103E)
+
+	// RteMappingAlreadyLoaded  映射已经被加载，不允许重复加载
+	RteMappingAlreadyLoaded = RtdbError(0xFFFF103F)
+
+	// RteArchiveIsModified  存档文件被修改，动作被中断
+	RteArchiveIsModified = RtdbError(0xFFFF1040)
+
+	// RteActiveArchiveFull  活动文档已满
+	RteActiveArchiveFull = RtdbError(0xFFFF1041)
+
+	// RteSplitNoData  拆分数据页后所给时间区间内没有数据
+	RteSplitNoData = RtdbError(0xFFFF1042)
+
+	// RteInvalidDirectory  指定的路径不存在或无效
+	RteInvalidDirectory = RtdbError(0xFFFF1043)
+
+	// RteArchiveLackExFiles  指定存档文件的部分附属文件缺失
+	RteArchiveLackExFiles = RtdbError(0xFFFF1044)
+
+	// RteBigJobIsCanceled  后台任务被取消
+	RteBigJobIsCanceled = RtdbError(0xFFFF1045)
+
+	// RteArvexBlobPageNotReady  没有空闲的blob补历史数据缓存页
+	RteArvexBlobPageNotReady = RtdbError(0xFFFF1046)
+
+	// RteInvalidArvexBlobPageAllocate  刚分配的blob补历史数据缓存页ID与标签点事件对象ID不匹配
+	RteInvalidArvexBlobPageAllocate = RtdbError(0xFFFF1047)
+
+	// RteTimestampEqualtoSnapshot  写入的时间与快照时间相同
+	RteTimestampEqualtoSnapshot = RtdbError(0xFFFF1048)
+
+	// RteTimestampEarlierThanSnapshot  写入的时间比当前快照时间较早
+	RteTimestampEarlierThanSnapshot = RtdbError(0xFFFF1049)
+
+	// RteTimestampGreaterThanAllow  写入的时间超过了允许的时间
+	RteTimestampGreaterThanAllow = RtdbError(0xFFFF104A)
+
+	// RteTimestampBegintimeGreagerThanEndtime  开始时间大于结束时间
+	RteTimestampBegintimeGreagerThanEndtime = RtdbError(0xFFFF104B)
+
+	// RteTimestampBegintimeEqualtoEndtime  开始时间等于结束时间
+	RteTimestampBegintimeEqualtoEndtime = RtdbError(0xFFFF104C)
+
+	// RteInvalidCount  无效的count
+	RteInvalidCount = RtdbError(0xFFFF104D)
+
+	// RteInvalidCapacity  无效的capacity
+	RteInvalidCapacity = RtdbError(0xFFFF104E)
+
+	// RteInvalidPath  无效的路径
+	RteInvalidPath = RtdbError(0xFFFF104F)
+
+	// RteInvalidPosition  无效的position
+	RteInvalidPosition = RtdbError(0xFFFF1050)
+
+	// RteInvalidArvPage  无效的rtdb_arv_page<RTDB_T>,未加载，或者size小于等于0
+	RteInvalidArvPage = RtdbError(0xFFFF1051)
+
+	// RteInvalidHisinfoItemState  无效的历史信息条目
+	RteInvalidHisinfoItemState = RtdbError(0xFFFF1052)
+
+	// RteInvalidInterval  无效的间隔
+	RteInvalidInterval = RtdbError(0xFFFF1053)
+
+	// RteInvalidLength  无效的字符串长度
+	RteInvalidLength = RtdbError(0xFFFF1054)
+
+	// RteInvalidSerachMode  无效的search mode
+	RteInvalidSerachMode = RtdbError(0xFFFF1055)
+
+	// RteInvalidFileId  无效的存档文件ID
+	RteInvalidFileId = RtdbError(0xFFFF1056)
+
+	// RteInvalidMillisecond  无效的毫秒值/纳秒值
+	RteInvalidMillisecond = RtdbError(0xFFFF1057)
+
+	// RteInvalidDeadline  无效的截止时间
+	RteInvalidDeadline = RtdbError(0xFFFF1058)
+
+	// RteInvalidJobname  无效的Job名称
+	RteInvalidJobname = RtdbError(0xFFFF1059)
+
+	// RteInvalidJobstate  无效的Job状态
+	RteInvalidJobstate = RtdbError(0xFFFF105A)
+
+	// RteInvalidProcessRate  无效的Process速率
+	RteInvalidProcessRate = RtdbError(0xFFFF105B)
+
+	// RteInvalidTableId  无效的表ID
+	RteInvalidTableId = RtdbError(0xFFFF105C)
+
+	// RteInvalidDataSource  无效的数据源格式
+	RteInvalidDataSource = RtdbError(0xFFFF105D)
+
+	// RteInvalidTriggerMethod  无效的触发方式
+	RteInvalidTriggerMethod = RtdbError(0xFFFF105E)
+
+	// RteInvalidCalcTimeRes  无效的计算结果时间戳参考方式
+	RteInvalidCalcTimeRes = RtdbError(0xFFFF105F)
+
+	// RteInvalidTriggerTimer  无效的定时触发触发周期,不能小于1秒
+	RteInvalidTriggerTimer = RtdbError(0xFFFF1060)
+
+	// RteInvalidLimit  工程上限不得低于工程下限
+	RteInvalidLimit = RtdbError(0xFFFF1061)
+
+	// RteInvalidCompTime  无效的压缩间隔，最长压缩间隔不得小于最短压缩间隔
+	RteInvalidCompTime = RtdbError(0xFFFF1062)
+
+	// RteInvalidExtTime  无效的例外间隔，最长例外间隔不得小于最短例外间隔
+	RteInvalidExtTime = RtdbError(0xFFFF1063)
+
+	// RteInvalidDigits  无效的数值位数，数值位数超出了范围,-20~10
+	RteInvalidDigits = RtdbError(0xFFFF1064)
+
+	// RteInvalidFullTagName  标签点全名有误，找不到表名与点名的分隔符“.”
+	RteInvalidFullTagName = RtdbError(0xFFFF1065)
+
+	// RteInvalidTableDesc  表描述信息过有误
+	RteInvalidTableDesc = RtdbError(0xFFFF1066)
+
+	// RteInvalidUserCount  非法的用户个数，小于0
+	RteInvalidUserCount = RtdbError(0xFFFF1067)
+
+	// RteInvalidBlacklistCount  非法的黑名单个数，小于0
+	RteInvalidBlacklistCount = RtdbError(0xFFFF1068)
+
+	// RteInvalidAuthorizationCount  非法的信任连接个数，小于0
+	RteInvalidAuthorizationCount = RtdbError(0xFFFF1069)
+
+	// RteInvalidBigJobType  非法的大任务类型
+	RteInvalidBigJobType = RtdbError(0xFFFF106A)
+
+	// RteInvalidSysParam  无效的系统参数，调用db_set_db_info2时，参数有误
+	RteInvalidSysParam = RtdbError(0xFFFF106B)
+
+	// RteInvalidFileParam  无效的文件路径参数，调用db_set_db_info1时，参数有误
+	RteInvalidFileParam = RtdbError(0xFFFF106C)
+
+	// RteInvalidFileSize  文件长度有误  < 1 baserecycle.dat、scanrecycle.dat、calcrecycle.dat、snaprecycle.dat
+	RteInvalidFileSize = RtdbError(0xFFFF106D)
+
+	// RteInvalidTagType  标签点类型有误，合法（ rtdb_bool ~ rtdb_blob)，但是不属于相应函数的处理范围
+	RteInvalidTagType = RtdbError(0xFFFF106E)
+
+	// RteInvalidRecyStructPos  回收站对象最后一个结构体位置非法
+	RteInvalidRecyStructPos = RtdbError(0xFFFF106F)
+
+	// RteInvalidRecycleFile  scanrecycle.dat、baserecycle.dat  snaprecycle.dat文件不存在或失效
+	RteInvalidRecycleFile = RtdbError(0xFFFF1070)
+
+	// RteInvalidSuffixName  无效的文件后缀名
+	RteInvalidSuffixName = RtdbError(0xFFFF1071)
+
+	// RteInsertStringFalse  向数据页中插入字符串数据失败
+	RteInsertStringFalse = RtdbError(0xFFFF1072)
+
+	// RteBlobPageFull  blob数据页已满
+	RteBlobPageFull = RtdbError(0xFFFF1073)
+
+	// RteInvalidStringIteratorPointer  无效的str/blob迭代器指针
+	RteInvalidStringIteratorPointer = RtdbError(0xFFFF1074)
+
+	// RteNotEqualTagid  目标页标签点ID 与 当前ID不一致
+	RteNotEqualTagid = RtdbError(0xFFFF1075)
+
+	// RtePathsOfArchiveAndAutobackAreSame  存档文件路径与自动备份路径相同
+	RtePathsOfArchiveAndAutobackAreSame = RtdbError(0xFFFF1076)
+
+	// RteXmlParseFail  xml文件解析失败
+	RteXmlParseFail = RtdbError(0xFFFF1077)
+
+	// RteXmlElementsAbsent  xml清单文件文件内容缺失
+	RteXmlElementsAbsent = RtdbError(0xFFFF1078)
+
+	// RteXmlMismatchOnName  xml清单文件与本产品不匹配
+	RteXmlMismatchOnName = RtdbError(0xFFFF1079)
+
+	// RteXmlMismatchOnVersion  xml清单文件版本不匹配
+	RteXmlMismatchOnVersion = RtdbError(0xFFFF107A)
+
+	// RteXmlMismatchOnDatasize  xml清单文件数据尺寸不匹配
+	RteXmlMismatchOnDatasize = RtdbError(0xFFFF107B)
+
+	// RteXmlMismatchOnFileinfo  xml清单文件中数据文件信息不匹配
+	RteXmlMismatchOnFileinfo = RtdbError(0xFFFF107C)
+
+	// RteXmlMismatchOnWindow  xml清单文件中所有数据文件的窗口大小必须一致
+	RteXmlMismatchOnWindow = RtdbError(0xFFFF107D)
+
+	// RteXmlMismatchOnTypecount  xml清单文件自定义数据类型的数量不匹配
+	RteXmlMismatchOnTypecount = RtdbError(0xFFFF107F)
+
+	// RteXmlMismatchOnFieldcount  xml清单文件自定义数据类型的field不匹配
+	RteXmlMismatchOnFieldcount = RtdbError(0xFFFF1080)
+
+	// RteXmlFieldMustInType  xml清单文件中field标签必须嵌套在type标签中
+	RteXmlFieldMustInType = RtdbError(0xFFFF1081)
+
+	// RteInvalidNamedTypeFieldCount  无效的FIELD数量
+	RteInvalidNamedTypeFieldCount = RtdbError(0xFFFF1082)
+
+	// RteReduplicateFieldName  重复的FIELD名字
+	RteReduplicateFieldName = RtdbError(0xFFFF1083)
+
+	// RteInvalidNamedTypeName  无效的自定义数据类型的名字
+	RteInvalidNamedTypeName = RtdbError(0xFFFF1084)
+
+	// RteReduplicateNamedType  已经存在的自定义数据类型
+	RteReduplicateNamedType = RtdbError(0xFFFF1085)
+
+	// RteNotExistNamedType  不存在的自定义数据类型
+	RteNotExistNamedType = RtdbError(0xFFFF1086)
+
+	// RteUpdateXmlFailed  更新XML清单文件失败
+	RteUpdateXmlFailed = RtdbError(0xFFFF1087)
+
+	// RteNamedTypeUsedWithPoint  有些标签点正在使用此自定义数据类型，不允许删除
+	RteNamedTypeUsedWithPoint = RtdbError(0xFFFF1088)
+
+	// RteNamedTypeUnsupportCalcPoint  自定义数据类型不支持计算点
+	RteNamedTypeUnsupportCalcPoint = RtdbError(0xFFFF1089)
+
+	// RteXmlMismatchOnMaxId  自定义数据类型的最大ID与实际的自定义数据类型数量不一致
+	RteXmlMismatchOnMaxId = RtdbError(0xFFFF1090)
+
+	// RteNamedTypeLicenseFull  自定义数据类型的数量超出了许可证规定的最大数目
+	RteNamedTypeLicenseFull = RtdbError(0xFFFF1091)
+
+	// RteNoFreeNamedTypeId  没有空闲的自定义数据类型的ID
+	RteNoFreeNamedTypeId = RtdbError(0xFFFF1092)
+
+	// RteInvalidNamedTypeId  无效的自定义数据类型ID
+	RteInvalidNamedTypeId = RtdbError(0xFFFF1093)
+
+	// RteInvalidNamedTypeFieldName  无效的自定义数据类型的字段名字
+	RteInvalidNamedTypeFieldName = RtdbError(0xFFFF1094)
+
+	// RteNamedTypeUsedWithRecyclePoint  有些回收站中的标签点正在使用此自定义数据类型，不允许删除
+	RteNamedTypeUsedWithRecyclePoint = RtdbError(0xFFFF1095)
+
+	// RteNamedTypeNameTooLong  自定义类型的名字超过了允许的最大长度
+	RteNamedTypeNameTooLong = RtdbError(0xFFFF1096)
+
+	// RteNamedTypeFieldNameTooLong  自定义类型的field 名字超过了允许的最大长度
+	RteNamedTypeFieldNameTooLong = RtdbError(0xFFFF1097)
+
+	// RteInvalidNamedTypeFieldLength  无效的自定义数据类型的字段长度
+	RteInvalidNamedTypeFieldLength = RtdbError(0xFFFF1098)
+
+	// RteInvalidSearchMask  无效的高级搜索的标签点属性mask
+	RteInvalidSearchMask = RtdbError(0xFFFF1099)
+
+	// RteRecycledSpaceNotEnough  标签点回收站空闲空间不足
+	RteRecycledSpaceNotEnough = RtdbError(0xFFFF109A)
+
+	// RteDynamicLoadedMemoryNotInit  动态加载的内存未初始化
+	RteDynamicLoadedMemoryNotInit = RtdbError(0xFFFF109B)
+
+	// RteForbidDynamicAllocType  内存库禁止动态分配类型
+	RteForbidDynamicAllocType = RtdbError(0xFFFF109C)
+
+	// RteMemorydbIndexCreateFailed  内存库索引创建失败
+	RteMemorydbIndexCreateFailed = RtdbError(0xFFFF109D)
+
+	// RteWgMakeQueryReturnNull  whitedb make_query_rc返回null
+	RteWgMakeQueryReturnNull = RtdbError(0xFFFF109F)
+
+	// RteThtreadPoolCreatedFailed  内存库创建线程池失败
+	RteThtreadPoolCreatedFailed = RtdbError(0xFFFF10A0)
+
+	// RteMemorydbRemoveRecordFailed  内存库删除记录失败
+	RteMemorydbRemoveRecordFailed = RtdbError(0xFFFF10A1)
+
+	// RteMemorydbConfigLoadFailed  内存库配置文件加载失败
+	RteMemorydbConfigLoadFailed = RtdbError(0xFFFF10A2)
+
+	// RteMemorydbProhibitDynamicAlloType  内存库禁止动态分配类型
+	RteMemorydbProhibitDynamicAlloType = RtdbError(0xFFFF10A3)
+
+	// RteMemorydbDynamicAllocTypeFailed  内存库动态分配类型失败
+	RteMemorydbDynamicAllocTypeFailed = RtdbError(0xFFFF10A4)
+
+	// RteMemorydbStorageFileNameParseFailed  内存库优先级文件名解析失败
+	RteMemorydbStorageFileNameParseFailed = RtdbError(0xFFFF10A5)
+
+	// RteMemorydbTtreeIndexDamage  内存库T树索引损坏
+	RteMemorydbTtreeIndexDamage = RtdbError(0xFFFF10A6)
+
+	// RteMemorydbConfigFailed  内存库配置文件错误
+	RteMemorydbConfigFailed = RtdbError(0xFFFF10A7)
+
+	// RteMemorydbValueCountNotMatch  内存库记录的值个数不匹配。
+	RteMemorydbValueCountNotMatch = RtdbError(0xFFFF10A8)
+
+	// RteMemorydbFieldTypeNotMatch  内存库的字段类型不匹配
+	RteMemorydbFieldTypeNotMatch = RtdbError(0xFFFF10A9)
+
+	// RteMemorydbMemoryAllocFailed  内存库内存分配失败
+	RteMemorydbMemoryAllocFailed = RtdbError(0xFFFF10AA)
+
+	// RteMemorydbMethodParamErr  内存库方法参数错误
+	RteMemorydbMethodParamErr = RtdbError(0xFFFF10AB)
+
+	// RteMemorydbQueryResultAllocFailed  内存库查询结果缓存分配失败
+	RteMemorydbQueryResultAllocFailed = RtdbError(0xFFFF10AC)
+
+	// RteFilePathLength  指定的文件路径长度错误
+	RteFilePathLength = RtdbError(0xFFFF10AD)
+
+	// RteMemorydbFileVersionMatch  内存库文件版本不匹配
+	RteMemorydbFileVersionMatch = RtdbError(0xFFFF10AE)
+
+	// RteMemorydbFileCrcError  内存库文件CRC错误
+	RteMemorydbFileCrcError = RtdbError(0xFFFF10AF)
+
+	// RteMemorydbFileFlagMatch  内存库文件标志错误
+	RteMemorydbFileFlagMatch = RtdbError(0xFFFF10B0)
+
+	// RteMemorydbInexistence  存储库不存在
+	RteMemorydbInexistence = RtdbError(0xFFFF10B1)
+
+	// RteMemorydbLoadFailed  存储库加载失败
+	RteMemorydbLoadFailed = RtdbError(0xFFFF10B2)
+
+	// RteNoDataInInterval  指定的查询区间内没有数据。
+	RteNoDataInInterval = RtdbError(0xFFFF10B3)
+
+	// RteCantLoadMemorydb  不能与内存服务取得联系
+	RteCantLoadMemorydb = RtdbError(0xFFFF10B4)
+
+	// RteQueryInWhitedb  查询内存库过程中出现了错误，这是whitedb内部错误
+	RteQueryInWhitedb = RtdbError(0xFFFF10B5)
+
+	// RteNoDatabaseMemorydb  没有找到指定数据类型所对应的分库
+	RteNoDatabaseMemorydb = RtdbError(0xFFFF10B6)
+
+	// RteRecordNotGet  从whitedb中获取记录失败
+	RteRecordNotGet = RtdbError(0xFFFF10B7)
+
+	// RteMemoryAllocErr  内存库用于接收快照的缓冲区分配失败
+	RteMemoryAllocErr = RtdbError(0xFFFF10B8)
+
+	// RteEventCreateFailed  用于内存库接收缓冲区的事件创建失败
+	RteEventCreateFailed = RtdbError(0xFFFF10B9)
+
+	// RteGetPointFailed  获取标签点失败
+	RteGetPointFailed = RtdbError(0xFFFF10BA)
+
+	// RteMemoryInitFailed  内存库初始化失败
+	RteMemoryInitFailed = RtdbError(0xFFFF10BB)
+
+	// RteDatatypeNotMatch  数据类型不匹配
+	RteDatatypeNotMatch = RtdbError(0xFFFF10BC)
+
+	// RteGetFieldErr  在whitedb获取记录的字段时出现了错误
+	RteGetFieldErr = RtdbError(0xFFFF10BD)
+
+	// RteMemorydbInternalErr  whitedb内部未知错误
+	RteMemorydbInternalErr = RtdbError(0xFFFF10BE)
+
+	// RteMemorydbRecordCreatedFailed  内存库创建记录失败
+	RteMemorydbRecordCreatedFailed = RtdbError(0xFFFF10BF)
+
+	// RteParseNormalTypeSnapshotErr  解析普通数据类型的快照失败
+	RteParseNormalTypeSnapshotErr = RtdbError(0xFFFF10C0)
+
+	// RteParseNamedTypeSnapshotErr  解析自定义数据类型的快照失败
+	RteParseNamedTypeSnapshotErr = RtdbError(0xFFFF10C1)
+
+	// RteStringBlobTypeUnsupportCalcPoint  string、blob类型不支持计算点
+	RteStringBlobTypeUnsupportCalcPoint = RtdbError(0xFFFF10C2)
+
+	// RteCoorTypeUnsupportCalcPoint  坐标类型不支持计算点
+	RteCoorTypeUnsupportCalcPoint = RtdbError(0xFFFF10C3)
+
+	// RteIncludeHisData  记录是历史数据，可能是无效过期的脏数据
+	RteIncludeHisData = RtdbError(0xFFFF10C4)
+
+	// RteThreadCreateErr  线程创建失败
+	RteThreadCreateErr = RtdbError(0xFFFF10C5)
+
+	// RteXmlCrcError  xml文件crc校验失败
+	RteXmlCrcError = RtdbError(0xFFFF10C6)
+
+	// RteOversizeIntervals  intervals >
+	RteOversizeIntervals = RtdbError(0xFFFF10C7)
+
+	// RteDatetimesMustAscendingOrder  时间必须按升序排序
+	RteDatetimesMustAscendingOrder = RtdbError(0xFFFF10C8)
+
+	// RteCantLoadPerf  不能同性能计数服务取得联系
+	RteCantLoadPerf = RtdbError(0xFFFF10C9)
+
+	// RtePerfTagNotFound  性能计数点不存在
+	RtePerfTagNotFound = RtdbError(0xFFFF10CA)
+
+	// RteWaitDataEmpty  数据为空
+	RteWaitDataEmpty = RtdbError(0xFFFF10CB)
+
+	// RteWaitDataFull  数据满了
+	RteWaitDataFull = RtdbError(0xFFFF10CC)
+
+	// RteDataTypeCountLess  数据类型数量最小值
+	RteDataTypeCountLess = RtdbError(0xFFFF10CD)
+
+	// RteMemorydbCreateFailed  内存库创建失败
+	RteMemorydbCreateFailed = RtdbError(0xFFFF10CE)
+
+	// RteMemorydbFieldEncodeFailed  内存库字段编码失败
+	RteMemorydbFieldEncodeFailed = RtdbError(0xFFFF10CF)
+
+	// RteRecordCreateFailed  内存库记录创建失败
+	RteRecordCreateFailed = RtdbError(0xFFFF10D0)
+
+	// RteRemoveRecordErr  内存库记录删除失败
+	RteRemoveRecordErr = RtdbError(0xFFFF10D1)
+
+	// RteMemorydbFileOpenField  内存库打开文件失败
+	RteMemorydbFileOpenField = RtdbError(0xFFFF10D2)
+
+	// RteMemorydbFileWriteFailed  内存库写入文件失败
+	RteMemorydbFileWriteFailed = RtdbError(0xFFFF10D3)
+
+	// RteFilterWtihFloatAndEqual  含有浮点数不等式中不能有
+	RteFilterWtihFloatAndEqual = RtdbError(0xFFFF10D4)
+
+	// RteDispatchPluginNotExsit  转发服务器插件不存在
+	RteDispatchPluginNotExsit = RtdbError(0xFFFF10D5)
+
+	// RteDispatchPluginFileNotExsit  转发服务器插件DLL文件不存在
+	RteDispatchPluginFileNotExsit = RtdbError(0xFFFF10D6)
+
+	// RteDispatchPluginAlreadyExsit  转发服务器插件已存在
+	RteDispatchPluginAlreadyExsit = RtdbError(0xFFFF10D7)
+
+	// RteDispatchRegisterPluginFailure  插件注册失败
+	RteDispatchRegisterPluginFailure = RtdbError(0xFFFF10D8)
+
+	// RteDispatchStartPluginFailure  启动插件失败
+	RteDispatchStartPluginFailure = RtdbError(0xFFFF10D9)
+
+	// RteDispatchStopPluginFailure  停止插件失败
+	RteDispatchStopPluginFailure = RtdbError(0xFFFF10DA)
+
+	// RteDispatchSetPluginEnableStatusFailure  设置插件状态失败
+	RteDispatchSetPluginEnableStatusFailure = RtdbError(0xFFFF10DB)
+
+	// RteDispatchGetPluginCountFailure  获取插件个数信息失败
+	RteDispatchGetPluginCountFailure = RtdbError(0xFFFF10DC)
+
+	// RteDispatchConfigfileNotExist  转发服务配置文件不存在
+	RteDispatchConfigfileNotExist = RtdbError(0xFFFF10DD)
+
+	// RteDispatchConfigDataParseErr  转发服务配置数据解析错误
+	RteDispatchConfigDataParseErr = RtdbError(0xFFFF10DE)
+
+	// RteDispatchPluginAlreadyRunning  转发服务器插件已经运行
+	RteDispatchPluginAlreadyRunning = RtdbError(0xFFFF10DF)
+
+	// RteDispatchPluginCannotRun  转发服务器插件禁止运行
+	RteDispatchPluginCannotRun = RtdbError(0xFFFF10E0)
+
+	// RteDispatchPluginContainerUnrun  转发服务器插件容器未运行
+	RteDispatchPluginContainerUnrun = RtdbError(0xFFFF10E1)
+
+	// RteDispatchPluginInterfaceErr  转发服务器插件接口未实现
+	RteDispatchPluginInterfaceErr = RtdbError(0xFFFF10E2)
+
+	// RteDispatchPluginSaveConfigErr  转发服务器保存配置文件出错
+	RteDispatchPluginSaveConfigErr = RtdbError(0xFFFF10E3)
+
+	// RteDispatchPluginStartErr  转发服务器插件启动时失败
+	RteDispatchPluginStartErr = RtdbError(0xFFFF10E4)
+
+	// RteDispatchPluginStopErr  转发服务器插件停止时失败
+	RteDispatchPluginStopErr = RtdbError(0xFFFF10E5)
+
+	// RteDispatchParseDataPageErr  不支持的数据页类型
+	RteDispatchParseDataPageErr = RtdbError(0xFFFF10E6)
+
+	// RteDispatchNotRun  转发服务未启用
+	RteDispatchNotRun = RtdbError(0xFFFF10E7)
+
+	// RteBigJobIsCanceledBecauseArcRoll  因存档文件滚动，后台任务被取消
+	RteBigJobIsCanceledBecauseArcRoll = RtdbError(0xFFFF10E8)
+
+	// RtePerfForbiddenOperation  禁止对性能表的操作
+	RtePerfForbiddenOperation = RtdbError(0xFFFF10E9)
+
+	// RteReduplicateTagInDestTable  目标表中存在同名的标签点（用于标签点移动）
+	RteReduplicateTagInDestTable = RtdbError(0xFFFF10EA)
+
+	// RteProtocolnotimpl  用户请求的报文未实现
+	RteProtocolnotimpl = RtdbError(0xFFFF2001)
+
+	// RteCrcerror  报文CRC校验错误
+	RteCrcerror = RtdbError(0xFFFF2002)
+
+	// RteWrongUserpw  验证用户名密码失败
+	RteWrongUserpw = RtdbError(0xFFFF2003)
+
+	// RteChangeUserpw  修改用户名密码失败
+	RteChangeUserpw = RtdbError(0xFFFF2004)
+
+	// RteInvalidHandle  无效的句柄
+	RteInvalidHandle = RtdbError(0xFFFF2005)
+
+	// RteInvalidSocketHandle  无效的套接字句柄
+	RteInvalidSocketHandle = RtdbError(0xFFFF2006)
+
+	// RteFalse  操作未成功完成，具体原因查看小错误码。
+	RteFalse = RtdbError(0xFFFF2007)
+
+	// RteScanPointNotFound  要求访问的采集标签点不存在或无效
+	RteScanPointNotFound = RtdbError(0xFFFF2008)
+
+	// RteCalcPointNotFound  要求访问的计算标签点不存在或无效
+	RteCalcPointNotFound = RtdbError(0xFFFF2009)
+
+	// RteReduplicateId  重复的标签点标识
+	RteReduplicateId = RtdbError(0xFFFF200A)
+
+	// RteHandleSubscribed  句柄已经被订阅
+	RteHandleSubscribed = RtdbError(0xFFFF200B)
+
+	// RteOtherSdkDoing  另一个API正在执行
+	RteOtherSdkDoing = RtdbError(0xFFFF200C)
+
+	// RteBatchEnd  分段数据返回结束
+	RteBatchEnd = RtdbError(0xFFFF200D)
+
+	// RteAuthNotFound  信任连接段不存在
+	RteAuthNotFound = RtdbError(0xFFFF200E)
+
+	// RteAuthExist  连接地址段已经位于信任列表中
+	RteAuthExist = RtdbError(0xFFFF200F)
+
+	// RteAuthFull  信任连接段已满
+	RteAuthFull = RtdbError(0xFFFF2010)
+
+	// RteUserFull  用户已满
+	RteUserFull = RtdbError(0xFFFF2011)
+
+	// RteVersionUnmatch  报文或数据版本不匹配
+	RteVersionUnmatch = RtdbError(0xFFFF2012)
+
+	// RteInvalidPriv  无效的权限
+	RteInvalidPriv = RtdbError(0xFFFF2013)
+
+	// RteInvalidMask  无效的子网掩码
+	RteInvalidMask = RtdbError(0xFFFF2014)
+
+	// RteInvalidUsername  无效的用户名
+	RteInvalidUsername = RtdbError(0xFFFF2015)
+
+	// RteInvalidMark  无法识别的报文头标记
+	RteInvalidMark = RtdbError(0xFFFF2016)
+
+	// RteUnexpectedMethod  意外的消息 ID
+	RteUnexpectedMethod = RtdbError(0xFFFF2017)
+
+	// RteInvalidParamIndex  无效的系统参数索引值
+	RteInvalidParamIndex = RtdbError(0xFFFF2018)
+
+	// RteDecodePacketError  解包错误
+	RteDecodePacketError = RtdbError(0xFFFF2019)
+
+	// RteEncodePacketError  编包错误
+	RteEncodePacketError = RtdbError(0xFFFF201A)
+
+	// RteBlacklistFull  阻止连接段已满
+	RteBlacklistFull = RtdbError(0xFFFF201B)
+
+	// RteBlacklistExist  连接地址段已经位于黑名单中
+	RteBlacklistExist = RtdbError(0xFFFF201C)
+
+	// RteBlacklistNotFound  阻止连接段不存在
+	RteBlacklistNotFound = RtdbError(0xFFFF201D)
+
+	// RteInBlacklist  连接地址位于黑名单中，被主动拒绝
+	RteInBlacklist = RtdbError(0xFFFF201E)
+
+	// RteIncreaseFileFailed  试图增大文件失败
+	RteIncreaseFileFailed = RtdbError(0xFFFF201F)
+
+	// RteRpcInterfaceFailed  远程过程接口调用失败
+	RteRpcInterfaceFailed = RtdbError(0xFFFF2020)
+
+	// RteConnectionFull  连接已满
+	RteConnectionFull = RtdbError(0xFFFF2021)
+
+	// RteOneClientConnectionFull  连接已达到单个客户端允许连接数的最大值
+	RteOneClientConnectionFull = RtdbError(0xFFFF2022)
+
+	// RteServerClutterPoolNotEnough  网络数据交换空间不足
+	RteServerClutterPoolNotEnough = RtdbError(0xFFFF2023)
+
+	// RteEquationClutterPoolNotEnough  实时方程式交换空间不足
+	RteEquationClutterPoolNotEnough = RtdbError(0xFFFF2024)
+
+	// RteNamedTypeNameLenError  自定义类型的名称过长
+	RteNamedTypeNameLenError = RtdbError(0xFFFF2025)
+
+	// RteNamedTypeLengthNotMatch  数值长度与自定义类型的定义不符
+	RteNamedTypeLengthNotMatch = RtdbError(0xFFFF2026)
+
+	// RteCanNotUpdateSummary  无法更新卫星数据
+	RteCanNotUpdateSummary = RtdbError(0xFFFF2027)
+
+	// RteTooManyArvexFile  附属文件太多，无法继续创建附属文件
+	RteTooManyArvexFile = RtdbError(0xFFFF2028)
+
+	// RteNotSupportedFeature  测试版本，暂时不支持此功能
+	RteNotSupportedFeature = RtdbError(0xFFFF2029)
+
+	// RteEnsureError  验证信息失败，详细信息请查看数据库日志
+	RteEnsureError = RtdbError(0xFFFF2030)
+
+	// RteOperatorIsCancel  操作被取消
+	RteOperatorIsCancel = RtdbError(0xFFFF2031)
+
+	// RteMsgbodyRevError  报文体接收失败
+	RteMsgbodyRevError = RtdbError(0xFFFF2032)
+
+	// RteUncompressFailed  解压缩失败
+	RteUncompressFailed = RtdbError(0xFFFF2033)
+
+	// RteCompressFailed  压缩失败
+	RteCompressFailed = RtdbError(0xFFFF2034)
+
+	// RteSubscribeError  订阅失败，前一个订阅线程尚未退出
+	RteSubscribeError = RtdbError(0xFFFF2035)
+
+	// RteSubscribeCancelError  取消订阅失败
+	RteSubscribeCancelError = RtdbError(0xFFFF2036)
+
+	// RteSubscribeCallbackFailed  订阅回掉函数中不能调用取消订阅、断开连接
+	RteSubscribeCallbackFailed = RtdbError(0xFFFF2037)
+
+	// RteSubscribeGreaterMaxCount  超过单连接可订阅标签点数量
+	RteSubscribeGreaterMaxCount = RtdbError(0xFFFF2038)
+
+	// RteKillConnectionFailed  断开连接失败，无法断开自身连接
+	RteKillConnectionFailed = RtdbError(0xFFFF2039)
+
+	// RteSubscribeNotMatch  请求的方法与当前的订阅不匹配
+	RteSubscribeNotMatch = RtdbError(0xFFFF203A)
+
+	// RteNoSubscribe  连接还未发起订阅，或者标签点还未订阅
+	RteNoSubscribe = RtdbError(0xFFFF203B)
+
+	// RteAlreadySubscribe  标签点已经被订阅
+	RteAlreadySubscribe = RtdbError(0xFFFF203C)
+
+	// RteCalcPointUnsupportedWriteData  计算点不支持写入数据
+	RteCalcPointUnsupportedWriteData = RtdbError(0xFFFF203D)
+
+	// RteFeatureDeprecated  不再支持此功能
+	RteFeatureDeprecated = RtdbError(0xFFFF203E)
+
+	// RteInvalidValue  无效的数据
+	RteInvalidValue = RtdbError(0xFFFF203F)
+
+	// RteVerifyVercodeFailed  验证授权码失败
+	RteVerifyVercodeFailed = RtdbError(0xFFFF2040)
+
+	// RteInvalidPageSize  无效的数据页的大小
+	RteInvalidPageSize = RtdbError(0xFFFF3001)
+
+	// RteInvalidPrecision  无效的时间戳精度
+	RteInvalidPrecision = RtdbError(0xFFFF3002)
+
+	// RteInvalidPageVersion  无效的数据页版本
+	RteInvalidPageVersion = RtdbError(0xFFFF3003)
+
+	// RtePageIsFull  数据页已满
+	RtePageIsFull = RtdbError(0xFFFF3004)
+
+	// RtePageNotLoaded  还未加载数据页
+	RtePageNotLoaded = RtdbError(0xFFFF3005)
+
+	// RtePageAlreadyLoaded  已经加载了数据页
+	RtePageAlreadyLoaded = RtdbError(0xFFFF3006)
+
+	// RtePageTooSmall  数据页太小，有效空间小于数据长度
+	RtePageTooSmall = RtdbError(0xFFFF3007)
+
+	// RtePageNoEnoughData  数据页中没有足够的数据
+	RtePageNoEnoughData = RtdbError(0xFFFF3008)
+
+	// RtePageInsertFailed  数据页插入数据失败
+	RtePageInsertFailed = RtdbError(0xFFFF3009)
+
+	// RtePageNoEnoughSpace  数据页没有足够的空间
+	RtePageNoEnoughSpace = RtdbError(0xFFFF300A)
+
+	// RteModifingMetaData  正在修改元数据，请稍后再试
+	RteModifingMetaData = RtdbError(0xFFFF300B)
+
+	// RtePageSizeNotMatch  数据页大小不匹配
+	RtePageSizeNotMatch = RtdbError(0xFFFF300C)
+
+	// RteSyncBegin  元数据同步错误码起始值
+	RteSyncBegin = RtdbError(0xFFFF6000)
+
+	// RteSyncInvalidConfig  元数据同步-无效的配置
+	RteSyncInvalidConfig = RtdbError(0xFFFF6001)
+
+	// RteSyncInvalidVersion  元数据同步-无效的版本号
+	RteSyncInvalidVersion = RtdbError(0xFFFF6002)
+
+	// RteSyncConfirmExpired  元数据同步-等待确认信息过期
+	RteSyncConfirmExpired = RtdbError(0xFFFF6003)
+
+	// RteSyncTooManyFwdinfo  元数据同步-转发信息过多
+	RteSyncTooManyFwdinfo = RtdbError(0xFFFF6004)
+
+	// RteSyncNotMaster  元数据同步-不是主库
+	RteSyncNotMaster = RtdbError(0xFFFF6005)
+
+	// RteSyncSyncing  元数据同步-正在同步
+	RteSyncSyncing = RtdbError(0xFFFF6006)
+
+	// RteSyncUnsynced  元数据同步-未同步
+	RteSyncUnsynced = RtdbError(0xFFFF6007)
+
+	// RteSyncTablePosConflict  元数据同步-表位置冲突
+	RteSyncTablePosConflict = RtdbError(0xFFFF6008)
+
+	// RteSyncInvalidPointId  元数据同步-无效的标签点ID
+	RteSyncInvalidPointId = RtdbError(0xFFFF6009)
+
+	// RteSyncInvalidTableId  元数据同步-无效的表ID
+	RteSyncInvalidTableId = RtdbError(0xFFFF600A)
+
+	// RteSyncInvalidNamedTypeId  元数据同步-无效的自定义类型ID
+	RteSyncInvalidNamedTypeId = RtdbError(0xFFFF600B)
+
+	// RteSyncRestoring  元数据同步-正在重建元数据
+	RteSyncRestoring = RtdbError(0xFFFF600C)
+
+	// RteSyncServerIsNotRunning  元数据同步-网络服务不是运行状态
+	RteSyncServerIsNotRunning = RtdbError(0xFFFF600D)
+
+	// RteSyncWriteWalFailed  元数据同步-写WAL失败
+	RteSyncWriteWalFailed = RtdbError(0xFFFF600E)
+
+	// RteSyncEnd  元数据同步错误码结束值
+	RteSyncEnd = RtdbError(0xFFFF6FFF)
+
+	// RteNetError  网络错误的起始值
+	RteNetError = RtdbError(0xFFFF7000)
+
+	// RteSockWsaeintr  （阻塞）调用被 WSACancelBlockingCall() 函数取消
+	RteSockWsaeintr = RtdbError(0xFFFF7004)
+
+	// RteSockWsaeacces  请求地址是广播地址，但是相应的 flags 没设置
+	RteSockWsaeacces = RtdbError(0xFFFF700D)
+
+	// RteSockWsaefault  非法内存访问
+	RteSockWsaefault = RtdbError(0xFFFF700E)
+
+	// RteSockWsaemfile  无多余的描述符可用
+	RteSockWsaemfile = RtdbError(0xFFFF7018)
+
+	// RteSockWsaewouldblock  套接字被标识为非阻塞，但操作将被阻塞
+	RteSockWsaewouldblock = RtdbError(0xFFFF7023)
+
+	// RteSockWsaeinprogress  一个阻塞的 Windows Sockets 操作正在进行
+	RteSockWsaeinprogress = RtdbError(0xFFFF7024)
+
+	// RteSockWsaealready  一个非阻塞的 connect() 调用已经在指定的套接字上进行
+	RteSockWsaealready = RtdbError(0xFFFF7025)
+
+	// RteSockWsaenotsock  描述符不是套接字描述符
+	RteSockWsaenotsock = RtdbError(0xFFFF7026)
+
+	// RteSockWsaedestaddrreq  要求（未指定）目的地址
+	RteSockWsaedestaddrreq = RtdbError(0xFFFF7027)
+
+	// RteSockWsaemsgsize  套接字为基于消息的，消息太大（大于底层传输支持的最大值）
+	RteSockWsaemsgsize = RtdbError(0xFFFF7028)
+
+	// RteSockWsaeprototype  对此套接字来说，指定协议是错误的类型
+	RteSockWsaeprototype = RtdbError(0xFFFF7029)
+
+	// RteSockWsaeprotonosupport  不支持指定协议
+	RteSockWsaeprotonosupport = RtdbError(0xFFFF702B)
+
+	// RteSockWsaesocktnosupport  在此地址族中不支持指定套接字类型
+	RteSockWsaesocktnosupport = RtdbError(0xFFFF702C)
+
+	// RteSockWsaeopnotsupp  MSG_OOB 被指定，但是套接字不是流风格的
+	RteSockWsaeopnotsupp = RtdbError(0xFFFF702D)
+
+	// RteSockWsaeafnosupport  不支持指定的地址族
+	RteSockWsaeafnosupport = RtdbError(0xFFFF702F)
+
+	// RteSockWsaeaddrinuse  套接字的本地地址已被使用
+	RteSockWsaeaddrinuse = RtdbError(0xFFFF7030)
+
+	// RteSockWsaeaddrnotavail  远程地址非法
+	RteSockWsaeaddrnotavail = RtdbError(0xFFFF7031)
+
+	// RteSockWsaenetdown  Windows Sockets 检测到网络系统已经失效
+	RteSockWsaenetdown = RtdbError(0xFFFF7032)
+
+	// RteSockWsaenetunreach  网络无法到达主机
+	RteSockWsaenetunreach = RtdbError(0xFFFF7033)
+
+	// RteSockWsaenetreset  在操作进行时 keep-alive 活动检测到一个失败，连接被中断
+	RteSockWsaenetreset = RtdbError(0xFFFF7034)
+
+	// RteSockWsaeconnaborted  连接因超时或其他失败而中断
+	RteSockWsaeconnaborted = RtdbError(0xFFFF7035)
+
+	// RteSockWsaeconnreset  连接被复位
+	RteSockWsaeconnreset = RtdbError(0xFFFF7036)
+
+	// RteSockWsaenobufs  无缓冲区空间可用
+	RteSockWsaenobufs = RtdbError(0xFFFF7037)
+
+	// RteSockWsaeisconn  连接已建立
+	RteSockWsaeisconn = RtdbError(0xFFFF7038)
+
+	// RteSockWsaenotconn  套接字未建立连接
+	RteSockWsaenotconn = RtdbError(0xFFFF7039)
+
+	// RteSockWsaeshutdown  套接字已 shutdown，连接已断开
+	RteSockWsaeshutdown = RtdbError(0xFFFF703A)
+
+	// RteSockWsaetimedout  连接请求超时，未能建立连接
+	RteSockWsaetimedout = RtdbError(0xFFFF703C)
+
+	// RteSockWsaeconnrefused  连接被拒绝
+	RteSockWsaeconnrefused = RtdbError(0xFFFF703D)
+
+	// RteSockWsaeclose  连接被关闭
+	RteSockWsaeclose = RtdbError(0xFFFF703E)
+
+	// RteSockWsanotinitialised  Windows Sockets DLL 未初始化
+	RteSockWsanotinitialised = RtdbError(0xFFFF705D)
+
+	// RteCErrnoError  C语言errno错误的起始值
+	RteCErrnoError = RtdbError(0xFFFF8000)
+
+	// RteCErrnoEperm  Operation not permitted
+	RteCErrnoEperm = RtdbError(0xFFFF8001)
+
+	// RteCErrnoEnoent  No such file or directory
+	RteCErrnoEnoent = RtdbError(0xFFFF8002)
+
+	// RteCErrnoEsrch  No such process
+	RteCErrnoEsrch = RtdbError(0xFFFF8003)
+
+	// RteCErrnoEintr  Interrupted system call
+	RteCErrnoEintr = RtdbError(0xFFFF8004)
+
+	// RteCErrnoEio  I/O error
+	RteCErrnoEio = RtdbError(0xFFFF8005)
+
+	// RteCErrnoEnxio  No such device or address
+	RteCErrnoEnxio = RtdbError(0xFFFF8006)
+
+	// RteCErrnoE2big  Argument list too long
+	RteCErrnoE2big = RtdbError(0xFFFF8007)
+
+	// RteCErrnoEnoexec  Exec format error
+	RteCErrnoEnoexec = RtdbError(0xFFFF8008)
+
+	// RteCErrnoEbadf  Bad file number
+	RteCErrnoEbadf = RtdbError(0xFFFF8009)
+
+	// RteCErrnoEchild  No child processes
+	RteCErrnoEchild = RtdbError(0xFFFF800A)
+
+	// RteCErrnoEagain  Try again
+	RteCErrnoEagain = RtdbError(0xFFFF800B)
+
+	// RteCErrnoEnomem  Out of memory
+	RteCErrnoEnomem = RtdbError(0xFFFF800C)
+
+	// RteCErrnoEacces  Permission denied
+	RteCErrnoEacces = RtdbError(0xFFFF800D)
+
+	// RteCErrnoEfault  Bad address
+	RteCErrnoEfault = RtdbError(0xFFFF800E)
+
+	// RteCErrnoEnotblk  Block device required
+	RteCErrnoEnotblk = RtdbError(0xFFFF800F)
+
+	// RteCErrnoEbusy  Device or resource busy
+	RteCErrnoEbusy = RtdbError(0xFFFF8010)
+
+	// RteCErrnoEexist  File exists
+	RteCErrnoEexist = RtdbError(0xFFFF8011)
+
+	// RteCErrnoExdev  Cross-device link
+	RteCErrnoExdev = RtdbError(0xFFFF8012)
+
+	// RteCErrnoEnodev  No such device
+	RteCErrnoEnodev = RtdbError(0xFFFF8013)
+
+	// RteCErrnoEnotdir  Not a directory
+	RteCErrnoEnotdir = RtdbError(0xFFFF8014)
+
+	// RteCErrnoEisdir  Is a directory
+	RteCErrnoEisdir = RtdbError(0xFFFF8015)
+
+	// RteCErrnoEinval  Invalid argument
+	RteCErrnoEinval = RtdbError(0xFFFF8016)
+
+	// RteCErrnoEnfile  File table overflow
+	RteCErrnoEnfile = RtdbError(0xFFFF8017)
+
+	// RteCErrnoEmfile  Too many open files
+	RteCErrnoEmfile = RtdbError(0xFFFF8018)
+
+	// RteCErrnoEnotty  Not a typewriter
+	RteCErrnoEnotty = RtdbError(0xFFFF8019)
+
+	// RteCErrnoEtxtbsy  Text file busy
+	RteCErrnoEtxtbsy = RtdbError(0xFFFF801A)
+
+	// RteCErrnoEfbig  File too large
+	RteCErrnoEfbig = RtdbError(0xFFFF801B)
+
+	// RteCErrnoEnospc  No space left on device
+	RteCErrnoEnospc = RtdbError(0xFFFF801C)
+
+	// RteCErrnoEspipe  Illegal seek
+	RteCErrnoEspipe = RtdbError(0xFFFF801D)
+
+	// RteCErrnoErofs  Read-only file system
+	RteCErrnoErofs = RtdbError(0xFFFF801E)
+
+	// RteCErrnoEmlink  Too many links
+	RteCErrnoEmlink = RtdbError(0xFFFF801F)
+
+	// RteCErrnoEpipe  Broken pipe
+	RteCErrnoEpipe = RtdbError(0xFFFF8020)
+
+	// RteCErrnoEdom  Math argument out of domain of func
+	RteCErrnoEdom = RtdbError(0xFFFF8021)
+
+	// RteCErrnoErange  Math result not representable
+	RteCErrnoErange = RtdbError(0xFFFF8022)
+
+	// RteCErrnoEdeadlk  Resource deadlock would occur
+	RteCErrnoEdeadlk = RtdbError(0xFFFF8023)
+
+	// RteCErrnoEnametoolong  File name too long
+	RteCErrnoEnametoolong = RtdbError(0xFFFF8024)
+
+	// RteCErrnoEnolck  No record locks available
+	RteCErrnoEnolck = RtdbError(0xFFFF8025)
+
+	// RteCErrnoEnosys  Function not implemented
+	RteCErrnoEnosys = RtdbError(0xFFFF8026)
+
+	// RteCErrnoEnotempty  Directory not empty
+	RteCErrnoEnotempty = RtdbError(0xFFFF8027)
+
+	// RteCErrnoEloop  Too many symbolic links encountered
+	RteCErrnoEloop = RtdbError(0xFFFF8028)
+
+	// RteCErrnoEnomsg  No message of desired type
+	RteCErrnoEnomsg = RtdbError(0xFFFF802A)
+
+	// RteCErrnoEidrm  Identifier removed
+	RteCErrnoEidrm = RtdbError(0xFFFF802B)
+
+	// RteCErrnoEchrng  Channel number out of range
+	RteCErrnoEchrng = RtdbError(0xFFFF802C)
+
+	// RteCErrnoEl2nsync  Level 2 not synchronized
+	RteCErrnoEl2nsync = RtdbError(0xFFFF802D)
+
+	// RteCErrnoEl3hlt  Level 3 halted
+	RteCErrnoEl3hlt = RtdbError(0xFFFF802E)
+
+	// RteCErrnoEl3rst  Level 3 reset
+	RteCErrnoEl3rst = RtdbError(0xFFFF802F)
+
+	// RteCErrnoElnrng  Link number out of range
+	RteCErrnoElnrng = RtdbError(0xFFFF8030)
+
+	// RteCErrnoEunatch  Protocol driver not attached
+	RteCErrnoEunatch = RtdbError(0xFFFF8031)
+
+	// RteCErrnoEnocsi  No CSI structure available
+	RteCErrnoEnocsi = RtdbError(0xFFFF8032)
+
+	// RteCErrnoEl2hlt  Level 2 halted
+	RteCErrnoEl2hlt = RtdbError(0xFFFF8033)
+
+	// RteCErrnoEbade  Invalid exchange
+	RteCErrnoEbade = RtdbError(0xFFFF8034)
+
+	// RteCErrnoEbadr  Invalid request descriptor
+	RteCErrnoEbadr = RtdbError(0xFFFF8035)
+
+	// RteCErrnoExfull  Exchange full
+	RteCErrnoExfull = RtdbError(0xFFFF8036)
+
+	// RteCErrnoEnoano  No anode
+	RteCErrnoEnoano = RtdbError(0xFFFF8037)
+
+	// RteCErrnoEbadrqc  Invalid request code
+	RteCErrnoEbadrqc = RtdbError(0xFFFF8038)
+
+	// RteCErrnoEbadslt  Invalid slot
+	RteCErrnoEbadslt = RtdbError(0xFFFF8039)
+
+	// RteCErrnoEbfont  Bad font file format
+	RteCErrnoEbfont = RtdbError(0xFFFF803B)
+
+	// RteCErrnoEnostr  Device not a stream
+	RteCErrnoEnostr = RtdbError(0xFFFF803C)
+
+	// RteCErrnoEnodata  No data available
+	RteCErrnoEnodata = RtdbError(0xFFFF803D)
+
+	// RteCErrnoEtime  Timer expired
+	RteCErrnoEtime = RtdbError(0xFFFF803E)
+
+	// RteCErrnoEnosr  Out of streams resources
+	RteCErrnoEnosr = RtdbError(0xFFFF803F)
+
+	// RteCErrnoEnonet  Machine is not on the network
+	RteCErrnoEnonet = RtdbError(0xFFFF8040)
+
+	// RteCErrnoEnopkg  Package not installed
+	RteCErrnoEnopkg = RtdbError(0xFFFF8041)
+
+	// RteCErrnoEremote  Object is remote
+	RteCErrnoEremote = RtdbError(0xFFFF8042)
+
+	// RteCErrnoEnolink  Link has been severed
+	RteCErrnoEnolink = RtdbError(0xFFFF8043)
+
+	// RteCErrnoEadv  Advertise error
+	RteCErrnoEadv = RtdbError(0xFFFF8044)
+
+	// RteCErrnoEsrmnt  Srmount error
+	RteCErrnoEsrmnt = RtdbError(0xFFFF8045)
+
+	// RteCErrnoEcomm  Communication error on send
+	RteCErrnoEcomm = RtdbError(0xFFFF8046)
+
+	// RteCErrnoEproto  Protocol error
+	RteCErrnoEproto = RtdbError(0xFFFF8047)
+
+	// RteCErrnoEmultihop  Multihop attempted
+	RteCErrnoEmultihop = RtdbError(0xFFFF8048)
+
+	// RteCErrnoEdotdot  RFS specific error
+	RteCErrnoEdotdot = RtdbError(0xFFFF8049)
+
+	// RteCErrnoEbadmsg  Not a data message
+	RteCErrnoEbadmsg = RtdbError(0xFFFF804A)
+
+	// RteCErrnoEoverflow  Value too large for defined data type
+	RteCErrnoEoverflow = RtdbError(0xFFFF804B)
+
+	// RteCErrnoEnotuniq  Name not unique on network
+	RteCErrnoEnotuniq = RtdbError(0xFFFF804C)
+
+	// RteCErrnoEbadfd  File descriptor in bad state
+	RteCErrnoEbadfd = RtdbError(0xFFFF804D)
+
+	// RteCErrnoEremchg  Remote address changed
+	RteCErrnoEremchg = RtdbError(0xFFFF804E)
+
+	// RteCErrnoElibacc  Can not access a needed shared library
+	RteCErrnoElibacc = RtdbError(0xFFFF804F)
+
+	// RteCErrnoElibbad  Accessing a corrupted shared library
+	RteCErrnoElibbad = RtdbError(0xFFFF8050)
+
+	// RteCErrnoElibscn  .lib section in a.out corrupted
+	RteCErrnoElibscn = RtdbError(0xFFFF8051)
+
+	// RteCErrnoElibmax  Attempting to link in too many shared libraries
+	RteCErrnoElibmax = RtdbError(0xFFFF8052)
+
+	// RteCErrnoElibexec  Cannot exec a shared library directly
+	RteCErrnoElibexec = RtdbError(0xFFFF8053)
+
+	// RteCErrnoEilseq  Illegal byte sequence
+	RteCErrnoEilseq = RtdbError(0xFFFF8054)
+
+	// RteCErrnoErestart  Interrupted system call should be restarted
+	RteCErrnoErestart = RtdbError(0xFFFF8055)
+
+	// RteCErrnoEstrpipe  Streams pipe error
+	RteCErrnoEstrpipe = RtdbError(0xFFFF8056)
+
+	// RteCErrnoEusers  Too many users
+	RteCErrnoEusers = RtdbError(0xFFFF8057)
+
+	// RteCErrnoEnotsock  Socket operation on non-socket
+	RteCErrnoEnotsock = RtdbError(0xFFFF8058)
+
+	// RteCErrnoEdestaddrreq  Destination address required
+	RteCErrnoEdestaddrreq = RtdbError(0xFFFF8059)
+
+	// RteCErrnoEmsgsize  Message too long
+	RteCErrnoEmsgsize = RtdbError(0xFFFF805A)
+
+	// RteCErrnoEprototype  Protocol wrong type for socket
+	RteCErrnoEprototype = RtdbError(0xFFFF805B)
+
+	// RteCErrnoEnoprotoopt  Protocol not available
+	RteCErrnoEnoprotoopt = RtdbError(0xFFFF805C)
+
+	// RteCErrnoEprotonosupport  Protocol not supported
+	RteCErrnoEprotonosupport = RtdbError(0xFFFF805D)
+
+	// RteCErrnoEsocktnosupport  Socket type not supported
+	RteCErrnoEsocktnosupport = RtdbError(0xFFFF805E)
+
+	// RteCErrnoEopnotsupp  Operation not supported on transport endpoint
+	RteCErrnoEopnotsupp = RtdbError(0xFFFF805F)
+
+	// RteCErrnoEpfnosupport  Protocol family not supported
+	RteCErrnoEpfnosupport = RtdbError(0xFFFF8060)
+
+	// RteCErrnoEafnosupport  Address family not supported by protocol
+	RteCErrnoEafnosupport = RtdbError(0xFFFF8061)
+
+	// RteCErrnoEaddrinuse  Address already in use
+	RteCErrnoEaddrinuse = RtdbError(0xFFFF8062)
+
+	// RteCErrnoEaddrnotavail  Cannot assign requested address
+	RteCErrnoEaddrnotavail = RtdbError(0xFFFF8063)
+
+	// RteCErrnoEnetdown  Network is down
+	RteCErrnoEnetdown = RtdbError(0xFFFF8064)
+
+	// RteCErrnoEnetunreach  Network is unreachable
+	RteCErrnoEnetunreach = RtdbError(0xFFFF8065)
+
+	// RteCErrnoEnetreset  Network dropped connection because of reset
+	RteCErrnoEnetreset = RtdbError(0xFFFF8066)
+
+	// RteCErrnoEconnaborted  Software caused connection abort
+	RteCErrnoEconnaborted = RtdbError(0xFFFF8067)
+
+	// RteCErrnoEconnreset  Connection reset by peer
+	RteCErrnoEconnreset = RtdbError(0xFFFF8068)
+
+	// RteCErrnoEnobufs  No buffer space available
+	RteCErrnoEnobufs = RtdbError(0xFFFF8069)
+
+	// RteCErrnoEisconn  Transport endpoint is already connected
+	RteCErrnoEisconn = RtdbError(0xFFFF806A)
+
+	// RteCErrnoEnotconn  Transport endpoint is not connected
+	RteCErrnoEnotconn = RtdbError(0xFFFF806B)
+
+	// RteCErrnoEshutdown  Cannot send after transport endpoint shutdown
+	RteCErrnoEshutdown = RtdbError(0xFFFF806C)
+
+	// RteCErrnoEtoomanyrefs  Too many references: cannot splice
+	RteCErrnoEtoomanyrefs = RtdbError(0xFFFF806D)
+
+	// RteCErrnoEtimedout  Connection timed out
+	RteCErrnoEtimedout = RtdbError(0xFFFF806E)
+
+	// RteCErrnoEconnrefused  Connection refused
+	RteCErrnoEconnrefused = RtdbError(0xFFFF806F)
+
+	// RteCErrnoEhostdown  Host is down
+	RteCErrnoEhostdown = RtdbError(0xFFFF8070)
+
+	// RteCErrnoEhostunreach  No route to host
+	RteCErrnoEhostunreach = RtdbError(0xFFFF8071)
+
+	// RteCErrnoEalready  Operation already in progress
+	RteCErrnoEalready = RtdbError(0xFFFF8072)
+
+	// RteCErrnoEinprogress  Operation now in progress
+	RteCErrnoEinprogress = RtdbError(0xFFFF8073)
+
+	// RteCErrnoEstale  Stale file handle
+	RteCErrnoEstale = RtdbError(0xFFFF8074)
+
+	// RteCErrnoEuclean  Structure needs cleaning
+	RteCErrnoEuclean = RtdbError(0xFFFF8075)
+
+	// RteCErrnoEnotnam  Not a XENIX named type file
+	RteCErrnoEnotnam = RtdbError(0xFFFF8076)
+
+	// RteCErrnoEnavail  No XENIX semaphores available
+	RteCErrnoEnavail = RtdbError(0xFFFF8077)
+
+	// RteCErrnoEisnam  Is a named type file
+	RteCErrnoEisnam = RtdbError(0xFFFF8078)
+
+	// RteCErrnoEremoteio  Remote I/O error
+	RteCErrnoEremoteio = RtdbError(0xFFFF8079)
+
+	// RteCErrnoEdquot  Quota exceeded
+	RteCErrnoEdquot = RtdbError(0xFFFF807A)
+
+	// RteCErrnoEnomedium  No medium found
+	RteCErrnoEnomedium = RtdbError(0xFFFF807B)
+
+	// RteCErrnoEmediumtype  Wrong medium type
+	RteCErrnoEmediumtype = RtdbError(0xFFFF807C)
+
+	// RteCErrnoEcanceled  Operation Canceled
+	RteCErrnoEcanceled = RtdbError(0xFFFF807D)
+
+	// RteCErrnoEnokey  Required key not available
+	RteCErrnoEnokey = RtdbError(0xFFFF807E)
+
+	// RteCErrnoEkeyexpired  Key has expired
+	RteCErrnoEkeyexpired = RtdbError(0xFFFF807F)
+
+	// RteCErrnoEkeyrevoked  Key has been revoked
+	RteCErrnoEkeyrevoked = RtdbError(0xFFFF8080)
+
+	// RteCErrnoEkeyrejected  Key was rejected by service
+	RteCErrnoEkeyrejected = RtdbError(0xFFFF8081)
+
+	// RteCErrnoEownerdead  Owner died
+	RteCErrnoEownerdead = RtdbError(0xFFFF8082)
+
+	// RteCErrnoEnotrecoverable  State not recoverable
+	RteCErrnoEnotrecoverable = RtdbError(0xFFFF8083)
+
+	// RteCErrnoErfkill  Operation not possible due to RF-kill
+	RteCErrnoErfkill = RtdbError(0xFFFF8084)
+
+	// RteCErrnoEhwpoison  Memory page has hardware error
+	RteCErrnoEhwpoison = RtdbError(0xFFFF8085)
+
+	// RteIpcError  ipc error begin
+	RteIpcError = RtdbError(0xFFFFC000)
+
+	// RteIpcErrorEnd  ipc error end
+	RteIpcErrorEnd = RtdbError(0xFFFFC09F)
+)
+
+// RtdbApiOption 用于设置API的工作模式的参数选项
+type RtdbApiOption uint32
+
+const (
+	// RtdbApiOptionAutoReconn api 在连接中断后是否自动重连, 0 不重连；1 重连。默认为 0 不重连
+	RtdbApiOptionAutoReconn = RtdbApiOption(0)
+
+	// RtdbApiOptionConnTimeout api 连接超时值设置（单位：毫秒）,0 阻塞模式，无限等待，默认为1000
+	RtdbApiOptionConnTimeout = RtdbApiOption(1)
+
+	// RtdbApiOptionSendTimeout api 发送超时值设置（单位：毫秒）,0 阻塞模式，无限等待，默认为1000
+	RtdbApiOptionSendTimeout = RtdbApiOption(2)
+
+	// RtdbApiOptionRecvTimeout api 接收超时值设置（单位：毫秒）,0 阻塞模式，无限等待，默认为60000
+	RtdbApiOptionRecvTimeout = RtdbApiOption(3)
+
+	// RtdbApiOptionUserTimeout api TCP_USER_TIMEOUT超时值设置（单位：毫秒），默认为10000，Linux内核2.6.37以上有效
+	RtdbApiOptionUserTimeout = RtdbApiOption(4)
+
+	// RtdbApiOptionDefaultPrecision api 默认的时间戳精度，当使用旧版相关的api，以及新版api中未设置时间戳精度时，则使用此默认时间戳精度。 默认为毫秒精度
+	RtdbApiOptionDefaultPrecision = RtdbApiOption(5)
+
+	// RtdbApiOptionServerPrecision api 连接3.0数据库时，设置3.0数据库的时间戳精度，0表示毫秒精度，非0表示纳秒精度，默认为毫秒精度
+	RtdbApiOptionServerPrecision = RtdbApiOption(6)
+)
+
+func (rao RtdbApiOption) Desc() string {
+	switch rao {
+	case RtdbApiOptionAutoReconn:
+		return "api 在连接中断后是否自动重连, 0 不重连；1 重连。默认为 0 不重连"
+	case RtdbApiOptionConnTimeout:
+		return "api 连接超时值设置（单位：毫秒）,0 阻塞模式，无限等待，默认为1000"
+	case RtdbApiOptionSendTimeout:
+		return "api 发送超时值设置（单位：毫秒）,0 阻塞模式，无限等待，默认为1000"
+	case RtdbApiOptionRecvTimeout:
+		return "api 接收超时值设置（单位：毫秒）,0 阻塞模式，无限等待，默认为60000"
+	case RtdbApiOptionUserTimeout:
+		return "api TCP_USER_TIMEOUT超时值设置（单位：毫秒），默认为10000，Linux内核2.6.37以上有效"
+	case RtdbApiOptionDefaultPrecision:
+		return "api 默认的时间戳精度，当使用旧版相关的api，以及新版api中未设置时间戳精度时，则使用此默认时间戳精度。 默认为毫秒精度"
+	case RtdbApiOptionServerPrecision:
+		return "api 连接3.0数据库时，设置3.0数据库的时间戳精度，0表示毫秒精度，非0表示纳秒精度，默认为毫秒精度"
+	default:
+		return "未知的RtdbApiOption"
+	}
+}
+
+// DatagramHandle 流句柄, 用于数据流订阅
+type DatagramHandle struct {
+	handle unsafe.Pointer
+}
+
+// ApiVersion 版本号
+type ApiVersion struct {
+	Major int32 // 主版本号
+	Minor int32 // 次版本号
+	Beta  int32 // 发布版本号
+}
+
+// PrivGroup 用户权限
+type PrivGroup uint32
+
+const (
+	// PrivGroupRtdbRO 只读
+	PrivGroupRtdbRO = PrivGroup(0)
+
+	// PrivGroupRtdbDW 数据记录
+	PrivGroupRtdbDW = PrivGroup(1)
+
+	// PrivGroupRtdbTA 标签点表管理员
+	PrivGroupRtdbTA = PrivGroup(2)
+
+	// PrivGroupRtdbSA 数据库管理员
+	PrivGroupRtdbSA = PrivGroup(3)
+)
+
+func (pg PrivGroup) Desc() string {
+	switch pg {
+	case PrivGroupRtdbRO:
+		return "只读"
+	case PrivGroupRtdbDW:
+		return "数据记录"
+	case PrivGroupRtdbTA:
+		return "标签点表管理员"
+	case PrivGroupRtdbSA:
+		return "数据库管理员"
+	default:
+		return "未知权限"
+	}
+}
+
+// ConnectHandle 连接句柄, 用于描述一个 API库 与 数据库 之间的连接
+type ConnectHandle int32
+
+// SocketHandle socket连接句柄
+type SocketHandle int32
+
+// RtdbParam 查询系统参数时对应的索引
+type RtdbParam int32
+
+// IsStringParam 判断系统参数是否为String类型，如果是则返回true，否则返回false
+func (p RtdbParam) IsStringParam() bool {
+	return p < rtdbParamStrLast
+}
+
+// rtdbParamStrLast 字符串类型参数的结束索引, 对应 RTDB_PARAM_STR_LAST
+const rtdbParamStrLast = RtdbParam(48)
+
+const (
+	// RtdbParamTableFile 标签点表文件全路径，字符串最大长度为 RTDB_MAX_PATH
+	RtdbParamTableFile = RtdbParam(0)
+
+	// RtdbParamBaseFile 基本标签点文件全路径，字符串最大长度为 RTDB_MAX_PATH
+	RtdbParamBaseFile = RtdbParam(1)
+
+	// RtdbParamScanFile 采集标签点文件全路径，字符串最大长度为 RTDB_MAX_PATH
+	RtdbParamScanFile = RtdbParam(2)
+
+	// RtdbParamCalcFile 计算标签点文件全路径，字符串最大长度为 RTDB_MAX_PATH
+	RtdbParamCalcFile = RtdbParam(3)
+
+	// RtdbParamSnapFile 标签点快照文件全路径，字符串最大长度为 RTDB_MAX_PATH
+	RtdbParamSnapFile = RtdbParam(4)
+
+	// RtdbParamLicFile 协议文件全路径，字符串最大长度为 RTDB_MAX_PATH
+	RtdbParamLicFile = RtdbParam(5)
+
+	// RtdbParamHisFile 历史信息文件全路径，字符串最大长度为 RTDB_MAX_PATH
+	RtdbParamHisFile = RtdbParam(6)
+
+	// RtdbParamLogDir 服务器端日志文件全路径，字符串最大长度为 RTDB_MAX_PATH
+	RtdbParamLogDir = RtdbParam(7)
+
+	// RtdbParamUserFile 用户权限信息文件全路径，字符串最大长度为 RTDB_MAX_PATH
+	RtdbParamUserFile = RtdbParam(8)
+
+	// RtdbParamServerFile 网络服务进程与其它进程交互所用的共享内存文件，字符串最大长度为 RTDB_MAX_PATH
+	RtdbParamServerFile = RtdbParam(9)
+
+	// RtdbParamEqautionFile 方程式服务进程与其它进程交互所用的共享内存文件，字符串最大长度为 RTDB_MAX_PATH
+	RtdbParamEqautionFile = RtdbParam(10)
+
+	// RtdbParamArvPagesFile 历史数据缓存文件全路径，字符串最大长度为 RTDB_MAX_PATH
+	RtdbParamArvPagesFile = RtdbParam(11)
+
+	// RtdbParamArvexPagesFile 补历史数据缓存文件全路径，字符串最大长度为 RTDB_MAX_PATH
+	RtdbParamArvexPagesFile = RtdbParam(12)
+
+	// RtdbParamArvexPagesBlobFile 补历史数据blob、str缓存文件全路径，字符串最大长度为 RTDB_MAX_PATH
+	RtdbParamArvexPagesBlobFile = RtdbParam(13)
+
+	// RtdbParamAuthFile 信任连接段信息文件路径，字符串最大长度为 RTDB_MAX_PATH
+	RtdbParamAuthFile = RtdbParam(14)
+
+	// RtdbParamRecycledBaseFile 可回收基本标签点文件全路径，字符串最大长度为 RTDB_MAX_PATH
+	RtdbParamRecycledBaseFile = RtdbParam(15)
+
+	// RtdbParamRecycledScanFile 可回收采集标签点文件全路径，字符串最大长度为 RTDB_MAX_PATH
+	RtdbParamRecycledScanFile = RtdbParam(16)
+
+	// RtdbParamRecycledCalcFile 可回收计算标签点文件全路径，字符串最大长度为 RTDB_MAX_PATH
+	RtdbParamRecycledCalcFile = RtdbParam(17)
+
+	// RtdbParamAutoBackupPath 自动备份目的地全路径，必须以“\”或“/”结束，字符串最大长度为 RTDB_MAX_PATH
+	RtdbParamAutoBackupPath = RtdbParam(18)
+
+	// RtdbParamServerSenderIp 镜像发送地址，字符串最大长度为 RTDB_MAX_HOSTNAME_SIZE
+	RtdbParamServerSenderIp = RtdbParam(19)
+
+	// RtdbParamBlacklistFile 连接黑名单文件路径，字符串最大长度为 RTDB_MAX_PATH
+	RtdbParamBlacklistFile = RtdbParam(20)
+
+	// RtdbParamDbVersion 数据库版本
+	RtdbParamDbVersion = RtdbParam(21)
+
+	// RtdbParamLicUser 授权单位
+	RtdbParamLicUser = RtdbParam(22)
+
+	// RtdbParamLicType 授权方式
+	RtdbParamLicType = RtdbParam(23)
+
+	// RtdbParamIndexDir 索引文件存放目录，字符串最大长度为 RTDB_MAX_PATH
+	RtdbParamIndexDir = RtdbParam(24)
+
+	// RtdbParamMirrorBufferPath 镜像缓存文件全路径，字符串最大长度为 RTDB_MAX_PATH
+	RtdbParamMirrorBufferPath = RtdbParam(25)
+
+	// RtdbParamMirrorExBufferPath 补写镜像缓存文件全路径，字符串最大长度为 RTDB_MAX_PATH
+	RtdbParamMirrorExBufferPath = RtdbParam(26)
+
+	// RtdbParamEqautionPathFile 方程式长度超过规定长度时进行保存的文件全路径，字符串最大长度为 RTDB_MAX_PATH
+	RtdbParamEqautionPathFile = RtdbParam(27)
+
+	// RtdbParamTagsFile 标签点关键属性文件全路径，字符串最大长度为 RTDB_MAX_PATH
+	RtdbParamTagsFile = RtdbParam(28)
+
+	// RtdbParamRecycledSnapFile 可回收标签点快照事件文件全路径，字符串最大长度为 RTDB_MAX_PATH
+	RtdbParamRecycledSnapFile = RtdbParam(29)
+
+	// RtdbParamSwapPageFile 历史数据交换页文件全路径，字符串最大长度为 RTDB_MAX_PATH
+	RtdbParamSwapPageFile = RtdbParam(30)
+
+	// RtdbParamPageAllocatorFile 活动存档数据页分配器文件全路径，字符串最大长度为 RTDB_MAX_PATH, 该系统配置项2.1版数据库在使用，3.0数据库已去掉，但为了保证系统选项索引号, 与2.1一致，此处不能去掉。便于java sdk统一调用
+	RtdbParamPageAllocatorFile = RtdbParam(31)
+
+	// RtdbParamNamedTypeFile 自定义类型配置信息全路径，字符串最大长度为 RTDB_MAX_PATH
+	RtdbParamNamedTypeFile = RtdbParam(32)
+
+	// RtdbParamStrblobMirrorPath BLOB/STRING镜像数据缓存文件全路径，字符串最大长度为 RTDB_MAX_PATH
+	RtdbParamStrblobMirrorPath = RtdbParam(33)
+
+	// RtdbParamStrblobMirrorExPath 补写BLOB/STRING镜像数据缓存文件全路径，字符串最大长度为 RTDB_MAX_PATH
+	RtdbParamStrblobMirrorExPath = RtdbParam(34)
+
+	// RtdbParamBufferDir 临时数据缓存路径
+	RtdbParamBufferDir = RtdbParam(35)
+
+	// RtdbParamPoolCacheFlie 曲线池索引文件全路径，字符串最大长度为 RTDB_MAX_PATH
+	RtdbParamPoolCacheFlie = RtdbParam(36)
+
+	// RtdbParamPoolDataFileDir 曲线池缓存文件目录，字符串最大长度为 RTDB_MAX_PATH
+	RtdbParamPoolDataFileDir = RtdbParam(37)
+
+	// RtdbParamArchiveFilePath 存档文件低速存储区路径，字符串最大长度为 RTDB_MAX_PATH
+	RtdbParamArchiveFilePath = RtdbParam(38)
+
+	// RtdbParamLicVersionType 授权版本
+	RtdbParamLicVersionType = RtdbParam(39)
+
+	// RtdbParamAutoMovePath 自动移动目的地全路径，必须以“\”或“/”结束，字符串最大长度为 RTDB_MAX_PATH
+	RtdbParamAutoMovePath = RtdbParam(40)
+
+	// RtdbParamReplicationBufferPath 双活：数据同步缓存文件全路径，字符串最大长度为 RTDB_MAX_PATH
+	RtdbParamReplicationBufferPath = RtdbParam(41)
+
+	// RtdbParamReplicationExBufferPath 双活：数据同步补写数据缓存文件全路径，字符串最大长度为 RTDB_MAX_PATH
+	RtdbParamReplicationExBufferPath = RtdbParam(42)
+
+	// RtdbParamStrblobReplicationBufferPath 双活：数据同步BLOB/STRING数据缓存文件全路径，字符串最大长度为 RTDB_MAX_PATH
+	RtdbParamStrblobReplicationBufferPath = RtdbParam(43)
+
+	// RtdbParamStrblobReplicationExBufferPath 双活：数据同步补写BLOB/STRING数据缓存文件全路径，字符串最大长度为 RTDB_MAX_PATH
+	RtdbParamStrblobReplicationExBufferPath = RtdbParam(44)
+
+	// RtdbParamReplicationGroupIp 双活：同步组地址，字符串最大长度为 RTDB_MAX_HOSTNAME_SIZE
+	RtdbParamReplicationGroupIp = RtdbParam(45)
+
+	// RtdbParamArcFilenamePrefixWhenUsingDate 是否归档文件使用日期作为文件名
+	RtdbParamArcFilenamePrefixWhenUsingDate = RtdbParam(46)
+
+	// RtdbParamHotArchiveFilePath 存档文件高速存储区路径，字符串最大长度为 RTDB_MAX_PATH
+	RtdbParamHotArchiveFilePath = RtdbParam(47)
+
+	// RtdbParamLicTablesCount 协议中限定的标签点表数量
+	RtdbParamLicTablesCount = RtdbParam(4096)
+
+	// RtdbParamLicTagsCount 协议中限定的所有标签点数量
+	RtdbParamLicTagsCount = RtdbParam(4097)
+
+	// RtdbParamLicScanCount 协议中限定的采集标签点数量
+	RtdbParamLicScanCount = RtdbParam(4098)
+
+	// RtdbParamLicCalcCount 协议中限定的计算标签点数量
+	RtdbParamLicCalcCount = RtdbParam(4099)
+
+	// RtdbParamLicArchicveCount 协议中限定的历史存档文件数量
+	RtdbParamLicArchicveCount = RtdbParam(4100)
+
+	// RtdbParamServerIpcSize 网络服务进程与其它进程进行交互所使用的共享内存池的字节尺寸（单位：B）
+	RtdbParamServerIpcSize = RtdbParam(4101)
+
+	// RtdbParamEquationIpcSize 方程式服务进程与其它进程进行交互所使用的共享内存池的字节尺寸（单位：B）
+	RtdbParamEquationIpcSize = RtdbParam(4102)
+
+	// RtdbParamHashTableSize 标签点求余哈希表的尺寸
+	RtdbParamHashTableSize = RtdbParam(4103)
+
+	// RtdbParamTagDeleteTimes 可整库删除标签点的次数
+	RtdbParamTagDeleteTimes = RtdbParam(4104)
+
+	// RtdbParamServerPort 网络服务独立服务器端口
+	RtdbParamServerPort = RtdbParam(4105)
+
+	// RtdbParamServerSenderPort 网络服务镜像发送端口
+	RtdbParamServerSenderPort = RtdbParam(4106)
+
+	// RtdbParamServerReceiverPort 网络服务镜像接收端口
+	RtdbParamServerReceiverPort = RtdbParam(4107)
+
+	// RtdbParamServerMode 网络服务启动模式
+	RtdbParamServerMode = RtdbParam(4108)
+
+	// RtdbParamServerConnectionCount 协议中限定网络服务连接并发数量
+	RtdbParamServerConnectionCount = RtdbParam(4109)
+
+	// RtdbParamArvPagesNumber 历史数据缓存中的页数量
+	RtdbParamArvPagesNumber = RtdbParam(4110)
+
+	// RtdbParamArvexPagesNumber 补历史数据缓存中的页数量
+	RtdbParamArvexPagesNumber = RtdbParam(4111)
+
+	// RtdbParamExceptionAtServer 是否由服务器进行例外判定
+	RtdbParamExceptionAtServer = RtdbParam(4112)
+
+	// RtdbParamArvPageRecycleDelay 历史数据缓存页回收延时（毫秒）
+	RtdbParamArvPageRecycleDelay = RtdbParam(4113)
+
+	// RtdbParamExArchiveSize 历史数据存档文件文件自动增长大小（单位：MB）
+	RtdbParamExArchiveSize = RtdbParam(4114)
+
+	// RtdbParamArchiveBatchSize 历史存储值分段查询个数
+	RtdbParamArchiveBatchSize = RtdbParam(4115)
+
+	// RtdbParamDatafilePagesize 系统数据文件页大小
+	RtdbParamDatafilePagesize = RtdbParam(4116)
+
+	// RtdbParamArvAsyncQueueNormalDoor 历史数据缓存队列中速归档区（单位：百分比）
+	RtdbParamArvAsyncQueueNormalDoor = RtdbParam(4117)
+
+	// RtdbParamIndexAlwaysInMemory 常驻内存的历史数据索引大小（单位：MB）
+	RtdbParamIndexAlwaysInMemory = RtdbParam(4118)
+
+	// RtdbParamDiskMinRestSize 最低可用磁盘空间（单位：MB）
+	RtdbParamDiskMinRestSize = RtdbParam(4119)
+
+	// RtdbParamMinSizeOfArchive 历史存档文件和附属文件的最小尺寸（单位：MB）
+	RtdbParamMinSizeOfArchive = RtdbParam(4120)
+
+	// RtdbParamDelayOfAutoMergeOrArrange 自动合并/整理最小延迟（单位：小时）
+	RtdbParamDelayOfAutoMergeOrArrange = RtdbParam(4121)
+
+	// RtdbParamStartOfAutoMergeOrArrange 自动合并/整理开始时间（单位：点钟）
+	RtdbParamStartOfAutoMergeOrArrange = RtdbParam(4122)
+
+	// RtdbParamStopOfAutoMergeOrArrange 自动合并/整理停止时间（单位：点钟）
+	RtdbParamStopOfAutoMergeOrArrange = RtdbParam(4123)
+
+	// RtdbParamStartOfAutoBackup 自动备份开始时间（单位：点钟）
+	RtdbParamStartOfAutoBackup = RtdbParam(4124)
+
+	// RtdbParamStopOfAutoBackup 自动备份停止时间（单位：点钟）
+	RtdbParamStopOfAutoBackup = RtdbParam(4125)
+
+	// RtdbParamMaxLatencyOfSnapshot 允许服务器时间之后多少小时内的数据进入快照（单位：小时）
+	RtdbParamMaxLatencyOfSnapshot = RtdbParam(4126)
+
+	// RtdbParamPageAllocatorReserveSize 活动页分配器预留大小（单位：KB）， 0 表示使用操作系统视图大小
+	RtdbParamPageAllocatorReserveSize = RtdbParam(4127)
+
+	// RtdbParamIncludeSnapshotInQuery 决定取样本值和统计值时，快照是否应该出现在查询结果中
+	RtdbParamIncludeSnapshotInQuery = RtdbParam(4128)
+
+	// RtdbParamLicBlobCount 协议中限定的字符串或BLOB类型标签点数量
+	RtdbParamLicBlobCount = RtdbParam(4129)
+
+	// RtdbParamMirrorBufferSize 镜像文件大小（单位：GB）
+	RtdbParamMirrorBufferSize = RtdbParam(4130)
+
+	// RtdbParamBlobArvexPagesNumber blob、str补历史的默认缓存页数量
+	RtdbParamBlobArvexPagesNumber = RtdbParam(4131)
+
+	// RtdbParamMirrorEventQueueCapacity 镜像缓存队列容量
+	RtdbParamMirrorEventQueueCapacity = RtdbParam(4132)
+
+	// RtdbParamNotifyNotEnoughSpace 提示磁盘空间不足，一旦启用，设置为ON，则通过API返回大错误码，否则只记录日志
+	RtdbParamNotifyNotEnoughSpace = RtdbParam(4133)
+
+	// RtdbParamArchiveFixedRange 历史数据存档文件的固定时间范围，默认为0表示不使用固定时间范围（单位：分钟）
+	RtdbParamArchiveFixedRange = RtdbParam(4134)
+
+	// RtdbParamOneClinetMaxConnectionCount 单个客户端允许的最大连接数，默认为0表示不限制
+	RtdbParamOneClinetMaxConnectionCount = RtdbParam(4135)
+
+	// RtdbParamArvPagesCapacity 历史数据缓存所占字节大小，单位：字节
+	RtdbParamArvPagesCapacity = RtdbParam(4136)
+
+	// RtdbParamArvexPagesCapacity 历史数据补写缓存所占字节大小，单位：字节
+	RtdbParamArvexPagesCapacity = RtdbParam(4137)
+
+	// RtdbParamBlobArvexPagesCapacity blob、string类型标签点历史数据补写缓存所占字节大小，单位：字节
+	RtdbParamBlobArvexPagesCapacity = RtdbParam(4138)
+
+	// RtdbParamLockedPagesMem 指定分配给数据库用的内存大小，单位：MB
+	RtdbParamLockedPagesMem = RtdbParam(4139)
+
+	// RtdbParamLicRecycleCount 协议中回收站的容量
+	RtdbParamLicRecycleCount = RtdbParam(4140)
+
+	// RtdbParamArchivedPolicy 快照数据和补写数据的归档策略
+	RtdbParamArchivedPolicy = RtdbParam(4141)
+
+	// RtdbParamNetworkIsolationAckByte 网络隔离装置ACK字节
+	RtdbParamNetworkIsolationAckByte = RtdbParam(4142)
+
+	// RtdbParamEnableLogger 启用日志输出，0为不启用
+	RtdbParamEnableLogger = RtdbParam(4143)
+
+	// RtdbParamLogEncode 启用日志加密，0为不启用
+	RtdbParamLogEncode = RtdbParam(4144)
+
+	// RtdbParamLoginTry 启用登录失败次数验证，0为不启用
+	RtdbParamLoginTry = RtdbParam(4145)
+
+	// RtdbParamUserLog 启用用户详细日志，0为不启用
+	RtdbParamUserLog = RtdbParam(4146)
+
+	// RtdbParamCoverWriteLog 启用日志覆盖写功能，0为不启用
+	RtdbParamCoverWriteLog = RtdbParam(4147)
+
+	// RtdbParamLicNamedTypeCount 协议中限定的自定义类型标签点数量
+	RtdbParamLicNamedTypeCount = RtdbParam(4148)
+
+	// RtdbParamMirrorReceiverThreadpoolSize 镜像接收线程数量
+	RtdbParamMirrorReceiverThreadpoolSize = RtdbParam(4149)
+
+	// RtdbParamSnapshotUseArchiveInterface 按照补历史流程归档快照数据页
+	RtdbParamSnapshotUseArchiveInterface = RtdbParam(4150)
+
+	// RtdbParamNoArcdataWriteLog 归档无对应存档文件的数据时记录日志
+	RtdbParamNoArcdataWriteLog = RtdbParam(4151)
+
+	// RtdbParamPutArchiveThreadNum 补历史归档线程数
+	RtdbParamPutArchiveThreadNum = RtdbParam(4152)
+
+	// RtdbParamArvexDataArchivedThreshold 单次补写数据归档阈值
+	RtdbParamArvexDataArchivedThreshold = RtdbParam(4153)
+
+	// RtdbParamSnapshotFlushBufferDelay 快照服务的共享缓存刷新到磁盘的周期
+	RtdbParamSnapshotFlushBufferDelay = RtdbParam(4154)
+
+	// RtdbParamDataSpeed 查询时使用加速统计
+	RtdbParamDataSpeed = RtdbParam(4155)
+
+	// RtdbParamUseNewPlotAlgo 启用新的曲线算法
+	RtdbParamUseNewPlotAlgo = RtdbParam(4156)
+
+	// RtdbParamQueryThreadPoolSize 曲线查询线程池中线程数量
+	RtdbParamQueryThreadPoolSize = RtdbParam(4157)
+
+	// RtdbParamArchivedValues 使用查询线程池查询历史数据
+	RtdbParamArchivedValues = RtdbParam(4158)
+
+	// RtdbParamArchivedValuesCount 使用查询线程池查询历史数据的条数
+	RtdbParamArchivedValuesCount = RtdbParam(4159)
+
+	// RtdbParamPoolUseFlag 启用曲线池
+	RtdbParamPoolUseFlag = RtdbParam(4160)
+
+	// RtdbParamPoolOutLogFlag 输出曲线池日志
+	RtdbParamPoolOutLogFlag = RtdbParam(4161)
+
+	// RtdbParamPoolTimeUsePoolFlag 使用曲线池缓存计算插值
+	RtdbParamPoolTimeUsePoolFlag = RtdbParam(4162)
+
+	// RtdbParamPoolMaxPointCount 曲线池的标签点容量
+	RtdbParamPoolMaxPointCount = RtdbParam(4163)
+
+	// RtdbParamPoolOneFileSavePointCount 曲线池每个数据文件的标签点容量
+	RtdbParamPoolOneFileSavePointCount = RtdbParam(4164)
+
+	// RtdbParamPoolSaveMemorySize 曲线缓存退出时临时缓冲区大小
+	RtdbParamPoolSaveMemorySize = RtdbParam(4165)
+
+	// RtdbParamPoolMinTimeUnitSeconds 曲线池缓存数据当前时间单位
+	RtdbParamPoolMinTimeUnitSeconds = RtdbParam(4166)
+
+	// RtdbParamPoolTimeUnitViewRate 曲线池查询数据最小时间单位显示系数
+	RtdbParamPoolTimeUnitViewRate = RtdbParam(4167)
+
+	// RtdbParamPoolTimerIntervalSeconds 曲线池定时器刷新周期
+	RtdbParamPoolTimerIntervalSeconds = RtdbParam(4168)
+
+	// RtdbParamPoolPerfTimerIntervalSeconds 曲线池性能计算点刷新周期
+	RtdbParamPoolPerfTimerIntervalSeconds = RtdbParam(4169)
+
+	// RtdbParamArchiveInitFileSize 存档文件初始大小
+	RtdbParamArchiveInitFileSize = RtdbParam(4170)
+
+	// RtdbParamArchiveIncreaseMode 存档文件增长模式
+	RtdbParamArchiveIncreaseMode = RtdbParam(4171)
+
+	// RtdbParamArchiveIncreaseSize 固定模式下文件增长大小
+	RtdbParamArchiveIncreaseSize = RtdbParam(4172)
+
+	// RtdbParamArchiveIncreasePercent 百分比模式下增长百分比
+	RtdbParamArchiveIncreasePercent = RtdbParam(4173)
+
+	// RtdbParamAllowConvertSklToRbtIndex 跳跃链表转换到红黑树
+	RtdbParamAllowConvertSklToRbtIndex = RtdbParam(4174)
+
+	// RtdbParamEarlyDataTime 冷数据时间
+	RtdbParamEarlyDataTime = RtdbParam(4175)
+
+	// RtdbParamEarlyIndexTime 自动转换索引时间
+	RtdbParamEarlyIndexTime = RtdbParam(4176)
+
+	// RtdbParamArrangeRbtTime 整理存档文件时决定索引格式的时间轴
+	RtdbParamArrangeRbtTime = RtdbParam(4177)
+
+	// RtdbParamEnableBigData 将存档文件全部读取到内存中
+	RtdbParamEnableBigData = RtdbParam(4178)
+
+	// RtdbParamAutoArrangePercent 自动整理存档文件时的实际使用率
+	RtdbParamAutoArrangePercent = RtdbParam(4179)
+
+	// RtdbParamEarlyArrangeTime 自动整理存档文件的时间
+	RtdbParamEarlyArrangeTime = RtdbParam(4180)
+
+	// RtdbParamMinAutoArrangeArcfilePercent 自动整理存档文件时的最小使用率
+	RtdbParamMinAutoArrangeArcfilePercent = RtdbParam(4181)
+
+	// RtdbParamArrangeArcWithMemory 在内存中整理存档文件
+	RtdbParamArrangeArcWithMemory = RtdbParam(4182)
+
+	// RtdbParamAraangeArcMaxMemPercent 整理存档文件最大内存使用率
+	RtdbParamAraangeArcMaxMemPercent = RtdbParam(4183)
+
+	// RtdbParamMaxDiskSpacePercent 磁盘最大使用率
+	RtdbParamMaxDiskSpacePercent = RtdbParam(4184)
+
+	// RtdbParamUseDispath windows 用 linux 已禁用,是否启用转发服务
+	RtdbParamUseDispath = RtdbParam(4185)
+
+	// RtdbParamUseSmartParam windows 用 linux 已禁用,是否使用推荐参数
+	RtdbParamUseSmartParam = RtdbParam(4186)
+
+	// RtdbParamSubscribeSnapshotCount 单连接快照事件订阅个数
+	RtdbParamSubscribeSnapshotCount = RtdbParam(4187)
+
+	// RtdbParamSubscribeQueueSize 订阅事件队列大小
+	RtdbParamSubscribeQueueSize = RtdbParam(4188)
+
+	// RtdbParamSubscribeTimeout 订阅事件超时时间
+	RtdbParamSubscribeTimeout = RtdbParam(4189)
+
+	// RtdbParamMirrorCompressOnoff 镜像报文压缩是否打开
+	RtdbParamMirrorCompressOnoff = RtdbParam(4190)
+
+	// RtdbParamMirrorCompressType 镜像报文压缩类型
+	RtdbParamMirrorCompressType = RtdbParam(4191)
+
+	// RtdbParamMirrorCompressMin 镜像报文压缩最小值
+	RtdbParamMirrorCompressMin = RtdbParam(4192)
+
+	// RtdbParamArchiveRollTime 存档文件滚动时间轴
+	RtdbParamArchiveRollTime = RtdbParam(4193)
+
+	// RtdbParamHandleTimeOut 连接超时断开，单位：秒
+	RtdbParamHandleTimeOut = RtdbParam(4194)
+
+	// RtdbParamMoveArvTime 移动存档文件时决定移动存档的时间轴
+	RtdbParamMoveArvTime = RtdbParam(4195)
+
+	// RtdbParamUseNewInterpAlgo 启用新的插值算法
+	RtdbParamUseNewInterpAlgo = RtdbParam(4196)
+
+	// RtdbParamEnableReplication 启用双活，0为不启用，1为启用
+	RtdbParamEnableReplication = RtdbParam(4197)
+
+	// RtdbParamReplicationGroupPort 双活：同步组端口
+	RtdbParamReplicationGroupPort = RtdbParam(4198)
+
+	// RtdbParamReplicationThreadSize 双活：同步线程数
+	RtdbParamReplicationThreadSize = RtdbParam(4199)
+
+	// RtdbParamForceArchiveIncompleteDataPageDelay 强制归档补历史缓存里面未满数据页的延迟时间
+	RtdbParamForceArchiveIncompleteDataPageDelay = RtdbParam(4200)
+
+	// RtdbParamArchiveRollDiskPercentage 存档文件滚动存储空间百分比
+	RtdbParamArchiveRollDiskPercentage = RtdbParam(4201)
+
+	// RtdbParamEnableIpv6 启用ipv6设置
+	RtdbParamEnableIpv6 = RtdbParam(4202)
+
+	// RtdbParamEnableUseArchivedValue 按条件获取历史值时，是否直接获取条件中点的历史值，0:获取插值，1:获取历史值
+	RtdbParamEnableUseArchivedValue = RtdbParam(4203)
+
+	// RtdbParamTimestampType 获取服务器时间戳类型
+	RtdbParamTimestampType = RtdbParam(4204)
+
+	// RtdbParamArcFilenameUsingDate 是否归档文件使用日期作为文件名
+	RtdbParamArcFilenameUsingDate = RtdbParam(4205)
+
+	// RtdbParamLogMaxSpace 日志文件占用的最大磁盘空间
+	RtdbParamLogMaxSpace = RtdbParam(4206)
+
+	// RtdbParamLogFileSize 单个日志文件大小
+	RtdbParamLogFileSize = RtdbParam(4207)
+
+	// RtdbParamIgnoreToWriteNoarcbuffer 是否丢弃补历史数据
+	RtdbParamIgnoreToWriteNoarcbuffer = RtdbParam(4208)
+
+	// RtdbParamArchivesCountForCalc 统计存档文件平均大小的存档文件个数
+	RtdbParamArchivesCountForCalc = RtdbParam(4209)
+
+	// RtdbParamMaxBlobSize blob、str类型数据在数据库中允许的最大长度
+	RtdbParamMaxBlobSize = RtdbParam(8192)
+)
+
+func (rp RtdbParam) Desc() string {
+	switch rp {
+	case RtdbParamTableFile:
+		return "标签点表文件全路径，字符串最大长度为 RTDB_MAX_PATH"
+	case RtdbParamBaseFile:
+		return "基本标签点文件全路径，字符串最大长度为 RTDB_MAX_PATH"
+	case RtdbParamScanFile:
+		return "采集标签点文件全路径，字符串最大长度为 RTDB_MAX_PATH"
+	case RtdbParamCalcFile:
+		return "计算标签点文件全路径，字符串最大长度为 RTDB_MAX_PATH"
+	case RtdbParamSnapFile:
+		return "标签点快照文件全路径，字符串最大长度为 RTDB_MAX_PATH"
+	case RtdbParamLicFile:
+		return "协议文件全路径，字符串最大长度为 RTDB_MAX_PATH"
+	case RtdbParamHisFile:
+		return "历史信息文件全路径，字符串最大长度为 RTDB_MAX_PATH"
+	case RtdbParamLogDir:
+		return "服务器端日志文件全路径，字符串最大长度为 RTDB_MAX_PATH"
+	case RtdbParamUserFile:
+		return "用户权限信息文件全路径，字符串最大长度为 RTDB_MAX_PATH"
+	case RtdbParamServerFile:
+		return "网络服务进程与其它进程交互所用的共享内存文件，字符串最大长度为 RTDB_MAX_PATH"
+	case RtdbParamEqautionFile:
+		return "方程式服务进程与其它进程交互所用的共享内存文件，字符串最大长度为 RTDB_MAX_PATH"
+	case RtdbParamArvPagesFile:
+		return "历史数据缓存文件全路径，字符串最大长度为 RTDB_MAX_PATH"
+	case RtdbParamArvexPagesFile:
+		return "补历史数据缓存文件全路径，字符串最大长度为 RTDB_MAX_PATH"
+	case RtdbParamArvexPagesBlobFile:
+		return "补历史数据blob、str缓存文件全路径，字符串最大长度为 RTDB_MAX_PATH"
+	case RtdbParamAuthFile:
+		return "信任连接段信息文件路径，字符串最大长度为 RTDB_MAX_PATH"
+	case RtdbParamRecycledBaseFile:
+		return "可回收基本标签点文件全路径，字符串最大长度为 RTDB_MAX_PATH"
+	case RtdbParamRecycledScanFile:
+		return "可回收采集标签点文件全路径，字符串最大长度为 RTDB_MAX_PATH"
+	case RtdbParamRecycledCalcFile:
+		return "可回收计算标签点文件全路径，字符串最大长度为 RTDB_MAX_PATH"
+	case RtdbParamAutoBackupPath:
+		return "自动备份目的地全路径，必须以“\\”或“/”结束，字符串最大长度为 RTDB_MAX_PATH"
+	case RtdbParamServerSenderIp:
+		return "镜像发送地址，字符串最大长度为 RTDB_MAX_HOSTNAME_SIZE"
+	case RtdbParamBlacklistFile:
+		return "连接黑名单文件路径，字符串最大长度为 RTDB_MAX_PATH"
+	case RtdbParamDbVersion:
+		return "数据库版本"
+	case RtdbParamLicUser:
+		return "授权单位"
+	case RtdbParamLicType:
+		return "授权方式"
+	case RtdbParamIndexDir:
+		return "索引文件存放目录，字符串最大长度为 RTDB_MAX_PATH"
+	case RtdbParamMirrorBufferPath:
+		return "镜像缓存文件全路径，字符串最大长度为 RTDB_MAX_PATH"
+	case RtdbParamMirrorExBufferPath:
+		return "补写镜像缓存文件全路径，字符串最大长度为 RTDB_MAX_PATH"
+	case RtdbParamEqautionPathFile:
+		return "方程式长度超过规定长度时进行保存的文件全路径，字符串最大长度为 RTDB_MAX_PATH"
+	case RtdbParamTagsFile:
+		return "标签点关键属性文件全路径，字符串最大长度为 RTDB_MAX_PATH"
+	case RtdbParamRecycledSnapFile:
+		return "可回收标签点快照事件文件全路径，字符串最大长度为 RTDB_MAX_PATH"
+	case RtdbParamSwapPageFile:
+		return "历史数据交换页文件全路径，字符串最大长度为 RTDB_MAX_PATH"
+	case RtdbParamPageAllocatorFile:
+		return "活动存档数据页分配器文件全路径，字符串最大长度为 RTDB_MAX_PATH, 该系统配置项2.1版数据库在使用，3.0数据库已去掉，但为了保证系统选项索引号, 与2.1一致，此处不能去掉。便于java sdk统一调用"
+	case RtdbParamNamedTypeFile:
+		return "自定义类型配置信息全路径，字符串最大长度为 RTDB_MAX_PATH"
+	case RtdbParamStrblobMirrorPath:
+		return "BLOB/STRING镜像数据缓存文件全路径，字符串最大长度为 RTDB_MAX_PATH"
+	case RtdbParamStrblobMirrorExPath:
+		return "补写BLOB/STRING镜像数据缓存文件全路径，字符串最大长度为 RTDB_MAX_PATH"
+	case RtdbParamBufferDir:
+		return "临时数据缓存路径"
+	case RtdbParamPoolCacheFlie:
+		return "曲线池索引文件全路径，字符串最大长度为 RTDB_MAX_PATH"
+	case RtdbParamPoolDataFileDir:
+		return "曲线池缓存文件目录，字符串最大长度为 RTDB_MAX_PATH"
+	case RtdbParamArchiveFilePath:
+		return "存档文件低速存储区路径，字符串最大长度为 RTDB_MAX_PATH"
+	case RtdbParamLicVersionType:
+		return "授权版本"
+	case RtdbParamAutoMovePath:
+		return "自动移动目的地全路径，必须以“\\”或“/”结束，字符串最大长度为 RTDB_MAX_PATH"
+	case RtdbParamReplicationBufferPath:
+		return "双活：数据同步缓存文件全路径，字符串最大长度为 RTDB_MAX_PATH"
+	case RtdbParamReplicationExBufferPath:
+		return "双活：数据同步补写数据缓存文件全路径，字符串最大长度为 RTDB_MAX_PATH"
+	case RtdbParamStrblobReplicationBufferPath:
+		return "双活：数据同步BLOB/STRING数据缓存文件全路径，字符串最大长度为 RTDB_MAX_PATH"
+	case RtdbParamStrblobReplicationExBufferPath:
+		return "双活：数据同步补写BLOB/STRING数据缓存文件全路径，字符串最大长度为 RTDB_MAX_PATH"
+	case RtdbParamReplicationGroupIp:
+		return "双活：同步组地址，字符串最大长度为 RTDB_MAX_HOSTNAME_SIZE"
+	case RtdbParamArcFilenamePrefixWhenUsingDate:
+		return "是否归档文件使用日期作为文件名"
+	case RtdbParamHotArchiveFilePath:
+		return "存档文件高速存储区路径，字符串最大长度为 RTDB_MAX_PATH"
+	case RtdbParamLicTablesCount:
+		return "协议中限定的标签点表数量"
+	case RtdbParamLicTagsCount:
+		return "协议中限定的所有标签点数量"
+	case RtdbParamLicScanCount:
+		return "协议中限定的采集标签点数量"
+	case RtdbParamLicCalcCount:
+		return "协议中限定的计算标签点数量"
+	case RtdbParamLicArchicveCount:
+		return "协议中限定的历史存档文件数量"
+	case RtdbParamServerIpcSize:
+		return "网络服务进程与其它进程进行交互所使用的共享内存池的字节尺寸（单位：B）"
+	case RtdbParamEquationIpcSize:
+		return "方程式服务进程与其它进程进行交互所使用的共享内存池的字节尺寸（单位：B）"
+	case RtdbParamHashTableSize:
+		return "标签点求余哈希表的尺寸"
+	case RtdbParamTagDeleteTimes:
+		return "可整库删除标签点的次数"
+	case RtdbParamServerPort:
+		return "网络服务独立服务器端口"
+	case RtdbParamServerSenderPort:
+		return "网络服务镜像发送端口"
+	case RtdbParamServerReceiverPort:
+		return "网络服务镜像接收端口"
+	case RtdbParamServerMode:
+		return "网络服务启动模式"
+	case RtdbParamServerConnectionCount:
+		return "协议中限定网络服务连接并发数量"
+	case RtdbParamArvPagesNumber:
+		return "历史数据缓存中的页数量"
+	case RtdbParamArvexPagesNumber:
+		return "补历史数据缓存中的页数量"
+	case RtdbParamExceptionAtServer:
+		return "是否由服务器进行例外判定"
+	case RtdbParamArvPageRecycleDelay:
+		return "历史数据缓存页回收延时（毫秒）"
+	case RtdbParamExArchiveSize:
+		return "历史数据存档文件文件自动增长大小（单位：MB）"
+	case RtdbParamArchiveBatchSize:
+		return "历史存储值分段查询个数"
+	case RtdbParamDatafilePagesize:
+		return "系统数据文件页大小"
+	case RtdbParamArvAsyncQueueNormalDoor:
+		return "历史数据缓存队列中速归档区（单位：百分比）"
+	case RtdbParamIndexAlwaysInMemory:
+		return "常驻内存的历史数据索引大小（单位：MB）"
+	case RtdbParamDiskMinRestSize:
+		return "最低可用磁盘空间（单位：MB）"
+	case RtdbParamMinSizeOfArchive:
+		return "历史存档文件和附属文件的最小尺寸（单位：MB）"
+	case RtdbParamDelayOfAutoMergeOrArrange:
+		return "自动合并/整理最小延迟（单位：小时）"
+	case RtdbParamStartOfAutoMergeOrArrange:
+		return "自动合并/整理开始时间（单位：点钟）"
+	case RtdbParamStopOfAutoMergeOrArrange:
+		return "自动合并/整理停止时间（单位：点钟）"
+	case RtdbParamStartOfAutoBackup:
+		return "自动备份开始时间（单位：点钟）"
+	case RtdbParamStopOfAutoBackup:
+		return "自动备份停止时间（单位：点钟）"
+	case RtdbParamMaxLatencyOfSnapshot:
+		return "允许服务器时间之后多少小时内的数据进入快照（单位：小时）"
+	case RtdbParamPageAllocatorReserveSize:
+		return "活动页分配器预留大小（单位：KB）， 0 表示使用操作系统视图大小"
+	case RtdbParamIncludeSnapshotInQuery:
+		return "决定取样本值和统计值时，快照是否应该出现在查询结果中"
+	case RtdbParamLicBlobCount:
+		return "协议中限定的字符串或BLOB类型标签点数量"
+	case RtdbParamMirrorBufferSize:
+		return "镜像文件大小（单位：GB）"
+	case RtdbParamBlobArvexPagesNumber:
+		return "blob、str补历史的默认缓存页数量"
+	case RtdbParamMirrorEventQueueCapacity:
+		return "镜像缓存队列容量"
+	case RtdbParamNotifyNotEnoughSpace:
+		return "提示磁盘空间不足，一旦启用，设置为ON，则通过API返回大错误码，否则只记录日志"
+	case RtdbParamArchiveFixedRange:
+		return "历史数据存档文件的固定时间范围，默认为0表示不使用固定时间范围（单位：分钟）"
+	case RtdbParamOneClinetMaxConnectionCount:
+		return "单个客户端允许的最大连接数，默认为0表示不限制"
+	case RtdbParamArvPagesCapacity:
+		return "历史数据缓存所占字节大小，单位：字节"
+	case RtdbParamArvexPagesCapacity:
+		return "历史数据补写缓存所占字节大小，单位：字节"
+	case RtdbParamBlobArvexPagesCapacity:
+		return "blob、string类型标签点历史数据补写缓存所占字节大小，单位：字节"
+	case RtdbParamLockedPagesMem:
+		return "指定分配给数据库用的内存大小，单位：MB"
+	case RtdbParamLicRecycleCount:
+		return "协议中回收站的容量"
+	case RtdbParamArchivedPolicy:
+		return "快照数据和补写数据的归档策略"
+	case RtdbParamNetworkIsolationAckByte:
+		return "网络隔离装置ACK字节"
+	case RtdbParamEnableLogger:
+		return "启用日志输出，0为不启用"
+	case RtdbParamLogEncode:
+		return "启用日志加密，0为不启用"
+	case RtdbParamLoginTry:
+		return "启用登录失败次数验证，0为不启用"
+	case RtdbParamUserLog:
+		return "启用用户详细日志，0为不启用"
+	case RtdbParamCoverWriteLog:
+		return "启用日志覆盖写功能，0为不启用"
+	case RtdbParamLicNamedTypeCount:
+		return "协议中限定的自定义类型标签点数量"
+	case RtdbParamMirrorReceiverThreadpoolSize:
+		return "镜像接收线程数量"
+	case RtdbParamSnapshotUseArchiveInterface:
+		return "按照补历史流程归档快照数据页"
+	case RtdbParamNoArcdataWriteLog:
+		return "归档无对应存档文件的数据时记录日志"
+	case RtdbParamPutArchiveThreadNum:
+		return "补历史归档线程数"
+	case RtdbParamArvexDataArchivedThreshold:
+		return "单次补写数据归档阈值"
+	case RtdbParamSnapshotFlushBufferDelay:
+		return "快照服务的共享缓存刷新到磁盘的周期"
+	case RtdbParamDataSpeed:
+		return "查询时使用加速统计"
+	case RtdbParamUseNewPlotAlgo:
+		return "启用新的曲线算法"
+	case RtdbParamQueryThreadPoolSize:
+		return "曲线查询线程池中线程数量"
+	case RtdbParamArchivedValues:
+		return "使用查询线程池查询历史数据"
+	case RtdbParamArchivedValuesCount:
+		return "使用查询线程池查询历史数据的条数"
+	case RtdbParamPoolUseFlag:
+		return "启用曲线池"
+	case RtdbParamPoolOutLogFlag:
+		return "输出曲线池日志"
+	case RtdbParamPoolTimeUsePoolFlag:
+		return "使用曲线池缓存计算插值"
+	case RtdbParamPoolMaxPointCount:
+		return "曲线池的标签点容量"
+	case RtdbParamPoolOneFileSavePointCount:
+		return "曲线池每个数据文件的标签点容量"
+	case RtdbParamPoolSaveMemorySize:
+		return "曲线缓存退出时临时缓冲区大小"
+	case RtdbParamPoolMinTimeUnitSeconds:
+		return "曲线池缓存数据当前时间单位"
+	case RtdbParamPoolTimeUnitViewRate:
+		return "曲线池查询数据最小时间单位显示系数"
+	case RtdbParamPoolTimerIntervalSeconds:
+		return "曲线池定时器刷新周期"
+	case RtdbParamPoolPerfTimerIntervalSeconds:
+		return "曲线池性能计算点刷新周期"
+	case RtdbParamArchiveInitFileSize:
+		return "存档文件初始大小"
+	case RtdbParamArchiveIncreaseMode:
+		return "存档文件增长模式"
+	case RtdbParamArchiveIncreaseSize:
+		return "固定模式下文件增长大小"
+	case RtdbParamArchiveIncreasePercent:
+		return "百分比模式下增长百分比"
+	case RtdbParamAllowConvertSklToRbtIndex:
+		return "跳跃链表转换到红黑树"
+	case RtdbParamEarlyDataTime:
+		return "冷数据时间"
+	case RtdbParamEarlyIndexTime:
+		return "自动转换索引时间"
+	case RtdbParamArrangeRbtTime:
+		return "整理存档文件时决定索引格式的时间轴"
+	case RtdbParamEnableBigData:
+		return "将存档文件全部读取到内存中"
+	case RtdbParamAutoArrangePercent:
+		return "自动整理存档文件时的实际使用率"
+	case RtdbParamEarlyArrangeTime:
+		return "自动整理存档文件的时间"
+	case RtdbParamMinAutoArrangeArcfilePercent:
+		return "自动整理存档文件时的最小使用率"
+	case RtdbParamArrangeArcWithMemory:
+		return "在内存中整理存档文件"
+	case RtdbParamAraangeArcMaxMemPercent:
+		return "整理存档文件最大内存使用率"
+	case RtdbParamMaxDiskSpacePercent:
+		return "磁盘最大使用率"
+	case RtdbParamUseDispath:
+		return "windows 用 linux 已禁用,是否启用转发服务"
+	case RtdbParamUseSmartParam:
+		return "windows 用 linux 已禁用,是否使用推荐参数"
+	case RtdbParamSubscribeSnapshotCount:
+		return "单连接快照事件订阅个数"
+	case RtdbParamSubscribeQueueSize:
+		return "订阅事件队列大小"
+	case RtdbParamSubscribeTimeout:
+		return "订阅事件超时时间"
+	case RtdbParamMirrorCompressOnoff:
+		return "镜像报文压缩是否打开"
+	case RtdbParamMirrorCompressType:
+		return "镜像报文压缩类型"
+	case RtdbParamMirrorCompressMin:
+		return "镜像报文压缩最小值"
+	case RtdbParamArchiveRollTime:
+		return "存档文件滚动时间轴"
+	case RtdbParamHandleTimeOut:
+		return "连接超时断开，单位：秒"
+	case RtdbParamMoveArvTime:
+		return "移动存档文件时决定移动存档的时间轴"
+	case RtdbParamUseNewInterpAlgo:
+		return "启用新的插值算法"
+	case RtdbParamEnableReplication:
+		return "启用双活，0为不启用，1为启用"
+	case RtdbParamReplicationGroupPort:
+		return "双活：同步组端口"
+	case RtdbParamReplicationThreadSize:
+		return "双活：同步线程数"
+	case RtdbParamForceArchiveIncompleteDataPageDelay:
+		return "强制归档补历史缓存里面未满数据页的延迟时间"
+	case RtdbParamArchiveRollDiskPercentage:
+		return "存档文件滚动存储空间百分比"
+	case RtdbParamEnableIpv6:
+		return "启用ipv6设置"
+	case RtdbParamEnableUseArchivedValue:
+		return "按条件获取历史值时，是否直接获取条件中点的历史值，0:获取插值，1:获取历史值"
+	case RtdbParamTimestampType:
+		return "获取服务器时间戳类型"
+	case RtdbParamArcFilenameUsingDate:
+		return "是否归档文件使用日期作为文件名"
+	case RtdbParamLogMaxSpace:
+		return "日志文件占用的最大磁盘空间"
+	case RtdbParamLogFileSize:
+		return "单个日志文件大小"
+	case RtdbParamIgnoreToWriteNoarcbuffer:
+		return "是否丢弃补历史数据"
+	case RtdbParamArchivesCountForCalc:
+		return "统计存档文件平均大小的存档文件个数"
+	case RtdbParamMaxBlobSize:
+		return "blob、str类型数据在数据库中允许的最大长度"
+	default:
+		return "未知系统参数"
+	}
+}
+
+// ParamString 字符串类型系统参数
+type ParamString string
+
+// ParamInt 数值类型系统参数
+type ParamInt uint32
+
+// RtdbConst 一些常量
+type RtdbConst int32
+
+const (
+	// RtdbConstTagSize 标签点名称占用字节数
+	RtdbConstTagSize = RtdbConst(80)
+
+	// RtdbConstDescSize 标签点描述占用字节数
+	RtdbConstDescSize = RtdbConst(100)
+
+	// RtdbConstUnitSize 标签点单位占用字节数
+	RtdbConstUnitSize = RtdbConst(20)
+
+	// RtdbConstUserSize 用户名占用字节数
+	RtdbConstUserSize = RtdbConst(20)
+
+	// RtdbConstSourceSize 标签点数据源占用字节数
+	RtdbConstSourceSize = RtdbConst(256)
+
+	// RtdbConstInstrumentSize 标签点所属设备占用字节数
+	RtdbConstInstrumentSize = RtdbConst(50)
+
+	// RtdbConstLocationsSize  采集标签点位址个数
+	RtdbConstLocationsSize = RtdbConst(5)
+
+	// RtdbConstUserintSize  采集标签点用户自定义整数个数
+	RtdbConstUserintSize = RtdbConst(2)
+
+	// RtdbConstUserrealSize  采集标签点用户自定义浮点数个数
+	RtdbConstUserrealSize = RtdbConst(2)
+
+	// RtdbConstEquationSize 计算标签点方程式占用字节数
+	RtdbConstEquationSize = RtdbConst(2036)
+
+	// RtdbConstTypeNameSize 自定义类型名称占用字节数
+	RtdbConstTypeNameSize = RtdbConst(21)
+
+	// RtdbConstPackOfSnapshot   事件快照备用字节空间
+	RtdbConstPackOfSnapshot = RtdbConst(0)
+
+	// RtdbConstPackOfPoint 标签点备用字节空间
+	RtdbConstPackOfPoint = RtdbConst(4)
+
+	// RtdbConstPackOfBasePoint 基本标签点备用字节空间
+	RtdbConstPackOfBasePoint = RtdbConst(74)
+
+	// RtdbConstPackOfScan 采集标签点备用字节空间
+	RtdbConstPackOfScan = RtdbConst(164)
+
+	// RtdbConstPackOfCalc 计算标签点备用字节空间
+	RtdbConstPackOfCalc = RtdbConst(0)
+
+	// RtdbConstFileNameSize 文件名字符串字节长度
+	RtdbConstFileNameSize = RtdbConst(64)
+
+	// RtdbConstPathSize  路径字符串字节长度
+	RtdbConstPathSize = RtdbConst(956)
+
+	// RtdbConstMaxUserCount 最大用户个数
+	RtdbConstMaxUserCount = RtdbConst(100)
+
+	// RtdbConstMaxAuthCount 最大信任连接段个数
+	RtdbConstMaxAuthCount = RtdbConst(100)
+
+	// RtdbConstMaxBlacklistLen 连接黑名单最大长度
+	RtdbConstMaxBlacklistLen = RtdbConst(100)
+
+	// RtdbConstMaxSubscribeSnapshots  单个连接最大订阅快照数量
+	RtdbConstMaxSubscribeSnapshots = RtdbConst(1000)
+
+	// RtdbConstApiServerDescriptionLen  API_SERVER中，decription描述字段的长度
+	RtdbConstApiServerDescriptionLen = RtdbConst(512)
+
+	// RtdbConstMinEquationSize 缩减长度后的方程式占用字节数
+	RtdbConstMinEquationSize = RtdbConst(480)
+
+	// RtdbConstPackOfMinCalc 缩减长度后的计算标签点备用字节空间
+	RtdbConstPackOfMinCalc = RtdbConst(19)
+
+	// RtdbConstMaxEquationSize 最大长度的方程式占用字节数
+	RtdbConstMaxEquationSize = RtdbConst(63488)
+
+	// RtdbConstPackOfMaxCalc 最大长度的计算标签点备用字节空间
+	RtdbConstPackOfMaxCalc = RtdbConst(2036)
+
+	// RtdbConstMaxJsonSize  允许的json字符串的最大长度
+	RtdbConstMaxJsonSize = RtdbConst(16384)
+
+	// RtdbConstIpv6AddrSize ipv6地址空间大小
+	RtdbConstIpv6AddrSize = RtdbConst(16)
+
+	// RtdbConstOutputPluginLibVersionLength 输入输出适配器插件库版本号长度64Bytes
+	RtdbConstOutputPluginLibVersionLength = RtdbConst(64)
+
+	// RtdbConstOutputPluginLibNameLength  输入输出适配器插件库名长度128Bytes
+	RtdbConstOutputPluginLibNameLength = RtdbConst(128)
+
+	// RtdbConstOutputPluginActorNameLength  输入输出适配器执行实例长度128Bytes
+	RtdbConstOutputPluginActorNameLength = RtdbConst(128)
+
+	// RtdbConstOutputPluginNameLength 输入输出适配器插件名长度255Bytes
+	RtdbConstOutputPluginNameLength = RtdbConst(255)
+
+	// RtdbConstOutputPluginDirLength 输入输出适配器路径长度255Bytes
+	RtdbConstOutputPluginDirLength = RtdbConst(255)
+
+	// RtdbConstPerOfUsefulMemSize 历史数据缓存/补历史数据缓存/blob补历史数据缓存/内存大小上限占可用内存百分比值占用的字节数
+	RtdbConstPerOfUsefulMemSize = RtdbConst(10)
+)
+
+// DateTimeType 32位时间戳类型，秒级时间戳
+type DateTimeType uint32
+
+// TimestampType 64位时间戳类型，秒级时间戳
+type TimestampType int64
+
+// SubtimeType 时间戳，小于秒的部分，根据设置的全局时间戳精度，表示毫秒、微秒、纳秒的部分
+type SubtimeType int32
+
+// RtdbHostConnectInfo 连接到RTDB数据库服务器的连接信息
+// 备注， IPv6版本兼容此 RtdbHostConnectInfo ， 因此暂时注释掉
+// type RtdbHostConnectInfo struct {
+// 	IpAddr      int32        // 连接的客户端IP地址
+// 	Port        uint16       // 连接端口
+// 	Job         int32        // 连接最近处理的任务
+// 	JobTime     DateTimeType // 最近处理任务的时间
+// 	ConnectTime DateTimeType // 客户端连接时间
+// 	Client      string       // 连接的客户端主机名称
+// 	Process     string       // 连接的客户端程序名
+// 	User        string       // 登录的用户
+// 	Length      int32        // 记录用户名长度，用于加密传输
+// }
+// func cToRtdbHostConnectInfo(cInfo *C.RTDB_HOST_CONNECT_INFO) RtdbHostConnectInfo {
+// 	goInfo := RtdbHostConnectInfo{
+// 		IpAddr:      int32(cInfo.ipaddr),
+// 		Port:        uint16(cInfo.port),
+// 		Job:         int32(cInfo.job),
+// 		JobTime:     DateTimeType(cInfo.job_time),
+// 		ConnectTime: DateTimeType(cInfo.connect_time),
+// 		Client:      CCharArrayToString(&cInfo.client[0], len(cInfo.client)),
+// 		Process:     CCharArrayToString(&cInfo.process[0], len(cInfo.process)),
+// 		User:        CCharArrayToString(&cInfo.user[0], len(cInfo.user)),
+// 		Length:      int32(cInfo.length),
+// 	}
+// 	return goInfo
+// }
+
+type RtdbHostConnectInfoIpv6 struct {
+	IpAddr      int32        // 连接的客户端IP地址
+	IpAddr6     string       // ipv6地址
+	Port        uint16       // 连接端口
+	Job         int32        // 连接最近处理的任务
+	JobTime     DateTimeType // 最近处理任务的时间
+	ConnectTime DateTimeType // 客户端连接时间
+	Client      string       // 连接的客户端主机名称
+	Process     string       // 连接的客户端程序名
+	User        string       // 登录的用户
+	Length      int32        // 记录用户名长度，用于加密传输
+}
+
+// RtdbOsType 操作系统类型
+type RtdbOsType int8
+
+const (
+	RtdbOsWindows = RtdbOsType(0)
+	RtdbOsLinux   = RtdbOsType(1)
+	RtdbOsInvalid = RtdbOsType(50)
+)
+
+func (ost RtdbOsType) Desc() string {
+	switch ost {
+	case RtdbOsWindows:
+		return "windows"
+	case RtdbOsLinux:
+		return "linux"
+	case RtdbOsInvalid:
+		return "未知操作系统"
+	default:
+		return "无效OsType"
+	}
+}
+
+// RtdbHandleInfo 连接信息
+type RtdbHandleInfo struct {
+	OsType RtdbOsType // 当前连接数据库的系统，参考 RTDB_OS_TYPE
+	NewDB  Switch     // 当前连接数据库的版本，0(OFF)表示旧版本，1(ON)表示新版本
+}
+
+// RtdbUserInfo 用户信息
+type RtdbUserInfo struct {
+	User      string    // 用户名
+	Privilege PrivGroup // 权限
+	IsLocked  Switch    // 是否锁定
+}
+
+// BlackList 黑名单
+type BlackList struct {
+	Addr string
+	Mask string
+	Desc string
+}
+
+// AuthorizationsList 白名单
+type AuthorizationsList struct {
+	Addr string
+	Mask string
+	Desc string
+	Priv PrivGroup
+}
+
+// DirItem 目录项，表示目录下面的子条目，可能是子目录也可能是子文件
+type DirItem struct {
+	Path  string        // 文件、子目录全路径
+	IsDir Switch        // ON(1)为目录，OFF(0)为文件
+	ATime TimestampType // 访问时间
+	CTime TimestampType // 建立时间
+	MTime TimestampType // 修改时间
+	Size  int64         // 文件大小
+}
+
+const (
+	// RtdbMaxPath 系统支持的最大路径长度
+	RtdbMaxPath = int32(2048)
+
+	// RtdbMaxHostnameSize 系统支持的最大主机名长度
+	RtdbMaxHostnameSize = int32(1024)
+)
+
+// Quality 质量码
+type Quality int16
+
+const (
+	// QualityGood 正常
+	QualityGood = Quality(0)
+
+	// QualityNoData 无数据
+	QualityNoData = Quality(1)
+
+	// QualityCreated 创建
+	QualityCreated = Quality(2)
+
+	// QualityShutdown 停机
+	QualityShutdown = Quality(3)
+
+	// QualityCalcOff 计算停止
+	QualityCalcOff = Quality(4)
+
+	// QualityBad 坏点
+	QualityBad = Quality(5)
+
+	// QualityDivByZero 被零除
+	QualityDivByZero = Quality(6)
+
+	// QualityRemoved 已被删除
+	QualityRemoved = Quality(7)
+
+	// QualityOpc 从0x0100至0x01FF为OPC质量码
+	QualityOpc = Quality(256)
+
+	// QualityUser 此质量码（含）之后为用户自定义
+	QualityUser = Quality(512)
+)
+
+// TableID 表ID
+type TableID int32
+
+// RtdbTable 表
+type RtdbTable struct {
+	ID   TableID // 表ID
+	Type int32   // 表类型，暂时无用
+	Name string  // 表名
+	Desc string  // 表描述
+}
+
+// PointID 点ID
+type PointID int32
+
+// RtdbType 数据类型
+type RtdbType int32
+
+const (
+	// RtdbTypeAny 任意类型, 备注，只有标签点搜索的时候可以使用, 如：RawRtdbbSearchExWarp
+	RtdbTypeAny = RtdbType(-1)
+
+	// RtdbTypeBool 布尔类型，0值或1值
+	RtdbTypeBool = RtdbType(0)
+
+	// RtdbTypeUint8 无符号8位整数，占用1字节
+	RtdbTypeUint8 = RtdbType(1)
+
+	// RtdbTypeInt8 有符号8位整数，占用1字节
+	RtdbTypeInt8 = RtdbType(2)
+
+	// RtdbTypeChar 单字节字符，占用1字节
+	RtdbTypeChar = RtdbType(3)
+
+	// RtdbTypeUint16 无符号16位整数，占用2字节
+	RtdbTypeUint16 = RtdbType(4)
+
+	// RtdbTypeInt16 有符号16位整数，占用2字节
+	RtdbTypeInt16 = RtdbType(5)
+
+	// RtdbTypeUint32 无符号32位整数，占用4字节
+	RtdbTypeUint32 = RtdbType(6)
+
+	// RtdbTypeInt32 有符号32位整数，占用4字节
+	RtdbTypeInt32 = RtdbType(7)
+
+	// RtdbTypeInt64 有符号64位整数，占用8字节
+	RtdbTypeInt64 = RtdbType(8)
+
+	// RtdbTypeReal16 16位浮点数，占用2字节
+	RtdbTypeReal16 = RtdbType(9)
+
+	// RtdbTypeReal32 32位单精度浮点数，占用4字节
+	RtdbTypeReal32 = RtdbType(10)
+
+	// RtdbTypeReal64 64位双精度浮点数，占用8字节
+	RtdbTypeReal64 = RtdbType(11)
+
+	// RtdbTypeCoor 二维坐标，具有x、y两个维度的浮点数，占用8字节
+	RtdbTypeCoor = RtdbType(12)
+
+	// RtdbTypeString 字符串，长度不超过存储页面大小
+	RtdbTypeString = RtdbType(13)
+
+	// RtdbTypeBlob 二进制数据块，占用字节不超过存储页面大小
+	RtdbTypeBlob = RtdbType(14)
+
+	// RtdbTypeNamedT 自定义类型，由用户创建时确定字节长度
+	RtdbTypeNamedT = RtdbType(15)
+
+	// RtdbTypeDatetime 时间格式类型
+	RtdbTypeDatetime = RtdbType(16)
+
+	// RtdbTypeFp16 定点数，占用2字节
+	RtdbTypeFp16 = RtdbType(17)
+
+	// RtdbTypeFp32 定点数，占用4字节
+	RtdbTypeFp32 = RtdbType(18)
+
+	// RtdbTypeFp64 定点数，占用8字节
+	RtdbTypeFp64 = RtdbType(19)
+)
+
+// RtdbMirror 镜像选项
+type RtdbMirror int8
+
+const (
+	// RtdbMirrorPointOff 镜像关闭
+	RtdbMirrorPointOff = RtdbMirror(0)
+
+	// RtdbPointSendRecv 镜像收发
+	RtdbPointSendRecv = RtdbMirror(1)
+
+	// RtdbPointRecv 镜像接收
+	RtdbPointRecv = RtdbMirror(2)
+
+	// RtdbPointSend 镜像发送
+	RtdbPointSend = RtdbMirror(3)
+)
+
+// RtdbPrecision 时间戳精度
+type RtdbPrecision int8
+
+const (
+	// RtdbPrecisionAny 所有时间戳精度，只有标签点搜索的时候可用，例如: RawRtdbbSearchExWarp
+	RtdbPrecisionAny = RtdbPrecision(-1)
+
+	// RtdbPrecisionSecond 秒
+	RtdbPrecisionSecond = RtdbPrecision(0)
+
+	// RtdbPrecisionMilli 毫秒
+	RtdbPrecisionMilli = RtdbPrecision(1)
+
+	// RtdbPrecisionMicro 微秒
+	RtdbPrecisionMicro = RtdbPrecision(2)
+
+	// RtdbPrecisionNano 纳秒
+	RtdbPrecisionNano = RtdbPrecision(3)
+)
+
+// RtdbClass 点类型
+type RtdbClass uint32
+
+const (
+	// RtdbClassBase 基本标签点，所有类别标签点均在基本标签点的属性集上扩展自己的属性集。
+	RtdbClassBase = RtdbClass(0)
+
+	// RtdbClassScan 采集标签点。
+	RtdbClassScan = RtdbClass(1)
+
+	// RtdbClassCalc 计算标签点
+	RtdbClassCalc = RtdbClass(2)
+
+	// RtdbClassAlarm 报警标签点
+	RtdbClassAlarm = RtdbClass(4)
+)
+
+// RtdbTrigger 计算标签点触发机制
+type RtdbTrigger uint8
+
+const (
+	// RtdbNullTrigger 无触发
+	RtdbNullTrigger = RtdbTrigger(0)
+
+	// RtdbEventTrigger 事件触发
+	RtdbEventTrigger = RtdbTrigger(1)
+
+	// RtdbTimerTrigger 周期触发
+	RtdbTimerTrigger = RtdbTrigger(2)
+
+	// RtdbFixtimeTrigger 定时触发
+	RtdbFixtimeTrigger = RtdbTrigger(3)
+)
+
+// RtdbTimeCopy 计算结果时间戳参考
+type RtdbTimeCopy uint8
+
+const (
+	// RtdbCalcTime 采用计算时间
+	RtdbCalcTime = RtdbTimeCopy(0)
+
+	// RtdbLatestTime 采用最晚标签点时间
+	RtdbLatestTime = RtdbTimeCopy(1)
+
+	// RtdbEarliestTime 采用最早标签点时间
+	RtdbEarliestTime = RtdbTimeCopy(2)
+)
+
+// RtdbPoint 基本标签点属性集
+type RtdbPoint struct {
+	// 标签点名称。
+	// 用于在表中唯一标识一个标签点；
+	// 该属性允许被修改；
+	// 命名规则：
+	// 1、第一个字符必须是26个字母之一或数字0-9之一或者"_"、"%"；
+	// 2、不允许使用控制字符，比如换行符或制表符；
+	// 3、不允许使用以下字符（'*'、'''、'?'、';'、'('、')'、'{'、'}'、'['、']'、'|'、'\'、'`'、'''、'"'、'.'）；
+	// 4、字节长度不要超出 \b RTDB_TAG_SIZE，如果超出，系统会自动将后面的字符截断。
+	Tag string
+
+	// 全库唯一标识。
+	// 只读属性，创建标签点时系统会自动为标签点分配的唯一标识，即标签点ID，标签点ID一经创建后永不更改。
+	ID PointID
+
+	// 标签点的数值类型。
+	// 只读属性，在创建标签点时指定。
+	Type RtdbType
+
+	// 标签点所属表ID。
+	Table TableID
+
+	// 有关标签点的描述性文字。
+	// 字节长度不要超出 \b RTDB_DESC_SIZE，多余的部分会被截断。
+	Desc string
+
+	// 工程单位。
+	// 字节长度不要超出 \b RTDB_UNIT_SIZE，多余的部分会被截断。
+	Unit string
+
+	// 是否存档。
+	// 缺省值：ON，1；
+	// ON或1表示存档，OFF或0表示不存档。
+	Archive Switch
+
+	// 数值位数。
+	// 缺省值：-5；
+	// 范围：>=-20、<=10；
+	// 用来控制数值的显示格式；
+	// 如果为0或正数，表示数值的小数位数，如果为负数，表示数值的有效位数。
+	Digits int16
+
+	// 停机状态字（Shutdown）
+	// 缺省值：0；
+	// 定义该点在停机状态下是否补写停机状态值。
+	// 1 表示补写；0 表示不补写。
+	Shutdown Switch
+
+	// 量程下限。
+	// 缺省值：0；
+	// 单位：标签点工程单位。
+	LowLimit float32
+
+	// 量程上限。
+	// 缺省值：100；
+	// 单位：标签点工程单位。
+	HighLimit float32
+
+	// 是否阶跃。
+	// 缺省值：OFF，0；
+	// 该属性决定了中间值的计算是用阶梯还是连续的内插值替换；
+	// 缺省情况下该属性为OFF，即中间值的计算是用内插值替换；
+	// 如果被设置为ON，则中间值的数值同前一个有记录的数值相同。
+	// 在历史数据检索中，本设置可能被外部输入的阶跃开关覆盖。
+	Step Switch
+
+	// 典型值。
+	// 缺省值：50；
+	// 大于等于量程下限，小于等于量程上限。
+	Typical float32
+
+	// 是否压缩。
+	// 缺省值：ON，1；
+	// 如果该属性被关闭（OFF，0），任何到达数据存储服务器Server的数据都会被提交到历史数据库；否则（ON，1），只有满足压缩条件的数据才会被提交到历史数据库。
+	// 需要手工录入的标签点应该将该属性设置为OFF，0。
+	Compress Switch
+
+	// 压缩偏差。
+	// 单位：标签点工程单位；
+	// 缺省值：1；
+	// 当有新的数值被提交到数据存储服务器Server，如果从上一个被提交到历史数据库的数值开始有数值超出了压缩偏差外，则上一个被提交到数据存储服务器Server的数值被视为关键数值；
+	// 该属性与[压缩偏差百分比（the percent of compression deviation）]属性含义相同，该属性与量程（high
+	// limit - low limit）的百分比即[压缩偏差百分比（the percent of compression
+	// deviation）]属性的值；
+	// 对该属性的修改将导致对[压缩偏差百分比（the percent of compression
+	// deviation）]的修改，同样，对[压缩偏差百分比（the percent of compression
+	// deviation）]的修改也将修改该属性，如果两个同时被修改，[压缩偏差百分比（the percent of compression
+	// deviation）]具有更高的优先权。
+	CompDev float32
+
+	// 压缩偏差百分比。
+	// 单位：百分比；
+	// \see compdev。
+	CompDevPercent float32
+
+	// 最大压缩间隔。
+	// 单位：秒；
+	// 缺省值：28800；
+	// 如果某个数值与上一个被提交到历史数据库的数值的时间间隔大于或等于最大压缩间隔，无论是否满足压缩条件，该数值都应该被视为关键数值从而被提交到历史数据库的数据队列；
+	// 数据库中两个标签点间的时间间隔有可能超出该属性值，因为数据存储服务器Server可能长时间接收不到提交的数据，而且任何系统绝对不会自己创造数据。
+	CompTimeMax int32
+
+	// 最短压缩间隔。
+	// 单位：秒；
+	// 缺省值：0；
+	// 如果某个数值与上一个被提交到历史数据库的数值的时间间隔小于最短压缩间隔，该数值会被忽略；
+	// 该属性有降噪（suppress noise）的作用。
+	CompTimeMin int32
+
+	// 例外偏差。
+	// 单位：标签点工程单位；
+	// 缺省值：0.5；
+	// 如果某个数值与上一个被提交到数据存储服务器Server的数值的偏差大于该例外偏差（以数值的工程单位为准），则该数值被视为例外数值，应该被提交到数据存储服务器Server；
+	// 建议例外偏差应该小于等于压缩偏差的一半；
+	// 该属性与[例外偏差百分比（The Percent of Exception Deviation）]属性含义相同，该属性与量程（high
+	// limit - low limit）的百分比即[例外偏差百分比（The Percent of Exception
+	// Deviation）]属性的值；
+	// 对该属性的修改将导致对[例外偏差百分比（The Percent of Exception
+	// Deviation）]的修改，同样，对[例外偏差百分比（The Percent of Exception
+	// Deviation）]的修改也将修改该属性，如果两个同时被修改，[例外偏差百分比（The Percent of Exception
+	// Deviation）]具有更高的优先权。
+	ExcDev float32
+
+	// 例外偏差百分比。
+	// 单位：百分比；
+	// \see excdev。
+	ExcDevPercent float32
+
+	// 最大例外间隔。
+	// 单位：秒；
+	// 缺省值：600；
+	// 如果某个数值与上一个被提交到数据存储服务器Server的数值的时间间隔大于或等于最大例外间隔，无论是否满足例外条件，该数值都应该被视为例外数值从而被提交到数据存储服务器Server；
+	// 数据库中两个标签点间的时间间隔有可能超出该属性值，因为接口可能长时间采集不到数据，而且任何系统绝对不会自己创造数据；
+	// 如果要关闭例外过滤，设置该属性为0即可。
+	ExcTimeMax int32
+
+	// 最短例外间隔。
+	// 单位：秒；
+	// 缺省值：0；
+	// 如果某个数值与上一个被提交到数据存储服务器Server的数值的时间间隔小于最短例外间隔，无论是否满足例外条件，该数值都会被忽略；
+	// 该属性有降噪（suppress noise）的作用。
+	ExcTimeMin int32
+
+	// 标签点类别。
+	// RTDB_CLASS类型的组合，最多可以扩展至32种类型的组合；
+	// 所有类别标签点均继承自"基本"类型标签点。
+	// 不同类别的标签点具有不同的属性集，"采集"类别的标签点具有"设备标签"、"位号"、"自定义整数"和"自定义浮点数"等扩展属性，"计算"类别的标签点具有"扩展描述"、"触发机制"等扩展属性。
+	Class RtdbClass
+
+	// 标签点属性最后一次被修改的时间。
+	ChangeDate DateTimeType
+
+	// 标签点属性最后一次被修改的用户名。
+	Changer string
+
+	// 标签点被创建的时间。
+	CreateDate DateTimeType
+
+	// 标签点创建者的用户名。
+	Creator string
+
+	// 镜像收发控制。
+	// 默认值：关闭，0
+	// 开启镜像收发，1，既接收，又发送
+	// 开启镜像接收，2，只接收，不发送
+	// 开启镜像发送，3，只发送，不接收
+	Mirror RtdbMirror
+
+	// 时间戳精度。
+	// 默认值：秒，0；
+	// 用于设定标签点的历史值在存储中精确到"秒"（0）还是"毫秒/纳秒"（1）。
+	// 标签点一经创建就不允许修改该属性。
+	MilliSecond int8
+
+	// 采集点扩展属性集存储地址索引。
+	ScanIndex uint32
+
+	// 计算点扩展属性集存储地址索引。
+	CalcIndex uint32
+
+	// 报警点扩展属性集存储地址索引。
+	AlarmIndex uint32
+
+	// 标签点全名，格式为“表名称.标签点名称”。
+	TableDotTag string
+
+	// 统计加速。
+	// 默认值：关，0；
+	// 用于设定是否生成标签点统计信息，从而加速历史数据统计过程。
+	Summary Switch
+
+	// 标签点对应自定义类型id，只用标签点类别为自定义类型时，才有意义。
+	NamedTypeID uint16
+
+	// 时间戳精度，0秒、1毫秒、2微秒、3纳秒
+	Precision RtdbPrecision
+}
+
+// NewDefaultPoint 新建基本点结构，使用默认参数
+func NewDefaultPoint(tag string, rtdbType RtdbType, tableID TableID, class RtdbClass, precision RtdbPrecision) *RtdbPoint {
+	rtn := RtdbPoint{
+		Tag:            tag,
+		Type:           rtdbType,
+		Table:          tableID,
+		Desc:           "",
+		Unit:           "",
+		Archive:        ON,
+		Digits:         -5,
+		Shutdown:       OFF,
+		LowLimit:       0,
+		HighLimit:      100,
+		Step:           OFF,
+		Typical:        50,
+		Compress:       ON,
+		CompDev:        1,
+		CompDevPercent: 0,
+		CompTimeMax:    28800,
+		CompTimeMin:    0,
+		ExcDev:         0.5,
+		ExcDevPercent:  0,
+		ExcTimeMax:     600,
+		ExcTimeMin:     0,
+		Class:          class,
+		Mirror:         RtdbMirrorPointOff,
+		Summary:        OFF,
+		NamedTypeID:    0,
+		Precision:      precision,
+	}
+	switch rtn.Precision {
+	case RtdbPrecisionSecond:
+		rtn.MilliSecond = 0
+	case RtdbPrecisionMilli, RtdbPrecisionMicro, RtdbPrecisionNano:
+		rtn.MilliSecond = 1
+	}
+	return &rtn
+}
+
+// NewRtdbPoint 创建基本点结构
+func NewRtdbPoint(
+	tag string, rtdbType RtdbType, tableID TableID, desc, unit string, archive Switch, digits int16,
+	shutdown Switch, lowLimit float32, highLimit float32, step Switch, typical float32, compress Switch,
+	compDev float32, compDevPercent float32, compTimeMax int32, compTimeMin int32,
+	excDev float32, excDevPercent float32, excTimeMax int32, excTimeMin int32, class RtdbClass,
+	mirror RtdbMirror, summary Switch, precision RtdbPrecision,
+) *RtdbPoint {
+	rtn := RtdbPoint{
+		Tag:            tag,
+		Type:           rtdbType,
+		Table:          tableID,
+		Desc:           desc,
+		Unit:           unit,
+		Archive:        archive,
+		Digits:         digits,
+		Shutdown:       shutdown,
+		LowLimit:       lowLimit,
+		HighLimit:      highLimit,
+		Step:           step,
+		Typical:        typical,
+		Compress:       compress,
+		CompDev:        compDev,
+		CompDevPercent: compDevPercent,
+		CompTimeMax:    compTimeMax,
+		CompTimeMin:    compTimeMin,
+		ExcDev:         excDev,
+		ExcDevPercent:  excDevPercent,
+		ExcTimeMax:     excTimeMax,
+		ExcTimeMin:     excTimeMin,
+		Class:          class,
+		Mirror:         mirror,
+		Summary:        summary,
+		Precision:      precision,
+	}
+	switch rtn.Precision {
+	case RtdbPrecisionSecond:
+		rtn.MilliSecond = 0
+	case RtdbPrecisionMilli, RtdbPrecisionMicro, RtdbPrecisionNano:
+		rtn.MilliSecond = 1
+	}
+	return &rtn
+}
+
+// RtdbScan 采集标签点扩展属性集
+type RtdbScan struct {
+	// 全库唯一标识。0表示无效
+	ID PointID
+
+	// 数据源。
+	// 缺省值：空（NULL）；
+	// 将标签点同某些接口或某些模块相关联；
+	// 每个数据源字符串只允许由26个字母（大小写敏感）和数字（0-9）组成，字节长度不要超出 \b RTDB_SOURCE_SIZE，多余的部分会被截断。
+	Source string
+
+	// 是否采集。
+	// 缺省值：ON，1；
+	// 该属性可能会被某些接口用到，如果该属性被关闭（OFF，0），从接口传来的数据可能不会被报告到数据库。
+	Scan Switch
+
+	// 设备标签。
+	// 缺省值：空（NULL）；
+	// 字节长度不要超出 \b RTDB_INSTRUMENT_SIZE，多余的部分会被截断。
+	Instrument string
+
+	// 共包含五个设备位址，缺省值全部为0。
+	Locations [RtdbConstLocationsSize]int32
+
+	// 共包含两个自定义整数，缺省值全部为0。
+	UserInts [RtdbConstUserintSize]int32
+
+	// 共包含两个自定义单精度浮点数，缺省值全部为0。
+	UserReals [RtdbConstUserrealSize]float32
+}
+
+// NewRtdbScan 新建采集点结构
+func NewRtdbScan(source string, scan Switch, instrument string) *RtdbScan {
+	rtn := RtdbScan{
+		Source:     source,
+		Scan:       scan,
+		Instrument: instrument,
+	}
+	return &rtn
+}
+
+// RtdbCalc 最大长度计算点扩展属性集
+type RtdbCalc struct {
+	// 全库唯一标识。0表示无效。
+	ID PointID
+
+	// 实时方程式。
+	// 缺省值：空（NULL）；
+	// 字节长度不要超出 \b RTDB_MAX_EQUATION_SIZE，长度超长的方程式将被拒绝设置入库，返回一个错误，避免错误的方程式进入系统，引发不安全因素。
+	Equation string
+
+	// 计算触发机制。枚举值参见 \b RTDB_TRIGGER。
+	// 仅对"计算"类别标签点起作用，用于设置实时方程式服务对单个计算点的计算触发采用"事件触发"还是"周期触发"，
+	// 对于"周期触发"以"事件触发"作为其先决判断条件，如果"事件触发"不满足，则不进行"周期触发"。
+	Trigger RtdbTrigger
+
+	// 计算结果时间戳参考, 枚举值参见 \b RTDB_TIME_COPY
+	// 0: 表示采用计算时间作为计算结果时间戳；
+	// 1: 表示采用输入标签点中的最晚时间作为计算结果时间戳；
+	// 2: 表示采用输入标签点中的最早时间作为计算结果时间戳。
+	TimeCopy RtdbTimeCopy
+
+	// 对于“周期触发”的计算点，设定其计算周期，单位：秒
+	Period int32
+}
+
+func NewRtdbCalc(equation string, trigger RtdbTrigger, timeCopy RtdbTimeCopy, period int32) *RtdbCalc {
+	rtn := RtdbCalc{
+		Equation: equation,
+		Trigger:  trigger,
+		TimeCopy: timeCopy,
+		Period:   period,
+	}
+	return &rtn
+}
+
+type RtdbSortFlag uint32
+
+const (
+	// RtdbSortFlagDescend 降序
+	RtdbSortFlagDescend = RtdbSortFlag(1)
+
+	// RtdbSortFlagCaseSensitive 大小写敏感
+	RtdbSortFlagCaseSensitive = RtdbSortFlag(2)
+
+	// RtdbSortFlagRecycled 用于回收站标签点排序
+	RtdbSortFlagRecycled = RtdbSortFlag(4)
+)
+
+type RtdbSearch int32
+
+const (
+	RtdbSearchAny            = RtdbSearch(0)  //!< 不使用任何标签点属性作为搜索条件
+	RtdbSearchNull           = RtdbSearch(0)  //!< 不使用任何标签点属性作为搜索条件
+	RtdbSearchCompdev        = RtdbSearch(1)  //!< 使用压缩偏差作为搜索条件
+	RtdbSearchCompmax        = RtdbSearch(2)  //!< 最大压缩间隔
+	RtdbSearchCompmin        = RtdbSearch(3)  //!< 最小压缩间隔
+	RtdbSearchExcdev         = RtdbSearch(4)  //!< 例外偏差
+	RtdbSearchExcmax         = RtdbSearch(5)  //!< 最大例外间隔
+	RtdbSearchExcmin         = RtdbSearch(6)  //!< 最小例外间隔
+	RtdbSearchSummary        = RtdbSearch(7)  //!< 是否加速
+	RtdbSearchMirror         = RtdbSearch(8)  //!< 是否镜像
+	RtdbSearchCompress       = RtdbSearch(9)  //!< 是否压缩
+	RtdbSearchStep           = RtdbSearch(10) //!< 是否阶跃
+	RtdbSearchShutdown       = RtdbSearch(11) //!< 是否停机补写
+	RtdbSearchArchive        = RtdbSearch(12) //!< 是否归档
+	RtdbSearchChanger        = RtdbSearch(13) //!< 修改人
+	RtdbSearchCreator        = RtdbSearch(14) //!< 创建人
+	RtdbSearchLowlimit       = RtdbSearch(15) //!< 量程下限
+	RtdbSearchHighlimit      = RtdbSearch(16) //!< 量程上限
+	RtdbSearchTypical        = RtdbSearch(17) //!< 典型值
+	RtdbSearchChangedate     = RtdbSearch(18) //!< 修改时间
+	RtdbSearchCreatedate     = RtdbSearch(19) //!< 创建时间
+	RtdbSearchDigits         = RtdbSearch(20) //!< 数值位数
+	RtdbSearchCompdevpercent = RtdbSearch(21) //!< 压缩偏差百分比
+	RtdbSearchExcdevpercent  = RtdbSearch(22) //!< 例外偏差百分比
+	RtdbSearchScan_begin     = RtdbSearch(23) //!< 辅助作用，不能作为搜索条件
+	RtdbSearchScan           = RtdbSearch(24) //!< 是否采集
+	RtdbSearchLocation1      = RtdbSearch(25) //!< 设备位址1
+	RtdbSearchLocation2      = RtdbSearch(26) //!< 设备位址2
+	RtdbSearchLocation3      = RtdbSearch(27) //!< 设备位址3
+	RtdbSearchLocation4      = RtdbSearch(28) //!< 设备位址4
+	RtdbSearchLocation5      = RtdbSearch(29) //!< 设备位址5
+	RtdbSearchUserint1       = RtdbSearch(30) //!< 自定义整数1
+	RtdbSearchUserint2       = RtdbSearch(31) //!< 自定义整数2
+	RtdbSearchUserreal1      = RtdbSearch(32) //!< 自定义单精度浮点数1
+	RtdbSearchUserreal2      = RtdbSearch(33) //!< 自定义单精度浮点数2
+	RtdbSearchScan_end       = RtdbSearch(34) //!< 辅助作用，不能作为搜索条件
+	RtdbSearchCalc_begin     = RtdbSearch(35) //!< 辅助作用，不能作为搜索条件
+	RtdbSearchEquation       = RtdbSearch(36) //!< 方程式
+	RtdbSearchTrigger        = RtdbSearch(37) //!< 计算触发机制
+	RtdbSearchTimecopy       = RtdbSearch(38) //!< 计算结果时间戳参考
+	RtdbSearchPeriod         = RtdbSearch(39) //!< 计算周期
+	RtdbSearchCalc_end       = RtdbSearch(40) //!< 辅助作用，不能作为搜索条件
+)
+
+func (rs RtdbSearch) Desc() string {
+	switch rs {
+	case RtdbSearchNull:
+		return "不使用任何标签点属性作为搜索条件"
+	case RtdbSearchCompdev:
+		return "使用压缩偏差作为搜索条件"
+	case RtdbSearchCompmax:
+		return "最大压缩间隔"
+	case RtdbSearchCompmin:
+		return "最小压缩间隔"
+	case RtdbSearchExcdev:
+		return "例外偏差"
+	case RtdbSearchExcmax:
+		return "最大例外间隔"
+	case RtdbSearchExcmin:
+		return "最小例外间隔"
+	case RtdbSearchSummary:
+		return "是否统计加速"
+	case RtdbSearchMirror:
+		return "是否镜像"
+	case RtdbSearchCompress:
+		return "是否压缩"
+	case RtdbSearchStep:
+		return "是否阶跃"
+	case RtdbSearchShutdown:
+		return "是否停机补写"
+	case RtdbSearchArchive:
+		return "是否归档"
+	case RtdbSearchChanger:
+		return "修改人"
+	case RtdbSearchCreator:
+		return "创建人"
+	case RtdbSearchLowlimit:
+		return "量程下限"
+	case RtdbSearchHighlimit:
+		return "量程上限"
+	case RtdbSearchTypical:
+		return "典型值"
+	case RtdbSearchChangedate:
+		return "修改时间"
+	case RtdbSearchCreatedate:
+		return "创建时间"
+	case RtdbSearchDigits:
+		return "数值位数"
+	case RtdbSearchCompdevpercent:
+		return "压缩偏差百分比"
+	case RtdbSearchExcdevpercent:
+		return "例外偏差百分比"
+	case RtdbSearchScan_begin:
+		return "辅助作用，不能作为搜索条件"
+	case RtdbSearchScan:
+		return "是否采集"
+	case RtdbSearchLocation1:
+		return "设备位址1"
+	case RtdbSearchLocation2:
+		return "设备位址2"
+	case RtdbSearchLocation3:
+		return "设备位址3"
+	case RtdbSearchLocation4:
+		return "设备位址4"
+	case RtdbSearchLocation5:
+		return "设备位址5"
+	case RtdbSearchUserint1:
+		return "自定义整数1"
+	case RtdbSearchUserint2:
+		return "自定义整数2"
+	case RtdbSearchUserreal1:
+		return "自定义单精度浮点数1"
+	case RtdbSearchUserreal2:
+		return "自定义单精度浮点数2"
+	case RtdbSearchScan_end:
+		return "辅助作用，不能作为搜索条件"
+	case RtdbSearchCalc_begin:
+		return "辅助作用，不能作为搜索条件"
+	case RtdbSearchEquation:
+		return "方程式"
+	case RtdbSearchTrigger:
+		return "计算触发机制"
+	case RtdbSearchTimecopy:
+		return "计算结果时间戳参考"
+	case RtdbSearchPeriod:
+		return "计算周期"
+	case RtdbSearchCalc_end:
+		return "辅助作用，不能作为搜索条件"
+	default:
+		return "未知搜索条件"
+	}
+}
+
+type RtdbTagIndex uint32
+
+const (
+	// RtdbTagIndexTag tag
+	RtdbTagIndexTag = RtdbTagIndex(0)
+
+	// RtdbTagIndexId id
+	RtdbTagIndexId = RtdbTagIndex(1)
+
+	// RtdbTagIndexType type
+	RtdbTagIndexType = RtdbTagIndex(2)
+
+	// RtdbTagIndexTable table
+	RtdbTagIndexTable = RtdbTagIndex(3)
+
+	// RtdbTagIndexDesc desc
+	RtdbTagIndexDesc = RtdbTagIndex(4)
+
+	// RtdbTagIndexUnit unit
+	RtdbTagIndexUnit = RtdbTagIndex(5)
+
+	// RtdbTagIndexArchive archive
+	RtdbTagIndexArchive = RtdbTagIndex(6)
+
+	// RtdbTagIndexDigits digits
+	RtdbTagIndexDigits = RtdbTagIndex(7)
+
+	// RtdbTagIndexShutdown shutdown
+	RtdbTagIndexShutdown = RtdbTagIndex(8)
+
+	// RtdbTagIndexLowlimit lowlimit
+	RtdbTagIndexLowlimit = RtdbTagIndex(9)
+
+	// RtdbTagIndexHighlimit highlimit
+	RtdbTagIndexHighlimit = RtdbTagIndex(10)
+
+	// RtdbTagIndexStep step
+	RtdbTagIndexStep = RtdbTagIndex(11)
+
+	// RtdbTagIndexTypical typical
+	RtdbTagIndexTypical = RtdbTagIndex(12)
+
+	// RtdbTagIndexCompress compress
+	RtdbTagIndexCompress = RtdbTagIndex(13)
+
+	// RtdbTagIndexCompdev compdev
+	RtdbTagIndexCompdev = RtdbTagIndex(14)
+
+	// RtdbTagIndexCompdevpercent compdevpercent
+	RtdbTagIndexCompdevpercent = RtdbTagIndex(15)
+
+	// RtdbTagIndexComptimemax comptimemax
+	RtdbTagIndexComptimemax = RtdbTagIndex(16)
+
+	// RtdbTagIndexComptimemin comptimemin
+	RtdbTagIndexComptimemin = RtdbTagIndex(17)
+
+	// RtdbTagIndexExcdev excdev
+	RtdbTagIndexExcdev = RtdbTagIndex(18)
+
+	// RtdbTagIndexExcdevpercent excdevpercent
+	RtdbTagIndexExcdevpercent = RtdbTagIndex(19)
+
+	// RtdbTagIndexExctimemax exctimemax
+	RtdbTagIndexExctimemax = RtdbTagIndex(20)
+
+	// RtdbTagIndexExctimemin exctimemin
+	RtdbTagIndexExctimemin = RtdbTagIndex(21)
+
+	// RtdbTagIndexClassof classof
+	RtdbTagIndexClassof = RtdbTagIndex(22)
+
+	// RtdbTagIndexChangedate changedate
+	RtdbTagIndexChangedate = RtdbTagIndex(23)
+
+	// RtdbTagIndexChanger changer
+	RtdbTagIndexChanger = RtdbTagIndex(24)
+
+	// RtdbTagIndexCreatedate createdate
+	RtdbTagIndexCreatedate = RtdbTagIndex(25)
+
+	// RtdbTagIndexCreator creator
+	RtdbTagIndexCreator = RtdbTagIndex(26)
+
+	// RtdbTagIndexMirror mirror
+	RtdbTagIndexMirror = RtdbTagIndex(27)
+
+	// RtdbTagIndexMs ms
+	RtdbTagIndexMs = RtdbTagIndex(28)
+
+	// RtdbTagIndexFullname fullname
+	RtdbTagIndexFullname = RtdbTagIndex(29)
+
+	// RtdbTagIndexSummary summary
+	RtdbTagIndexSummary = RtdbTagIndex(30)
+
+	// RtdbTagIndexDatetimeformat datetimeformat
+	RtdbTagIndexDatetimeformat = RtdbTagIndex(31)
+
+	// RtdbTagIndexSource source
+	RtdbTagIndexSource = RtdbTagIndex(4096)
+
+	// RtdbTagIndexScan scan
+	RtdbTagIndexScan = RtdbTagIndex(4097)
+
+	// RtdbTagIndexInstrument instrument
+	RtdbTagIndexInstrument = RtdbTagIndex(4098)
+
+	// RtdbTagIndexLocation1 location1
+	RtdbTagIndexLocation1 = RtdbTagIndex(4099)
+
+	// RtdbTagIndexLocation2 location2
+	RtdbTagIndexLocation2 = RtdbTagIndex(4100)
+
+	// RtdbTagIndexLocation3 location3
+	RtdbTagIndexLocation3 = RtdbTagIndex(4101)
+
+	// RtdbTagIndexLocation4 location4
+	RtdbTagIndexLocation4 = RtdbTagIndex(4102)
+
+	// RtdbTagIndexLocation5 location5
+	RtdbTagIndexLocation5 = RtdbTagIndex(4103)
+
+	// RtdbTagIndexUserint1 userint1
+	RtdbTagIndexUserint1 = RtdbTagIndex(4104)
+
+	// RtdbTagIndexUserint2 userint2
+	RtdbTagIndexUserint2 = RtdbTagIndex(4105)
+
+	// RtdbTagIndexUserreal1 userreal1
+	RtdbTagIndexUserreal1 = RtdbTagIndex(4106)
+
+	// RtdbTagIndexUserreal2 userreal2
+	RtdbTagIndexUserreal2 = RtdbTagIndex(4107)
+
+	// RtdbTagIndexEquation equation
+	RtdbTagIndexEquation = RtdbTagIndex(8192)
+
+	// RtdbTagIndexTrigger trigger
+	RtdbTagIndexTrigger = RtdbTagIndex(8193)
+
+	// RtdbTagIndexTimecopy timecopy
+	RtdbTagIndexTimecopy = RtdbTagIndex(8194)
+
+	// RtdbTagIndexPeriod period
+	RtdbTagIndexPeriod = RtdbTagIndex(8195)
+
+	// RtdbTagIndexTimestamp timestamp
+	RtdbTagIndexTimestamp = RtdbTagIndex(12288)
+
+	// RtdbTagIndexValue value
+	RtdbTagIndexValue = RtdbTagIndex(12289)
+
+	// RtdbTagIndexQuality quality
+	RtdbTagIndexQuality = RtdbTagIndex(12290)
+)
+
+// RtdbSubscribeOption 订阅选项
+type RtdbSubscribeOption int32
+
+const (
+	// RtdbSubscribeOptionAutoConn 自动重连
+	RtdbSubscribeOptionAutoConn = RtdbSubscribeOption(1)
+)
+
+// RtdbTagChangeReason 标签点变更原因，用于标签点订阅
+type RtdbTagChangeReason int32
+
+const (
+	RtdbTagCreated  = RtdbTagChangeReason(1)
+	RtdbTagUpdated  = RtdbTagChangeReason(2)
+	RtdbTagRemoved  = RtdbTagChangeReason(3)
+	RtdbTagRecoverd = RtdbTagChangeReason(4)
+	RtdbTagPurged   = RtdbTagChangeReason(5)
+	RtdbTabUpdated  = RtdbTagChangeReason(6)
+	RtdbTabRemoved  = RtdbTagChangeReason(7)
+)
+
+// RtdbDataTypeField 自定义类型字段项
+type RtdbDataTypeField struct {
+	// 自定义类型的字段的名称
+	Name string
+
+	// 字段的类型
+	Type RtdbType
+
+	// 字段类型的长度, 单位字节
+	Length int32
+
+	// 字段类型的描述
+	Desc string
+}
+
+// RtdbSyncRole 元数据同步角色
+type RtdbSyncRole int8
+
+const (
+	// RtdbSyncRoleOffline 离线
+	RtdbSyncRoleOffline = RtdbSyncRole(0)
+
+	// RtdbSyncRoleUnsynced 未同步
+	RtdbSyncRoleUnsynced = RtdbSyncRole(1)
+
+	// RtdbSyncRoleSyncing 同步中
+	RtdbSyncRoleSyncing = RtdbSyncRole(2)
+
+	// RtdbSyncRoleSlave 备库
+	RtdbSyncRoleSlave = RtdbSyncRole(3)
+
+	// RtdbSyncRoleMaster 主库
+	RtdbSyncRoleMaster = RtdbSyncRole(4)
+)
+
+func (rs RtdbSyncRole) Desc() string {
+	switch rs {
+	case RtdbSyncRoleOffline:
+		return "离线"
+	case RtdbSyncRoleUnsynced:
+		return "未同步"
+	case RtdbSyncRoleSyncing:
+		return "同步中"
+	case RtdbSyncRoleSlave:
+		return "备库"
+	case RtdbSyncRoleMaster:
+		return "主库"
+	default:
+		return "未知角色"
+	}
+}
+
+// RtdbSyncStatus 元数据同步状态
+type RtdbSyncStatus int8
+
+const (
+	// RtdbSyncStatusInit 正常
+	RtdbSyncStatusInit = RtdbSyncStatus(0)
+
+	// RtdbSyncStatusStart 启动同步
+	RtdbSyncStatusStart = RtdbSyncStatus(1)
+
+	// RtdbSyncStatusFile 同步文件
+	RtdbSyncStatusFile = RtdbSyncStatus(2)
+
+	// RtdbSyncStatusCache 同步缓存
+	RtdbSyncStatusCache = RtdbSyncStatus(3)
+)
+
+func (ss RtdbSyncStatus) Desc() string {
+	switch ss {
+	case RtdbSyncStatusInit:
+		return "正常"
+	case RtdbSyncStatusStart:
+		return "启动同步"
+	case RtdbSyncStatusFile:
+		return "同步文件"
+	case RtdbSyncStatusCache:
+		return "同步缓存"
+	default:
+		return "未知同步状态"
+	}
+}
+
+// RtdbSyncInfo 节点的元数据同步信息
+type RtdbSyncInfo struct {
+	Role     RtdbSyncRole   // 角色
+	Status   RtdbSyncStatus // 状态
+	IP       uint32         // IP地址
+	IpString string         // StringIP地址
+	Version  uint64         // 同步版本
+	DataSize uint64         // 堆积数据大小
+}
+
+// RtdbSubscribeChangeType 元数据同步状态
+type RtdbSubscribeChangeType int32
+
+const (
+	// RtdbSubscribeChangeTypeAdd 增加订阅
+	RtdbSubscribeChangeTypeAdd = RtdbSubscribeChangeType(0)
+
+	// RtdbSubscribeChangeTypeUpdate 更新订阅信息
+	RtdbSubscribeChangeTypeUpdate = RtdbSubscribeChangeType(1)
+
+	// RtdbSubscribeChangeTypeRemove 移除订阅
+	RtdbSubscribeChangeTypeRemove = RtdbSubscribeChangeType(2)
+)
+
+// RtdbArchiveState 历史存档文件状态
+type RtdbArchiveState int32
+
+const (
+	// RtdbArchiveStateInvalid 无效
+	RtdbArchiveStateInvalid = RtdbArchiveState(0)
+
+	// RtdbArchiveStateActived 活动
+	RtdbArchiveStateActived = RtdbArchiveState(1)
+
+	// RtdbArchiveStateNormal 普通
+	RtdbArchiveStateNormal = RtdbArchiveState(2)
+
+	// RtdbArchiveStateReadonly 只读
+	RtdbArchiveStateReadonly = RtdbArchiveState(3)
+)
+
+// RtdbHeaderPage 历史数据存档文件头部信息
+type RtdbHeaderPage struct {
+	DbVer         int32        // 所属数据库版本
+	DataVer       int32        //数据格式变更版本
+	Begin         DateTimeType // 数据起始时间
+	End           DateTimeType // 数据结束时间
+	RealSize      int64        // 实际写入的字节数 用来统计实际使用率
+	CreateTime    DateTimeType // 创建时间
+	ModifyTime    DateTimeType // 修改时间
+	MergeTime     DateTimeType // 上次合并时间
+	ArrangeTime   DateTimeType // 上次整理时间
+	ReindexTime   DateTimeType // 上次重建索引时间
+	BackupTime    DateTimeType // 上次备份时间
+	RatedCapacity int64        // 创建时容量（额定容量）
+	Capacity      int64        // 当前容量，文件内包含的总数据页数，不包括头页。
+	Size          int64        // 实际使用量，已被占用的数据页数，不包括头页。
+	ExCapacity    int64        // 附属文件容量
+	IsMain        byte         // 是主文件还是附属文件
+	PageSize      byte         // 单个页的字节尺寸，单位为KB
+	IdOrCount     byte         // 主文件在这里存放附属文件数量，附属文件在这里存放自身的ID。
+	AutoMerge     byte         // 启用自动合并
+	AutoArrange   byte         // 启用自动整理
+	Merged        byte         // 1：已合并，0：尚未合并或合并后又产生了新的附属文件。
+	Arranged      byte         // 1：已整理，0：尚未整理过或整理后内容发生变更。
+	IndexType     byte         // 索引类型，0 为红黑树，1为跳跃链表。默认为红黑树
+	FileName      string       // 在这里存放自己的文件名。
+	Crc32         uint32       // 以上内容的CRC32校验码，暂不启用。
+	IndexInMem    byte         // 索引是否加载到内存中
+	ManageType    byte         // 被管理类型，RTDB_ARCHIVE_MANAGE_TYPE， 0:兼容模式  1:未管理 2:管理中
+	CompressType  byte         // 压缩类型，RTDB_ARCHIVE_COMPRESS_TYPE， 0:未压缩 1:无损压缩 2:两阶段压缩
+	Status        RtdbError    // 存档文件的当前状态，表示存档文件操作中遇到的异常错误码，正常为RtE_OK，可能遇到的异常有：RtE_INDEX_NOT_READY, RtE_CAN_NOT_CREATE_INDEX, RtE_NOT_ENOUGH_SPACE
+	UsedSize      int64        // 实际使用字节数，不包括存档文件头
+	BlockCount    int64        // 数据块数量
+	DelBlockSize  int64        // 逻辑上删除的数据块字节数
+	TotalCount    int64        // 数据总条数
+	BigPageSize   int16        // 数据页大小，单位为KB
+	VerCode       string       // 授权信息
+}
+
+type RtdbArchivePerfData struct {
+	WriteCount      uint32  // 历史服务查询的写磁盘次数
+	ReadCount       uint32  // 历史服务查询的读磁盘次数
+	WriteTime       float32 // 历史服务查询的写磁盘时间
+	ReadTime        float32 // 历史服务查询的读磁盘时间
+	IndexWriteCount uint32  // 历史服务查询的写索引次数
+	IndexReadCount  uint32  // 历史服务查询的读索引次数
+	IndexWriteTime  float32 // 历史服务查询的写索引时间
+	IndexReadTime   float32 // 历史服务查询的读索引时间
+	ArcListLockTime float32 // 历史服务查询的文件列表锁时间
+	ArcLockTime     float32 // 历史服务查询的存档文件锁时间
+	IndexLockTime   float32 // 历史服务查询的索引锁时间
+	TotalLockTime   float32 // 历史服务查询的锁的总时间
+	WriteSize       float32 // 历史服务查询的写入数据量，单位KB
+	ReadSize        float32 // 历史服务查询的读取数据量，单位KB
+	WriteRealSize   float32 // 历史服务查询的写入有效数据量，单位KB
+	ReadRealSize    float32 // 历史服务查询的读取有效数据量，单位KB
+
+}
+
+// BigJobName 数据库对应服务的大任务，每个服务最多同时执行一个大任务
+type BigJobName int16
+
+const (
+	// BigJobNameMerge 合并附属文件到主文件
+	BigJobNameMerge = BigJobName(1)
+
+	// BigJobNameArrange 整理存档文件，整理过程中会完成合并
+	BigJobNameArrange = BigJobName(2)
+
+	// BigJobNameReindex 重建索引
+	BigJobNameReindex = BigJobName(3)
+
+	// BigJobNameBackup 备份
+	BigJobNameBackup = BigJobName(4)
+
+	// BigJobNameReactive 激活为活动存档
+	BigJobNameReactive = BigJobName(5)
+
+	// BigJobNameArchive 移动存档文件
+	BigJobNameArchive = BigJobName(6)
+
+	// BigJobNameConvertIndex 转换存档文件索引类型
+	BigJobNameConvertIndex = BigJobName(7)
+
+	// BigJobNameCompress 压缩
+	BigJobNameCompress = BigJobName(8)
+
+	// BigJobNameMoveArchiveAuto  自动移动存档文件（用于存档文件滚动存储）
+	BigJobNameMoveArchiveAuto = BigJobName(9)
+
+	// BigJobNameCompute  历史计算
+	BigJobNameCompute = BigJobName(11)
+
+	// BigJobNameUpdateTable 修改表名称
+	BigJobNameUpdateTable = BigJobName(21)
+
+	// BigJobNameRemoveTable 删除表
+	BigJobNameRemoveTable = BigJobName(22)
+)
+
+type RtdbProcess int32
+
+const (
+	// RtdbProcessHistorian 历史服务
+	RtdbProcessHistorian = RtdbProcess(1)
+
+	// RtdbProcessEquation 方程式服务
+	RtdbProcessEquation = RtdbProcess(2)
+
+	// RtdbProcessBase 标签点服务
+	RtdbProcessBase = RtdbProcess(3)
+)
+
+// RtdbPerfTagID 性能计数点的ID
+type RtdbPerfTagID int32
+
+const (
+	// PftCpuUsageOfLogger 日志服务CPU使用
+	PftCpuUsageOfLogger = RtdbPerfTagID(0)
+
+	// PftMemBytesOfLogger 日志服务内存
+	PftMemBytesOfLogger = RtdbPerfTagID(1)
+
+	// PftVmemBytesOfLogger 日志服务虚拟内存
+	PftVmemBytesOfLogger = RtdbPerfTagID(2)
+
+	// PftReadBytesOfLogger 日志服务 I/O 读取字节
+	PftReadBytesOfLogger = RtdbPerfTagID(3)
+
+	// PftWriteBytesOfLogger 日志服务 I/O 写入字节
+	PftWriteBytesOfLogger = RtdbPerfTagID(4)
+
+	// PftCpuUsageOfHistorian 历史数据服务CPU使用
+	PftCpuUsageOfHistorian = RtdbPerfTagID(5)
+
+	// PftMemBytesOfHistorian 历史数据服务内存
+	PftMemBytesOfHistorian = RtdbPerfTagID(6)
+
+	// PftVmemBytesOfHistorian 历史数据服务虚拟内存
+	PftVmemBytesOfHistorian = RtdbPerfTagID(7)
+
+	// PftReadBytesOfHistorian 历史数据服务 I/O 读取字节
+	PftReadBytesOfHistorian = RtdbPerfTagID(8)
+
+	// PftWriteBytesOfHistorian 历史数据服务 I/O 写入字节
+	PftWriteBytesOfHistorian = RtdbPerfTagID(9)
+
+	// PftCpuUsageOfSnapshot 快照数据服务CPU使用
+	PftCpuUsageOfSnapshot = RtdbPerfTagID(10)
+
+	// PftMemBytesOfSnapshot 快照数据服务内存
+	PftMemBytesOfSnapshot = RtdbPerfTagID(11)
+
+	// PftVmemBytesOfSnapshot 快照数据服务虚拟内存
+	PftVmemBytesOfSnapshot = RtdbPerfTagID(12)
+
+	// PftReadBytesOfSnapshot 快照数据服务 I/O 读取字节
+	PftReadBytesOfSnapshot = RtdbPerfTagID(13)
+
+	// PftWriteBytesOfSnapshot 快照数据服务 I/O 写入字节
+	PftWriteBytesOfSnapshot = RtdbPerfTagID(14)
+
+	// PftCpuUsageOfEquation 实时方程式服务CPU使用
+	PftCpuUsageOfEquation = RtdbPerfTagID(15)
+
+	// PftMemBytesOfEquation 实时方程式服务内存
+	PftMemBytesOfEquation = RtdbPerfTagID(16)
+
+	// PftVmemBytesOfEquation 实时方程式服务虚拟内存
+	PftVmemBytesOfEquation = RtdbPerfTagID(17)
+
+	// PftReadBytesOfEquation 实时方程式服务 I/O 读取字节
+	PftReadBytesOfEquation = RtdbPerfTagID(18)
+
+	// PftWriteBytesOfEquation 实时方程式服务 I/O 写入字节
+	PftWriteBytesOfEquation = RtdbPerfTagID(19)
+
+	// PftCpuUsageOfBase 标签点信息服务CPU使用
+	PftCpuUsageOfBase = RtdbPerfTagID(20)
+
+	// PftMemBytesOfBase 标签点信息服务内存
+	PftMemBytesOfBase = RtdbPerfTagID(21)
+
+	// PftVmemBytesOfBase 标签点信息服务虚拟内存
+	PftVmemBytesOfBase = RtdbPerfTagID(22)
+
+	// PftReadBytesOfBase 标签点信息服务 I/O 读取字节
+	PftReadBytesOfBase = RtdbPerfTagID(23)
+
+	// PftWriteBytesOfBase 标签点信息服务 I/O 写入字节
+	PftWriteBytesOfBase = RtdbPerfTagID(24)
+
+	// PftCpuUsageOfServer 网络服务CPU使用
+	PftCpuUsageOfServer = RtdbPerfTagID(25)
+
+	// PftMemBytesOfServer 网络服务内存
+	PftMemBytesOfServer = RtdbPerfTagID(26)
+
+	// PftVmemBytesOfServer 网络服务虚拟内存
+	PftVmemBytesOfServer = RtdbPerfTagID(27)
+
+	// PftReadBytesOfServer 网络服务 I/O 读取字节
+	PftReadBytesOfServer = RtdbPerfTagID(28)
+
+	// PftWriteBytesOfServer 网络服务 I/O 写入字节
+	PftWriteBytesOfServer = RtdbPerfTagID(29)
+
+	// PftArvAsyncQueue 历史数据队列地址
+	PftArvAsyncQueue = RtdbPerfTagID(30)
+
+	// PftArvAsyncQueueUsage 历史数据队列使用率
+	PftArvAsyncQueueUsage = RtdbPerfTagID(31)
+
+	// PftArvexAsyncQueue 补历史数据队列地址
+	PftArvexAsyncQueue = RtdbPerfTagID(32)
+
+	// PftArvexAsyncQueueUsage 补历史数据队列使用率
+	PftArvexAsyncQueueUsage = RtdbPerfTagID(33)
+
+	// PftEventsInputRate 普通事件入库速度（KB/秒）
+	PftEventsInputRate = RtdbPerfTagID(34)
+
+	// PftEventsOutputRate 普通事件归档速度（KB/秒）
+	PftEventsOutputRate = RtdbPerfTagID(35)
+
+	// PftFillInInputRate 补历史事件入库速度（KB/秒）
+	PftFillInInputRate = RtdbPerfTagID(36)
+
+	// PftFillInOutputRate 补历史事件归档速度（KB/秒）
+	PftFillInOutputRate = RtdbPerfTagID(37)
+
+	// PftArvCacheUsage 历史数据缓存使用率
+	PftArvCacheUsage = RtdbPerfTagID(38)
+
+	// PftArvexCacheUsage 补历史数据缓存使用率
+	PftArvexCacheUsage = RtdbPerfTagID(39)
+
+	// PftMirrorSnapshotsQueue 快照数据的镜像队列地址
+	PftMirrorSnapshotsQueue = RtdbPerfTagID(40)
+
+	// PftMirrorSnapshotsQueueUsage 快照数据的镜像队列使用率
+	PftMirrorSnapshotsQueueUsage = RtdbPerfTagID(41)
+
+	// PftArvexBlobAsyncQueue str、blob补历史数据队列地址
+	PftArvexBlobAsyncQueue = RtdbPerfTagID(42)
+
+	// PftArvexBlobAsyncQueueUsage str、blob补历史数据队列使用率
+	PftArvexBlobAsyncQueueUsage = RtdbPerfTagID(43)
+
+	// PftArvexBlobCacheUsage str、blob补历史数据缓存使用率
+	PftArvexBlobCacheUsage = RtdbPerfTagID(44)
+
+	// PftMirrorBufferSize 快照数据的镜像缓存文件
+	PftMirrorBufferSize = RtdbPerfTagID(45)
+
+	// PftClutterPoolUsage 消息交换池利用率
+	PftClutterPoolUsage = RtdbPerfTagID(46)
+
+	// PftMaxBlockInClutterPool 消息交换池的最大可用额度
+	PftMaxBlockInClutterPool = RtdbPerfTagID(47)
+
+	// PftArvArchivedTime 历史数据归档耗时
+	PftArvArchivedTime = RtdbPerfTagID(48)
+
+	// PftArvexArchivedTime 补历史数据归档耗时
+	PftArvexArchivedTime = RtdbPerfTagID(49)
+
+	// PftArvexBlobArchivedTime str、blob补历史数据归档耗时
+	PftArvexBlobArchivedTime = RtdbPerfTagID(50)
+
+	// PftArvArchivedPageCount 历史数据归档的数据页数量
+	PftArvArchivedPageCount = RtdbPerfTagID(51)
+
+	// PftArvexArchivedPageCount 补历史数据归档的数据页数量
+	PftArvexArchivedPageCount = RtdbPerfTagID(52)
+
+	// PftArvexBlobArchivedPageCount str、blob补历史数据归档的数据页数量
+	PftArvexBlobArchivedPageCount = RtdbPerfTagID(53)
+
+	// PftMirrorArvValuesQueue 补写历史数据的镜像队列地址
+	PftMirrorArvValuesQueue = RtdbPerfTagID(54)
+
+	// PftMirrorArvValuesQueueUsage 补写历史数据的镜像队列使用率
+	PftMirrorArvValuesQueueUsage = RtdbPerfTagID(55)
+
+	// PftMirrorArvBufferSize 补写历史数据的镜像缓存文件
+	PftMirrorArvBufferSize = RtdbPerfTagID(56)
+
+	// PftArvWriteCount 历史数据归档写磁盘次数
+	PftArvWriteCount = RtdbPerfTagID(57)
+
+	// PftArvReadCount 历史数据归档读磁盘次数
+	PftArvReadCount = RtdbPerfTagID(58)
+
+	// PftArvWriteTime 历史数据归档写磁盘时间
+	PftArvWriteTime = RtdbPerfTagID(59)
+
+	// PftArvReadTime 历史数据归档读磁盘时间
+	PftArvReadTime = RtdbPerfTagID(60)
+
+	// PftArvIndexWriteCount 历史数据归档写索引次数
+	PftArvIndexWriteCount = RtdbPerfTagID(61)
+
+	// PftArvIndexReadCount 历史数据归档读索引次数
+	PftArvIndexReadCount = RtdbPerfTagID(62)
+
+	// PftArvIndexWriteTime 历史数据归档写索引时间
+	PftArvIndexWriteTime = RtdbPerfTagID(63)
+
+	// PftArvIndexReadTime 历史数据归档读索引时间
+	PftArvIndexReadTime = RtdbPerfTagID(64)
+
+	// PftArvArcListLockTime 历史数据归档列表锁时间
+	PftArvArcListLockTime = RtdbPerfTagID(65)
+
+	// PftArvArcLockTime 历史数据归档文件锁时间
+	PftArvArcLockTime = RtdbPerfTagID(66)
+
+	// PftArvIndexLockTime 历史数据归档索引锁时间
+	PftArvIndexLockTime = RtdbPerfTagID(67)
+
+	// PftArvTotalLockTime 历史数据归档锁总时间
+	PftArvTotalLockTime = RtdbPerfTagID(68)
+
+	// PftArvWriteSize 历史数据归档写磁盘数据量
+	PftArvWriteSize = RtdbPerfTagID(69)
+
+	// PftArvReadSize 历史数据归档读磁盘数据量
+	PftArvReadSize = RtdbPerfTagID(70)
+
+	// PftArvWriteRealSize 历史数据归档写磁盘有效数据量
+	PftArvWriteRealSize = RtdbPerfTagID(71)
+
+	// PftArvReadRealSize 历史数据归档读磁盘有效数据量
+	PftArvReadRealSize = RtdbPerfTagID(72)
+
+	// PftArvexWriteCount 补历史数据归档写磁盘次数
+	PftArvexWriteCount = RtdbPerfTagID(73)
+
+	// PftArvexReadCount 补历史数据归档读磁盘次数
+	PftArvexReadCount = RtdbPerfTagID(74)
+
+	// PftArvexWriteTime 补历史数据归档写磁盘时间
+	PftArvexWriteTime = RtdbPerfTagID(75)
+
+	// PftArvexReadTime 补历史数据归档读磁盘时间
+	PftArvexReadTime = RtdbPerfTagID(76)
+
+	// PftArvexIndexWriteCount 补历史数据归档写索引次数
+	PftArvexIndexWriteCount = RtdbPerfTagID(77)
+
+	// PftArvexIndexReadCount 补历史数据归档读索引次数
+	PftArvexIndexReadCount = RtdbPerfTagID(78)
+
+	// PftArvexIndexWriteTime 补历史数据归档写索引时间
+	PftArvexIndexWriteTime = RtdbPerfTagID(79)
+
+	// PftArvexIndexReadTime 补历史数据归档读索引时间
+	PftArvexIndexReadTime = RtdbPerfTagID(80)
+
+	// PftArvexArcListLockTime 补历史数据归档列表锁时间
+	PftArvexArcListLockTime = RtdbPerfTagID(81)
+
+	// PftArvexArcLockTime 补历史数据归档文件锁时间
+	PftArvexArcLockTime = RtdbPerfTagID(82)
+
+	// PftArvexIndexLockTime 补历史数据归档索引锁时间
+	PftArvexIndexLockTime = RtdbPerfTagID(83)
+
+	// PftArvexTotalLockTime 补历史数据归档锁总时间
+	PftArvexTotalLockTime = RtdbPerfTagID(84)
+
+	// PftArvexWriteSize 补历史数据归档写磁盘数据量
+	PftArvexWriteSize = RtdbPerfTagID(85)
+
+	// PftArvexReadSize 补历史数据归档读磁盘数据量
+	PftArvexReadSize = RtdbPerfTagID(86)
+
+	// PftArvexWriteRealSize 补历史数据归档写磁盘有效数据量
+	PftArvexWriteRealSize = RtdbPerfTagID(87)
+
+	// PftArvexReadRealSize 补历史数据归档读磁盘有效数据量
+	PftArvexReadRealSize = RtdbPerfTagID(88)
+
+	// PftPlotPoolPointCount 曲线缓存标签点数量
+	PftPlotPoolPointCount = RtdbPerfTagID(89)
+
+	// PftPlotPoolWeightedPointCount 曲线缓存权重点数量
+	PftPlotPoolWeightedPointCount = RtdbPerfTagID(90)
+
+	// PftPlotPoolTotalMemSize 曲线缓存总内存数
+	PftPlotPoolTotalMemSize = RtdbPerfTagID(91)
+
+	// PftPlotPoolCachedHitPercent 曲线缓存命中率
+	PftPlotPoolCachedHitPercent = RtdbPerfTagID(92)
+
+	// PftOsCpuUsage 数据库所在操作系统的CPU使用率
+	PftOsCpuUsage = RtdbPerfTagID(93)
+
+	// PftOsMemSize 数据库所在操作系统的物理内存大小，单位MB
+	PftOsMemSize = RtdbPerfTagID(94)
+
+	// PftOsMemUsage 数据库所在操作系统的物理内存使用率
+	PftOsMemUsage = RtdbPerfTagID(95)
+
+	// PftQueryPoolWaitTasksSize 查询线程池中等待执行的任务数
+	PftQueryPoolWaitTasksSize = RtdbPerfTagID(96)
+
+	// PftMirrorEnqueue 镜像每秒入队的数量，单位字节
+	PftMirrorEnqueue = RtdbPerfTagID(97)
+
+	// PftMirrorOutqueue 镜像每秒出对的数量，单位字节
+	PftMirrorOutqueue = RtdbPerfTagID(98)
+
+	// PftMirrorSendCprs 镜像每秒压缩的数量，单位字节
+	PftMirrorSendCprs = RtdbPerfTagID(99)
+
+	// PftMirrorRecvCprs 镜像每秒收到的压缩数量，单位字节
+	PftMirrorRecvCprs = RtdbPerfTagID(100)
+
+	// PftMirrorRecvUncprs 镜像每秒解压缩的数量，单位字节
+	PftMirrorRecvUncprs = RtdbPerfTagID(101)
+
+	// PftMirrorCprsSpan 镜像报文每秒的压缩耗时总和，单位毫秒
+	PftMirrorCprsSpan = RtdbPerfTagID(102)
+
+	// PftMirrorCompressRate 1秒内镜像的压缩率
+	PftMirrorCompressRate = RtdbPerfTagID(103)
+
+	// PftApiCprsRate API报文压缩率
+	PftApiCprsRate = RtdbPerfTagID(104)
+
+	// PftServerCprsRate Server报文压缩率
+	PftServerCprsRate = RtdbPerfTagID(105)
+
+	// PftTagSubscribeCustomerCount 标签点信息订阅客户端数量
+	PftTagSubscribeCustomerCount = RtdbPerfTagID(106)
+
+	// PftTagSubscribeSendEventCount 标签点信息订阅发送事件数量
+	PftTagSubscribeSendEventCount = RtdbPerfTagID(107)
+
+	// PftSnapSubscribeCustomerCount 快照信息订阅客户端数量
+	PftSnapSubscribeCustomerCount = RtdbPerfTagID(108)
+
+	// PftSnapSubscribeSendEventCount 快照信息订阅发送事件数量
+	PftSnapSubscribeSendEventCount = RtdbPerfTagID(109)
+
+	// PftSnapSubscribePointCount 快照信息订阅标签点数量
+	PftSnapSubscribePointCount = RtdbPerfTagID(110)
+
+	// PftConnectSubscribeCustomerCount API监视订阅客户端数量
+	PftConnectSubscribeCustomerCount = RtdbPerfTagID(111)
+
+	// PftConnectSubscribeSendEventCount API监视订阅发送事件数量
+	PftConnectSubscribeSendEventCount = RtdbPerfTagID(112)
+
+	// PftNamedTypeCreateSubscribeCustomerCount 创建自定义类型订阅客户端数量
+	PftNamedTypeCreateSubscribeCustomerCount = RtdbPerfTagID(113)
+
+	// PftNamedTypeCreateSendEventCount 创建自定义类型订阅发送事件数量
+	PftNamedTypeCreateSendEventCount = RtdbPerfTagID(114)
+
+	// PftNamedTypeRemoveSubscribeCustomerCount 删除自定义类型订阅客户端数量
+	PftNamedTypeRemoveSubscribeCustomerCount = RtdbPerfTagID(115)
+
+	// PftNamedTypeRemoveSendEventCount 删除自定义类型订阅发送事件数量
+	PftNamedTypeRemoveSendEventCount = RtdbPerfTagID(116)
+
+	// PftDoubleActiveSyncSendCount 双活同步每秒同步发送的数据量
+	PftDoubleActiveSyncSendCount = RtdbPerfTagID(117)
+
+	// PftDoubleActiveSyncReceiveCount 双活同步每秒同步接授的数据量
+	PftDoubleActiveSyncReceiveCount = RtdbPerfTagID(118)
+
+	// PftIsReceivingNormalDataFromPeer 双活系统正在接收普通类型数据
+	PftIsReceivingNormalDataFromPeer = RtdbPerfTagID(119)
+
+	// PftIsReceivingBlobDataFromPeer 双活系统正在接收blob类型数据
+	PftIsReceivingBlobDataFromPeer = RtdbPerfTagID(120)
+
+	// PftReplicatorBufferBlockCount 双活本地的同步历史缓存还有多少数据块
+	PftReplicatorBufferBlockCount = RtdbPerfTagID(121)
+
+	// PftReplicatorExBufferBlockCount 双活本地的同步补历史缓存还有多少数据块
+	PftReplicatorExBufferBlockCount = RtdbPerfTagID(122)
+
+	// PftReplicatorBlobBufferBlockCount 双活本地的同步历史缓存(blob string 数据)还有多少数据块
+	PftReplicatorBlobBufferBlockCount = RtdbPerfTagID(123)
+
+	// PftReplicatorBlobExBufferBlockCount 双活本地的同步补历史缓存(blob string 数据)还有多少数据块
+	PftReplicatorBlobExBufferBlockCount = RtdbPerfTagID(124)
+
+	// PftSnapshotPutRate 每秒写入快照记录数，单位 条
+	PftSnapshotPutRate = RtdbPerfTagID(125)
+
+	// PftSnapshotGetRate 每秒读取快照记录数，单位 条
+	PftSnapshotGetRate = RtdbPerfTagID(126)
+
+	// PftHistorianPutRate 每秒写入历史记录数，单位 条
+	PftHistorianPutRate = RtdbPerfTagID(127)
+
+	// PftHistorianGetRate 每秒读取历史记录数，单位 条
+	PftHistorianGetRate = RtdbPerfTagID(128)
+
+	// PftHistorianWriteRecordCount 每秒写入历史数据块数
+	PftHistorianWriteRecordCount = RtdbPerfTagID(129)
+
+	// PftHistorianReadRecordCount 每秒读取历史数据块数
+	PftHistorianReadRecordCount = RtdbPerfTagID(130)
+
+	// PftServerNetworkReadBytes 网络服务网络 IO 每秒读取字节数
+	PftServerNetworkReadBytes = RtdbPerfTagID(131)
+
+	// PftServerNetworkWriteBytes 网络服务网络 IO 每秒写入字节数
+	PftServerNetworkWriteBytes = RtdbPerfTagID(132)
+
+	// PftEnd 信息数量
+	PftEnd = RtdbPerfTagID(133)
+)
+
+// RtdbPerfTagInfo 性能计数点的信息
+type RtdbPerfTagInfo struct {
+	PerfID  RtdbPerfTagID // 性能计数点的ID 参考RTDB_PERF_TAG_ID
+	TagName string        // 性能计数点的名字
+	Desc    string        // 性能计数点的描述
+	Unit    string        // 性能计数点数值的单位
+	Type    RtdbType      // 性能计数点的数值类型
+}
+
+// RtdbGraphFlag 标签点拓扑图类型
+type RtdbGraphFlag int32
+
+const (
+	// RtdbGraphFlagAll 任何有关联的标签的关系图
+	RtdbGraphFlagAll = RtdbGraphFlag(0)
+
+	// RtdgGraphFlagDirect 有直接关系的关系图
+	RtdgGraphFlagDirect = RtdbGraphFlag(1)
+)
+
+// RtdbGraph 计算标签点方程式拓扑图键值对信息
+type RtdbGraph struct {
+	ID       int32  // 标签点ID
+	ParentID int32  // 标签点父ID，即父ID方程式中引用了子ID做运算
+	Tag      string // 标签点名称
+	ErrorMsg string // 无效标签点错误信息
+}
+
+// RtdbHisMode 历史数据搜索方式
+type RtdbHisMode int32
+
+const (
+	// RtdbHisModeNext 寻找下一个最近的数据
+	RtdbHisModeNext = RtdbHisMode(0)
+	// RtdbHisModePrevious 寻找上一个最近的数据
+	RtdbHisModePrevious = RtdbHisMode(1)
+	// RtdbHisModeExact 取指定时间的数据，如果没有则返回错误 \b RtE_DATA_NOT_FOUND
+	RtdbHisModeExact = RtdbHisMode(2)
+	// RtdbHisModeInter 取指定时间的内插值数据
+	RtdbHisModeInter = RtdbHisMode(3)
+	// RtdbHisModeExactOrNext 取指定时间的数据，如果没有则取下一条数据。如果都没有数据则返回错误 \b RtE_DATA_NOT_FOUND
+	RtdbHisModeExactOrNext = RtdbHisMode(4)
+	// RtdbHisModeExactOrPrev 取指定时间的数据，如果没有则取上一条数据。如果都没有数据则返回错误 \b RtE_DATA_NOT_FOUND
+	RtdbHisModeExactOrPrev = RtdbHisMode(5)
+	// RtdbHisModeInterOrNext 取指定时间的内插值数据, 如果没有则取下一条数据。如果都没有数据则返回错误 \b RtE_DATA_NOT_FOUND
+	RtdbHisModeInterOrNext = RtdbHisMode(6)
+)
+
+// String 历史数据搜索方式名称
+func (m RtdbHisMode) String() string {
+	switch m {
+	case RtdbHisModeNext:
+		return "next"
+	case RtdbHisModePrevious:
+		return "previous"
+	case RtdbHisModeExact:
+		return "exact"
+	case RtdbHisModeInter:
+		return "inter"
+	case RtdbHisModeExactOrNext:
+		return "exact_or_next"
+	case RtdbHisModeExactOrPrev:
+		return "exact_or_prev"
+	case RtdbHisModeInterOrNext:
+		return "inter_or_next"
+	default:
+		return "unknown"
+	}
+}
+
+// RtdbEventType 订阅事件
+type RtdbEventType uint32
+
+const (
+	// RtdbEventData 数据
+	RtdbEventData = RtdbEventType(0)
+	// RtdbEventDisconnect 连接断开
+	RtdbEventDisconnect = RtdbEventType(1)
+	// RtdbEventRecovery 连接恢复
+	RtdbEventRecovery = RtdbEventType(2)
+	// RtdbEventSwitching 双活模式，快照订阅，开始切换连接
+	RtdbEventSwitching = RtdbEventType(3)
+	// RtdbEventSwitched 双活模式，快照订阅，切换连接完毕
+	RtdbEventSwitched = RtdbEventType(4)
+	// RtdbEventChanged 订阅信息发生变化
+	RtdbEventChanged = RtdbEventType(5)
+)
+
+// String 订阅事件名称
+func (e RtdbEventType) String() string {
+	switch e {
+	case RtdbEventData:
+		return "data"
+	case RtdbEventDisconnect:
+		return "disconnect"
+	case RtdbEventRecovery:
+		return "recovery"
+	case RtdbEventSwitching:
+		return "switching"
+	case RtdbEventSwitched:
+		return "switched"
+	case RtdbEventChanged:
+		return "changed"
+	default:
+		return "unknown"
+	}
+}
+
+/*
+//   - \param max_value         双精度浮点型，输出，表示统计时间段内的最大数值。
+//   - \param min_value         双精度浮点型，输出，表示统计时间段内的最小数值。
+//   - \param total_value       双精度浮点型，输出，表示统计时间段内的累计值，结果的单位为标签点的工程单位。
+//   - \param calc_avg          双精度浮点型，输出，表示统计时间段内的算术平均值。
+//   - \param power_avg         双精度浮点型，输出，表示统计时间段内的加权平均值。
+//   - 此时前者表示结束时间，后者表示起始时间。
+//   - 如果输出的最大值或最小值的时间戳秒值为 0，
+//   - 则表明仅有累计值和加权平均值输出有效，其余统计结果无效。
+//   - 本接口对数据类型为 RTDB_COOR、RTDB_BLOB、RTDB_STRING 的标签点无效。
+*/
+
+type RtdbSummaryData struct {
+	FirstTime    TimestampType // 首部时间戳精度，秒级
+	LastTime     TimestampType // 尾部时间戳精度，秒级
+	MaxTime      TimestampType // 最大时间戳精度，秒级
+	MinTime      TimestampType // 最小时间戳精度，秒级
+	FirstSubtime SubtimeType   // 首部时间戳精度，纳秒级
+	LastSubtime  SubtimeType   // 尾部时间戳精度，纳秒级
+	MaxSubtime   SubtimeType   // 最大时间戳精度，纳秒级
+	MinSubtime   SubtimeType   // 最小时间戳精度，纳秒级
+	FirstValue   float64       // 首部值
+	LastValue    float64       // 尾部值
+	MaxValue     float64       // 最大值
+	MinValue     float64       // 最小值
+	FirstQuality int16         // 首部质量
+	LastQuality  int16         // 尾部质量
+	MaxQuality   int16         // 最大质量
+	MinQuality   int16         // 最小质量
+	Power        float64       // 加权值
+	PowerAvg     float64       // 加权平均值
+	Total        float64       // 累计值
+	CalcAvg      float64       // 算数平均值
+	Count        int32         // 个数
+	ValidCount   int32         // 有效个数
+}
@@ -0,0 +1,37 @@
+//go:build cgo
+
+package rtdb_api
+
+import "C"
+import "unsafe"
+
+func CCharArrayToString(p *C.char, n int) string {
+	b := C.GoBytes(unsafe.Pointer(p), C.int(n))
+	for i, v := range b {
+		if v == 0 {
+			return string(b[:i])
+		}
+	}
+	return string(b)
+}
+
+func GoStringToCCharArray(s string, p *C.char, n int) {
+	if p == nil || n <= 0 {
+		return
+	}
+
+	dst := unsafe.Slice((*byte)(unsafe.Pointer(p)), n)
+
+	b := []byte(s)
+	if len(b) >= n {
+		b = b[:n-1]
+	}
+
+	copy(dst, b)
+	dst[len(b)] = 0
+
+	// 可选：清零剩余空间
+	for i := len(b) + 1; i < n; i++ {
+		dst[i] = 0
+	}
+}
@@ -0,0 +1,555 @@
+// Package wspush 通过WebSocket向浏览器推送标签点快照, 可以嵌入到任意 http.ServeMux
+//
+// 所有客户端共用服务端的快照订阅: 同一个标签点只订阅一次, 收到快照后分发给订阅了该标签点的客户端。
+// 服务端订阅由 rtdb.SubscriptionManager 管理, 按 ShardSize(默认 RtdbConstMaxSubscribeSnapshots)个标签点一个连接进行分片。
+//
+// 协议为JSON文本消息:
+//   - 订阅: {"op":"subscribe","points":["plant.temp","plant.pressure"]}
+//   - 取消订阅: {"op":"unsubscribe","points":["plant.temp"]}
+//   - 订阅结果: {"type":"subscribed","points":["plant.temp"],"errors":[{"point":"plant.none","error":"...","category":"not-found"}]}
+//   - 取消订阅结果: {"type":"unsubscribed","points":["plant.temp"]}
+//   - 快照: {"type":"values","values":[{"id":1,"tag":"plant.temp","precision":1,"t":"...","type":"float64","v":21.5,"q":"good"}]}
+//   - 连接状态: {"type":"status","event":"disconnect"}, event为disconnect、recovery、switching、switched
+//   - 请求错误: {"type":"error","error":"..."}
+package wspush
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	rtdb "github.com/kkbase/rtdb_api"
+)
+
+// Options 推送选项
+type Options struct {
+	// Interval 每个客户端两次推送之间的最小间隔, 期间同一标签点只推送最新的快照, 为0时为500毫秒
+	Interval time.Duration
+
+	// MaxPoints 每个客户端最多订阅的标签点个数, 为0时为10000
+	MaxPoints int
+
+	// ShardSize 每个订阅连接的标签点个数, 为0或超过 RtdbConstMaxSubscribeSnapshots 时为 RtdbConstMaxSubscribeSnapshots
+	ShardSize int
+
+	// MaxMessageSize 客户端消息的最大字节数, 为0时为1MB
+	MaxMessageSize int64
+
+	// WriteTimeout 发送消息的超时时间, 超时的客户端会被断开, 为0时为10秒
+	WriteTimeout time.Duration
+
+	// CheckOrigin 检查握手请求的Origin, 为nil时只允许同源或没有Origin的请求
+	CheckOrigin func(r *http.Request) bool
+}
+
+// Handler WebSocket推送, 实现 http.Handler
+type Handler struct {
+	conn *rtdb.RtdbConnect
+	opts Options
+
+	// mu 串行修改服务端订阅与分发表中的标签点
+	mu  sync.Mutex
+	sub *rtdb.SubscriptionManager
+
+	// fanMu 保护分发表与客户端列表, 订阅回调中只持有读锁
+	fanMu   sync.RWMutex
+	fanout  map[rtdb.PointID]map[*client]struct{}
+	clients map[*client]struct{}
+	closing bool // Close 已开始, 不再接受新的客户端
+}
+
+// New 创建WebSocket推送
+//
+// input:
+//   - conn 数据库连接, 用于查找标签点与读取初始快照, 订阅使用单独登录的连接
+//   - opts 推送选项
+func New(conn *rtdb.RtdbConnect, opts Options) *Handler {
+	if opts.Interval <= 0 {
+		opts.Interval = 500 * time.Millisecond
+	}
+	if opts.MaxPoints <= 0 {
+		opts.MaxPoints = 10000
+	}
+	if opts.ShardSize <= 0 || opts.ShardSize > int(rtdb.RtdbConstMaxSubscribeSnapshots) {
+		opts.ShardSize = int(rtdb.RtdbConstMaxSubscribeSnapshots)
+	}
+	if opts.MaxMessageSize <= 0 {
+		opts.MaxMessageSize = 1 << 20
+	}
+	if opts.WriteTimeout <= 0 {
+		opts.WriteTimeout = 10 * time.Second
+	}
+	if opts.CheckOrigin == nil {
+		opts.CheckOrigin = sameOrigin
+	}
+	h := &Handler{
+		conn:    conn,
+		opts:    opts,
+		fanout:  make(map[rtdb.PointID]map[*client]struct{}),
+		clients: make(map[*client]struct{}),
+	}
+	h.sub = conn.NewSubscriptionManager(rtdb.SubscriptionManagerOptions{
+		SubscribeOptions: rtdb.SubscribeOptions{AutoConn: true},
+		ShardSize:        opts.ShardSize,
+	}, h.handler)
+	return h
+}
+
+// Shards 当前的订阅连接个数
+func (h *Handler) Shards() int {
+	return len(h.sub.Shards())
+}
+
+// Close 断开所有客户端并取消服务端订阅
+func (h *Handler) Close() error {
+	h.fanMu.Lock()
+	h.closing = true
+	clients := make([]*client, 0, len(h.clients))
+	for c := range h.clients {
+		clients = append(clients, c)
+	}
+	h.fanMu.Unlock()
+	for _, c := range clients {
+		_ = c.ws.closeWith(closeGoingAway, "服务关闭")
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.sub.Close()
+}
+
+// ServeHTTP 完成WebSocket握手并处理客户端消息, 直到连接断开
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ws, err := upgrade(w, r, h.opts.CheckOrigin)
+	if err != nil {
+		return
+	}
+	ws.maxMessage, ws.writeTimeout = h.opts.MaxMessageSize, h.opts.WriteTimeout
+	c := newClient(ws)
+
+	// 检查与登记在同一把锁内, Close 要么断开该客户端, 要么在此之前已经开始
+	h.fanMu.Lock()
+	closing := h.closing
+	if !closing {
+		h.clients[c] = struct{}{}
+	}
+	h.fanMu.Unlock()
+	if closing {
+		_ = ws.closeWith(closeGoingAway, "服务关闭")
+		return
+	}
+
+	go c.writeLoop(h.opts.Interval)
+	err = h.serve(c)
+	close(c.done)
+	h.unsubscribe(c, c.ids())
+	h.fanMu.Lock()
+	delete(h.clients, c)
+	h.fanMu.Unlock()
+
+	var ce *closeError
+	if errors.As(err, &ce) {
+		_ = ws.closeWith(ce.code, ce.msg)
+	} else {
+		_ = ws.closeWith(closeNormal, "")
+	}
+}
+
+// request 客户端的请求
+type request struct {
+	Op     string   `json:"op"`
+	Points []string `json:"points"`
+}
+
+// message 推送给客户端的消息
+type message struct {
+	Type   string       `json:"type"`
+	Points []string     `json:"points,omitempty"`
+	Errors []pointError `json:"errors,omitempty"`
+	Values []rtdb.PTVQ  `json:"values,omitempty"`
+	Event  string       `json:"event,omitempty"`
+	Error  string       `json:"error,omitempty"`
+}
+
+// pointError 订阅失败的标签点
+type pointError struct {
+	Point    string `json:"point"`
+	Error    string `json:"error"`
+	Category string `json:"category,omitempty"`
+}
+
+// newPointError 生成订阅失败的标签点, 数据库错误附带错误分类
+func newPointError(point string, err error) pointError {
+	e := pointError{Point: point, Error: err.Error()}
+	if category := rtdb.ErrorCategoryOf(err); category != rtdb.ErrorCategoryUnknown {
+		e.Category = category.String()
+	}
+	return e
+}
+
+// serve 处理客户端消息, 返回连接断开的原因
+func (h *Handler) serve(c *client) error {
+	for {
+		data, err := c.ws.readMessage()
+		if err != nil {
+			return err
+		}
+		req := request{}
+		if err := json.Unmarshal(data, &req); err != nil {
+			if err := c.send(message{Type: "error", Error: fmt.Sprintf("消息格式错误: %v", err)}); err != nil {
+				return err
+			}
+			continue
+		}
+		var reply message
+		switch req.Op {
+		case "subscribe":
+			reply = h.subscribe(c, req.Points)
+		case "unsubscribe":
+			reply = message{Type: "unsubscribed", Points: h.unsubscribeNames(c, req.Points)}
+		default:
+			reply = message{Type: "error", Error: fmt.Sprintf("未知的op: %q", req.Op)}
+		}
+		if err := c.send(reply); err != nil {
+			return err
+		}
+	}
+}
+
+// subscribe 订阅标签点, 订阅成功后推送当前快照
+func (h *Handler) subscribe(c *client, names []string) message {
+	reply := message{Type: "subscribed", Points: make([]string, 0), Errors: make([]pointError, 0)}
+	tags := make([]string, 0, len(names))
+	seen := make(map[string]bool, len(names))
+	for _, name := range names {
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+		if c.has(name) {
+			reply.Points = append(reply.Points, name)
+			continue
+		}
+		tags = append(tags, name)
+	}
+	if len(tags) == 0 {
+		return reply
+	}
+	if free := h.opts.MaxPoints - c.count(); len(tags) > free {
+		for _, name := range tags[max(free, 0):] {
+			reply.Errors = append(reply.Errors, pointError{Point: name, Error: fmt.Sprintf("超过单个客户端的订阅上限%d", h.opts.MaxPoints)})
+		}
+		tags = tags[:max(free, 0)]
+	}
+	if len(tags) == 0 {
+		return reply
+	}
+	found, errs, err := h.conn.FindPoints(tags)
+	if err != nil {
+		return message{Type: "error", Error: err.Error()}
+	}
+	infos := make([]*rtdb.PointInfo, 0, len(found))
+	for i, info := range found {
+		if errs[i] != nil {
+			reply.Errors = append(reply.Errors, newPointError(tags[i], errs[i]))
+			continue
+		}
+		infos = append(infos, info)
+	}
+
+	attachErrs := h.attach(c, infos)
+	subscribed := make([]*rtdb.PointInfo, 0, len(infos))
+	for i, info := range infos {
+		if attachErrs[i] != nil {
+			reply.Errors = append(reply.Errors, newPointError(info.TableDotTag, attachErrs[i]))
+			continue
+		}
+		c.add(info)
+		subscribed = append(subscribed, info)
+		reply.Points = append(reply.Points, info.TableDotTag)
+	}
+
+	// 订阅之前的快照不会推送, 读取一次作为初始值
+	if len(subscribed) != 0 {
+		tvqs, readErrs, err := h.conn.ReadSnapshots(subscribed)
+		if err == nil {
+			for i, info := range subscribed {
+				if readErrs[i] == nil {
+					c.push(rtdb.NewPTVQ(info, tvqs[i]))
+				}
+			}
+		}
+	}
+	return reply
+}
+
+// attach 将客户端加入标签点的分发表, 没有订阅的标签点添加到服务端订阅, 返回值与infos一一对应
+func (h *Handler) attach(c *client, infos []*rtdb.PointInfo) []error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	errs := make([]error, len(infos))
+	// 分发表中的标签点都已经订阅, 只在持有mu时修改, 可以不持有fanMu读取
+	rest, idx := make([]*rtdb.PointInfo, 0), make([]int, 0)
+	for i, info := range infos {
+		if _, ok := h.fanout[info.ID]; !ok {
+			rest, idx = append(rest, info), append(idx, i)
+		}
+	}
+	if len(rest) != 0 {
+		addErrs, err := h.sub.Add(rest)
+		for j, i := range idx {
+			switch {
+			case err != nil:
+				errs[i] = err
+			case !errors.Is(addErrs[j], rtdb.RteAlreadySubscribe):
+				// 之前取消订阅失败的标签点仍然在服务端订阅中
+				errs[i] = addErrs[j]
+			}
+		}
+	}
+
+	h.fanMu.Lock()
+	defer h.fanMu.Unlock()
+	for i, info := range infos {
+		if errs[i] != nil {
+			continue
+		}
+		set, ok := h.fanout[info.ID]
+		if !ok {
+			set = make(map[*client]struct{})
+			h.fanout[info.ID] = set
+		}
+		set[c] = struct{}{}
+	}
+	return errs
+}
+
+// unsubscribeNames 按名称取消订阅, 返回取消成功的标签点
+func (h *Handler) unsubscribeNames(c *client, names []string) []string {
+	ids := make([]rtdb.PointID, 0, len(names))
+	done := make([]string, 0, len(names))
+	for _, name := range names {
+		if info := c.remove(name); info != nil {
+			ids = append(ids, info.ID)
+			done = append(done, name)
+		}
+	}
+	h.unsubscribe(c, ids)
+	return done
+}
+
+// unsubscribe 将客户端移出分发表, 没有客户端的标签点从服务端订阅中删除
+func (h *Handler) unsubscribe(c *client, ids []rtdb.PointID) {
+	if len(ids) == 0 {
+		return
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	unused := make([]rtdb.PointID, 0)
+	h.fanMu.Lock()
+	for _, id := range ids {
+		set, ok := h.fanout[id]
+		if !ok {
+			continue
+		}
+		delete(set, c)
+		if len(set) == 0 {
+			delete(h.fanout, id)
+			unused = append(unused, id)
+		}
+	}
+	h.fanMu.Unlock()
+	if len(unused) != 0 {
+		_, _ = h.sub.Remove(unused)
+	}
+}
+
+// handler 服务端订阅的事件处理函数, 在数据库API的线程中执行, 只把快照放入客户端的待发送缓存
+func (h *Handler) handler(u rtdb.SnapshotUpdate) {
+	event := ""
+	switch u.Type {
+	case rtdb.RtdbEventData:
+	case rtdb.RtdbEventDisconnect:
+		event = "disconnect"
+	case rtdb.RtdbEventRecovery:
+		event = "recovery"
+	case rtdb.RtdbEventSwitching:
+		event = "switching"
+	case rtdb.RtdbEventSwitched:
+		event = "switched"
+	default:
+		return
+	}
+
+	h.fanMu.RLock()
+	defer h.fanMu.RUnlock()
+	if event != "" {
+		for c := range h.clients {
+			c.pushStatus(event)
+		}
+		return
+	}
+	for i, v := range u.Values {
+		if u.Errors[i] != nil {
+			continue
+		}
+		for c := range h.fanout[v.PointInfo.ID] {
+			c.push(v)
+		}
+	}
+}
+
+// client WebSocket客户端
+type client struct {
+	ws *wsConn
+
+	// points 客户端订阅的标签点, 键为标签点全名
+	pmu    sync.Mutex
+	points map[string]*rtdb.PointInfo
+
+	// 待发送的快照与连接状态, 同一标签点只保留最新的快照
+	mu      sync.Mutex
+	pending map[rtdb.PointID]rtdb.PTVQ
+	status  string
+
+	notify chan struct{}
+	done   chan struct{}
+}
+
+// newClient 创建客户端
+func newClient(ws *wsConn) *client {
+	return &client{
+		ws:      ws,
+		points:  make(map[string]*rtdb.PointInfo),
+		pending: make(map[rtdb.PointID]rtdb.PTVQ),
+		notify:  make(chan struct{}, 1),
+		done:    make(chan struct{}),
+	}
+}
+
+func (c *client) has(name string) bool {
+	c.pmu.Lock()
+	defer c.pmu.Unlock()
+	_, ok := c.points[name]
+	return ok
+}
+
+func (c *client) count() int {
+	c.pmu.Lock()
+	defer c.pmu.Unlock()
+	return len(c.points)
+}
+
+func (c *client) add(info *rtdb.PointInfo) {
+	c.pmu.Lock()
+	defer c.pmu.Unlock()
+	c.points[info.TableDotTag] = info
+}
+
+// remove 删除订阅的标签点, 没有订阅时返回nil
+func (c *client) remove(name string) *rtdb.PointInfo {
+	c.pmu.Lock()
+	defer c.pmu.Unlock()
+	info, ok := c.points[name]
+	if !ok {
+		return nil
+	}
+	delete(c.points, name)
+	c.mu.Lock()
+	delete(c.pending, info.ID)
+	c.mu.Unlock()
+	return info
+}
+
+// ids 订阅的全部标签点ID
+func (c *client) ids() []rtdb.PointID {
+	c.pmu.Lock()
+	defer c.pmu.Unlock()
+	ids := make([]rtdb.PointID, 0, len(c.points))
+	for _, info := range c.points {
+		ids = append(ids, info.ID)
+	}
+	return ids
+}
+
+// push 缓存快照, 已缓存更新的快照时忽略
+func (c *client) push(v rtdb.PTVQ) {
+	c.mu.Lock()
+	if old, ok := c.pending[v.PointInfo.ID]; !ok || !v.TVQ.Timestamp.Before(old.TVQ.Timestamp) {
+		c.pending[v.PointInfo.ID] = v
+	}
+	c.mu.Unlock()
+	c.signal()
+}
+
+// pushStatus 缓存连接状态, 只保留最新的状态
+func (c *client) pushStatus(event string) {
+	c.mu.Lock()
+	c.status = event
+	c.mu.Unlock()
+	c.signal()
+}
+
+func (c *client) signal() {
+	select {
+	case c.notify <- struct{}{}:
+	default:
+	}
+}
+
+// take 取出待发送的快照与连接状态, 快照按标签点全名排序
+func (c *client) take() (string, []rtdb.PTVQ) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	status := c.status
+	c.status = ""
+	values := make([]rtdb.PTVQ, 0, len(c.pending))
+	for _, v := range c.pending {
+		values = append(values, v)
+	}
+	clear(c.pending)
+	sort.Slice(values, func(i, j int) bool { return values[i].PointInfo.TableDotTag < values[j].PointInfo.TableDotTag })
+	return status, values
+}
+
+// send 发送一个消息
+func (c *client) send(m message) error {
+	data, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	return c.ws.writeFrame(opText, data)
+}
+
+// writeLoop 按间隔发送缓存的快照, 发送失败时断开连接
+func (c *client) writeLoop(interval time.Duration) {
+	for {
+		select {
+		case <-c.done:
+			return
+		case <-c.notify:
+		}
+		status, values := c.take()
+		if status != "" {
+			if err := c.send(message{Type: "status", Event: status}); err != nil {
+				_ = c.ws.closeWith(closeGoingAway, "")
+				return
+			}
+		}
+		if len(values) != 0 {
+			if err := c.send(message{Type: "values", Values: values}); err != nil {
+				_ = c.ws.closeWith(closeGoingAway, "")
+				return
+			}
+		}
+		select {
+		case <-c.done:
+			return
+		case <-time.After(interval):
+		}
+	}
+}
@@ -0,0 +1,295 @@
+package wspush
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	rtdb "github.com/kkbase/rtdb_api"
+)
+
+// testClient 测试用的WebSocket客户端
+type testClient struct {
+	t    *testing.T
+	conn net.Conn
+	br   *bufio.Reader
+}
+
+// dial 连接到测试服务
+func dial(t *testing.T, server *httptest.Server) *testClient {
+	host := strings.TrimPrefix(server.URL, "http://")
+	conn, err := net.Dial("tcp", host)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	key := "dGhlIHNhbXBsZSBub25jZQ=="
+	req := "GET / HTTP/1.1\r\nHost: " + host + "\r\nUpgrade: websocket\r\nConnection: Upgrade\r\nSec-WebSocket-Key: " + key + "\r\nSec-WebSocket-Version: 13\r\n\r\n"
+	if _, err := conn.Write([]byte(req)); err != nil {
+		t.Fatal(err)
+	}
+	br := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(br, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusSwitchingProtocols || resp.Header.Get("Sec-WebSocket-Accept") != acceptKey(key) {
+		t.Fatal("握手失败", resp.Status)
+	}
+	return &testClient{t: t, conn: conn, br: br}
+}
+
+// send 发送请求
+func (c *testClient) send(v any) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	if _, err := c.conn.Write(appendFrame(nil, opText, data, []byte{7, 8, 9, 10})); err != nil {
+		c.t.Fatal(err)
+	}
+}
+
+// recv 接收一个消息
+func (c *testClient) recv() message {
+	c.t.Helper()
+	_ = c.conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	head := make([]byte, 2)
+	if _, err := io.ReadFull(c.br, head); err != nil {
+		c.t.Fatal("接收消息失败", err)
+	}
+	length := int(head[1] & 0x7F)
+	switch length {
+	case 126:
+		ext := make([]byte, 2)
+		_, _ = io.ReadFull(c.br, ext)
+		length = int(binary.BigEndian.Uint16(ext))
+	case 127:
+		ext := make([]byte, 8)
+		_, _ = io.ReadFull(c.br, ext)
+		length = int(binary.BigEndian.Uint64(ext))
+	}
+	payload := make([]byte, length)
+	if _, err := io.ReadFull(c.br, payload); err != nil {
+		c.t.Fatal(err)
+	}
+	if head[0]&0x0F != opText {
+		c.t.Fatalf("期望文本消息 %x %v", head[0], payload)
+	}
+	m := message{}
+	if err := json.Unmarshal(payload, &m); err != nil {
+		c.t.Fatal(err)
+	}
+	return m
+}
+
+// recvClose 接收关闭帧, 返回关闭码
+func (c *testClient) recvClose() int {
+	c.t.Helper()
+	_ = c.conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	head := make([]byte, 2)
+	if _, err := io.ReadFull(c.br, head); err != nil {
+		c.t.Fatal("接收消息失败", err)
+	}
+	payload := make([]byte, head[1]&0x7F)
+	if _, err := io.ReadFull(c.br, payload); err != nil {
+		c.t.Fatal(err)
+	}
+	if head[0]&0x0F != opClose || len(payload) < 2 {
+		c.t.Fatalf("期望关闭帧 %x %v", head[0], payload)
+	}
+	return int(binary.BigEndian.Uint16(payload))
+}
+
+// waitShards 等待订阅连接个数变为n
+func waitShards(t *testing.T, h *Handler, n int) {
+	t.Helper()
+	for i := 0; i < 100 && h.Shards() != n; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if h.Shards() != n {
+		t.Fatalf("订阅连接个数错误, 期望%d实际%d", n, h.Shards())
+	}
+}
+
+// 订阅、分片、分发与节流
+func TestHandler(t *testing.T) {
+	conn, err := rtdb.LoginWithBackend(rtdb.NewMemoryBackend(), "127.0.0.1", 6327, "sa", "golden")
+	if err != nil {
+		t.Fatal("登录用户失败", err)
+	}
+	defer func() { _ = conn.Logout() }()
+	table, err := conn.CreateTable("hmi", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	infos := make(map[string]*rtdb.PointInfo)
+	now := time.UnixMilli(1700000000000)
+	for _, name := range []string{"a", "b", "c"} {
+		info, err := conn.AddPoint(rtdb.NewPointInfo(name, table.ID, rtdb.ValueTypeFloat64, rtdb.PointBase, rtdb.RtdbPrecisionMilli, "", ""))
+		if err != nil {
+			t.Fatal(err)
+		}
+		infos[name] = info
+		if err := conn.WriteValue(info, false, rtdb.NewTvqFloat64(now, 1, rtdb.QualityGood)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write := func(name string, v float64) {
+		now = now.Add(time.Second)
+		if err := conn.WriteValue(infos[name], false, rtdb.NewTvqFloat64(now, v, rtdb.QualityGood)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	h := New(conn, Options{Interval: 200 * time.Millisecond, ShardSize: 2, MaxPoints: 4})
+	defer func() { _ = h.Close() }()
+	server := httptest.NewServer(h)
+	defer server.Close()
+
+	c1 := dial(t, server)
+	c1.send(request{Op: "subscribe", Points: []string{"hmi.a", "hmi.b", "hmi.c", "hmi.none", "hmi.a"}})
+	m := c1.recv()
+	if m.Type != "subscribed" || len(m.Points) != 3 || len(m.Errors) != 1 || m.Errors[0].Point != "hmi.none" || m.Errors[0].Category != "not-found" {
+		t.Fatalf("订阅结果错误 %+v", m)
+	}
+	waitShards(t, h, 2)
+	if m = c1.recv(); m.Type != "values" || len(m.Values) != 3 || m.Values[0].PointInfo.TableDotTag != "hmi.a" || m.Values[0].TVQ.Value.FloatValue != 1 {
+		t.Fatalf("初始快照错误 %+v", m)
+	}
+
+	c2 := dial(t, server)
+	c2.send(request{Op: "subscribe", Points: []string{"hmi.a"}})
+	if m = c2.recv(); m.Type != "subscribed" || len(m.Points) != 1 {
+		t.Fatalf("订阅结果错误 %+v", m)
+	}
+	if m = c2.recv(); m.Type != "values" || len(m.Values) != 1 {
+		t.Fatalf("初始快照错误 %+v", m)
+	}
+	waitShards(t, h, 2)
+
+	// 同一个服务端订阅分发给两个客户端
+	write("a", 2)
+	for _, c := range []*testClient{c1, c2} {
+		if m = c.recv(); m.Type != "values" || len(m.Values) != 1 || m.Values[0].TVQ.Value.FloatValue != 2 {
+			t.Fatalf("快照推送错误 %+v", m)
+		}
+	}
+	// 推送间隔内只保留最新的快照
+	write("a", 3)
+	write("a", 4)
+	write("b", 5)
+	m = c1.recv()
+	if m.Type != "values" || len(m.Values) != 2 || m.Values[0].TVQ.Value.FloatValue != 4 || m.Values[1].TVQ.Value.FloatValue != 5 {
+		t.Fatalf("节流后的快照错误 %+v", m)
+	}
+	if m = c2.recv(); len(m.Values) != 1 || m.Values[0].TVQ.Value.FloatValue != 4 {
+		t.Fatalf("节流后的快照错误 %+v", m)
+	}
+
+	c1.send(request{Op: "subscribe", Points: []string{"hmi.x", "hmi.y"}})
+	if m = c1.recv(); len(m.Errors) != 2 || m.Errors[0].Point != "hmi.y" || !strings.Contains(m.Errors[0].Error, "上限") {
+		t.Fatalf("超过订阅上限时的结果错误 %+v", m)
+	}
+	c1.send(request{Op: "unsubscribe", Points: []string{"hmi.c", "hmi.none"}})
+	if m = c1.recv(); m.Type != "unsubscribed" || len(m.Points) != 1 || m.Points[0] != "hmi.c" {
+		t.Fatalf("取消订阅结果错误 %+v", m)
+	}
+	waitShards(t, h, 1)
+	c1.send(request{Op: "noop"})
+	if m = c1.recv(); m.Type != "error" {
+		t.Fatalf("未知op的结果错误 %+v", m)
+	}
+
+	// 客户端断开后只保留其他客户端订阅的标签点
+	_ = c1.conn.Close()
+	waitShards(t, h, 1)
+	write("b", 6)
+	write("a", 7)
+	if m = c2.recv(); len(m.Values) != 1 || m.Values[0].TVQ.Value.FloatValue != 7 {
+		t.Fatalf("快照推送错误 %+v", m)
+	}
+	_ = c2.conn.Close()
+	waitShards(t, h, 0)
+}
+
+// 握手请求错误与Origin检查
+func TestHandler_Upgrade(t *testing.T) {
+	conn, err := rtdb.LoginWithBackend(rtdb.NewMemoryBackend(), "127.0.0.1", 6327, "sa", "golden")
+	if err != nil {
+		t.Fatal("登录用户失败", err)
+	}
+	defer func() { _ = conn.Logout() }()
+	h := New(conn, Options{})
+	server := httptest.NewServer(h)
+	defer server.Close()
+
+	resp, err := http.Get(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Error("非WebSocket请求期望400", resp.StatusCode)
+	}
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+	req.Header.Set("Connection", "Upgrade")
+	req.Header.Set("Upgrade", "websocket")
+	req.Header.Set("Sec-WebSocket-Version", "13")
+	req.Header.Set("Sec-WebSocket-Key", "dGhlIHNhbXBsZSBub25jZQ==")
+	req.Header.Set("Origin", "http://example.com")
+	resp, err = http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusForbidden {
+		t.Error("跨域请求期望403", resp.StatusCode)
+	}
+}
+
+// Close 断开已登记的客户端, 之后连接的客户端立即被断开
+func TestHandler_Close(t *testing.T) {
+	conn, err := rtdb.LoginWithBackend(rtdb.NewMemoryBackend(), "127.0.0.1", 6327, "sa", "golden")
+	if err != nil {
+		t.Fatal("登录用户失败", err)
+	}
+	defer func() { _ = conn.Logout() }()
+	h := New(conn, Options{})
+	server := httptest.NewServer(h)
+	defer server.Close()
+
+	clients := func() int {
+		h.fanMu.RLock()
+		defer h.fanMu.RUnlock()
+		return len(h.clients)
+	}
+	before := dial(t, server)
+	for i := 0; i < 100 && clients() != 1; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if err := h.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if code := before.recvClose(); code != closeGoingAway {
+		t.Error("关闭码错误", code)
+	}
+	after := dial(t, server)
+	if code := after.recvClose(); code != closeGoingAway {
+		t.Error("关闭码错误", code)
+	}
+	for i := 0; i < 100 && clients() != 0; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if n := clients(); n != 0 {
+		t.Error("Close之后不应登记客户端", n)
+	}
+}
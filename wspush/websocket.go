@@ -0,0 +1,294 @@
+package wspush
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// 以下为RFC 6455中服务端需要的部分: 握手、分片消息、ping/pong与关闭, 不支持扩展(permessage-deflate)
+
+// websocketGUID 计算 Sec-WebSocket-Accept 使用的GUID
+const websocketGUID = "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"
+
+// 帧类型
+const (
+	opContinuation = 0x0
+	opText         = 0x1
+	opBinary       = 0x2
+	opClose        = 0x8
+	opPing         = 0x9
+	opPong         = 0xA
+)
+
+// 关闭码
+const (
+	closeNormal        = 1000
+	closeGoingAway     = 1001
+	closeProtocolError = 1002
+	closeUnsupported   = 1003
+	closeTooLarge      = 1009
+)
+
+// errClosed 对方发送了关闭帧
+var errClosed = errors.New("WebSocket连接已关闭")
+
+// closeError 需要以指定关闭码关闭连接的错误
+type closeError struct {
+	code int
+	msg  string
+}
+
+func (e *closeError) Error() string {
+	return fmt.Sprintf("WebSocket错误(%d): %s", e.code, e.msg)
+}
+
+// wsConn 服务端的WebSocket连接
+type wsConn struct {
+	conn         net.Conn
+	br           *bufio.Reader
+	maxMessage   int64
+	writeTimeout time.Duration
+
+	wmu    sync.Mutex
+	closed bool
+}
+
+// headerContains 请求头中逗号分隔的值是否包含token(不区分大小写)
+func headerContains(h http.Header, name, token string) bool {
+	for _, v := range h.Values(name) {
+		for _, t := range strings.Split(v, ",") {
+			if strings.EqualFold(strings.TrimSpace(t), token) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// acceptKey 计算 Sec-WebSocket-Accept
+func acceptKey(key string) string {
+	h := sha1.Sum([]byte(key + websocketGUID))
+	return base64.StdEncoding.EncodeToString(h[:])
+}
+
+// sameOrigin 没有Origin(非浏览器客户端)或者Origin与Host相同
+func sameOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+	u, err := url.Parse(origin)
+	if err != nil {
+		return false
+	}
+	return strings.EqualFold(u.Host, r.Host)
+}
+
+// upgrade 完成WebSocket握手, 失败时已经输出错误响应
+func upgrade(w http.ResponseWriter, r *http.Request, checkOrigin func(*http.Request) bool) (*wsConn, error) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "只支持GET请求", http.StatusMethodNotAllowed)
+		return nil, errors.New("只支持GET请求")
+	}
+	if !headerContains(r.Header, "Connection", "upgrade") || !headerContains(r.Header, "Upgrade", "websocket") {
+		http.Error(w, "需要WebSocket握手请求", http.StatusBadRequest)
+		return nil, errors.New("需要WebSocket握手请求")
+	}
+	if r.Header.Get("Sec-WebSocket-Version") != "13" {
+		w.Header().Set("Sec-WebSocket-Version", "13")
+		http.Error(w, "只支持WebSocket版本13", http.StatusUpgradeRequired)
+		return nil, errors.New("只支持WebSocket版本13")
+	}
+	key := r.Header.Get("Sec-WebSocket-Key")
+	if key == "" {
+		http.Error(w, "缺少Sec-WebSocket-Key", http.StatusBadRequest)
+		return nil, errors.New("缺少Sec-WebSocket-Key")
+	}
+	if !checkOrigin(r) {
+		http.Error(w, "不允许的Origin", http.StatusForbidden)
+		return nil, errors.New("不允许的Origin")
+	}
+	hj, ok := w.(http.Hijacker)
+	if !ok {
+		http.Error(w, "不支持WebSocket", http.StatusInternalServerError)
+		return nil, errors.New("http.ResponseWriter不支持Hijack")
+	}
+	conn, rw, err := hj.Hijack()
+	if err != nil {
+		return nil, err
+	}
+	_ = conn.SetDeadline(time.Time{})
+	resp := "HTTP/1.1 101 Switching Protocols\r\nUpgrade: websocket\r\nConnection: Upgrade\r\nSec-WebSocket-Accept: " + acceptKey(key) + "\r\n\r\n"
+	if _, err := conn.Write([]byte(resp)); err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	return &wsConn{conn: conn, br: rw.Reader}, nil
+}
+
+// readFrame 读取一帧, 客户端发送的帧必须带掩码
+func (c *wsConn) readFrame() (fin bool, op byte, payload []byte, err error) {
+	var head [2]byte
+	if _, err = io.ReadFull(c.br, head[:]); err != nil {
+		return
+	}
+	fin, op = head[0]&0x80 != 0, head[0]&0x0F
+	if head[0]&0x70 != 0 {
+		return fin, op, nil, &closeError{closeProtocolError, "不支持扩展"}
+	}
+	if head[1]&0x80 == 0 {
+		return fin, op, nil, &closeError{closeProtocolError, "客户端的帧没有掩码"}
+	}
+	length := int64(head[1] & 0x7F)
+	switch length {
+	case 126:
+		var ext [2]byte
+		if _, err = io.ReadFull(c.br, ext[:]); err != nil {
+			return
+		}
+		length = int64(binary.BigEndian.Uint16(ext[:]))
+	case 127:
+		var ext [8]byte
+		if _, err = io.ReadFull(c.br, ext[:]); err != nil {
+			return
+		}
+		length = int64(binary.BigEndian.Uint64(ext[:]) & (1<<63 - 1))
+	}
+	if op >= opClose && (length > 125 || !fin) {
+		return fin, op, nil, &closeError{closeProtocolError, "控制帧格式错误"}
+	}
+	if c.maxMessage > 0 && length > c.maxMessage {
+		return fin, op, nil, &closeError{closeTooLarge, "消息过大"}
+	}
+	var mask [4]byte
+	if _, err = io.ReadFull(c.br, mask[:]); err != nil {
+		return
+	}
+	payload = make([]byte, length)
+	if _, err = io.ReadFull(c.br, payload); err != nil {
+		return
+	}
+	for i := range payload {
+		payload[i] ^= mask[i%4]
+	}
+	return fin, op, payload, nil
+}
+
+// readMessage 读取一个完整的文本消息, 自动回复ping, 收到关闭帧时返回 errClosed
+func (c *wsConn) readMessage() ([]byte, error) {
+	var message []byte
+	started := false
+	for {
+		fin, op, payload, err := c.readFrame()
+		if err != nil {
+			return nil, err
+		}
+		switch op {
+		case opPing:
+			if err := c.writeFrame(opPong, payload); err != nil {
+				return nil, err
+			}
+			continue
+		case opPong:
+			continue
+		case opClose:
+			code := closeNormal
+			if len(payload) >= 2 {
+				code = int(binary.BigEndian.Uint16(payload))
+			}
+			_ = c.closeWith(code, "")
+			return nil, errClosed
+		case opText, opBinary:
+			if started {
+				return nil, &closeError{closeProtocolError, "上一个消息没有结束"}
+			}
+			if op == opBinary {
+				return nil, &closeError{closeUnsupported, "只支持文本消息"}
+			}
+			started, message = true, payload
+		case opContinuation:
+			if !started {
+				return nil, &closeError{closeProtocolError, "没有开始的分片消息"}
+			}
+			message = append(message, payload...)
+		default:
+			return nil, &closeError{closeProtocolError, fmt.Sprintf("未知的帧类型%d", op)}
+		}
+		if c.maxMessage > 0 && int64(len(message)) > c.maxMessage {
+			return nil, &closeError{closeTooLarge, "消息过大"}
+		}
+		if fin {
+			return message, nil
+		}
+	}
+}
+
+// appendFrame 编码一帧, mask不为nil时使用掩码(客户端)
+func appendFrame(buf []byte, op byte, payload []byte, mask []byte) []byte {
+	buf = append(buf, 0x80|op)
+	maskBit := byte(0)
+	if mask != nil {
+		maskBit = 0x80
+	}
+	switch n := len(payload); {
+	case n <= 125:
+		buf = append(buf, maskBit|byte(n))
+	case n <= 0xFFFF:
+		buf = append(buf, maskBit|126)
+		buf = binary.BigEndian.AppendUint16(buf, uint16(n))
+	default:
+		buf = append(buf, maskBit|127)
+		buf = binary.BigEndian.AppendUint64(buf, uint64(n))
+	}
+	if mask == nil {
+		return append(buf, payload...)
+	}
+	buf = append(buf, mask[:4]...)
+	for i, b := range payload {
+		buf = append(buf, b^mask[i%4])
+	}
+	return buf
+}
+
+// writeFrame 发送一帧, 可以在多个协程中调用
+func (c *wsConn) writeFrame(op byte, payload []byte) error {
+	c.wmu.Lock()
+	defer c.wmu.Unlock()
+	if c.closed {
+		return errClosed
+	}
+	if c.writeTimeout > 0 {
+		_ = c.conn.SetWriteDeadline(time.Now().Add(c.writeTimeout))
+	}
+	_, err := c.conn.Write(appendFrame(nil, op, payload, nil))
+	return err
+}
+
+// closeWith 发送关闭帧并关闭连接
+func (c *wsConn) closeWith(code int, reason string) error {
+	c.wmu.Lock()
+	defer c.wmu.Unlock()
+	if c.closed {
+		return nil
+	}
+	c.closed = true
+	payload := binary.BigEndian.AppendUint16(nil, uint16(code))
+	payload = append(payload, reason...)
+	if len(payload) > 125 {
+		payload = payload[:125]
+	}
+	_ = c.conn.SetWriteDeadline(time.Now().Add(time.Second))
+	_, _ = c.conn.Write(appendFrame(nil, opClose, payload, nil))
+	return c.conn.Close()
+}
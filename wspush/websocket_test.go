@@ -0,0 +1,54 @@
+package wspush
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"net"
+	"testing"
+)
+
+// 分片消息、ping与消息大小限制
+func TestWsConn_ReadMessage(t *testing.T) {
+	server, client := net.Pipe()
+	defer func() { _ = client.Close() }()
+	c := &wsConn{conn: server, br: bufio.NewReader(server), maxMessage: 8}
+	mask := []byte{1, 2, 3, 4}
+
+	go func() {
+		frames := appendFrame(nil, opText, []byte("ab"), mask)
+		frames[0] &^= 0x80 // 第一个分片没有FIN
+		frames = append(frames, appendFrame(nil, opPing, []byte("p"), mask)...)
+		frames = append(frames, appendFrame(nil, opContinuation, []byte("cd"), mask)...)
+		frames = append(frames, appendFrame(nil, opText, []byte("123456789"), mask)...)
+		_, _ = client.Write(frames)
+	}()
+	pong := make(chan []byte, 1)
+	go func() {
+		buf := make([]byte, 3)
+		n, _ := client.Read(buf)
+		pong <- buf[:n]
+	}()
+
+	message, err := c.readMessage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(message) != "abcd" {
+		t.Errorf("分片消息错误 %q", message)
+	}
+	if p := <-pong; !bytes.Equal(p, appendFrame(nil, opPong, []byte("p"), nil)) {
+		t.Errorf("pong错误 %v", p)
+	}
+	var ce *closeError
+	if _, err := c.readMessage(); !errors.As(err, &ce) || ce.code != closeTooLarge {
+		t.Error("消息过大时期望closeTooLarge", err)
+	}
+}
+
+// Sec-WebSocket-Accept 与RFC 6455中的示例一致
+func TestAcceptKey(t *testing.T) {
+	if got := acceptKey("dGhlIHNhbXBsZSBub25jZQ=="); got != "s3pPLMBiTxaQ9kYGzzhZRbK+xOo=" {
+		t.Error("Sec-WebSocket-Accept错误", got)
+	}
+}